@@ -0,0 +1,236 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullAddr represents a netip.Addr that may be null.
+// NullAddr implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullAddr struct {
+	Addr  netip.Addr
+	Valid bool // Valid is true if Addr is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports the textual form of an address, as used by Postgres INET columns,
+// and 4 or 16 byte binary addresses, as stored in VARBINARY columns.
+// Bytes are first parsed as text, and only treated as binary if that fails.
+func (n *NullAddr) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	switch v := value.(type) {
+	case nil:
+		n.Addr, n.Valid = netip.Addr{}, false
+		return nil
+	case string:
+		addr, err := parseScannedAddr(v)
+		if err != nil {
+			return err
+		}
+		n.Addr, n.Valid = addr, true
+		return nil
+	case []byte:
+		addr, err := parseScannedAddr(string(v))
+		if err != nil {
+			var ok bool
+			if addr, ok = netip.AddrFromSlice(v); !ok {
+				return err
+			}
+		}
+		n.Addr, n.Valid = addr, true
+		return nil
+	}
+	return fmt.Errorf("null: couldn't scan type %T into Addr: %v", value, value)
+}
+
+// parseScannedAddr parses an address from the database.
+// INET values may include a prefix length, which is dropped.
+func parseScannedAddr(str string) (netip.Addr, error) {
+	if strings.Contains(str, "/") {
+		prefix, err := netip.ParsePrefix(str)
+		if err != nil {
+			return netip.Addr{}, fmt.Errorf("null: couldn't scan Addr: %w", err)
+		}
+		return prefix.Addr(), nil
+	}
+	addr, err := netip.ParseAddr(str)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("null: couldn't scan Addr: %w", err)
+	}
+	return addr, nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent in their textual form.
+func (n NullAddr) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Addr.String(), nil
+}
+
+// IsZero returns true for invalid NullAddrs.
+func (n NullAddr) IsZero() bool {
+	return !n.Valid
+}
+
+// Addr is a nullable netip.Addr.
+// It will decode to null, not the zero Addr, if null.
+type Addr struct {
+	NullAddr
+}
+
+// NewAddr creates a new Addr.
+func NewAddr(a netip.Addr, valid bool) Addr {
+	return Addr{
+		NullAddr: NullAddr{
+			Addr:  a,
+			Valid: valid,
+		},
+	}
+}
+
+// AddrFrom creates a new Addr that will always be valid.
+func AddrFrom(a netip.Addr) Addr {
+	return NewAddr(a, true)
+}
+
+// AddrFromPtr creates a new Addr that will be null if a is nil.
+func AddrFromPtr(a *netip.Addr) Addr {
+	if a == nil {
+		return NewAddr(netip.Addr{}, false)
+	}
+	return NewAddr(*a, true)
+}
+
+// ParseAddr parses s into a new Addr that will always be valid.
+// It will return an error if s is not an IP address.
+func ParseAddr(s string) (Addr, error) {
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return Addr{}, fmt.Errorf("null: couldn't parse Addr: %w", err)
+	}
+	return AddrFrom(a), nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero netip.Addr.
+func (a Addr) ValueOrZero() netip.Addr {
+	if !a.Valid {
+		return netip.Addr{}
+	}
+	return a.Addr
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+// It will return an error if the input is not an IP address.
+func (a *Addr) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(a, &err)
+	if bytes.Equal(data, nullBytes) {
+		a.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := netip.ParseAddr(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	a.Addr = v
+	a.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Addr is null.
+func (a Addr) MarshalJSON() ([]byte, error) {
+	if !a.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(a.Addr.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Addr if the input is blank, "null", or DefaultNullText.
+// It will return an error if the input is not an IP address, blank, or "null".
+func (a *Addr) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(a, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		a.Valid = false
+		return nil
+	}
+	v, err := netip.ParseAddr(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	a.Addr = v
+	a.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Addr is null.
+func (a Addr) MarshalText() ([]byte, error) {
+	if !a.Valid {
+		return nullText(), nil
+	}
+	return []byte(a.Addr.String()), nil
+}
+
+// SetValid changes this Addr's value and also sets it to be non-null.
+func (a *Addr) SetValid(v netip.Addr) {
+	a.Addr = v
+	a.Valid = true
+}
+
+// SetNull sets this Addr to null, leaving its value as is. Use Reset to clear the value too.
+func (a *Addr) SetNull() {
+	a.Valid = false
+}
+
+// Reset sets this Addr to null and clears its value.
+func (a *Addr) Reset() {
+	*a = Addr{}
+}
+
+// Ptr returns a pointer to this Addr's value, or a nil pointer if this Addr is null.
+func (a Addr) Ptr() *netip.Addr {
+	if !a.Valid {
+		return nil
+	}
+	return &a.Addr
+}
+
+// SetPtr changes this Addr's value to the value v points to, or sets it to be null if v is nil, like AddrFromPtr.
+func (a *Addr) SetPtr(v *netip.Addr) {
+	*a = AddrFromPtr(v)
+}
+
+// IsZero returns true for invalid Addrs.
+// A non-null Addr with a zero value will not be considered zero.
+func (a Addr) IsZero() bool {
+	return !a.Valid
+}
+
+// IsZeroOrNull returns true for invalid Addrs, or valid Addrs with the zero netip.Addr.
+func (a Addr) IsZeroOrNull() bool {
+	return !a.Valid || !a.Addr.IsValid()
+}
+
+// Equal returns true if both Addrs are the same address or are both null.
+// An IPv4 address and its IPv4-mapped IPv6 form are not considered equal.
+func (a Addr) Equal(other Addr) bool {
+	return a.Valid == other.Valid && (!a.Valid || a.Addr == other.Addr)
+}
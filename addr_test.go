@@ -0,0 +1,254 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+var (
+	addrString = "192.0.2.1"
+	addrJSON   = []byte(`"` + addrString + `"`)
+	addrValue  = netip.MustParseAddr(addrString)
+	addr6Value = netip.MustParseAddr("2001:db8::1")
+)
+
+func TestAddrFrom(t *testing.T) {
+	a := AddrFrom(addrValue)
+	assertAddr(t, a, "AddrFrom()")
+}
+
+func TestAddrFromPtr(t *testing.T) {
+	v := addrValue
+	a := AddrFromPtr(&v)
+	assertAddr(t, a, "AddrFromPtr()")
+
+	null := AddrFromPtr(nil)
+	assertNullAddr(t, null, "AddrFromPtr(nil)")
+}
+
+func TestParseAddr(t *testing.T) {
+	a, err := ParseAddr(addrString)
+	maybePanic(err)
+	assertAddr(t, a, "ParseAddr()")
+
+	_, err = ParseAddr("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestUnmarshalAddr(t *testing.T) {
+	var a Addr
+	err := json.Unmarshal(addrJSON, &a)
+	maybePanic(err)
+	assertAddr(t, a, "addr json")
+
+	var null Addr
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullAddr(t, null, "null json")
+
+	var bad Addr
+	err = json.Unmarshal(stringJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullAddr(t, bad, "bad addr json")
+
+	var badType Addr
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullAddr(t, badType, "wrong type json")
+
+	var invalid Addr
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullAddr(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalAddr(t *testing.T) {
+	var a Addr
+	err := a.UnmarshalText([]byte(addrString))
+	maybePanic(err)
+	assertAddr(t, a, "UnmarshalText() addr")
+
+	var blank Addr
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullAddr(t, blank, "UnmarshalText() empty addr")
+
+	var null Addr
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullAddr(t, null, `UnmarshalText() "null"`)
+
+	var invalid Addr
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalAddr(t *testing.T) {
+	a := AddrFrom(addrValue)
+	data, err := json.Marshal(a)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(addrJSON), "non-empty json marshal")
+
+	null := NewAddr(netip.Addr{}, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalAddrText(t *testing.T) {
+	a := AddrFrom(addrValue)
+	data, err := a.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, addrString, "non-empty text marshal")
+
+	null := NewAddr(netip.Addr{}, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestAddrPointer(t *testing.T) {
+	a := AddrFrom(addrValue)
+	ptr := a.Ptr()
+	if *ptr != addrValue {
+		t.Errorf("bad %s addr: %v ≠ %v\n", "pointer", ptr, addrValue)
+	}
+
+	null := NewAddr(netip.Addr{}, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s addr: %v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestAddrIsZero(t *testing.T) {
+	a := AddrFrom(addrValue)
+	if a.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewAddr(netip.Addr{}, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestAddrSetValid(t *testing.T) {
+	change := NewAddr(netip.Addr{}, false)
+	assertNullAddr(t, change, "SetValid()")
+	change.SetValid(addrValue)
+	assertAddr(t, change, "SetValid()")
+}
+
+func TestAddrScan(t *testing.T) {
+	var a Addr
+	err := a.Scan(addrString)
+	maybePanic(err)
+	assertAddr(t, a, "scanned string")
+
+	var inet Addr
+	err = inet.Scan([]byte(addrString + "/24"))
+	maybePanic(err)
+	assertAddr(t, inet, "scanned inet")
+
+	var v4 Addr
+	err = v4.Scan([]byte{192, 0, 2, 1})
+	maybePanic(err)
+	assertAddr(t, v4, "scanned 4 bytes")
+
+	var v6 Addr
+	err = v6.Scan(addr6Value.AsSlice())
+	maybePanic(err)
+	if !v6.Valid || v6.Addr != addr6Value {
+		t.Errorf("bad scanned 16 bytes: %v", v6.Addr)
+	}
+
+	var null Addr
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullAddr(t, null, "scanned null")
+
+	var invalid Addr
+	err = invalid.Scan([]byte{1, 2, 3})
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var wrong Addr
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestAddrValue(t *testing.T) {
+	a := AddrFrom(addrValue)
+	v, err := a.Value()
+	maybePanic(err)
+	if v != addrString {
+		t.Errorf("bad Value(): %#v ≠ %s", v, addrString)
+	}
+
+	null := NewAddr(addrValue, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestAddrEqual(t *testing.T) {
+	a1 := NewAddr(addrValue, false)
+	a2 := NewAddr(addr6Value, false)
+	if !a1.Equal(a2) {
+		t.Error("null Addrs should be equal")
+	}
+
+	a1 = AddrFrom(addrValue)
+	a2 = AddrFrom(netip.MustParseAddr(addrString))
+	if !a1.Equal(a2) {
+		t.Error("same Addrs should be equal")
+	}
+
+	a1 = AddrFrom(addrValue)
+	a2 = NewAddr(addrValue, false)
+	if a1.Equal(a2) {
+		t.Error("valid and null Addrs should not be equal")
+	}
+
+	a1 = AddrFrom(addrValue)
+	a2 = AddrFrom(addr6Value)
+	if a1.Equal(a2) {
+		t.Error("different Addrs should not be equal")
+	}
+}
+
+func assertAddr(t *testing.T, a Addr, from string) {
+	t.Helper()
+	if a.Addr != addrValue {
+		t.Errorf("bad %s addr: %v ≠ %v\n", from, a.Addr, addrValue)
+	}
+	if !a.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullAddr(t *testing.T, a Addr, from string) {
+	t.Helper()
+	if a.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,91 @@
+package null
+
+// number is the value type of the numeric types that Sum and StrictSum can add.
+type number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// summable is implemented by the numeric types, such as Int, Uint, Float, and Duration.
+type summable[T number] interface {
+	Get() (T, bool)
+}
+
+// settable is implemented by pointers to the numeric types.
+type settable[N any, T number] interface {
+	*N
+	SetValid(T)
+}
+
+// Sum returns the sum of the valid values, skipping null ones like SQL's SUM.
+// It returns null if there are no valid values. Overflow wraps around, as with Go's + operator.
+// It can be used with the numeric types, such as Int, Uint, Float, Duration, ByteSize, and the zero types.
+func Sum[N summable[T], T number, PN settable[N, T]](values ...N) N {
+	return sum[N, T, PN](values, false)
+}
+
+// StrictSum is like Sum, but returns null if any value is null, like SQL's + operator.
+func StrictSum[N summable[T], T number, PN settable[N, T]](values ...N) N {
+	return sum[N, T, PN](values, true)
+}
+
+func sum[N summable[T], T number, PN settable[N, T]](values []N, strict bool) N {
+	var total T
+	var result N
+	for _, v := range values {
+		n, ok := v.Get()
+		if !ok {
+			if strict {
+				var null N
+				return null
+			}
+			continue
+		}
+		total += n
+		PN(&result).SetValid(total)
+	}
+	return result
+}
+
+// Min returns the least of the valid values according to their Compare methods, skipping null ones like SQL's MIN.
+// It returns null if there are no valid values.
+func Min[T ordered[T]](values ...T) T {
+	return extreme(values, -1, false)
+}
+
+// Max returns the greatest of the valid values according to their Compare methods, skipping null ones like SQL's MAX.
+// It returns null if there are no valid values.
+func Max[T ordered[T]](values ...T) T {
+	return extreme(values, 1, false)
+}
+
+// StrictMin is like Min, but returns null if any value is null.
+func StrictMin[T ordered[T]](values ...T) T {
+	return extreme(values, -1, true)
+}
+
+// StrictMax is like Max, but returns null if any value is null.
+func StrictMax[T ordered[T]](values ...T) T {
+	return extreme(values, 1, true)
+}
+
+// extreme returns the first valid value that no other value compares to as sign does,
+// which is the minimum for a sign of -1 and the maximum for 1.
+func extreme[T ordered[T]](values []T, sign int, strict bool) T {
+	var result T
+	found := false
+	for _, v := range values {
+		if v.IsZero() {
+			if strict {
+				var null T
+				return null
+			}
+			continue
+		}
+		if !found || v.Compare(result) == sign {
+			result, found = v, true
+		}
+	}
+	return result
+}
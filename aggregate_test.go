@@ -0,0 +1,71 @@
+package null
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+func TestSum(t *testing.T) {
+	null := NewInt(0, false)
+	if got := Sum(IntFrom(1), null, IntFrom(2)); !got.Equal(IntFrom(3)) {
+		t.Errorf("Sum(): got %v, want 3", got)
+	}
+	if got := Sum(null, null); got.Valid {
+		t.Errorf("Sum() of nulls: got %v, want null", got)
+	}
+	if got := Sum[Int](); got.Valid {
+		t.Errorf("Sum() of nothing: got %v, want null", got)
+	}
+	if got := StrictSum(IntFrom(1), null, IntFrom(2)); got.Valid {
+		t.Errorf("StrictSum() with null: got %v, want null", got)
+	}
+	if got := StrictSum(IntFrom(1), IntFrom(2)); !got.Equal(IntFrom(3)) {
+		t.Errorf("StrictSum(): got %v, want 3", got)
+	}
+
+	if got := Sum(FloatFrom(0.5), NewFloat(0, false), FloatFrom(0.25)); !got.Equal(FloatFrom(0.75)) {
+		t.Errorf("Float Sum(): got %v", got)
+	}
+	if got := Sum(DurationFrom(time.Second), DurationFrom(time.Minute)); !got.Equal(DurationFrom(61 * time.Second)) {
+		t.Errorf("Duration Sum(): got %v", got)
+	}
+	if got := Sum(ByteSizeFrom(1<<10), ByteSizeFrom(1<<20)); !got.Equal(ByteSizeFrom(1<<10 + 1<<20)) {
+		t.Errorf("ByteSize Sum(): got %v", got)
+	}
+	if got := Sum(zero.IntFrom(2), zero.IntFrom(0), zero.NewInt(5, false)); !got.Equal(zero.IntFrom(2)) {
+		t.Errorf("zero.Int Sum(): got %v", got)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	null := NewUint(0, false)
+	values := []Uint{UintFrom(3), null, UintFrom(1), UintFrom(2)}
+	if got := Min(values...); !got.Equal(UintFrom(1)) {
+		t.Errorf("Min(): got %v, want 1", got)
+	}
+	if got := Max(values...); !got.Equal(UintFrom(3)) {
+		t.Errorf("Max(): got %v, want 3", got)
+	}
+	if got := StrictMin(values...); got.Valid {
+		t.Errorf("StrictMin() with null: got %v, want null", got)
+	}
+	if got := StrictMax(UintFrom(3), UintFrom(4)); !got.Equal(UintFrom(4)) {
+		t.Errorf("StrictMax(): got %v, want 4", got)
+	}
+	if got := Max(null, null); got.Valid {
+		t.Errorf("Max() of nulls: got %v, want null", got)
+	}
+	if got := Min[String](); got.Valid {
+		t.Errorf("Min() of nothing: got %v, want null", got)
+	}
+
+	now := time.Now()
+	if got := Max(TimeFrom(now), NewTime(now.Add(time.Hour), false), TimeFrom(now.Add(-time.Hour))); !got.Equal(TimeFrom(now)) {
+		t.Errorf("Time Max(): got %v", got)
+	}
+	if got := Min(MustSemver("1.2.0"), MustSemver("1.10.0"), NewSemver("", false)); !got.Equal(MustSemver("1.2.0")) {
+		t.Errorf("Semver Min(): got %v", got)
+	}
+}
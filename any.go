@@ -0,0 +1,197 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// Any is a nullable value of any type, for schemaless data such as attribute tables.
+// JSON numbers are decoded as json.Number, so integers keep their precision.
+// It will decode to null if null.
+type Any struct {
+	Any   interface{}
+	Valid bool // Valid is true if Any is not NULL
+}
+
+// NewAny creates a new Any.
+func NewAny(v interface{}, valid bool) Any {
+	return Any{
+		Any:   v,
+		Valid: valid,
+	}
+}
+
+// AnyFrom creates a new Any that will be null if v is nil.
+func AnyFrom(v interface{}) Any {
+	return NewAny(v, v != nil)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (a Any) ValueOrZero() interface{} {
+	if !a.Valid {
+		return nil
+	}
+	return a.Any
+}
+
+// AsString returns the inner value if it is a string or []byte.
+// ok is false if this Any is null or holds a different type.
+func (a Any) AsString() (s string, ok bool) {
+	if !a.Valid {
+		return "", false
+	}
+	switch v := a.Any.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	}
+	return "", false
+}
+
+// AsInt64 returns the inner value if it is an integer that fits in an int64.
+// ok is false if this Any is null or holds a different type.
+func (a Any) AsInt64() (i int64, ok bool) {
+	if !a.Valid {
+		return 0, false
+	}
+	if n, ok := a.Any.(json.Number); ok {
+		i, err := n.Int64()
+		return i, err == nil
+	}
+	rv := reflect.ValueOf(a.Any)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := rv.Uint(); u <= math.MaxInt64 {
+			return int64(u), true
+		}
+	}
+	return 0, false
+}
+
+// AsFloat64 returns the inner value if it is a number.
+// ok is false if this Any is null or holds a different type.
+func (a Any) AsFloat64() (f float64, ok bool) {
+	if !a.Valid {
+		return 0, false
+	}
+	if n, ok := a.Any.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	rv := reflect.ValueOf(a.Any)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	}
+	return 0, false
+}
+
+// Scan implements the Scanner interface.
+// Any driver value is accepted as-is, except that []byte values are copied.
+func (a *Any) Scan(value interface{}) (err error) {
+	defer validateDecoded(a, &err)
+	if b, ok := value.([]byte); ok {
+		value = append([]byte(nil), b...)
+	}
+	a.Any, a.Valid = value, value != nil
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Primitive values are sent as-is, and other values are converted with
+// driver.DefaultParameterConverter if possible, otherwise encoded as JSON strings.
+func (a Any) Value() (driver.Value, error) {
+	if !a.Valid || a.Any == nil {
+		return nil, nil
+	}
+	switch v := a.Any.(type) {
+	case int64, float64, bool, string, []byte, time.Time:
+		return v, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		return v.Float64()
+	}
+	if v, err := driver.DefaultParameterConverter.ConvertValue(a.Any); err == nil {
+		return v, nil
+	}
+	data, err := json.Marshal(a.Any)
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't convert Any: %w", err)
+	}
+	return string(data), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports any JSON input. Numbers are decoded as json.Number.
+func (a *Any) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(a, &err)
+	if bytes.Equal(data, nullBytes) {
+		a.Any, a.Valid = nil, false
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	a.Any = v
+	a.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Any is null.
+func (a Any) MarshalJSON() ([]byte, error) {
+	if !a.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(a.Any)
+}
+
+// SetValid changes this Any's value and also sets it to be non-null.
+func (a *Any) SetValid(v interface{}) {
+	a.Any = v
+	a.Valid = true
+}
+
+// SetNull sets this Any to null, leaving its value as is. Use Reset to clear the value too.
+func (a *Any) SetNull() {
+	a.Valid = false
+}
+
+// Reset sets this Any to null and clears its value.
+func (a *Any) Reset() {
+	*a = Any{}
+}
+
+// IsZero returns true for invalid Anys.
+func (a Any) IsZero() bool {
+	return !a.Valid
+}
+
+// IsZeroOrNull returns true for invalid Anys, or valid Anys with a nil value.
+func (a Any) IsZeroOrNull() bool {
+	return !a.Valid || a.Any == nil
+}
+
+// Equal returns true if both Anys hold deeply equal values or are both null.
+func (a Any) Equal(other Any) bool {
+	return a.Valid == other.Valid && (!a.Valid || reflect.DeepEqual(a.Any, other.Any))
+}
@@ -0,0 +1,196 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAnyFrom(t *testing.T) {
+	a := AnyFrom("hello")
+	if a.Any != "hello" || !a.Valid {
+		t.Errorf("bad AnyFrom(): %#v", a)
+	}
+
+	zero := AnyFrom(0)
+	if !zero.Valid {
+		t.Error("AnyFrom(0)", "is invalid, but should be valid")
+	}
+
+	null := AnyFrom(nil)
+	assertNullAny(t, null, "AnyFrom(nil)")
+}
+
+func TestAnyAs(t *testing.T) {
+	if s, ok := AnyFrom("hello").AsString(); s != "hello" || !ok {
+		t.Errorf("bad AsString(): %q %v", s, ok)
+	}
+	if s, ok := AnyFrom([]byte("hello")).AsString(); s != "hello" || !ok {
+		t.Errorf("bad AsString() of bytes: %q %v", s, ok)
+	}
+	if _, ok := AnyFrom(1).AsString(); ok {
+		t.Error("AsString() of int should not be ok")
+	}
+
+	for _, v := range []interface{}{12345, int8(57), int64(12345), uint32(12345), json.Number("12345")} {
+		if i, ok := AnyFrom(v).AsInt64(); !ok || (i != 12345 && i != 57) {
+			t.Errorf("bad AsInt64() of %T: %d %v", v, i, ok)
+		}
+	}
+	for _, v := range []interface{}{"12345", 1.5, uint64(math.MaxUint64), json.Number("1.5")} {
+		if _, ok := AnyFrom(v).AsInt64(); ok {
+			t.Errorf("AsInt64() of %T %v should not be ok", v, v)
+		}
+	}
+
+	for _, v := range []interface{}{1.5, float32(1.5), json.Number("1.5")} {
+		if f, ok := AnyFrom(v).AsFloat64(); f != 1.5 || !ok {
+			t.Errorf("bad AsFloat64() of %T: %v %v", v, f, ok)
+		}
+	}
+	if f, ok := AnyFrom(int64(2)).AsFloat64(); f != 2 || !ok {
+		t.Errorf("bad AsFloat64() of int64: %v %v", f, ok)
+	}
+	if _, ok := AnyFrom(true).AsFloat64(); ok {
+		t.Error("AsFloat64() of bool should not be ok")
+	}
+
+	var null Any
+	if _, ok := null.AsString(); ok {
+		t.Error("AsString() of null should not be ok")
+	}
+	if _, ok := null.AsInt64(); ok {
+		t.Error("AsInt64() of null should not be ok")
+	}
+	if _, ok := null.AsFloat64(); ok {
+		t.Error("AsFloat64() of null should not be ok")
+	}
+}
+
+func TestUnmarshalAny(t *testing.T) {
+	var i Any
+	err := json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	if n, ok := i.AsInt64(); n != 12345 || !ok {
+		t.Errorf("bad int json: %#v", i)
+	}
+
+	var big Any
+	err = json.Unmarshal([]byte(`9007199254740993`), &big)
+	maybePanic(err)
+	if n, ok := big.AsInt64(); n != 9007199254740993 || !ok {
+		t.Errorf("bad big int json: %#v", big)
+	}
+
+	var obj Any
+	err = json.Unmarshal([]byte(`{"a":[true,"b"]}`), &obj)
+	maybePanic(err)
+	want := map[string]interface{}{"a": []interface{}{true, "b"}}
+	if !obj.Equal(AnyFrom(want)) {
+		t.Errorf("bad object json: %#v", obj)
+	}
+
+	var null Any
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullAny(t, null, "null json")
+
+	var invalid Any
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullAny(t, invalid, "invalid json")
+}
+
+func TestMarshalAny(t *testing.T) {
+	data, err := json.Marshal(AnyFrom(map[string]interface{}{"a": 1}))
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"a":1}`, "non-empty json marshal")
+
+	var roundtrip Any
+	maybePanic(json.Unmarshal(intJSON, &roundtrip))
+	data, err = json.Marshal(roundtrip)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(intJSON), "round trip json marshal")
+
+	data, err = json.Marshal(NewAny("hello", false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestAnyScanValue(t *testing.T) {
+	b := []byte("hello")
+	var a Any
+	err := a.Scan(b)
+	maybePanic(err)
+	b[0] = 'j'
+	if s, ok := a.AsString(); s != "hello" || !ok {
+		t.Errorf("scanned bytes should be copied: %#v", a)
+	}
+
+	var i Any
+	err = i.Scan(int64(12345))
+	maybePanic(err)
+	if v, err := i.Value(); v != int64(12345) || err != nil {
+		t.Errorf("bad int Value(): %#v %v", v, err)
+	}
+
+	var null Any
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullAny(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Errorf("bad null Value(): %#v %v", v, err)
+	}
+
+	table := []struct {
+		in   interface{}
+		want interface{}
+	}{
+		{"hello", "hello"},
+		{12345, int64(12345)},
+		{float32(1.5), float64(1.5)},
+		{json.Number("12345"), int64(12345)},
+		{json.Number("1.5"), 1.5},
+		{true, true},
+		{map[string]int{"a": 1}, `{"a":1}`},
+	}
+	for _, tc := range table {
+		v, err := AnyFrom(tc.in).Value()
+		maybePanic(err)
+		if v != tc.want {
+			t.Errorf("bad Value() of %T: %#v ≠ %#v", tc.in, v, tc.want)
+		}
+	}
+
+	if _, err := AnyFrom(func() {}).Value(); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestAnyMisc(t *testing.T) {
+	a := NewAny("hello", false)
+	if !a.IsZero() || a.ValueOrZero() != nil {
+		t.Error("bad null Any")
+	}
+	a.SetValid("hello")
+	if a.IsZero() || a.ValueOrZero() != "hello" {
+		t.Error("bad valid Any")
+	}
+	if !a.Equal(AnyFrom("hello")) || a.Equal(AnyFrom("world")) || a.Equal(Any{}) {
+		t.Error("bad Equal()")
+	}
+	if !NewAny(1, false).Equal(NewAny(2, false)) {
+		t.Error("null Anys should be equal")
+	}
+}
+
+func assertNullAny(t *testing.T, a Any, from string) {
+	t.Helper()
+	if a.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,949 @@
+package null
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// This file implements AppendJSON and AppendText, which append the same output as MarshalJSON and MarshalText
+// to a byte slice instead of allocating a new one, so that encoders can reuse a buffer.
+// AppendText implements encoding.TextAppender.
+// Numbers, booleans, strings, and binary types are appended directly;
+// other types are formatted as usual and then appended.
+
+// appendJSON appends the JSON encoding of m to dst.
+func appendJSON(dst []byte, m json.Marshaler) ([]byte, error) {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, data...), nil
+}
+
+// appendText appends the text encoding of m to dst.
+func appendText(dst []byte, m encoding.TextMarshaler) ([]byte, error) {
+	text, err := m.MarshalText()
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, text...), nil
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends s to dst as a JSON string, escaped the same way as encoding/json,
+// including HTML characters.
+func appendJSONString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are escaped for JavaScript, as in encoding/json
+		if r == '\u2028' || r == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// AppendJSON appends the JSON encoding of this Addr to dst, the same as MarshalJSON.
+func (a Addr) AppendJSON(dst []byte) ([]byte, error) {
+	if !a.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, a.Addr.String()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (a Addr) AppendText(dst []byte) ([]byte, error) {
+	if !a.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, a.Addr.String()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Any to dst, the same as MarshalJSON.
+func (a Any) AppendJSON(dst []byte) ([]byte, error) {
+	if !a.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSON(dst, a)
+}
+
+// AppendJSON appends the JSON encoding of this Base64 to dst, the same as MarshalJSON.
+func (b Base64) AppendJSON(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, '"')
+	dst = DefaultBase64Encoding.encoding().AppendEncode(dst, b.Bytes)
+	return append(dst, '"'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (b Base64) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return DefaultBase64Encoding.encoding().AppendEncode(dst, b.Bytes), nil
+}
+
+// AppendJSON appends the JSON encoding of this BigInt to dst, the same as MarshalJSON.
+func (b BigInt) AppendJSON(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, '"')
+	dst = b.BigInt.Append(dst, 10)
+	return append(dst, '"'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (b BigInt) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return b.BigInt.Append(dst, 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this Bool to dst, the same as MarshalJSON.
+func (b Bool) AppendJSON(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendBool(dst, b.Bool), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (b Bool) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendBool(dst, b.Bool), nil
+}
+
+// AppendJSON appends the JSON encoding of this ByteSize to dst, the same as MarshalJSON.
+func (b ByteSize) AppendJSON(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, formatByteSize(b.Size)), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (b ByteSize) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, formatByteSize(b.Size)...), nil
+}
+
+func (e BytesEncoding) appendEncode(dst, b []byte) []byte {
+	if e == BytesHex {
+		return hex.AppendEncode(dst, b)
+	}
+	return base64.StdEncoding.AppendEncode(dst, b)
+}
+
+// AppendJSON appends the JSON encoding of this Bytes to dst, the same as MarshalJSON.
+func (b Bytes) AppendJSON(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, '"')
+	dst = DefaultBytesEncoding.appendEncode(dst, b.Bytes)
+	return append(dst, '"'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (b Bytes) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return DefaultBytesEncoding.appendEncode(dst, b.Bytes), nil
+}
+
+// AppendJSON appends the JSON encoding of this Complex to dst, the same as MarshalJSON.
+func (c Complex) AppendJSON(dst []byte) ([]byte, error) {
+	if !c.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	if DefaultComplexFormat == ComplexString {
+		return appendJSONString(dst, strconv.FormatComplex(c.Complex, 'g', -1, 128)), nil
+	}
+	return appendJSON(dst, c)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (c Complex) AppendText(dst []byte) ([]byte, error) {
+	if !c.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, strconv.FormatComplex(c.Complex, 'g', -1, 128)...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Date to dst, the same as MarshalJSON.
+func (d Date) AppendJSON(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, d.format()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (d Date) AppendText(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, d.format()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Duration to dst, the same as MarshalJSON.
+func (d Duration) AppendJSON(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	switch DefaultDurationFormat {
+	case DurationNanoseconds:
+		return strconv.AppendInt(dst, int64(d.Duration), 10), nil
+	case DurationSeconds:
+		return strconv.AppendFloat(dst, d.Duration.Seconds(), 'f', -1, 64), nil
+	}
+	return appendJSONString(dst, d.Duration.String()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (d Duration) AppendText(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, d.Duration.String()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Email to dst, the same as MarshalJSON.
+func (e Email) AppendJSON(dst []byte) ([]byte, error) {
+	if !e.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, e.Address), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (e Email) AppendText(dst []byte) ([]byte, error) {
+	if !e.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, e.Address...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Enum to dst, the same as MarshalJSON.
+func (e Enum[T]) AppendJSON(dst []byte) ([]byte, error) {
+	if !e.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSON(dst, e)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (e Enum[T]) AppendText(dst []byte) ([]byte, error) {
+	return appendText(dst, e)
+}
+
+// AppendJSON appends the JSON encoding of this FileMode to dst, the same as MarshalJSON.
+func (m FileMode) AppendJSON(dst []byte) ([]byte, error) {
+	if !m.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, formatFileMode(m.FileMode)), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (m FileMode) AppendText(dst []byte) ([]byte, error) {
+	if !m.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, formatFileMode(m.FileMode)...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Flags to dst, the same as MarshalJSON.
+func (f Flags[T]) AppendJSON(dst []byte) ([]byte, error) {
+	if !f.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	if _, ok := lookupFlagNames[T](); ok {
+		return appendJSON(dst, f)
+	}
+	return strconv.AppendUint(dst, uint64(f.V), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (f Flags[T]) AppendText(dst []byte) ([]byte, error) {
+	if !f.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendUint(dst, uint64(f.V), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this Float to dst, the same as MarshalJSON.
+// It returns an error for infinity and NaN, which can't be represented in JSON.
+func (f Float) AppendJSON(dst []byte) ([]byte, error) {
+	if !f.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	if math.IsInf(f.Float64, 0) || math.IsNaN(f.Float64) {
+		_, err := f.MarshalJSON()
+		return dst, err
+	}
+	return strconv.AppendFloat(dst, f.Float64, 'f', -1, 64), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (f Float) AppendText(dst []byte) ([]byte, error) {
+	if !f.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendFloat(dst, f.Float64, 'f', -1, 64), nil
+}
+
+// AppendJSON appends the JSON encoding of this HardwareAddr to dst, the same as MarshalJSON.
+func (h HardwareAddr) AppendJSON(dst []byte) ([]byte, error) {
+	if !h.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, h.HardwareAddr.String()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (h HardwareAddr) AppendText(dst []byte) ([]byte, error) {
+	if !h.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, h.HardwareAddr.String()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Hex to dst, the same as MarshalJSON.
+func (h Hex) AppendJSON(dst []byte) ([]byte, error) {
+	if !h.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, '"')
+	dst = hex.AppendEncode(dst, h.Bytes)
+	return append(dst, '"'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (h Hex) AppendText(dst []byte) ([]byte, error) {
+	if !h.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return hex.AppendEncode(dst, h.Bytes), nil
+}
+
+// AppendJSON appends the JSON encoding of this FixedHex to dst, the same as MarshalJSON.
+func (h FixedHex[A]) AppendJSON(dst []byte) ([]byte, error) {
+	if !h.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, '"')
+	dst = hex.AppendEncode(dst, fixedHexBytes(&h.V))
+	return append(dst, '"'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (h FixedHex[A]) AppendText(dst []byte) ([]byte, error) {
+	if !h.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return hex.AppendEncode(dst, fixedHexBytes(&h.V)), nil
+}
+
+// AppendJSON appends the JSON encoding of this Int to dst, the same as MarshalJSON.
+func (i Int) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendInt(dst, i.Int64, 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (i Int) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendInt(dst, i.Int64, 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this Int16 to dst, the same as MarshalJSON.
+func (i Int16) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendInt(dst, int64(i.Int16), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (i Int16) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendInt(dst, int64(i.Int16), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this Int32 to dst, the same as MarshalJSON.
+func (i Int32) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendInt(dst, int64(i.Int32), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (i Int32) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendInt(dst, int64(i.Int32), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this Int8 to dst, the same as MarshalJSON.
+func (i Int8) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendInt(dst, int64(i.Int8), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (i Int8) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendInt(dst, int64(i.Int8), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this CountryCode to dst, the same as MarshalJSON.
+func (c CountryCode) AppendJSON(dst []byte) ([]byte, error) {
+	if !c.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, c.Code), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (c CountryCode) AppendText(dst []byte) ([]byte, error) {
+	if !c.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, c.Code...), nil
+}
+
+// AppendJSON appends the JSON encoding of this CurrencyCode to dst, the same as MarshalJSON.
+func (c CurrencyCode) AppendJSON(dst []byte) ([]byte, error) {
+	if !c.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, c.Code), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (c CurrencyCode) AppendText(dst []byte) ([]byte, error) {
+	if !c.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, c.Code...), nil
+}
+
+// AppendJSON appends the JSON encoding of this JSON to dst, the same as MarshalJSON.
+func (j JSON) AppendJSON(dst []byte) ([]byte, error) {
+	if !j.Valid || len(j.JSON) == 0 {
+		return append(dst, nullBytes...), nil
+	}
+	return append(dst, j.JSON...), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (j JSON) AppendText(dst []byte) ([]byte, error) {
+	if !j.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, j.JSON...), nil
+}
+
+// AppendJSON appends the JSON encoding of this KSUID to dst, the same as MarshalJSON.
+func (k KSUID) AppendJSON(dst []byte) ([]byte, error) {
+	if !k.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, '"')
+	dst = append(dst, formatKSUID(k.KSUID)...)
+	return append(dst, '"'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (k KSUID) AppendText(dst []byte) ([]byte, error) {
+	if !k.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, formatKSUID(k.KSUID)...), nil
+}
+
+// AppendJSON appends the JSON encoding of this LanguageTag to dst, the same as MarshalJSON.
+func (l LanguageTag) AppendJSON(dst []byte) ([]byte, error) {
+	if !l.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, l.Tag), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (l LanguageTag) AppendText(dst []byte) ([]byte, error) {
+	if !l.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, l.Tag...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Map to dst, the same as MarshalJSON.
+func (m Map[K, V]) AppendJSON(dst []byte) ([]byte, error) {
+	if !m.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSON(dst, m)
+}
+
+// AppendJSON appends the JSON encoding of this Money to dst, the same as MarshalJSON.
+func (m Money) AppendJSON(dst []byte) ([]byte, error) {
+	if !m.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, `{"amount":`...)
+	dst = appendJSONString(dst, m.AmountString())
+	dst = append(dst, `,"currency":`...)
+	dst = appendJSONString(dst, m.Currency)
+	return append(dst, '}'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (m Money) AppendText(dst []byte) ([]byte, error) {
+	if !m.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	dst = append(dst, m.AmountString()...)
+	dst = append(dst, ' ')
+	return append(dst, m.Currency...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Optional to dst, the same as MarshalJSON.
+func (o Optional[T]) AppendJSON(dst []byte) ([]byte, error) {
+	return o.value().AppendJSON(dst)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (o Optional[T]) AppendText(dst []byte) ([]byte, error) {
+	return o.value().AppendText(dst)
+}
+
+// AppendJSON appends the JSON encoding of this Percent to dst, the same as MarshalJSON.
+func (p Percent) AppendJSON(dst []byte) ([]byte, error) {
+	return Float{p.NullFloat64}.AppendJSON(dst)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (p Percent) AppendText(dst []byte) ([]byte, error) {
+	return Float{p.NullFloat64}.AppendText(dst)
+}
+
+// AppendJSON appends the JSON encoding of this Point to dst, the same as MarshalJSON.
+func (p Point) AppendJSON(dst []byte) ([]byte, error) {
+	if !p.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSON(dst, p)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (p Point) AppendText(dst []byte) ([]byte, error) {
+	if !p.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, formatPointWKT(p.Lat, p.Lng)...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Prefix to dst, the same as MarshalJSON.
+func (p Prefix) AppendJSON(dst []byte) ([]byte, error) {
+	if !p.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, p.Prefix.String()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (p Prefix) AppendText(dst []byte) ([]byte, error) {
+	if !p.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, p.Prefix.String()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Int64Range to dst, the same as MarshalJSON.
+func (r Int64Range) AppendJSON(dst []byte) ([]byte, error) {
+	if !r.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, r.String()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (r Int64Range) AppendText(dst []byte) ([]byte, error) {
+	if !r.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, r.String()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this TimeRange to dst, the same as MarshalJSON.
+func (r TimeRange) AppendJSON(dst []byte) ([]byte, error) {
+	if !r.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, r.String()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (r TimeRange) AppendText(dst []byte) ([]byte, error) {
+	if !r.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, r.String()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Regexp to dst, the same as MarshalJSON.
+func (r Regexp) AppendJSON(dst []byte) ([]byte, error) {
+	if !r.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, r.Regexp.String()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (r Regexp) AppendText(dst []byte) ([]byte, error) {
+	if !r.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, r.Regexp.String()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Semver to dst, the same as MarshalJSON.
+func (s Semver) AppendJSON(dst []byte) ([]byte, error) {
+	if !s.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, s.Version), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (s Semver) AppendText(dst []byte) ([]byte, error) {
+	if !s.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, s.Version...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Slice to dst, the same as MarshalJSON.
+func (s Slice[T]) AppendJSON(dst []byte) ([]byte, error) {
+	if !s.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSON(dst, s)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (s Slice[T]) AppendText(dst []byte) ([]byte, error) {
+	if !s.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return s.AppendJSON(dst)
+}
+
+// AppendJSON appends the JSON encoding of this String to dst, the same as MarshalJSON.
+func (s String) AppendJSON(dst []byte) ([]byte, error) {
+	if !s.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, s.String), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (s String) AppendText(dst []byte) ([]byte, error) {
+	if !s.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, s.String...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Time to dst, the same as MarshalJSON.
+func (t Time) AppendJSON(dst []byte) ([]byte, error) {
+	return DefaultTimeOptions.AppendTimeJSON(dst, t)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (t Time) AppendText(dst []byte) ([]byte, error) {
+	return DefaultTimeOptions.AppendTimeText(dst, t)
+}
+
+// AppendJSON appends the JSON encoding of this TimeOfDay to dst, the same as MarshalJSON.
+func (t TimeOfDay) AppendJSON(dst []byte) ([]byte, error) {
+	if !t.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, t.format()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (t TimeOfDay) AppendText(dst []byte) ([]byte, error) {
+	if !t.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, t.format()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Uint to dst, the same as MarshalJSON.
+func (i Uint) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendUint(dst, i.Uint64, 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (i Uint) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendUint(dst, i.Uint64, 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this Uint16 to dst, the same as MarshalJSON.
+func (i Uint16) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint16), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (i Uint16) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint16), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this Uint32 to dst, the same as MarshalJSON.
+func (i Uint32) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint32), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (i Uint32) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint32), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this Uint8 to dst, the same as MarshalJSON.
+func (i Uint8) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint8), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (i Uint8) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint8), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this ULID to dst, the same as MarshalJSON.
+func (u ULID) AppendJSON(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, '"')
+	dst = append(dst, formatULID(u.ULID)...)
+	return append(dst, '"'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (u ULID) AppendText(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, formatULID(u.ULID)...), nil
+}
+
+// AppendJSON appends the JSON encoding of this UnixSeconds to dst, the same as MarshalJSON.
+func (u UnixSeconds) AppendJSON(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendInt(dst, u.Time.Unix(), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (u UnixSeconds) AppendText(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendInt(dst, u.Time.Unix(), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this UnixMillis to dst, the same as MarshalJSON.
+func (u UnixMillis) AppendJSON(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return strconv.AppendInt(dst, u.Time.UnixMilli(), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (u UnixMillis) AppendText(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return strconv.AppendInt(dst, u.Time.UnixMilli(), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this URL to dst, the same as MarshalJSON.
+func (u URL) AppendJSON(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSONString(dst, u.URL.String()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (u URL) AppendText(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	return append(dst, u.URL.String()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Value to dst, the same as MarshalJSON.
+func (v Value[T]) AppendJSON(dst []byte) ([]byte, error) {
+	if !v.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	return appendJSON(dst, v)
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (v Value[T]) AppendText(dst []byte) ([]byte, error) {
+	if !v.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	if a, ok := any(v.V).(encoding.TextAppender); ok {
+		return a.AppendText(dst)
+	}
+	return appendText(dst, v)
+}
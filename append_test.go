@@ -0,0 +1,133 @@
+package null
+
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type jsonAppender interface {
+	AppendJSON(dst []byte) ([]byte, error)
+}
+
+func TestAppendJSON(t *testing.T) {
+	prefix := []byte("prefix:")
+	for _, in := range []allTypes{exampleAllTypes(), {}} {
+		rv := reflect.ValueOf(in)
+		for i := 0; i < rv.NumField(); i++ {
+			name := rv.Type().Field(i).Name
+			field := rv.Field(i).Interface()
+
+			want, err := field.(json.Marshaler).MarshalJSON()
+			maybePanic(err)
+			got, err := field.(jsonAppender).AppendJSON(prefix[:len(prefix):len(prefix)])
+			maybePanic(err)
+			assertJSONEquals(t, got, string(prefix)+string(want), name+" AppendJSON")
+
+			m, ok := field.(encoding.TextMarshaler)
+			if !ok {
+				continue
+			}
+			want, err = m.MarshalText()
+			maybePanic(err)
+			got, err = field.(encoding.TextAppender).AppendText(prefix[:len(prefix):len(prefix)])
+			maybePanic(err)
+			assertJSONEquals(t, got, string(prefix)+string(want), name+" AppendText")
+		}
+	}
+}
+
+func TestAppendJSONOptions(t *testing.T) {
+	defer func() {
+		DefaultTimeOptions = TimeOptions{}
+		DefaultDurationFormat = DurationString
+		DefaultBytesEncoding = BytesBase64
+		DefaultComplexFormat = ComplexObject
+	}()
+	DefaultDurationFormat = DurationSeconds
+	DefaultBytesEncoding = BytesHex
+	DefaultComplexFormat = ComplexString
+
+	loc := time.FixedZone("JST", 9*60*60)
+	for _, opts := range []TimeOptions{
+		{Format: "2006-01-02 15:04:05"},
+		{Format: "Jan 2 <15:04>", Location: loc},
+		{Format: TimeLayoutUnix},
+	} {
+		DefaultTimeOptions = opts
+		for _, v := range []interface {
+			json.Marshaler
+			encoding.TextMarshaler
+			jsonAppender
+			encoding.TextAppender
+		}{
+			TimeFrom(timeValue1), DurationFrom(1500 * time.Millisecond), BytesFrom([]byte("hello")), ComplexFrom(1 + 2i),
+		} {
+			want, err := v.MarshalJSON()
+			maybePanic(err)
+			got, err := v.AppendJSON(nil)
+			maybePanic(err)
+			assertJSONEquals(t, got, string(want), "AppendJSON with options")
+
+			want, err = v.MarshalText()
+			maybePanic(err)
+			got, err = v.AppendText(nil)
+			maybePanic(err)
+			assertJSONEquals(t, got, string(want), "AppendText with options")
+		}
+	}
+}
+
+func TestAppendJSONString(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"test",
+		"a\b\f\n\r\t\x01\x1f\x7f",
+		`<script>"&'\</script>`,
+		"\u2028\u2029",
+		"日本語 é 🙂",
+	} {
+		want, err := json.Marshal(s)
+		maybePanic(err)
+		assertJSONEquals(t, appendJSONString(nil, s), string(want), "appendJSONString")
+	}
+
+	// invalid UTF-8 is replaced, like encoding/json
+	var s string
+	err := json.Unmarshal(appendJSONString(nil, "a\xffb"), &s)
+	maybePanic(err)
+	if s != "a\ufffdb" {
+		t.Errorf("bad invalid UTF-8: %q", s)
+	}
+}
+
+func TestAppendJSONError(t *testing.T) {
+	dst := []byte("prefix")
+	got, err := FloatFrom(math.NaN()).AppendJSON(dst)
+	if err == nil {
+		t.Error("expected error for NaN")
+	}
+	assertJSONEquals(t, got, "prefix", "AppendJSON error")
+
+	if _, err := TimeFrom(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)).AppendJSON(nil); err == nil {
+		t.Error("expected error for year out of range")
+	}
+}
+
+func TestAppendJSONAllocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	for _, v := range []jsonAppender{
+		IntFrom(12345), UintFrom(12345), FloatFrom(1.2345), BoolFrom(true), StringFrom("test"),
+		TimeFrom(timeValue1), HexFrom([]byte("hello")), Base64From([]byte("hello")),
+	} {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = v.AppendJSON(buf[:0])
+		})
+		if allocs != 0 {
+			t.Errorf("%T: AppendJSON allocated %v times", v, allocs)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+)
+
+// Args returns a copy of the named parameters m, with null values replaced by nil.
+// Values that are null are those that implement driver.Valuer and return a nil driver value,
+// such as this package's types when they are null, as well as nil pointers.
+// Other values, including valid values of this package's types, are kept as they are.
+//
+// It is intended for query builders that format or inspect parameters themselves instead of leaving them
+// to database/sql, which could otherwise insert a zero value instead of NULL.
+// If a Value method returns an error, the value is kept so that the error is reported when the query runs.
+func Args(m map[string]interface{}) map[string]interface{} {
+	args := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if isNullArg(v) {
+			v = nil
+		}
+		args[k] = v
+	}
+	return args
+}
+
+// StructArgs converts a struct, or a pointer to one, into named parameters like Args.
+// Fields are named by the given struct tag key, such as "db", or else by field name.
+// Fields tagged with "-" and unexported fields are skipped.
+// Fields tagged with omitempty are skipped if they are null or zero, which suits partial updates.
+func StructArgs(v interface{}, key string) (map[string]interface{}, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: StructArgs needs a struct, got %T", v)
+	}
+	args := make(map[string]interface{})
+	for _, f := range structFields(rv.Type(), key) {
+		field := rv.FieldByIndex(f.index).Interface()
+		null := isNullArg(field)
+		if f.omitEmpty && (null || rv.FieldByIndex(f.index).IsZero()) {
+			continue
+		}
+		if null {
+			field = nil
+		}
+		args[f.name] = field
+	}
+	return args, nil
+}
+
+func isNullArg(v interface{}) bool {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return true
+	}
+	if valuer, ok := v.(driver.Valuer); ok {
+		val, err := valuer.Value()
+		return err == nil && val == nil
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArgs(t *testing.T) {
+	ptr := IntFrom(2)
+	in := map[string]interface{}{
+		"int":     IntFrom(1),
+		"null":    Int{},
+		"ptr":     &ptr,
+		"nilptr":  (*Int)(nil),
+		"nilstr":  (*string)(nil),
+		"absent":  Optional[int]{},
+		"present": OptionalNull[int](),
+		"plain":   0,
+		"nil":     nil,
+	}
+	want := map[string]interface{}{
+		"int":     IntFrom(1),
+		"null":    nil,
+		"ptr":     &ptr,
+		"nilptr":  nil,
+		"nilstr":  nil,
+		"absent":  nil,
+		"present": nil,
+		"plain":   0,
+		"nil":     nil,
+	}
+	if got := Args(in); !reflect.DeepEqual(got, want) {
+		t.Errorf("bad args: %#v", got)
+	}
+	if in["null"] == nil {
+		t.Error("Args should not modify its argument")
+	}
+}
+
+func TestStructArgs(t *testing.T) {
+	type update struct {
+		ID       int    `db:"id"`
+		Name     String `db:"name"`
+		Nickname String `db:"nickname,omitempty"`
+		Age      Int    `db:"age,omitempty"`
+		Count    Int
+		Secret   String `db:"-"`
+		private  String
+	}
+	got, err := StructArgs(&update{ID: 1, Age: IntFrom(30), Secret: StringFrom("x")}, "db")
+	maybePanic(err)
+	want := map[string]interface{}{"id": 1, "name": nil, "age": IntFrom(30), "Count": nil}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bad struct args: %#v", got)
+	}
+
+	if _, err := StructArgs(1, "db"); err == nil {
+		t.Error("expected error for non-struct")
+	}
+}
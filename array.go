@@ -0,0 +1,47 @@
+package null
+
+import "database/sql/driver"
+
+// Array is a nullable slice of T that is always sent to the database as a PostgreSQL array literal,
+// regardless of DefaultSliceFormat.
+// Use a nullable element type, such as Array[String] or Array[Uint], for arrays with NULL elements.
+// Otherwise, it behaves like Slice.
+type Array[T any] struct {
+	Slice[T]
+}
+
+// NewArray creates a new Array.
+func NewArray[T any](v []T, valid bool) Array[T] {
+	return Array[T]{
+		Slice: NewSlice(v, valid),
+	}
+}
+
+// ArrayFrom creates a new Array that will always be valid.
+// A nil slice is considered empty, not null.
+func ArrayFrom[T any](v []T) Array[T] {
+	return NewArray(v, true)
+}
+
+// ArrayFromPtr creates a new Array that will be null if v is nil.
+func ArrayFromPtr[T any](v *[]T) Array[T] {
+	if v == nil {
+		return NewArray[T](nil, false)
+	}
+	return NewArray(*v, true)
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as PostgreSQL array literals, such as `{1,NULL,3}`.
+func (a Array[T]) Value() (driver.Value, error) {
+	if !a.Valid {
+		return nil, nil
+	}
+	return formatPostgresArray(a.V)
+}
+
+// Equal returns true if both Arrays have the same elements or are both null.
+// Nil and empty slices are equal. Elements are compared with reflect.DeepEqual.
+func (a Array[T]) Equal(other Array[T]) bool {
+	return a.Slice.Equal(other.Slice)
+}
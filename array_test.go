@@ -0,0 +1,97 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestArrayScan(t *testing.T) {
+	var strs Array[String]
+	err := strs.Scan([]byte(`{a,NULL,"NULL","b c"}`))
+	maybePanic(err)
+	want := ArrayFrom([]String{StringFrom("a"), {}, StringFrom("NULL"), StringFrom("b c")})
+	if !strs.Equal(want) {
+		t.Errorf("bad scanned array: %#v", strs.V)
+	}
+
+	var uints Array[Uint]
+	err = uints.Scan("{18446744073709551615,NULL,0}")
+	maybePanic(err)
+	if !uints.Equal(ArrayFrom([]Uint{UintFrom(math.MaxUint64), {}, UintFrom(0)})) {
+		t.Errorf("bad scanned uint array: %#v", uints.V)
+	}
+
+	var null Array[String]
+	err = null.Scan(nil)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("scanned nil should be null")
+	}
+
+	// JSON arrays are accepted too, like Slice
+	var fromJSON Array[Int]
+	err = fromJSON.Scan(`[1,null]`)
+	maybePanic(err)
+	if !fromJSON.Equal(ArrayFrom([]Int{IntFrom(1), {}})) {
+		t.Errorf("bad scanned JSON array: %#v", fromJSON.V)
+	}
+}
+
+func TestArrayValue(t *testing.T) {
+	for _, test := range []struct {
+		value driver.Valuer
+		want  string
+	}{
+		{ArrayFrom([]String{StringFrom(`a "b"`), {}}), `{"a \"b\"",NULL}`},
+		{ArrayFrom([]Uint{UintFrom(math.MaxUint64), {}}), `{18446744073709551615,NULL}`},
+		{ArrayFrom([]uint64{math.MaxUint64}), `{18446744073709551615}`},
+		{ArrayFrom[int](nil), `{}`},
+	} {
+		v, err := test.value.Value()
+		maybePanic(err)
+		if v != test.want {
+			t.Errorf("bad Value(): %#v ≠ %#v", v, test.want)
+		}
+	}
+
+	// unlike Slice, it ignores DefaultSliceFormat, which is SliceJSON
+	v, err := ArrayFrom([]Int{IntFrom(1)}).Value()
+	maybePanic(err)
+	if v != "{1}" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	v, err = NewArray([]Int{IntFrom(1)}, false).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+
+	var roundtrip Array[Uint]
+	arr := ArrayFrom([]Uint{UintFrom(math.MaxUint64), {}})
+	v, err = arr.Value()
+	maybePanic(err)
+	maybePanic(roundtrip.Scan(v))
+	if !roundtrip.Equal(arr) {
+		t.Errorf("bad round trip: %#v", roundtrip.V)
+	}
+}
+
+func TestArrayJSON(t *testing.T) {
+	data, err := json.Marshal(ArrayFrom([]String{StringFrom("a"), {}}))
+	maybePanic(err)
+	assertJSONEquals(t, data, `["a",null]`, "non-empty json marshal")
+
+	var arr Array[String]
+	err = json.Unmarshal([]byte(`["a",null]`), &arr)
+	maybePanic(err)
+	if !arr.Equal(ArrayFrom([]String{StringFrom("a"), {}})) {
+		t.Errorf("bad unmarshaled array: %#v", arr.V)
+	}
+
+	if ArrayFromPtr[string](nil).Valid || !ArrayFromPtr(&[]string{}).Valid {
+		t.Error("bad ArrayFromPtr")
+	}
+}
@@ -0,0 +1,697 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"time"
+)
+
+// This file implements the UnionConverter interface from github.com/hamba/avro,
+// so that fields of null types can be encoded as Avro unions such as ["null", "long"].
+// The interface only uses built-in types, so no build tag is needed.
+// hamba/avro only uses UnionConverter for pointers, so fields must be declared as pointers:
+//
+//	type Record struct {
+//		Count *null.Int `avro:"count"` // ["null", "long"]
+//	}
+//
+// Values are encoded as the Avro equivalent of what would be sent to an SQL database (see Value),
+// except that integers narrower than 64 bits are encoded as Avro int instead of long.
+// Null values (or nil pointers) are encoded as Avro null.
+
+// toAvro returns a pointer to the driver value of v, as avro.UnionConverter expects.
+func toAvro(v driver.Valuer) (any, error) {
+	val, err := v.Value()
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't marshal Avro: %w", err)
+	}
+	return &val, nil
+}
+
+// fromAvro converts a value decoded from an Avro union into the equivalent driver value and scans it into s.
+func fromAvro(s sql.Scanner, payload any) error {
+	v := payload
+	switch x := payload.(type) {
+	case int:
+		v = int64(x)
+	case int32:
+		v = int64(x)
+	case float32:
+		v = float64(x)
+	case time.Duration:
+		v = int64(x)
+	}
+	if err := s.Scan(v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal Avro: %w", err)
+	}
+	return nil
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Addr is null.
+func (a Addr) ToAny() (any, error) {
+	return toAvro(a)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Addr can be scanned from.
+func (a *Addr) FromAny(payload any) error {
+	return fromAvro(a, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Any is null.
+func (a Any) ToAny() (any, error) {
+	return toAvro(a)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Any can be scanned from.
+func (a *Any) FromAny(payload any) error {
+	return fromAvro(a, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Base64 is null.
+func (b Base64) ToAny() (any, error) {
+	return toAvro(b)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Base64 can be scanned from.
+func (b *Base64) FromAny(payload any) error {
+	return fromAvro(b, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this BigInt is null.
+func (b BigInt) ToAny() (any, error) {
+	return toAvro(b)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this BigInt can be scanned from.
+func (b *BigInt) FromAny(payload any) error {
+	return fromAvro(b, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Bool is null.
+func (b Bool) ToAny() (any, error) {
+	return toAvro(b)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Bool can be scanned from.
+func (b *Bool) FromAny(payload any) error {
+	return fromAvro(b, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this ByteSize is null.
+func (b ByteSize) ToAny() (any, error) {
+	return toAvro(b)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this ByteSize can be scanned from.
+func (b *ByteSize) FromAny(payload any) error {
+	return fromAvro(b, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Bytes is null.
+func (b Bytes) ToAny() (any, error) {
+	return toAvro(b)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Bytes can be scanned from.
+func (b *Bytes) FromAny(payload any) error {
+	return fromAvro(b, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Complex is null.
+func (c Complex) ToAny() (any, error) {
+	return toAvro(c)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Complex can be scanned from.
+func (c *Complex) FromAny(payload any) error {
+	return fromAvro(c, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Date is null.
+func (d Date) ToAny() (any, error) {
+	return toAvro(d)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Date can be scanned from.
+func (d *Date) FromAny(payload any) error {
+	return fromAvro(d, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Duration is null.
+func (d Duration) ToAny() (any, error) {
+	return toAvro(d)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Duration can be scanned from.
+func (d *Duration) FromAny(payload any) error {
+	return fromAvro(d, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Email is null.
+func (e Email) ToAny() (any, error) {
+	return toAvro(e)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Email can be scanned from.
+func (e *Email) FromAny(payload any) error {
+	return fromAvro(e, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Enum is null.
+func (e Enum[T]) ToAny() (any, error) {
+	return toAvro(e)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Enum can be scanned from.
+func (e *Enum[T]) FromAny(payload any) error {
+	return fromAvro(e, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this FileMode is null.
+func (m FileMode) ToAny() (any, error) {
+	return toAvro(m)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this FileMode can be scanned from.
+func (m *FileMode) FromAny(payload any) error {
+	return fromAvro(m, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Flags is null.
+func (f Flags[T]) ToAny() (any, error) {
+	return toAvro(f)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Flags can be scanned from.
+func (f *Flags[T]) FromAny(payload any) error {
+	return fromAvro(f, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Float is null.
+func (f Float) ToAny() (any, error) {
+	return toAvro(f)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Float can be scanned from.
+func (f *Float) FromAny(payload any) error {
+	return fromAvro(f, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this HardwareAddr is null.
+func (h HardwareAddr) ToAny() (any, error) {
+	return toAvro(h)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this HardwareAddr can be scanned from.
+func (h *HardwareAddr) FromAny(payload any) error {
+	return fromAvro(h, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Hex is null.
+func (h Hex) ToAny() (any, error) {
+	return toAvro(h)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Hex can be scanned from.
+func (h *Hex) FromAny(payload any) error {
+	return fromAvro(h, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this FixedHex is null.
+func (h FixedHex[A]) ToAny() (any, error) {
+	return toAvro(h)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this FixedHex can be scanned from.
+func (h *FixedHex[A]) FromAny(payload any) error {
+	return fromAvro(h, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Int is null.
+func (i Int) ToAny() (any, error) {
+	return toAvro(i)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Int can be scanned from.
+func (i *Int) FromAny(payload any) error {
+	return fromAvro(i, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Int16 is null, otherwise an Avro int.
+func (i Int16) ToAny() (any, error) {
+	if !i.Valid {
+		return new(any), nil
+	}
+	v := int32(i.Int16)
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Int16 can be scanned from.
+func (i *Int16) FromAny(payload any) error {
+	return fromAvro(i, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Int32 is null, otherwise an Avro int.
+func (i Int32) ToAny() (any, error) {
+	if !i.Valid {
+		return new(any), nil
+	}
+	v := i.Int32
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Int32 can be scanned from.
+func (i *Int32) FromAny(payload any) error {
+	return fromAvro(i, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Int8 is null, otherwise an Avro int.
+func (i Int8) ToAny() (any, error) {
+	if !i.Valid {
+		return new(any), nil
+	}
+	v := int32(i.Int8)
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Int8 can be scanned from.
+func (i *Int8) FromAny(payload any) error {
+	return fromAvro(i, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this CountryCode is null.
+func (c CountryCode) ToAny() (any, error) {
+	return toAvro(c)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this CountryCode can be scanned from.
+func (c *CountryCode) FromAny(payload any) error {
+	return fromAvro(c, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this CurrencyCode is null.
+func (c CurrencyCode) ToAny() (any, error) {
+	return toAvro(c)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this CurrencyCode can be scanned from.
+func (c *CurrencyCode) FromAny(payload any) error {
+	return fromAvro(c, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this JSON is null.
+func (j JSON) ToAny() (any, error) {
+	return toAvro(j)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this JSON can be scanned from.
+func (j *JSON) FromAny(payload any) error {
+	return fromAvro(j, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this KSUID is null.
+func (k KSUID) ToAny() (any, error) {
+	return toAvro(k)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this KSUID can be scanned from.
+func (k *KSUID) FromAny(payload any) error {
+	return fromAvro(k, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this LanguageTag is null.
+func (l LanguageTag) ToAny() (any, error) {
+	return toAvro(l)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this LanguageTag can be scanned from.
+func (l *LanguageTag) FromAny(payload any) error {
+	return fromAvro(l, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Map is null.
+func (m Map[K, V]) ToAny() (any, error) {
+	return toAvro(m)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Map can be scanned from.
+func (m *Map[K, V]) FromAny(payload any) error {
+	return fromAvro(m, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Money is null.
+func (m Money) ToAny() (any, error) {
+	return toAvro(m)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Money can be scanned from.
+func (m *Money) FromAny(payload any) error {
+	return fromAvro(m, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Optional is null.
+func (o Optional[T]) ToAny() (any, error) {
+	return toAvro(o)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Optional can be scanned from.
+func (o *Optional[T]) FromAny(payload any) error {
+	return fromAvro(o, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Percent is null.
+func (p Percent) ToAny() (any, error) {
+	return toAvro(p)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Percent can be scanned from.
+func (p *Percent) FromAny(payload any) error {
+	return fromAvro(p, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Point is null.
+func (p Point) ToAny() (any, error) {
+	return toAvro(p)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Point can be scanned from.
+func (p *Point) FromAny(payload any) error {
+	return fromAvro(p, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Prefix is null.
+func (p Prefix) ToAny() (any, error) {
+	return toAvro(p)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Prefix can be scanned from.
+func (p *Prefix) FromAny(payload any) error {
+	return fromAvro(p, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Int64Range is null.
+func (r Int64Range) ToAny() (any, error) {
+	return toAvro(r)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Int64Range can be scanned from.
+func (r *Int64Range) FromAny(payload any) error {
+	return fromAvro(r, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this TimeRange is null.
+func (r TimeRange) ToAny() (any, error) {
+	return toAvro(r)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this TimeRange can be scanned from.
+func (r *TimeRange) FromAny(payload any) error {
+	return fromAvro(r, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Regexp is null.
+func (r Regexp) ToAny() (any, error) {
+	return toAvro(r)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Regexp can be scanned from.
+func (r *Regexp) FromAny(payload any) error {
+	return fromAvro(r, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Semver is null.
+func (s Semver) ToAny() (any, error) {
+	return toAvro(s)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Semver can be scanned from.
+func (s *Semver) FromAny(payload any) error {
+	return fromAvro(s, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Slice is null.
+func (s Slice[T]) ToAny() (any, error) {
+	return toAvro(s)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Slice can be scanned from.
+func (s *Slice[T]) FromAny(payload any) error {
+	return fromAvro(s, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this String is null.
+func (s String) ToAny() (any, error) {
+	return toAvro(s)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this String can be scanned from.
+func (s *String) FromAny(payload any) error {
+	return fromAvro(s, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Time is null.
+func (t Time) ToAny() (any, error) {
+	return toAvro(t)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Time can be scanned from.
+func (t *Time) FromAny(payload any) error {
+	return fromAvro(t, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this TimeOfDay is null.
+func (t TimeOfDay) ToAny() (any, error) {
+	return toAvro(t)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this TimeOfDay can be scanned from.
+func (t *TimeOfDay) FromAny(payload any) error {
+	return fromAvro(t, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Uint is null, otherwise an Avro long.
+// It returns an error if the value overflows int64.
+func (i Uint) ToAny() (any, error) {
+	if !i.Valid {
+		return new(any), nil
+	}
+	if i.Uint64 > math.MaxInt64 {
+		return nil, fmt.Errorf("null: couldn't marshal Avro: %d overflows long", i.Uint64)
+	}
+	v := int64(i.Uint64)
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and Avro integers.
+func (i *Uint) FromAny(payload any) error {
+	// Avro has no unsigned types, so only accept non-negative integers.
+	var v int64
+	switch x := payload.(type) {
+	case nil:
+		i.Uint64, i.Valid = 0, false
+		return nil
+	case int64:
+		v = x
+	case int:
+		v = int64(x)
+	case int32:
+		v = int64(x)
+	default:
+		return fmt.Errorf("null: couldn't unmarshal Avro: %T into Uint", payload)
+	}
+	if v < 0 {
+		return fmt.Errorf("null: couldn't unmarshal Avro: %d overflows Uint", v)
+	}
+	i.Uint64, i.Valid = uint64(v), true
+	return nil
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Uint16 is null, otherwise an Avro int.
+func (i Uint16) ToAny() (any, error) {
+	if !i.Valid {
+		return new(any), nil
+	}
+	v := int32(i.Uint16)
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Uint16 can be scanned from.
+func (i *Uint16) FromAny(payload any) error {
+	return fromAvro(i, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Uint32 is null.
+func (i Uint32) ToAny() (any, error) {
+	return toAvro(i)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Uint32 can be scanned from.
+func (i *Uint32) FromAny(payload any) error {
+	return fromAvro(i, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Uint8 is null, otherwise an Avro int.
+func (i Uint8) ToAny() (any, error) {
+	if !i.Valid {
+		return new(any), nil
+	}
+	v := int32(i.Uint8)
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Uint8 can be scanned from.
+func (i *Uint8) FromAny(payload any) error {
+	return fromAvro(i, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this ULID is null.
+func (u ULID) ToAny() (any, error) {
+	return toAvro(u)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this ULID can be scanned from.
+func (u *ULID) FromAny(payload any) error {
+	return fromAvro(u, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this UnixSeconds is null.
+func (u UnixSeconds) ToAny() (any, error) {
+	return toAvro(u)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this UnixSeconds can be scanned from.
+func (u *UnixSeconds) FromAny(payload any) error {
+	return fromAvro(u, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this UnixMillis is null.
+func (u UnixMillis) ToAny() (any, error) {
+	return toAvro(u)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this UnixMillis can be scanned from.
+func (u *UnixMillis) FromAny(payload any) error {
+	return fromAvro(u, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this URL is null.
+func (u URL) ToAny() (any, error) {
+	return toAvro(u)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this URL can be scanned from.
+func (u *URL) FromAny(payload any) error {
+	return fromAvro(u, payload)
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Value is null.
+func (v Value[T]) ToAny() (any, error) {
+	return toAvro(v)
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null and any Avro value this Value can be scanned from.
+func (v *Value[T]) FromAny(payload any) error {
+	return fromAvro(v, payload)
+}
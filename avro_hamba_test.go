@@ -0,0 +1,133 @@
+//go:build avro
+
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hamba/avro/v2"
+)
+
+// avroSchemaOf returns the Avro type for the Go type of a driver value.
+func avroSchemaOf(v any) string {
+	switch v.(type) {
+	case int32:
+		return `"int"`
+	case int64:
+		return `"long"`
+	case float64:
+		return `"double"`
+	case bool:
+		return `"boolean"`
+	case []byte:
+		return `"bytes"`
+	case time.Time:
+		return `{"type": "long", "logicalType": "timestamp-micros"}`
+	default:
+		return `"string"`
+	}
+}
+
+func TestAvroHamba(t *testing.T) {
+	in := exampleAllTypes()
+	rv := reflect.ValueOf(in)
+	for i := 0; i < rv.NumField(); i++ {
+		name := rv.Type().Field(i).Name
+		field := rv.Field(i)
+		val, err := field.Interface().(avroToAny).ToAny()
+		maybePanic(err)
+		schema := avro.MustParse(`["null", ` + avroSchemaOf(reflect.ValueOf(val).Elem().Interface()) + `]`)
+
+		ptr := reflect.New(field.Type())
+		ptr.Elem().Set(field)
+		data, err := avro.Marshal(schema, ptr.Interface())
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		out := reflect.New(ptr.Type())
+		if err := avro.Unmarshal(schema, data, out.Interface()); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if out.Elem().IsNil() {
+			t.Fatalf("%s: decoded as nil", name)
+		}
+
+		want, err := json.Marshal(field.Interface())
+		maybePanic(err)
+		got, err := json.Marshal(out.Elem().Elem().Interface())
+		maybePanic(err)
+		assertJSONEquals(t, got, string(want), name+" hamba/avro round trip")
+
+		// null values and nil pointers are both written as the null branch
+		ptr.Elem().Set(reflect.Zero(field.Type()))
+		for _, v := range []any{ptr.Interface(), reflect.Zero(ptr.Type()).Interface()} {
+			data, err := avro.Marshal(schema, v)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if len(data) != 1 || data[0] != 0 {
+				t.Errorf("%s: null should encode as the null branch: %v", name, data)
+			}
+		}
+	}
+}
+
+func TestAvroHambaRecord(t *testing.T) {
+	schema := avro.MustParse(`{
+		"type": "record",
+		"name": "rec",
+		"fields": [
+			{"name": "count", "type": ["null", "long"]},
+			{"name": "small", "type": ["null", "int"]},
+			{"name": "ratio", "type": ["null", "double"]},
+			{"name": "name", "type": ["null", "string"]},
+			{"name": "missing", "type": ["null", "string"]}
+		]
+	}`)
+	type rec struct {
+		Count   *Int    `avro:"count"`
+		Small   *Int16  `avro:"small"`
+		Ratio   *Float  `avro:"ratio"`
+		Name    *String `avro:"name"`
+		Missing *String `avro:"missing"`
+	}
+	in := rec{
+		Count: ptrTo(IntFrom(12345)),
+		Small: ptrTo(Int16From(-16)),
+		Ratio: ptrTo(FloatFrom(0.5)),
+		Name:  ptrTo(StringFrom("hello")),
+	}
+	data, err := avro.Marshal(schema, in)
+	maybePanic(err)
+
+	// should be the same as encoding the plain values
+	want, err := avro.Marshal(schema, map[string]any{
+		"count":   int64(12345),
+		"small":   int32(-16),
+		"ratio":   0.5,
+		"name":    "hello",
+		"missing": nil,
+	})
+	maybePanic(err)
+	if string(data) != string(want) {
+		t.Errorf("encoded record %v ≠ %v", data, want)
+	}
+
+	var out rec
+	err = avro.Unmarshal(schema, data, &out)
+	maybePanic(err)
+	if out.Count == nil || out.Small == nil || out.Ratio == nil || out.Name == nil || out.Missing != nil {
+		t.Fatalf("bad decoded record: %+v", out)
+	}
+	assertInt(t, *out.Count, "hamba/avro record")
+	if !out.Small.Equal(*in.Small) || !out.Ratio.Equal(*in.Ratio) || !out.Name.Equal(*in.Name) {
+		t.Errorf("bad decoded record: %+v", out)
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,95 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type avroToAny interface {
+	ToAny() (any, error)
+}
+
+type avroFromAny interface {
+	FromAny(payload any) error
+}
+
+func TestAvroRoundTrip(t *testing.T) {
+	for _, in := range []allTypes{exampleAllTypes(), {}} {
+		rv := reflect.ValueOf(in)
+		for i := 0; i < rv.NumField(); i++ {
+			name := rv.Type().Field(i).Name
+			field := rv.Field(i)
+			ptr, err := field.Interface().(avroToAny).ToAny()
+			maybePanic(err)
+			pv := reflect.ValueOf(ptr)
+			if pv.Kind() != reflect.Pointer {
+				t.Fatalf("%s: ToAny should return a pointer, got %T", name, ptr)
+			}
+
+			out := reflect.New(field.Type())
+			err = out.Interface().(avroFromAny).FromAny(pv.Elem().Interface())
+			maybePanic(err)
+
+			want, err := json.Marshal(field.Interface())
+			maybePanic(err)
+			got, err := json.Marshal(out.Elem().Interface())
+			maybePanic(err)
+			assertJSONEquals(t, got, string(want), name+" avro round trip")
+		}
+	}
+}
+
+func TestAvroFromAny(t *testing.T) {
+	var i Int
+	err := i.FromAny(int(12345))
+	maybePanic(err)
+	assertInt(t, i, "FromAny int")
+
+	var f Float
+	err = f.FromAny(float32(1.5))
+	maybePanic(err)
+	if !f.Equal(FloatFrom(1.5)) {
+		t.Errorf("bad Float from float32: %v", f)
+	}
+
+	var d Duration
+	err = d.FromAny(90 * time.Second)
+	maybePanic(err)
+	if !d.Equal(DurationFrom(90 * time.Second)) {
+		t.Errorf("bad Duration from time.Duration: %v", d)
+	}
+
+	err = i.FromAny(nil)
+	maybePanic(err)
+	assertNullInt(t, i, "FromAny nil")
+
+	if err := i.FromAny([]int{1}); err == nil {
+		t.Error("expected error for []int into Int")
+	}
+}
+
+func TestAvroUint(t *testing.T) {
+	var u Uint
+	err := u.FromAny(int64(12345))
+	maybePanic(err)
+	if !u.Equal(UintFrom(12345)) {
+		t.Errorf("bad Uint: %v", u)
+	}
+	if err := u.FromAny(int64(-1)); err == nil {
+		t.Error("expected error for negative Uint")
+	}
+	if err := u.FromAny("1"); err == nil {
+		t.Error("expected error for string into Uint")
+	}
+	err = u.FromAny(nil)
+	maybePanic(err)
+	if u.Valid {
+		t.Error("nil should decode as null Uint")
+	}
+	if _, err := UintFrom(math.MaxUint64).ToAny(); err == nil {
+		t.Error("expected overflow error for MaxUint64")
+	}
+}
@@ -0,0 +1,206 @@
+package null
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// Base64Encoding is a base64 alphabet used by Base64.
+type Base64Encoding int
+
+const (
+	// Base64Std is the standard base64 alphabet from RFC 4648, with padding.
+	Base64Std Base64Encoding = iota
+	// Base64URL is the URL-safe base64 alphabet from RFC 4648, with padding.
+	Base64URL
+)
+
+// DefaultBase64Encoding is the alphabet Base64 uses for JSON and text marshaling and unmarshaling,
+// and for scanning strings. It defaults to Base64Std.
+var DefaultBase64Encoding = Base64Std
+
+func (e Base64Encoding) encoding() *base64.Encoding {
+	if e == Base64URL {
+		return base64.URLEncoding.Strict()
+	}
+	return base64.StdEncoding.Strict()
+}
+
+// Base64 is a nullable []byte that is always represented as padded base64 in JSON and text,
+// using DefaultBase64Encoding. Input with missing or incorrect padding is rejected.
+// Unlike Bytes, its encoding is independent of DefaultBytesEncoding.
+// It does not consider empty values to be null.
+// It will decode to null, not empty, if null.
+type Base64 struct {
+	NullBytes
+}
+
+// NewBase64 creates a new Base64.
+func NewBase64(b []byte, valid bool) Base64 {
+	return Base64{
+		NullBytes: NullBytes{
+			Bytes: b,
+			Valid: valid,
+		},
+	}
+}
+
+// Base64From creates a new Base64 that will always be valid.
+// A nil b will be considered empty, not null.
+func Base64From(b []byte) Base64 {
+	return NewBase64(b, true)
+}
+
+// Base64FromPtr creates a new Base64 that will be null if b is nil.
+func Base64FromPtr(b *[]byte) Base64 {
+	if b == nil {
+		return NewBase64(nil, false)
+	}
+	return NewBase64(*b, true)
+}
+
+// ParseBase64 creates a new Base64 by decoding s with DefaultBase64Encoding.
+func ParseBase64(s string) (Base64, error) {
+	v, err := DefaultBase64Encoding.encoding().DecodeString(s)
+	if err != nil {
+		return Base64{}, fmt.Errorf("null: couldn't decode base64: %w", err)
+	}
+	return Base64From(v), nil
+}
+
+// Scan implements the Scanner interface.
+// Binary ([]byte) values are taken as the raw bytes,
+// while strings from text columns are decoded with DefaultBase64Encoding.
+// Values are sent to the database as raw bytes.
+func (b *Base64) Scan(value interface{}) (err error) {
+	defer validateDecoded(b, &err)
+	value = driverval.Convert(value)
+	str, ok := value.(string)
+	if !ok {
+		return b.NullBytes.Scan(value)
+	}
+	v, err := DefaultBase64Encoding.encoding().DecodeString(str)
+	if err != nil {
+		b.Valid = false
+		return fmt.Errorf("null: couldn't scan Base64: %w", err)
+	}
+	b.Bytes, b.Valid = v, true
+	return nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (b Base64) ValueOrZero() []byte {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports base64 string and null input.
+// An empty string will not be considered null.
+func (b *Base64) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(b, &err)
+	if bytes.Equal(data, nullBytes) {
+		b.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := DefaultBase64Encoding.encoding().DecodeString(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't decode base64: %w", err)
+	}
+
+	b.Bytes = v
+	b.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Base64 is null, otherwise a base64 string.
+func (b Base64) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(DefaultBase64Encoding.encoding().EncodeToString(b.Bytes))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Base64 if the input is DefaultNullText, which is blank by default, otherwise it decodes the input.
+func (b *Base64) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(b, &err)
+	if isNullTextOnly(text) {
+		b.Valid = false
+		return nil
+	}
+	v, err := DefaultBase64Encoding.encoding().DecodeString(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	b.Bytes = v
+	b.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Base64 is null, otherwise the base64 encoding of its value.
+func (b Base64) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return nullText(), nil
+	}
+	return []byte(DefaultBase64Encoding.encoding().EncodeToString(b.Bytes)), nil
+}
+
+// SetValid changes this Base64's value and also sets it to be non-null.
+func (b *Base64) SetValid(v []byte) {
+	b.Bytes = v
+	b.Valid = true
+}
+
+// SetNull sets this Base64 to null, leaving its value as is. Use Reset to clear the value too.
+func (b *Base64) SetNull() {
+	b.Valid = false
+}
+
+// Reset sets this Base64 to null and clears its value.
+func (b *Base64) Reset() {
+	*b = Base64{}
+}
+
+// Ptr returns a pointer to this Base64's value, or a nil pointer if this Base64 is null.
+func (b Base64) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// SetPtr changes this Base64's value to the value v points to, or sets it to be null if v is nil, like Base64FromPtr.
+func (b *Base64) SetPtr(v *[]byte) {
+	*b = Base64FromPtr(v)
+}
+
+// IsZero returns true for invalid Base64s.
+// A non-null Base64 with an empty value will not be considered zero.
+func (b Base64) IsZero() bool {
+	return !b.Valid
+}
+
+// IsZeroOrNull returns true for invalid Base64s, or valid Base64s with an empty value.
+func (b Base64) IsZeroOrNull() bool {
+	return !b.Valid || len(b.Bytes) == 0
+}
+
+// Equal returns true if both Base64s have the same contents or are both null.
+// Nil and empty values are considered equal.
+func (b Base64) Equal(other Base64) bool {
+	return b.Valid == other.Valid && (!b.Valid || bytes.Equal(b.Bytes, other.Bytes))
+}
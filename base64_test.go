@@ -0,0 +1,279 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	base64Value       = []byte{0xfb, 0xff, 0xbf}
+	base64StdJSON     = []byte(`"+/+/"`)
+	base64URLJSON     = []byte(`"-_-_"`)
+	unpaddedBase64    = []byte(`"aGVsbG8"`)
+	badPaddingBase64  = []byte(`"aGVsbG8=="`)
+	paddedBase64Value = []byte(`"aGVsbG8="`)
+)
+
+func TestBase64From(t *testing.T) {
+	b := Base64From(base64Value)
+	assertBase64(t, b, "Base64From()")
+
+	empty := Base64From(nil)
+	if !empty.Valid {
+		t.Error("Base64From(nil)", "is invalid, but should be valid")
+	}
+}
+
+func TestBase64FromPtr(t *testing.T) {
+	v := base64Value
+	b := Base64FromPtr(&v)
+	assertBase64(t, b, "Base64FromPtr()")
+
+	null := Base64FromPtr(nil)
+	assertNullBase64(t, null, "Base64FromPtr(nil)")
+}
+
+func TestUnmarshalBase64(t *testing.T) {
+	var b Base64
+	err := json.Unmarshal(base64StdJSON, &b)
+	maybePanic(err)
+	assertBase64(t, b, "base64 json")
+
+	var padded Base64
+	err = json.Unmarshal(paddedBase64Value, &padded)
+	maybePanic(err)
+	if !padded.Valid || string(padded.Bytes) != "hello" {
+		t.Errorf("bad padded base64 json: %#v", padded)
+	}
+
+	var blank Base64
+	err = json.Unmarshal(blankBytesJSON, &blank)
+	maybePanic(err)
+	if !blank.Valid || len(blank.Bytes) != 0 {
+		t.Errorf("bad blank base64 json: %#v", blank)
+	}
+
+	var null Base64
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBase64(t, null, "null json")
+
+	for _, bad := range [][]byte{unpaddedBase64, badPaddingBase64, base64URLJSON, intJSON, invalidJSON} {
+		var invalid Base64
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullBase64(t, invalid, "invalid json")
+	}
+}
+
+func TestBase64URLEncoding(t *testing.T) {
+	DefaultBase64Encoding = Base64URL
+	defer func() { DefaultBase64Encoding = Base64Std }()
+
+	var b Base64
+	err := json.Unmarshal(base64URLJSON, &b)
+	maybePanic(err)
+	assertBase64(t, b, "url base64 json")
+
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(base64URLJSON), "url base64 json")
+
+	var std Base64
+	if err := json.Unmarshal(base64StdJSON, &std); err == nil {
+		t.Error("expected error unmarshaling standard base64 with URL encoding")
+	}
+}
+
+func TestMarshalBase64(t *testing.T) {
+	b := Base64From(base64Value)
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(base64StdJSON), "non-empty json marshal")
+
+	empty := Base64From(nil)
+	data, err = json.Marshal(empty)
+	maybePanic(err)
+	assertJSONEquals(t, data, `""`, "empty json marshal")
+
+	null := NewBase64(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestBase64Text(t *testing.T) {
+	b := Base64From(base64Value)
+	data, err := b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "+/+/", "text marshal")
+
+	var text Base64
+	err = text.UnmarshalText(data)
+	maybePanic(err)
+	assertBase64(t, text, "text unmarshal")
+
+	null := NewBase64(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var blank Base64
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBase64(t, blank, "blank text unmarshal")
+
+	var bad Base64
+	if err := bad.UnmarshalText([]byte("aGVsbG8")); err == nil {
+		t.Error("expected error unmarshaling unpadded text")
+	}
+}
+
+func TestParseBase64(t *testing.T) {
+	b, err := ParseBase64("+/+/")
+	maybePanic(err)
+	assertBase64(t, b, "ParseBase64()")
+
+	if _, err := ParseBase64("aGVsbG8"); err == nil {
+		t.Error("expected error parsing unpadded base64")
+	}
+}
+
+func TestBase64Scan(t *testing.T) {
+	var text Base64
+	err := text.Scan("+/+/")
+	maybePanic(err)
+	assertBase64(t, text, "scanned text")
+
+	raw := append([]byte(nil), base64Value...)
+	var bin Base64
+	err = bin.Scan(raw)
+	maybePanic(err)
+	assertBase64(t, bin, "scanned binary")
+	raw[0] = 0
+	assertBase64(t, bin, "scanned binary after modifying source")
+
+	var null Base64
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBase64(t, null, "scanned null")
+
+	var bad Base64
+	if err := bad.Scan("aGVsbG8"); err == nil {
+		t.Error("expected error scanning unpadded base64")
+	}
+	assertNullBase64(t, bad, "scanned bad base64")
+}
+
+func TestBase64Value(t *testing.T) {
+	b := Base64From(base64Value)
+	v, err := b.Value()
+	maybePanic(err)
+	if raw, ok := v.([]byte); !ok || !bytes.Equal(raw, base64Value) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	null := NewBase64(nil, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestBase64Pointer(t *testing.T) {
+	b := Base64From(base64Value)
+	ptr := b.Ptr()
+	if !bytes.Equal(*ptr, base64Value) {
+		t.Errorf("bad %s []byte: %#v ≠ %v\n", "pointer", ptr, base64Value)
+	}
+
+	null := NewBase64(nil, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s []byte: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestBase64IsZero(t *testing.T) {
+	b := Base64From(base64Value)
+	if b.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewBase64(nil, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	empty := Base64From(nil)
+	if empty.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestBase64SetValid(t *testing.T) {
+	change := NewBase64(nil, false)
+	assertNullBase64(t, change, "SetValid()")
+	change.SetValid(base64Value)
+	assertBase64(t, change, "SetValid()")
+}
+
+func TestBase64Equal(t *testing.T) {
+	b1 := NewBase64(nil, false)
+	b2 := NewBase64(nil, false)
+	assertBase64EqualIsTrue(t, b1, b2)
+
+	b1 = Base64From(nil)
+	b2 = Base64From([]byte{})
+	assertBase64EqualIsTrue(t, b1, b2)
+
+	b1 = Base64From(base64Value)
+	b2 = Base64From(base64Value)
+	assertBase64EqualIsTrue(t, b1, b2)
+
+	b1 = NewBase64(base64Value, false)
+	b2 = NewBase64([]byte("foo"), false)
+	assertBase64EqualIsTrue(t, b1, b2)
+
+	b1 = Base64From(base64Value)
+	b2 = NewBase64(base64Value, false)
+	assertBase64EqualIsFalse(t, b1, b2)
+
+	b1 = Base64From(base64Value)
+	b2 = Base64From([]byte("foo"))
+	assertBase64EqualIsFalse(t, b1, b2)
+}
+
+func assertBase64(t *testing.T, b Base64, from string) {
+	t.Helper()
+	if !bytes.Equal(b.Bytes, base64Value) {
+		t.Errorf("bad %s bytes: %#v ≠ %#v\n", from, b.Bytes, base64Value)
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullBase64(t *testing.T, b Base64, from string) {
+	t.Helper()
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertBase64EqualIsTrue(t *testing.T, a, b Base64) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Base64{%q, Valid:%t} and Base64{%q, Valid:%t} should return true", a.Bytes, a.Valid, b.Bytes, b.Valid)
+	}
+}
+
+func assertBase64EqualIsFalse(t *testing.T, a, b Base64) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Base64{%q, Valid:%t} and Base64{%q, Valid:%t} should return false", a.Bytes, a.Valid, b.Bytes, b.Valid)
+	}
+}
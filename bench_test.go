@@ -43,3 +43,33 @@ func BenchmarkNullStringUnmarshalJSON(b *testing.B) {
 		nullable.UnmarshalJSON(input)
 	}
 }
+
+func BenchmarkIntMarshalJSON(b *testing.B) {
+	nullable := IntFrom(123456)
+	for n := 0; n < b.N; n++ {
+		nullable.MarshalJSON()
+	}
+}
+
+func BenchmarkIntAppendJSON(b *testing.B) {
+	nullable := IntFrom(123456)
+	buf := make([]byte, 0, 64)
+	for n := 0; n < b.N; n++ {
+		nullable.AppendJSON(buf[:0])
+	}
+}
+
+func BenchmarkStringMarshalJSON(b *testing.B) {
+	nullable := StringFrom("hello")
+	for n := 0; n < b.N; n++ {
+		nullable.MarshalJSON()
+	}
+}
+
+func BenchmarkStringAppendJSON(b *testing.B) {
+	nullable := StringFrom("hello")
+	buf := make([]byte, 0, 64)
+	for n := 0; n < b.N; n++ {
+		nullable.AppendJSON(buf[:0])
+	}
+}
@@ -0,0 +1,228 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullBigInt represents a *big.Int that may be null.
+// NullBigInt implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullBigInt struct {
+	BigInt *big.Int
+	Valid  bool // Valid is true if BigInt is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports integers, floats without a fractional part, and decimal strings or bytes.
+func (n *NullBigInt) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var i *big.Int
+	switch v := value.(type) {
+	case nil:
+		n.BigInt, n.Valid = nil, false
+		return nil
+	case int64:
+		i = big.NewInt(v)
+	case uint64:
+		i = new(big.Int).SetUint64(v)
+	case float64:
+		if math.IsInf(v, 0) || v != math.Trunc(v) {
+			return fmt.Errorf("null: couldn't scan BigInt: not an integer: %v", v)
+		}
+		i, _ = big.NewFloat(v).Int(nil)
+	case []byte:
+		i = parseBigInt(string(v))
+	case string:
+		i = parseBigInt(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into BigInt: %v", value, value)
+	}
+	if i == nil {
+		return fmt.Errorf("null: couldn't scan BigInt: invalid integer %q", value)
+	}
+	n.BigInt, n.Valid = i, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as decimal strings, as they may not fit in an int64.
+func (n NullBigInt) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.BigInt.String(), nil
+}
+
+// IsZero returns true for invalid NullBigInts.
+func (n NullBigInt) IsZero() bool {
+	return !n.Valid
+}
+
+// BigInt is a nullable *big.Int.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Copies of a BigInt share the same underlying *big.Int.
+type BigInt struct {
+	NullBigInt
+}
+
+// NewBigInt creates a new BigInt.
+func NewBigInt(i *big.Int, valid bool) BigInt {
+	return BigInt{
+		NullBigInt: NullBigInt{
+			BigInt: i,
+			Valid:  valid && i != nil,
+		},
+	}
+}
+
+// BigIntFrom creates a new BigInt that will be null if i is nil.
+func BigIntFrom(i *big.Int) BigInt {
+	return NewBigInt(i, i != nil)
+}
+
+// BigIntFromInt64 creates a new BigInt that will always be valid.
+func BigIntFromInt64(i int64) BigInt {
+	return NewBigInt(big.NewInt(i), true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise a new zero *big.Int.
+func (b BigInt) ValueOrZero() *big.Int {
+	if !b.Valid {
+		return new(big.Int)
+	}
+	return b.BigInt
+}
+
+// SetString sets this BigInt to the value of s, interpreted in the given base,
+// and sets it to be non-null. See big.Int's SetString for details on base.
+// It returns false and leaves this BigInt unchanged if s is not a valid integer.
+func (b *BigInt) SetString(s string, base int) bool {
+	i, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return false
+	}
+	b.SetValid(i)
+	return true
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null BigInt.
+func (b *BigInt) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(b, &err)
+	if bytes.Equal(data, nullBytes) {
+		b.Valid = false
+		return nil
+	}
+
+	// json.Number accepts both numbers and numeric strings
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	i := parseBigInt(num.String())
+	if i == nil {
+		return errors.New("null: couldn't convert number to BigInt: " + num.String() + " is not an integer")
+	}
+	b.BigInt = i
+	b.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null BigInt if the input is blank or DefaultNullText.
+// It will return an error if the input is not an integer, blank, or "null".
+func (b *BigInt) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(b, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		b.Valid = false
+		return nil
+	}
+	i := parseBigInt(str)
+	if i == nil {
+		return errors.New("null: couldn't unmarshal text: invalid integer " + str)
+	}
+	b.BigInt = i
+	b.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this BigInt is null, otherwise a decimal string
+// so that JSON decoders don't lose precision.
+func (b BigInt) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.BigInt.String())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this BigInt is null.
+func (b BigInt) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return nullText(), nil
+	}
+	return []byte(b.BigInt.String()), nil
+}
+
+// SetValid changes this BigInt's value and also sets it to be non-null.
+// A nil i will set this BigInt to null.
+func (b *BigInt) SetValid(i *big.Int) {
+	b.BigInt = i
+	b.Valid = i != nil
+}
+
+// SetNull sets this BigInt to null, leaving its value as is. Use Reset to clear the value too.
+func (b *BigInt) SetNull() {
+	b.Valid = false
+}
+
+// Reset sets this BigInt to null and clears its value.
+func (b *BigInt) Reset() {
+	*b = BigInt{}
+}
+
+// Ptr returns this BigInt's value, or a nil pointer if this BigInt is null.
+func (b BigInt) Ptr() *big.Int {
+	if !b.Valid {
+		return nil
+	}
+	return b.BigInt
+}
+
+// IsZero returns true for invalid BigInts.
+// A non-null BigInt with a 0 value will not be considered zero.
+func (b BigInt) IsZero() bool {
+	return !b.Valid
+}
+
+// IsZeroOrNull returns true for invalid BigInts, or valid BigInts with a 0 value.
+func (b BigInt) IsZeroOrNull() bool {
+	return !b.Valid || b.BigInt == nil || b.BigInt.Sign() == 0
+}
+
+// Equal returns true if both BigInts have the same value or are both null.
+func (b BigInt) Equal(other BigInt) bool {
+	return b.Valid == other.Valid && (!b.Valid || b.BigInt.Cmp(other.BigInt) == 0)
+}
+
+// parseBigInt parses a base 10 integer, returning nil if str is invalid.
+func parseBigInt(str string) *big.Int {
+	i, ok := new(big.Int).SetString(strings.TrimSpace(str), 10)
+	if !ok {
+		return nil
+	}
+	return i
+}
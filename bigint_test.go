@@ -0,0 +1,296 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+var (
+	bigIntString     = "123456789012345678901234567890"
+	bigIntJSON       = []byte(bigIntString)
+	bigIntStringJSON = []byte(`"` + bigIntString + `"`)
+	bigIntValue, _   = new(big.Int).SetString(bigIntString, 10)
+)
+
+func TestBigIntFrom(t *testing.T) {
+	b := BigIntFrom(bigIntValue)
+	assertBigInt(t, b, "BigIntFrom()")
+
+	zero := BigIntFrom(new(big.Int))
+	if !zero.Valid {
+		t.Error("BigIntFrom(0)", "is invalid, but should be valid")
+	}
+
+	null := BigIntFrom(nil)
+	assertNullBigInt(t, null, "BigIntFrom(nil)")
+}
+
+func TestBigIntFromInt64(t *testing.T) {
+	b := BigIntFromInt64(12345)
+	if !b.Valid || b.BigInt.Int64() != 12345 {
+		t.Errorf("bad BigIntFromInt64(): %v", b.BigInt)
+	}
+}
+
+func TestBigIntSetString(t *testing.T) {
+	var b BigInt
+	if !b.SetString(bigIntString, 10) {
+		t.Error("SetString() should succeed")
+	}
+	assertBigInt(t, b, "SetString()")
+
+	var hex BigInt
+	if !hex.SetString("ff", 16) || hex.BigInt.Int64() != 255 {
+		t.Errorf("bad SetString() hex: %v", hex.BigInt)
+	}
+
+	var bad BigInt
+	if bad.SetString("hello", 10) {
+		t.Error("SetString() should fail")
+	}
+	assertNullBigInt(t, bad, "SetString() invalid")
+}
+
+func TestUnmarshalBigInt(t *testing.T) {
+	var b BigInt
+	err := json.Unmarshal(bigIntJSON, &b)
+	maybePanic(err)
+	assertBigInt(t, b, "bigint json")
+
+	var sb BigInt
+	err = json.Unmarshal(bigIntStringJSON, &sb)
+	maybePanic(err)
+	assertBigInt(t, sb, "bigint string json")
+
+	var null BigInt
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBigInt(t, null, "null json")
+
+	var float BigInt
+	err = json.Unmarshal(floatJSON, &float)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullBigInt(t, float, "float json")
+
+	var badType BigInt
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullBigInt(t, badType, "wrong type json")
+
+	var invalid BigInt
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullBigInt(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalBigInt(t *testing.T) {
+	var b BigInt
+	err := b.UnmarshalText(bigIntJSON)
+	maybePanic(err)
+	assertBigInt(t, b, "UnmarshalText() bigint")
+
+	var blank BigInt
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBigInt(t, blank, "UnmarshalText() empty bigint")
+
+	var null BigInt
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullBigInt(t, null, `UnmarshalText() "null"`)
+
+	var invalid BigInt
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalBigInt(t *testing.T) {
+	b := BigIntFrom(bigIntValue)
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(bigIntStringJSON), "non-empty json marshal")
+
+	null := NewBigInt(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalBigIntText(t *testing.T) {
+	b := BigIntFrom(bigIntValue)
+	data, err := b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, bigIntString, "non-empty text marshal")
+
+	null := NewBigInt(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestBigIntPointer(t *testing.T) {
+	b := BigIntFrom(bigIntValue)
+	if ptr := b.Ptr(); ptr.Cmp(bigIntValue) != 0 {
+		t.Errorf("bad %s bigint: %v ≠ %v\n", "pointer", ptr, bigIntValue)
+	}
+
+	null := NewBigInt(bigIntValue, false)
+	if ptr := null.Ptr(); ptr != nil {
+		t.Errorf("bad %s bigint: %v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestBigIntIsZero(t *testing.T) {
+	b := BigIntFrom(bigIntValue)
+	if b.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewBigInt(nil, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewBigInt(new(big.Int), true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestBigIntSetValid(t *testing.T) {
+	change := NewBigInt(nil, false)
+	assertNullBigInt(t, change, "SetValid()")
+	change.SetValid(bigIntValue)
+	assertBigInt(t, change, "SetValid()")
+}
+
+func TestBigIntValueOrZero(t *testing.T) {
+	valid := BigIntFrom(bigIntValue)
+	if valid.ValueOrZero().Cmp(bigIntValue) != 0 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewBigInt(bigIntValue, false)
+	if invalid.ValueOrZero().Sign() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestBigIntScan(t *testing.T) {
+	var b BigInt
+	err := b.Scan([]byte(bigIntString))
+	maybePanic(err)
+	assertBigInt(t, b, "scanned bytes")
+
+	var s BigInt
+	err = s.Scan(bigIntString)
+	maybePanic(err)
+	assertBigInt(t, s, "scanned string")
+
+	var i BigInt
+	err = i.Scan(int64(-12345))
+	maybePanic(err)
+	if !i.Valid || i.BigInt.Int64() != -12345 {
+		t.Errorf("bad scanned int64: %v", i.BigInt)
+	}
+
+	var u BigInt
+	err = u.Scan(uint64(18446744073709551615))
+	maybePanic(err)
+	if !u.Valid || u.BigInt.String() != "18446744073709551615" {
+		t.Errorf("bad scanned uint64: %v", u.BigInt)
+	}
+
+	var null BigInt
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBigInt(t, null, "scanned null")
+
+	var bad BigInt
+	err = bad.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var wrong BigInt
+	err = wrong.Scan(1.5)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestBigIntValue(t *testing.T) {
+	b := BigIntFrom(bigIntValue)
+	v, err := b.Value()
+	maybePanic(err)
+	if v != bigIntString {
+		t.Errorf("bad Value(): %#v ≠ %s", v, bigIntString)
+	}
+
+	null := NewBigInt(nil, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestBigIntEqual(t *testing.T) {
+	b1 := NewBigInt(big.NewInt(10), false)
+	b2 := NewBigInt(big.NewInt(20), false)
+	assertBigIntEqualIsTrue(t, b1, b2)
+
+	b1 = BigIntFrom(big.NewInt(10))
+	b2 = BigIntFrom(big.NewInt(10))
+	assertBigIntEqualIsTrue(t, b1, b2)
+
+	b1 = BigIntFrom(big.NewInt(10))
+	b2 = NewBigInt(big.NewInt(10), false)
+	assertBigIntEqualIsFalse(t, b1, b2)
+
+	b1 = BigIntFrom(big.NewInt(10))
+	b2 = BigIntFrom(big.NewInt(20))
+	assertBigIntEqualIsFalse(t, b1, b2)
+}
+
+func assertBigInt(t *testing.T, b BigInt, from string) {
+	t.Helper()
+	if b.BigInt == nil || b.BigInt.Cmp(bigIntValue) != 0 {
+		t.Errorf("bad %s bigint: %v ≠ %v\n", from, b.BigInt, bigIntValue)
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullBigInt(t *testing.T, b BigInt, from string) {
+	t.Helper()
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertBigIntEqualIsTrue(t *testing.T, a, b BigInt) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of BigInt{%v, Valid:%t} and BigInt{%v, Valid:%t} should return true", a.BigInt, a.Valid, b.BigInt, b.Valid)
+	}
+}
+
+func assertBigIntEqualIsFalse(t *testing.T, a, b BigInt) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of BigInt{%v, Valid:%t} and BigInt{%v, Valid:%t} should return false", a.BigInt, a.Valid, b.BigInt, b.Valid)
+	}
+}
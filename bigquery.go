@@ -0,0 +1,249 @@
+//go:build bigquery
+
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+)
+
+// This file converts between this package's types and the BigQuery client's NullInt64, NullDate, and so on,
+// and provides BigQueryStruct for saving and loading structs with null fields.
+// It is only built with the bigquery build tag, so that the null package stays free of dependencies:
+//
+//	go build -tags bigquery
+
+// BigQueryStruct adapts a pointer to a struct whose fields are this package's types
+// (or any other sql.Scanner and driver.Valuer types, such as the zero package's)
+// into a bigquery.ValueSaver for Inserter.Put and a bigquery.ValueLoader for RowIterator.Next.
+// The BigQuery client would otherwise treat such fields as nested records.
+//
+// Fields are matched to columns by their bigquery struct tag, or else by name, ignoring case.
+// Fields tagged with "-" and unexported fields are skipped.
+// Null values are saved as NULL, and NULL loads as null.
+//
+//	var row Row
+//	err := it.Next(null.BigQueryStruct{Struct: &row})
+type BigQueryStruct struct {
+	// Struct is a pointer to the struct to save or load.
+	Struct interface{}
+	// InsertID is returned by Save, see bigquery.ValueSaver.
+	InsertID string
+}
+
+// Save implements bigquery.ValueSaver.
+// Values are saved as what would be sent to an SQL database (see Value),
+// except that Time, Date, TimeOfDay, and JSON are saved as their BigQuery equivalents.
+func (s BigQueryStruct) Save() (map[string]bigquery.Value, string, error) {
+	rv := reflect.Indirect(reflect.ValueOf(s.Struct))
+	if rv.Kind() != reflect.Struct {
+		return nil, "", fmt.Errorf("null: BigQueryStruct needs a struct, got %T", s.Struct)
+	}
+	row := make(map[string]bigquery.Value)
+	for _, f := range structFields(rv.Type(), "bigquery") {
+		v, err := bigQueryValue(rv.FieldByIndex(f.index).Interface())
+		if err != nil {
+			return nil, "", fmt.Errorf("null: couldn't save BigQuery field %s: %w", f.name, err)
+		}
+		row[f.name] = v
+	}
+	return row, s.InsertID, nil
+}
+
+// Load implements bigquery.ValueLoader.
+// Fields that implement sql.Scanner scan the equivalent driver value,
+// and other fields are set to the value if it is convertible to the field's type.
+// Columns without a matching field are ignored.
+func (s BigQueryStruct) Load(values []bigquery.Value, schema bigquery.Schema) error {
+	rv := reflect.ValueOf(s.Struct)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("null: BigQueryStruct needs a pointer to a struct, got %T", s.Struct)
+	}
+	rv = rv.Elem()
+	fields := structFields(rv.Type(), "bigquery")
+	for i, col := range schema {
+		if i >= len(values) {
+			break
+		}
+		f, ok := matchBigQueryField(fields, col.Name)
+		if !ok {
+			continue
+		}
+		if err := loadBigQueryValue(rv.FieldByIndex(f.index), values[i]); err != nil {
+			return fmt.Errorf("null: couldn't load BigQuery column %s: %w", col.Name, err)
+		}
+	}
+	return nil
+}
+
+// matchBigQueryField finds the field for a column, preferring exact tag matches.
+func matchBigQueryField(fields []structField, column string) (structField, bool) {
+	for _, f := range fields {
+		if f.tagged && f.name == column {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, column) {
+			return f, true
+		}
+	}
+	return structField{}, false
+}
+
+// bigQueryValue converts a field value into a value for bigquery.ValueSaver.
+func bigQueryValue(v interface{}) (bigquery.Value, error) {
+	switch x := v.(type) {
+	case Time:
+		return x.ToBigQuery(), nil
+	case Date:
+		return x.ToBigQuery(), nil
+	case TimeOfDay:
+		return x.ToBigQuery(), nil
+	case JSON:
+		return x.ToBigQuery(), nil
+	case driver.Valuer:
+		return x.Value()
+	}
+	return v, nil
+}
+
+// bigQueryDriverValue converts a value loaded from BigQuery into the equivalent driver value.
+func bigQueryDriverValue(v bigquery.Value) (interface{}, error) {
+	switch x := v.(type) {
+	case civil.Date:
+		return x.In(time.UTC), nil
+	case civil.Time:
+		return x.String(), nil
+	case civil.DateTime:
+		return x.In(time.UTC), nil
+	case *big.Rat:
+		if x == nil {
+			return nil, nil
+		}
+		if x.IsInt() {
+			return x.Num().String(), nil
+		}
+		return strings.TrimRight(x.FloatString(bigquery.BigNumericScaleDigits), "0"), nil
+	case []bigquery.Value:
+		// repeated fields, for Slice and Array
+		data, err := json.Marshal(x)
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+	return v, nil
+}
+
+func loadBigQueryValue(field reflect.Value, v bigquery.Value) error {
+	if s, ok := field.Addr().Interface().(sql.Scanner); ok {
+		dv, err := bigQueryDriverValue(v)
+		if err != nil {
+			return err
+		}
+		return s.Scan(dv)
+	}
+	return setField(field, v)
+}
+
+// BoolFromBigQuery creates a new Bool from a bigquery.NullBool.
+func BoolFromBigQuery(n bigquery.NullBool) Bool {
+	return NewBool(n.Bool, n.Valid)
+}
+
+// ToBigQuery returns this Bool as a bigquery.NullBool.
+func (b Bool) ToBigQuery() bigquery.NullBool {
+	return bigquery.NullBool{Bool: b.Bool, Valid: b.Valid}
+}
+
+// DateFromBigQuery creates a new Date from a bigquery.NullDate.
+func DateFromBigQuery(n bigquery.NullDate) Date {
+	return NewDate(n.Date.Year, n.Date.Month, n.Date.Day, n.Valid)
+}
+
+// ToBigQuery returns this Date as a bigquery.NullDate.
+func (d Date) ToBigQuery() bigquery.NullDate {
+	if !d.Valid {
+		return bigquery.NullDate{}
+	}
+	return bigquery.NullDate{Date: civil.Date{Year: d.Year, Month: d.Month, Day: d.Day}, Valid: true}
+}
+
+// FloatFromBigQuery creates a new Float from a bigquery.NullFloat64.
+func FloatFromBigQuery(n bigquery.NullFloat64) Float {
+	return NewFloat(n.Float64, n.Valid)
+}
+
+// ToBigQuery returns this Float as a bigquery.NullFloat64.
+func (f Float) ToBigQuery() bigquery.NullFloat64 {
+	return bigquery.NullFloat64{Float64: f.Float64, Valid: f.Valid}
+}
+
+// IntFromBigQuery creates a new Int from a bigquery.NullInt64.
+func IntFromBigQuery(n bigquery.NullInt64) Int {
+	return NewInt(n.Int64, n.Valid)
+}
+
+// ToBigQuery returns this Int as a bigquery.NullInt64.
+func (i Int) ToBigQuery() bigquery.NullInt64 {
+	return bigquery.NullInt64{Int64: i.Int64, Valid: i.Valid}
+}
+
+// JSONFromBigQuery creates a new JSON from a bigquery.NullJSON.
+func JSONFromBigQuery(n bigquery.NullJSON) JSON {
+	if !n.Valid {
+		return JSON{}
+	}
+	return JSONFrom(json.RawMessage(n.JSONVal))
+}
+
+// ToBigQuery returns this JSON as a bigquery.NullJSON.
+func (j JSON) ToBigQuery() bigquery.NullJSON {
+	return bigquery.NullJSON{JSONVal: string(j.JSON), Valid: j.Valid}
+}
+
+// StringFromBigQuery creates a new String from a bigquery.NullString.
+func StringFromBigQuery(n bigquery.NullString) String {
+	return NewString(n.StringVal, n.Valid)
+}
+
+// ToBigQuery returns this String as a bigquery.NullString.
+func (s String) ToBigQuery() bigquery.NullString {
+	return bigquery.NullString{StringVal: s.String, Valid: s.Valid}
+}
+
+// TimeFromBigQuery creates a new Time from a bigquery.NullTimestamp.
+func TimeFromBigQuery(n bigquery.NullTimestamp) Time {
+	return NewTime(n.Timestamp, n.Valid)
+}
+
+// ToBigQuery returns this Time as a bigquery.NullTimestamp.
+func (t Time) ToBigQuery() bigquery.NullTimestamp {
+	return bigquery.NullTimestamp{Timestamp: t.Time, Valid: t.Valid}
+}
+
+// TimeOfDayFromBigQuery creates a new TimeOfDay from a bigquery.NullTime.
+func TimeOfDayFromBigQuery(n bigquery.NullTime) TimeOfDay {
+	return NewTimeOfDay(n.Time.Hour, n.Time.Minute, n.Time.Second, n.Time.Nanosecond, n.Valid)
+}
+
+// ToBigQuery returns this TimeOfDay as a bigquery.NullTime.
+func (t TimeOfDay) ToBigQuery() bigquery.NullTime {
+	if !t.Valid {
+		return bigquery.NullTime{}
+	}
+	return bigquery.NullTime{
+		Time:  civil.Time{Hour: t.Hour, Minute: t.Minute, Second: t.Second, Nanosecond: t.Nanosecond},
+		Valid: true,
+	}
+}
@@ -0,0 +1,149 @@
+//go:build bigquery
+
+package null
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+)
+
+type bigQueryRow struct {
+	ID      int
+	Name    String `bigquery:"full_name"`
+	Count   Int
+	Born    Date
+	Wake    TimeOfDay
+	Seen    Time
+	Tags    Slice[string]
+	Data    JSON
+	Balance BigInt
+	Skip    String `bigquery:"-"`
+}
+
+func TestBigQuerySave(t *testing.T) {
+	seen := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	row := bigQueryRow{
+		ID:   1,
+		Name: StringFrom("Gopher"),
+		Born: NewDate(2009, 11, 10, true),
+		Wake: NewTimeOfDay(7, 30, 0, 0, true),
+		Seen: TimeFrom(seen),
+		Data: JSONFrom([]byte(`{"a":1}`)),
+		Skip: StringFrom("skipped"),
+	}
+	m, id, err := BigQueryStruct{Struct: &row, InsertID: "x"}.Save()
+	maybePanic(err)
+	if id != "x" {
+		t.Errorf("bad insert ID: %q", id)
+	}
+	// the inserter sends rows as JSON
+	data, err := json.Marshal(m)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Balance":null,"Born":"2009-11-10","Count":null,"Data":"{\"a\":1}","ID":1,`+
+		`"Seen":"2024-05-06T07:08:09Z","Tags":null,"Wake":"07:30:00","full_name":"Gopher"}`, "BigQuery save")
+
+	if _, _, err := (BigQueryStruct{Struct: 1}).Save(); err == nil {
+		t.Error("expected error for non-struct")
+	}
+}
+
+func TestBigQueryLoad(t *testing.T) {
+	schema := bigquery.Schema{
+		{Name: "id"},
+		{Name: "full_name"},
+		{Name: "count"},
+		{Name: "born"},
+		{Name: "wake"},
+		{Name: "seen"},
+		{Name: "tags"},
+		{Name: "data"},
+		{Name: "balance"},
+		{Name: "skip"},
+		{Name: "unknown"},
+	}
+	seen := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	values := []bigquery.Value{
+		int64(1),
+		"Gopher",
+		nil,
+		civil.Date{Year: 2009, Month: 11, Day: 10},
+		civil.Time{Hour: 7, Minute: 30, Nanosecond: 500},
+		seen,
+		[]bigquery.Value{"a", "b"},
+		`{"a":1}`,
+		big.NewRat(12345, 1),
+		"skipped",
+		"ignored",
+	}
+	row := bigQueryRow{Count: IntFrom(5)}
+	err := BigQueryStruct{Struct: &row}.Load(values, schema)
+	maybePanic(err)
+
+	want := bigQueryRow{
+		ID:      1,
+		Name:    StringFrom("Gopher"),
+		Born:    NewDate(2009, 11, 10, true),
+		Wake:    NewTimeOfDay(7, 30, 0, 500, true),
+		Seen:    TimeFrom(seen),
+		Tags:    SliceFrom([]string{"a", "b"}),
+		Data:    JSONFrom([]byte(`{"a":1}`)),
+		Balance: BigIntFrom(big.NewInt(12345)),
+	}
+	gotJSON, err := json.Marshal(row)
+	maybePanic(err)
+	wantJSON, err := json.Marshal(want)
+	maybePanic(err)
+	assertJSONEquals(t, gotJSON, string(wantJSON), "BigQuery load")
+
+	if err := (BigQueryStruct{Struct: row}).Load(values, schema); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+	bad := []bigquery.Value{"one"}
+	if err := (BigQueryStruct{Struct: &row}).Load(bad, schema[:1]); err == nil {
+		t.Error("expected error for string into int")
+	}
+}
+
+func TestBigQueryConversions(t *testing.T) {
+	if got := IntFromBigQuery(bigquery.NullInt64{Int64: 5, Valid: true}); !got.Equal(IntFrom(5)) {
+		t.Errorf("bad IntFromBigQuery: %v", got)
+	}
+	if got := IntFrom(5).ToBigQuery(); got != (bigquery.NullInt64{Int64: 5, Valid: true}) {
+		t.Errorf("bad Int.ToBigQuery: %v", got)
+	}
+	if got := StringFromBigQuery(bigquery.NullString{}); got.Valid {
+		t.Errorf("bad StringFromBigQuery: %v", got)
+	}
+	if got := FloatFrom(1.5).ToBigQuery(); got != (bigquery.NullFloat64{Float64: 1.5, Valid: true}) {
+		t.Errorf("bad Float.ToBigQuery: %v", got)
+	}
+	if got := BoolFromBigQuery(bigquery.NullBool{Bool: true, Valid: true}); !got.Equal(BoolFrom(true)) {
+		t.Errorf("bad BoolFromBigQuery: %v", got)
+	}
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	if got := TimeFromBigQuery(bigquery.NullTimestamp{Timestamp: now, Valid: true}); !got.Equal(TimeFrom(now)) {
+		t.Errorf("bad TimeFromBigQuery: %v", got)
+	}
+	date := civil.Date{Year: 2024, Month: 5, Day: 6}
+	if got := DateFromBigQuery(bigquery.NullDate{Date: date, Valid: true}); !got.Equal(NewDate(2024, 5, 6, true)) {
+		t.Errorf("bad DateFromBigQuery: %v", got)
+	}
+	if got := (Date{}).ToBigQuery(); got.Valid {
+		t.Errorf("bad null Date.ToBigQuery: %v", got)
+	}
+	clock := civil.Time{Hour: 7, Minute: 8, Second: 9}
+	if got := NewTimeOfDay(7, 8, 9, 0, true).ToBigQuery(); got != (bigquery.NullTime{Time: clock, Valid: true}) {
+		t.Errorf("bad TimeOfDay.ToBigQuery: %v", got)
+	}
+	if got := TimeOfDayFromBigQuery(bigquery.NullTime{Time: clock, Valid: true}); !got.Equal(NewTimeOfDay(7, 8, 9, 0, true)) {
+		t.Errorf("bad TimeOfDayFromBigQuery: %v", got)
+	}
+	if got := JSONFromBigQuery(bigquery.NullJSON{JSONVal: `[1]`, Valid: true}); !got.Equal(JSONFrom([]byte(`[1]`))) {
+		t.Errorf("bad JSONFromBigQuery: %v", got)
+	}
+}
@@ -0,0 +1,1127 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// MarshalBinary and GobEncode use a stable binary encoding, suitable for key-value stores.
+// It starts with a byte holding the kind of the value that would be sent to an SQL database (see Value),
+// followed by the value itself:
+//
+//	0  null, with nothing following
+//	1  int64, as a varint
+//	2  float64, as 8 big-endian IEEE 754 bytes
+//	3  bool, as 0 or 1
+//	4  []byte, as is
+//	5  string, as is
+//	6  time.Time, as encoded by its MarshalBinary
+//	7  uint64, as a uvarint
+//
+// Decoding scans the value back.
+const (
+	binaryNull byte = iota
+	binaryInt64
+	binaryFloat64
+	binaryBool
+	binaryBytes
+	binaryString
+	binaryTime
+	binaryUint64
+)
+
+// encodeBinary encodes the driver value of v.
+func encodeBinary(v driver.Valuer) ([]byte, error) {
+	val, err := v.Value()
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't encode binary: %w", err)
+	}
+	if u, ok := val.(uint64); ok {
+		return binary.AppendUvarint([]byte{binaryUint64}, u), nil
+	}
+	val, err = driver.DefaultParameterConverter.ConvertValue(val)
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't encode binary: %w", err)
+	}
+	switch x := val.(type) {
+	case nil:
+		return []byte{binaryNull}, nil
+	case int64:
+		return binary.AppendVarint([]byte{binaryInt64}, x), nil
+	case float64:
+		return binary.BigEndian.AppendUint64([]byte{binaryFloat64}, math.Float64bits(x)), nil
+	case bool:
+		if x {
+			return []byte{binaryBool, 1}, nil
+		}
+		return []byte{binaryBool, 0}, nil
+	case []byte:
+		return append([]byte{binaryBytes}, x...), nil
+	case string:
+		return append([]byte{binaryString}, x...), nil
+	case time.Time:
+		return x.AppendBinary([]byte{binaryTime})
+	}
+	return nil, fmt.Errorf("null: couldn't encode binary: unsupported type %T", val)
+}
+
+// decodeBinaryValue decodes data into the driver value it was encoded from.
+func decodeBinaryValue(data []byte) (interface{}, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("null: couldn't decode binary: no data")
+	}
+	kind, rest := data[0], data[1:]
+	switch kind {
+	case binaryNull:
+		if len(rest) == 0 {
+			return nil, nil
+		}
+	case binaryInt64:
+		if n, size := binary.Varint(rest); size > 0 && size == len(rest) {
+			return n, nil
+		}
+	case binaryFloat64:
+		if len(rest) == 8 {
+			return math.Float64frombits(binary.BigEndian.Uint64(rest)), nil
+		}
+	case binaryBool:
+		if len(rest) == 1 && rest[0] <= 1 {
+			return rest[0] == 1, nil
+		}
+	case binaryBytes:
+		return bytes.Clone(rest), nil
+	case binaryString:
+		return string(rest), nil
+	case binaryTime:
+		var t time.Time
+		if err := t.UnmarshalBinary(rest); err != nil {
+			return nil, fmt.Errorf("null: couldn't decode binary: %w", err)
+		}
+		return t, nil
+	case binaryUint64:
+		if n, size := binary.Uvarint(rest); size > 0 && size == len(rest) {
+			return n, nil
+		}
+	default:
+		return nil, fmt.Errorf("null: couldn't decode binary: unknown kind %d", kind)
+	}
+	return nil, fmt.Errorf("null: couldn't decode binary: invalid data for kind %d", kind)
+}
+
+// decodeBinary decodes data and scans it into s.
+func decodeBinary(s sql.Scanner, data []byte) error {
+	v, err := decodeBinaryValue(data)
+	if err != nil {
+		return err
+	}
+	return s.Scan(v)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (a Addr) MarshalBinary() ([]byte, error) {
+	return encodeBinary(a)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (a *Addr) UnmarshalBinary(data []byte) error {
+	return decodeBinary(a, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (a Addr) GobEncode() ([]byte, error) {
+	return encodeBinary(a)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (a *Addr) GobDecode(data []byte) error {
+	return decodeBinary(a, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (a Any) MarshalBinary() ([]byte, error) {
+	return encodeBinary(a)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (a *Any) UnmarshalBinary(data []byte) error {
+	return decodeBinary(a, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (a Any) GobEncode() ([]byte, error) {
+	return encodeBinary(a)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (a *Any) GobDecode(data []byte) error {
+	return decodeBinary(a, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b Base64) MarshalBinary() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Base64) UnmarshalBinary(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b Base64) GobEncode() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *Base64) GobDecode(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b BigInt) MarshalBinary() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *BigInt) UnmarshalBinary(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b BigInt) GobEncode() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *BigInt) GobDecode(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b Bool) MarshalBinary() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Bool) UnmarshalBinary(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b Bool) GobEncode() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *Bool) GobDecode(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b ByteSize) MarshalBinary() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *ByteSize) UnmarshalBinary(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b ByteSize) GobEncode() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *ByteSize) GobDecode(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b Bytes) MarshalBinary() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Bytes) UnmarshalBinary(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b Bytes) GobEncode() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *Bytes) GobDecode(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (c Complex) MarshalBinary() ([]byte, error) {
+	return encodeBinary(c)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *Complex) UnmarshalBinary(data []byte) error {
+	return decodeBinary(c, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (c Complex) GobEncode() ([]byte, error) {
+	return encodeBinary(c)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (c *Complex) GobDecode(data []byte) error {
+	return decodeBinary(c, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d Date) MarshalBinary() ([]byte, error) {
+	return encodeBinary(d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Date) UnmarshalBinary(data []byte) error {
+	return decodeBinary(d, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (d Date) GobEncode() ([]byte, error) {
+	return encodeBinary(d)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (d *Date) GobDecode(data []byte) error {
+	return decodeBinary(d, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d Duration) MarshalBinary() ([]byte, error) {
+	return encodeBinary(d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Duration) UnmarshalBinary(data []byte) error {
+	return decodeBinary(d, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (d Duration) GobEncode() ([]byte, error) {
+	return encodeBinary(d)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (d *Duration) GobDecode(data []byte) error {
+	return decodeBinary(d, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (e Email) MarshalBinary() ([]byte, error) {
+	return encodeBinary(e)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (e *Email) UnmarshalBinary(data []byte) error {
+	return decodeBinary(e, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (e Email) GobEncode() ([]byte, error) {
+	return encodeBinary(e)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (e *Email) GobDecode(data []byte) error {
+	return decodeBinary(e, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (e Enum[T]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(e)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (e *Enum[T]) UnmarshalBinary(data []byte) error {
+	return decodeBinary(e, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (e Enum[T]) GobEncode() ([]byte, error) {
+	return encodeBinary(e)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (e *Enum[T]) GobDecode(data []byte) error {
+	return decodeBinary(e, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m FileMode) MarshalBinary() ([]byte, error) {
+	return encodeBinary(m)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *FileMode) UnmarshalBinary(data []byte) error {
+	return decodeBinary(m, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (m FileMode) GobEncode() ([]byte, error) {
+	return encodeBinary(m)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (m *FileMode) GobDecode(data []byte) error {
+	return decodeBinary(m, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (f Flags[T]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(f)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Flags[T]) UnmarshalBinary(data []byte) error {
+	return decodeBinary(f, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (f Flags[T]) GobEncode() ([]byte, error) {
+	return encodeBinary(f)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (f *Flags[T]) GobDecode(data []byte) error {
+	return decodeBinary(f, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (f Float) MarshalBinary() ([]byte, error) {
+	return encodeBinary(f)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Float) UnmarshalBinary(data []byte) error {
+	return decodeBinary(f, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (f Float) GobEncode() ([]byte, error) {
+	return encodeBinary(f)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (f *Float) GobDecode(data []byte) error {
+	return decodeBinary(f, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (h HardwareAddr) MarshalBinary() ([]byte, error) {
+	return encodeBinary(h)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (h *HardwareAddr) UnmarshalBinary(data []byte) error {
+	return decodeBinary(h, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (h HardwareAddr) GobEncode() ([]byte, error) {
+	return encodeBinary(h)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (h *HardwareAddr) GobDecode(data []byte) error {
+	return decodeBinary(h, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (h Hex) MarshalBinary() ([]byte, error) {
+	return encodeBinary(h)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (h *Hex) UnmarshalBinary(data []byte) error {
+	return decodeBinary(h, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (h Hex) GobEncode() ([]byte, error) {
+	return encodeBinary(h)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (h *Hex) GobDecode(data []byte) error {
+	return decodeBinary(h, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (h FixedHex[A]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(h)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (h *FixedHex[A]) UnmarshalBinary(data []byte) error {
+	return decodeBinary(h, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (h FixedHex[A]) GobEncode() ([]byte, error) {
+	return encodeBinary(h)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (h *FixedHex[A]) GobDecode(data []byte) error {
+	return decodeBinary(h, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Int) MarshalBinary() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int) UnmarshalBinary(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Int) GobEncode() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Int) GobDecode(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Int16) MarshalBinary() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int16) UnmarshalBinary(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Int16) GobEncode() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Int16) GobDecode(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Int32) MarshalBinary() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int32) UnmarshalBinary(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Int32) GobEncode() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Int32) GobDecode(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Int8) MarshalBinary() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int8) UnmarshalBinary(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Int8) GobEncode() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Int8) GobDecode(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (c CountryCode) MarshalBinary() ([]byte, error) {
+	return encodeBinary(c)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *CountryCode) UnmarshalBinary(data []byte) error {
+	return decodeBinary(c, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (c CountryCode) GobEncode() ([]byte, error) {
+	return encodeBinary(c)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (c *CountryCode) GobDecode(data []byte) error {
+	return decodeBinary(c, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (c CurrencyCode) MarshalBinary() ([]byte, error) {
+	return encodeBinary(c)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *CurrencyCode) UnmarshalBinary(data []byte) error {
+	return decodeBinary(c, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (c CurrencyCode) GobEncode() ([]byte, error) {
+	return encodeBinary(c)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (c *CurrencyCode) GobDecode(data []byte) error {
+	return decodeBinary(c, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (j JSON) MarshalBinary() ([]byte, error) {
+	return encodeBinary(j)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (j *JSON) UnmarshalBinary(data []byte) error {
+	return decodeBinary(j, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (j JSON) GobEncode() ([]byte, error) {
+	return encodeBinary(j)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (j *JSON) GobDecode(data []byte) error {
+	return decodeBinary(j, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (k KSUID) MarshalBinary() ([]byte, error) {
+	return encodeBinary(k)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (k *KSUID) UnmarshalBinary(data []byte) error {
+	return decodeBinary(k, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (k KSUID) GobEncode() ([]byte, error) {
+	return encodeBinary(k)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (k *KSUID) GobDecode(data []byte) error {
+	return decodeBinary(k, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (l LanguageTag) MarshalBinary() ([]byte, error) {
+	return encodeBinary(l)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (l *LanguageTag) UnmarshalBinary(data []byte) error {
+	return decodeBinary(l, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (l LanguageTag) GobEncode() ([]byte, error) {
+	return encodeBinary(l)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (l *LanguageTag) GobDecode(data []byte) error {
+	return decodeBinary(l, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m Map[K, V]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(m)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *Map[K, V]) UnmarshalBinary(data []byte) error {
+	return decodeBinary(m, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (m Map[K, V]) GobEncode() ([]byte, error) {
+	return encodeBinary(m)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (m *Map[K, V]) GobDecode(data []byte) error {
+	return decodeBinary(m, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m Money) MarshalBinary() ([]byte, error) {
+	return encodeBinary(m)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *Money) UnmarshalBinary(data []byte) error {
+	return decodeBinary(m, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (m Money) GobEncode() ([]byte, error) {
+	return encodeBinary(m)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (m *Money) GobDecode(data []byte) error {
+	return decodeBinary(m, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o Optional[T]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(o)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *Optional[T]) UnmarshalBinary(data []byte) error {
+	return decodeBinary(o, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o Optional[T]) GobEncode() ([]byte, error) {
+	return encodeBinary(o)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *Optional[T]) GobDecode(data []byte) error {
+	return decodeBinary(o, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (p Percent) MarshalBinary() ([]byte, error) {
+	return encodeBinary(p)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (p *Percent) UnmarshalBinary(data []byte) error {
+	return decodeBinary(p, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (p Percent) GobEncode() ([]byte, error) {
+	return encodeBinary(p)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (p *Percent) GobDecode(data []byte) error {
+	return decodeBinary(p, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (p Point) MarshalBinary() ([]byte, error) {
+	return encodeBinary(p)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (p *Point) UnmarshalBinary(data []byte) error {
+	return decodeBinary(p, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (p Point) GobEncode() ([]byte, error) {
+	return encodeBinary(p)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (p *Point) GobDecode(data []byte) error {
+	return decodeBinary(p, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (p Prefix) MarshalBinary() ([]byte, error) {
+	return encodeBinary(p)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (p *Prefix) UnmarshalBinary(data []byte) error {
+	return decodeBinary(p, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (p Prefix) GobEncode() ([]byte, error) {
+	return encodeBinary(p)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (p *Prefix) GobDecode(data []byte) error {
+	return decodeBinary(p, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r Int64Range) MarshalBinary() ([]byte, error) {
+	return encodeBinary(r)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *Int64Range) UnmarshalBinary(data []byte) error {
+	return decodeBinary(r, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (r Int64Range) GobEncode() ([]byte, error) {
+	return encodeBinary(r)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (r *Int64Range) GobDecode(data []byte) error {
+	return decodeBinary(r, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r TimeRange) MarshalBinary() ([]byte, error) {
+	return encodeBinary(r)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *TimeRange) UnmarshalBinary(data []byte) error {
+	return decodeBinary(r, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (r TimeRange) GobEncode() ([]byte, error) {
+	return encodeBinary(r)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (r *TimeRange) GobDecode(data []byte) error {
+	return decodeBinary(r, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r Regexp) MarshalBinary() ([]byte, error) {
+	return encodeBinary(r)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *Regexp) UnmarshalBinary(data []byte) error {
+	return decodeBinary(r, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (r Regexp) GobEncode() ([]byte, error) {
+	return encodeBinary(r)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (r *Regexp) GobDecode(data []byte) error {
+	return decodeBinary(r, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Semver) MarshalBinary() ([]byte, error) {
+	return encodeBinary(s)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Semver) UnmarshalBinary(data []byte) error {
+	return decodeBinary(s, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Semver) GobEncode() ([]byte, error) {
+	return encodeBinary(s)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Semver) GobDecode(data []byte) error {
+	return decodeBinary(s, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Slice[T]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(s)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Slice[T]) UnmarshalBinary(data []byte) error {
+	return decodeBinary(s, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Slice[T]) GobEncode() ([]byte, error) {
+	return encodeBinary(s)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Slice[T]) GobDecode(data []byte) error {
+	return decodeBinary(s, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s String) MarshalBinary() ([]byte, error) {
+	return encodeBinary(s)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *String) UnmarshalBinary(data []byte) error {
+	return decodeBinary(s, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s String) GobEncode() ([]byte, error) {
+	return encodeBinary(s)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *String) GobDecode(data []byte) error {
+	return decodeBinary(s, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return encodeBinary(t)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	return decodeBinary(t, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (t Time) GobEncode() ([]byte, error) {
+	return encodeBinary(t)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (t *Time) GobDecode(data []byte) error {
+	return decodeBinary(t, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t TimeOfDay) MarshalBinary() ([]byte, error) {
+	return encodeBinary(t)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *TimeOfDay) UnmarshalBinary(data []byte) error {
+	return decodeBinary(t, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (t TimeOfDay) GobEncode() ([]byte, error) {
+	return encodeBinary(t)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (t *TimeOfDay) GobDecode(data []byte) error {
+	return decodeBinary(t, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// Unlike Value, it doesn't depend on DefaultUintValueFormat.
+func (i Uint) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{binaryNull}, nil
+	}
+	return binary.AppendUvarint([]byte{binaryUint64}, i.Uint64), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint) UnmarshalBinary(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Uint16) MarshalBinary() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint16) UnmarshalBinary(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint16) GobEncode() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint16) GobDecode(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Uint32) MarshalBinary() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint32) UnmarshalBinary(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint32) GobEncode() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint32) GobDecode(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Uint8) MarshalBinary() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint8) UnmarshalBinary(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint8) GobEncode() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint8) GobDecode(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (u ULID) MarshalBinary() ([]byte, error) {
+	return encodeBinary(u)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *ULID) UnmarshalBinary(data []byte) error {
+	return decodeBinary(u, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (u ULID) GobEncode() ([]byte, error) {
+	return encodeBinary(u)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (u *ULID) GobDecode(data []byte) error {
+	return decodeBinary(u, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (u UnixSeconds) MarshalBinary() ([]byte, error) {
+	return encodeBinary(u)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *UnixSeconds) UnmarshalBinary(data []byte) error {
+	return decodeBinary(u, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (u UnixSeconds) GobEncode() ([]byte, error) {
+	return encodeBinary(u)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (u *UnixSeconds) GobDecode(data []byte) error {
+	return decodeBinary(u, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (u UnixMillis) MarshalBinary() ([]byte, error) {
+	return encodeBinary(u)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *UnixMillis) UnmarshalBinary(data []byte) error {
+	return decodeBinary(u, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (u UnixMillis) GobEncode() ([]byte, error) {
+	return encodeBinary(u)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (u *UnixMillis) GobDecode(data []byte) error {
+	return decodeBinary(u, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (u URL) MarshalBinary() ([]byte, error) {
+	return encodeBinary(u)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *URL) UnmarshalBinary(data []byte) error {
+	return decodeBinary(u, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (u URL) GobEncode() ([]byte, error) {
+	return encodeBinary(u)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (u *URL) GobDecode(data []byte) error {
+	return decodeBinary(u, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (v Value[T]) MarshalBinary() ([]byte, error) {
+	return encodeBinary(v)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (v *Value[T]) UnmarshalBinary(data []byte) error {
+	return decodeBinary(v, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (v Value[T]) GobEncode() ([]byte, error) {
+	return encodeBinary(v)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (v *Value[T]) GobDecode(data []byte) error {
+	return decodeBinary(v, data)
+}
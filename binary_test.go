@@ -0,0 +1,130 @@
+package null
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, in := range []allTypes{exampleAllTypes(), {}} {
+		rv := reflect.ValueOf(in)
+		for i := 0; i < rv.NumField(); i++ {
+			name := rv.Type().Field(i).Name
+			field := rv.Field(i)
+			data, err := field.Interface().(encoding.BinaryMarshaler).MarshalBinary()
+			maybePanic(err)
+
+			out := reflect.New(field.Type())
+			err = out.Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(data)
+			maybePanic(err)
+
+			want, err := json.Marshal(field.Interface())
+			maybePanic(err)
+			got, err := json.Marshal(out.Elem().Interface())
+			maybePanic(err)
+			assertJSONEquals(t, got, string(want), name+" binary round trip")
+
+			gobData, err := field.Interface().(gob.GobEncoder).GobEncode()
+			maybePanic(err)
+			if !bytes.Equal(gobData, data) {
+				t.Errorf("%s: GobEncode %v ≠ MarshalBinary %v", name, gobData, data)
+			}
+		}
+	}
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	in := exampleAllTypes()
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(in)
+	maybePanic(err)
+	var out allTypes
+	err = gob.NewDecoder(&buf).Decode(&out)
+	maybePanic(err)
+	assertAllTypesEqual(t, out, in, "gob round trip")
+
+	null := allTypes{Optional: OptionalNull[string]()}
+	buf.Reset()
+	err = gob.NewEncoder(&buf).Encode(null)
+	maybePanic(err)
+	out = exampleAllTypes()
+	err = gob.NewDecoder(&buf).Decode(&out)
+	maybePanic(err)
+	if !out.Optional.IsSet() || out.Optional.Valid {
+		t.Error("null Optional should stay present")
+	}
+}
+
+func TestGobEncoding(t *testing.T) {
+	tests := []struct {
+		v    interface{ GobEncode() ([]byte, error) }
+		want []byte
+	}{
+		{NewInt(1, false), []byte{0}},
+		{IntFrom(-1), []byte{1, 1}},
+		{IntFrom(64), []byte{1, 0x80, 0x01}},
+		{FloatFrom(1), []byte{2, 0x3f, 0xf0, 0, 0, 0, 0, 0, 0}},
+		{BoolFrom(true), []byte{3, 1}},
+		{BytesFrom([]byte{0xff}), []byte{4, 0xff}},
+		{StringFrom(""), []byte{5}},
+		{StringFrom("hi"), []byte{5, 'h', 'i'}},
+		{UintFrom(math.MaxUint64), []byte{7, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{ValueFrom(int8(3)), []byte{1, 6}},
+	}
+	for _, test := range tests {
+		got, err := test.v.GobEncode()
+		maybePanic(err)
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("%#v: got %v, want %v", test.v, got, test.want)
+		}
+	}
+
+	var s String
+	err := s.GobDecode([]byte{5})
+	maybePanic(err)
+	if !s.Equal(StringFrom("")) {
+		t.Error("blank string should decode as valid")
+	}
+
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.FixedZone("", 9*60*60))
+	data, err := TimeFrom(now).GobEncode()
+	maybePanic(err)
+	if data[0] != binaryTime {
+		t.Errorf("bad time kind: %d", data[0])
+	}
+	var tm Time
+	err = tm.GobDecode(data)
+	maybePanic(err)
+	if !tm.Valid || !tm.Time.Equal(now) {
+		t.Errorf("bad decoded time: %v", tm)
+	}
+}
+
+func TestGobDecodeInvalid(t *testing.T) {
+	for _, bad := range [][]byte{nil, {0, 1}, {1}, {2, 1, 2}, {3, 2}, {6, 1}, {7}, {99}} {
+		var i Int
+		if err := i.GobDecode(bad); err == nil {
+			t.Errorf("expected error decoding %v", bad)
+		}
+	}
+
+	var i Int
+	if err := i.GobDecode([]byte{5, 'x'}); err == nil {
+		t.Error("expected error decoding string into Int")
+	}
+	var u Uint
+	if err := u.GobDecode([]byte{1, 1}); err == nil {
+		t.Error("expected error decoding negative int into Uint")
+	}
+	err := u.GobDecode([]byte{1, 2})
+	maybePanic(err)
+	if !u.Equal(UintFrom(1)) {
+		t.Errorf("bad decoded Uint: %v", u)
+	}
+}
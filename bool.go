@@ -6,6 +6,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // Bool is a nullable bool.
@@ -43,17 +46,59 @@ func (b Bool) ValueOrZero() bool {
 	return b.Valid && b.Bool
 }
 
+// Scan implements the Scanner interface.
+// In addition to what sql.NullBool accepts, it accepts the float64 values 0 and 1.
+func (b *Bool) Scan(value interface{}) (err error) {
+	defer validateDecoded(b, &err)
+	value = driverval.Convert(value)
+	if f, ok := value.(float64); ok {
+		if f != 0 && f != 1 {
+			b.Valid = false
+			return fmt.Errorf("null: couldn't scan value %v into Bool", f)
+		}
+		b.Bool, b.Valid = f == 1, true
+		return nil
+	}
+	return b.NullBool.Scan(value)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
-// 0 will not be considered a null Bool.
-func (b *Bool) UnmarshalJSON(data []byte) error {
+// It supports boolean and null input.
+// false will not be considered a null Bool.
+// If DefaultJSONDecoding is JSONLenient, it also supports strings such as "true" or "0", and the numbers 0 and 1.
+func (b *Bool) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(b, &err)
+	return b.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (b *Bool) unmarshalJSONWith(data []byte, d JSONDecoding) error {
 	if bytes.Equal(data, nullBytes) {
 		b.Valid = false
 		return nil
 	}
 
 	if err := json.Unmarshal(data, &b.Bool); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		var typeError *json.UnmarshalTypeError
+		if !errors.As(err, &typeError) || d != JSONLenient {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		switch x := v.(type) {
+		case string:
+			if b.Bool, err = strconv.ParseBool(x); err != nil {
+				return fmt.Errorf("null: couldn't convert string to bool: %w", err)
+			}
+		case float64:
+			if x != 0 && x != 1 {
+				return fmt.Errorf("null: JSON input is invalid bool number (need 0 or 1): %s", data)
+			}
+			b.Bool = x == 1
+		default:
+			return fmt.Errorf("null: JSON input is invalid type (need bool, string, or number): %w", err)
+		}
 	}
 
 	b.Valid = true
@@ -61,14 +106,16 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Bool if the input is blank.
+// It will unmarshal to a null Bool if the input is blank, "null", or DefaultNullText.
 // It will return an error if the input is not an integer, blank, or "null".
-func (b *Bool) UnmarshalText(text []byte) error {
+func (b *Bool) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(b, &err)
 	str := string(text)
-	switch str {
-	case "", "null":
+	if isNullText(text) || str == "null" {
 		b.Valid = false
 		return nil
+	}
+	switch str {
 	case "true":
 		b.Bool = true
 	case "false":
@@ -93,10 +140,10 @@ func (b Bool) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string if this Bool is null.
+// It will encode DefaultNullText, blank by default, if this Bool is null.
 func (b Bool) MarshalText() ([]byte, error) {
 	if !b.Valid {
-		return []byte{}, nil
+		return nullText(), nil
 	}
 	if !b.Bool {
 		return []byte("false"), nil
@@ -110,6 +157,16 @@ func (b *Bool) SetValid(v bool) {
 	b.Valid = true
 }
 
+// SetNull sets this Bool to null, leaving its value as is. Use Reset to clear the value too.
+func (b *Bool) SetNull() {
+	b.Valid = false
+}
+
+// Reset sets this Bool to null and clears its value.
+func (b *Bool) Reset() {
+	*b = Bool{}
+}
+
 // Ptr returns a pointer to this Bool's value, or a nil pointer if this Bool is null.
 func (b Bool) Ptr() *bool {
 	if !b.Valid {
@@ -118,13 +175,81 @@ func (b Bool) Ptr() *bool {
 	return &b.Bool
 }
 
+// SetPtr changes this Bool's value to the value v points to, or sets it to be null if v is nil, like BoolFromPtr.
+func (b *Bool) SetPtr(v *bool) {
+	*b = BoolFromPtr(v)
+}
+
 // IsZero returns true for invalid Bools, for future omitempty support (Go 1.4?)
 // A non-null Bool with a 0 value will not be considered zero.
 func (b Bool) IsZero() bool {
 	return !b.Valid
 }
 
+// IsZeroOrNull returns true for invalid Bools, or valid Bools with a false value.
+func (b Bool) IsZeroOrNull() bool {
+	return !b.Valid || !b.Bool
+}
+
 // Equal returns true if both booleans have the same value or are both null.
 func (b Bool) Equal(other Bool) bool {
 	return b.Valid == other.Valid && (!b.Valid || b.Bool == other.Bool)
 }
+
+// IsTrue returns true if this Bool is valid and true.
+func (b Bool) IsTrue() bool {
+	return b.Valid && b.Bool
+}
+
+// IsFalse returns true if this Bool is valid and false.
+func (b Bool) IsFalse() bool {
+	return b.Valid && !b.Bool
+}
+
+// IsUnknown returns true if this Bool is null.
+// In SQL's three-valued logic, null is unknown.
+func (b Bool) IsUnknown() bool {
+	return !b.Valid
+}
+
+// And returns the logical conjunction of both Bools, using SQL's three-valued logic.
+// It is false if either is false, otherwise null if either is null.
+func (b Bool) And(other Bool) Bool {
+	if b.IsFalse() || other.IsFalse() {
+		return BoolFrom(false)
+	}
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(true)
+}
+
+// Or returns the logical disjunction of both Bools, using SQL's three-valued logic.
+// It is true if either is true, otherwise null if either is null.
+func (b Bool) Or(other Bool) Bool {
+	if b.IsTrue() || other.IsTrue() {
+		return BoolFrom(true)
+	}
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(false)
+}
+
+// Not returns the negation of this Bool, using SQL's three-valued logic.
+// The negation of null is null.
+func (b Bool) Not() Bool {
+	if !b.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(!b.Bool)
+}
+
+// Xor returns the exclusive disjunction of both Bools, using SQL's three-valued logic.
+// It is null if either is null.
+func (b Bool) Xor(other Bool) Bool {
+	if !b.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return BoolFrom(b.Bool != other.Bool)
+}
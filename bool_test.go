@@ -217,6 +217,49 @@ func TestBoolEqual(t *testing.T) {
 	assertBoolEqualIsFalse(t, b1, b2)
 }
 
+func TestBoolThreeValuedLogic(t *testing.T) {
+	T, F, N := BoolFrom(true), BoolFrom(false), NewBool(false, false)
+	table := []struct {
+		a, b         Bool
+		and, or, xor Bool
+	}{
+		{T, T, T, T, F},
+		{T, F, F, T, T},
+		{T, N, N, T, N},
+		{F, T, F, T, T},
+		{F, F, F, F, F},
+		{F, N, F, N, N},
+		{N, T, N, T, N},
+		{N, F, F, N, N},
+		{N, N, N, N, N},
+	}
+	for _, tc := range table {
+		if got := tc.a.And(tc.b); !got.Equal(tc.and) {
+			t.Errorf("%v AND %v = %v, want %v", tc.a, tc.b, got, tc.and)
+		}
+		if got := tc.a.Or(tc.b); !got.Equal(tc.or) {
+			t.Errorf("%v OR %v = %v, want %v", tc.a, tc.b, got, tc.or)
+		}
+		if got := tc.a.Xor(tc.b); !got.Equal(tc.xor) {
+			t.Errorf("%v XOR %v = %v, want %v", tc.a, tc.b, got, tc.xor)
+		}
+	}
+
+	if !T.Not().Equal(F) || !F.Not().Equal(T) || !N.Not().Equal(N) {
+		t.Error("bad Not()")
+	}
+
+	if !T.IsTrue() || F.IsTrue() || N.IsTrue() {
+		t.Error("bad IsTrue()")
+	}
+	if T.IsFalse() || !F.IsFalse() || N.IsFalse() {
+		t.Error("bad IsFalse()")
+	}
+	if T.IsUnknown() || F.IsUnknown() || !N.IsUnknown() {
+		t.Error("bad IsUnknown()")
+	}
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
 		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
@@ -0,0 +1,674 @@
+//go:build bson
+
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// This file implements bson.ValueMarshaler and bson.ValueUnmarshaler from the MongoDB driver.
+// It is only built with the bson build tag, so that the null package stays free of dependencies:
+//
+//	go build -tags bson
+//
+// Values are stored as the BSON equivalent of what would be sent to an SQL database (see Value),
+// and null values are stored as BSON null.
+
+// marshalBSONValue encodes the driver value of v as BSON.
+func marshalBSONValue(v driver.Valuer) (byte, []byte, error) {
+	val, err := v.Value()
+	if err != nil {
+		return 0, nil, fmt.Errorf("null: couldn't marshal BSON: %w", err)
+	}
+	if val == nil {
+		return byte(bson.TypeNull), nil, nil
+	}
+	typ, data, err := bson.MarshalValue(val)
+	if err != nil {
+		return 0, nil, fmt.Errorf("null: couldn't marshal BSON: %w", err)
+	}
+	return byte(typ), data, nil
+}
+
+// unmarshalBSONValue decodes a BSON value into the equivalent driver value and scans it into s.
+func unmarshalBSONValue(s sql.Scanner, typ byte, data []byte) error {
+	raw := bson.RawValue{Type: bson.Type(typ), Value: data}
+	var v interface{}
+	switch raw.Type {
+	case bson.TypeNull, bson.TypeUndefined:
+		v = nil
+	case bson.TypeBoolean:
+		v = raw.Boolean()
+	case bson.TypeInt32:
+		v = int64(raw.Int32())
+	case bson.TypeInt64:
+		v = raw.Int64()
+	case bson.TypeDouble:
+		v = raw.Double()
+	case bson.TypeDecimal128:
+		v = raw.Decimal128().String()
+	case bson.TypeString:
+		v = raw.StringValue()
+	case bson.TypeDateTime:
+		v = raw.Time().UTC()
+	case bson.TypeBinary:
+		_, b := raw.Binary()
+		v = bytes.Clone(b)
+	default:
+		return fmt.Errorf("null: couldn't unmarshal BSON type %s", raw.Type)
+	}
+	return s.Scan(v)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Addr is null.
+func (a Addr) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(a)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Addr can be scanned from.
+func (a *Addr) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(a, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Any is null.
+func (a Any) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(a)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Any can be scanned from.
+func (a *Any) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(a, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Base64 is null.
+func (b Base64) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(b)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Base64 can be scanned from.
+func (b *Base64) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(b, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this BigInt is null.
+func (b BigInt) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(b)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this BigInt can be scanned from.
+func (b *BigInt) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(b, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(b)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Bool can be scanned from.
+func (b *Bool) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(b, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this ByteSize is null.
+func (b ByteSize) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(b)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this ByteSize can be scanned from.
+func (b *ByteSize) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(b, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Bytes is null.
+func (b Bytes) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(b)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Bytes can be scanned from.
+func (b *Bytes) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(b, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Complex is null.
+func (c Complex) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(c)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Complex can be scanned from.
+func (c *Complex) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(c, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Date is null.
+func (d Date) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(d)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Date can be scanned from.
+func (d *Date) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(d, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Duration is null.
+func (d Duration) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(d)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Duration can be scanned from.
+func (d *Duration) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(d, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Email is null.
+func (e Email) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(e)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Email can be scanned from.
+func (e *Email) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(e, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Enum is null.
+func (e Enum[T]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(e)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Enum can be scanned from.
+func (e *Enum[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(e, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this FileMode is null.
+func (m FileMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(m)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this FileMode can be scanned from.
+func (m *FileMode) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(m, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Flags is null.
+func (f Flags[T]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(f)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Flags can be scanned from.
+func (f *Flags[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(f, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Float is null.
+func (f Float) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(f)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Float can be scanned from.
+func (f *Float) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(f, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this HardwareAddr is null.
+func (h HardwareAddr) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(h)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this HardwareAddr can be scanned from.
+func (h *HardwareAddr) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(h, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Hex is null.
+func (h Hex) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(h)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Hex can be scanned from.
+func (h *Hex) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(h, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this FixedHex is null.
+func (h FixedHex[A]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(h)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this FixedHex can be scanned from.
+func (h *FixedHex[A]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(h, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Int is null.
+func (i Int) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(i)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Int can be scanned from.
+func (i *Int) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(i, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(i)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Int16 can be scanned from.
+func (i *Int16) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(i, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(i)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Int32 can be scanned from.
+func (i *Int32) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(i, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(i)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Int8 can be scanned from.
+func (i *Int8) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(i, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this CountryCode is null.
+func (c CountryCode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(c)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this CountryCode can be scanned from.
+func (c *CountryCode) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(c, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this CurrencyCode is null.
+func (c CurrencyCode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(c)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this CurrencyCode can be scanned from.
+func (c *CurrencyCode) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(c, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this JSON is null.
+func (j JSON) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(j)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this JSON can be scanned from.
+func (j *JSON) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(j, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this KSUID is null.
+func (k KSUID) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(k)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this KSUID can be scanned from.
+func (k *KSUID) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(k, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this LanguageTag is null.
+func (l LanguageTag) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(l)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this LanguageTag can be scanned from.
+func (l *LanguageTag) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(l, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Map is null.
+func (m Map[K, V]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(m)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Map can be scanned from.
+func (m *Map[K, V]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(m, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Money is null.
+func (m Money) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(m)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Money can be scanned from.
+func (m *Money) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(m, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Optional is null.
+func (o Optional[T]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(o)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Optional can be scanned from.
+func (o *Optional[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(o, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Percent is null.
+func (p Percent) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(p)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Percent can be scanned from.
+func (p *Percent) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(p, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Point is null.
+func (p Point) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(p)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Point can be scanned from.
+func (p *Point) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(p, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Prefix is null.
+func (p Prefix) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(p)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Prefix can be scanned from.
+func (p *Prefix) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(p, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Int64Range is null.
+func (r Int64Range) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(r)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Int64Range can be scanned from.
+func (r *Int64Range) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(r, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this TimeRange is null.
+func (r TimeRange) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(r)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this TimeRange can be scanned from.
+func (r *TimeRange) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(r, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Regexp is null.
+func (r Regexp) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(r)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Regexp can be scanned from.
+func (r *Regexp) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(r, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Semver is null.
+func (s Semver) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(s)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Semver can be scanned from.
+func (s *Semver) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Slice is null.
+func (s Slice[T]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(s)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Slice can be scanned from.
+func (s *Slice[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this String is null.
+func (s String) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(s)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this String can be scanned from.
+func (s *String) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Time is null.
+func (t Time) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(t)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Time can be scanned from.
+func (t *Time) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(t, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this TimeOfDay is null.
+func (t TimeOfDay) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(t)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this TimeOfDay can be scanned from.
+func (t *TimeOfDay) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(t, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Uint is null.
+// Unlike Value, it doesn't depend on DefaultUintValueFormat.
+func (i Uint) MarshalBSONValue() (byte, []byte, error) {
+	if !i.Valid {
+		return byte(bson.TypeNull), nil, nil
+	}
+	typ, data, err := bson.MarshalValue(i.Uint64)
+	if err != nil {
+		return 0, nil, fmt.Errorf("null: couldn't marshal BSON: %w", err)
+	}
+	return byte(typ), data, nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Uint can be scanned from.
+func (i *Uint) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(i, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Uint16 is null.
+func (i Uint16) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(i)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Uint16 can be scanned from.
+func (i *Uint16) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(i, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Uint32 is null.
+func (i Uint32) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(i)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Uint32 can be scanned from.
+func (i *Uint32) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(i, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Uint8 is null.
+func (i Uint8) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(i)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Uint8 can be scanned from.
+func (i *Uint8) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(i, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this ULID is null.
+func (u ULID) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(u)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this ULID can be scanned from.
+func (u *ULID) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(u, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this UnixSeconds is null.
+func (u UnixSeconds) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(u)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this UnixSeconds can be scanned from.
+func (u *UnixSeconds) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(u, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this UnixMillis is null.
+func (u UnixMillis) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(u)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this UnixMillis can be scanned from.
+func (u *UnixMillis) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(u, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this URL is null.
+func (u URL) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(u)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this URL can be scanned from.
+func (u *URL) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(u, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Value is null.
+func (v Value[T]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(v)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null and any BSON value this Value can be scanned from.
+func (v *Value[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONValue(v, typ, data)
+}
@@ -0,0 +1,92 @@
+//go:build bson
+
+package null
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestBSONRoundTrip(t *testing.T) {
+	in := exampleAllTypes()
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+	var out allTypes
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+
+	assertAllTypesEqual(t, out, in, "bson round trip")
+	if !out.Optional.IsSet() {
+		t.Error("unmarshaled Optional should be present")
+	}
+}
+
+func TestBSONNull(t *testing.T) {
+	data, err := bson.Marshal(allTypes{})
+	maybePanic(err)
+
+	elems, err := bson.Raw(data).Elements()
+	maybePanic(err)
+	if len(elems) != reflect.TypeOf(allTypes{}).NumField() {
+		t.Fatalf("bad element count: %d", len(elems))
+	}
+	for _, elem := range elems {
+		if typ := elem.Value().Type; typ != bson.TypeNull {
+			t.Errorf("%s: encoded as %s, want null", elem.Key(), typ)
+		}
+	}
+
+	var out allTypes
+	out.Int = IntFrom(1)
+	out.Time = TimeFrom(time.Now())
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if !reflect.DeepEqual(out.Int, Int{}) || out.Time.Valid {
+		t.Errorf("null BSON should unmarshal to null: %#v %#v", out.Int, out.Time)
+	}
+	if !out.Optional.IsSet() || out.Optional.Valid {
+		t.Error("null BSON should unmarshal to a present, null Optional")
+	}
+}
+
+func TestBSONUnmarshalTypes(t *testing.T) {
+	data, err := bson.Marshal(bson.D{
+		{Key: "int", Value: int32(7)},
+		{Key: "float", Value: int64(2)},
+		{Key: "string", Value: bson.Undefined{}},
+		{Key: "bigint", Value: mustDecimal128("123456789012345678901234567890")},
+		{Key: "bad", Value: bson.A{1, 2}},
+	})
+	maybePanic(err)
+
+	var out struct {
+		Int    Int    `bson:"int"`
+		Float  Float  `bson:"float"`
+		String String `bson:"string"`
+		BigInt BigInt `bson:"bigint"`
+	}
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.Int.Equal(IntFrom(7)) || !out.Float.Equal(FloatFrom(2)) || out.String.Valid {
+		t.Errorf("bad unmarshaled values: %#v", out)
+	}
+	if out.BigInt.ValueOrZero().String() != "123456789012345678901234567890" {
+		t.Errorf("bad unmarshaled decimal: %v", out.BigInt.ValueOrZero())
+	}
+
+	var bad struct {
+		Int Int `bson:"bad"`
+	}
+	if err := bson.Unmarshal(data, &bad); err == nil {
+		t.Error("expected error unmarshaling array into Int")
+	}
+}
+
+func mustDecimal128(s string) bson.Decimal128 {
+	d, err := bson.ParseDecimal128(s)
+	maybePanic(err)
+	return d
+}
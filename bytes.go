@@ -0,0 +1,229 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// BytesEncoding is a way of representing Bytes as JSON and text.
+type BytesEncoding int
+
+const (
+	// BytesBase64 encodes Bytes with standard padded base64, like encoding/json does for []byte.
+	BytesBase64 BytesEncoding = iota
+	// BytesHex encodes Bytes as lowercase hexadecimal.
+	BytesHex
+)
+
+// DefaultBytesEncoding is the encoding Bytes uses for JSON and text marshaling and unmarshaling.
+// It defaults to BytesBase64.
+var DefaultBytesEncoding = BytesBase64
+
+func (e BytesEncoding) encode(b []byte) string {
+	if e == BytesHex {
+		return hex.EncodeToString(b)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func (e BytesEncoding) decode(s string) ([]byte, error) {
+	if e == BytesHex {
+		return hex.DecodeString(s)
+	}
+	return base64.StdEncoding.DecodeString(s)
+}
+
+// NullBytes represents a []byte that may be null.
+// NullBytes implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullBytes struct {
+	Bytes []byte
+	Valid bool // Valid is true if Bytes is not NULL
+}
+
+// Scan implements the Scanner interface.
+// The scanned bytes are copied, so they remain valid after the next call to Next.
+func (n *NullBytes) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	switch v := value.(type) {
+	case nil:
+		n.Bytes, n.Valid = nil, false
+	case []byte:
+		n.Bytes, n.Valid = append([]byte{}, v...), true
+	case string:
+		n.Bytes, n.Valid = []byte(v), true
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Bytes: %v", value, value)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// A valid empty value is sent as an empty, non-nil []byte.
+func (n NullBytes) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if n.Bytes == nil {
+		return []byte{}, nil
+	}
+	return n.Bytes, nil
+}
+
+// IsZero returns true if this NullBytes is null.
+func (n NullBytes) IsZero() bool {
+	return !n.Valid
+}
+
+// Bytes is a nullable []byte.
+// It does not consider empty values to be null.
+// It will decode to null, not empty, if null.
+type Bytes struct {
+	NullBytes
+}
+
+// NewBytes creates a new Bytes.
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{
+		NullBytes: NullBytes{
+			Bytes: b,
+			Valid: valid,
+		},
+	}
+}
+
+// BytesFrom creates a new Bytes that will always be valid.
+// A nil b will be considered empty, not null.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, true)
+}
+
+// BytesFromPtr creates a new Bytes that will be null if b is nil.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return NewBytes(nil, false)
+	}
+	return NewBytes(*b, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (b Bytes) ValueOrZero() []byte {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input, with strings decoded using DefaultBytesEncoding.
+// An empty string will not be considered a null Bytes.
+func (b *Bytes) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(b, &err)
+	if bytes.Equal(data, nullBytes) {
+		b.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := DefaultBytesEncoding.decode(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't decode bytes: %w", err)
+	}
+
+	b.Bytes = v
+	b.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Bytes is null,
+// otherwise a string encoded with DefaultBytesEncoding.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(DefaultBytesEncoding.encode(b.Bytes))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Bytes if the input is DefaultNullText, which is blank by default,
+// otherwise it decodes the input using DefaultBytesEncoding.
+func (b *Bytes) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(b, &err)
+	if isNullTextOnly(text) {
+		b.Valid = false
+		return nil
+	}
+	v, err := DefaultBytesEncoding.decode(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	b.Bytes = v
+	b.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Bytes is null,
+// otherwise the value encoded with DefaultBytesEncoding.
+func (b Bytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return nullText(), nil
+	}
+	return []byte(DefaultBytesEncoding.encode(b.Bytes)), nil
+}
+
+// SetValid changes this Bytes's value and also sets it to be non-null.
+func (b *Bytes) SetValid(v []byte) {
+	b.Bytes = v
+	b.Valid = true
+}
+
+// SetNull sets this Bytes to null, leaving its value as is. Use Reset to clear the value too.
+func (b *Bytes) SetNull() {
+	b.Valid = false
+}
+
+// Reset sets this Bytes to null and clears its value.
+func (b *Bytes) Reset() {
+	*b = Bytes{}
+}
+
+// Ptr returns a pointer to this Bytes's value, or a nil pointer if this Bytes is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// SetPtr changes this Bytes's value to the value v points to, or sets it to be null if v is nil, like BytesFromPtr.
+func (b *Bytes) SetPtr(v *[]byte) {
+	*b = BytesFromPtr(v)
+}
+
+// IsZero returns true for invalid Bytes.
+// A non-null Bytes with an empty value will not be considered zero.
+func (b Bytes) IsZero() bool {
+	return !b.Valid
+}
+
+// IsZeroOrNull returns true for invalid Bytess, or valid Bytess with an empty value.
+func (b Bytes) IsZeroOrNull() bool {
+	return !b.Valid || len(b.Bytes) == 0
+}
+
+// Equal returns true if both Bytes have the same contents or are both null.
+// Nil and empty values are considered equal.
+func (b Bytes) Equal(other Bytes) bool {
+	return b.Valid == other.Valid && (!b.Valid || bytes.Equal(b.Bytes, other.Bytes))
+}
@@ -0,0 +1,287 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var (
+	bytesValue      = []byte("hello")
+	bytesJSON       = []byte(`"aGVsbG8="`)
+	bytesHexJSON    = []byte(`"68656c6c6f"`)
+	blankBytesJSON  = []byte(`""`)
+	invalidBytesHex = []byte(`"zz"`)
+)
+
+func TestBytesFrom(t *testing.T) {
+	b := BytesFrom(bytesValue)
+	assertBytes(t, b, "BytesFrom()")
+
+	empty := BytesFrom(nil)
+	if !empty.Valid {
+		t.Error("BytesFrom(nil)", "is invalid, but should be valid")
+	}
+}
+
+func TestBytesFromPtr(t *testing.T) {
+	v := bytesValue
+	b := BytesFromPtr(&v)
+	assertBytes(t, b, "BytesFromPtr()")
+
+	null := BytesFromPtr(nil)
+	assertNullBytes(t, null, "BytesFromPtr(nil)")
+}
+
+func TestUnmarshalBytes(t *testing.T) {
+	var b Bytes
+	err := json.Unmarshal(bytesJSON, &b)
+	maybePanic(err)
+	assertBytes(t, b, "bytes json")
+
+	var blank Bytes
+	err = json.Unmarshal(blankBytesJSON, &blank)
+	maybePanic(err)
+	if !blank.Valid || len(blank.Bytes) != 0 {
+		t.Errorf("bad blank bytes json: %#v", blank)
+	}
+
+	var null Bytes
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBytes(t, null, "null json")
+
+	var badType Bytes
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullBytes(t, badType, "wrong type json")
+
+	var invalid Bytes
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullBytes(t, invalid, "invalid json")
+}
+
+func TestBytesHexEncoding(t *testing.T) {
+	DefaultBytesEncoding = BytesHex
+	defer func() { DefaultBytesEncoding = BytesBase64 }()
+
+	var b Bytes
+	err := json.Unmarshal(bytesHexJSON, &b)
+	maybePanic(err)
+	assertBytes(t, b, "hex bytes json")
+
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(bytesHexJSON), "hex json marshal")
+
+	data, err = b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "68656c6c6f", "hex text marshal")
+
+	var invalid Bytes
+	err = json.Unmarshal(invalidBytesHex, &invalid)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestTextUnmarshalBytes(t *testing.T) {
+	var b Bytes
+	err := b.UnmarshalText([]byte("aGVsbG8="))
+	maybePanic(err)
+	assertBytes(t, b, "UnmarshalText() bytes")
+
+	var blank Bytes
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBytes(t, blank, "UnmarshalText() empty bytes")
+
+	var invalid Bytes
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalBytes(t *testing.T) {
+	b := BytesFrom(bytesValue)
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(bytesJSON), "non-empty json marshal")
+
+	empty := BytesFrom(nil)
+	data, err = json.Marshal(empty)
+	maybePanic(err)
+	assertJSONEquals(t, data, `""`, "empty json marshal")
+
+	null := NewBytes(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalBytesText(t *testing.T) {
+	b := BytesFrom(bytesValue)
+	data, err := b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "aGVsbG8=", "non-empty text marshal")
+
+	null := NewBytes(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestBytesPointer(t *testing.T) {
+	b := BytesFrom(bytesValue)
+	ptr := b.Ptr()
+	if !bytes.Equal(*ptr, bytesValue) {
+		t.Errorf("bad %s bytes: %#v ≠ %s\n", "pointer", ptr, bytesValue)
+	}
+
+	null := NewBytes(nil, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s bytes: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestBytesIsZero(t *testing.T) {
+	b := BytesFrom(bytesValue)
+	if b.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewBytes(nil, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	empty := NewBytes([]byte{}, true)
+	if empty.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestBytesSetValid(t *testing.T) {
+	change := NewBytes(nil, false)
+	assertNullBytes(t, change, "SetValid()")
+	change.SetValid(bytesValue)
+	assertBytes(t, change, "SetValid()")
+}
+
+func TestBytesScan(t *testing.T) {
+	src := []byte("hello")
+	var b Bytes
+	err := b.Scan(src)
+	maybePanic(err)
+	assertBytes(t, b, "scanned bytes")
+	src[0] = 'j'
+	assertBytes(t, b, "scanned bytes after reuse")
+
+	var s Bytes
+	err = s.Scan("hello")
+	maybePanic(err)
+	assertBytes(t, s, "scanned string")
+
+	var empty Bytes
+	err = empty.Scan([]byte{})
+	maybePanic(err)
+	if !empty.Valid || empty.Bytes == nil {
+		t.Errorf("bad scanned empty bytes: %#v", empty)
+	}
+
+	var null Bytes
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBytes(t, null, "scanned null")
+
+	var wrong Bytes
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestBytesValue(t *testing.T) {
+	b := BytesFrom(bytesValue)
+	v, err := b.Value()
+	maybePanic(err)
+	if !bytes.Equal(v.([]byte), bytesValue) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	empty := BytesFrom(nil)
+	v, err = empty.Value()
+	maybePanic(err)
+	if v, ok := v.([]byte); !ok || v == nil {
+		t.Errorf("bad empty Value(): %#v", v)
+	}
+
+	null := NewBytes(bytesValue, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestBytesEqual(t *testing.T) {
+	b1 := NewBytes([]byte("foo"), false)
+	b2 := NewBytes([]byte("bar"), false)
+	assertBytesEqualIsTrue(t, b1, b2)
+
+	b1 = BytesFrom([]byte("foo"))
+	b2 = BytesFrom([]byte("foo"))
+	assertBytesEqualIsTrue(t, b1, b2)
+
+	b1 = BytesFrom(nil)
+	b2 = BytesFrom([]byte{})
+	assertBytesEqualIsTrue(t, b1, b2)
+
+	b1 = BytesFrom([]byte("foo"))
+	b2 = NewBytes([]byte("foo"), false)
+	assertBytesEqualIsFalse(t, b1, b2)
+
+	b1 = BytesFrom([]byte("foo"))
+	b2 = BytesFrom([]byte("bar"))
+	assertBytesEqualIsFalse(t, b1, b2)
+}
+
+func assertBytes(t *testing.T, b Bytes, from string) {
+	t.Helper()
+	if !bytes.Equal(b.Bytes, bytesValue) {
+		t.Errorf("bad %s bytes: %q ≠ %q\n", from, b.Bytes, bytesValue)
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullBytes(t *testing.T, b Bytes, from string) {
+	t.Helper()
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertBytesEqualIsTrue(t *testing.T, a, b Bytes) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Bytes{%q, Valid:%t} and Bytes{%q, Valid:%t} should return true", a.Bytes, a.Valid, b.Bytes, b.Valid)
+	}
+}
+
+func assertBytesEqualIsFalse(t *testing.T, a, b Bytes) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Bytes{%q, Valid:%t} and Bytes{%q, Valid:%t} should return false", a.Bytes, a.Valid, b.Bytes, b.Valid)
+	}
+}
@@ -0,0 +1,322 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullByteSize represents a size in bytes that may be null.
+// NullByteSize implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullByteSize struct {
+	Size  uint64
+	Valid bool // Valid is true if Size is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports non-negative integers, including integral floats, and sizes such as "10MiB" (see ParseByteSize).
+func (n *NullByteSize) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var size uint64
+	var err error
+	switch v := value.(type) {
+	case nil:
+		n.Size, n.Valid = 0, false
+		return nil
+	case int64:
+		if v < 0 {
+			err = fmt.Errorf("negative size: %d", v)
+		}
+		size = uint64(v)
+	case float64:
+		i, ok := driverval.FloatToInt(v)
+		if !ok || i < 0 {
+			err = fmt.Errorf("not a whole number of bytes: %v", v)
+		}
+		size = uint64(i)
+	case []byte:
+		size, err = parseByteSize(string(v))
+	case string:
+		size, err = parseByteSize(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into ByteSize: %v", value, value)
+	}
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan ByteSize: %w", err)
+	}
+	n.Size, n.Valid = size, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as int64 numbers of bytes.
+// It returns an error if the size overflows int64, which is 8EiB.
+func (n NullByteSize) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if n.Size > math.MaxInt64 {
+		return nil, fmt.Errorf("null: ByteSize %d overflows int64", n.Size)
+	}
+	return int64(n.Size), nil
+}
+
+// IsZero returns true for invalid NullByteSizes.
+func (n NullByteSize) IsZero() bool {
+	return !n.Valid
+}
+
+// ByteSize is a nullable size in bytes, such as a resource limit.
+// It parses human sizes such as "512k", "10MiB", or "1.5GB" (see ParseByteSize),
+// and encodes them as strings in the largest unit that is exact, such as "10MiB" or "1500MB", in JSON and text.
+// It does not consider 0 to be null.
+// It will decode to null, not zero, if null.
+type ByteSize struct {
+	NullByteSize
+}
+
+// NewByteSize creates a new ByteSize.
+func NewByteSize(size uint64, valid bool) ByteSize {
+	return ByteSize{
+		NullByteSize: NullByteSize{
+			Size:  size,
+			Valid: valid,
+		},
+	}
+}
+
+// ByteSizeFrom creates a new ByteSize that will always be valid.
+func ByteSizeFrom(size uint64) ByteSize {
+	return NewByteSize(size, true)
+}
+
+// ByteSizeFromPtr creates a new ByteSize that will be null if size is nil.
+func ByteSizeFromPtr(size *uint64) ByteSize {
+	if size == nil {
+		return NewByteSize(0, false)
+	}
+	return NewByteSize(*size, true)
+}
+
+// ParseByteSize creates a new ByteSize from a number of bytes with an optional unit, such as "512k", "10MiB", or "1.5GB".
+// Units are case-insensitive and may be separated from the number by spaces.
+// The SI units k or kB, M or MB, G or GB, T or TB, P or PB, and E or EB are powers of 1000,
+// and the IEC units Ki or KiB, Mi or MiB, and so on are powers of 1024.
+// Sizes with a fraction must be a whole number of bytes, such as "1.5KiB", but not "1.5" or "0.5B".
+// It returns an error if the size is negative, isn't a whole number of bytes, or overflows uint64.
+func ParseByteSize(s string) (ByteSize, error) {
+	size, err := parseByteSize(s)
+	if err != nil {
+		return ByteSize{}, fmt.Errorf("null: couldn't parse ByteSize: %w", err)
+	}
+	return ByteSizeFrom(size), nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (b ByteSize) ValueOrZero() uint64 {
+	if !b.Valid {
+		return 0
+	}
+	return b.Size
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports sizes as strings such as "10MiB", numbers of bytes, and null input.
+func (b *ByteSize) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(b, &err)
+	if bytes.Equal(data, nullBytes) {
+		b.Valid = false
+		return nil
+	}
+
+	var size uint64
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		var err error
+		if size, err = parseByteSize(str); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+	} else if err := json.Unmarshal(data, &size); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	b.Size = size
+	b.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this ByteSize is null, otherwise a string such as "10MiB".
+func (b ByteSize) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(formatByteSize(b.Size))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null ByteSize if the input is blank, "null", or DefaultNullText.
+func (b *ByteSize) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(b, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		b.Valid = false
+		return nil
+	}
+	size, err := parseByteSize(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	b.Size = size
+	b.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this ByteSize is null, otherwise a string such as "10MiB".
+func (b ByteSize) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return nullText(), nil
+	}
+	return []byte(formatByteSize(b.Size)), nil
+}
+
+// SetValid changes this ByteSize's value and also sets it to be non-null.
+func (b *ByteSize) SetValid(size uint64) {
+	b.Size = size
+	b.Valid = true
+}
+
+// SetNull sets this ByteSize to null, leaving its value as is. Use Reset to clear the value too.
+func (b *ByteSize) SetNull() {
+	b.Valid = false
+}
+
+// Reset sets this ByteSize to null and clears its value.
+func (b *ByteSize) Reset() {
+	*b = ByteSize{}
+}
+
+// Ptr returns a pointer to this ByteSize's value, or a nil pointer if this ByteSize is null.
+func (b ByteSize) Ptr() *uint64 {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Size
+}
+
+// SetPtr changes this ByteSize's value to the value v points to, or sets it to be null if v is nil, like ByteSizeFromPtr.
+func (b *ByteSize) SetPtr(v *uint64) {
+	*b = ByteSizeFromPtr(v)
+}
+
+// IsZero returns true for invalid ByteSizes.
+// A non-null ByteSize of 0 will not be considered zero.
+func (b ByteSize) IsZero() bool {
+	return !b.Valid
+}
+
+// IsZeroOrNull returns true for invalid ByteSizes, or valid ByteSizes with a 0 value.
+func (b ByteSize) IsZeroOrNull() bool {
+	return !b.Valid || b.Size == 0
+}
+
+// Equal returns true if both ByteSizes have the same value or are both null.
+func (b ByteSize) Equal(other ByteSize) bool {
+	return b.Valid == other.Valid && (!b.Valid || b.Size == other.Size)
+}
+
+// byteSizeUnits are the unit prefixes in increasing order, each 1000 or 1024 times the previous one.
+const byteSizeUnits = "kMGTPE"
+
+// formatByteSize formats size in the largest unit that divides it, preferring IEC units such as MiB.
+func formatByteSize(size uint64) string {
+	if size == 0 {
+		return "0B"
+	}
+	iec, iecExp := size, 0
+	for iec%1024 == 0 {
+		iec /= 1024
+		iecExp++
+	}
+	si, siExp := size, 0
+	for si%1000 == 0 {
+		si /= 1000
+		siExp++
+	}
+	switch {
+	case iecExp == 0 && siExp == 0:
+		return strconv.FormatUint(size, 10) + "B"
+	case iecExp >= siExp:
+		return strconv.FormatUint(iec, 10) + strings.ToUpper(byteSizeUnits[iecExp-1:iecExp]) + "iB"
+	}
+	return strconv.FormatUint(si, 10) + byteSizeUnits[siExp-1:siExp] + "B"
+}
+
+func parseByteSize(str string) (uint64, error) {
+	s := strings.TrimSpace(str)
+	end := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+	if end < 0 {
+		end = len(s)
+	}
+	number, unit := s[:end], strings.ToLower(strings.TrimSpace(s[end:]))
+
+	whole, fraction, _ := strings.Cut(number, ".")
+	if (whole == "" && fraction == "") || strings.Contains(fraction, ".") {
+		return 0, fmt.Errorf("invalid size: %q", str)
+	}
+
+	base, exp := uint64(1000), 0
+	unit = strings.TrimSuffix(unit, "b")
+	if u, ok := strings.CutSuffix(unit, "i"); ok && u != "" {
+		base, unit = 1024, u
+	}
+	if unit != "" {
+		exp = strings.IndexByte(strings.ToLower(byteSizeUnits), unit[0]) + 1
+		if exp == 0 || len(unit) != 1 {
+			return 0, fmt.Errorf("invalid size unit: %q", str)
+		}
+	}
+	multiplier := uint64(1)
+	for i := 0; i < exp; i++ {
+		multiplier *= base
+	}
+
+	var size uint64
+	if whole != "" {
+		n, err := strconv.ParseUint(whole, 10, 64)
+		hi, lo := bits.Mul64(n, multiplier)
+		if err != nil || hi != 0 {
+			return 0, fmt.Errorf("size out of range: %q", str)
+		}
+		size = lo
+	}
+	// scale the fraction to whole bytes exactly, from its last digit:
+	// each step is below 10 times multiplier, which fits in a uint64,
+	// and a remainder at any step means the size isn't a whole number of bytes
+	var part uint64
+	for i := len(fraction) - 1; i >= 0; i-- {
+		n := uint64(fraction[i]-'0')*multiplier + part
+		if n%10 != 0 {
+			return 0, fmt.Errorf("size is not a whole number of bytes: %q", str)
+		}
+		part = n / 10
+	}
+	size, carry := bits.Add64(size, part, 0)
+	if carry != 0 {
+		return 0, fmt.Errorf("size out of range: %q", str)
+	}
+	return size, nil
+}
@@ -0,0 +1,255 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+var (
+	byteSizeValue   uint64 = 10 << 20
+	byteSizeJSON           = []byte(`"10MiB"`)
+	byteSizeIntJSON        = []byte(`10485760`)
+)
+
+func TestByteSizeFrom(t *testing.T) {
+	assertByteSize(t, ByteSizeFrom(byteSizeValue), "ByteSizeFrom()")
+
+	zero := ByteSizeFrom(0)
+	if !zero.Valid {
+		t.Error("ByteSizeFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestByteSizeFromPtr(t *testing.T) {
+	v := byteSizeValue
+	assertByteSize(t, ByteSizeFromPtr(&v), "ByteSizeFromPtr()")
+	assertNullByteSize(t, ByteSizeFromPtr(nil), "ByteSizeFromPtr(nil)")
+}
+
+func TestParseByteSize(t *testing.T) {
+	for in, want := range map[string]uint64{
+		"0":                    0,
+		"512":                  512,
+		"512B":                 512,
+		"512k":                 512000,
+		"512 kB":               512000,
+		"512KiB":               512 << 10,
+		"512ki":                512 << 10,
+		"10MiB":                10 << 20,
+		"10mib":                10 << 20,
+		" 10 MiB ":             10 << 20,
+		"1.5GB":                1500000000,
+		"1.5GiB":               3 << 29,
+		".5k":                  500,
+		"1.":                   1,
+		"1.0":                  1,
+		"1.25KiB":              1280,
+		"0.333k":               333,
+		"0.001k":               1,
+		"2TB":                  2e12,
+		"1PiB":                 1 << 50,
+		"15EiB":                15 << 60,
+		"18EB":                 18e18,
+		"18446744073709551615": math.MaxUint64,
+		"15.75EiB":             63 << 58,
+	} {
+		b, err := ParseByteSize(in)
+		if err != nil {
+			t.Errorf("ParseByteSize(%q): unexpected error: %v", in, err)
+			continue
+		}
+		if !b.Valid || b.Size != want {
+			t.Errorf("ParseByteSize(%q): got %d, want %d", in, b.Size, want)
+		}
+	}
+
+	for _, bad := range []string{"", "B", ".", "1..5k", "1.2.3", "-1k", "10 MiBs", "10XB", "10i", "1z", "16EiB", "18446744073709551616", "19EB", "0x10",
+		"1.5", "0.5B", "1.9", "1.0001KiB", "0.3333333k", "0.0001k", "15.999999999999999999EiB"} {
+		if _, err := ParseByteSize(bad); err == nil {
+			t.Errorf("ParseByteSize(%q): expected error", bad)
+		}
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	for size, want := range map[uint64]string{
+		0:                  "0B",
+		1:                  "1B",
+		1000:               "1kB",
+		1024:               "1KiB",
+		1536:               "1536B",
+		512000:             "500KiB",
+		10 << 20:           "10MiB",
+		1500000000:         "1500MB",
+		1 << 60:            "1EiB",
+		1e18:               "1EB",
+		math.MaxUint64:     "18446744073709551615B",
+		1000 * 1024:        "1000KiB",
+		3 * 1000 * 1000:    "3MB",
+		1024 * 1000 * 1000: "1024MB",
+	} {
+		if got := formatByteSize(size); got != want {
+			t.Errorf("formatByteSize(%d): got %q, want %q", size, got, want)
+		}
+		if size, err := parseByteSize(want); err != nil || formatByteSize(size) != want {
+			t.Errorf("formatByteSize(%d): %q doesn't round trip: %v", size, want, err)
+		}
+	}
+}
+
+func TestUnmarshalByteSize(t *testing.T) {
+	var b ByteSize
+	err := json.Unmarshal(byteSizeJSON, &b)
+	maybePanic(err)
+	assertByteSize(t, b, "string json")
+
+	var n ByteSize
+	err = json.Unmarshal(byteSizeIntJSON, &n)
+	maybePanic(err)
+	assertByteSize(t, n, "number json")
+
+	var null ByteSize
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullByteSize(t, null, "null json")
+
+	for _, bad := range [][]byte{[]byte(`"10QB"`), []byte(`""`), []byte(`-1`), floatJSON, boolJSON, invalidJSON} {
+		var invalid ByteSize
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullByteSize(t, invalid, "invalid json")
+	}
+}
+
+func TestMarshalByteSize(t *testing.T) {
+	b := ByteSizeFrom(byteSizeValue)
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(byteSizeJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(ByteSizeFrom(0))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0B"`, "zero json marshal")
+
+	null := NewByteSize(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestByteSizeText(t *testing.T) {
+	b := ByteSizeFrom(1500000000)
+	data, err := b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "1500MB", "text marshal")
+
+	var text ByteSize
+	err = text.UnmarshalText([]byte("10 MiB"))
+	maybePanic(err)
+	assertByteSize(t, text, "text unmarshal")
+
+	var blank ByteSize
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullByteSize(t, blank, "blank text unmarshal")
+
+	null := NewByteSize(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var bad ByteSize
+	if err := bad.UnmarshalText([]byte("ten MiB")); err == nil {
+		t.Error("expected error unmarshaling invalid text")
+	}
+}
+
+func TestByteSizeScanValue(t *testing.T) {
+	for _, in := range []interface{}{int64(10485760), float64(10485760), "10MiB", []byte("10240KiB")} {
+		var b ByteSize
+		err := b.Scan(in)
+		maybePanic(err)
+		assertByteSize(t, b, "scanned")
+	}
+
+	var null ByteSize
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullByteSize(t, null, "scanned null")
+
+	for _, bad := range []interface{}{int64(-1), "x", 1.5, -1.0, true} {
+		var invalid ByteSize
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %v", bad)
+		}
+		assertNullByteSize(t, invalid, "invalid scan")
+	}
+
+	v, err := ByteSizeFrom(byteSizeValue).Value()
+	maybePanic(err)
+	if v != int64(byteSizeValue) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+	if _, err := ByteSizeFrom(math.MaxInt64 + 1).Value(); err == nil {
+		t.Error("expected error for Value() overflowing int64")
+	}
+}
+
+func TestByteSizePointer(t *testing.T) {
+	b := ByteSizeFrom(byteSizeValue)
+	if ptr := b.Ptr(); ptr == nil || *ptr != byteSizeValue {
+		t.Errorf("bad pointer: %v", ptr)
+	}
+	if NewByteSize(0, false).Ptr() != nil {
+		t.Error("null Ptr() should be nil")
+	}
+	if b.ValueOrZero() != byteSizeValue || NewByteSize(byteSizeValue, false).ValueOrZero() != 0 {
+		t.Error("bad ValueOrZero()")
+	}
+}
+
+func TestByteSizeSetValid(t *testing.T) {
+	change := NewByteSize(0, false)
+	assertNullByteSize(t, change, "SetValid()")
+	change.SetValid(byteSizeValue)
+	assertByteSize(t, change, "SetValid()")
+}
+
+func TestByteSizeEqual(t *testing.T) {
+	if !ByteSizeFrom(1024).Equal(ByteSizeFrom(1024)) || !NewByteSize(1, false).Equal(NewByteSize(2, false)) {
+		t.Error("ByteSizes should be equal")
+	}
+	if ByteSizeFrom(1024).Equal(ByteSizeFrom(1000)) || ByteSizeFrom(0).Equal(NewByteSize(0, false)) {
+		t.Error("ByteSizes should not be equal")
+	}
+	if ByteSizeFrom(0).IsZero() || !NewByteSize(0, false).IsZero() {
+		t.Error("bad IsZero()")
+	}
+	if !ByteSizeFrom(0).IsZeroOrNull() || ByteSizeFrom(1).IsZeroOrNull() {
+		t.Error("bad IsZeroOrNull()")
+	}
+}
+
+func assertByteSize(t *testing.T, b ByteSize, from string) {
+	t.Helper()
+	if b.Size != byteSizeValue {
+		t.Errorf("bad %s byte size: %d ≠ %d\n", from, b.Size, byteSizeValue)
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullByteSize(t *testing.T, b ByteSize, from string) {
+	t.Helper()
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,148 @@
+package null
+
+import (
+	"math/big"
+	"reflect"
+	"regexp"
+	"unsafe"
+)
+
+// Clone returns a deep copy of v, such as a struct with fields of this package's types,
+// so that changing the bytes of a Bytes, the URL of a URL, or the elements of a Slice or Map
+// in the copy doesn't change v, and vice versa. This is useful when caching values that callers may modify.
+//
+// Pointers, slices, maps, interfaces, and the exported fields of structs are copied recursively,
+// and pointer cycles are preserved. *big.Int, *big.Float, and *big.Rat values are copied with their Set methods.
+// Map keys, unexported fields, and values that are safe to share, such as *regexp.Regexp, funcs, and channels,
+// are copied as is, so types that keep references in unexported fields are only copied shallowly.
+func Clone[T any](v T) T {
+	var out T
+	c := cloner{seen: make(map[clonedRef]reflect.Value)}
+	c.copy(reflect.ValueOf(&out).Elem(), reflect.ValueOf(&v).Elem())
+	return out
+}
+
+// cloner deep copies values for Clone.
+type cloner struct {
+	// seen maps pointers and maps that have been copied to their copies, to preserve cycles and aliasing.
+	seen map[clonedRef]reflect.Value
+}
+
+// clonedRef identifies a pointer or map that has been copied.
+type clonedRef struct {
+	ptr unsafe.Pointer
+	typ reflect.Type
+}
+
+// copy sets dst, which must be settable and the zero value, to a deep copy of src.
+func (c cloner) copy(dst, src reflect.Value) {
+	if shallow(src.Type()) {
+		dst.Set(src)
+		return
+	}
+
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		ref := clonedRef{src.UnsafePointer(), src.Type()}
+		if p, ok := c.seen[ref]; ok {
+			dst.Set(p)
+			return
+		}
+		if p, ok := clonePointer(src); ok {
+			dst.Set(p)
+			return
+		}
+		p := reflect.New(src.Type().Elem())
+		c.seen[ref] = p
+		c.copy(p.Elem(), src.Elem())
+		dst.Set(p)
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		elem := reflect.New(src.Elem().Type()).Elem()
+		c.copy(elem, src.Elem())
+		dst.Set(elem)
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		s := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			c.copy(s.Index(i), src.Index(i))
+		}
+		dst.Set(s)
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			c.copy(dst.Index(i), src.Index(i))
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		ref := clonedRef{src.UnsafePointer(), src.Type()}
+		if m, ok := c.seen[ref]; ok {
+			dst.Set(m)
+			return
+		}
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		c.seen[ref] = m
+		iter := src.MapRange()
+		for iter.Next() {
+			v := reflect.New(src.Type().Elem()).Elem()
+			c.copy(v, iter.Value())
+			m.SetMapIndex(iter.Key(), v)
+		}
+		dst.Set(m)
+	case reflect.Struct:
+		// copy unexported fields as is, since they can't be set
+		dst.Set(src)
+		typ := src.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			if f := typ.Field(i); f.IsExported() && !shallow(f.Type) {
+				field := dst.Field(i)
+				field.SetZero()
+				c.copy(field, src.Field(i))
+			}
+		}
+	default:
+		dst.Set(src)
+	}
+}
+
+// clonePointer copies pointers to types whose values can't be copied field by field,
+// and returns pointers to values that are safe to share as is.
+func clonePointer(src reflect.Value) (reflect.Value, bool) {
+	switch p := src.Interface().(type) {
+	case *big.Int:
+		return reflect.ValueOf(new(big.Int).Set(p)), true
+	case *big.Float:
+		return reflect.ValueOf(new(big.Float).Copy(p)), true
+	case *big.Rat:
+		return reflect.ValueOf(new(big.Rat).Set(p)), true
+	case *regexp.Regexp:
+		return src, true
+	}
+	return reflect.Value{}, false
+}
+
+// shallow returns true if values of typ don't reference other memory that Clone would copy,
+// so they can be copied by assignment.
+func shallow(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		return false
+	case reflect.Array:
+		return typ.Len() == 0 || shallow(typ.Elem())
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if f := typ.Field(i); f.IsExported() && !shallow(f.Type) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package null
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	type cached struct {
+		Bytes  Bytes
+		URL    URL
+		BigInt BigInt
+		Slice  Slice[int]
+		Map    Map[string, int]
+		Any    Any
+		Ptr    *Int
+		Self   *cached
+	}
+	n := IntFrom(1)
+	in := cached{
+		Bytes:  BytesFrom([]byte("hello")),
+		URL:    MustURL("https://example.com/a"),
+		BigInt: BigIntFrom(big.NewInt(42)),
+		Slice:  SliceFrom([]int{1, 2}),
+		Map:    MapFrom(map[string]int{"a": 1}),
+		Any:    AnyFrom([]string{"x"}),
+		Ptr:    &n,
+	}
+	in.Self = &in
+
+	out := Clone(in)
+	if !out.Bytes.Equal(in.Bytes) || !out.URL.Equal(in.URL) || !out.BigInt.Equal(in.BigInt) ||
+		!reflect.DeepEqual(out.Slice, in.Slice) || !reflect.DeepEqual(out.Map, in.Map) || !reflect.DeepEqual(out.Any, in.Any) {
+		t.Fatalf("clone isn't equal: %+v", out)
+	}
+	if out.Self == &in || out.Self.Self != out.Self {
+		t.Error("cycle wasn't preserved")
+	}
+
+	out.Bytes.Bytes[0] = 'j'
+	out.URL.URL.Path = "/b"
+	out.BigInt.BigInt.SetInt64(0)
+	out.Slice.V[0] = 100
+	out.Map.V["a"] = 100
+	out.Any.Any.([]string)[0] = "y"
+	out.Ptr.SetValid(100)
+	if string(in.Bytes.Bytes) != "hello" || in.URL.URL.Path != "/a" || in.BigInt.BigInt.Int64() != 42 ||
+		in.Slice.V[0] != 1 || in.Map.V["a"] != 1 || in.Any.Any.([]string)[0] != "x" || in.Ptr.Int64 != 1 {
+		t.Errorf("changing the clone changed the original: %+v", in)
+	}
+}
+
+func TestCloneNull(t *testing.T) {
+	if b := Clone(NewBytes([]byte{}, true)); b.Bytes == nil || !b.Valid {
+		t.Error("empty Bytes should stay non-nil")
+	}
+	if b := Clone(Bytes{}); b.Bytes != nil || b.Valid {
+		t.Error("null Bytes should stay nil")
+	}
+	if v := Clone[interface{}](nil); v != nil {
+		t.Errorf("nil should stay nil: %v", v)
+	}
+}
+
+func TestAllTypesClone(t *testing.T) {
+	in := exampleAllTypes()
+	out := Clone(in)
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("clone isn't equal:\n got: %+v\nwant: %+v", out, in)
+	}
+	if out.Regexp.Regexp != in.Regexp.Regexp {
+		t.Error("Regexp should be shared")
+	}
+	if &out.Bytes.Bytes[0] == &in.Bytes.Bytes[0] || out.URL.URL == in.URL.URL || out.BigInt.BigInt == in.BigInt.BigInt {
+		t.Error("clone shares memory with the original")
+	}
+}
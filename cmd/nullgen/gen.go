@@ -0,0 +1,244 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// nullType is the type from the null package that a wrapper delegates to, and the type of its value.
+type nullType struct {
+	name  string
+	value string
+}
+
+// nullTypes maps the supported underlying types to their null types.
+var nullTypes = map[string]nullType{
+	"bool":    {"Bool", "bool"},
+	"float32": {"Float", "float64"},
+	"float64": {"Float", "float64"},
+	"int":     {"Int", "int64"},
+	"int8":    {"Int8", "int8"},
+	"int16":   {"Int16", "int16"},
+	"int32":   {"Int32", "int32"},
+	"rune":    {"Int32", "int32"},
+	"int64":   {"Int", "int64"},
+	"string":  {"String", "string"},
+	"uint":    {"Uint", "uint64"},
+	"uint8":   {"Uint8", "uint8"},
+	"byte":    {"Uint8", "uint8"},
+	"uint16":  {"Uint16", "uint16"},
+	"uint32":  {"Uint32", "uint32"},
+	"uint64":  {"Uint", "uint64"},
+}
+
+// generate returns the formatted source of a wrapper called name for the type typeName declared in dir.
+func generate(dir, typeName, name string) ([]byte, error) {
+	pkg, underlying, err := findType(dir, typeName)
+	if err != nil {
+		return nil, err
+	}
+	nt, ok := nullTypes[underlying]
+	if !ok {
+		return nil, fmt.Errorf("type %s has underlying type %s, which isn't supported", typeName, underlying)
+	}
+
+	var buf bytes.Buffer
+	err = wrapperTemplate.Execute(&buf, map[string]string{
+		"Package": pkg,
+		"Type":    typeName,
+		"Name":    name,
+		"Null":    nt.name,
+		"Value":   nt.value,
+	})
+	if err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("couldn't format generated code: %w", err)
+	}
+	return src, nil
+}
+
+// findType returns the package name of the non-test Go files in dir and the underlying type of typeName,
+// following named types declared in the same package.
+func findType(dir, typeName string) (pkg, underlying string, err error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", "", err
+	}
+	fset := token.NewFileSet()
+	decls := make(map[string]ast.Expr)
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return "", "", err
+		}
+		f, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			return "", "", err
+		}
+		pkg = f.Name.Name
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if ts.TypeParams == nil {
+					decls[ts.Name.Name] = ts.Type
+				}
+			}
+		}
+	}
+
+	name := typeName
+	for seen := map[string]bool{}; !seen[name]; {
+		seen[name] = true
+		expr, ok := decls[name]
+		if !ok {
+			if name == typeName {
+				return "", "", fmt.Errorf("type %s not found in %s", typeName, dir)
+			}
+			return pkg, name, nil
+		}
+		ident, ok := expr.(*ast.Ident)
+		if !ok {
+			return "", "", fmt.Errorf("type %s has underlying type %s, which isn't supported", typeName, types.ExprString(expr))
+		}
+		name = ident.Name
+	}
+	return "", "", fmt.Errorf("type %s has an invalid recursive definition", typeName)
+}
+
+var wrapperTemplate = template.Must(template.New("wrapper").Parse(`// Code generated by nullgen -type {{.Type}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"database/sql/driver"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// {{.Name}} is a nullable {{.Type}}.
+// It behaves like null.{{.Null}}: it will decode to null, not zero, if null, and zero input won't make it null.
+type {{.Name}} struct {
+	{{.Type}} {{.Type}}
+	Valid bool // Valid is true if {{.Type}} is not NULL
+}
+
+// New{{.Name}} creates a new {{.Name}}.
+func New{{.Name}}(v {{.Type}}, valid bool) {{.Name}} {
+	return {{.Name}}{ {{- .Type}}: v, Valid: valid}
+}
+
+// {{.Name}}From creates a new {{.Name}} that will always be valid.
+func {{.Name}}From(v {{.Type}}) {{.Name}} {
+	return New{{.Name}}(v, true)
+}
+
+// {{.Name}}FromPtr creates a new {{.Name}} that will be null if v is nil.
+func {{.Name}}FromPtr(v *{{.Type}}) {{.Name}} {
+	if v == nil {
+		return {{.Name}}{}
+	}
+	return New{{.Name}}(*v, true)
+}
+
+// nullValue returns n as a null.{{.Null}}.
+func (n {{.Name}}) nullValue() null.{{.Null}} {
+	return null.New{{.Null}}({{.Value}}(n.{{.Type}}), n.Valid)
+}
+
+// set sets n to v.
+func (n *{{.Name}}) set(v null.{{.Null}}) {
+	x, ok := v.Get()
+	n.{{.Type}}, n.Valid = {{.Type}}(x), ok
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n {{.Name}}) ValueOrZero() {{.Type}} {
+	if !n.Valid {
+		var zero {{.Type}}
+		return zero
+	}
+	return n.{{.Type}}
+}
+
+// Ptr returns a pointer to this {{.Name}}'s value, or a nil pointer if this {{.Name}} is null.
+func (n {{.Name}}) Ptr() *{{.Type}} {
+	if !n.Valid {
+		return nil
+	}
+	return &n.{{.Type}}
+}
+
+// Scan implements the sql.Scanner interface, like null.{{.Null}}.
+func (n *{{.Name}}) Scan(value interface{}) error {
+	var v null.{{.Null}}
+	err := v.Scan(value)
+	n.set(v)
+	return err
+}
+
+// Value implements the driver.Valuer interface.
+func (n {{.Name}}) Value() (driver.Value, error) {
+	return n.nullValue().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, like null.{{.Null}}.
+func (n *{{.Name}}) UnmarshalJSON(data []byte) error {
+	var v null.{{.Null}}
+	if err := v.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.set(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this {{.Name}} is null.
+func (n {{.Name}}) MarshalJSON() ([]byte, error) {
+	return n.nullValue().MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, like null.{{.Null}}.
+func (n *{{.Name}}) UnmarshalText(text []byte) error {
+	var v null.{{.Null}}
+	if err := v.UnmarshalText(text); err != nil {
+		return err
+	}
+	n.set(v)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode null.DefaultNullText, blank by default, if this {{.Name}} is null.
+func (n {{.Name}}) MarshalText() ([]byte, error) {
+	return n.nullValue().MarshalText()
+}
+
+// IsZero returns true if this {{.Name}} is null, for omitzero and similar.
+func (n {{.Name}}) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if this {{.Name}} and other have the same value or are both null.
+func (n {{.Name}}) Equal(other {{.Name}}) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.{{.Type}} == other.{{.Type}})
+}
+`))
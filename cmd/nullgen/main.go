@@ -0,0 +1,67 @@
+// Command nullgen generates a nullable wrapper for a named type with an integer, float, string, or bool underlying type,
+// such as a domain ID, so that it can be used like the types in gopkg.in/guregu/null.v4.
+// The wrapper implements sql.Scanner, driver.Valuer, json.Marshaler, json.Unmarshaler,
+// encoding.TextMarshaler, and encoding.TextUnmarshaler, and has Equal, IsZero, ValueOrZero, and Ptr methods
+// and constructors, all behaving like the null type for its underlying type, such as null.Uint for uint64.
+//
+// For example, given
+//
+//	//go:generate nullgen -type OrderID
+//	type OrderID uint64
+//
+// go generate writes orderid_null.go, declaring
+//
+//	type NullOrderID struct {
+//		OrderID OrderID
+//		Valid   bool
+//	}
+//
+// Usage:
+//
+//	nullgen -type T [-name NullT] [-output t_null.go] [dir]
+//
+// The type is looked up in the non-test Go files of dir, which defaults to the current directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeName := flag.String("type", "", "the named type to generate a wrapper for; required")
+	name := flag.String("name", "", "the name of the wrapper type; default Null<type>")
+	output := flag.String("output", "", "the output file name; default <type>_null.go in lower case, in dir")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: nullgen -type T [-name NullT] [-output t_null.go] [dir]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeName == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+	if *name == "" {
+		*name = "Null" + *typeName
+	}
+	if *output == "" {
+		*output = filepath.Join(dir, strings.ToLower(*typeName)+"_null.go")
+	}
+
+	src, err := generate(dir, *typeName, *name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "nullgen: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "nullgen: %v\n", err)
+		os.Exit(1)
+	}
+}
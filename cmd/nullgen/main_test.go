@@ -0,0 +1,80 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTestPackage(t *testing.T, src string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "orders.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestGenerate(t *testing.T) {
+	dir := writeTestPackage(t, `package orders
+
+type OrderID uint64
+
+type LegacyID OrderID
+
+type Status string
+`)
+	for _, tc := range []struct {
+		typ, null string
+	}{
+		{"OrderID", "null.Uint"},
+		{"LegacyID", "null.Uint"},
+		{"Status", "null.String"},
+	} {
+		src, err := generate(dir, tc.typ, "Null"+tc.typ)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.typ, err)
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+		if err != nil {
+			t.Fatalf("%s: generated code doesn't parse: %v\n%s", tc.typ, err, src)
+		}
+		if f.Name.Name != "orders" {
+			t.Errorf("%s: bad package: %s", tc.typ, f.Name.Name)
+		}
+		for _, want := range []string{
+			"type Null" + tc.typ + " struct",
+			"func (n *Null" + tc.typ + ") Scan(value interface{}) error",
+			"func (n Null" + tc.typ + ") Value() (driver.Value, error)",
+			"func (n *Null" + tc.typ + ") UnmarshalJSON(data []byte) error",
+			"func (n Null" + tc.typ + ") MarshalText() ([]byte, error)",
+			"func (n Null" + tc.typ + ") Equal(other Null" + tc.typ + ") bool",
+			"var v " + tc.null,
+		} {
+			if !strings.Contains(string(src), want) {
+				t.Errorf("%s: generated code is missing %q:\n%s", tc.typ, want, src)
+			}
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	dir := writeTestPackage(t, `package orders
+
+import "time"
+
+type Created time.Time
+
+type Tags []string
+
+type Loop Loop
+`)
+	for _, typ := range []string{"Created", "Tags", "Loop", "Missing"} {
+		if _, err := generate(dir, typ, "Null"+typ); err == nil {
+			t.Errorf("%s: expected error", typ)
+		}
+	}
+}
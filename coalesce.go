@@ -0,0 +1,19 @@
+package null
+
+// Coalesce returns the first of values that is not zero according to its IsZero method,
+// or the zero value if there is none, like SQL's COALESCE.
+// It can be used with any of this package's types, such as null.Coalesce(fromRequest, fromConfig, fromEnv),
+// as well as the zero and nulldecimal types.
+//
+// For most types, IsZero is true for null values, so Coalesce returns the first valid value.
+// For the zero package, zero values are skipped too.
+// An Optional is only zero if it is unset, so an explicit null is returned instead of falling through to later values.
+func Coalesce[T interface{ IsZero() bool }](values ...T) T {
+	for _, v := range values {
+		if !v.IsZero() {
+			return v
+		}
+	}
+	var zero T
+	return zero
+}
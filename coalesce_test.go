@@ -0,0 +1,30 @@
+package null
+
+import "testing"
+
+func TestCoalesce(t *testing.T) {
+	if got := Coalesce(NewUint(1, false), UintFrom(0), UintFrom(2)); !got.Equal(UintFrom(0)) {
+		t.Errorf("Coalesce(): got %v, want 0", got)
+	}
+	if got := Coalesce(NewString("", false), StringFrom("config"), StringFrom("env")); !got.Equal(StringFrom("config")) {
+		t.Errorf("Coalesce(): got %v, want config", got)
+	}
+	if got := Coalesce(Bool{}, Bool{}); got.Valid {
+		t.Errorf("Coalesce() of nulls: got %v", got)
+	}
+	if got := Coalesce[Int](); got.Valid {
+		t.Errorf("Coalesce() of nothing: got %v", got)
+	}
+	if got := Coalesce(Money{}, MoneyFrom(100, "USD")); !got.Equal(MoneyFrom(100, "USD")) {
+		t.Errorf("Coalesce() of Money: got %v", got)
+	}
+
+	// an explicit null Optional wins over later values
+	var unset Optional[int]
+	if got := Coalesce(unset, OptionalNull[int](), OptionalFrom(3)); !got.IsNull() {
+		t.Errorf("Coalesce() of Optionals: got %v, want null", got)
+	}
+	if got := Coalesce(unset, OptionalFrom(3)); !got.Equal(OptionalFrom(3)) {
+		t.Errorf("Coalesce() of Optionals: got %v, want 3", got)
+	}
+}
@@ -0,0 +1,263 @@
+package null
+
+import (
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// allTypes has a field of every type, for testing encodings.
+type allTypes struct {
+	Addr         Addr
+	Any          Any
+	Base64       Base64
+	BigInt       BigInt
+	Bool         Bool
+	ByteSize     ByteSize
+	Bytes        Bytes
+	Complex      Complex
+	CountryCode  CountryCode
+	CurrencyCode CurrencyCode
+	Date         Date
+	Duration     Duration
+	Email        Email
+	Enum         Enum[testState]
+	FileMode     FileMode
+	FixedHex     FixedHex[[4]byte]
+	Flags        Flags[uint8]
+	Float        Float
+	HardwareAddr HardwareAddr
+	Hex          Hex
+	Int          Int
+	Int8         Int8
+	Int16        Int16
+	Int32        Int32
+	Int64Range   Int64Range
+	JSON         JSON
+	KSUID        KSUID
+	LanguageTag  LanguageTag
+	Map          Map[string, int]
+	Money        Money
+	Optional     Optional[string]
+	Percent      Percent
+	Point        Point
+	Prefix       Prefix
+	Regexp       Regexp
+	Semver       Semver
+	Slice        Slice[int]
+	String       String
+	Time         Time
+	TimeOfDay    TimeOfDay
+	TimeRange    TimeRange
+	Uint         Uint
+	Uint8        Uint8
+	Uint16       Uint16
+	Uint32       Uint32
+	ULID         ULID
+	UnixMillis   UnixMillis
+	UnixSeconds  UnixSeconds
+	URL          URL
+	Value        Value[int]
+}
+
+// exampleAllTypes returns an allTypes with every field set to a valid value.
+func exampleAllTypes() allTypes {
+	// some encodings only have millisecond precision
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123000000, time.UTC)
+	mac, _ := net.ParseMAC("00:00:5e:00:53:01")
+	return allTypes{
+		Addr:         AddrFrom(netip.MustParseAddr("192.0.2.1")),
+		Any:          AnyFrom("hello"),
+		Base64:       Base64From([]byte("hello")),
+		BigInt:       BigIntFrom(big.NewInt(1234567890)),
+		Bool:         BoolFrom(true),
+		ByteSize:     ByteSizeFrom(10 << 20),
+		Bytes:        BytesFrom([]byte("hello")),
+		Complex:      ComplexFrom(1 + 2i),
+		CountryCode:  must(ParseCountryCode("JP")),
+		CurrencyCode: must(ParseCurrencyCode("JPY")),
+		Date:         DateFrom(now),
+		Duration:     DurationFrom(90 * time.Second),
+		Email:        must(ParseEmail("gopher@example.com")),
+		Enum:         EnumFrom(testStateOpen),
+		FileMode:     FileModeFrom(0644),
+		FixedHex:     FixedHexFrom([4]byte{1, 2, 3, 4}),
+		Flags:        FlagsFrom[uint8](5),
+		Float:        FloatFrom(1.5),
+		HardwareAddr: HardwareAddrFrom(mac),
+		Hex:          HexFrom([]byte{0xde, 0xad}),
+		Int:          IntFrom(-12345),
+		Int8:         Int8From(-8),
+		Int16:        Int16From(-16),
+		Int32:        Int32From(-32),
+		Int64Range:   Int64RangeFrom(1, 5),
+		JSON:         JSONFrom([]byte(`{"a":1}`)),
+		KSUID:        KSUIDFrom(ksuidValue),
+		LanguageTag:  must(ParseLanguageTag("en-US")),
+		Map:          MapFrom(map[string]int{"a": 1}),
+		Money:        MoneyFrom(1050, "USD"),
+		Optional:     OptionalFrom("hi"),
+		Percent:      PercentFrom(0.125),
+		Point:        PointFrom(35.5, 139.75),
+		Prefix:       PrefixFrom(netip.MustParsePrefix("192.0.2.0/24")),
+		Regexp:       RegexpFrom(regexp.MustCompile("^a+$")),
+		Semver:       MustSemver("1.2.3"),
+		Slice:        SliceFrom([]int{1, 2, 3}),
+		String:       StringFrom("hello"),
+		Time:         TimeFrom(now),
+		TimeOfDay:    TimeOfDayFrom(now),
+		TimeRange:    TimeRangeFrom(now, now.Add(time.Hour)),
+		Uint:         UintFrom(12345),
+		Uint8:        Uint8From(8),
+		Uint16:       Uint16From(16),
+		Uint32:       Uint32From(32),
+		ULID:         ULIDFrom(ulidValue),
+		UnixMillis:   UnixMillisFrom(now),
+		UnixSeconds:  UnixSecondsFrom(now.Truncate(time.Second)),
+		URL:          MustURL("https://example.com/a?b=c"),
+		Value:        ValueFrom(42),
+	}
+}
+
+func TestAllTypesJSON(t *testing.T) {
+	in := exampleAllTypes()
+	data, err := json.Marshal(in)
+	maybePanic(err)
+	var out allTypes
+	err = json.Unmarshal(data, &out)
+	maybePanic(err)
+	assertAllTypesEqual(t, out, in, "json round trip")
+}
+
+type zeroer interface {
+	IsZero() bool
+	IsZeroOrNull() bool
+}
+
+func TestAllTypesIsZero(t *testing.T) {
+	example := reflect.ValueOf(exampleAllTypes())
+	typ := example.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		null, ok := reflect.Zero(f.Type).Interface().(zeroer)
+		if !ok {
+			t.Errorf("%s: doesn't implement IsZero and IsZeroOrNull", f.Name)
+			continue
+		}
+		if !null.IsZero() || !null.IsZeroOrNull() {
+			t.Errorf("%s: null value should be zero", f.Name)
+		}
+		valid := example.Field(i).Interface().(zeroer)
+		if valid.IsZero() || valid.IsZeroOrNull() {
+			t.Errorf("%s: %v should not be zero", f.Name, valid)
+		}
+
+		// a valid zero value is only zero for IsZeroOrNull
+		zero := reflect.New(f.Type).Elem()
+		zero.FieldByName("Valid").SetBool(true)
+		if present := zero.FieldByName("Present"); present.IsValid() {
+			present.SetBool(true)
+		}
+		if z := zero.Interface().(zeroer); z.IsZero() {
+			t.Errorf("%s: valid zero value should not be IsZero", f.Name)
+		} else if !z.IsZeroOrNull() && f.Name != "Int64Range" && f.Name != "TimeRange" {
+			t.Errorf("%s: valid zero value should be IsZeroOrNull", f.Name)
+		}
+
+		// this package's embedded structs like NullUint64 should also support omitzero
+		for j := 0; j < f.Type.NumField(); j++ {
+			embed := f.Type.Field(j)
+			if embed.Anonymous && embed.Type.PkgPath() == typ.PkgPath() && strings.HasPrefix(embed.Name, "Null") {
+				if _, ok := reflect.Zero(embed.Type).Interface().(interface{ IsZero() bool }); !ok {
+					t.Errorf("%s: %s doesn't implement IsZero", f.Name, embed.Name)
+				}
+			}
+		}
+	}
+
+	// null values are omitted with omitzero
+	type omit struct {
+		Int   Int        `json:",omitzero"`
+		Uint  Uint       `json:",omitzero"`
+		Slice Slice[int] `json:",omitzero"`
+	}
+	data, err := json.Marshal(omit{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "{}", "omitzero null")
+	data, err = json.Marshal(omit{Int: IntFrom(0), Uint: UintFrom(0), Slice: SliceFrom([]int{})})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Int":0,"Uint":0,"Slice":[]}`, "omitzero valid")
+}
+
+func TestAllTypesSetNull(t *testing.T) {
+	example := reflect.ValueOf(exampleAllTypes())
+	typ := example.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		v := reflect.New(f.Type)
+		v.Elem().Set(example.Field(i))
+		setNull := v.MethodByName("SetNull")
+		if !setNull.IsValid() {
+			t.Errorf("%s: doesn't implement SetNull", f.Name)
+			continue
+		}
+		setNull.Call(nil)
+		if v.Elem().FieldByName("Valid").Bool() {
+			t.Errorf("%s: should be null after SetNull", f.Name)
+		}
+
+		// Optional has Unset instead
+		if f.Name == "Optional" {
+			continue
+		}
+		v.Elem().Set(example.Field(i))
+		v.MethodByName("Reset").Call(nil)
+		if !v.Elem().IsZero() {
+			t.Errorf("%s: should be the zero value after Reset, got %v", f.Name, v.Elem())
+		}
+	}
+}
+
+func TestAllTypesSetPtr(t *testing.T) {
+	example := reflect.ValueOf(exampleAllTypes())
+	typ := example.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		valid := example.Field(i)
+		v := reflect.New(f.Type)
+		setPtr := v.MethodByName("SetPtr")
+		ptr := valid.MethodByName("Ptr")
+		if !setPtr.IsValid() || !ptr.IsValid() {
+			continue
+		}
+		setPtr.Call(ptr.Call(nil))
+		if !v.Elem().MethodByName("Equal").Call([]reflect.Value{valid})[0].Bool() {
+			t.Errorf("%s: SetPtr(Ptr()) should be equal, got %v", f.Name, v.Elem())
+		}
+		setPtr.Call([]reflect.Value{reflect.Zero(setPtr.Type().In(0))})
+		if v.Elem().FieldByName("Valid").Bool() {
+			t.Errorf("%s: SetPtr(nil) should be null", f.Name)
+		}
+	}
+}
+
+func must[T any](v T, err error) T {
+	maybePanic(err)
+	return v
+}
+
+// assertAllTypesEqual compares got and want by their JSON encodings.
+func assertAllTypesEqual(t *testing.T, got, want allTypes, from string) {
+	t.Helper()
+	gotJSON, err := json.Marshal(got)
+	maybePanic(err)
+	wantJSON, err := json.Marshal(want)
+	maybePanic(err)
+	assertJSONEquals(t, gotJSON, string(wantJSON), from)
+}
@@ -0,0 +1,291 @@
+package null
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// The column codecs encode a slice of values as two fixed-size buffers, laid out like Apache Arrow's:
+//
+//   - a validity bitmap, with one bit per value in least significant bit order,
+//     set if the value is valid and clear if it is null
+//   - the values, little-endian, each taking the same number of bytes; null values are zeroed
+//
+// Bool values are packed into a bitmap the same way.
+// Time is stored as nanoseconds since the Unix epoch in UTC, so it is limited to the years 1678 to 2262.
+// Date is stored as days since the Unix epoch, and Duration as nanoseconds.
+//
+// Decoding accepts a nil validity bitmap, meaning that every value is valid.
+// The number of values is taken from the length of the values buffer.
+
+// EncodeIntColumn encodes col as a validity bitmap and 8-byte values.
+func EncodeIntColumn(col []Int) (validity, values []byte) {
+	return encodeColumn(col, 8, func(b []byte, v Int) bool {
+		binary.LittleEndian.PutUint64(b, uint64(v.Int64))
+		return v.Valid
+	})
+}
+
+// DecodeIntColumn decodes a column encoded by EncodeIntColumn.
+func DecodeIntColumn(validity, values []byte) ([]Int, error) {
+	return decodeColumn(validity, values, 8, "Int", func(b []byte, valid bool) Int {
+		return NewInt(int64(binary.LittleEndian.Uint64(b)), valid)
+	})
+}
+
+// EncodeInt8Column encodes col as a validity bitmap and 1-byte values.
+func EncodeInt8Column(col []Int8) (validity, values []byte) {
+	return encodeColumn(col, 1, func(b []byte, v Int8) bool {
+		b[0] = byte(v.Int8)
+		return v.Valid
+	})
+}
+
+// DecodeInt8Column decodes a column encoded by EncodeInt8Column.
+func DecodeInt8Column(validity, values []byte) ([]Int8, error) {
+	return decodeColumn(validity, values, 1, "Int8", func(b []byte, valid bool) Int8 {
+		return NewInt8(int8(b[0]), valid)
+	})
+}
+
+// EncodeInt16Column encodes col as a validity bitmap and 2-byte values.
+func EncodeInt16Column(col []Int16) (validity, values []byte) {
+	return encodeColumn(col, 2, func(b []byte, v Int16) bool {
+		binary.LittleEndian.PutUint16(b, uint16(v.Int16))
+		return v.Valid
+	})
+}
+
+// DecodeInt16Column decodes a column encoded by EncodeInt16Column.
+func DecodeInt16Column(validity, values []byte) ([]Int16, error) {
+	return decodeColumn(validity, values, 2, "Int16", func(b []byte, valid bool) Int16 {
+		return NewInt16(int16(binary.LittleEndian.Uint16(b)), valid)
+	})
+}
+
+// EncodeInt32Column encodes col as a validity bitmap and 4-byte values.
+func EncodeInt32Column(col []Int32) (validity, values []byte) {
+	return encodeColumn(col, 4, func(b []byte, v Int32) bool {
+		binary.LittleEndian.PutUint32(b, uint32(v.Int32))
+		return v.Valid
+	})
+}
+
+// DecodeInt32Column decodes a column encoded by EncodeInt32Column.
+func DecodeInt32Column(validity, values []byte) ([]Int32, error) {
+	return decodeColumn(validity, values, 4, "Int32", func(b []byte, valid bool) Int32 {
+		return NewInt32(int32(binary.LittleEndian.Uint32(b)), valid)
+	})
+}
+
+// EncodeUintColumn encodes col as a validity bitmap and 8-byte values.
+func EncodeUintColumn(col []Uint) (validity, values []byte) {
+	return encodeColumn(col, 8, func(b []byte, v Uint) bool {
+		binary.LittleEndian.PutUint64(b, v.Uint64)
+		return v.Valid
+	})
+}
+
+// DecodeUintColumn decodes a column encoded by EncodeUintColumn.
+func DecodeUintColumn(validity, values []byte) ([]Uint, error) {
+	return decodeColumn(validity, values, 8, "Uint", func(b []byte, valid bool) Uint {
+		return NewUint(binary.LittleEndian.Uint64(b), valid)
+	})
+}
+
+// EncodeUint8Column encodes col as a validity bitmap and 1-byte values.
+func EncodeUint8Column(col []Uint8) (validity, values []byte) {
+	return encodeColumn(col, 1, func(b []byte, v Uint8) bool {
+		b[0] = v.Uint8
+		return v.Valid
+	})
+}
+
+// DecodeUint8Column decodes a column encoded by EncodeUint8Column.
+func DecodeUint8Column(validity, values []byte) ([]Uint8, error) {
+	return decodeColumn(validity, values, 1, "Uint8", func(b []byte, valid bool) Uint8 {
+		return NewUint8(b[0], valid)
+	})
+}
+
+// EncodeUint16Column encodes col as a validity bitmap and 2-byte values.
+func EncodeUint16Column(col []Uint16) (validity, values []byte) {
+	return encodeColumn(col, 2, func(b []byte, v Uint16) bool {
+		binary.LittleEndian.PutUint16(b, v.Uint16)
+		return v.Valid
+	})
+}
+
+// DecodeUint16Column decodes a column encoded by EncodeUint16Column.
+func DecodeUint16Column(validity, values []byte) ([]Uint16, error) {
+	return decodeColumn(validity, values, 2, "Uint16", func(b []byte, valid bool) Uint16 {
+		return NewUint16(binary.LittleEndian.Uint16(b), valid)
+	})
+}
+
+// EncodeUint32Column encodes col as a validity bitmap and 4-byte values.
+func EncodeUint32Column(col []Uint32) (validity, values []byte) {
+	return encodeColumn(col, 4, func(b []byte, v Uint32) bool {
+		binary.LittleEndian.PutUint32(b, v.Uint32)
+		return v.Valid
+	})
+}
+
+// DecodeUint32Column decodes a column encoded by EncodeUint32Column.
+func DecodeUint32Column(validity, values []byte) ([]Uint32, error) {
+	return decodeColumn(validity, values, 4, "Uint32", func(b []byte, valid bool) Uint32 {
+		return NewUint32(binary.LittleEndian.Uint32(b), valid)
+	})
+}
+
+// EncodeFloatColumn encodes col as a validity bitmap and 8-byte IEEE 754 values.
+func EncodeFloatColumn(col []Float) (validity, values []byte) {
+	return encodeColumn(col, 8, func(b []byte, v Float) bool {
+		binary.LittleEndian.PutUint64(b, math.Float64bits(v.Float64))
+		return v.Valid
+	})
+}
+
+// DecodeFloatColumn decodes a column encoded by EncodeFloatColumn.
+func DecodeFloatColumn(validity, values []byte) ([]Float, error) {
+	return decodeColumn(validity, values, 8, "Float", func(b []byte, valid bool) Float {
+		return NewFloat(math.Float64frombits(binary.LittleEndian.Uint64(b)), valid)
+	})
+}
+
+// EncodeDurationColumn encodes col as a validity bitmap and 8-byte nanosecond values.
+func EncodeDurationColumn(col []Duration) (validity, values []byte) {
+	return encodeColumn(col, 8, func(b []byte, v Duration) bool {
+		binary.LittleEndian.PutUint64(b, uint64(v.Duration))
+		return v.Valid
+	})
+}
+
+// DecodeDurationColumn decodes a column encoded by EncodeDurationColumn.
+func DecodeDurationColumn(validity, values []byte) ([]Duration, error) {
+	return decodeColumn(validity, values, 8, "Duration", func(b []byte, valid bool) Duration {
+		return NewDuration(time.Duration(binary.LittleEndian.Uint64(b)), valid)
+	})
+}
+
+// EncodeTimeColumn encodes col as a validity bitmap and 8-byte values holding nanoseconds since the Unix epoch.
+func EncodeTimeColumn(col []Time) (validity, values []byte) {
+	return encodeColumn(col, 8, func(b []byte, v Time) bool {
+		if v.Valid {
+			binary.LittleEndian.PutUint64(b, uint64(v.Time.UnixNano()))
+		}
+		return v.Valid
+	})
+}
+
+// DecodeTimeColumn decodes a column encoded by EncodeTimeColumn. Times are in UTC.
+func DecodeTimeColumn(validity, values []byte) ([]Time, error) {
+	return decodeColumn(validity, values, 8, "Time", func(b []byte, valid bool) Time {
+		if !valid {
+			return Time{}
+		}
+		return TimeFrom(time.Unix(0, int64(binary.LittleEndian.Uint64(b))).UTC())
+	})
+}
+
+// EncodeDateColumn encodes col as a validity bitmap and 4-byte values holding days since the Unix epoch.
+func EncodeDateColumn(col []Date) (validity, values []byte) {
+	return encodeColumn(col, 4, func(b []byte, v Date) bool {
+		if v.Valid {
+			days := time.Date(v.Year, v.Month, v.Day, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay
+			binary.LittleEndian.PutUint32(b, uint32(int32(days)))
+		}
+		return v.Valid
+	})
+}
+
+// DecodeDateColumn decodes a column encoded by EncodeDateColumn.
+func DecodeDateColumn(validity, values []byte) ([]Date, error) {
+	return decodeColumn(validity, values, 4, "Date", func(b []byte, valid bool) Date {
+		if !valid {
+			return Date{}
+		}
+		days := int64(int32(binary.LittleEndian.Uint32(b)))
+		return DateFrom(time.Unix(days*secondsPerDay, 0).UTC())
+	})
+}
+
+// EncodeBoolColumn encodes col as a validity bitmap and a bitmap of values.
+func EncodeBoolColumn(col []Bool) (validity, values []byte) {
+	validity = make([]byte, bitmapLen(len(col)))
+	values = make([]byte, bitmapLen(len(col)))
+	for i, v := range col {
+		if v.Valid {
+			setBit(validity, i)
+			if v.Bool {
+				setBit(values, i)
+			}
+		}
+	}
+	return validity, values
+}
+
+// DecodeBoolColumn decodes n values from a column encoded by EncodeBoolColumn.
+// Unlike the other column types, the number of values can't be taken from the values bitmap, so it must be given.
+func DecodeBoolColumn(validity, values []byte, n int) ([]Bool, error) {
+	if n < 0 || len(values) < bitmapLen(n) {
+		return nil, fmt.Errorf("null: couldn't decode Bool column: %d bytes of values is too short for %d values", len(values), n)
+	}
+	if validity != nil && len(validity) < bitmapLen(n) {
+		return nil, fmt.Errorf("null: couldn't decode Bool column: %d bytes of validity is too short for %d values", len(validity), n)
+	}
+	col := make([]Bool, n)
+	for i := range col {
+		if validity == nil || bit(validity, i) {
+			col[i] = BoolFrom(bit(values, i))
+		}
+	}
+	return col, nil
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// encodeColumn encodes col with put, which writes a value into a slice of width bytes and reports whether it is valid.
+func encodeColumn[T any](col []T, width int, put func([]byte, T) bool) (validity, values []byte) {
+	validity = make([]byte, bitmapLen(len(col)))
+	values = make([]byte, len(col)*width)
+	for i, v := range col {
+		b := values[i*width : (i+1)*width]
+		if put(b, v) {
+			setBit(validity, i)
+		} else {
+			clear(b)
+		}
+	}
+	return validity, values
+}
+
+// decodeColumn decodes a column with get, which reads a value from a slice of width bytes.
+func decodeColumn[T any](validity, values []byte, width int, name string, get func([]byte, bool) T) ([]T, error) {
+	if len(values)%width != 0 {
+		return nil, fmt.Errorf("null: couldn't decode %s column: %d bytes of values isn't a multiple of %d", name, len(values), width)
+	}
+	n := len(values) / width
+	if validity != nil && len(validity) < bitmapLen(n) {
+		return nil, fmt.Errorf("null: couldn't decode %s column: %d bytes of validity is too short for %d values", name, len(validity), n)
+	}
+	col := make([]T, n)
+	for i := range col {
+		col[i] = get(values[i*width:(i+1)*width], validity == nil || bit(validity, i))
+	}
+	return col, nil
+}
+
+func bitmapLen(n int) int {
+	return (n + 7) / 8
+}
+
+func setBit(bitmap []byte, i int) {
+	bitmap[i/8] |= 1 << (i % 8)
+}
+
+func bit(bitmap []byte, i int) bool {
+	return bitmap[i/8]&(1<<(i%8)) != 0
+}
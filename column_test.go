@@ -0,0 +1,145 @@
+package null
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestUintColumn(t *testing.T) {
+	col := []Uint{UintFrom(1), {}, UintFrom(math.MaxUint64), UintFrom(0), {}, {}, {}, {}, UintFrom(9)}
+	validity, values := EncodeUintColumn(col)
+	if !bytes.Equal(validity, []byte{0b00001101, 0b00000001}) {
+		t.Errorf("bad validity: %08b", validity)
+	}
+	if len(values) != len(col)*8 {
+		t.Errorf("bad values length: %d", len(values))
+	}
+	if !bytes.Equal(values[8:16], make([]byte, 8)) {
+		t.Errorf("null value not zeroed: %x", values[8:16])
+	}
+	if !bytes.Equal(values[:8], []byte{1, 0, 0, 0, 0, 0, 0, 0}) {
+		t.Errorf("values should be little-endian: %x", values[:8])
+	}
+
+	got, err := DecodeUintColumn(validity, values)
+	maybePanic(err)
+	assertColumn(t, got, col, "Uint")
+}
+
+func TestColumnRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+
+	validity, values := EncodeIntColumn([]Int{IntFrom(-1), {}, IntFrom(math.MaxInt64)})
+	ints, err := DecodeIntColumn(validity, values)
+	maybePanic(err)
+	assertColumn(t, ints, []Int{IntFrom(-1), {}, IntFrom(math.MaxInt64)}, "Int")
+
+	validity, values = EncodeInt8Column([]Int8{Int8From(-128), {}})
+	int8s, err := DecodeInt8Column(validity, values)
+	maybePanic(err)
+	assertColumn(t, int8s, []Int8{Int8From(-128), {}}, "Int8")
+
+	validity, values = EncodeInt16Column([]Int16{{}, Int16From(-300)})
+	int16s, err := DecodeInt16Column(validity, values)
+	maybePanic(err)
+	assertColumn(t, int16s, []Int16{{}, Int16From(-300)}, "Int16")
+
+	validity, values = EncodeInt32Column([]Int32{Int32From(math.MinInt32), {}})
+	int32s, err := DecodeInt32Column(validity, values)
+	maybePanic(err)
+	assertColumn(t, int32s, []Int32{Int32From(math.MinInt32), {}}, "Int32")
+
+	validity, values = EncodeUint8Column([]Uint8{Uint8From(255), {}})
+	uint8s, err := DecodeUint8Column(validity, values)
+	maybePanic(err)
+	assertColumn(t, uint8s, []Uint8{Uint8From(255), {}}, "Uint8")
+
+	validity, values = EncodeUint16Column([]Uint16{Uint16From(65535), {}})
+	uint16s, err := DecodeUint16Column(validity, values)
+	maybePanic(err)
+	assertColumn(t, uint16s, []Uint16{Uint16From(65535), {}}, "Uint16")
+
+	validity, values = EncodeUint32Column([]Uint32{{}, Uint32From(math.MaxUint32)})
+	uint32s, err := DecodeUint32Column(validity, values)
+	maybePanic(err)
+	assertColumn(t, uint32s, []Uint32{{}, Uint32From(math.MaxUint32)}, "Uint32")
+
+	validity, values = EncodeFloatColumn([]Float{FloatFrom(1.5), {}, FloatFrom(math.Inf(-1))})
+	floats, err := DecodeFloatColumn(validity, values)
+	maybePanic(err)
+	assertColumn(t, floats, []Float{FloatFrom(1.5), {}, FloatFrom(math.Inf(-1))}, "Float")
+
+	validity, values = EncodeDurationColumn([]Duration{DurationFrom(-time.Hour), {}})
+	durations, err := DecodeDurationColumn(validity, values)
+	maybePanic(err)
+	assertColumn(t, durations, []Duration{DurationFrom(-time.Hour), {}}, "Duration")
+
+	validity, values = EncodeTimeColumn([]Time{TimeFrom(now), {}, TimeFrom(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC))})
+	times, err := DecodeTimeColumn(validity, values)
+	maybePanic(err)
+	assertColumn(t, times, []Time{TimeFrom(now), {}, TimeFrom(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC))}, "Time")
+
+	dates := []Date{NewDate(2024, time.February, 29, true), {}, NewDate(1969, time.December, 31, true), NewDate(1970, time.January, 1, true)}
+	validity, values = EncodeDateColumn(dates)
+	if !bytes.Equal(values[8:], []byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0}) {
+		t.Errorf("bad Date values: %x", values)
+	}
+	gotDates, err := DecodeDateColumn(validity, values)
+	maybePanic(err)
+	assertColumn(t, gotDates, dates, "Date")
+}
+
+func TestBoolColumn(t *testing.T) {
+	col := []Bool{BoolFrom(true), BoolFrom(false), {}, BoolFrom(true), {}, {}, {}, {}, BoolFrom(true)}
+	validity, values := EncodeBoolColumn(col)
+	if !bytes.Equal(validity, []byte{0b00001011, 0b00000001}) {
+		t.Errorf("bad validity: %08b", validity)
+	}
+	if !bytes.Equal(values, []byte{0b00001001, 0b00000001}) {
+		t.Errorf("bad values: %08b", values)
+	}
+	got, err := DecodeBoolColumn(validity, values, len(col))
+	maybePanic(err)
+	assertColumn(t, got, col, "Bool")
+
+	if _, err := DecodeBoolColumn(validity, values, 17); err == nil {
+		t.Error("expected error for short values")
+	}
+	if _, err := DecodeBoolColumn(validity[:1], values, len(col)); err == nil {
+		t.Error("expected error for short validity")
+	}
+}
+
+func TestDecodeColumnErrors(t *testing.T) {
+	// nil validity means every value is valid
+	got, err := DecodeInt32Column(nil, []byte{1, 0, 0, 0, 2, 0, 0, 0})
+	maybePanic(err)
+	assertColumn(t, got, []Int32{Int32From(1), Int32From(2)}, "nil validity")
+
+	if _, err := DecodeInt32Column(nil, []byte{1, 0, 0}); err == nil {
+		t.Error("expected error for partial value")
+	}
+	if _, err := DecodeUint8Column([]byte{0xff}, make([]byte, 9)); err == nil {
+		t.Error("expected error for short validity")
+	}
+
+	empty, err := DecodeIntColumn(nil, nil)
+	maybePanic(err)
+	if len(empty) != 0 {
+		t.Errorf("expected empty column, got %v", empty)
+	}
+}
+
+func assertColumn[T interface{ Equal(T) bool }](t *testing.T, got, want []T, from string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %d values, want %d", from, len(got), len(want))
+	}
+	for i := range got {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("%s: value %d: got %v, want %v", from, i, got[i], want[i])
+		}
+	}
+}
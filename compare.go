@@ -0,0 +1,356 @@
+package null
+
+import (
+	"bytes"
+	"cmp"
+	"math/big"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// The Compare and Less methods of the ordered types, such as the numbers, strings, and times,
+// order null before any valid value, as SQL does for ascending ORDER BY in some databases such as MySQL and SQLite.
+// Use CompareFunc or LessFunc with NullsLast to sort nulls after valid values instead.
+
+// NullOrder is where CompareFunc and LessFunc sort null values.
+type NullOrder int
+
+const (
+	// NullsFirst sorts null before any valid value, like the Compare methods.
+	NullsFirst NullOrder = iota
+	// NullsLast sorts null after any valid value.
+	NullsLast
+)
+
+// ordered is implemented by the types with a Compare method.
+type ordered[T any] interface {
+	Compare(other T) int
+	IsZero() bool
+}
+
+// CompareFunc returns a comparison function for values of T that sorts null values in the given order,
+// for use with slices.SortFunc, slices.BinarySearchFunc, and similar.
+// Values are null if IsZero returns true, which includes zero values for the zero package.
+func CompareFunc[T ordered[T]](nulls NullOrder) func(a, b T) int {
+	if nulls != NullsLast {
+		return func(a, b T) int {
+			return a.Compare(b)
+		}
+	}
+	return func(a, b T) int {
+		switch aNull, bNull := a.IsZero(), b.IsZero(); {
+		case aNull && bNull:
+			return 0
+		case aNull:
+			return 1
+		case bNull:
+			return -1
+		}
+		return a.Compare(b)
+	}
+}
+
+// LessFunc returns a function that reports whether a sorts before b, sorting null values in the given order,
+// for use with sort.Slice and similar:
+//
+//	less := null.LessFunc[null.Int](null.NullsLast)
+//	sort.Slice(s, func(i, j int) bool { return less(s[i], s[j]) })
+func LessFunc[T ordered[T]](nulls NullOrder) func(a, b T) bool {
+	compare := CompareFunc[T](nulls)
+	return func(a, b T) bool {
+		return compare(a, b) < 0
+	}
+}
+
+// compareNull compares a and b with compare, ordering null before any valid value.
+func compareNull[T any](a T, aValid bool, b T, bValid bool, compare func(a, b T) int) int {
+	switch {
+	case !aValid && !bValid:
+		return 0
+	case !aValid:
+		return -1
+	case !bValid:
+		return 1
+	}
+	return compare(a, b)
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	}
+	return 1
+}
+
+// Compare compares two Addrs, ordering null before any valid value.
+// The result will be 0 if a == other, -1 if a < other, and +1 if a > other.
+func (a Addr) Compare(other Addr) int {
+	return compareNull(a.Addr, a.Valid, other.Addr, other.Valid, netip.Addr.Compare)
+}
+
+// Less reports whether this Addr sorts before other, with null first.
+func (a Addr) Less(other Addr) bool {
+	return a.Compare(other) < 0
+}
+
+// Compare compares two BigInts, ordering null before any valid value.
+// The result will be 0 if b == other, -1 if b < other, and +1 if b > other.
+func (b BigInt) Compare(other BigInt) int {
+	return compareNull(b.BigInt, b.Valid, other.BigInt, other.Valid, (*big.Int).Cmp)
+}
+
+// Less reports whether this BigInt sorts before other, with null first.
+func (b BigInt) Less(other BigInt) bool {
+	return b.Compare(other) < 0
+}
+
+// Compare compares two Bools, ordering null before any valid value.
+// The result will be 0 if b == other, -1 if b < other, and +1 if b > other.
+// false is less than true.
+func (b Bool) Compare(other Bool) int {
+	return compareNull(b.Bool, b.Valid, other.Bool, other.Valid, compareBool)
+}
+
+// Less reports whether this Bool sorts before other, with null first.
+func (b Bool) Less(other Bool) bool {
+	return b.Compare(other) < 0
+}
+
+// Compare compares two ByteSizes, ordering null before any valid value.
+// The result will be 0 if b == other, -1 if b < other, and +1 if b > other.
+func (b ByteSize) Compare(other ByteSize) int {
+	return compareNull(b.Size, b.Valid, other.Size, other.Valid, cmp.Compare[uint64])
+}
+
+// Less reports whether this ByteSize sorts before other, with null first.
+func (b ByteSize) Less(other ByteSize) bool {
+	return b.Compare(other) < 0
+}
+
+// Compare compares two Dates, ordering null before any valid value.
+// The result will be 0 if d == other, -1 if d < other, and +1 if d > other.
+func (d Date) Compare(other Date) int {
+	return compareNull(d.In(time.UTC), d.Valid, other.In(time.UTC), other.Valid, time.Time.Compare)
+}
+
+// Less reports whether this Date sorts before other, with null first.
+func (d Date) Less(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// Compare compares two Durations, ordering null before any valid value.
+// The result will be 0 if d == other, -1 if d < other, and +1 if d > other.
+func (d Duration) Compare(other Duration) int {
+	return compareNull(d.Duration, d.Valid, other.Duration, other.Valid, cmp.Compare[time.Duration])
+}
+
+// Less reports whether this Duration sorts before other, with null first.
+func (d Duration) Less(other Duration) bool {
+	return d.Compare(other) < 0
+}
+
+// Compare compares two Enums, ordering null before any valid value.
+// The result will be 0 if e == other, -1 if e < other, and +1 if e > other.
+func (e Enum[T]) Compare(other Enum[T]) int {
+	return compareNull(e.V, e.Valid, other.V, other.Valid, cmp.Compare[T])
+}
+
+// Less reports whether this Enum sorts before other, with null first.
+func (e Enum[T]) Less(other Enum[T]) bool {
+	return e.Compare(other) < 0
+}
+
+// Compare compares two Floats, ordering null before any valid value.
+// The result will be 0 if f == other, -1 if f < other, and +1 if f > other.
+// NaN is less than any other number, like cmp.Compare.
+func (f Float) Compare(other Float) int {
+	return compareNull(f.Float64, f.Valid, other.Float64, other.Valid, cmp.Compare[float64])
+}
+
+// Less reports whether this Float sorts before other, with null first.
+func (f Float) Less(other Float) bool {
+	return f.Compare(other) < 0
+}
+
+// Compare compares two Ints, ordering null before any valid value.
+// The result will be 0 if i == other, -1 if i < other, and +1 if i > other.
+func (i Int) Compare(other Int) int {
+	return compareNull(i.Int64, i.Valid, other.Int64, other.Valid, cmp.Compare[int64])
+}
+
+// Less reports whether this Int sorts before other, with null first.
+func (i Int) Less(other Int) bool {
+	return i.Compare(other) < 0
+}
+
+// Compare compares two Int16s, ordering null before any valid value.
+// The result will be 0 if i == other, -1 if i < other, and +1 if i > other.
+func (i Int16) Compare(other Int16) int {
+	return compareNull(i.Int16, i.Valid, other.Int16, other.Valid, cmp.Compare[int16])
+}
+
+// Less reports whether this Int16 sorts before other, with null first.
+func (i Int16) Less(other Int16) bool {
+	return i.Compare(other) < 0
+}
+
+// Compare compares two Int32s, ordering null before any valid value.
+// The result will be 0 if i == other, -1 if i < other, and +1 if i > other.
+func (i Int32) Compare(other Int32) int {
+	return compareNull(i.Int32, i.Valid, other.Int32, other.Valid, cmp.Compare[int32])
+}
+
+// Less reports whether this Int32 sorts before other, with null first.
+func (i Int32) Less(other Int32) bool {
+	return i.Compare(other) < 0
+}
+
+// Compare compares two Int8s, ordering null before any valid value.
+// The result will be 0 if i == other, -1 if i < other, and +1 if i > other.
+func (i Int8) Compare(other Int8) int {
+	return compareNull(i.Int8, i.Valid, other.Int8, other.Valid, cmp.Compare[int8])
+}
+
+// Less reports whether this Int8 sorts before other, with null first.
+func (i Int8) Less(other Int8) bool {
+	return i.Compare(other) < 0
+}
+
+// Compare compares two KSUIDs, ordering null before any valid value.
+// The result will be 0 if k == other, -1 if k < other, and +1 if k > other.
+// KSUIDs are ordered by their bytes, and so by time.
+func (k KSUID) Compare(other KSUID) int {
+	return compareNull(k.KSUID[:], k.Valid, other.KSUID[:], other.Valid, bytes.Compare)
+}
+
+// Less reports whether this KSUID sorts before other, with null first.
+func (k KSUID) Less(other KSUID) bool {
+	return k.Compare(other) < 0
+}
+
+// Compare compares two Percents, ordering null before any valid value.
+// The result will be 0 if p == other, -1 if p < other, and +1 if p > other.
+func (p Percent) Compare(other Percent) int {
+	return compareNull(p.Float64, p.Valid, other.Float64, other.Valid, cmp.Compare[float64])
+}
+
+// Less reports whether this Percent sorts before other, with null first.
+func (p Percent) Less(other Percent) bool {
+	return p.Compare(other) < 0
+}
+
+// Compare compares two Strings, ordering null before any valid value.
+// The result will be 0 if s == other, -1 if s < other, and +1 if s > other.
+// Strings are ordered by their bytes.
+func (s String) Compare(other String) int {
+	return compareNull(s.String, s.Valid, other.String, other.Valid, strings.Compare)
+}
+
+// Less reports whether this String sorts before other, with null first.
+func (s String) Less(other String) bool {
+	return s.Compare(other) < 0
+}
+
+// Compare compares two Times, ordering null before any valid value.
+// The result will be 0 if t == other, -1 if t < other, and +1 if t > other.
+func (t Time) Compare(other Time) int {
+	return compareNull(t.Time, t.Valid, other.Time, other.Valid, time.Time.Compare)
+}
+
+// Less reports whether this Time sorts before other, with null first.
+func (t Time) Less(other Time) bool {
+	return t.Compare(other) < 0
+}
+
+// Compare compares two TimeOfDays, ordering null before any valid value.
+// The result will be 0 if t == other, -1 if t < other, and +1 if t > other.
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	return compareNull(t.time(), t.Valid, other.time(), other.Valid, time.Time.Compare)
+}
+
+// Less reports whether this TimeOfDay sorts before other, with null first.
+func (t TimeOfDay) Less(other TimeOfDay) bool {
+	return t.Compare(other) < 0
+}
+
+// Compare compares two Uints, ordering null before any valid value.
+// The result will be 0 if i == other, -1 if i < other, and +1 if i > other.
+func (i Uint) Compare(other Uint) int {
+	return compareNull(i.Uint64, i.Valid, other.Uint64, other.Valid, cmp.Compare[uint64])
+}
+
+// Less reports whether this Uint sorts before other, with null first.
+func (i Uint) Less(other Uint) bool {
+	return i.Compare(other) < 0
+}
+
+// Compare compares two Uint16s, ordering null before any valid value.
+// The result will be 0 if i == other, -1 if i < other, and +1 if i > other.
+func (i Uint16) Compare(other Uint16) int {
+	return compareNull(i.Uint16, i.Valid, other.Uint16, other.Valid, cmp.Compare[uint16])
+}
+
+// Less reports whether this Uint16 sorts before other, with null first.
+func (i Uint16) Less(other Uint16) bool {
+	return i.Compare(other) < 0
+}
+
+// Compare compares two Uint32s, ordering null before any valid value.
+// The result will be 0 if i == other, -1 if i < other, and +1 if i > other.
+func (i Uint32) Compare(other Uint32) int {
+	return compareNull(i.Uint32, i.Valid, other.Uint32, other.Valid, cmp.Compare[uint32])
+}
+
+// Less reports whether this Uint32 sorts before other, with null first.
+func (i Uint32) Less(other Uint32) bool {
+	return i.Compare(other) < 0
+}
+
+// Compare compares two Uint8s, ordering null before any valid value.
+// The result will be 0 if i == other, -1 if i < other, and +1 if i > other.
+func (i Uint8) Compare(other Uint8) int {
+	return compareNull(i.Uint8, i.Valid, other.Uint8, other.Valid, cmp.Compare[uint8])
+}
+
+// Less reports whether this Uint8 sorts before other, with null first.
+func (i Uint8) Less(other Uint8) bool {
+	return i.Compare(other) < 0
+}
+
+// Compare compares two ULIDs, ordering null before any valid value.
+// The result will be 0 if u == other, -1 if u < other, and +1 if u > other.
+// ULIDs are ordered by their bytes, and so by time.
+func (u ULID) Compare(other ULID) int {
+	return compareNull(u.ULID[:], u.Valid, other.ULID[:], other.Valid, bytes.Compare)
+}
+
+// Less reports whether this ULID sorts before other, with null first.
+func (u ULID) Less(other ULID) bool {
+	return u.Compare(other) < 0
+}
+
+// Compare compares two UnixMilliss, ordering null before any valid value.
+// The result will be 0 if u == other, -1 if u < other, and +1 if u > other.
+func (u UnixMillis) Compare(other UnixMillis) int {
+	return compareNull(u.Time, u.Valid, other.Time, other.Valid, time.Time.Compare)
+}
+
+// Less reports whether this UnixMillis sorts before other, with null first.
+func (u UnixMillis) Less(other UnixMillis) bool {
+	return u.Compare(other) < 0
+}
+
+// Compare compares two UnixSecondss, ordering null before any valid value.
+// The result will be 0 if u == other, -1 if u < other, and +1 if u > other.
+func (u UnixSeconds) Compare(other UnixSeconds) int {
+	return compareNull(u.Time, u.Valid, other.Time, other.Valid, time.Time.Compare)
+}
+
+// Less reports whether this UnixSeconds sorts before other, with null first.
+func (u UnixSeconds) Less(other UnixSeconds) bool {
+	return u.Compare(other) < 0
+}
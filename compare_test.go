@@ -0,0 +1,95 @@
+package null
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	null := NewUint(0, false)
+	for _, test := range []struct {
+		a, b Uint
+		want int
+	}{
+		{UintFrom(1), UintFrom(2), -1},
+		{UintFrom(2), UintFrom(1), 1},
+		{UintFrom(1), UintFrom(1), 0},
+		{null, UintFrom(0), -1},
+		{UintFrom(0), null, 1},
+		{null, NewUint(5, false), 0},
+	} {
+		if got := test.a.Compare(test.b); got != test.want {
+			t.Errorf("%v.Compare(%v): got %d, want %d", test.a, test.b, got, test.want)
+		}
+		if got := test.a.Less(test.b); got != (test.want < 0) {
+			t.Errorf("%v.Less(%v): got %v", test.a, test.b, got)
+		}
+	}
+
+	if FloatFrom(math.NaN()).Compare(FloatFrom(math.Inf(-1))) != -1 || NewFloat(0, false).Compare(FloatFrom(math.NaN())) != -1 {
+		t.Error("Float: NaN should be less than other numbers, but more than null")
+	}
+	if BoolFrom(false).Compare(BoolFrom(true)) != -1 || NewBool(false, false).Compare(BoolFrom(false)) != -1 {
+		t.Error("Bool: bad ordering")
+	}
+	now := time.Now()
+	if TimeFrom(now).Compare(TimeFrom(now.Add(time.Second))) != -1 || DateFrom(now).Compare(DateFrom(now.AddDate(0, 0, -1))) != 1 {
+		t.Error("times: bad ordering")
+	}
+	if StringFrom("a").Compare(StringFrom("b")) != -1 || StringFrom("").Compare(NewString("", false)) != 1 {
+		t.Error("String: bad ordering")
+	}
+	if !ULIDFrom([16]byte{1}).Less(ULIDFrom([16]byte{2})) {
+		t.Error("ULID: bad ordering")
+	}
+}
+
+func TestCompareFunc(t *testing.T) {
+	null := NewInt(0, false)
+	s := []Int{IntFrom(2), null, IntFrom(-1), IntFrom(0)}
+
+	slices.SortFunc(s, CompareFunc[Int](NullsFirst))
+	if want := []Int{null, IntFrom(-1), IntFrom(0), IntFrom(2)}; !slices.EqualFunc(s, want, Int.Equal) {
+		t.Errorf("NullsFirst: got %v", s)
+	}
+	slices.SortFunc(s, CompareFunc[Int](NullsLast))
+	if want := []Int{IntFrom(-1), IntFrom(0), IntFrom(2), null}; !slices.EqualFunc(s, want, Int.Equal) {
+		t.Errorf("NullsLast: got %v", s)
+	}
+
+	strs := []String{NewString("", false), StringFrom("b"), StringFrom("a")}
+	less := LessFunc[String](NullsLast)
+	sort.Slice(strs, func(i, j int) bool { return less(strs[i], strs[j]) })
+	if want := []String{StringFrom("a"), StringFrom("b"), NewString("", false)}; !slices.EqualFunc(strs, want, String.Equal) {
+		t.Errorf("LessFunc: got %v", strs)
+	}
+}
+
+func TestAllTypesCompare(t *testing.T) {
+	example := reflect.ValueOf(exampleAllTypes())
+	typ := example.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		valid, null := example.Field(i), reflect.Zero(f.Type)
+		compare := valid.MethodByName("Compare")
+		if !compare.IsValid() {
+			continue
+		}
+		results := []int64{
+			compare.Call([]reflect.Value{valid})[0].Int(),
+			compare.Call([]reflect.Value{null})[0].Int(),
+			null.MethodByName("Compare").Call([]reflect.Value{valid})[0].Int(),
+			null.MethodByName("Compare").Call([]reflect.Value{null})[0].Int(),
+		}
+		if !reflect.DeepEqual(results, []int64{0, 1, -1, 0}) {
+			t.Errorf("%s: bad Compare results for valid and null values: %v", f.Name, results)
+		}
+		if !null.MethodByName("Less").Call([]reflect.Value{valid})[0].Bool() {
+			t.Errorf("%s: null should be Less than a valid value", f.Name)
+		}
+	}
+}
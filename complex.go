@@ -0,0 +1,265 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// ComplexFormat is a way of representing a Complex as JSON.
+type ComplexFormat int
+
+const (
+	// ComplexObject encodes Complexes as objects, such as {"re":1.5,"im":-2}.
+	ComplexObject ComplexFormat = iota
+	// ComplexString encodes Complexes as strings, such as "(1.5-2i)".
+	ComplexString
+)
+
+// DefaultComplexFormat is the format Complex uses for JSON marshaling.
+// Objects, strings, and numbers are always accepted when unmarshaling.
+// It defaults to ComplexObject.
+var DefaultComplexFormat = ComplexObject
+
+// NullComplex represents a complex128 that may be null.
+// NullComplex implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullComplex struct {
+	Complex complex128
+	Valid   bool // Valid is true if Complex is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports strings such as "(1.5-2i)" or "1.5,-2", and real numbers.
+func (n *NullComplex) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var err error
+	switch v := value.(type) {
+	case nil:
+		n.Complex, n.Valid = 0, false
+		return nil
+	case []byte:
+		n.Complex, err = parseComplex(string(v))
+	case string:
+		n.Complex, err = parseComplex(v)
+	case float64:
+		n.Complex = complex(v, 0)
+	case int64:
+		n.Complex = complex(float64(v), 0)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Complex: %v", value, value)
+	}
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan Complex: %w", err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as strings, such as "(1.5-2i)".
+func (n NullComplex) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return strconv.FormatComplex(n.Complex, 'g', -1, 128), nil
+}
+
+// IsZero returns true if this NullComplex is null.
+func (n NullComplex) IsZero() bool {
+	return !n.Valid
+}
+
+// Complex is a nullable complex128.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Complex struct {
+	NullComplex
+}
+
+// NewComplex creates a new Complex.
+func NewComplex(c complex128, valid bool) Complex {
+	return Complex{
+		NullComplex: NullComplex{
+			Complex: c,
+			Valid:   valid,
+		},
+	}
+}
+
+// ComplexFrom creates a new Complex that will always be valid.
+func ComplexFrom(c complex128) Complex {
+	return NewComplex(c, true)
+}
+
+// ComplexFromPtr creates a new Complex that will be null if c is nil.
+func ComplexFromPtr(c *complex128) Complex {
+	if c == nil {
+		return NewComplex(0, false)
+	}
+	return NewComplex(*c, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (c Complex) ValueOrZero() complex128 {
+	if !c.Valid {
+		return 0
+	}
+	return c.Complex
+}
+
+type complexJSON struct {
+	Re float64 `json:"re"`
+	Im float64 `json:"im"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports {"re":..,"im":..} objects, complex strings, numbers, and null input.
+// 0 will not be considered a null Complex.
+func (c *Complex) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(c, &err)
+	if bytes.Equal(data, nullBytes) {
+		c.Valid = false
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	switch x := v.(type) {
+	case map[string]interface{}:
+		var obj complexJSON
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		c.Complex = complex(obj.Re, obj.Im)
+	case string:
+		cmplx, err := parseComplex(x)
+		if err != nil {
+			return fmt.Errorf("null: couldn't convert string to complex: %w", err)
+		}
+		c.Complex = cmplx
+	case float64:
+		c.Complex = complex(x, 0)
+	default:
+		return fmt.Errorf("null: JSON input is invalid type (need object, string, or number): %s", data)
+	}
+
+	c.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Complex is null,
+// otherwise it uses DefaultComplexFormat.
+func (c Complex) MarshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return []byte("null"), nil
+	}
+	if DefaultComplexFormat == ComplexString {
+		return json.Marshal(strconv.FormatComplex(c.Complex, 'g', -1, 128))
+	}
+	return json.Marshal(complexJSON{Re: real(c.Complex), Im: imag(c.Complex)})
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Complex if the input is blank, "null", or DefaultNullText.
+func (c *Complex) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(c, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		c.Valid = false
+		return nil
+	}
+	cmplx, err := parseComplex(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	c.Complex = cmplx
+	c.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Complex is null,
+// otherwise a string such as "(1.5-2i)".
+func (c Complex) MarshalText() ([]byte, error) {
+	if !c.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatComplex(c.Complex, 'g', -1, 128)), nil
+}
+
+// SetValid changes this Complex's value and also sets it to be non-null.
+func (c *Complex) SetValid(v complex128) {
+	c.Complex = v
+	c.Valid = true
+}
+
+// SetNull sets this Complex to null, leaving its value as is. Use Reset to clear the value too.
+func (c *Complex) SetNull() {
+	c.Valid = false
+}
+
+// Reset sets this Complex to null and clears its value.
+func (c *Complex) Reset() {
+	*c = Complex{}
+}
+
+// Ptr returns a pointer to this Complex's value, or a nil pointer if this Complex is null.
+func (c Complex) Ptr() *complex128 {
+	if !c.Valid {
+		return nil
+	}
+	return &c.Complex
+}
+
+// SetPtr changes this Complex's value to the value v points to, or sets it to be null if v is nil, like ComplexFromPtr.
+func (c *Complex) SetPtr(v *complex128) {
+	*c = ComplexFromPtr(v)
+}
+
+// IsZero returns true for invalid Complexes.
+// A non-null Complex with a 0 value will not be considered zero.
+func (c Complex) IsZero() bool {
+	return !c.Valid
+}
+
+// IsZeroOrNull returns true for invalid Complexs, or valid Complexs with a 0 value.
+func (c Complex) IsZeroOrNull() bool {
+	return !c.Valid || c.Complex == 0
+}
+
+// Equal returns true if both Complexes have the same value or are both null.
+func (c Complex) Equal(other Complex) bool {
+	return c.Valid == other.Valid && (!c.Valid || c.Complex == other.Complex)
+}
+
+// parseComplex parses a complex string such as "(1.5-2i)",
+// or real and imaginary parts delimited by a comma, such as "1.5,-2" or the composite form "(1.5,-2)".
+// Either form may be surrounded by one pair of parentheses.
+func parseComplex(str string) (complex128, error) {
+	s := strings.TrimSpace(str)
+	if len(s) >= 2 && s[0] == '(' && s[len(s)-1] == ')' {
+		s = s[1 : len(s)-1]
+	}
+	if re, im, ok := strings.Cut(s, ","); ok {
+		r, err := strconv.ParseFloat(strings.TrimSpace(re), 64)
+		if err != nil {
+			return 0, err
+		}
+		i, err := strconv.ParseFloat(strings.TrimSpace(im), 64)
+		if err != nil {
+			return 0, err
+		}
+		return complex(r, i), nil
+	}
+	return strconv.ParseComplex(strings.TrimSpace(s), 128)
+}
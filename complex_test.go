@@ -0,0 +1,291 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var (
+	complexValue      = complex(1.5, -2)
+	complexJSONObject = []byte(`{"re":1.5,"im":-2}`)
+	complexStringJSON = []byte(`"(1.5-2i)"`)
+)
+
+func TestComplexFrom(t *testing.T) {
+	c := ComplexFrom(complexValue)
+	assertComplex(t, c, "ComplexFrom()")
+
+	zero := ComplexFrom(0)
+	if !zero.Valid {
+		t.Error("ComplexFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestComplexFromPtr(t *testing.T) {
+	v := complexValue
+	c := ComplexFromPtr(&v)
+	assertComplex(t, c, "ComplexFromPtr()")
+
+	null := ComplexFromPtr(nil)
+	assertNullComplex(t, null, "ComplexFromPtr(nil)")
+}
+
+func TestUnmarshalComplex(t *testing.T) {
+	var c Complex
+	err := json.Unmarshal(complexJSONObject, &c)
+	maybePanic(err)
+	assertComplex(t, c, "complex object json")
+
+	var str Complex
+	err = json.Unmarshal(complexStringJSON, &str)
+	maybePanic(err)
+	assertComplex(t, str, "complex string json")
+
+	var num Complex
+	err = json.Unmarshal(floatJSON, &num)
+	maybePanic(err)
+	if num.Complex != complex(1.2345, 0) || !num.Valid {
+		t.Errorf("bad number json: %v", num.Complex)
+	}
+
+	var null Complex
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullComplex(t, null, "null json")
+
+	var bad Complex
+	err = json.Unmarshal(stringJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullComplex(t, bad, "bad complex string json")
+
+	var badObject Complex
+	err = json.Unmarshal([]byte(`{"re":"1"}`), &badObject)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullComplex(t, badObject, "bad complex object json")
+
+	var badType Complex
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullComplex(t, badType, "wrong type json")
+
+	var invalid Complex
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullComplex(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalComplex(t *testing.T) {
+	var c Complex
+	err := c.UnmarshalText([]byte("(1.5-2i)"))
+	maybePanic(err)
+	assertComplex(t, c, "UnmarshalText() complex")
+
+	var delimited Complex
+	err = delimited.UnmarshalText([]byte("1.5, -2"))
+	maybePanic(err)
+	assertComplex(t, delimited, "UnmarshalText() delimited complex")
+
+	var blank Complex
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullComplex(t, blank, "UnmarshalText() empty complex")
+
+	var null Complex
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullComplex(t, null, `UnmarshalText() "null"`)
+
+	var invalid Complex
+	err = invalid.UnmarshalText([]byte("1.5,x"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalComplex(t *testing.T) {
+	defer func() { DefaultComplexFormat = ComplexObject }()
+
+	c := ComplexFrom(complexValue)
+	data, err := json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(complexJSONObject), "non-empty json marshal")
+
+	DefaultComplexFormat = ComplexString
+	data, err = json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(complexStringJSON), "string json marshal")
+
+	null := NewComplex(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalComplexText(t *testing.T) {
+	c := ComplexFrom(complexValue)
+	data, err := c.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "(1.5-2i)", "non-empty text marshal")
+
+	null := NewComplex(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestComplexPointer(t *testing.T) {
+	c := ComplexFrom(complexValue)
+	ptr := c.Ptr()
+	if *ptr != complexValue {
+		t.Errorf("bad %s complex: %v ≠ %v\n", "pointer", ptr, complexValue)
+	}
+
+	null := NewComplex(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s complex: %v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestComplexIsZero(t *testing.T) {
+	c := ComplexFrom(complexValue)
+	if c.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewComplex(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewComplex(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestComplexSetValid(t *testing.T) {
+	change := NewComplex(0, false)
+	assertNullComplex(t, change, "SetValid()")
+	change.SetValid(complexValue)
+	assertComplex(t, change, "SetValid()")
+}
+
+func TestComplexScan(t *testing.T) {
+	var c Complex
+	err := c.Scan("(1.5-2i)")
+	maybePanic(err)
+	assertComplex(t, c, "scanned string")
+
+	var b Complex
+	err = b.Scan([]byte("1.5,-2"))
+	maybePanic(err)
+	assertComplex(t, b, "scanned delimited bytes")
+
+	for _, in := range []string{"(1.5,-2)", "( 1.5, -2 )", "(1.5-2i)", "1.5-2i"} {
+		var p Complex
+		err = p.Scan(in)
+		maybePanic(err)
+		assertComplex(t, p, "scanned "+in)
+	}
+	for _, in := range []string{"((1.5,-2))", "(1.5,-2", "1.5,-2)"} {
+		var bad Complex
+		if err := bad.Scan(in); err == nil {
+			t.Errorf("Scan(%q): expected error", in)
+		}
+	}
+
+	var f Complex
+	err = f.Scan(1.5)
+	maybePanic(err)
+	if f.Complex != complex(1.5, 0) || !f.Valid {
+		t.Errorf("bad scanned float: %v", f.Complex)
+	}
+
+	var null Complex
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullComplex(t, null, "scanned null")
+
+	var invalid Complex
+	err = invalid.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullComplex(t, invalid, "scanned invalid")
+
+	var wrong Complex
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestComplexValue(t *testing.T) {
+	c := ComplexFrom(complexValue)
+	v, err := c.Value()
+	maybePanic(err)
+	if v != "(1.5-2i)" {
+		t.Errorf("bad Value(): %#v ≠ %q", v, "(1.5-2i)")
+	}
+
+	null := NewComplex(complexValue, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestComplexEqual(t *testing.T) {
+	c1 := NewComplex(1, false)
+	c2 := NewComplex(2, false)
+	if !c1.Equal(c2) {
+		t.Error("null Complexes should be equal")
+	}
+
+	c1 = ComplexFrom(complexValue)
+	c2 = ComplexFrom(complexValue)
+	if !c1.Equal(c2) {
+		t.Error("same Complexes should be equal")
+	}
+
+	c1 = ComplexFrom(complexValue)
+	c2 = NewComplex(complexValue, false)
+	if c1.Equal(c2) {
+		t.Error("valid and null Complexes should not be equal")
+	}
+
+	c1 = ComplexFrom(complexValue)
+	c2 = ComplexFrom(complex(1.5, 2))
+	if c1.Equal(c2) {
+		t.Error("different Complexes should not be equal")
+	}
+}
+
+func assertComplex(t *testing.T, c Complex, from string) {
+	t.Helper()
+	if c.Complex != complexValue {
+		t.Errorf("bad %s complex: %v ≠ %v\n", from, c.Complex, complexValue)
+	}
+	if !c.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullComplex(t *testing.T, c Complex, from string) {
+	t.Helper()
+	if c.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,243 @@
+package null
+
+import (
+	"fmt"
+	"math"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// The To methods convert between the numeric types, preserving null and checking that values are in range.
+// Widening conversions, such as Int8.ToInt, always succeed.
+
+// Rounding is how Float.ToInt and Float.ToUint convert numbers with a fraction.
+type Rounding int
+
+const (
+	// RoundExact returns an error for numbers with a fraction.
+	RoundExact Rounding = iota
+	// RoundTowardZero drops the fraction, like a Go conversion or math.Trunc.
+	RoundTowardZero
+	// RoundDown rounds toward negative infinity, like math.Floor.
+	RoundDown
+	// RoundUp rounds toward positive infinity, like math.Ceil.
+	RoundUp
+	// RoundHalfAwayFromZero rounds to the nearest integer, rounding halves away from zero, like math.Round.
+	RoundHalfAwayFromZero
+	// RoundHalfEven rounds to the nearest integer, rounding halves to even, like math.RoundToEven.
+	RoundHalfEven
+)
+
+func (r Rounding) round(f float64) (float64, error) {
+	switch r {
+	case RoundExact:
+		if f != math.Trunc(f) {
+			return 0, fmt.Errorf("null: %v is not an integer", f)
+		}
+		return f, nil
+	case RoundTowardZero:
+		return math.Trunc(f), nil
+	case RoundDown:
+		return math.Floor(f), nil
+	case RoundUp:
+		return math.Ceil(f), nil
+	case RoundHalfAwayFromZero:
+		return math.Round(f), nil
+	case RoundHalfEven:
+		return math.RoundToEven(f), nil
+	}
+	return 0, fmt.Errorf("null: invalid Rounding: %d", r)
+}
+
+type integer interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// convertInteger converts n to To, returning an error if it is out of range.
+func convertInteger[To, From integer](n From) (To, error) {
+	v := To(n)
+	if From(v) != n || (v < 0) != (n < 0) {
+		return 0, fmt.Errorf("null: %d overflows %T", n, v)
+	}
+	return v, nil
+}
+
+// ToFloat converts this Int to a Float, which is null if this Int is null.
+// Integers beyond ±2⁵³ are rounded to the nearest float64.
+func (i Int) ToFloat() Float {
+	return NewFloat(float64(i.Int64), i.Valid)
+}
+
+// ToFloat converts this Uint to a Float, which is null if this Uint is null.
+// Integers beyond 2⁵³ are rounded to the nearest float64.
+func (i Uint) ToFloat() Float {
+	return NewFloat(float64(i.Uint64), i.Valid)
+}
+
+// ToInt converts this Float to an Int, which is null if this Float is null, rounding it as given.
+// It returns an error if the rounded value overflows int64, or with RoundExact if it has a fraction.
+func (f Float) ToInt(rounding Rounding) (Int, error) {
+	if !f.Valid {
+		return NewInt(0, false), nil
+	}
+	r, err := rounding.round(f.Float64)
+	if err != nil {
+		return NewInt(0, false), err
+	}
+	n, ok := driverval.FloatToInt(r)
+	if !ok {
+		return NewInt(0, false), fmt.Errorf("null: %v overflows int64", f.Float64)
+	}
+	return IntFrom(n), nil
+}
+
+// ToUint converts this Float to a Uint, which is null if this Float is null, rounding it as given.
+// It returns an error if the rounded value is negative or overflows uint64, or with RoundExact if it has a fraction.
+func (f Float) ToUint(rounding Rounding) (Uint, error) {
+	if !f.Valid {
+		return NewUint(0, false), nil
+	}
+	r, err := rounding.round(f.Float64)
+	if err != nil {
+		return NewUint(0, false), err
+	}
+	// math.MaxUint64 rounds up to 2⁶⁴ as a float64, which is out of range
+	if math.IsNaN(r) || r < 0 || r >= math.MaxUint64 {
+		return NewUint(0, false), fmt.Errorf("null: %v overflows uint64", f.Float64)
+	}
+	return UintFrom(uint64(r)), nil
+}
+
+// ToInt8 converts this Int to an Int8, which is null if this Int is null.
+// It returns an error if the value overflows int8.
+func (i Int) ToInt8() (Int8, error) {
+	if !i.Valid {
+		return NewInt8(0, false), nil
+	}
+	n, err := convertInteger[int8](i.Int64)
+	if err != nil {
+		return NewInt8(0, false), err
+	}
+	return Int8From(n), nil
+}
+
+// ToInt16 converts this Int to an Int16, which is null if this Int is null.
+// It returns an error if the value overflows int16.
+func (i Int) ToInt16() (Int16, error) {
+	if !i.Valid {
+		return NewInt16(0, false), nil
+	}
+	n, err := convertInteger[int16](i.Int64)
+	if err != nil {
+		return NewInt16(0, false), err
+	}
+	return Int16From(n), nil
+}
+
+// ToInt32 converts this Int to an Int32, which is null if this Int is null.
+// It returns an error if the value overflows int32.
+func (i Int) ToInt32() (Int32, error) {
+	if !i.Valid {
+		return NewInt32(0, false), nil
+	}
+	n, err := convertInteger[int32](i.Int64)
+	if err != nil {
+		return NewInt32(0, false), err
+	}
+	return Int32From(n), nil
+}
+
+// ToUint converts this Int to a Uint, which is null if this Int is null.
+// It returns an error if the value is negative.
+func (i Int) ToUint() (Uint, error) {
+	if !i.Valid {
+		return NewUint(0, false), nil
+	}
+	n, err := convertInteger[uint64](i.Int64)
+	if err != nil {
+		return NewUint(0, false), err
+	}
+	return UintFrom(n), nil
+}
+
+// ToUint8 converts this Uint to a Uint8, which is null if this Uint is null.
+// It returns an error if the value overflows uint8.
+func (i Uint) ToUint8() (Uint8, error) {
+	if !i.Valid {
+		return NewUint8(0, false), nil
+	}
+	n, err := convertInteger[uint8](i.Uint64)
+	if err != nil {
+		return NewUint8(0, false), err
+	}
+	return Uint8From(n), nil
+}
+
+// ToUint16 converts this Uint to a Uint16, which is null if this Uint is null.
+// It returns an error if the value overflows uint16.
+func (i Uint) ToUint16() (Uint16, error) {
+	if !i.Valid {
+		return NewUint16(0, false), nil
+	}
+	n, err := convertInteger[uint16](i.Uint64)
+	if err != nil {
+		return NewUint16(0, false), err
+	}
+	return Uint16From(n), nil
+}
+
+// ToUint32 converts this Uint to a Uint32, which is null if this Uint is null.
+// It returns an error if the value overflows uint32.
+func (i Uint) ToUint32() (Uint32, error) {
+	if !i.Valid {
+		return NewUint32(0, false), nil
+	}
+	n, err := convertInteger[uint32](i.Uint64)
+	if err != nil {
+		return NewUint32(0, false), err
+	}
+	return Uint32From(n), nil
+}
+
+// ToInt converts this Uint to an Int, which is null if this Uint is null.
+// It returns an error if the value overflows int64.
+func (i Uint) ToInt() (Int, error) {
+	if !i.Valid {
+		return NewInt(0, false), nil
+	}
+	n, err := convertInteger[int64](i.Uint64)
+	if err != nil {
+		return NewInt(0, false), err
+	}
+	return IntFrom(n), nil
+}
+
+// ToInt converts this Int8 to an Int, which is null if this Int8 is null.
+func (i Int8) ToInt() Int {
+	return NewInt(int64(i.Int8), i.Valid)
+}
+
+// ToInt converts this Int16 to an Int, which is null if this Int16 is null.
+func (i Int16) ToInt() Int {
+	return NewInt(int64(i.Int16), i.Valid)
+}
+
+// ToInt converts this Int32 to an Int, which is null if this Int32 is null.
+func (i Int32) ToInt() Int {
+	return NewInt(int64(i.Int32), i.Valid)
+}
+
+// ToUint converts this Uint8 to a Uint, which is null if this Uint8 is null.
+func (i Uint8) ToUint() Uint {
+	return NewUint(uint64(i.Uint8), i.Valid)
+}
+
+// ToUint converts this Uint16 to a Uint, which is null if this Uint16 is null.
+func (i Uint16) ToUint() Uint {
+	return NewUint(uint64(i.Uint16), i.Valid)
+}
+
+// ToUint converts this Uint32 to a Uint, which is null if this Uint32 is null.
+func (i Uint32) ToUint() Uint {
+	return NewUint(uint64(i.Uint32), i.Valid)
+}
@@ -0,0 +1,109 @@
+package null
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntConversions(t *testing.T) {
+	if got, err := IntFrom(127).ToInt8(); err != nil || !got.Equal(Int8From(127)) {
+		t.Errorf("ToInt8(): got %v, %v", got, err)
+	}
+	if _, err := IntFrom(128).ToInt8(); err == nil {
+		t.Error("ToInt8(128): expected error")
+	}
+	if _, err := IntFrom(math.MinInt32 - 1).ToInt32(); err == nil {
+		t.Error("ToInt32(MinInt32-1): expected error")
+	}
+	if got, err := IntFrom(-32768).ToInt16(); err != nil || !got.Equal(Int16From(-32768)) {
+		t.Errorf("ToInt16(): got %v, %v", got, err)
+	}
+	if _, err := IntFrom(-1).ToUint(); err == nil {
+		t.Error("ToUint(-1): expected error")
+	}
+	if got, err := UintFrom(math.MaxInt64).ToInt(); err != nil || !got.Equal(IntFrom(math.MaxInt64)) {
+		t.Errorf("ToInt(): got %v, %v", got, err)
+	}
+	if _, err := UintFrom(math.MaxInt64 + 1).ToInt(); err == nil {
+		t.Error("ToInt(MaxInt64+1): expected error")
+	}
+	if got, err := UintFrom(255).ToUint8(); err != nil || !got.Equal(Uint8From(255)) {
+		t.Errorf("ToUint8(): got %v, %v", got, err)
+	}
+	if _, err := UintFrom(1 << 32).ToUint32(); err == nil {
+		t.Error("ToUint32(1<<32): expected error")
+	}
+	if got := Int8From(-5).ToInt(); !got.Equal(IntFrom(-5)) {
+		t.Errorf("Int8.ToInt(): got %v", got)
+	}
+	if got := Uint16From(65535).ToUint(); !got.Equal(UintFrom(65535)) {
+		t.Errorf("Uint16.ToUint(): got %v", got)
+	}
+	if got := IntFrom(-3).ToFloat(); !got.Equal(FloatFrom(-3)) {
+		t.Errorf("ToFloat(): got %v", got)
+	}
+
+	// null stays null, without an error
+	if got, err := NewInt(1000, false).ToInt8(); err != nil || got.Valid {
+		t.Errorf("null ToInt8(): got %v, %v", got, err)
+	}
+	if got, err := NewUint(0, false).ToInt(); err != nil || got.Valid {
+		t.Errorf("null ToInt(): got %v, %v", got, err)
+	}
+	if got := NewInt32(0, false).ToInt(); got.Valid {
+		t.Errorf("null ToInt(): got %v", got)
+	}
+	if got := NewUint(0, false).ToFloat(); got.Valid {
+		t.Errorf("null ToFloat(): got %v", got)
+	}
+}
+
+func TestFloatConversions(t *testing.T) {
+	for _, test := range []struct {
+		f        float64
+		rounding Rounding
+		want     int64
+	}{
+		{2, RoundExact, 2},
+		{2.5, RoundTowardZero, 2},
+		{-2.5, RoundTowardZero, -2},
+		{-2.5, RoundDown, -3},
+		{2.1, RoundUp, 3},
+		{2.5, RoundHalfAwayFromZero, 3},
+		{-2.5, RoundHalfAwayFromZero, -3},
+		{2.5, RoundHalfEven, 2},
+		{3.5, RoundHalfEven, 4},
+	} {
+		got, err := FloatFrom(test.f).ToInt(test.rounding)
+		if err != nil || !got.Equal(IntFrom(test.want)) {
+			t.Errorf("ToInt(%v, %d): got %v, %v; want %d", test.f, test.rounding, got, err, test.want)
+		}
+	}
+	for _, bad := range []float64{2.5, math.NaN(), math.Inf(1), 1e19} {
+		if _, err := FloatFrom(bad).ToInt(RoundExact); err == nil {
+			t.Errorf("ToInt(%v): expected error", bad)
+		}
+	}
+	if _, err := FloatFrom(1).ToInt(Rounding(100)); err == nil {
+		t.Error("expected error for invalid Rounding")
+	}
+
+	if got, err := FloatFrom(1e19).ToUint(RoundExact); err != nil || !got.Equal(UintFrom(1e19)) {
+		t.Errorf("ToUint(1e19): got %v, %v", got, err)
+	}
+	if got, err := FloatFrom(-0.4).ToUint(RoundHalfAwayFromZero); err != nil || !got.Equal(UintFrom(0)) {
+		t.Errorf("ToUint(-0.4): got %v, %v", got, err)
+	}
+	for _, bad := range []float64{-1, math.MaxUint64, math.NaN()} {
+		if _, err := FloatFrom(bad).ToUint(RoundTowardZero); err == nil {
+			t.Errorf("ToUint(%v): expected error", bad)
+		}
+	}
+
+	if got, err := NewFloat(0.5, false).ToInt(RoundExact); err != nil || got.Valid {
+		t.Errorf("null ToInt(): got %v, %v", got, err)
+	}
+	if got, err := NewFloat(-1, false).ToUint(RoundExact); err != nil || got.Valid {
+		t.Errorf("null ToUint(): got %v, %v", got, err)
+	}
+}
@@ -0,0 +1,844 @@
+//go:build gocql
+
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/gocql/gocql"
+	"gopkg.in/inf.v0"
+)
+
+// This file implements gocql.Marshaler and gocql.Unmarshaler from the Cassandra driver.
+// It is only built with the gocql build tag, so that the null package stays free of dependencies:
+//
+//	go build -tags gocql
+//
+// Values are encoded as what would be sent to an SQL database (see Value), converted to the column's CQL type,
+// and null values are encoded as CQL null. Binding a null value writes a tombstone. To leave a column untouched instead,
+// bind CQLUnsetIfNull(v), or Optional's CQLValue to only skip absent values.
+
+// CQLUnsetIfNull returns gocql.UnsetValue if v is null, or v otherwise.
+// Binding gocql.UnsetValue leaves the column as it is, instead of writing null.
+// It requires protocol version 4 or higher.
+func CQLUnsetIfNull(v driver.Valuer) interface{} {
+	if val, err := v.Value(); err == nil && val == nil {
+		return gocql.UnsetValue
+	}
+	return v
+}
+
+// CQLValue returns gocql.UnsetValue if this Optional is not present, or this Optional otherwise.
+// Binding it leaves the column as it is if this Optional is absent, and writes null if it is present and null.
+// It requires protocol version 4 or higher.
+func (o Optional[T]) CQLValue() interface{} {
+	if !o.Present {
+		return gocql.UnsetValue
+	}
+	return o
+}
+
+// marshalCQL encodes the driver value of v as CQL.
+func marshalCQL(info gocql.TypeInfo, v driver.Valuer) ([]byte, error) {
+	val, err := v.Value()
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't marshal CQL: %w", err)
+	}
+	if val == nil {
+		return nil, nil
+	}
+	return marshalCQLValue(info, val)
+}
+
+// marshalCQLValue encodes v as CQL, converting it to what gocql expects for float and decimal columns.
+func marshalCQLValue(info gocql.TypeInfo, v interface{}) ([]byte, error) {
+	switch info.Type() {
+	case gocql.TypeFloat:
+		if f, ok := v.(float64); ok {
+			v = float32(f)
+		}
+	case gocql.TypeDecimal:
+		dec, err := cqlDecimal(v)
+		if err != nil {
+			return nil, fmt.Errorf("null: couldn't marshal CQL: %w", err)
+		}
+		v = dec
+	}
+	data, err := gocql.Marshal(info, v)
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't marshal CQL: %w", err)
+	}
+	return data, nil
+}
+
+func cqlDecimal(v interface{}) (*inf.Dec, error) {
+	switch x := v.(type) {
+	case int64:
+		return inf.NewDec(x, 0), nil
+	case uint64:
+		return inf.NewDecBig(new(big.Int).SetUint64(x), 0), nil
+	case *big.Int:
+		return inf.NewDecBig(x, 0), nil
+	case float64:
+		v = strconv.FormatFloat(x, 'f', -1, 64)
+	}
+	if str, ok := v.(string); ok {
+		if dec, ok := new(inf.Dec).SetString(str); ok {
+			return dec, nil
+		}
+	}
+	return nil, fmt.Errorf("can't convert %T to decimal", v)
+}
+
+func isCQLInteger(info gocql.TypeInfo) bool {
+	switch info.Type() {
+	case gocql.TypeBigInt, gocql.TypeInt, gocql.TypeVarint, gocql.TypeCounter:
+		return true
+	}
+	return false
+}
+
+func cqlSinceMidnight(t TimeOfDay) time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+// cqlValue decodes CQL data of the given type into the equivalent driver value.
+// Decimals and UUIDs become strings, and collections become JSON.
+func cqlValue(info gocql.TypeInfo, data []byte) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+	ptr, err := info.NewWithError()
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't unmarshal CQL: %w", err)
+	}
+	if err := gocql.Unmarshal(info, data, ptr); err != nil {
+		return nil, fmt.Errorf("null: couldn't unmarshal CQL: %w", err)
+	}
+	switch x := reflect.ValueOf(ptr).Elem().Interface().(type) {
+	case *inf.Dec:
+		if x == nil {
+			return nil, nil
+		}
+		return x.String(), nil
+	case gocql.UUID:
+		return x.String(), nil
+	case time.Duration:
+		return int64(x), nil
+	case []byte:
+		return x, nil
+	default:
+		switch reflect.TypeOf(x).Kind() {
+		case reflect.Slice, reflect.Map:
+			data, err := json.Marshal(x)
+			if err != nil {
+				return nil, fmt.Errorf("null: couldn't unmarshal CQL: %w", err)
+			}
+			return string(data), nil
+		}
+		return x, nil
+	}
+}
+
+// unmarshalCQL decodes CQL data into the equivalent driver value and scans it into s.
+func unmarshalCQL(s sql.Scanner, info gocql.TypeInfo, data []byte) error {
+	v, err := cqlValue(info, data)
+	if err != nil {
+		return err
+	}
+	return s.Scan(v)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Addr is null.
+func (a Addr) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, a)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Addr can be scanned from.
+func (a *Addr) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(a, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Any is null.
+func (a Any) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, a)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Any can be scanned from.
+func (a *Any) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(a, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Base64 is null.
+func (b Base64) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, b)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Base64 can be scanned from.
+func (b *Base64) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(b, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this BigInt is null.
+func (b BigInt) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return marshalCQLValue(info, b.BigInt)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this BigInt can be scanned from.
+func (b *BigInt) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(b, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, b)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Bool can be scanned from.
+func (b *Bool) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(b, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this ByteSize is null.
+func (b ByteSize) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, b)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this ByteSize can be scanned from.
+func (b *ByteSize) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(b, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Bytes is null.
+func (b Bytes) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, b)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Bytes can be scanned from.
+func (b *Bytes) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(b, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Complex is null.
+func (c Complex) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, c)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Complex can be scanned from.
+func (c *Complex) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(c, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Date is null.
+// For date and timestamp columns, it is encoded as midnight UTC.
+func (d Date) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	switch info.Type() {
+	case gocql.TypeDate, gocql.TypeTimestamp:
+		return marshalCQLValue(info, d.In(time.UTC))
+	}
+	return marshalCQL(info, d)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Date can be scanned from.
+func (d *Date) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(d, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Duration is null.
+func (d Duration) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return marshalCQLValue(info, d.Duration)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Duration can be scanned from.
+func (d *Duration) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(d, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Email is null.
+func (e Email) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, e)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Email can be scanned from.
+func (e *Email) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(e, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Enum is null.
+func (e Enum[T]) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, e)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Enum can be scanned from.
+func (e *Enum[T]) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(e, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this FileMode is null.
+func (m FileMode) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, m)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this FileMode can be scanned from.
+func (m *FileMode) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(m, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Flags is null.
+func (f Flags[T]) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, f)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Flags can be scanned from.
+func (f *Flags[T]) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(f, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Float is null.
+func (f Float) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, f)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Float can be scanned from.
+func (f *Float) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(f, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this HardwareAddr is null.
+func (h HardwareAddr) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, h)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this HardwareAddr can be scanned from.
+func (h *HardwareAddr) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(h, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Hex is null.
+func (h Hex) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, h)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Hex can be scanned from.
+func (h *Hex) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(h, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this FixedHex is null.
+func (h FixedHex[A]) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, h)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this FixedHex can be scanned from.
+func (h *FixedHex[A]) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(h, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Int is null.
+func (i Int) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, i)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Int can be scanned from.
+func (i *Int) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(i, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, i)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Int16 can be scanned from.
+func (i *Int16) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(i, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, i)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Int32 can be scanned from.
+func (i *Int32) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(i, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, i)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Int8 can be scanned from.
+func (i *Int8) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(i, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this CountryCode is null.
+func (c CountryCode) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, c)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this CountryCode can be scanned from.
+func (c *CountryCode) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(c, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this CurrencyCode is null.
+func (c CurrencyCode) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, c)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this CurrencyCode can be scanned from.
+func (c *CurrencyCode) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(c, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this JSON is null.
+func (j JSON) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, j)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this JSON can be scanned from.
+func (j *JSON) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(j, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this KSUID is null.
+func (k KSUID) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, k)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this KSUID can be scanned from.
+func (k *KSUID) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(k, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this LanguageTag is null.
+func (l LanguageTag) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, l)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this LanguageTag can be scanned from.
+func (l *LanguageTag) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(l, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Map is null.
+// For map columns, it is encoded as a collection.
+func (m Map[K, V]) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+	if info.Type() == gocql.TypeMap {
+		return marshalCQLValue(info, m.V)
+	}
+	return marshalCQL(info, m)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Map can be scanned from.
+// Map columns are decoded directly, so that null values can be kept.
+func (m *Map[K, V]) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if data != nil && info.Type() == gocql.TypeMap {
+		var v map[K]V
+		if err := gocql.Unmarshal(info, data, &v); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal CQL: %w", err)
+		}
+		*m = MapFrom(v)
+		return nil
+	}
+	return unmarshalCQL(m, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Money is null.
+func (m Money) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, m)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Money can be scanned from.
+func (m *Money) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(m, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Optional is null.
+func (o Optional[T]) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, o)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Optional can be scanned from.
+func (o *Optional[T]) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(o, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Percent is null.
+func (p Percent) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, p)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Percent can be scanned from.
+func (p *Percent) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(p, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Point is null.
+func (p Point) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, p)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Point can be scanned from.
+func (p *Point) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(p, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Prefix is null.
+func (p Prefix) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, p)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Prefix can be scanned from.
+func (p *Prefix) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(p, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Int64Range is null.
+func (r Int64Range) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, r)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Int64Range can be scanned from.
+func (r *Int64Range) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(r, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this TimeRange is null.
+func (r TimeRange) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, r)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this TimeRange can be scanned from.
+func (r *TimeRange) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(r, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Regexp is null.
+func (r Regexp) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, r)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Regexp can be scanned from.
+func (r *Regexp) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(r, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Semver is null.
+func (s Semver) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, s)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Semver can be scanned from.
+func (s *Semver) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(s, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Slice is null.
+// For list and set columns, it is encoded as a collection.
+func (s Slice[T]) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	switch info.Type() {
+	case gocql.TypeList, gocql.TypeSet:
+		return marshalCQLValue(info, s.V)
+	}
+	return marshalCQL(info, s)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Slice can be scanned from.
+// List and set columns are decoded directly, so that null elements can be kept.
+func (s *Slice[T]) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if data != nil {
+		switch info.Type() {
+		case gocql.TypeList, gocql.TypeSet:
+			var v []T
+			if err := gocql.Unmarshal(info, data, &v); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal CQL: %w", err)
+			}
+			*s = SliceFrom(v)
+			return nil
+		}
+	}
+	return unmarshalCQL(s, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this String is null.
+func (s String) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, s)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this String can be scanned from.
+func (s *String) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(s, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Time is null.
+func (t Time) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return marshalCQLValue(info, t.Time)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Time can be scanned from.
+func (t *Time) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(t, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this TimeOfDay is null.
+// For time columns, it is encoded as nanoseconds since midnight.
+func (t TimeOfDay) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	if info.Type() == gocql.TypeTime {
+		return marshalCQLValue(info, cqlSinceMidnight(t))
+	}
+	return marshalCQL(info, t)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this TimeOfDay can be scanned from.
+// Values of time columns are nanoseconds since midnight.
+func (t *TimeOfDay) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	v, err := cqlValue(info, data)
+	if err != nil {
+		return err
+	}
+	if d, ok := v.(int64); ok && info.Type() == gocql.TypeTime {
+		v = time.Time{}.Add(time.Duration(d))
+	}
+	return t.Scan(v)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Uint is null.
+// Unlike Value, it doesn't depend on DefaultUintValueFormat.
+func (i Uint) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return marshalCQLValue(info, i.Uint64)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Uint can be scanned from.
+func (i *Uint) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(i, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Uint16 is null.
+func (i Uint16) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, i)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Uint16 can be scanned from.
+func (i *Uint16) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(i, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Uint32 is null.
+func (i Uint32) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, i)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Uint32 can be scanned from.
+func (i *Uint32) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(i, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Uint8 is null.
+func (i Uint8) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, i)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Uint8 can be scanned from.
+func (i *Uint8) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(i, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this ULID is null.
+func (u ULID) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, u)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this ULID can be scanned from.
+func (u *ULID) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(u, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this UnixSeconds is null.
+// For integer columns, it is encoded as a number of seconds.
+func (u UnixSeconds) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	if isCQLInteger(info) {
+		return marshalCQLValue(info, u.Time.Unix())
+	}
+	return marshalCQLValue(info, u.Time)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this UnixSeconds can be scanned from.
+func (u *UnixSeconds) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(u, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this UnixMillis is null.
+// For integer columns, it is encoded as a number of milliseconds.
+func (u UnixMillis) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	if isCQLInteger(info) {
+		return marshalCQLValue(info, u.Time.UnixMilli())
+	}
+	return marshalCQLValue(info, u.Time)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this UnixMillis can be scanned from.
+func (u *UnixMillis) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(u, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this URL is null.
+func (u URL) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, u)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this URL can be scanned from.
+func (u *URL) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(u, info, data)
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Value is null.
+func (v Value[T]) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, v)
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null and any CQL value this Value can be scanned from.
+func (v *Value[T]) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	return unmarshalCQL(v, info, data)
+}
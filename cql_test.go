@@ -0,0 +1,107 @@
+//go:build gocql
+
+package null
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+func cqlType(typ gocql.Type) gocql.TypeInfo {
+	return gocql.NewNativeType(4, typ, "")
+}
+
+func TestCQLRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123000000, time.UTC)
+	list := gocql.CollectionType{NativeType: gocql.NewNativeType(4, gocql.TypeList, ""), Elem: cqlType(gocql.TypeInt)}
+	for _, test := range []struct {
+		typ   gocql.TypeInfo
+		value interface{ gocql.Marshaler }
+	}{
+		{cqlType(gocql.TypeBigInt), IntFrom(math.MinInt64)},
+		{cqlType(gocql.TypeInt), Int32From(-5)},
+		{cqlType(gocql.TypeSmallInt), Int16From(300)},
+		{cqlType(gocql.TypeTinyInt), Int8From(-8)},
+		{cqlType(gocql.TypeBigInt), UintFrom(math.MaxInt64)},
+		{cqlType(gocql.TypeVarint), UintFrom(math.MaxUint64)},
+		{cqlType(gocql.TypeVarint), BigIntFrom(new(big.Int).Lsh(big.NewInt(1), 100))},
+		{cqlType(gocql.TypeDecimal), BigIntFrom(big.NewInt(12345))},
+		{cqlType(gocql.TypeDouble), FloatFrom(1.25)},
+		{cqlType(gocql.TypeFloat), FloatFrom(1.25)},
+		{cqlType(gocql.TypeBoolean), BoolFrom(true)},
+		{cqlType(gocql.TypeText), StringFrom("hello")},
+		{cqlType(gocql.TypeBlob), BytesFrom([]byte("hello"))},
+		{cqlType(gocql.TypeTimestamp), TimeFrom(now)},
+		{cqlType(gocql.TypeTimestamp), UnixMillisFrom(now)},
+		{cqlType(gocql.TypeBigInt), UnixSecondsFrom(now.Truncate(time.Second))},
+		{cqlType(gocql.TypeDate), NewDate(2024, 5, 6, true)},
+		{cqlType(gocql.TypeText), NewDate(2024, 5, 6, true)},
+		{cqlType(gocql.TypeTime), NewTimeOfDay(7, 8, 9, 123, true)},
+		{cqlType(gocql.TypeBigInt), DurationFrom(time.Minute)},
+		{cqlType(gocql.TypeInet), exampleAllTypes().Addr},
+		{cqlType(gocql.TypeText), JSONFrom([]byte(`{"a":1}`))},
+		{list, SliceFrom([]int{1, 2})},
+		{list, SliceFrom([]Int{IntFrom(1), {}})},
+		{list, SliceFrom([]int{})},
+		{gocql.CollectionType{
+			NativeType: gocql.NewNativeType(4, gocql.TypeMap, ""),
+			Key:        cqlType(gocql.TypeText),
+			Elem:       cqlType(gocql.TypeText),
+		}, MapFrom(map[string]String{"a": StringFrom("b"), "c": {}})},
+	} {
+		data, err := test.value.MarshalCQL(test.typ)
+		if err != nil {
+			t.Errorf("%T as %s: MarshalCQL: %v", test.value, test.typ, err)
+			continue
+		}
+		out := reflect.New(reflect.TypeOf(test.value))
+		if err := out.Interface().(gocql.Unmarshaler).UnmarshalCQL(test.typ, data); err != nil {
+			t.Errorf("%T as %s: UnmarshalCQL: %v", test.value, test.typ, err)
+			continue
+		}
+		if got := out.Elem().Interface(); !reflect.DeepEqual(got, test.value) {
+			t.Errorf("%T as %s: got %v, want %v", test.value, test.typ, got, test.value)
+		}
+	}
+}
+
+func TestCQLNull(t *testing.T) {
+	rv := reflect.ValueOf(allTypes{})
+	for i := 0; i < rv.NumField(); i++ {
+		name := rv.Type().Field(i).Name
+		data, err := rv.Field(i).Interface().(gocql.Marshaler).MarshalCQL(cqlType(gocql.TypeText))
+		if err != nil || data != nil {
+			t.Errorf("%s: null should marshal as nil: %v %v", name, data, err)
+		}
+
+		out := reflect.New(rv.Field(i).Type())
+		out.Elem().Set(reflect.ValueOf(exampleAllTypes()).Field(i))
+		if err := out.Interface().(gocql.Unmarshaler).UnmarshalCQL(cqlType(gocql.TypeText), nil); err != nil {
+			t.Errorf("%s: UnmarshalCQL: %v", name, err)
+		}
+		if out.Elem().FieldByName("Valid").Bool() {
+			t.Errorf("%s: null should unmarshal as null", name)
+		}
+	}
+}
+
+func TestCQLUnset(t *testing.T) {
+	if CQLUnsetIfNull(Int{}) != gocql.UnsetValue {
+		t.Error("null Int should be unset")
+	}
+	if v := CQLUnsetIfNull(IntFrom(0)); v != IntFrom(0) {
+		t.Errorf("valid Int should be bound as is: %v", v)
+	}
+
+	if (Optional[int]{}).CQLValue() != gocql.UnsetValue {
+		t.Error("absent Optional should be unset")
+	}
+	if v := OptionalNull[int]().CQLValue(); v == gocql.UnsetValue {
+		t.Error("present null Optional should be bound as null, not unset")
+	}
+}
@@ -0,0 +1,235 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// dateLayout is the ISO 8601 layout used for dates.
+const dateLayout = "2006-01-02"
+
+// NullDate represents a calendar date that may be null.
+// It has no time or time zone component.
+// NullDate implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullDate struct {
+	Year  int
+	Month time.Month
+	Day   int
+	Valid bool // Valid is true if the date is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports time.Time values, using the date in the time's own location,
+// and "2006-01-02" strings. A trailing time component is ignored.
+func (n *NullDate) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var t time.Time
+	switch v := value.(type) {
+	case nil:
+		n.Year, n.Month, n.Day, n.Valid = 0, 0, 0, false
+		return nil
+	case time.Time:
+		t = v
+	case []byte:
+		var err error
+		if t, err = parseScannedDate(string(v)); err != nil {
+			return err
+		}
+	case string:
+		var err error
+		if t, err = parseScannedDate(v); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Date: %v", value, value)
+	}
+	n.Year, n.Month, n.Day = t.Date()
+	n.Valid = true
+	return nil
+}
+
+// parseScannedDate parses a date from the database, ignoring any time component.
+func parseScannedDate(str string) (time.Time, error) {
+	if len(str) > len(dateLayout) && (str[len(dateLayout)] == ' ' || str[len(dateLayout)] == 'T') {
+		str = str[:len(dateLayout)]
+	}
+	t, err := time.Parse(dateLayout, str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("null: couldn't scan Date: %w", err)
+	}
+	return t, nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as "2006-01-02" strings.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.format(), nil
+}
+
+// IsZero returns true for invalid NullDates.
+func (n NullDate) IsZero() bool {
+	return !n.Valid
+}
+
+func (n NullDate) format() string {
+	return time.Date(n.Year, n.Month, n.Day, 0, 0, 0, 0, time.UTC).Format(dateLayout)
+}
+
+// Date is a nullable calendar date, for DATE columns.
+// Unlike Time, it has no time or time zone component,
+// so it won't shift to a different day when converted between locations.
+// It will decode to null, not a zero date, if null.
+type Date struct {
+	NullDate
+}
+
+// NewDate creates a new Date.
+func NewDate(year int, month time.Month, day int, valid bool) Date {
+	return Date{
+		NullDate: NullDate{
+			Year:  year,
+			Month: month,
+			Day:   day,
+			Valid: valid,
+		},
+	}
+}
+
+// DateFrom creates a new Date from the date of t in its own location.
+// It will always be valid.
+func DateFrom(t time.Time) Date {
+	year, month, day := t.Date()
+	return NewDate(year, month, day, true)
+}
+
+// DateFromPtr creates a new Date that will be null if t is nil.
+func DateFromPtr(t *time.Time) Date {
+	if t == nil {
+		return NewDate(0, 0, 0, false)
+	}
+	return DateFrom(*t)
+}
+
+// ParseDate parses a "2006-01-02" string into a new Date that will always be valid.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("null: couldn't parse Date: %w", err)
+	}
+	return DateFrom(t), nil
+}
+
+// In returns the time at midnight of this Date in loc,
+// or the zero time if this Date is null.
+func (d Date) In(loc *time.Location) time.Time {
+	if !d.Valid {
+		return time.Time{}
+	}
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports "2006-01-02" strings and null input.
+func (d *Date) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(d, &err)
+	if bytes.Equal(data, nullBytes) {
+		d.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	t, err := time.Parse(dateLayout, str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	d.Year, d.Month, d.Day = t.Date()
+	d.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Date is null, otherwise a "2006-01-02" string.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.format())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Date if the input is blank, "null", or DefaultNullText.
+// It will return an error if the input is not a "2006-01-02" date, blank, or "null".
+func (d *Date) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(d, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		d.Valid = false
+		return nil
+	}
+	t, err := time.Parse(dateLayout, str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	d.Year, d.Month, d.Day = t.Date()
+	d.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Date is null.
+func (d Date) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return nullText(), nil
+	}
+	return []byte(d.format()), nil
+}
+
+// SetValid changes this Date's value and also sets it to be non-null.
+func (d *Date) SetValid(year int, month time.Month, day int) {
+	d.Year, d.Month, d.Day = year, month, day
+	d.Valid = true
+}
+
+// SetNull sets this Date to null, leaving its value as is. Use Reset to clear the value too.
+func (d *Date) SetNull() {
+	d.Valid = false
+}
+
+// Reset sets this Date to null and clears its value.
+func (d *Date) Reset() {
+	*d = Date{}
+}
+
+// SetPtr changes this Date's value to the value v points to, or sets it to be null if v is nil, like DateFromPtr.
+func (d *Date) SetPtr(v *time.Time) {
+	*d = DateFromPtr(v)
+}
+
+// IsZero returns true for invalid Dates.
+// A non-null Date with a zero value will not be considered zero.
+func (d Date) IsZero() bool {
+	return !d.Valid
+}
+
+// IsZeroOrNull returns true for invalid Dates, or valid Dates with a zero date.
+func (d Date) IsZeroOrNull() bool {
+	return !d.Valid || d.Year == 0 && d.Month == 0 && d.Day == 0
+}
+
+// Equal returns true if both Dates are the same day or are both null.
+func (d Date) Equal(other Date) bool {
+	return d.Valid == other.Valid && (!d.Valid || d.NullDate == other.NullDate)
+}
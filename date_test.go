@@ -0,0 +1,259 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	dateString = "2012-12-21"
+	dateJSON   = []byte(`"` + dateString + `"`)
+)
+
+func TestDateFrom(t *testing.T) {
+	d := DateFrom(timeValue1)
+	assertDate(t, d, "DateFrom()")
+
+	// the date should be taken from the time's own location, not UTC
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	d = DateFrom(time.Date(2012, 12, 21, 1, 0, 0, 0, loc))
+	assertDate(t, d, "DateFrom() in another location")
+}
+
+func TestDateFromPtr(t *testing.T) {
+	d := DateFromPtr(&timeValue1)
+	assertDate(t, d, "DateFromPtr()")
+
+	null := DateFromPtr(nil)
+	assertNullDate(t, null, "DateFromPtr(nil)")
+}
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate(dateString)
+	maybePanic(err)
+	assertDate(t, d, "ParseDate()")
+
+	_, err = ParseDate("2012-02-30")
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestDateIn(t *testing.T) {
+	d := NewDate(2012, 12, 21, true)
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	if got := d.In(loc); !got.Equal(time.Date(2012, 12, 21, 0, 0, 0, 0, loc)) {
+		t.Errorf("bad In(): %v", got)
+	}
+
+	null := NewDate(2012, 12, 21, false)
+	if !null.In(loc).IsZero() {
+		t.Error("In() should return the zero time for a null Date")
+	}
+}
+
+func TestUnmarshalDate(t *testing.T) {
+	var d Date
+	err := json.Unmarshal(dateJSON, &d)
+	maybePanic(err)
+	assertDate(t, d, "date json")
+
+	var null Date
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDate(t, null, "null json")
+
+	var withTime Date
+	err = json.Unmarshal(timeJSON, &withTime)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDate(t, withTime, "time json")
+
+	var badType Date
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullDate(t, badType, "wrong type json")
+
+	var invalid Date
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullDate(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalDate(t *testing.T) {
+	var d Date
+	err := d.UnmarshalText([]byte(dateString))
+	maybePanic(err)
+	assertDate(t, d, "UnmarshalText() date")
+
+	var blank Date
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDate(t, blank, "UnmarshalText() empty date")
+
+	var null Date
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullDate(t, null, `UnmarshalText() "null"`)
+
+	var invalid Date
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalDate(t *testing.T) {
+	d := NewDate(2012, 12, 21, true)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(dateJSON), "non-empty json marshal")
+
+	old := NewDate(33, 1, 2, true)
+	data, err = json.Marshal(old)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0033-01-02"`, "padded json marshal")
+
+	null := NewDate(0, 0, 0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalDateText(t *testing.T) {
+	d := NewDate(2012, 12, 21, true)
+	data, err := d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, dateString, "non-empty text marshal")
+
+	null := NewDate(0, 0, 0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestDateIsZero(t *testing.T) {
+	d := NewDate(2012, 12, 21, true)
+	if d.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewDate(0, 0, 0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestDateSetValid(t *testing.T) {
+	change := NewDate(0, 0, 0, false)
+	assertNullDate(t, change, "SetValid()")
+	change.SetValid(2012, 12, 21)
+	assertDate(t, change, "SetValid()")
+}
+
+func TestDateScan(t *testing.T) {
+	var d Date
+	err := d.Scan(timeValue1)
+	maybePanic(err)
+	assertDate(t, d, "scanned time")
+
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	var local Date
+	err = local.Scan(time.Date(2012, 12, 21, 0, 0, 0, 0, loc))
+	maybePanic(err)
+	assertDate(t, local, "scanned local midnight")
+
+	var s Date
+	err = s.Scan(dateString)
+	maybePanic(err)
+	assertDate(t, s, "scanned string")
+
+	var b Date
+	err = b.Scan([]byte(dateString + " 00:00:00"))
+	maybePanic(err)
+	assertDate(t, b, "scanned datetime bytes")
+
+	var null Date
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullDate(t, null, "scanned null")
+
+	var invalid Date
+	err = invalid.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var wrong Date
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestDateValue(t *testing.T) {
+	d := NewDate(2012, 12, 21, true)
+	v, err := d.Value()
+	maybePanic(err)
+	if v != dateString {
+		t.Errorf("bad Value(): %#v ≠ %s", v, dateString)
+	}
+
+	null := NewDate(2012, 12, 21, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestDateEqual(t *testing.T) {
+	d1 := NewDate(2012, 12, 21, false)
+	d2 := NewDate(2000, 1, 1, false)
+	if !d1.Equal(d2) {
+		t.Error("null Dates should be equal")
+	}
+
+	d1 = NewDate(2012, 12, 21, true)
+	d2 = DateFrom(timeValue1)
+	if !d1.Equal(d2) {
+		t.Error("same Dates should be equal")
+	}
+
+	d1 = NewDate(2012, 12, 21, true)
+	d2 = NewDate(2012, 12, 21, false)
+	if d1.Equal(d2) {
+		t.Error("valid and null Dates should not be equal")
+	}
+
+	d1 = NewDate(2012, 12, 21, true)
+	d2 = NewDate(2012, 12, 22, true)
+	if d1.Equal(d2) {
+		t.Error("different Dates should not be equal")
+	}
+}
+
+func assertDate(t *testing.T, d Date, from string) {
+	t.Helper()
+	if d.Year != 2012 || d.Month != time.December || d.Day != 21 {
+		t.Errorf("bad %s date: %d-%d-%d ≠ %s\n", from, d.Year, d.Month, d.Day, dateString)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDate(t *testing.T, d Date, from string) {
+	t.Helper()
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
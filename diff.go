@@ -0,0 +1,45 @@
+package null
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Diff compares two structs of the same type, such as a model before and after an update,
+// and returns the fields that differ, mapped to their values in new. It returns an empty map if no fields differ.
+// This is useful for audit logs, and for UPDATE statements that only set the changed columns,
+// since this package's types can be passed to database/sql as is.
+//
+// Fields are named by their null struct tag, or else by field name. Fields tagged with "-" and unexported fields are skipped.
+// Fields with an Equal method, such as this package's types and time.Time, are compared with it,
+// so null values are equal to each other, regardless of the value left in them, and never equal to a valid value.
+// Other fields are compared with reflect.DeepEqual.
+// Both old and new may be pointers to structs.
+func Diff(old, new interface{}) (map[string]interface{}, error) {
+	ov, nv := reflect.Indirect(reflect.ValueOf(old)), reflect.Indirect(reflect.ValueOf(new))
+	if ov.Kind() != reflect.Struct || nv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: Diff needs two structs, got %T and %T", old, new)
+	}
+	if ov.Type() != nv.Type() {
+		return nil, fmt.Errorf("null: Diff needs two structs of the same type, got %T and %T", old, new)
+	}
+	changed := make(map[string]interface{})
+	for _, f := range structFields(nv.Type(), "null") {
+		of, nf := ov.FieldByIndex(f.index), nv.FieldByIndex(f.index)
+		if !fieldsEqual(of, nf) {
+			changed[f.name] = nf.Interface()
+		}
+	}
+	return changed, nil
+}
+
+// fieldsEqual reports whether two values of the same type are equal, with their Equal method if they have one.
+func fieldsEqual(a, b reflect.Value) bool {
+	if eq := a.MethodByName("Equal"); eq.IsValid() {
+		typ := eq.Type()
+		if typ.NumIn() == 1 && typ.In(0) == a.Type() && typ.NumOut() == 1 && typ.Out(0).Kind() == reflect.Bool {
+			return eq.Call([]reflect.Value{b})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
@@ -0,0 +1,58 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type diffModel struct {
+	ID      int
+	Count   Uint
+	Name    String `null:"name"`
+	Created Time
+	Tags    []string
+	Secret  String `null:"-"`
+	hidden  int
+}
+
+func TestDiff(t *testing.T) {
+	created := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	old := diffModel{ID: 1, Count: UintFrom(1), Name: NewString("left over", false), Created: TimeFrom(created), Tags: []string{"a"}, hidden: 1}
+	new := old
+	new.Name = NewString("different left over", false)
+	new.Created = TimeFrom(created.In(time.FixedZone("UTC+1", 60*60)))
+	new.Secret = StringFrom("changed")
+	new.hidden = 2
+
+	changed, err := Diff(old, &new)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changed) != 0 {
+		t.Errorf("expected no changes, got %v", changed)
+	}
+
+	new.Count = Uint{}
+	new.Name = StringFrom("")
+	new.Tags = append(new.Tags, "b")
+	changed, err = Diff(old, new)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"Count": Uint{},
+		"name":  StringFrom(""),
+		"Tags":  []string{"a", "b"},
+	}
+	if !reflect.DeepEqual(changed, want) {
+		t.Errorf("bad Diff: %v, want %v", changed, want)
+	}
+
+	if _, err := Diff(old, allTypes{}); err == nil {
+		t.Error("expected error for different types")
+	}
+	if _, err := Diff(1, 2); err == nil {
+		t.Error("expected error for non-structs")
+	}
+}
@@ -0,0 +1,279 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// DurationFormat is a way of representing a Duration as JSON.
+type DurationFormat int
+
+const (
+	// DurationString encodes Durations as Go duration strings, such as "1h30m0s".
+	DurationString DurationFormat = iota
+	// DurationNanoseconds encodes Durations as integer nanoseconds.
+	DurationNanoseconds
+	// DurationSeconds encodes Durations as floating point seconds.
+	DurationSeconds
+)
+
+// DefaultDurationFormat is the format Duration uses for JSON marshaling.
+// It also controls how JSON numbers are unmarshaled: as seconds for DurationSeconds,
+// otherwise as nanoseconds. Duration strings are always accepted.
+// It defaults to DurationString.
+var DefaultDurationFormat = DurationString
+
+// NullDuration represents a time.Duration that may be null.
+// NullDuration implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullDuration struct {
+	Duration time.Duration
+	Valid    bool // Valid is true if Duration is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports integer nanoseconds, including floats without a fractional part, and duration strings such as "1h30m".
+func (n *NullDuration) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var err error
+	switch v := value.(type) {
+	case nil:
+		n.Duration, n.Valid = 0, false
+		return nil
+	case int64:
+		n.Duration = time.Duration(v)
+	case float64:
+		i, ok := driverval.FloatToInt(v)
+		if !ok {
+			err = fmt.Errorf("not an integer number of nanoseconds: %v", v)
+		}
+		n.Duration = time.Duration(i)
+	case []byte:
+		n.Duration, err = parseDuration(string(v))
+	case string:
+		n.Duration, err = parseDuration(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Duration: %v", value, value)
+	}
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan Duration: %w", err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as integer nanoseconds.
+func (n NullDuration) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.Duration), nil
+}
+
+// IsZero returns true for invalid NullDurations.
+func (n NullDuration) IsZero() bool {
+	return !n.Valid
+}
+
+// Duration is a nullable time.Duration.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Duration struct {
+	NullDuration
+}
+
+// NewDuration creates a new Duration.
+func NewDuration(d time.Duration, valid bool) Duration {
+	return Duration{
+		NullDuration: NullDuration{
+			Duration: d,
+			Valid:    valid,
+		},
+	}
+}
+
+// DurationFrom creates a new Duration that will always be valid.
+func DurationFrom(d time.Duration) Duration {
+	return NewDuration(d, true)
+}
+
+// DurationFromPtr creates a new Duration that will be null if d is nil.
+func DurationFromPtr(d *time.Duration) Duration {
+	if d == nil {
+		return NewDuration(0, false)
+	}
+	return NewDuration(*d, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Duration) ValueOrZero() time.Duration {
+	if !d.Valid {
+		return 0
+	}
+	return d.Duration
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports duration strings, numbers, and null input.
+// Numbers are interpreted according to DefaultDurationFormat.
+// 0 will not be considered a null Duration.
+func (d *Duration) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(d, &err)
+	if bytes.Equal(data, nullBytes) {
+		d.Valid = false
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	switch x := v.(type) {
+	case string:
+		dur, err := time.ParseDuration(x)
+		if err != nil {
+			return fmt.Errorf("null: couldn't convert string to duration: %w", err)
+		}
+		d.Duration = dur
+	case float64:
+		dur, err := durationFromNumber(data)
+		if err != nil {
+			return err
+		}
+		d.Duration = dur
+	default:
+		return fmt.Errorf("null: JSON input is invalid type (need duration string or number): %s", data)
+	}
+
+	d.Valid = true
+	return nil
+}
+
+// durationFromNumber converts a JSON number to a Duration according to DefaultDurationFormat.
+func durationFromNumber(data []byte) (time.Duration, error) {
+	if DefaultDurationFormat == DurationSeconds {
+		secs, err := strconv.ParseFloat(string(data), 64)
+		if err != nil {
+			return 0, fmt.Errorf("null: couldn't convert number to duration: %w", err)
+		}
+		nanos := secs * float64(time.Second)
+		// float64(math.MaxInt64) rounds up to 1<<63, which doesn't fit
+		if math.IsNaN(nanos) || math.IsInf(nanos, 0) || nanos >= 1<<63 || nanos < -(1<<63) {
+			return 0, errors.New("null: couldn't convert number to duration: out of range")
+		}
+		return time.Duration(nanos), nil
+	}
+	nanos, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("null: couldn't convert number to duration: %w", err)
+	}
+	return time.Duration(nanos), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Duration is null,
+// otherwise it uses DefaultDurationFormat.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	switch DefaultDurationFormat {
+	case DurationNanoseconds:
+		return []byte(strconv.FormatInt(int64(d.Duration), 10)), nil
+	case DurationSeconds:
+		return []byte(strconv.FormatFloat(d.Duration.Seconds(), 'f', -1, 64)), nil
+	}
+	return json.Marshal(d.Duration.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Duration if the input is blank, "null", or DefaultNullText.
+// It supports duration strings such as "1h30m" and integer nanoseconds.
+func (d *Duration) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(d, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		d.Valid = false
+		return nil
+	}
+	dur, err := parseDuration(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	d.Duration = dur
+	d.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Duration is null,
+// otherwise a duration string such as "1h30m0s".
+func (d Duration) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return nullText(), nil
+	}
+	return []byte(d.Duration.String()), nil
+}
+
+// SetValid changes this Duration's value and also sets it to be non-null.
+func (d *Duration) SetValid(v time.Duration) {
+	d.Duration = v
+	d.Valid = true
+}
+
+// SetNull sets this Duration to null, leaving its value as is. Use Reset to clear the value too.
+func (d *Duration) SetNull() {
+	d.Valid = false
+}
+
+// Reset sets this Duration to null and clears its value.
+func (d *Duration) Reset() {
+	*d = Duration{}
+}
+
+// Ptr returns a pointer to this Duration's value, or a nil pointer if this Duration is null.
+func (d Duration) Ptr() *time.Duration {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Duration
+}
+
+// SetPtr changes this Duration's value to the value v points to, or sets it to be null if v is nil, like DurationFromPtr.
+func (d *Duration) SetPtr(v *time.Duration) {
+	*d = DurationFromPtr(v)
+}
+
+// IsZero returns true for invalid Durations.
+// A non-null Duration with a 0 value will not be considered zero.
+func (d Duration) IsZero() bool {
+	return !d.Valid
+}
+
+// IsZeroOrNull returns true for invalid Durations, or valid Durations with a 0 value.
+func (d Duration) IsZeroOrNull() bool {
+	return !d.Valid || d.Duration == 0
+}
+
+// Equal returns true if both Durations have the same value or are both null.
+func (d Duration) Equal(other Duration) bool {
+	return d.Valid == other.Valid && (!d.Valid || d.Duration == other.Duration)
+}
+
+// parseDuration parses a duration string or integer nanoseconds.
+func parseDuration(str string) (time.Duration, error) {
+	if nanos, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return time.Duration(nanos), nil
+	}
+	return time.ParseDuration(str)
+}
@@ -0,0 +1,313 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+var (
+	durationValue     = 90 * time.Minute
+	durationJSON      = []byte(`"1h30m0s"`)
+	durationShortJSON = []byte(`"1h30m"`)
+	durationNanosJSON = []byte(`5400000000000`)
+	durationSecsJSON  = []byte(`5400`)
+)
+
+func TestDurationFrom(t *testing.T) {
+	d := DurationFrom(durationValue)
+	assertDuration(t, d, "DurationFrom()")
+
+	zero := DurationFrom(0)
+	if !zero.Valid {
+		t.Error("DurationFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestDurationFromPtr(t *testing.T) {
+	v := durationValue
+	d := DurationFromPtr(&v)
+	assertDuration(t, d, "DurationFromPtr()")
+
+	null := DurationFromPtr(nil)
+	assertNullDuration(t, null, "DurationFromPtr(nil)")
+}
+
+func TestUnmarshalDuration(t *testing.T) {
+	var d Duration
+	err := json.Unmarshal(durationShortJSON, &d)
+	maybePanic(err)
+	assertDuration(t, d, "duration string json")
+
+	var nanos Duration
+	err = json.Unmarshal(durationNanosJSON, &nanos)
+	maybePanic(err)
+	assertDuration(t, nanos, "duration nanoseconds json")
+
+	var null Duration
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDuration(t, null, "null json")
+
+	var bad Duration
+	err = json.Unmarshal(stringJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDuration(t, bad, "bad duration json")
+
+	var fraction Duration
+	err = json.Unmarshal(floatJSON, &fraction)
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var badType Duration
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullDuration(t, badType, "wrong type json")
+
+	var invalid Duration
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullDuration(t, invalid, "invalid json")
+}
+
+func TestDurationFormats(t *testing.T) {
+	defer func() { DefaultDurationFormat = DurationString }()
+
+	DefaultDurationFormat = DurationNanoseconds
+	data, err := json.Marshal(DurationFrom(durationValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(durationNanosJSON), "nanoseconds json marshal")
+
+	DefaultDurationFormat = DurationSeconds
+	data, err = json.Marshal(DurationFrom(durationValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(durationSecsJSON), "seconds json marshal")
+
+	var secs Duration
+	err = json.Unmarshal(durationSecsJSON, &secs)
+	maybePanic(err)
+	assertDuration(t, secs, "seconds json")
+
+	var half Duration
+	err = json.Unmarshal([]byte(`0.5`), &half)
+	maybePanic(err)
+	if half.Duration != 500*time.Millisecond {
+		t.Errorf("bad fractional seconds json: %v", half.Duration)
+	}
+
+	var str Duration
+	err = json.Unmarshal(durationJSON, &str)
+	maybePanic(err)
+	assertDuration(t, str, "string json with seconds format")
+
+	for _, bad := range []string{`1e20`, `9223372036.854775807`, `9223372036.854776`, `-9223372036.855`} {
+		var overflow Duration
+		if err := json.Unmarshal([]byte(bad), &overflow); err == nil {
+			t.Errorf("%s: expected error, got %v", bad, overflow.Duration)
+		}
+	}
+	var min Duration
+	err = json.Unmarshal([]byte(`-9223372036.854775808`), &min)
+	maybePanic(err)
+	if min.Duration != math.MinInt64 {
+		t.Errorf("bad minimum seconds json: %v", min.Duration)
+	}
+	for _, bad := range []string{"NaN", "+Inf", "-Inf"} {
+		if _, err := durationFromNumber([]byte(bad)); err == nil {
+			t.Errorf("%s: expected error", bad)
+		}
+	}
+}
+
+func TestTextUnmarshalDuration(t *testing.T) {
+	var d Duration
+	err := d.UnmarshalText([]byte("1h30m"))
+	maybePanic(err)
+	assertDuration(t, d, "UnmarshalText() duration")
+
+	var nanos Duration
+	err = nanos.UnmarshalText(durationNanosJSON)
+	maybePanic(err)
+	assertDuration(t, nanos, "UnmarshalText() nanoseconds")
+
+	var blank Duration
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDuration(t, blank, "UnmarshalText() empty duration")
+
+	var null Duration
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullDuration(t, null, `UnmarshalText() "null"`)
+
+	var invalid Duration
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalDuration(t *testing.T) {
+	d := DurationFrom(durationValue)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(durationJSON), "non-empty json marshal")
+
+	null := NewDuration(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalDurationText(t *testing.T) {
+	d := DurationFrom(durationValue)
+	data, err := d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "1h30m0s", "non-empty text marshal")
+
+	null := NewDuration(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestDurationPointer(t *testing.T) {
+	d := DurationFrom(durationValue)
+	ptr := d.Ptr()
+	if *ptr != durationValue {
+		t.Errorf("bad %s duration: %v ≠ %v\n", "pointer", ptr, durationValue)
+	}
+
+	null := NewDuration(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s duration: %v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestDurationIsZero(t *testing.T) {
+	d := DurationFrom(durationValue)
+	if d.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewDuration(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewDuration(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestDurationSetValid(t *testing.T) {
+	change := NewDuration(0, false)
+	assertNullDuration(t, change, "SetValid()")
+	change.SetValid(durationValue)
+	assertDuration(t, change, "SetValid()")
+}
+
+func TestDurationScan(t *testing.T) {
+	var d Duration
+	err := d.Scan(int64(durationValue))
+	maybePanic(err)
+	assertDuration(t, d, "scanned int64")
+
+	var s Duration
+	err = s.Scan("1h30m")
+	maybePanic(err)
+	assertDuration(t, s, "scanned string")
+
+	var b Duration
+	err = b.Scan([]byte("5400000000000"))
+	maybePanic(err)
+	assertDuration(t, b, "scanned bytes")
+
+	var null Duration
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullDuration(t, null, "scanned null")
+
+	var invalid Duration
+	err = invalid.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var wrong Duration
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestDurationValue(t *testing.T) {
+	d := DurationFrom(durationValue)
+	v, err := d.Value()
+	maybePanic(err)
+	if v != int64(durationValue) {
+		t.Errorf("bad Value(): %#v ≠ %d", v, int64(durationValue))
+	}
+
+	null := NewDuration(durationValue, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestDurationEqual(t *testing.T) {
+	d1 := NewDuration(time.Second, false)
+	d2 := NewDuration(time.Minute, false)
+	if !d1.Equal(d2) {
+		t.Error("null Durations should be equal")
+	}
+
+	d1 = DurationFrom(time.Second)
+	d2 = DurationFrom(time.Second)
+	if !d1.Equal(d2) {
+		t.Error("same Durations should be equal")
+	}
+
+	d1 = DurationFrom(time.Second)
+	d2 = NewDuration(time.Second, false)
+	if d1.Equal(d2) {
+		t.Error("valid and null Durations should not be equal")
+	}
+
+	d1 = DurationFrom(time.Second)
+	d2 = DurationFrom(time.Minute)
+	if d1.Equal(d2) {
+		t.Error("different Durations should not be equal")
+	}
+}
+
+func assertDuration(t *testing.T, d Duration, from string) {
+	t.Helper()
+	if d.Duration != durationValue {
+		t.Errorf("bad %s duration: %v ≠ %v\n", from, d.Duration, durationValue)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDuration(t *testing.T, d Duration, from string) {
+	t.Helper()
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
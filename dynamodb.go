@@ -0,0 +1,725 @@
+//go:build dynamodb
+
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// This file implements attributevalue.Marshaler and attributevalue.Unmarshaler
+// from github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue.
+// It is only built with the dynamodb build tag, so that the null package stays free of dependencies:
+//
+//	go build -tags dynamodb
+//
+// Values are encoded as the DynamoDB equivalent of what would be sent to an SQL database (see Value),
+// except that Uint and BigInt are always numbers, and UnixSeconds and UnixMillis are numbers of seconds or milliseconds.
+// Null values are encoded as NULL attributes. To omit them instead, tag the fields with omitempty
+// and enable the encoder's OmitNullAttributeValues option:
+//
+//	type Item struct {
+//		Nickname null.String `dynamodbav:",omitempty"`
+//	}
+//
+//	av, err := attributevalue.MarshalMapWithOptions(item, func(o *attributevalue.EncoderOptions) {
+//		o.OmitNullAttributeValues = true
+//	})
+
+func dynamoDBNull() types.AttributeValue {
+	return &types.AttributeValueMemberNULL{Value: true}
+}
+
+// marshalDynamoDB encodes the driver value of v as a DynamoDB attribute value.
+func marshalDynamoDB(v driver.Valuer) (types.AttributeValue, error) {
+	val, err := v.Value()
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't marshal DynamoDB attribute: %w", err)
+	}
+	switch x := val.(type) {
+	case nil:
+		return dynamoDBNull(), nil
+	case int64:
+		return &types.AttributeValueMemberN{Value: strconv.FormatInt(x, 10)}, nil
+	case uint64:
+		return &types.AttributeValueMemberN{Value: strconv.FormatUint(x, 10)}, nil
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, fmt.Errorf("null: couldn't marshal DynamoDB attribute: unsupported number %v", x)
+		}
+		return &types.AttributeValueMemberN{Value: strconv.FormatFloat(x, 'f', -1, 64)}, nil
+	case bool:
+		return &types.AttributeValueMemberBOOL{Value: x}, nil
+	case string:
+		return &types.AttributeValueMemberS{Value: x}, nil
+	case []byte:
+		return &types.AttributeValueMemberB{Value: x}, nil
+	case time.Time:
+		return &types.AttributeValueMemberS{Value: x.Format(time.RFC3339Nano)}, nil
+	}
+	return nil, fmt.Errorf("null: couldn't marshal DynamoDB attribute: unsupported type %T", val)
+}
+
+// dynamoDBValue converts a DynamoDB attribute value into the equivalent driver value.
+// Numbers become int64 if possible, and strings otherwise.
+func dynamoDBValue(av types.AttributeValue) (interface{}, error) {
+	switch x := av.(type) {
+	case nil, *types.AttributeValueMemberNULL:
+		return nil, nil
+	case *types.AttributeValueMemberN:
+		if i, err := strconv.ParseInt(x.Value, 10, 64); err == nil {
+			return i, nil
+		}
+		return x.Value, nil
+	case *types.AttributeValueMemberS:
+		return x.Value, nil
+	case *types.AttributeValueMemberBOOL:
+		return x.Value, nil
+	case *types.AttributeValueMemberB:
+		return x.Value, nil
+	}
+	return nil, fmt.Errorf("null: couldn't unmarshal DynamoDB attribute of type %T", av)
+}
+
+// unmarshalDynamoDB decodes a DynamoDB attribute value into the equivalent driver value and scans it into s.
+func unmarshalDynamoDB(s sql.Scanner, av types.AttributeValue) error {
+	v, err := dynamoDBValue(av)
+	if err != nil {
+		return err
+	}
+	return s.Scan(v)
+}
+
+// unmarshalDynamoDBTime is like unmarshalDynamoDB, but parses RFC 3339 strings as times.
+func unmarshalDynamoDBTime(s sql.Scanner, av types.AttributeValue) error {
+	v, err := dynamoDBValue(av)
+	if err != nil {
+		return err
+	}
+	if str, ok := v.(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, str); err == nil {
+			v = t
+		}
+	}
+	return s.Scan(v)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Addr is null.
+func (a Addr) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(a)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Addr can be scanned from.
+func (a *Addr) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(a, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Any is null.
+func (a Any) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(a)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Any can be scanned from.
+func (a *Any) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(a, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Base64 is null.
+func (b Base64) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(b)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Base64 can be scanned from.
+func (b *Base64) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(b, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this BigInt is null, otherwise a number.
+func (b BigInt) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	if !b.Valid {
+		return dynamoDBNull(), nil
+	}
+	return &types.AttributeValueMemberN{Value: b.BigInt.String()}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this BigInt can be scanned from.
+func (b *BigInt) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(b, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Bool is null.
+func (b Bool) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(b)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Bool can be scanned from.
+func (b *Bool) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(b, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this ByteSize is null.
+func (b ByteSize) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(b)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this ByteSize can be scanned from.
+func (b *ByteSize) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(b, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Bytes is null.
+func (b Bytes) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(b)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Bytes can be scanned from.
+func (b *Bytes) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(b, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Complex is null.
+func (c Complex) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(c)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Complex can be scanned from.
+func (c *Complex) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(c, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Date is null.
+func (d Date) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(d)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Date can be scanned from.
+func (d *Date) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(d, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Duration is null.
+func (d Duration) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(d)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Duration can be scanned from.
+func (d *Duration) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(d, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Email is null.
+func (e Email) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(e)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Email can be scanned from.
+func (e *Email) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(e, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Enum is null.
+func (e Enum[T]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(e)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Enum can be scanned from.
+func (e *Enum[T]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(e, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this FileMode is null.
+func (m FileMode) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(m)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this FileMode can be scanned from.
+func (m *FileMode) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(m, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Flags is null.
+func (f Flags[T]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(f)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Flags can be scanned from.
+func (f *Flags[T]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(f, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Float is null.
+func (f Float) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(f)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Float can be scanned from.
+func (f *Float) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(f, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this HardwareAddr is null.
+func (h HardwareAddr) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(h)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this HardwareAddr can be scanned from.
+func (h *HardwareAddr) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(h, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Hex is null.
+func (h Hex) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(h)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Hex can be scanned from.
+func (h *Hex) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(h, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this FixedHex is null.
+func (h FixedHex[A]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(h)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this FixedHex can be scanned from.
+func (h *FixedHex[A]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(h, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Int is null.
+func (i Int) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(i)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Int can be scanned from.
+func (i *Int) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(i, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Int16 is null.
+func (i Int16) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(i)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Int16 can be scanned from.
+func (i *Int16) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(i, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Int32 is null.
+func (i Int32) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(i)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Int32 can be scanned from.
+func (i *Int32) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(i, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Int8 is null.
+func (i Int8) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(i)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Int8 can be scanned from.
+func (i *Int8) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(i, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this CountryCode is null.
+func (c CountryCode) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(c)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this CountryCode can be scanned from.
+func (c *CountryCode) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(c, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this CurrencyCode is null.
+func (c CurrencyCode) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(c)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this CurrencyCode can be scanned from.
+func (c *CurrencyCode) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(c, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this JSON is null.
+func (j JSON) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(j)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this JSON can be scanned from.
+func (j *JSON) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(j, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this KSUID is null.
+func (k KSUID) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(k)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this KSUID can be scanned from.
+func (k *KSUID) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(k, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this LanguageTag is null.
+func (l LanguageTag) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(l)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this LanguageTag can be scanned from.
+func (l *LanguageTag) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(l, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Map is null.
+func (m Map[K, V]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(m)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Map can be scanned from.
+func (m *Map[K, V]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(m, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Money is null.
+func (m Money) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(m)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Money can be scanned from.
+func (m *Money) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(m, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Optional is null.
+func (o Optional[T]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(o)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Optional can be scanned from.
+func (o *Optional[T]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(o, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Percent is null.
+func (p Percent) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(p)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Percent can be scanned from.
+func (p *Percent) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(p, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Point is null.
+func (p Point) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(p)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Point can be scanned from.
+func (p *Point) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(p, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Prefix is null.
+func (p Prefix) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(p)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Prefix can be scanned from.
+func (p *Prefix) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(p, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Int64Range is null.
+func (r Int64Range) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(r)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Int64Range can be scanned from.
+func (r *Int64Range) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(r, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this TimeRange is null.
+func (r TimeRange) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(r)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this TimeRange can be scanned from.
+func (r *TimeRange) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(r, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Regexp is null.
+func (r Regexp) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(r)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Regexp can be scanned from.
+func (r *Regexp) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(r, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Semver is null.
+func (s Semver) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(s)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Semver can be scanned from.
+func (s *Semver) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(s, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Slice is null.
+func (s Slice[T]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(s)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Slice can be scanned from.
+func (s *Slice[T]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(s, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this String is null.
+func (s String) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(s)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this String can be scanned from.
+func (s *String) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(s, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Time is null.
+func (t Time) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(t)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Time can be scanned from.
+func (t *Time) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDBTime(t, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this TimeOfDay is null.
+func (t TimeOfDay) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(t)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this TimeOfDay can be scanned from.
+func (t *TimeOfDay) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(t, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Uint is null, otherwise a number.
+// Unlike Value, it doesn't depend on DefaultUintValueFormat.
+func (i Uint) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	if !i.Valid {
+		return dynamoDBNull(), nil
+	}
+	return &types.AttributeValueMemberN{Value: strconv.FormatUint(i.Uint64, 10)}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Uint can be scanned from.
+func (i *Uint) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(i, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Uint16 is null.
+func (i Uint16) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(i)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Uint16 can be scanned from.
+func (i *Uint16) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(i, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Uint32 is null.
+func (i Uint32) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(i)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Uint32 can be scanned from.
+func (i *Uint32) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(i, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Uint8 is null.
+func (i Uint8) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(i)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Uint8 can be scanned from.
+func (i *Uint8) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(i, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this ULID is null.
+func (u ULID) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(u)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this ULID can be scanned from.
+func (u *ULID) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(u, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this UnixSeconds is null, otherwise a number of seconds,
+// which is the format DynamoDB expects for time to live attributes.
+func (u UnixSeconds) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	if !u.Valid {
+		return dynamoDBNull(), nil
+	}
+	return &types.AttributeValueMemberN{Value: strconv.FormatInt(u.Time.Unix(), 10)}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this UnixSeconds can be scanned from.
+func (u *UnixSeconds) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDBTime(u, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this UnixMillis is null, otherwise a number of milliseconds.
+func (u UnixMillis) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	if !u.Valid {
+		return dynamoDBNull(), nil
+	}
+	return &types.AttributeValueMemberN{Value: strconv.FormatInt(u.Time.UnixMilli(), 10)}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this UnixMillis can be scanned from.
+func (u *UnixMillis) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDBTime(u, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this URL is null.
+func (u URL) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(u)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this URL can be scanned from.
+func (u *URL) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(u, av)
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Value is null.
+func (v Value[T]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(v)
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL and any attribute value this Value can be scanned from.
+func (v *Value[T]) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return unmarshalDynamoDB(v, av)
+}
@@ -0,0 +1,118 @@
+//go:build dynamodb
+
+package null
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestDynamoDBRoundTrip(t *testing.T) {
+	in := exampleAllTypes()
+	item, err := attributevalue.MarshalMap(in)
+	maybePanic(err)
+	var out allTypes
+	err = attributevalue.UnmarshalMap(item, &out)
+	maybePanic(err)
+
+	assertAllTypesEqual(t, out, in, "dynamodb round trip")
+	if !out.Optional.IsSet() {
+		t.Error("unmarshaled Optional should be present")
+	}
+}
+
+func TestDynamoDBNull(t *testing.T) {
+	item, err := attributevalue.MarshalMap(allTypes{})
+	maybePanic(err)
+	if len(item) != reflect.TypeOf(allTypes{}).NumField() {
+		t.Fatalf("bad attribute count: %d", len(item))
+	}
+	for name, av := range item {
+		if _, ok := av.(*types.AttributeValueMemberNULL); !ok {
+			t.Errorf("%s: encoded as %T, want NULL", name, av)
+		}
+	}
+
+	var out allTypes
+	out.Int = IntFrom(1)
+	out.Time = TimeFrom(time.Now())
+	err = attributevalue.UnmarshalMap(item, &out)
+	maybePanic(err)
+	if !reflect.DeepEqual(out.Int, Int{}) || out.Time.Valid {
+		t.Errorf("NULL should unmarshal to null: %#v %#v", out.Int, out.Time)
+	}
+	if !out.Optional.IsSet() || out.Optional.Valid {
+		t.Error("NULL should unmarshal to a present, null Optional")
+	}
+}
+
+func TestDynamoDBOmitNull(t *testing.T) {
+	type item struct {
+		ID       Int
+		Nickname String `dynamodbav:",omitempty"`
+	}
+	av, err := attributevalue.MarshalMapWithOptions(item{ID: IntFrom(1)}, func(o *attributevalue.EncoderOptions) {
+		o.OmitNullAttributeValues = true
+	})
+	maybePanic(err)
+	if _, ok := av["Nickname"]; ok || len(av) != 1 {
+		t.Errorf("null omitempty field should be omitted: %v", av)
+	}
+
+	var out item
+	out.Nickname = StringFrom("stale")
+	err = attributevalue.UnmarshalMap(av, &out)
+	maybePanic(err)
+	// missing attributes are left untouched
+	if !out.ID.Equal(IntFrom(1)) || !out.Nickname.Equal(StringFrom("stale")) {
+		t.Errorf("bad unmarshaled item: %#v", out)
+	}
+}
+
+func TestDynamoDBNumbers(t *testing.T) {
+	av, err := UintFrom(math.MaxUint64).MarshalDynamoDBAttributeValue()
+	maybePanic(err)
+	if n, ok := av.(*types.AttributeValueMemberN); !ok || n.Value != "18446744073709551615" {
+		t.Errorf("bad Uint attribute: %#v", av)
+	}
+	var u Uint
+	err = u.UnmarshalDynamoDBAttributeValue(av)
+	maybePanic(err)
+	if !u.Equal(UintFrom(math.MaxUint64)) {
+		t.Errorf("bad unmarshaled Uint: %v", u)
+	}
+
+	ttl := time.Unix(1700000000, 0)
+	av, err = UnixSecondsFrom(ttl).MarshalDynamoDBAttributeValue()
+	maybePanic(err)
+	if n, ok := av.(*types.AttributeValueMemberN); !ok || n.Value != "1700000000" {
+		t.Errorf("bad UnixSeconds attribute: %#v", av)
+	}
+
+	var d Duration
+	err = d.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberN{Value: "1000"})
+	maybePanic(err)
+	if !d.Equal(DurationFrom(1000)) {
+		t.Errorf("bad unmarshaled Duration: %v", d)
+	}
+
+	var f Float
+	err = f.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberN{Value: "1.5"})
+	maybePanic(err)
+	if !f.Equal(FloatFrom(1.5)) {
+		t.Errorf("bad unmarshaled Float: %v", f)
+	}
+
+	if _, err := FloatFrom(math.NaN()).MarshalDynamoDBAttributeValue(); err == nil {
+		t.Error("expected error for NaN")
+	}
+	var s Slice[int]
+	if err := s.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberL{}); err == nil {
+		t.Error("expected error for list attribute")
+	}
+}
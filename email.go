@@ -0,0 +1,222 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullEmail represents an email address that may be null.
+// NullEmail implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullEmail struct {
+	// Address is a normalized address, such as "Bob@example.com".
+	Address string
+	Valid   bool // Valid is true if Address is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the value is not a valid email address.
+func (n *NullEmail) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		n.Address, n.Valid = "", false
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Email: %v", value, value)
+	}
+	addr, err := parseEmail(str)
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan Email: %w", err)
+	}
+	n.Address, n.Valid = addr, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as normalized address strings.
+func (n NullEmail) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Address, nil
+}
+
+// IsZero returns true for invalid NullEmails.
+func (n NullEmail) IsZero() bool {
+	return !n.Valid
+}
+
+// Email is a nullable email address, such as "bob@example.com".
+// Addresses are parsed with net/mail and must be bare addresses, without a display name or angle brackets.
+// Addresses are normalized by lowercasing the domain part. The local part is case sensitive, so it is kept as-is.
+// It will decode to null, not an empty string, if null.
+type Email struct {
+	NullEmail
+}
+
+// NewEmail creates a new Email.
+// It will be null if valid is false or address is not a valid email address.
+func NewEmail(address string, valid bool) Email {
+	if !valid {
+		return Email{}
+	}
+	e, err := ParseEmail(address)
+	if err != nil {
+		return Email{}
+	}
+	return e
+}
+
+// ParseEmail creates a new Email from an address such as "bob@example.com".
+// It returns an error if address is not a valid email address.
+func ParseEmail(address string) (Email, error) {
+	addr, err := parseEmail(address)
+	if err != nil {
+		return Email{}, fmt.Errorf("null: couldn't parse Email: %w", err)
+	}
+	return Email{NullEmail: NullEmail{Address: addr, Valid: true}}, nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise an empty string.
+func (e Email) ValueOrZero() string {
+	if !e.Valid {
+		return ""
+	}
+	return e.Address
+}
+
+// Local returns the part of the address before the @, or an empty string if this Email is null.
+func (e Email) Local() string {
+	local, _ := splitEmail(e.ValueOrZero())
+	return local
+}
+
+// Domain returns the lower case part of the address after the @, or an empty string if this Email is null.
+func (e Email) Domain() string {
+	_, domain := splitEmail(e.ValueOrZero())
+	return domain
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports address strings and null input.
+func (e *Email) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(e, &err)
+	if bytes.Equal(data, nullBytes) {
+		e.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	addr, err := parseEmail(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	e.Address = addr
+	e.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Email is null.
+func (e Email) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(e.Address)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Email if the input is blank or DefaultNullText.
+func (e *Email) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(e, &err)
+	if isNullText(text) {
+		e.Valid = false
+		return nil
+	}
+	addr, err := parseEmail(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	e.Address = addr
+	e.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Email is null.
+func (e Email) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return nullText(), nil
+	}
+	return []byte(e.Address), nil
+}
+
+// SetNull sets this Email to null, leaving its value as is. Use Reset to clear the value too.
+func (e *Email) SetNull() {
+	e.Valid = false
+}
+
+// Reset sets this Email to null and clears its value.
+func (e *Email) Reset() {
+	*e = Email{}
+}
+
+// IsZero returns true for invalid Emails.
+func (e Email) IsZero() bool {
+	return !e.Valid
+}
+
+// IsZeroOrNull returns true for invalid Emails, or valid Emails with an empty address.
+func (e Email) IsZeroOrNull() bool {
+	return !e.Valid || e.Address == ""
+}
+
+// Equal returns true if both Emails are the same normalized address or are both null.
+func (e Email) Equal(other Email) bool {
+	return e.Valid == other.Valid && (!e.Valid || e.Address == other.Address)
+}
+
+// parseEmail parses a bare email address and lowercases its domain.
+func parseEmail(str string) (string, error) {
+	str = strings.TrimSpace(str)
+	if strings.ContainsAny(str, "<>") {
+		return "", fmt.Errorf("email must be a bare address: %q", str)
+	}
+	addr, err := mail.ParseAddress(str)
+	if err != nil {
+		return "", err
+	}
+	if addr.Name != "" {
+		return "", fmt.Errorf("email must be a bare address: %q", str)
+	}
+	local, domain := splitEmail(addr.Address)
+	if local == "" || domain == "" {
+		return "", fmt.Errorf("invalid email address: %q", str)
+	}
+	return local + "@" + strings.ToLower(domain), nil
+}
+
+func splitEmail(addr string) (local, domain string) {
+	i := strings.LastIndexByte(addr, '@')
+	if i < 0 {
+		return addr, ""
+	}
+	return addr[:i], addr[i+1:]
+}
@@ -0,0 +1,174 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	emailString = "Bob.Smith@example.com"
+	emailJSON   = []byte(`"` + emailString + `"`)
+)
+
+func TestParseEmail(t *testing.T) {
+	e, err := ParseEmail("Bob.Smith@EXAMPLE.com")
+	maybePanic(err)
+	assertEmail(t, e, "ParseEmail()")
+
+	e, err = ParseEmail("  Bob.Smith@Example.COM ")
+	maybePanic(err)
+	assertEmail(t, e, "ParseEmail() with spaces")
+
+	for _, bad := range []string{
+		"", "bob", "bob@", "@example.com", "bob@@example.com", "bob smith@example.com",
+		"Bob <bob@example.com>", "<bob@example.com>", "bob@example.com, alice@example.com",
+	} {
+		if _, err := ParseEmail(bad); err == nil {
+			t.Errorf("ParseEmail(%q): expected error", bad)
+		}
+	}
+
+	assertEmail(t, NewEmail(emailString, true), "NewEmail()")
+	assertNullEmail(t, NewEmail(emailString, false), "NewEmail() invalid")
+	assertNullEmail(t, NewEmail("garbage", true), "NewEmail() garbage")
+}
+
+func TestEmailParts(t *testing.T) {
+	e := NewEmail(emailString, true)
+	if e.Local() != "Bob.Smith" || e.Domain() != "example.com" {
+		t.Errorf("bad parts: %q %q", e.Local(), e.Domain())
+	}
+
+	var null Email
+	if null.Local() != "" || null.Domain() != "" || null.ValueOrZero() != "" {
+		t.Error("null parts should be empty")
+	}
+	if e.ValueOrZero() != emailString {
+		t.Error("bad ValueOrZero()")
+	}
+}
+
+func TestUnmarshalEmail(t *testing.T) {
+	var e Email
+	err := json.Unmarshal([]byte(`"Bob.Smith@Example.Com"`), &e)
+	maybePanic(err)
+	assertEmail(t, e, "email json")
+
+	var null Email
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullEmail(t, null, "null json")
+
+	for _, bad := range [][]byte{[]byte(`"garbage"`), []byte(`""`), intJSON, invalidJSON} {
+		var invalid Email
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullEmail(t, invalid, "invalid json")
+	}
+}
+
+func TestMarshalEmail(t *testing.T) {
+	e := NewEmail(emailString, true)
+	data, err := json.Marshal(e)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(emailJSON), "non-empty json marshal")
+
+	data, err = e.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, emailString, "text marshal")
+
+	var null Email
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestEmailText(t *testing.T) {
+	var e Email
+	err := e.UnmarshalText([]byte("Bob.Smith@EXAMPLE.COM"))
+	maybePanic(err)
+	assertEmail(t, e, "text unmarshal")
+
+	var blank Email
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullEmail(t, blank, "blank text unmarshal")
+
+	var bad Email
+	if err := bad.UnmarshalText([]byte("bob@")); err == nil {
+		t.Error("expected error unmarshaling invalid text")
+	}
+}
+
+func TestEmailScanValue(t *testing.T) {
+	var e Email
+	err := e.Scan("Bob.Smith@Example.com")
+	maybePanic(err)
+	assertEmail(t, e, "scanned string")
+
+	var b Email
+	err = b.Scan([]byte(emailString))
+	maybePanic(err)
+	assertEmail(t, b, "scanned bytes")
+
+	var null Email
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullEmail(t, null, "scanned null")
+
+	var bad Email
+	if err := bad.Scan("garbage"); err == nil {
+		t.Error("expected error scanning invalid address")
+	}
+	assertNullEmail(t, bad, "scanned garbage")
+	if err := bad.Scan(int64(1)); err == nil {
+		t.Error("expected error scanning int64")
+	}
+
+	v, err := e.Value()
+	maybePanic(err)
+	if v != emailString {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestEmailEqual(t *testing.T) {
+	if !NewEmail("bob@EXAMPLE.com", true).Equal(NewEmail("bob@example.COM", true)) {
+		t.Error("Emails differing in domain case should be equal")
+	}
+	if NewEmail("Bob@example.com", true).Equal(NewEmail("bob@example.com", true)) {
+		t.Error("Emails differing in local part case should not be equal")
+	}
+	if NewEmail(emailString, true).Equal(Email{}) {
+		t.Error("valid and null Emails should not be equal")
+	}
+	if !(Email{}).Equal(Email{}) || !(Email{}).IsZero() || NewEmail(emailString, true).IsZero() {
+		t.Error("bad null Emails")
+	}
+}
+
+func assertEmail(t *testing.T, e Email, from string) {
+	t.Helper()
+	if e.Address != emailString {
+		t.Errorf("bad %s email: %q ≠ %q\n", from, e.Address, emailString)
+	}
+	if !e.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullEmail(t *testing.T, e Email, from string) {
+	t.Helper()
+	if e.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,265 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+var (
+	enumMu     sync.RWMutex
+	enumValues = make(map[reflect.Type]interface{})
+)
+
+type enumSet[T comparable] struct {
+	values  []T
+	allowed map[T]struct{}
+}
+
+// RegisterEnum sets the permitted values of Enum[T], replacing any previously registered values.
+// It is typically called from an init function or a package-level var declaration.
+func RegisterEnum[T ~string | ~int](values ...T) {
+	set := enumSet[T]{
+		values:  append([]T(nil), values...),
+		allowed: make(map[T]struct{}, len(values)),
+	}
+	for _, v := range values {
+		set.allowed[v] = struct{}{}
+	}
+	enumMu.Lock()
+	defer enumMu.Unlock()
+	enumValues[reflect.TypeOf((*T)(nil)).Elem()] = set
+}
+
+// EnumValues returns the permitted values of Enum[T], in the order they were registered.
+func EnumValues[T ~string | ~int]() []T {
+	set, _ := lookupEnum[T]()
+	return append([]T(nil), set.values...)
+}
+
+func lookupEnum[T ~string | ~int]() (enumSet[T], bool) {
+	enumMu.RLock()
+	defer enumMu.RUnlock()
+	set, ok := enumValues[reflect.TypeOf((*T)(nil)).Elem()].(enumSet[T])
+	return set, ok
+}
+
+// Enum is a nullable value of T that is restricted to the values registered with RegisterEnum.
+// UnmarshalJSON, UnmarshalText, and Scan will return an error for values that aren't permitted,
+// or for any value if none are registered for T.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Enum[T ~string | ~int] struct {
+	sql.Null[T]
+}
+
+// NewEnum creates a new Enum.
+// The value is not checked; see Enum.Check.
+func NewEnum[T ~string | ~int](v T, valid bool) Enum[T] {
+	return Enum[T]{
+		Null: sql.Null[T]{
+			V:     v,
+			Valid: valid,
+		},
+	}
+}
+
+// EnumFrom creates a new Enum that will always be valid.
+// The value is not checked; see Enum.Check.
+func EnumFrom[T ~string | ~int](v T) Enum[T] {
+	return NewEnum(v, true)
+}
+
+// EnumFromPtr creates a new Enum that will be null if v is nil.
+// The value is not checked; see Enum.Check.
+func EnumFromPtr[T ~string | ~int](v *T) Enum[T] {
+	if v == nil {
+		var zero T
+		return NewEnum(zero, false)
+	}
+	return NewEnum(*v, true)
+}
+
+// Check returns an error if this Enum is valid and its value is not permitted.
+// Null is always permitted.
+func (e Enum[T]) Check() error {
+	if !e.Valid {
+		return nil
+	}
+	set, ok := lookupEnum[T]()
+	if !ok {
+		return fmt.Errorf("null: no values registered for Enum[%T]", e.V)
+	}
+	if _, ok := set.allowed[e.V]; !ok {
+		return fmt.Errorf("null: %v is not a permitted value of Enum[%T]", e.V, e.V)
+	}
+	return nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (e Enum[T]) ValueOrZero() T {
+	if !e.Valid {
+		var zero T
+		return zero
+	}
+	return e.V
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the value is not permitted.
+func (e *Enum[T]) Scan(value interface{}) (err error) {
+	defer validateDecoded(e, &err)
+	value = driverval.Convert(value)
+	if f, ok := value.(float64); ok {
+		// database/sql would format large floats with an exponent, which doesn't parse as an int
+		if i, ok := driverval.FloatToInt(f); ok {
+			value = i
+		}
+	}
+	if err := e.Null.Scan(value); err != nil {
+		e.Valid = false
+		return fmt.Errorf("null: couldn't scan Enum: %w", err)
+	}
+	if err := e.Check(); err != nil {
+		e.Valid = false
+		return err
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as strings or int64s, depending on T.
+func (e Enum[T]) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(e.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null input, and any permitted value of T.
+func (e *Enum[T]) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(e, &err)
+	if bytes.Equal(data, nullBytes) {
+		e.Valid = false
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if err := EnumFrom(v).Check(); err != nil {
+		return err
+	}
+
+	e.V = v
+	e.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Enum is null.
+func (e Enum[T]) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(e.V)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Enum if the input is blank or DefaultNullText.
+// It returns an error if the input is not a permitted value.
+func (e *Enum[T]) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(e, &err)
+	if isNullText(text) {
+		e.Valid = false
+		return nil
+	}
+
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Kind() == reflect.String {
+		rv.SetString(string(text))
+	} else {
+		n, err := strconv.ParseInt(string(text), 10, 0)
+		if err != nil {
+			return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+		}
+		rv.SetInt(n)
+	}
+	if err := EnumFrom(v).Check(); err != nil {
+		return err
+	}
+
+	e.V = v
+	e.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Enum is null.
+func (e Enum[T]) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return nullText(), nil
+	}
+	rv := reflect.ValueOf(e.V)
+	if rv.Kind() == reflect.String {
+		return []byte(rv.String()), nil
+	}
+	return []byte(strconv.FormatInt(rv.Int(), 10)), nil
+}
+
+// SetValid changes this Enum's value and also sets it to be non-null.
+// The value is not checked; see Enum.Check.
+func (e *Enum[T]) SetValid(v T) {
+	e.V = v
+	e.Valid = true
+}
+
+// SetNull sets this Enum to null, leaving its value as is. Use Reset to clear the value too.
+func (e *Enum[T]) SetNull() {
+	e.Valid = false
+}
+
+// Reset sets this Enum to null and clears its value.
+func (e *Enum[T]) Reset() {
+	*e = Enum[T]{}
+}
+
+// Ptr returns a pointer to this Enum's value, or a nil pointer if this Enum is null.
+func (e Enum[T]) Ptr() *T {
+	if !e.Valid {
+		return nil
+	}
+	return &e.V
+}
+
+// SetPtr changes this Enum's value to the value v points to, or sets it to be null if v is nil, like EnumFromPtr.
+func (e *Enum[T]) SetPtr(v *T) {
+	*e = EnumFromPtr(v)
+}
+
+// IsZero returns true for invalid Enums.
+// A non-null Enum with a zero value will not be considered zero.
+func (e Enum[T]) IsZero() bool {
+	return !e.Valid
+}
+
+// IsZeroOrNull returns true for invalid Enums, or valid Enums with a zero value.
+func (e Enum[T]) IsZeroOrNull() bool {
+	var zero T
+	return !e.Valid || e.V == zero
+}
+
+// Equal returns true if both Enums have the same value or are both null.
+func (e Enum[T]) Equal(other Enum[T]) bool {
+	return e.Valid == other.Valid && (!e.Valid || e.V == other.V)
+}
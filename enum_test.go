@@ -0,0 +1,253 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testState string
+
+type testLevel int
+
+const (
+	testStateOpen   testState = "open"
+	testStateClosed testState = "closed"
+
+	testLevelLow  testLevel = 1
+	testLevelHigh testLevel = 2
+)
+
+type testUnregistered string
+
+func init() {
+	RegisterEnum(testStateOpen, testStateClosed)
+	RegisterEnum(testLevelLow, testLevelHigh)
+}
+
+func TestEnumValues(t *testing.T) {
+	values := EnumValues[testState]()
+	if len(values) != 2 || values[0] != testStateOpen || values[1] != testStateClosed {
+		t.Errorf("bad EnumValues(): %v", values)
+	}
+	values[0] = "changed"
+	if EnumValues[testState]()[0] != testStateOpen {
+		t.Error("EnumValues() should return a copy")
+	}
+	if len(EnumValues[testUnregistered]()) != 0 {
+		t.Error("EnumValues() of unregistered type should be empty")
+	}
+}
+
+func TestEnumFrom(t *testing.T) {
+	e := EnumFrom(testStateOpen)
+	assertEnum(t, e, "EnumFrom()")
+
+	v := testStateOpen
+	p := EnumFromPtr(&v)
+	assertEnum(t, p, "EnumFromPtr()")
+
+	null := EnumFromPtr[testState](nil)
+	assertNullEnum(t, null, "EnumFromPtr(nil)")
+}
+
+func TestEnumCheck(t *testing.T) {
+	if err := EnumFrom(testStateClosed).Check(); err != nil {
+		t.Error("unexpected error:", err)
+	}
+	if err := NewEnum(testState("bogus"), false).Check(); err != nil {
+		t.Error("null should always be permitted:", err)
+	}
+	if err := EnumFrom(testState("bogus")).Check(); err == nil {
+		t.Error("expected error")
+	}
+	if err := EnumFrom(testUnregistered("open")).Check(); err == nil {
+		t.Error("expected error for unregistered type")
+	}
+}
+
+func TestUnmarshalEnum(t *testing.T) {
+	var e Enum[testState]
+	err := json.Unmarshal([]byte(`"open"`), &e)
+	maybePanic(err)
+	assertEnum(t, e, "enum json")
+
+	var level Enum[testLevel]
+	err = json.Unmarshal([]byte(`2`), &level)
+	maybePanic(err)
+	if level.V != testLevelHigh || !level.Valid {
+		t.Errorf("bad int enum json: %v", level)
+	}
+
+	var null Enum[testState]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullEnum(t, null, "null json")
+
+	var bad Enum[testState]
+	err = json.Unmarshal(stringJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, bad, "not permitted json")
+
+	var badLevel Enum[testLevel]
+	err = json.Unmarshal(intJSON, &badLevel)
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var badType Enum[testState]
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, badType, "wrong type json")
+}
+
+func TestEnumText(t *testing.T) {
+	var e Enum[testState]
+	err := e.UnmarshalText([]byte("open"))
+	maybePanic(err)
+	assertEnum(t, e, "UnmarshalText() enum")
+
+	var level Enum[testLevel]
+	err = level.UnmarshalText([]byte("1"))
+	maybePanic(err)
+	if level.V != testLevelLow || !level.Valid {
+		t.Errorf("bad int enum UnmarshalText(): %v", level)
+	}
+
+	var blank Enum[testState]
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullEnum(t, blank, "UnmarshalText() empty enum")
+
+	var bad Enum[testState]
+	err = bad.UnmarshalText([]byte("bogus"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, bad, "UnmarshalText() not permitted")
+
+	var badLevel Enum[testLevel]
+	err = badLevel.UnmarshalText([]byte("high"))
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	data, err := e.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "open", "non-empty text marshal")
+
+	data, err = level.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "1", "int text marshal")
+
+	data, err = blank.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestMarshalEnum(t *testing.T) {
+	data, err := json.Marshal(EnumFrom(testStateOpen))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"open"`, "non-empty json marshal")
+
+	data, err = json.Marshal(EnumFrom(testLevelHigh))
+	maybePanic(err)
+	assertJSONEquals(t, data, "2", "int json marshal")
+
+	data, err = json.Marshal(NewEnum(testStateOpen, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestEnumScanValue(t *testing.T) {
+	var e Enum[testState]
+	err := e.Scan([]byte("open"))
+	maybePanic(err)
+	assertEnum(t, e, "scanned bytes")
+
+	var level Enum[testLevel]
+	err = level.Scan(int64(2))
+	maybePanic(err)
+	if level.V != testLevelHigh || !level.Valid {
+		t.Errorf("bad scanned int enum: %v", level)
+	}
+
+	var null Enum[testState]
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullEnum(t, null, "scanned null")
+
+	var bad Enum[testState]
+	err = bad.Scan("bogus")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullEnum(t, bad, "scanned not permitted")
+
+	var wrong Enum[testLevel]
+	err = wrong.Scan("high")
+	if err == nil {
+		t.Error("expected error")
+	}
+	if wrong.Valid {
+		t.Error("scanned wrong type should be invalid")
+	}
+
+	v, err := e.Value()
+	maybePanic(err)
+	if v != "open" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = level.Value()
+	maybePanic(err)
+	if v != int64(2) {
+		t.Errorf("bad int Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestEnumMisc(t *testing.T) {
+	e := NewEnum(testStateOpen, false)
+	if !e.IsZero() || e.Ptr() != nil || e.ValueOrZero() != "" {
+		t.Error("bad null Enum")
+	}
+	e.SetValid(testStateOpen)
+	assertEnum(t, e, "SetValid()")
+	if e.IsZero() || *e.Ptr() != testStateOpen || e.ValueOrZero() != testStateOpen {
+		t.Error("bad valid Enum")
+	}
+
+	if !e.Equal(EnumFrom(testStateOpen)) {
+		t.Error("same Enums should be equal")
+	}
+	if e.Equal(EnumFrom(testStateClosed)) || e.Equal(NewEnum(testStateOpen, false)) {
+		t.Error("different Enums should not be equal")
+	}
+	if !NewEnum(testStateOpen, false).Equal(NewEnum(testStateClosed, false)) {
+		t.Error("null Enums should be equal")
+	}
+}
+
+func assertEnum(t *testing.T, e Enum[testState], from string) {
+	t.Helper()
+	if e.V != testStateOpen {
+		t.Errorf("bad %s enum: %q ≠ %q\n", from, e.V, testStateOpen)
+	}
+	if !e.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullEnum(t *testing.T, e Enum[testState], from string) {
+	t.Helper()
+	if e.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,59 @@
+package null
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// structField is a struct field that is saved to or loaded from a map of values,
+// such as a BigQuery row or a Firestore document.
+type structField struct {
+	name      string
+	tagged    bool
+	omitEmpty bool
+	index     []int
+}
+
+// structFields returns the exported fields of a struct type, named by the given struct tag key or else by field name.
+// Fields tagged with "-" are skipped.
+func structFields(typ reflect.Type, key string) []structField {
+	var fields []structField
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(sf.Tag.Get(key), ",")
+		if name == "-" {
+			continue
+		}
+		f := structField{name: name, tagged: name != "", index: sf.Index}
+		if !f.tagged {
+			f.name = sf.Name
+		}
+		for _, opt := range strings.Split(opts, ",") {
+			if opt == "omitempty" {
+				f.omitEmpty = true
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// setField sets a field that doesn't implement sql.Scanner to v, converting it to the field's type if needed.
+// A nil v sets the field to its zero value.
+func setField(field reflect.Value, v interface{}) error {
+	if v == nil {
+		field.SetZero()
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	// Go converts integers to strings as runes, which is never what we want
+	if !rv.Type().ConvertibleTo(field.Type()) || (field.Kind() == reflect.String && rv.Kind() != reflect.String) {
+		return fmt.Errorf("can't convert %T to %s", v, field.Type())
+	}
+	field.Set(rv.Convert(field.Type()))
+	return nil
+}
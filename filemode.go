@@ -0,0 +1,259 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullFileMode represents a file permission mode that may be null.
+// NullFileMode implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullFileMode struct {
+	FileMode fs.FileMode
+	Valid    bool // Valid is true if FileMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports integers, including integral floats, and octal strings such as "0644".
+func (n *NullFileMode) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var mode fs.FileMode
+	var err error
+	switch v := value.(type) {
+	case nil:
+		n.FileMode, n.Valid = 0, false
+		return nil
+	case int64:
+		mode, err = fileModeFromInt(v)
+	case float64:
+		i, ok := driverval.FloatToInt(v)
+		if !ok {
+			err = fmt.Errorf("not an integer: %v", v)
+			break
+		}
+		mode, err = fileModeFromInt(i)
+	case []byte:
+		mode, err = parseFileMode(string(v))
+	case string:
+		mode, err = parseFileMode(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into FileMode: %v", value, value)
+	}
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan FileMode: %w", err)
+	}
+	n.FileMode, n.Valid = mode, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as int64s, so 0644 is sent as 420.
+func (n NullFileMode) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.FileMode), nil
+}
+
+// IsZero returns true for invalid NullFileModes.
+func (n NullFileMode) IsZero() bool {
+	return !n.Valid
+}
+
+// FileMode is a nullable file permission mode, such as 0644.
+// Only the permission bits (fs.ModePerm) are supported.
+// It encodes as an octal string such as "0644" in JSON and text.
+// It does not consider 0 to be null.
+// It will decode to null, not zero, if null.
+type FileMode struct {
+	NullFileMode
+}
+
+// NewFileMode creates a new FileMode.
+func NewFileMode(m fs.FileMode, valid bool) FileMode {
+	return FileMode{
+		NullFileMode: NullFileMode{
+			FileMode: m,
+			Valid:    valid,
+		},
+	}
+}
+
+// FileModeFrom creates a new FileMode that will always be valid.
+func FileModeFrom(m fs.FileMode) FileMode {
+	return NewFileMode(m, true)
+}
+
+// FileModeFromPtr creates a new FileMode that will be null if m is nil.
+func FileModeFromPtr(m *fs.FileMode) FileMode {
+	if m == nil {
+		return NewFileMode(0, false)
+	}
+	return NewFileMode(*m, true)
+}
+
+// ParseFileMode creates a new FileMode from an octal string such as "0644", "644", or "0o644".
+// It returns an error if s is not octal or has bits other than fs.ModePerm.
+func ParseFileMode(s string) (FileMode, error) {
+	m, err := parseFileMode(s)
+	if err != nil {
+		return FileMode{}, fmt.Errorf("null: couldn't parse FileMode: %w", err)
+	}
+	return FileModeFrom(m), nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (m FileMode) ValueOrZero() fs.FileMode {
+	if !m.Valid {
+		return 0
+	}
+	return m.FileMode
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports octal strings such as "0644", numbers, and null input.
+// Since JSON has no octal numbers, numbers are taken as their decimal value: 420 is 0644.
+func (m *FileMode) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(m, &err)
+	if bytes.Equal(data, nullBytes) {
+		m.Valid = false
+		return nil
+	}
+
+	var mode fs.FileMode
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		mode, err = parseFileMode(str)
+	} else {
+		var n int64
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		mode, err = fileModeFromInt(n)
+	}
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	m.FileMode = mode
+	m.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this FileMode is null, otherwise an octal string such as "0644".
+func (m FileMode) MarshalJSON() ([]byte, error) {
+	if !m.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(formatFileMode(m.FileMode))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null FileMode if the input is blank or DefaultNullText, otherwise it parses octal.
+func (m *FileMode) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(m, &err)
+	if isNullText(text) {
+		m.Valid = false
+		return nil
+	}
+	mode, err := parseFileMode(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	m.FileMode = mode
+	m.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this FileMode is null, otherwise an octal string such as "0644".
+func (m FileMode) MarshalText() ([]byte, error) {
+	if !m.Valid {
+		return nullText(), nil
+	}
+	return []byte(formatFileMode(m.FileMode)), nil
+}
+
+// SetValid changes this FileMode's value and also sets it to be non-null.
+func (m *FileMode) SetValid(v fs.FileMode) {
+	m.FileMode = v
+	m.Valid = true
+}
+
+// SetNull sets this FileMode to null, leaving its value as is. Use Reset to clear the value too.
+func (m *FileMode) SetNull() {
+	m.Valid = false
+}
+
+// Reset sets this FileMode to null and clears its value.
+func (m *FileMode) Reset() {
+	*m = FileMode{}
+}
+
+// Ptr returns a pointer to this FileMode's value, or a nil pointer if this FileMode is null.
+func (m FileMode) Ptr() *fs.FileMode {
+	if !m.Valid {
+		return nil
+	}
+	return &m.FileMode
+}
+
+// SetPtr changes this FileMode's value to the value v points to, or sets it to be null if v is nil, like FileModeFromPtr.
+func (m *FileMode) SetPtr(v *fs.FileMode) {
+	*m = FileModeFromPtr(v)
+}
+
+// IsZero returns true for invalid FileModes.
+// A non-null FileMode of 0 will not be considered zero.
+func (m FileMode) IsZero() bool {
+	return !m.Valid
+}
+
+// IsZeroOrNull returns true for invalid FileModes, or valid FileModes with a 0 value.
+func (m FileMode) IsZeroOrNull() bool {
+	return !m.Valid || m.FileMode == 0
+}
+
+// Equal returns true if both FileModes have the same value or are both null.
+func (m FileMode) Equal(other FileMode) bool {
+	return m.Valid == other.Valid && (!m.Valid || m.FileMode == other.FileMode)
+}
+
+func formatFileMode(m fs.FileMode) string {
+	return fmt.Sprintf("%04o", uint32(m))
+}
+
+func parseFileMode(str string) (fs.FileMode, error) {
+	str = strings.TrimSpace(str)
+	digits := strings.TrimPrefix(strings.TrimPrefix(str, "0o"), "0O")
+	if digits == "" {
+		return 0, fmt.Errorf("invalid file mode: %q", str)
+	}
+	n, err := strconv.ParseUint(digits, 8, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid file mode: %q", str)
+	}
+	if n&^uint64(fs.ModePerm) != 0 {
+		return 0, fmt.Errorf("file mode out of range: %q", str)
+	}
+	return fs.FileMode(n), nil
+}
+
+func fileModeFromInt(n int64) (fs.FileMode, error) {
+	if n < 0 || n&^int64(fs.ModePerm) != 0 {
+		return 0, fmt.Errorf("file mode out of range: %d", n)
+	}
+	return fs.FileMode(n), nil
+}
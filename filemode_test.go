@@ -0,0 +1,195 @@
+package null
+
+import (
+	"encoding/json"
+	"io/fs"
+	"testing"
+)
+
+var (
+	fileModeValue   fs.FileMode = 0644
+	fileModeJSON                = []byte(`"0644"`)
+	fileModeIntJSON             = []byte(`420`)
+)
+
+func TestFileModeFrom(t *testing.T) {
+	assertFileMode(t, FileModeFrom(fileModeValue), "FileModeFrom()")
+
+	zero := FileModeFrom(0)
+	if !zero.Valid {
+		t.Error("FileModeFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestFileModeFromPtr(t *testing.T) {
+	v := fileModeValue
+	assertFileMode(t, FileModeFromPtr(&v), "FileModeFromPtr()")
+	assertNullFileMode(t, FileModeFromPtr(nil), "FileModeFromPtr(nil)")
+}
+
+func TestParseFileMode(t *testing.T) {
+	for _, in := range []string{"0644", "644", "0o644", " 0644 "} {
+		m, err := ParseFileMode(in)
+		if err != nil {
+			t.Errorf("ParseFileMode(%q): unexpected error: %v", in, err)
+			continue
+		}
+		assertFileMode(t, m, "ParseFileMode("+in+")")
+	}
+
+	for _, bad := range []string{"", "0o", "0648", "rw-r--r--", "-644", "1777", "17777777777777"} {
+		if _, err := ParseFileMode(bad); err == nil {
+			t.Errorf("ParseFileMode(%q): expected error", bad)
+		}
+	}
+}
+
+func TestUnmarshalFileMode(t *testing.T) {
+	var m FileMode
+	err := json.Unmarshal(fileModeJSON, &m)
+	maybePanic(err)
+	assertFileMode(t, m, "octal string json")
+
+	var n FileMode
+	err = json.Unmarshal(fileModeIntJSON, &n)
+	maybePanic(err)
+	assertFileMode(t, n, "number json")
+
+	var null FileMode
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullFileMode(t, null, "null json")
+
+	for _, bad := range [][]byte{[]byte(`"0999"`), []byte(`""`), []byte(`512`), []byte(`-1`), floatJSON, boolJSON, invalidJSON} {
+		var invalid FileMode
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullFileMode(t, invalid, "invalid json")
+	}
+}
+
+func TestMarshalFileMode(t *testing.T) {
+	m := FileModeFrom(fileModeValue)
+	data, err := json.Marshal(m)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(fileModeJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(FileModeFrom(0))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0000"`, "zero json marshal")
+
+	null := NewFileMode(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestFileModeText(t *testing.T) {
+	m := FileModeFrom(0755)
+	data, err := m.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0755", "text marshal")
+
+	var text FileMode
+	err = text.UnmarshalText([]byte("644"))
+	maybePanic(err)
+	assertFileMode(t, text, "text unmarshal")
+
+	var blank FileMode
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullFileMode(t, blank, "blank text unmarshal")
+
+	null := NewFileMode(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var bad FileMode
+	if err := bad.UnmarshalText([]byte("9")); err == nil {
+		t.Error("expected error unmarshaling non-octal text")
+	}
+}
+
+func TestFileModeScanValue(t *testing.T) {
+	for _, in := range []interface{}{int64(420), "0644", []byte("644")} {
+		var m FileMode
+		err := m.Scan(in)
+		maybePanic(err)
+		assertFileMode(t, m, "scanned")
+	}
+
+	var null FileMode
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullFileMode(t, null, "scanned null")
+
+	for _, bad := range []interface{}{int64(-1), int64(01000), "x", 1.5} {
+		var invalid FileMode
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %v", bad)
+		}
+		assertNullFileMode(t, invalid, "invalid scan")
+	}
+
+	v, err := FileModeFrom(fileModeValue).Value()
+	maybePanic(err)
+	if v != int64(420) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestFileModePointer(t *testing.T) {
+	m := FileModeFrom(fileModeValue)
+	if ptr := m.Ptr(); ptr == nil || *ptr != fileModeValue {
+		t.Errorf("bad pointer: %v", ptr)
+	}
+	if NewFileMode(0, false).Ptr() != nil {
+		t.Error("null Ptr() should be nil")
+	}
+	if m.ValueOrZero() != fileModeValue || NewFileMode(fileModeValue, false).ValueOrZero() != 0 {
+		t.Error("bad ValueOrZero()")
+	}
+}
+
+func TestFileModeSetValid(t *testing.T) {
+	change := NewFileMode(0, false)
+	assertNullFileMode(t, change, "SetValid()")
+	change.SetValid(fileModeValue)
+	assertFileMode(t, change, "SetValid()")
+}
+
+func TestFileModeEqual(t *testing.T) {
+	if !FileModeFrom(0644).Equal(FileModeFrom(0644)) || !NewFileMode(1, false).Equal(NewFileMode(2, false)) {
+		t.Error("FileModes should be equal")
+	}
+	if FileModeFrom(0644).Equal(FileModeFrom(0600)) || FileModeFrom(0).Equal(NewFileMode(0, false)) {
+		t.Error("FileModes should not be equal")
+	}
+	if FileModeFrom(0).IsZero() || !NewFileMode(0, false).IsZero() {
+		t.Error("bad IsZero()")
+	}
+}
+
+func assertFileMode(t *testing.T, m FileMode, from string) {
+	t.Helper()
+	if m.FileMode != fileModeValue {
+		t.Errorf("bad %s file mode: %o ≠ %o\n", from, m.FileMode, fileModeValue)
+	}
+	if !m.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullFileMode(t *testing.T, m FileMode, from string) {
+	t.Helper()
+	if m.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
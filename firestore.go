@@ -0,0 +1,95 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// The Firestore client (cloud.google.com/go/firestore) has no interface for custom encoding,
+// so it would store fields of this package's types as maps of their fields.
+// FirestoreMap and FirestoreLoad convert structs to and from the maps of document data it accepts instead.
+// They only use built-in types, so no build tag is needed.
+
+// FirestoreMap converts a struct, or a pointer to one, into document data for the Firestore client,
+// for use with methods like DocumentRef.Set. Null values are stored as Firestore null,
+// or omitted if the field is tagged with omitempty.
+//
+// Fields are named by their firestore struct tag, or else by field name.
+// Fields tagged with "-" and unexported fields are skipped.
+// Values are stored as what would be sent to an SQL database (see Value), except that Time is always a timestamp.
+func FirestoreMap(v interface{}) (map[string]interface{}, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: FirestoreMap needs a struct, got %T", v)
+	}
+	data := make(map[string]interface{})
+	for _, f := range structFields(rv.Type(), "firestore") {
+		field := rv.FieldByIndex(f.index)
+		val, err := firestoreValue(field.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("null: couldn't convert Firestore field %s: %w", f.name, err)
+		}
+		if f.omitEmpty && (val == nil || field.IsZero()) {
+			continue
+		}
+		data[f.name] = val
+	}
+	return data, nil
+}
+
+// FirestoreLoad sets the fields of the struct pointed to by dst from Firestore document data,
+// such as the result of DocumentSnapshot.Data.
+// Fields that implement sql.Scanner scan the equivalent driver value, with arrays and maps scanned as JSON,
+// and other fields are set to the value if it is convertible to the field's type.
+// Fields missing from data are set to their zero value, which is null for this package's types.
+func FirestoreLoad(data map[string]interface{}, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("null: FirestoreLoad needs a pointer to a struct, got %T", dst)
+	}
+	rv = rv.Elem()
+	for _, f := range structFields(rv.Type(), "firestore") {
+		field := rv.FieldByIndex(f.index)
+		v, ok := data[f.name]
+		if !ok {
+			field.SetZero()
+			continue
+		}
+		if err := loadFirestoreValue(field, v); err != nil {
+			return fmt.Errorf("null: couldn't load Firestore field %s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+func firestoreValue(v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case Time:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.Time, nil
+	case driver.Valuer:
+		return x.Value()
+	}
+	return v, nil
+}
+
+func loadFirestoreValue(field reflect.Value, v interface{}) error {
+	s, ok := field.Addr().Interface().(sql.Scanner)
+	if !ok {
+		return setField(field, v)
+	}
+	switch v.(type) {
+	case []interface{}, map[string]interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		v = string(data)
+	}
+	return s.Scan(v)
+}
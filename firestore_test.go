@@ -0,0 +1,67 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFirestoreRoundTrip(t *testing.T) {
+	in := exampleAllTypes()
+	data, err := FirestoreMap(&in)
+	maybePanic(err)
+	var out allTypes
+	err = FirestoreLoad(data, &out)
+	maybePanic(err)
+	assertAllTypesEqual(t, out, in, "firestore round trip")
+
+	if _, ok := data["Time"].(time.Time); !ok {
+		t.Errorf("Time should be stored as a timestamp, got %T", data["Time"])
+	}
+}
+
+func TestFirestoreNull(t *testing.T) {
+	type doc struct {
+		ID       int    `firestore:"id"`
+		Name     String `firestore:"name"`
+		Nickname String `firestore:"nickname,omitempty"`
+		Count    Int    `firestore:",omitempty"`
+		Secret   String `firestore:"-"`
+	}
+	data, err := FirestoreMap(doc{ID: 1, Count: IntFrom(0), Secret: StringFrom("x")})
+	maybePanic(err)
+	want := map[string]interface{}{"id": 1, "name": nil, "Count": int64(0)}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("bad document data: %#v", data)
+	}
+
+	out := doc{Name: StringFrom("stale"), Nickname: StringFrom("stale")}
+	err = FirestoreLoad(map[string]interface{}{"id": int64(2), "name": nil}, &out)
+	maybePanic(err)
+	if out.ID != 2 || out.Name.Valid || out.Nickname.Valid || out.Count.Valid {
+		t.Errorf("null and missing fields should load as null: %#v", out)
+	}
+
+	if _, err := FirestoreMap(1); err == nil {
+		t.Error("expected error for non-struct")
+	}
+	if err := FirestoreLoad(nil, out); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+}
+
+func TestFirestoreLoadArray(t *testing.T) {
+	var out struct {
+		Tags  Slice[string]
+		Attrs Map[string, int]
+	}
+	data := map[string]interface{}{
+		"Tags":  []interface{}{"a", nil},
+		"Attrs": map[string]interface{}{"a": int64(1)},
+	}
+	err := FirestoreLoad(data, &out)
+	maybePanic(err)
+	if !reflect.DeepEqual(out.Tags.V, []string{"a", ""}) || out.Attrs.V["a"] != 1 {
+		t.Errorf("bad loaded array and map: %#v", out)
+	}
+}
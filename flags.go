@@ -0,0 +1,323 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+var (
+	flagsMu    sync.RWMutex
+	flagsNames = make(map[reflect.Type]interface{})
+)
+
+// RegisterFlagNames sets the names of the bits of Flags[T], replacing any previously registered names.
+// Each key of names should be a single bit. Once names are registered,
+// Flags[T] marshals to a JSON array of names instead of a number.
+// It is typically called from an init function.
+func RegisterFlagNames[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](names map[T]string) {
+	copied := make(map[T]string, len(names))
+	for bit, name := range names {
+		copied[bit] = name
+	}
+	flagsMu.Lock()
+	defer flagsMu.Unlock()
+	flagsNames[reflect.TypeOf((*T)(nil)).Elem()] = copied
+}
+
+func lookupFlagNames[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64]() (map[T]string, bool) {
+	flagsMu.RLock()
+	defer flagsMu.RUnlock()
+	names, ok := flagsNames[reflect.TypeOf((*T)(nil)).Elem()].(map[T]string)
+	return names, ok
+}
+
+// Flags is a nullable bitmask of T.
+// The bit helpers are null-safe: a null Flags has no bits set.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Flags[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64] struct {
+	sql.Null[T]
+}
+
+// NewFlags creates a new Flags.
+func NewFlags[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](v T, valid bool) Flags[T] {
+	return Flags[T]{
+		Null: sql.Null[T]{
+			V:     v,
+			Valid: valid,
+		},
+	}
+}
+
+// FlagsFrom creates a new Flags that will always be valid.
+func FlagsFrom[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](v T) Flags[T] {
+	return NewFlags(v, true)
+}
+
+// FlagsFromPtr creates a new Flags that will be null if v is nil.
+func FlagsFromPtr[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](v *T) Flags[T] {
+	if v == nil {
+		return NewFlags[T](0, false)
+	}
+	return NewFlags(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Flags[T]) ValueOrZero() T {
+	if !f.Valid {
+		return 0
+	}
+	return f.V
+}
+
+// Has returns true if all of the bits of flag are set.
+// It returns false if this Flags is null.
+func (f Flags[T]) Has(flag T) bool {
+	return f.Valid && f.V&flag == flag
+}
+
+// Set sets the bits of flag. A null Flags becomes valid, with only those bits set.
+func (f *Flags[T]) Set(flag T) {
+	f.SetValid(f.ValueOrZero() | flag)
+}
+
+// Clear clears the bits of flag. A null Flags stays null.
+func (f *Flags[T]) Clear(flag T) {
+	if f.Valid {
+		f.V &^= flag
+	}
+}
+
+// Toggle flips the bits of flag. A null Flags becomes valid, with only those bits set.
+func (f *Flags[T]) Toggle(flag T) {
+	f.SetValid(f.ValueOrZero() ^ flag)
+}
+
+// Names returns the registered names of the bits that are set, from the lowest bit to the highest.
+// It returns an error if any set bit has no name, or if no names are registered for T.
+func (f Flags[T]) Names() ([]string, error) {
+	names, ok := lookupFlagNames[T]()
+	if !ok {
+		return nil, fmt.Errorf("null: no names registered for Flags[%T]", f.V)
+	}
+	v := uint64(f.ValueOrZero())
+	list := make([]string, 0, bits.OnesCount64(v))
+	for v != 0 {
+		bit := uint64(1) << bits.TrailingZeros64(v)
+		name, ok := names[T(bit)]
+		if !ok {
+			return nil, fmt.Errorf("null: bit %#x of Flags[%T] has no name", bit, f.V)
+		}
+		list = append(list, name)
+		v &^= bit
+	}
+	return list, nil
+}
+
+// Scan implements the Scanner interface.
+// It supports integers, including integral floats, and integer strings.
+// Negative int64 values are accepted for 64-bit T, as a bigint column would store the highest bit.
+func (f *Flags[T]) Scan(value interface{}) (err error) {
+	defer validateDecoded(f, &err)
+	value = driverval.Convert(value)
+	var v uint64
+	if x, ok := value.(float64); ok {
+		i, ok := driverval.FloatToInt(x)
+		if !ok {
+			f.Valid = false
+			return fmt.Errorf("null: couldn't scan Flags: not an integer in range: %v", x)
+		}
+		value = i
+	}
+	switch x := value.(type) {
+	case nil:
+		f.V, f.Valid = 0, false
+		return nil
+	case int64:
+		if x < 0 && flagsBits[T]() != 64 {
+			err = fmt.Errorf("value out of range: %d", x)
+		}
+		v = uint64(x)
+	case []byte:
+		v, err = parseFlags[T](string(x))
+	case string:
+		v, err = parseFlags[T](x)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Flags: %v", value, value)
+	}
+	if err == nil && flagsBits[T]() < 64 && v>>flagsBits[T]() != 0 {
+		err = fmt.Errorf("value out of range: %d", v)
+	}
+	if err != nil {
+		f.Valid = false
+		return fmt.Errorf("null: couldn't scan Flags: %w", err)
+	}
+	f.V, f.Valid = T(v), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as int64s. For 64-bit T, the highest bit is sent as the sign bit.
+func (f Flags[T]) Value() (driver.Value, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return int64(f.V), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports numbers, arrays of registered names, and null input.
+func (f *Flags[T]) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(f, &err)
+	if bytes.Equal(data, nullBytes) {
+		f.Valid = false
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '[' {
+		var list []string
+		if err := json.Unmarshal(data, &list); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		names, ok := lookupFlagNames[T]()
+		if !ok {
+			return fmt.Errorf("null: no names registered for Flags[%T]", f.V)
+		}
+		var v T
+	next:
+		for _, name := range list {
+			for bit, n := range names {
+				if n == name {
+					v |= bit
+					continue next
+				}
+			}
+			return fmt.Errorf("null: unknown name for Flags[%T]: %q", f.V, name)
+		}
+		f.V, f.Valid = v, true
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	f.V, f.Valid = v, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Flags is null.
+// If names are registered for T, it encodes an array of names, otherwise a number.
+func (f Flags[T]) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+	if _, ok := lookupFlagNames[T](); ok {
+		names, err := f.Names()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(names)
+	}
+	return []byte(strconv.FormatUint(uint64(f.V), 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Flags if the input is blank, "null", or DefaultNullText.
+func (f *Flags[T]) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(f, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseUint(str, 10, flagsBits[T]())
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	f.V, f.Valid = T(v), true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Flags is null, otherwise a decimal number.
+func (f Flags[T]) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatUint(uint64(f.V), 10)), nil
+}
+
+// SetValid changes this Flags' value and also sets it to be non-null.
+func (f *Flags[T]) SetValid(v T) {
+	f.V = v
+	f.Valid = true
+}
+
+// SetNull sets this Flags to null, leaving its value as is. Use Reset to clear the value too.
+func (f *Flags[T]) SetNull() {
+	f.Valid = false
+}
+
+// Reset sets this Flags to null and clears its value.
+func (f *Flags[T]) Reset() {
+	*f = Flags[T]{}
+}
+
+// Ptr returns a pointer to this Flags' value, or a nil pointer if this Flags is null.
+func (f Flags[T]) Ptr() *T {
+	if !f.Valid {
+		return nil
+	}
+	return &f.V
+}
+
+// SetPtr changes this Flags's value to the value v points to, or sets it to be null if v is nil, like FlagsFromPtr.
+func (f *Flags[T]) SetPtr(v *T) {
+	*f = FlagsFromPtr(v)
+}
+
+// IsZero returns true for invalid Flags.
+// A non-null Flags with no bits set will not be considered zero.
+func (f Flags[T]) IsZero() bool {
+	return !f.Valid
+}
+
+// IsZeroOrNull returns true for invalid Flagss, or valid Flagss with no bits set.
+func (f Flags[T]) IsZeroOrNull() bool {
+	return !f.Valid || f.V == 0
+}
+
+// Equal returns true if both Flags have the same bits set or are both null.
+func (f Flags[T]) Equal(other Flags[T]) bool {
+	return f.Valid == other.Valid && (!f.Valid || f.V == other.V)
+}
+
+func flagsBits[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64]() int {
+	return bits.Len64(uint64(^T(0)))
+}
+
+func parseFlags[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](str string) (uint64, error) {
+	if v, err := strconv.ParseUint(str, 10, flagsBits[T]()); err == nil {
+		return v, nil
+	}
+	// signed representation of the highest bit
+	n, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 && flagsBits[T]() != 64 {
+		return 0, fmt.Errorf("value out of range: %d", n)
+	}
+	return uint64(n), nil
+}
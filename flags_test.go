@@ -0,0 +1,248 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testPerm uint8
+
+const (
+	testPermRead testPerm = 1 << iota
+	testPermWrite
+	testPermAdmin
+)
+
+type testNamedPerm uint16
+
+func init() {
+	RegisterFlagNames(map[testNamedPerm]string{
+		1: "read",
+		2: "write",
+		4: "admin",
+	})
+}
+
+func TestFlagsFrom(t *testing.T) {
+	f := FlagsFrom(testPermRead | testPermWrite)
+	assertFlags(t, f, "FlagsFrom()")
+
+	zero := FlagsFrom[testPerm](0)
+	if !zero.Valid {
+		t.Error("FlagsFrom(0)", "is invalid, but should be valid")
+	}
+
+	v := testPermRead | testPermWrite
+	p := FlagsFromPtr(&v)
+	assertFlags(t, p, "FlagsFromPtr()")
+
+	null := FlagsFromPtr[testPerm](nil)
+	assertNullFlags(t, null, "FlagsFromPtr(nil)")
+}
+
+func TestFlagsBits(t *testing.T) {
+	f := FlagsFrom(testPermRead)
+	if !f.Has(testPermRead) || f.Has(testPermWrite) || f.Has(testPermRead|testPermWrite) {
+		t.Errorf("bad Has(): %#v", f)
+	}
+	f.Set(testPermWrite)
+	assertFlags(t, f, "Set()")
+	f.Toggle(testPermRead | testPermAdmin)
+	if f.V != testPermWrite|testPermAdmin {
+		t.Errorf("bad Toggle(): %#v", f)
+	}
+	f.Clear(testPermAdmin)
+	if f.V != testPermWrite || !f.Valid {
+		t.Errorf("bad Clear(): %#v", f)
+	}
+
+	var null Flags[testPerm]
+	if null.Has(testPermRead) || null.Has(0) {
+		t.Error("null Flags should have no bits")
+	}
+	null.Clear(testPermRead)
+	assertNullFlags(t, null, "Clear() of null")
+	null.Set(testPermRead)
+	if null.V != testPermRead || !null.Valid {
+		t.Errorf("bad Set() of null: %#v", null)
+	}
+	var toggled Flags[testPerm]
+	toggled.Toggle(testPermWrite)
+	if toggled.V != testPermWrite || !toggled.Valid {
+		t.Errorf("bad Toggle() of null: %#v", toggled)
+	}
+}
+
+func TestFlagsNames(t *testing.T) {
+	names, err := FlagsFrom[testNamedPerm](5).Names()
+	maybePanic(err)
+	if len(names) != 2 || names[0] != "read" || names[1] != "admin" {
+		t.Errorf("bad Names(): %v", names)
+	}
+	if _, err := FlagsFrom[testNamedPerm](8).Names(); err == nil {
+		t.Error("expected error for unnamed bit")
+	}
+	if _, err := FlagsFrom(testPermRead).Names(); err == nil {
+		t.Error("expected error for unregistered type")
+	}
+}
+
+func TestUnmarshalFlags(t *testing.T) {
+	var f Flags[testPerm]
+	err := json.Unmarshal([]byte(`3`), &f)
+	maybePanic(err)
+	assertFlags(t, f, "number json")
+
+	var named Flags[testNamedPerm]
+	err = json.Unmarshal([]byte(`["admin","read"]`), &named)
+	maybePanic(err)
+	if named.V != 5 || !named.Valid {
+		t.Errorf("bad names json: %#v", named)
+	}
+
+	var namedNumber Flags[testNamedPerm]
+	err = json.Unmarshal([]byte(`5`), &namedNumber)
+	maybePanic(err)
+	if !namedNumber.Equal(named) {
+		t.Errorf("bad number json with names: %#v", namedNumber)
+	}
+
+	var null Flags[testPerm]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullFlags(t, null, "null json")
+
+	for _, bad := range []string{`256`, `-1`, `"3"`, `["read"]`} {
+		var invalid Flags[testPerm]
+		if err := json.Unmarshal([]byte(bad), &invalid); err == nil {
+			t.Errorf("%s: expected error", bad)
+		}
+		assertNullFlags(t, invalid, "invalid json")
+	}
+
+	var unknown Flags[testNamedPerm]
+	if err := json.Unmarshal([]byte(`["read","delete"]`), &unknown); err == nil {
+		t.Error("expected error for unknown name")
+	}
+}
+
+func TestMarshalFlags(t *testing.T) {
+	data, err := json.Marshal(FlagsFrom(testPermRead | testPermWrite))
+	maybePanic(err)
+	assertJSONEquals(t, data, "3", "number json marshal")
+
+	data, err = json.Marshal(FlagsFrom[testNamedPerm](6))
+	maybePanic(err)
+	assertJSONEquals(t, data, `["write","admin"]`, "names json marshal")
+
+	data, err = json.Marshal(FlagsFrom[testNamedPerm](0))
+	maybePanic(err)
+	assertJSONEquals(t, data, `[]`, "empty names json marshal")
+
+	if _, err := json.Marshal(FlagsFrom[testNamedPerm](9)); err == nil {
+		t.Error("expected error for unnamed bit")
+	}
+
+	data, err = json.Marshal(NewFlags(testPermRead, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestFlagsText(t *testing.T) {
+	var f Flags[testPerm]
+	err := f.UnmarshalText([]byte("3"))
+	maybePanic(err)
+	assertFlags(t, f, "UnmarshalText()")
+
+	data, err := f.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "3", "text marshal")
+
+	var blank Flags[testPerm]
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullFlags(t, blank, "UnmarshalText() empty")
+
+	data, err = blank.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var invalid Flags[testPerm]
+	if err := invalid.UnmarshalText([]byte("256")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestFlagsScanValue(t *testing.T) {
+	var f Flags[testPerm]
+	err := f.Scan(int64(3))
+	maybePanic(err)
+	assertFlags(t, f, "scanned int64")
+
+	var s Flags[testPerm]
+	err = s.Scan([]byte("3"))
+	maybePanic(err)
+	assertFlags(t, s, "scanned bytes")
+
+	var high Flags[uint64]
+	err = high.Scan(int64(-1 << 63))
+	maybePanic(err)
+	if high.V != 1<<63 {
+		t.Errorf("bad scanned high bit: %#x", high.V)
+	}
+	if v, err := high.Value(); v != int64(-1<<63) || err != nil {
+		t.Errorf("bad high bit Value(): %#v %v", v, err)
+	}
+
+	var null Flags[testPerm]
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullFlags(t, null, "scanned null")
+
+	for _, bad := range []interface{}{int64(256), int64(-1), "-1", "hello", 1.5} {
+		var invalid Flags[testPerm]
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("Scan(%#v): expected error", bad)
+		}
+		assertNullFlags(t, invalid, "scanned invalid")
+	}
+
+	if v, err := f.Value(); v != int64(3) || err != nil {
+		t.Errorf("bad Value(): %#v %v", v, err)
+	}
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Errorf("bad null Value(): %#v %v", v, err)
+	}
+}
+
+func TestFlagsMisc(t *testing.T) {
+	f := NewFlags(testPermRead, false)
+	if !f.IsZero() || f.Ptr() != nil || f.ValueOrZero() != 0 {
+		t.Error("bad null Flags")
+	}
+	f.SetValid(testPermRead | testPermWrite)
+	assertFlags(t, f, "SetValid()")
+	if f.IsZero() || *f.Ptr() != 3 || f.ValueOrZero() != 3 {
+		t.Error("bad valid Flags")
+	}
+	if !f.Equal(FlagsFrom[testPerm](3)) || f.Equal(FlagsFrom[testPerm](1)) || f.Equal(NewFlags[testPerm](3, false)) {
+		t.Error("bad Equal()")
+	}
+}
+
+func assertFlags(t *testing.T, f Flags[testPerm], from string) {
+	t.Helper()
+	if f.V != testPermRead|testPermWrite {
+		t.Errorf("bad %s flags: %#x ≠ %#x\n", from, f.V, testPermRead|testPermWrite)
+	}
+	if !f.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullFlags(t *testing.T, f Flags[testPerm], from string) {
+	t.Helper()
+	if f.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"math"
 	"reflect"
 	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // Float is a nullable float64.
@@ -49,10 +51,23 @@ func (f Float) ValueOrZero() float64 {
 	return f.Float64
 }
 
+// Scan implements the Scanner interface.
+// It accepts what sql.NullFloat64 does, as well as float32 and pointers from drivers such as clickhouse-go.
+func (f *Float) Scan(value interface{}) (err error) {
+	defer validateDecoded(f, &err)
+	return f.NullFloat64.Scan(driverval.Convert(value))
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
+// It supports number, string, and null input.
 // 0 will not be considered a null Float.
-func (f *Float) UnmarshalJSON(data []byte) error {
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict.
+func (f *Float) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(f, &err)
+	return f.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (f *Float) unmarshalJSONWith(data []byte, d JSONDecoding) error {
 	if bytes.Equal(data, nullBytes) {
 		f.Valid = false
 		return nil
@@ -62,14 +77,14 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
 			// special case: accept string input
-			if typeError.Value != "string" {
-				return fmt.Errorf("null: JSON input is invalid type (need float or string): %w", err)
+			if typeError.Value != "string" || !d.numberStrings() {
+				return fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("float"), err)
 			}
 			var str string
 			if err := json.Unmarshal(data, &str); err != nil {
 				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
 			}
-			n, err := strconv.ParseFloat(str, 64)
+			n, err := DefaultNumberFormat.parseFloat(str)
 			if err != nil {
 				return fmt.Errorf("null: couldn't convert string to float: %w", err)
 			}
@@ -85,16 +100,16 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Float if the input is blank.
+// It will unmarshal to a null Float if the input is blank or DefaultNullText.
 // It will return an error if the input is not an integer, blank, or "null".
-func (f *Float) UnmarshalText(text []byte) error {
+func (f *Float) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(f, &err)
 	str := string(text)
-	if str == "" || str == "null" {
+	if isNullText(text) || str == "null" {
 		f.Valid = false
 		return nil
 	}
-	var err error
-	f.Float64, err = strconv.ParseFloat(string(text), 64)
+	f.Float64, err = DefaultNumberFormat.parseFloat(string(text))
 	if err != nil {
 		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
 	}
@@ -118,10 +133,10 @@ func (f Float) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string if this Float is null.
+// It will encode DefaultNullText, blank by default, if this Float is null.
 func (f Float) MarshalText() ([]byte, error) {
 	if !f.Valid {
-		return []byte{}, nil
+		return nullText(), nil
 	}
 	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
 }
@@ -132,6 +147,16 @@ func (f *Float) SetValid(n float64) {
 	f.Valid = true
 }
 
+// SetNull sets this Float to null, leaving its value as is. Use Reset to clear the value too.
+func (f *Float) SetNull() {
+	f.Valid = false
+}
+
+// Reset sets this Float to null and clears its value.
+func (f *Float) Reset() {
+	*f = Float{}
+}
+
 // Ptr returns a pointer to this Float's value, or a nil pointer if this Float is null.
 func (f Float) Ptr() *float64 {
 	if !f.Valid {
@@ -140,12 +165,22 @@ func (f Float) Ptr() *float64 {
 	return &f.Float64
 }
 
+// SetPtr changes this Float's value to the value v points to, or sets it to be null if v is nil, like FloatFromPtr.
+func (f *Float) SetPtr(v *float64) {
+	*f = FloatFromPtr(v)
+}
+
 // IsZero returns true for invalid Floats, for future omitempty support (Go 1.4?)
 // A non-null Float with a 0 value will not be considered zero.
 func (f Float) IsZero() bool {
 	return !f.Valid
 }
 
+// IsZeroOrNull returns true for invalid Floats, or valid Floats with a 0 value.
+func (f Float) IsZeroOrNull() bool {
+	return !f.Valid || f.Float64 == 0
+}
+
 // Equal returns true if both floats have the same value or are both null.
 // Warning: calculations using floating point numbers can result in different ways
 // the numbers are stored in memory. Therefore, this function is not suitable to
@@ -0,0 +1,733 @@
+package null
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"math/rand"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"sync"
+	"testing/quick"
+	"time"
+)
+
+// The Generate methods implement quick.Generator, so that testing/quick can generate structs with fields of these types.
+// One in four generated values is null. Valid values satisfy the same rules as parsed ones,
+// such as assigned country codes and canonical language tags, so that they survive a round trip through any encoding.
+// Generic types generate their values with quick.Value, and are null if it can't generate T.
+
+// generateNullChance is the chance that the Generate methods return a null value, as one in generateNullChance.
+const generateNullChance = 4
+
+// generateSize is the size Generate is called with by GenerateValues, JSONCorpus, and TextCorpus, the same as testing/quick's default.
+const generateSize = 50
+
+// randomizer is implemented by the types with Generate methods.
+type randomizer[T any] interface {
+	// randomValid returns a random valid value, or a null value if none can be generated.
+	// Sizes, such as the length of slices, are below size, if it is positive.
+	randomValid(r *rand.Rand, size int) T
+}
+
+// generate returns a random valid or null value of T for the Generate methods.
+func generate[T randomizer[T]](r *rand.Rand, size int) reflect.Value {
+	var v T
+	if r.Intn(generateNullChance) != 0 {
+		v = v.randomValid(r, size)
+	}
+	return reflect.ValueOf(v)
+}
+
+// GenerateValues returns n random values of T, a mix of valid and null values as returned by its Generate method,
+// for property-based and table-driven tests.
+func GenerateValues[T quick.Generator](r *rand.Rand, n int) []T {
+	values := make([]T, n)
+	for i := range values {
+		values[i] = values[i].Generate(r, generateSize).Interface().(T)
+	}
+	return values
+}
+
+// JSONCorpus returns the JSON encodings of n random values of T, including null, as returned by GenerateValues,
+// as seeds for fuzz tests that decode JSON:
+//
+//	corpus, err := null.JSONCorpus[null.Int](rand.New(rand.NewSource(1)), 20)
+//	for _, seed := range corpus {
+//		f.Add(seed)
+//	}
+func JSONCorpus[T quick.Generator](r *rand.Rand, n int) ([][]byte, error) {
+	corpus := make([][]byte, 0, n)
+	for _, v := range GenerateValues[T](r, n) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("null: couldn't marshal generated %T: %w", v, err)
+		}
+		corpus = append(corpus, data)
+	}
+	return corpus, nil
+}
+
+// TextCorpus is like JSONCorpus, returning the text encodings of the values, for fuzz tests that decode text.
+func TextCorpus[T interface {
+	quick.Generator
+	encoding.TextMarshaler
+}](r *rand.Rand, n int) ([][]byte, error) {
+	corpus := make([][]byte, 0, n)
+	for _, v := range GenerateValues[T](r, n) {
+		text, err := v.MarshalText()
+		if err != nil {
+			return nil, fmt.Errorf("null: couldn't marshal generated %T: %w", v, err)
+		}
+		corpus = append(corpus, text)
+	}
+	return corpus, nil
+}
+
+// randomLength returns a random length below size, or 0 if size isn't positive.
+func randomLength(r *rand.Rand, size int) int {
+	if size <= 0 {
+		return 0
+	}
+	return r.Intn(size)
+}
+
+// randomFloat returns a normally distributed float with a standard deviation of 1000.
+func randomFloat(r *rand.Rand) float64 {
+	return r.NormFloat64() * 1000
+}
+
+// randomTime returns a random UTC time from 1970 to 2100 with microsecond precision, like many databases.
+func randomTime(r *rand.Rand) time.Time {
+	return time.Unix(r.Int63n(4102444800), r.Int63n(1e6)*1e3).UTC()
+}
+
+func randomBytes(r *rand.Rand, size int) []byte {
+	b := make([]byte, randomLength(r, size))
+	r.Read(b)
+	return b
+}
+
+// randomWord returns a random word of 1 to 8 lower case letters.
+func randomWord(r *rand.Rand) string {
+	word := make([]byte, 1+r.Intn(8))
+	for i := range word {
+		word[i] = byte('a' + r.Intn(26))
+	}
+	return string(word)
+}
+
+// randomString returns a random string of letters, digits, punctuation, and spaces, with some non-ASCII runes.
+func randomString(r *rand.Rand, size int) string {
+	runes := make([]rune, randomLength(r, size))
+	for i := range runes {
+		if r.Intn(10) == 0 {
+			runes[i] = rune(0xa0 + r.Intn(0x3000))
+		} else {
+			runes[i] = rune(' ' + r.Intn('~'-' '+1))
+		}
+	}
+	return string(runes)
+}
+
+func randomAddr(r *rand.Rand) netip.Addr {
+	if r.Intn(2) == 0 {
+		var ip [4]byte
+		r.Read(ip[:])
+		return netip.AddrFrom4(ip)
+	}
+	var ip [16]byte
+	r.Read(ip[:])
+	return netip.AddrFrom16(ip)
+}
+
+// randomQuick returns a random value of T from quick.Value, or false if it can't generate T.
+func randomQuick[T any](r *rand.Rand) (T, bool) {
+	var v T
+	generated, ok := quick.Value(reflect.TypeOf(&v).Elem(), r)
+	if ok {
+		v = generated.Interface().(T)
+	}
+	return v, ok
+}
+
+// randomSlice returns a random slice from quick.Value, or false if it can't generate T.
+func randomSlice[T any](r *rand.Rand, size int) ([]T, bool) {
+	s := make([]T, randomLength(r, size))
+	for i := range s {
+		v, ok := randomQuick[T](r)
+		if !ok {
+			return nil, false
+		}
+		s[i] = v
+	}
+	return s, true
+}
+
+// sortedISOCodes returns the codes in set, sorted so that random choices are reproducible.
+func sortedISOCodes(set map[string]struct{}) []string {
+	codes := make([]string, 0, len(set))
+	for code := range set {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+var (
+	countryCodeList  = sync.OnceValue(func() []string { return sortedISOCodes(countryCodes) })
+	currencyCodeList = sync.OnceValue(func() []string { return sortedISOCodes(currencyCodes) })
+)
+
+// randomLanguageTags are the canonical language tags that LanguageTag generates.
+var randomLanguageTags = []string{"ar", "de", "de-CH", "en", "en-GB", "en-US", "es-419", "fr", "ja", "ja-JP", "pt-BR", "sr-Latn", "zh-Hans-CN", "zh-Hant-TW"}
+
+// Generate implements quick.Generator, returning a random IPv4 or IPv6 Addr, or null.
+func (Addr) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Addr](r, size)
+}
+
+func (Addr) randomValid(r *rand.Rand, _ int) Addr {
+	return AddrFrom(randomAddr(r))
+}
+
+// Generate implements quick.Generator, returning a random Any holding a string, float64, or bool,
+// the types it holds when unmarshaled from JSON, or null.
+func (Any) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Any](r, size)
+}
+
+func (Any) randomValid(r *rand.Rand, size int) Any {
+	switch r.Intn(3) {
+	case 0:
+		return AnyFrom(randomString(r, size))
+	case 1:
+		return AnyFrom(randomFloat(r))
+	}
+	return AnyFrom(r.Intn(2) == 0)
+}
+
+// Generate implements quick.Generator, returning a random Array, or null.
+func (Array[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Array[T]](r, size)
+}
+
+func (Array[T]) randomValid(r *rand.Rand, size int) Array[T] {
+	s, ok := randomSlice[T](r, size)
+	return NewArray(s, ok)
+}
+
+// Generate implements quick.Generator, returning a random Base64, or null.
+func (Base64) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Base64](r, size)
+}
+
+func (Base64) randomValid(r *rand.Rand, size int) Base64 {
+	return Base64From(randomBytes(r, size))
+}
+
+// Generate implements quick.Generator, returning a random BigInt of up to 128 bits, or null.
+func (BigInt) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[BigInt](r, size)
+}
+
+func (BigInt) randomValid(r *rand.Rand, _ int) BigInt {
+	i := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), 128))
+	if r.Intn(2) == 0 {
+		i.Neg(i)
+	}
+	return BigIntFrom(i)
+}
+
+// Generate implements quick.Generator, returning a random Bool, or null.
+func (Bool) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Bool](r, size)
+}
+
+func (Bool) randomValid(r *rand.Rand, _ int) Bool {
+	return BoolFrom(r.Intn(2) == 0)
+}
+
+// Generate implements quick.Generator, returning a random ByteSize, or null.
+func (ByteSize) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[ByteSize](r, size)
+}
+
+func (ByteSize) randomValid(r *rand.Rand, _ int) ByteSize {
+	return ByteSizeFrom(r.Uint64())
+}
+
+// Generate implements quick.Generator, returning a random Bytes, or null.
+func (Bytes) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Bytes](r, size)
+}
+
+func (Bytes) randomValid(r *rand.Rand, size int) Bytes {
+	return BytesFrom(randomBytes(r, size))
+}
+
+// Generate implements quick.Generator, returning a random Complex, or null.
+func (Complex) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Complex](r, size)
+}
+
+func (Complex) randomValid(r *rand.Rand, _ int) Complex {
+	return ComplexFrom(complex(randomFloat(r), randomFloat(r)))
+}
+
+// Generate implements quick.Generator, returning a random assigned CountryCode, or null.
+func (CountryCode) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[CountryCode](r, size)
+}
+
+func (CountryCode) randomValid(r *rand.Rand, _ int) CountryCode {
+	codes := countryCodeList()
+	return NewCountryCode(codes[r.Intn(len(codes))], true)
+}
+
+// Generate implements quick.Generator, returning a random active CurrencyCode, or null.
+func (CurrencyCode) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[CurrencyCode](r, size)
+}
+
+func (CurrencyCode) randomValid(r *rand.Rand, _ int) CurrencyCode {
+	codes := currencyCodeList()
+	return NewCurrencyCode(codes[r.Intn(len(codes))], true)
+}
+
+// Generate implements quick.Generator, returning a random Date from 1970 to 2100, or null.
+func (Date) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Date](r, size)
+}
+
+func (Date) randomValid(r *rand.Rand, _ int) Date {
+	return DateFrom(randomTime(r))
+}
+
+// Generate implements quick.Generator, returning a random Duration, or null.
+func (Duration) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Duration](r, size)
+}
+
+func (Duration) randomValid(r *rand.Rand, _ int) Duration {
+	return DurationFrom(time.Duration(r.Uint64()))
+}
+
+// Generate implements quick.Generator, returning a random Email at example.com, or null.
+func (Email) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Email](r, size)
+}
+
+func (Email) randomValid(r *rand.Rand, _ int) Email {
+	return NewEmail(randomWord(r)+"@example.com", true)
+}
+
+// Generate implements quick.Generator, returning one of the registered values of Enum[T], or null.
+// It always returns null if no values are registered.
+func (Enum[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Enum[T]](r, size)
+}
+
+func (Enum[T]) randomValid(r *rand.Rand, _ int) Enum[T] {
+	values := EnumValues[T]()
+	if len(values) == 0 {
+		return Enum[T]{}
+	}
+	return EnumFrom(values[r.Intn(len(values))])
+}
+
+// Generate implements quick.Generator, returning a random FileMode of permission bits, or null.
+func (FileMode) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[FileMode](r, size)
+}
+
+func (FileMode) randomValid(r *rand.Rand, _ int) FileMode {
+	return FileModeFrom(fs.FileMode(r.Intn(int(fs.ModePerm) + 1)))
+}
+
+// Generate implements quick.Generator, returning a random FixedHex, or null.
+func (FixedHex[A]) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[FixedHex[A]](r, size)
+}
+
+func (FixedHex[A]) randomValid(r *rand.Rand, _ int) FixedHex[A] {
+	v, ok := randomQuick[A](r)
+	return NewFixedHex(v, ok)
+}
+
+// Generate implements quick.Generator, returning a random Flags, or null.
+// If names are registered for T, only the named bits are set.
+func (Flags[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Flags[T]](r, size)
+}
+
+func (Flags[T]) randomValid(r *rand.Rand, _ int) Flags[T] {
+	names, ok := lookupFlagNames[T]()
+	if !ok {
+		return FlagsFrom(T(r.Uint64()))
+	}
+	var v T
+	for bit := range names {
+		if r.Intn(2) == 0 {
+			v |= bit
+		}
+	}
+	return FlagsFrom(v)
+}
+
+// Generate implements quick.Generator, returning a random Float, or null.
+// Valid values are normally distributed with a standard deviation of 1000, and are never NaN or infinite.
+func (Float) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Float](r, size)
+}
+
+func (Float) randomValid(r *rand.Rand, _ int) Float {
+	return FloatFrom(randomFloat(r))
+}
+
+// Generate implements quick.Generator, returning a random 48-bit HardwareAddr, or null.
+func (HardwareAddr) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[HardwareAddr](r, size)
+}
+
+func (HardwareAddr) randomValid(r *rand.Rand, _ int) HardwareAddr {
+	mac := make(net.HardwareAddr, 6)
+	r.Read(mac)
+	return HardwareAddrFrom(mac)
+}
+
+// Generate implements quick.Generator, returning a random Hex, or null.
+func (Hex) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Hex](r, size)
+}
+
+func (Hex) randomValid(r *rand.Rand, size int) Hex {
+	return HexFrom(randomBytes(r, size))
+}
+
+// Generate implements quick.Generator, returning a random Int, or null.
+func (Int) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Int](r, size)
+}
+
+func (Int) randomValid(r *rand.Rand, _ int) Int {
+	return IntFrom(int64(r.Uint64()))
+}
+
+// Generate implements quick.Generator, returning a random Int16, or null.
+func (Int16) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Int16](r, size)
+}
+
+func (Int16) randomValid(r *rand.Rand, _ int) Int16 {
+	return Int16From(int16(r.Uint64()))
+}
+
+// Generate implements quick.Generator, returning a random Int32, or null.
+func (Int32) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Int32](r, size)
+}
+
+func (Int32) randomValid(r *rand.Rand, _ int) Int32 {
+	return Int32From(int32(r.Uint64()))
+}
+
+// Generate implements quick.Generator, returning a random Int64Range, or null.
+// Valid ranges have bounds from -1000 to 1000, and may be unbounded below or above.
+func (Int64Range) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Int64Range](r, size)
+}
+
+func (Int64Range) randomValid(r *rand.Rand, _ int) Int64Range {
+	lower := r.Int63n(2000) - 1000
+	lowerBound, upperBound := IntFrom(lower), IntFrom(lower+1+r.Int63n(1000-lower))
+	if r.Intn(8) == 0 {
+		lowerBound = Int{}
+	}
+	if r.Intn(8) == 0 {
+		upperBound = Int{}
+	}
+	return NewInt64Range(lowerBound, upperBound, true, false)
+}
+
+// Generate implements quick.Generator, returning a random Int8, or null.
+func (Int8) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Int8](r, size)
+}
+
+func (Int8) randomValid(r *rand.Rand, _ int) Int8 {
+	return Int8From(int8(r.Uint64()))
+}
+
+// Generate implements quick.Generator, returning a random JSON object of numbers, or null.
+func (JSON) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[JSON](r, size)
+}
+
+func (JSON) randomValid(r *rand.Rand, size int) JSON {
+	object := make(map[string]float64)
+	for i := randomLength(r, size); i > 0; i-- {
+		object[randomWord(r)] = randomFloat(r)
+	}
+	data, _ := json.Marshal(object)
+	return JSONFrom(data)
+}
+
+// Generate implements quick.Generator, returning a random KSUID, or null.
+func (KSUID) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[KSUID](r, size)
+}
+
+func (KSUID) randomValid(r *rand.Rand, _ int) KSUID {
+	var id [20]byte
+	r.Read(id[:])
+	return KSUIDFrom(id)
+}
+
+// Generate implements quick.Generator, returning a common LanguageTag, such as en-US, or null.
+func (LanguageTag) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[LanguageTag](r, size)
+}
+
+func (LanguageTag) randomValid(r *rand.Rand, _ int) LanguageTag {
+	return NewLanguageTag(randomLanguageTags[r.Intn(len(randomLanguageTags))], true)
+}
+
+// Generate implements quick.Generator, returning a random Map, or null.
+func (Map[K, V]) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Map[K, V]](r, size)
+}
+
+func (Map[K, V]) randomValid(r *rand.Rand, size int) Map[K, V] {
+	n := randomLength(r, size)
+	m := make(map[K]V, n)
+	for i := 0; i < n; i++ {
+		k, ok := randomQuick[K](r)
+		v, ok2 := randomQuick[V](r)
+		if !ok || !ok2 {
+			return Map[K, V]{}
+		}
+		m[k] = v
+	}
+	return MapFrom(m)
+}
+
+// Generate implements quick.Generator, returning a random Money in an active currency, or null.
+// Valid amounts are from -1,000,000,000 to 1,000,000,000 minor units.
+func (Money) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Money](r, size)
+}
+
+func (Money) randomValid(r *rand.Rand, _ int) Money {
+	codes := currencyCodeList()
+	return MoneyFrom(r.Int63n(2e9+1)-1e9, codes[r.Intn(len(codes))])
+}
+
+// Generate implements quick.Generator, returning a random Optional that is equally likely to be unset, null, or valid.
+func (Optional[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	switch r.Intn(3) {
+	case 0:
+		return reflect.ValueOf(Optional[T]{})
+	case 1:
+		return reflect.ValueOf(OptionalNull[T]())
+	}
+	return reflect.ValueOf(Optional[T]{}.randomValid(r, size))
+}
+
+func (Optional[T]) randomValid(r *rand.Rand, _ int) Optional[T] {
+	v, ok := randomQuick[T](r)
+	if !ok {
+		return OptionalNull[T]()
+	}
+	return OptionalFrom(v)
+}
+
+// Generate implements quick.Generator, returning a random Percent in DefaultPercentRange, or null.
+// Unbounded ranges are limited to -10000% to 10000%.
+func (Percent) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Percent](r, size)
+}
+
+func (Percent) randomValid(r *rand.Rand, _ int) Percent {
+	lower, upper := max(DefaultPercentRange.Min, -100), min(DefaultPercentRange.Max, 100)
+	return PercentFrom(lower + r.Float64()*(upper-lower))
+}
+
+// Generate implements quick.Generator, returning a random Point, or null.
+func (Point) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Point](r, size)
+}
+
+func (Point) randomValid(r *rand.Rand, _ int) Point {
+	return PointFrom(r.Float64()*180-90, r.Float64()*360-180)
+}
+
+// Generate implements quick.Generator, returning a random IPv4 or IPv6 Prefix, or null.
+func (Prefix) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Prefix](r, size)
+}
+
+func (Prefix) randomValid(r *rand.Rand, _ int) Prefix {
+	addr := randomAddr(r)
+	prefix, _ := addr.Prefix(r.Intn(addr.BitLen() + 1))
+	return PrefixFrom(prefix)
+}
+
+// Generate implements quick.Generator, returning a Regexp that matches a random word, or null.
+func (Regexp) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Regexp](r, size)
+}
+
+func (Regexp) randomValid(r *rand.Rand, _ int) Regexp {
+	return RegexpFrom(regexp.MustCompile("^" + randomWord(r) + "[0-9]*$"))
+}
+
+// Generate implements quick.Generator, returning a random Semver such as 1.2.3, or null.
+func (Semver) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Semver](r, size)
+}
+
+func (Semver) randomValid(r *rand.Rand, _ int) Semver {
+	version := fmt.Sprintf("%d.%d.%d", r.Intn(10), r.Intn(20), r.Intn(100))
+	if r.Intn(4) == 0 {
+		version += fmt.Sprintf("-rc.%d", 1+r.Intn(5))
+	}
+	return NewSemver(version, true)
+}
+
+// Generate implements quick.Generator, returning a random Slice, or null.
+func (Slice[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Slice[T]](r, size)
+}
+
+func (Slice[T]) randomValid(r *rand.Rand, size int) Slice[T] {
+	s, ok := randomSlice[T](r, size)
+	return NewSlice(s, ok)
+}
+
+// Generate implements quick.Generator, returning a random String, or null.
+func (String) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[String](r, size)
+}
+
+func (String) randomValid(r *rand.Rand, size int) String {
+	return StringFrom(randomString(r, size))
+}
+
+// Generate implements quick.Generator, returning a random UTC Time from 1970 to 2100 with microsecond precision, or null.
+func (Time) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Time](r, size)
+}
+
+func (Time) randomValid(r *rand.Rand, _ int) Time {
+	return TimeFrom(randomTime(r))
+}
+
+// Generate implements quick.Generator, returning a random TimeOfDay with microsecond precision, or null.
+func (TimeOfDay) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[TimeOfDay](r, size)
+}
+
+func (TimeOfDay) randomValid(r *rand.Rand, _ int) TimeOfDay {
+	return TimeOfDayFrom(randomTime(r))
+}
+
+// Generate implements quick.Generator, returning a random TimeRange of up to a week from 1970 to 2100, or null.
+func (TimeRange) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[TimeRange](r, size)
+}
+
+func (TimeRange) randomValid(r *rand.Rand, _ int) TimeRange {
+	lower := randomTime(r)
+	return TimeRangeFrom(lower, lower.Add(time.Duration(1+r.Int63n(7*24*60*60*1e6))*time.Microsecond))
+}
+
+// Generate implements quick.Generator, returning a random Uint, or null.
+func (Uint) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Uint](r, size)
+}
+
+func (Uint) randomValid(r *rand.Rand, _ int) Uint {
+	return UintFrom(r.Uint64())
+}
+
+// Generate implements quick.Generator, returning a random Uint16, or null.
+func (Uint16) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Uint16](r, size)
+}
+
+func (Uint16) randomValid(r *rand.Rand, _ int) Uint16 {
+	return Uint16From(uint16(r.Uint64()))
+}
+
+// Generate implements quick.Generator, returning a random Uint32, or null.
+func (Uint32) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Uint32](r, size)
+}
+
+func (Uint32) randomValid(r *rand.Rand, _ int) Uint32 {
+	return Uint32From(uint32(r.Uint64()))
+}
+
+// Generate implements quick.Generator, returning a random Uint8, or null.
+func (Uint8) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Uint8](r, size)
+}
+
+func (Uint8) randomValid(r *rand.Rand, _ int) Uint8 {
+	return Uint8From(uint8(r.Uint64()))
+}
+
+// Generate implements quick.Generator, returning a random ULID, or null.
+func (ULID) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[ULID](r, size)
+}
+
+func (ULID) randomValid(r *rand.Rand, _ int) ULID {
+	var id [16]byte
+	r.Read(id[:])
+	return ULIDFrom(id)
+}
+
+// Generate implements quick.Generator, returning a random UnixMillis from 1970 to 2100, or null.
+func (UnixMillis) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[UnixMillis](r, size)
+}
+
+func (UnixMillis) randomValid(r *rand.Rand, _ int) UnixMillis {
+	return UnixMillisFrom(randomTime(r).Truncate(time.Millisecond))
+}
+
+// Generate implements quick.Generator, returning a random UnixSeconds from 1970 to 2100, or null.
+func (UnixSeconds) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[UnixSeconds](r, size)
+}
+
+func (UnixSeconds) randomValid(r *rand.Rand, _ int) UnixSeconds {
+	return UnixSecondsFrom(randomTime(r).Truncate(time.Second))
+}
+
+// Generate implements quick.Generator, returning a random https URL on a subdomain of example.com, or null.
+func (URL) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[URL](r, size)
+}
+
+func (URL) randomValid(r *rand.Rand, _ int) URL {
+	return URLFrom(&url.URL{Scheme: "https", Host: randomWord(r) + ".example.com", Path: "/" + randomWord(r)})
+}
+
+// Generate implements quick.Generator, returning a random Value, or null.
+func (Value[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	return generate[Value[T]](r, size)
+}
+
+func (Value[T]) randomValid(r *rand.Rand, _ int) Value[T] {
+	v, ok := randomQuick[T](r)
+	return NewValue(v, ok)
+}
@@ -0,0 +1,128 @@
+package null
+
+import (
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+func TestAllTypesGenerate(t *testing.T) {
+	typ := reflect.TypeOf(allTypes{})
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		g, ok := reflect.Zero(f.Type).Interface().(quick.Generator)
+		if !ok {
+			t.Errorf("%s: doesn't implement quick.Generator", f.Name)
+			continue
+		}
+		if v := g.Generate(rand.New(rand.NewSource(1)), 10); v.Type() != f.Type {
+			t.Errorf("%s: Generate returned a %v", f.Name, v.Type())
+		}
+	}
+
+	var valid, null int
+	roundTrip := func(in allTypes) bool {
+		for _, field := range []zeroer{in.Int, in.String, in.Time, in.Slice} {
+			if field.IsZero() {
+				null++
+			} else {
+				valid++
+			}
+		}
+		data, err := json.Marshal(in)
+		if err != nil {
+			t.Errorf("couldn't marshal generated value: %v", err)
+			return false
+		}
+		var out allTypes
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Errorf("couldn't unmarshal generated value: %v\n%s", err, data)
+			return false
+		}
+		assertAllTypesEqual(t, out, in, "generated json round trip")
+		return true
+	}
+	if err := quick.Check(roundTrip, &quick.Config{Rand: rand.New(rand.NewSource(1))}); err != nil {
+		t.Error(err)
+	}
+	if valid == 0 || null == 0 {
+		t.Errorf("expected a mix of valid and null values, got %d valid and %d null", valid, null)
+	}
+}
+
+func TestGenerateValues(t *testing.T) {
+	values := GenerateValues[Array[int]](rand.New(rand.NewSource(1)), 100)
+	if len(values) != 100 {
+		t.Fatalf("expected 100 values, got %d", len(values))
+	}
+	var null int
+	for _, v := range values {
+		if !v.Valid {
+			null++
+		}
+	}
+	if null == 0 || null == len(values) {
+		t.Errorf("expected a mix of valid and null values, got %d null", null)
+	}
+	if again := GenerateValues[Array[int]](rand.New(rand.NewSource(1)), 100); !reflect.DeepEqual(again, values) {
+		t.Error("values should be reproducible with the same seed")
+	}
+
+	if v := (Enum[string]{}).randomValid(rand.New(rand.NewSource(1)), 10); v.Valid {
+		t.Error("an Enum with no registered values should be null")
+	}
+	for _, v := range GenerateValues[Optional[int]](rand.New(rand.NewSource(1)), 30) {
+		if v.Present && v.Valid && reflect.DeepEqual(v, OptionalNull[int]()) {
+			t.Errorf("bad Optional: %#v", v)
+		}
+	}
+}
+
+func TestCorpus(t *testing.T) {
+	corpus, err := JSONCorpus[Uint](rand.New(rand.NewSource(1)), 20)
+	maybePanic(err)
+	var null int
+	for _, data := range corpus {
+		var u Uint
+		if err := json.Unmarshal(data, &u); err != nil {
+			t.Errorf("bad JSON corpus entry %s: %v", data, err)
+		}
+		if !u.Valid {
+			null++
+		}
+	}
+	if len(corpus) != 20 || null == 0 {
+		t.Errorf("bad JSON corpus: %q", corpus)
+	}
+
+	text, err := TextCorpus[Date](rand.New(rand.NewSource(1)), 20)
+	maybePanic(err)
+	for _, data := range text {
+		var d Date
+		if err := d.UnmarshalText(data); err != nil {
+			t.Errorf("bad text corpus entry %q: %v", data, err)
+		}
+	}
+}
+
+func FuzzUintJSON(f *testing.F) {
+	corpus, err := JSONCorpus[Uint](rand.New(rand.NewSource(1)), 20)
+	maybePanic(err)
+	for _, seed := range corpus {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var u Uint
+		if err := json.Unmarshal(data, &u); err != nil {
+			return
+		}
+		out, err := json.Marshal(u)
+		maybePanic(err)
+		var again Uint
+		if err := json.Unmarshal(out, &again); err != nil || !again.Equal(u) {
+			t.Errorf("%s doesn't round trip: %s, %v", data, out, err)
+		}
+	})
+}
@@ -0,0 +1,807 @@
+package null
+
+import (
+	"encoding/json"
+	"io/fs"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// Get and MustGet return the value of the types with a single value,
+// for code that has already checked validity, without reaching into the embedded fields.
+// Types made of more than one value, such as Money, Point, and the ranges, don't have them,
+// and Map only has MustGet, because Map.Get looks up a key.
+
+// Get returns this Addr's value and true if it is valid, otherwise the zero value and false.
+func (a Addr) Get() (netip.Addr, bool) {
+	if !a.Valid {
+		var zero netip.Addr
+		return zero, false
+	}
+	return a.Addr, true
+}
+
+// MustGet returns this Addr's value, and panics if it is null.
+func (a Addr) MustGet() netip.Addr {
+	if !a.Valid {
+		panic("null: MustGet called on a null Addr")
+	}
+	return a.Addr
+}
+
+// Get returns this Any's value and true if it is valid, otherwise the zero value and false.
+func (a Any) Get() (interface{}, bool) {
+	if !a.Valid {
+		var zero interface{}
+		return zero, false
+	}
+	return a.Any, true
+}
+
+// MustGet returns this Any's value, and panics if it is null.
+func (a Any) MustGet() interface{} {
+	if !a.Valid {
+		panic("null: MustGet called on a null Any")
+	}
+	return a.Any
+}
+
+// Get returns this Array's value and true if it is valid, otherwise the zero value and false.
+func (a Array[T]) Get() ([]T, bool) {
+	if !a.Valid {
+		var zero []T
+		return zero, false
+	}
+	return a.V, true
+}
+
+// MustGet returns this Array's value, and panics if it is null.
+func (a Array[T]) MustGet() []T {
+	if !a.Valid {
+		panic("null: MustGet called on a null Array")
+	}
+	return a.V
+}
+
+// Get returns this Base64's value and true if it is valid, otherwise the zero value and false.
+func (b Base64) Get() ([]byte, bool) {
+	if !b.Valid {
+		var zero []byte
+		return zero, false
+	}
+	return b.Bytes, true
+}
+
+// MustGet returns this Base64's value, and panics if it is null.
+func (b Base64) MustGet() []byte {
+	if !b.Valid {
+		panic("null: MustGet called on a null Base64")
+	}
+	return b.Bytes
+}
+
+// Get returns this BigInt's value and true if it is valid, otherwise the zero value and false.
+func (b BigInt) Get() (*big.Int, bool) {
+	if !b.Valid {
+		var zero *big.Int
+		return zero, false
+	}
+	return b.BigInt, true
+}
+
+// MustGet returns this BigInt's value, and panics if it is null.
+func (b BigInt) MustGet() *big.Int {
+	if !b.Valid {
+		panic("null: MustGet called on a null BigInt")
+	}
+	return b.BigInt
+}
+
+// Get returns this Bool's value and true if it is valid, otherwise the zero value and false.
+func (b Bool) Get() (bool, bool) {
+	if !b.Valid {
+		var zero bool
+		return zero, false
+	}
+	return b.Bool, true
+}
+
+// MustGet returns this Bool's value, and panics if it is null.
+func (b Bool) MustGet() bool {
+	if !b.Valid {
+		panic("null: MustGet called on a null Bool")
+	}
+	return b.Bool
+}
+
+// Get returns this Bytes's value and true if it is valid, otherwise the zero value and false.
+func (b Bytes) Get() ([]byte, bool) {
+	if !b.Valid {
+		var zero []byte
+		return zero, false
+	}
+	return b.Bytes, true
+}
+
+// MustGet returns this Bytes's value, and panics if it is null.
+func (b Bytes) MustGet() []byte {
+	if !b.Valid {
+		panic("null: MustGet called on a null Bytes")
+	}
+	return b.Bytes
+}
+
+// Get returns this ByteSize's value and true if it is valid, otherwise the zero value and false.
+func (b ByteSize) Get() (uint64, bool) {
+	if !b.Valid {
+		var zero uint64
+		return zero, false
+	}
+	return b.Size, true
+}
+
+// MustGet returns this ByteSize's value, and panics if it is null.
+func (b ByteSize) MustGet() uint64 {
+	if !b.Valid {
+		panic("null: MustGet called on a null ByteSize")
+	}
+	return b.Size
+}
+
+// Get returns this Complex's value and true if it is valid, otherwise the zero value and false.
+func (c Complex) Get() (complex128, bool) {
+	if !c.Valid {
+		var zero complex128
+		return zero, false
+	}
+	return c.Complex, true
+}
+
+// MustGet returns this Complex's value, and panics if it is null.
+func (c Complex) MustGet() complex128 {
+	if !c.Valid {
+		panic("null: MustGet called on a null Complex")
+	}
+	return c.Complex
+}
+
+// Get returns this CountryCode's value and true if it is valid, otherwise the zero value and false.
+func (c CountryCode) Get() (string, bool) {
+	if !c.Valid {
+		var zero string
+		return zero, false
+	}
+	return c.Code, true
+}
+
+// MustGet returns this CountryCode's value, and panics if it is null.
+func (c CountryCode) MustGet() string {
+	if !c.Valid {
+		panic("null: MustGet called on a null CountryCode")
+	}
+	return c.Code
+}
+
+// Get returns this CurrencyCode's value and true if it is valid, otherwise the zero value and false.
+func (c CurrencyCode) Get() (string, bool) {
+	if !c.Valid {
+		var zero string
+		return zero, false
+	}
+	return c.Code, true
+}
+
+// MustGet returns this CurrencyCode's value, and panics if it is null.
+func (c CurrencyCode) MustGet() string {
+	if !c.Valid {
+		panic("null: MustGet called on a null CurrencyCode")
+	}
+	return c.Code
+}
+
+// Get returns this Date's value and true if it is valid, otherwise the zero value and false.
+func (d Date) Get() (time.Time, bool) {
+	if !d.Valid {
+		var zero time.Time
+		return zero, false
+	}
+	return d.In(time.UTC), true
+}
+
+// MustGet returns this Date's value, and panics if it is null.
+func (d Date) MustGet() time.Time {
+	if !d.Valid {
+		panic("null: MustGet called on a null Date")
+	}
+	return d.In(time.UTC)
+}
+
+// Get returns this Duration's value and true if it is valid, otherwise the zero value and false.
+func (d Duration) Get() (time.Duration, bool) {
+	if !d.Valid {
+		var zero time.Duration
+		return zero, false
+	}
+	return d.Duration, true
+}
+
+// MustGet returns this Duration's value, and panics if it is null.
+func (d Duration) MustGet() time.Duration {
+	if !d.Valid {
+		panic("null: MustGet called on a null Duration")
+	}
+	return d.Duration
+}
+
+// Get returns this Email's value and true if it is valid, otherwise the zero value and false.
+func (e Email) Get() (string, bool) {
+	if !e.Valid {
+		var zero string
+		return zero, false
+	}
+	return e.Address, true
+}
+
+// MustGet returns this Email's value, and panics if it is null.
+func (e Email) MustGet() string {
+	if !e.Valid {
+		panic("null: MustGet called on a null Email")
+	}
+	return e.Address
+}
+
+// Get returns this Enum's value and true if it is valid, otherwise the zero value and false.
+func (e Enum[T]) Get() (T, bool) {
+	if !e.Valid {
+		var zero T
+		return zero, false
+	}
+	return e.V, true
+}
+
+// MustGet returns this Enum's value, and panics if it is null.
+func (e Enum[T]) MustGet() T {
+	if !e.Valid {
+		panic("null: MustGet called on a null Enum")
+	}
+	return e.V
+}
+
+// Get returns this FileMode's value and true if it is valid, otherwise the zero value and false.
+func (m FileMode) Get() (fs.FileMode, bool) {
+	if !m.Valid {
+		var zero fs.FileMode
+		return zero, false
+	}
+	return m.FileMode, true
+}
+
+// MustGet returns this FileMode's value, and panics if it is null.
+func (m FileMode) MustGet() fs.FileMode {
+	if !m.Valid {
+		panic("null: MustGet called on a null FileMode")
+	}
+	return m.FileMode
+}
+
+// Get returns this FixedHex's value and true if it is valid, otherwise the zero value and false.
+func (h FixedHex[A]) Get() (A, bool) {
+	if !h.Valid {
+		var zero A
+		return zero, false
+	}
+	return h.V, true
+}
+
+// MustGet returns this FixedHex's value, and panics if it is null.
+func (h FixedHex[A]) MustGet() A {
+	if !h.Valid {
+		panic("null: MustGet called on a null FixedHex")
+	}
+	return h.V
+}
+
+// Get returns this Flags's value and true if it is valid, otherwise the zero value and false.
+func (f Flags[T]) Get() (T, bool) {
+	if !f.Valid {
+		var zero T
+		return zero, false
+	}
+	return f.V, true
+}
+
+// MustGet returns this Flags's value, and panics if it is null.
+func (f Flags[T]) MustGet() T {
+	if !f.Valid {
+		panic("null: MustGet called on a null Flags")
+	}
+	return f.V
+}
+
+// Get returns this Float's value and true if it is valid, otherwise the zero value and false.
+func (f Float) Get() (float64, bool) {
+	if !f.Valid {
+		var zero float64
+		return zero, false
+	}
+	return f.Float64, true
+}
+
+// MustGet returns this Float's value, and panics if it is null.
+func (f Float) MustGet() float64 {
+	if !f.Valid {
+		panic("null: MustGet called on a null Float")
+	}
+	return f.Float64
+}
+
+// Get returns this HardwareAddr's value and true if it is valid, otherwise the zero value and false.
+func (h HardwareAddr) Get() (net.HardwareAddr, bool) {
+	if !h.Valid {
+		var zero net.HardwareAddr
+		return zero, false
+	}
+	return h.HardwareAddr, true
+}
+
+// MustGet returns this HardwareAddr's value, and panics if it is null.
+func (h HardwareAddr) MustGet() net.HardwareAddr {
+	if !h.Valid {
+		panic("null: MustGet called on a null HardwareAddr")
+	}
+	return h.HardwareAddr
+}
+
+// Get returns this Hex's value and true if it is valid, otherwise the zero value and false.
+func (h Hex) Get() ([]byte, bool) {
+	if !h.Valid {
+		var zero []byte
+		return zero, false
+	}
+	return h.Bytes, true
+}
+
+// MustGet returns this Hex's value, and panics if it is null.
+func (h Hex) MustGet() []byte {
+	if !h.Valid {
+		panic("null: MustGet called on a null Hex")
+	}
+	return h.Bytes
+}
+
+// Get returns this Int's value and true if it is valid, otherwise the zero value and false.
+func (i Int) Get() (int64, bool) {
+	if !i.Valid {
+		var zero int64
+		return zero, false
+	}
+	return i.Int64, true
+}
+
+// MustGet returns this Int's value, and panics if it is null.
+func (i Int) MustGet() int64 {
+	if !i.Valid {
+		panic("null: MustGet called on a null Int")
+	}
+	return i.Int64
+}
+
+// Get returns this Int16's value and true if it is valid, otherwise the zero value and false.
+func (i Int16) Get() (int16, bool) {
+	if !i.Valid {
+		var zero int16
+		return zero, false
+	}
+	return i.Int16, true
+}
+
+// MustGet returns this Int16's value, and panics if it is null.
+func (i Int16) MustGet() int16 {
+	if !i.Valid {
+		panic("null: MustGet called on a null Int16")
+	}
+	return i.Int16
+}
+
+// Get returns this Int32's value and true if it is valid, otherwise the zero value and false.
+func (i Int32) Get() (int32, bool) {
+	if !i.Valid {
+		var zero int32
+		return zero, false
+	}
+	return i.Int32, true
+}
+
+// MustGet returns this Int32's value, and panics if it is null.
+func (i Int32) MustGet() int32 {
+	if !i.Valid {
+		panic("null: MustGet called on a null Int32")
+	}
+	return i.Int32
+}
+
+// Get returns this Int8's value and true if it is valid, otherwise the zero value and false.
+func (i Int8) Get() (int8, bool) {
+	if !i.Valid {
+		var zero int8
+		return zero, false
+	}
+	return i.Int8, true
+}
+
+// MustGet returns this Int8's value, and panics if it is null.
+func (i Int8) MustGet() int8 {
+	if !i.Valid {
+		panic("null: MustGet called on a null Int8")
+	}
+	return i.Int8
+}
+
+// Get returns this JSON's value and true if it is valid, otherwise the zero value and false.
+func (j JSON) Get() (json.RawMessage, bool) {
+	if !j.Valid {
+		var zero json.RawMessage
+		return zero, false
+	}
+	return j.JSON, true
+}
+
+// MustGet returns this JSON's value, and panics if it is null.
+func (j JSON) MustGet() json.RawMessage {
+	if !j.Valid {
+		panic("null: MustGet called on a null JSON")
+	}
+	return j.JSON
+}
+
+// Get returns this KSUID's value and true if it is valid, otherwise the zero value and false.
+func (k KSUID) Get() ([20]byte, bool) {
+	if !k.Valid {
+		var zero [20]byte
+		return zero, false
+	}
+	return k.KSUID, true
+}
+
+// MustGet returns this KSUID's value, and panics if it is null.
+func (k KSUID) MustGet() [20]byte {
+	if !k.Valid {
+		panic("null: MustGet called on a null KSUID")
+	}
+	return k.KSUID
+}
+
+// Get returns this LanguageTag's value and true if it is valid, otherwise the zero value and false.
+func (l LanguageTag) Get() (string, bool) {
+	if !l.Valid {
+		var zero string
+		return zero, false
+	}
+	return l.Tag, true
+}
+
+// MustGet returns this LanguageTag's value, and panics if it is null.
+func (l LanguageTag) MustGet() string {
+	if !l.Valid {
+		panic("null: MustGet called on a null LanguageTag")
+	}
+	return l.Tag
+}
+
+// MustGet returns this Map's value, and panics if it is null.
+func (m Map[K, V]) MustGet() map[K]V {
+	if !m.Valid {
+		panic("null: MustGet called on a null Map")
+	}
+	return m.V
+}
+
+// Get returns this Optional's value and true if it is valid, otherwise the zero value and false.
+func (o Optional[T]) Get() (T, bool) {
+	if !o.Valid {
+		var zero T
+		return zero, false
+	}
+	return o.V, true
+}
+
+// MustGet returns this Optional's value, and panics if it is null.
+func (o Optional[T]) MustGet() T {
+	if !o.Valid {
+		panic("null: MustGet called on a null Optional")
+	}
+	return o.V
+}
+
+// Get returns this Percent's value and true if it is valid, otherwise the zero value and false.
+func (p Percent) Get() (float64, bool) {
+	if !p.Valid {
+		var zero float64
+		return zero, false
+	}
+	return p.Float64, true
+}
+
+// MustGet returns this Percent's value, and panics if it is null.
+func (p Percent) MustGet() float64 {
+	if !p.Valid {
+		panic("null: MustGet called on a null Percent")
+	}
+	return p.Float64
+}
+
+// Get returns this Prefix's value and true if it is valid, otherwise the zero value and false.
+func (p Prefix) Get() (netip.Prefix, bool) {
+	if !p.Valid {
+		var zero netip.Prefix
+		return zero, false
+	}
+	return p.Prefix, true
+}
+
+// MustGet returns this Prefix's value, and panics if it is null.
+func (p Prefix) MustGet() netip.Prefix {
+	if !p.Valid {
+		panic("null: MustGet called on a null Prefix")
+	}
+	return p.Prefix
+}
+
+// Get returns this Regexp's value and true if it is valid, otherwise the zero value and false.
+func (r Regexp) Get() (*regexp.Regexp, bool) {
+	if !r.Valid {
+		var zero *regexp.Regexp
+		return zero, false
+	}
+	return r.Regexp, true
+}
+
+// MustGet returns this Regexp's value, and panics if it is null.
+func (r Regexp) MustGet() *regexp.Regexp {
+	if !r.Valid {
+		panic("null: MustGet called on a null Regexp")
+	}
+	return r.Regexp
+}
+
+// Get returns this Semver's value and true if it is valid, otherwise the zero value and false.
+func (s Semver) Get() (string, bool) {
+	if !s.Valid {
+		var zero string
+		return zero, false
+	}
+	return s.Version, true
+}
+
+// MustGet returns this Semver's value, and panics if it is null.
+func (s Semver) MustGet() string {
+	if !s.Valid {
+		panic("null: MustGet called on a null Semver")
+	}
+	return s.Version
+}
+
+// Get returns this Slice's value and true if it is valid, otherwise the zero value and false.
+func (s Slice[T]) Get() ([]T, bool) {
+	if !s.Valid {
+		var zero []T
+		return zero, false
+	}
+	return s.V, true
+}
+
+// MustGet returns this Slice's value, and panics if it is null.
+func (s Slice[T]) MustGet() []T {
+	if !s.Valid {
+		panic("null: MustGet called on a null Slice")
+	}
+	return s.V
+}
+
+// Get returns this String's value and true if it is valid, otherwise the zero value and false.
+func (s String) Get() (string, bool) {
+	if !s.Valid {
+		var zero string
+		return zero, false
+	}
+	return s.String, true
+}
+
+// MustGet returns this String's value, and panics if it is null.
+func (s String) MustGet() string {
+	if !s.Valid {
+		panic("null: MustGet called on a null String")
+	}
+	return s.String
+}
+
+// Get returns this Time's value and true if it is valid, otherwise the zero value and false.
+func (t Time) Get() (time.Time, bool) {
+	if !t.Valid {
+		var zero time.Time
+		return zero, false
+	}
+	return t.Time, true
+}
+
+// MustGet returns this Time's value, and panics if it is null.
+func (t Time) MustGet() time.Time {
+	if !t.Valid {
+		panic("null: MustGet called on a null Time")
+	}
+	return t.Time
+}
+
+// Get returns this TimeOfDay's value and true if it is valid, otherwise the zero value and false.
+func (t TimeOfDay) Get() (time.Time, bool) {
+	if !t.Valid {
+		var zero time.Time
+		return zero, false
+	}
+	return t.time(), true
+}
+
+// MustGet returns this TimeOfDay's value, and panics if it is null.
+func (t TimeOfDay) MustGet() time.Time {
+	if !t.Valid {
+		panic("null: MustGet called on a null TimeOfDay")
+	}
+	return t.time()
+}
+
+// Get returns this Uint's value and true if it is valid, otherwise the zero value and false.
+func (i Uint) Get() (uint64, bool) {
+	if !i.Valid {
+		var zero uint64
+		return zero, false
+	}
+	return i.Uint64, true
+}
+
+// MustGet returns this Uint's value, and panics if it is null.
+func (i Uint) MustGet() uint64 {
+	if !i.Valid {
+		panic("null: MustGet called on a null Uint")
+	}
+	return i.Uint64
+}
+
+// Get returns this Uint16's value and true if it is valid, otherwise the zero value and false.
+func (i Uint16) Get() (uint16, bool) {
+	if !i.Valid {
+		var zero uint16
+		return zero, false
+	}
+	return i.Uint16, true
+}
+
+// MustGet returns this Uint16's value, and panics if it is null.
+func (i Uint16) MustGet() uint16 {
+	if !i.Valid {
+		panic("null: MustGet called on a null Uint16")
+	}
+	return i.Uint16
+}
+
+// Get returns this Uint32's value and true if it is valid, otherwise the zero value and false.
+func (i Uint32) Get() (uint32, bool) {
+	if !i.Valid {
+		var zero uint32
+		return zero, false
+	}
+	return i.Uint32, true
+}
+
+// MustGet returns this Uint32's value, and panics if it is null.
+func (i Uint32) MustGet() uint32 {
+	if !i.Valid {
+		panic("null: MustGet called on a null Uint32")
+	}
+	return i.Uint32
+}
+
+// Get returns this Uint8's value and true if it is valid, otherwise the zero value and false.
+func (i Uint8) Get() (uint8, bool) {
+	if !i.Valid {
+		var zero uint8
+		return zero, false
+	}
+	return i.Uint8, true
+}
+
+// MustGet returns this Uint8's value, and panics if it is null.
+func (i Uint8) MustGet() uint8 {
+	if !i.Valid {
+		panic("null: MustGet called on a null Uint8")
+	}
+	return i.Uint8
+}
+
+// Get returns this ULID's value and true if it is valid, otherwise the zero value and false.
+func (u ULID) Get() ([16]byte, bool) {
+	if !u.Valid {
+		var zero [16]byte
+		return zero, false
+	}
+	return u.ULID, true
+}
+
+// MustGet returns this ULID's value, and panics if it is null.
+func (u ULID) MustGet() [16]byte {
+	if !u.Valid {
+		panic("null: MustGet called on a null ULID")
+	}
+	return u.ULID
+}
+
+// Get returns this UnixMillis's value and true if it is valid, otherwise the zero value and false.
+func (u UnixMillis) Get() (time.Time, bool) {
+	if !u.Valid {
+		var zero time.Time
+		return zero, false
+	}
+	return u.Time, true
+}
+
+// MustGet returns this UnixMillis's value, and panics if it is null.
+func (u UnixMillis) MustGet() time.Time {
+	if !u.Valid {
+		panic("null: MustGet called on a null UnixMillis")
+	}
+	return u.Time
+}
+
+// Get returns this UnixSeconds's value and true if it is valid, otherwise the zero value and false.
+func (u UnixSeconds) Get() (time.Time, bool) {
+	if !u.Valid {
+		var zero time.Time
+		return zero, false
+	}
+	return u.Time, true
+}
+
+// MustGet returns this UnixSeconds's value, and panics if it is null.
+func (u UnixSeconds) MustGet() time.Time {
+	if !u.Valid {
+		panic("null: MustGet called on a null UnixSeconds")
+	}
+	return u.Time
+}
+
+// Get returns this URL's value and true if it is valid, otherwise the zero value and false.
+func (u URL) Get() (*url.URL, bool) {
+	if !u.Valid {
+		var zero *url.URL
+		return zero, false
+	}
+	return u.URL, true
+}
+
+// MustGet returns this URL's value, and panics if it is null.
+func (u URL) MustGet() *url.URL {
+	if !u.Valid {
+		panic("null: MustGet called on a null URL")
+	}
+	return u.URL
+}
+
+// Get returns this Value's value and true if it is valid, otherwise the zero value and false.
+func (v Value[T]) Get() (T, bool) {
+	if !v.Valid {
+		var zero T
+		return zero, false
+	}
+	return v.V, true
+}
+
+// MustGet returns this Value's value, and panics if it is null.
+func (v Value[T]) MustGet() T {
+	if !v.Valid {
+		panic("null: MustGet called on a null Value")
+	}
+	return v.V
+}
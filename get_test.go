@@ -0,0 +1,70 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	if v, ok := UintFrom(42).Get(); v != 42 || !ok {
+		t.Errorf("Get(): got %d, %v", v, ok)
+	}
+	if v, ok := NewUint(42, false).Get(); v != 0 || ok {
+		t.Errorf("null Get(): got %d, %v", v, ok)
+	}
+	if v, ok := OptionalNull[string]().Get(); v != "" || ok {
+		t.Errorf("null Optional Get(): got %q, %v", v, ok)
+	}
+	if v := StringFrom("hello").MustGet(); v != "hello" {
+		t.Errorf("MustGet(): got %q", v)
+	}
+}
+
+func TestMustGetPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "null: MustGet called on a null Uint" {
+			t.Errorf("unexpected panic: %v", r)
+		}
+	}()
+	NewUint(42, false).MustGet()
+	t.Error("MustGet() of null should panic")
+}
+
+func TestAllTypesGet(t *testing.T) {
+	example := reflect.ValueOf(exampleAllTypes())
+	typ := example.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		valid, null := example.Field(i), reflect.Zero(f.Type)
+		if f.Name == "Money" || f.Name == "Point" || f.Name == "Int64Range" || f.Name == "TimeRange" {
+			continue
+		}
+		mustGet := valid.MethodByName("MustGet")
+		if !mustGet.IsValid() {
+			t.Errorf("%s: doesn't implement MustGet", f.Name)
+			continue
+		}
+		want := valid.MethodByName("OrElse").Call([]reflect.Value{reflect.Zero(mustGet.Type().Out(0))})[0].Interface()
+		if got := mustGet.Call(nil)[0].Interface(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: MustGet(): got %v, want %v", f.Name, got, want)
+		}
+		if get := valid.MethodByName("Get"); get.IsValid() && get.Type().NumIn() == 0 {
+			if out := get.Call(nil); !reflect.DeepEqual(out[0].Interface(), want) || !out[1].Bool() {
+				t.Errorf("%s: Get(): got %v, %v", f.Name, out[0], out[1])
+			}
+			if out := null.MethodByName("Get").Call(nil); !out[0].IsZero() || out[1].Bool() {
+				t.Errorf("%s: null Get(): got %v, %v", f.Name, out[0], out[1])
+			}
+		} else if f.Name != "Map" {
+			t.Errorf("%s: doesn't implement Get", f.Name)
+		}
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: MustGet() of null should panic", f.Name)
+				}
+			}()
+			null.MethodByName("MustGet").Call(nil)
+		}()
+	}
+}
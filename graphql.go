@@ -0,0 +1,637 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// This file implements the Marshaler and Unmarshaler interfaces from github.com/99designs/gqlgen,
+// so that every type can be bound directly as a GraphQL scalar:
+//
+//	models:
+//	  NullInt:
+//	    model: gopkg.in/guregu/null.v4.Int
+//
+// The interfaces only use built-in types, so no build tag is needed.
+// Values are converted to and from the same representation as JSON,
+// so null values are encoded as GraphQL null, and GraphQL null decodes to null.
+
+// marshalGQL writes the JSON encoding of m to w, or null if m can't be encoded.
+// MarshalGQL has no way to report errors.
+func marshalGQL(w io.Writer, m json.Marshaler) {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		data = nullBytes
+	}
+	_, _ = w.Write(data)
+}
+
+// unmarshalGQL decodes a GraphQL input value, as provided by gqlgen, into u via its JSON representation.
+func unmarshalGQL(u json.Unmarshaler, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal GraphQL: %w", err)
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Addr is null.
+func (a Addr) MarshalGQL(w io.Writer) {
+	marshalGQL(w, a)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Addr can be unmarshaled from as JSON.
+func (a *Addr) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(a, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Any is null.
+func (a Any) MarshalGQL(w io.Writer) {
+	marshalGQL(w, a)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Any can be unmarshaled from as JSON.
+func (a *Any) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(a, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Base64 is null.
+func (b Base64) MarshalGQL(w io.Writer) {
+	marshalGQL(w, b)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Base64 can be unmarshaled from as JSON.
+func (b *Base64) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(b, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this BigInt is null.
+func (b BigInt) MarshalGQL(w io.Writer) {
+	marshalGQL(w, b)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this BigInt can be unmarshaled from as JSON.
+func (b *BigInt) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(b, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalGQL(w io.Writer) {
+	marshalGQL(w, b)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Bool can be unmarshaled from as JSON.
+func (b *Bool) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(b, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this ByteSize is null.
+func (b ByteSize) MarshalGQL(w io.Writer) {
+	marshalGQL(w, b)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this ByteSize can be unmarshaled from as JSON.
+func (b *ByteSize) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(b, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Bytes is null.
+func (b Bytes) MarshalGQL(w io.Writer) {
+	marshalGQL(w, b)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Bytes can be unmarshaled from as JSON.
+func (b *Bytes) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(b, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Complex is null.
+func (c Complex) MarshalGQL(w io.Writer) {
+	marshalGQL(w, c)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Complex can be unmarshaled from as JSON.
+func (c *Complex) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(c, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Date is null.
+func (d Date) MarshalGQL(w io.Writer) {
+	marshalGQL(w, d)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Date can be unmarshaled from as JSON.
+func (d *Date) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(d, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Duration is null.
+func (d Duration) MarshalGQL(w io.Writer) {
+	marshalGQL(w, d)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Duration can be unmarshaled from as JSON.
+func (d *Duration) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(d, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Email is null.
+func (e Email) MarshalGQL(w io.Writer) {
+	marshalGQL(w, e)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Email can be unmarshaled from as JSON.
+func (e *Email) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(e, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Enum is null.
+func (e Enum[T]) MarshalGQL(w io.Writer) {
+	marshalGQL(w, e)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Enum can be unmarshaled from as JSON.
+func (e *Enum[T]) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(e, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this FileMode is null.
+func (m FileMode) MarshalGQL(w io.Writer) {
+	marshalGQL(w, m)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this FileMode can be unmarshaled from as JSON.
+func (m *FileMode) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(m, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Flags is null.
+func (f Flags[T]) MarshalGQL(w io.Writer) {
+	marshalGQL(w, f)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Flags can be unmarshaled from as JSON.
+func (f *Flags[T]) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(f, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Float is null.
+func (f Float) MarshalGQL(w io.Writer) {
+	marshalGQL(w, f)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Float can be unmarshaled from as JSON.
+func (f *Float) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(f, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this HardwareAddr is null.
+func (h HardwareAddr) MarshalGQL(w io.Writer) {
+	marshalGQL(w, h)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this HardwareAddr can be unmarshaled from as JSON.
+func (h *HardwareAddr) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(h, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Hex is null.
+func (h Hex) MarshalGQL(w io.Writer) {
+	marshalGQL(w, h)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Hex can be unmarshaled from as JSON.
+func (h *Hex) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(h, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this FixedHex is null.
+func (h FixedHex[A]) MarshalGQL(w io.Writer) {
+	marshalGQL(w, h)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this FixedHex can be unmarshaled from as JSON.
+func (h *FixedHex[A]) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(h, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Int is null.
+func (i Int) MarshalGQL(w io.Writer) {
+	marshalGQL(w, i)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Int can be unmarshaled from as JSON.
+func (i *Int) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(i, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalGQL(w io.Writer) {
+	marshalGQL(w, i)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Int16 can be unmarshaled from as JSON.
+func (i *Int16) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(i, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalGQL(w io.Writer) {
+	marshalGQL(w, i)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Int32 can be unmarshaled from as JSON.
+func (i *Int32) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(i, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalGQL(w io.Writer) {
+	marshalGQL(w, i)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Int8 can be unmarshaled from as JSON.
+func (i *Int8) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(i, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this CountryCode is null.
+func (c CountryCode) MarshalGQL(w io.Writer) {
+	marshalGQL(w, c)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this CountryCode can be unmarshaled from as JSON.
+func (c *CountryCode) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(c, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this CurrencyCode is null.
+func (c CurrencyCode) MarshalGQL(w io.Writer) {
+	marshalGQL(w, c)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this CurrencyCode can be unmarshaled from as JSON.
+func (c *CurrencyCode) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(c, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this JSON is null.
+func (j JSON) MarshalGQL(w io.Writer) {
+	marshalGQL(w, j)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this JSON can be unmarshaled from as JSON.
+func (j *JSON) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(j, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this KSUID is null.
+func (k KSUID) MarshalGQL(w io.Writer) {
+	marshalGQL(w, k)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this KSUID can be unmarshaled from as JSON.
+func (k *KSUID) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(k, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this LanguageTag is null.
+func (l LanguageTag) MarshalGQL(w io.Writer) {
+	marshalGQL(w, l)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this LanguageTag can be unmarshaled from as JSON.
+func (l *LanguageTag) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(l, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Map is null.
+func (m Map[K, V]) MarshalGQL(w io.Writer) {
+	marshalGQL(w, m)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Map can be unmarshaled from as JSON.
+func (m *Map[K, V]) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(m, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Money is null.
+func (m Money) MarshalGQL(w io.Writer) {
+	marshalGQL(w, m)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Money can be unmarshaled from as JSON.
+func (m *Money) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(m, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Optional is null.
+func (o Optional[T]) MarshalGQL(w io.Writer) {
+	marshalGQL(w, o)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Optional can be unmarshaled from as JSON.
+func (o *Optional[T]) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(o, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Percent is null.
+func (p Percent) MarshalGQL(w io.Writer) {
+	marshalGQL(w, p)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Percent can be unmarshaled from as JSON.
+func (p *Percent) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(p, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Point is null.
+func (p Point) MarshalGQL(w io.Writer) {
+	marshalGQL(w, p)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Point can be unmarshaled from as JSON.
+func (p *Point) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(p, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Prefix is null.
+func (p Prefix) MarshalGQL(w io.Writer) {
+	marshalGQL(w, p)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Prefix can be unmarshaled from as JSON.
+func (p *Prefix) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(p, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Int64Range is null.
+func (r Int64Range) MarshalGQL(w io.Writer) {
+	marshalGQL(w, r)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Int64Range can be unmarshaled from as JSON.
+func (r *Int64Range) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(r, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this TimeRange is null.
+func (r TimeRange) MarshalGQL(w io.Writer) {
+	marshalGQL(w, r)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this TimeRange can be unmarshaled from as JSON.
+func (r *TimeRange) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(r, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Regexp is null.
+func (r Regexp) MarshalGQL(w io.Writer) {
+	marshalGQL(w, r)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Regexp can be unmarshaled from as JSON.
+func (r *Regexp) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(r, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Semver is null.
+func (s Semver) MarshalGQL(w io.Writer) {
+	marshalGQL(w, s)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Semver can be unmarshaled from as JSON.
+func (s *Semver) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(s, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Slice is null.
+func (s Slice[T]) MarshalGQL(w io.Writer) {
+	marshalGQL(w, s)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Slice can be unmarshaled from as JSON.
+func (s *Slice[T]) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(s, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this String is null.
+func (s String) MarshalGQL(w io.Writer) {
+	marshalGQL(w, s)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this String can be unmarshaled from as JSON.
+func (s *String) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(s, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Time is null.
+func (t Time) MarshalGQL(w io.Writer) {
+	marshalGQL(w, t)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Time can be unmarshaled from as JSON.
+func (t *Time) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(t, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this TimeOfDay is null.
+func (t TimeOfDay) MarshalGQL(w io.Writer) {
+	marshalGQL(w, t)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this TimeOfDay can be unmarshaled from as JSON.
+func (t *TimeOfDay) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(t, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Uint is null.
+func (i Uint) MarshalGQL(w io.Writer) {
+	marshalGQL(w, i)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Uint can be unmarshaled from as JSON.
+func (i *Uint) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(i, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Uint16 is null.
+func (i Uint16) MarshalGQL(w io.Writer) {
+	marshalGQL(w, i)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Uint16 can be unmarshaled from as JSON.
+func (i *Uint16) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(i, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Uint32 is null.
+func (i Uint32) MarshalGQL(w io.Writer) {
+	marshalGQL(w, i)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Uint32 can be unmarshaled from as JSON.
+func (i *Uint32) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(i, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Uint8 is null.
+func (i Uint8) MarshalGQL(w io.Writer) {
+	marshalGQL(w, i)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Uint8 can be unmarshaled from as JSON.
+func (i *Uint8) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(i, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this ULID is null.
+func (u ULID) MarshalGQL(w io.Writer) {
+	marshalGQL(w, u)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this ULID can be unmarshaled from as JSON.
+func (u *ULID) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(u, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this UnixSeconds is null.
+func (u UnixSeconds) MarshalGQL(w io.Writer) {
+	marshalGQL(w, u)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this UnixSeconds can be unmarshaled from as JSON.
+func (u *UnixSeconds) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(u, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this UnixMillis is null.
+func (u UnixMillis) MarshalGQL(w io.Writer) {
+	marshalGQL(w, u)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this UnixMillis can be unmarshaled from as JSON.
+func (u *UnixMillis) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(u, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this URL is null.
+func (u URL) MarshalGQL(w io.Writer) {
+	marshalGQL(w, u)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this URL can be unmarshaled from as JSON.
+func (u *URL) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(u, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Value is null.
+func (v Value[T]) MarshalGQL(w io.Writer) {
+	marshalGQL(w, v)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports null and anything this Value can be unmarshaled from as JSON.
+func (v *Value[T]) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(v, input)
+}
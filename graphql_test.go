@@ -0,0 +1,69 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"testing"
+)
+
+type gqlMarshaler interface {
+	MarshalGQL(w io.Writer)
+}
+
+type gqlUnmarshaler interface {
+	UnmarshalGQL(v interface{}) error
+}
+
+func TestGraphQLRoundTrip(t *testing.T) {
+	for _, in := range []allTypes{exampleAllTypes(), {}} {
+		rv := reflect.ValueOf(in)
+		for i := 0; i < rv.NumField(); i++ {
+			name := rv.Type().Field(i).Name
+			field := rv.Field(i)
+			var buf bytes.Buffer
+			field.Interface().(gqlMarshaler).MarshalGQL(&buf)
+			want, err := json.Marshal(field.Interface())
+			maybePanic(err)
+			assertJSONEquals(t, buf.Bytes(), string(want), name+" MarshalGQL")
+
+			// gqlgen decodes variables with UseNumber
+			var input interface{}
+			dec := json.NewDecoder(&buf)
+			dec.UseNumber()
+			err = dec.Decode(&input)
+			maybePanic(err)
+
+			out := reflect.New(field.Type())
+			err = out.Interface().(gqlUnmarshaler).UnmarshalGQL(input)
+			maybePanic(err)
+			got, err := json.Marshal(out.Elem().Interface())
+			maybePanic(err)
+			assertJSONEquals(t, got, string(want), name+" UnmarshalGQL")
+		}
+	}
+}
+
+func TestGraphQLInput(t *testing.T) {
+	var i Int
+	err := i.UnmarshalGQL(int64(12345))
+	maybePanic(err)
+	assertInt(t, i, "UnmarshalGQL int64")
+
+	err = i.UnmarshalGQL(nil)
+	maybePanic(err)
+	assertNullInt(t, i, "UnmarshalGQL nil")
+
+	var s String
+	err = s.UnmarshalGQL("test")
+	maybePanic(err)
+	assertStr(t, s, "UnmarshalGQL string")
+
+	if err := i.UnmarshalGQL(map[string]interface{}{"a": 1}); err == nil {
+		t.Error("expected error for object into Int")
+	}
+	if err := i.UnmarshalGQL(func() {}); err == nil {
+		t.Error("expected error for func into Int")
+	}
+}
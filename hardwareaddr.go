@@ -0,0 +1,205 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullHardwareAddr represents a net.HardwareAddr that may be null.
+// NullHardwareAddr implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullHardwareAddr struct {
+	HardwareAddr net.HardwareAddr
+	Valid        bool // Valid is true if HardwareAddr is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports textual addresses, as stored in VARCHAR columns,
+// and 6, 8, or 20 byte binary addresses, as stored in BINARY columns.
+func (n *NullHardwareAddr) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		n.HardwareAddr, n.Valid = nil, false
+		return nil
+	case []byte:
+		switch len(v) {
+		case 6, 8, 20:
+			// too short to be textual
+			n.HardwareAddr, n.Valid = append(net.HardwareAddr{}, v...), true
+			return nil
+		}
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into HardwareAddr: %v", value, value)
+	}
+	mac, err := net.ParseMAC(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't scan HardwareAddr: %w", err)
+	}
+	n.HardwareAddr, n.Valid = mac, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent in their canonical textual form.
+func (n NullHardwareAddr) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.HardwareAddr.String(), nil
+}
+
+// IsZero returns true for invalid NullHardwareAddrs.
+func (n NullHardwareAddr) IsZero() bool {
+	return !n.Valid
+}
+
+// HardwareAddr is a nullable net.HardwareAddr, for MAC addresses.
+// It will decode to null, not an empty address, if null.
+// Addresses are parsed with net.ParseMAC, which accepts colon, dash, and dot separated forms,
+// and are encoded in the lowercase colon separated form.
+type HardwareAddr struct {
+	NullHardwareAddr
+}
+
+// NewHardwareAddr creates a new HardwareAddr.
+func NewHardwareAddr(mac net.HardwareAddr, valid bool) HardwareAddr {
+	return HardwareAddr{
+		NullHardwareAddr: NullHardwareAddr{
+			HardwareAddr: mac,
+			Valid:        valid,
+		},
+	}
+}
+
+// HardwareAddrFrom creates a new HardwareAddr that will be null if mac is nil.
+func HardwareAddrFrom(mac net.HardwareAddr) HardwareAddr {
+	return NewHardwareAddr(mac, mac != nil)
+}
+
+// ParseHardwareAddr parses s into a new HardwareAddr that will always be valid.
+// It will return an error if s is not a MAC address.
+func ParseHardwareAddr(s string) (HardwareAddr, error) {
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		return HardwareAddr{}, fmt.Errorf("null: couldn't parse HardwareAddr: %w", err)
+	}
+	return NewHardwareAddr(mac, true), nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (h HardwareAddr) ValueOrZero() net.HardwareAddr {
+	if !h.Valid {
+		return nil
+	}
+	return h.HardwareAddr
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+// It will return an error if the input is not a MAC address.
+func (h *HardwareAddr) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(h, &err)
+	if bytes.Equal(data, nullBytes) {
+		h.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	mac, err := net.ParseMAC(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	h.HardwareAddr = mac
+	h.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this HardwareAddr is null.
+func (h HardwareAddr) MarshalJSON() ([]byte, error) {
+	if !h.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(h.HardwareAddr.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null HardwareAddr if the input is blank, "null", or DefaultNullText.
+// It will return an error if the input is not a MAC address, blank, or "null".
+func (h *HardwareAddr) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(h, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		h.Valid = false
+		return nil
+	}
+	mac, err := net.ParseMAC(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	h.HardwareAddr = mac
+	h.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this HardwareAddr is null.
+func (h HardwareAddr) MarshalText() ([]byte, error) {
+	if !h.Valid {
+		return nullText(), nil
+	}
+	return []byte(h.HardwareAddr.String()), nil
+}
+
+// SetValid changes this HardwareAddr's value and also sets it to be non-null.
+func (h *HardwareAddr) SetValid(mac net.HardwareAddr) {
+	h.HardwareAddr = mac
+	h.Valid = true
+}
+
+// SetNull sets this HardwareAddr to null, leaving its value as is. Use Reset to clear the value too.
+func (h *HardwareAddr) SetNull() {
+	h.Valid = false
+}
+
+// Reset sets this HardwareAddr to null and clears its value.
+func (h *HardwareAddr) Reset() {
+	*h = HardwareAddr{}
+}
+
+// Ptr returns a pointer to this HardwareAddr's value, or a nil pointer if this HardwareAddr is null.
+func (h HardwareAddr) Ptr() *net.HardwareAddr {
+	if !h.Valid {
+		return nil
+	}
+	return &h.HardwareAddr
+}
+
+// IsZero returns true for invalid HardwareAddrs.
+func (h HardwareAddr) IsZero() bool {
+	return !h.Valid
+}
+
+// IsZeroOrNull returns true for invalid HardwareAddrs, or valid HardwareAddrs with an empty value.
+func (h HardwareAddr) IsZeroOrNull() bool {
+	return !h.Valid || len(h.HardwareAddr) == 0
+}
+
+// Equal returns true if both HardwareAddrs are the same address or are both null.
+func (h HardwareAddr) Equal(other HardwareAddr) bool {
+	return h.Valid == other.Valid && (!h.Valid || bytes.Equal(h.HardwareAddr, other.HardwareAddr))
+}
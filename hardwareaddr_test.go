@@ -0,0 +1,250 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+)
+
+var (
+	macString = "00:00:5e:00:53:01"
+	macJSON   = []byte(`"` + macString + `"`)
+	macValue  = net.HardwareAddr{0x00, 0x00, 0x5e, 0x00, 0x53, 0x01}
+)
+
+func TestHardwareAddrFrom(t *testing.T) {
+	h := HardwareAddrFrom(macValue)
+	assertHardwareAddr(t, h, "HardwareAddrFrom()")
+
+	null := HardwareAddrFrom(nil)
+	assertNullHardwareAddr(t, null, "HardwareAddrFrom(nil)")
+}
+
+func TestParseHardwareAddr(t *testing.T) {
+	for _, s := range []string{macString, "00-00-5E-00-53-01", "0000.5e00.5301"} {
+		h, err := ParseHardwareAddr(s)
+		maybePanic(err)
+		assertHardwareAddr(t, h, "ParseHardwareAddr() "+s)
+	}
+
+	_, err := ParseHardwareAddr("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestUnmarshalHardwareAddr(t *testing.T) {
+	var h HardwareAddr
+	err := json.Unmarshal(macJSON, &h)
+	maybePanic(err)
+	assertHardwareAddr(t, h, "mac json")
+
+	var dashed HardwareAddr
+	err = json.Unmarshal([]byte(`"00-00-5E-00-53-01"`), &dashed)
+	maybePanic(err)
+	assertHardwareAddr(t, dashed, "dashed mac json")
+
+	var null HardwareAddr
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullHardwareAddr(t, null, "null json")
+
+	var bad HardwareAddr
+	err = json.Unmarshal(stringJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullHardwareAddr(t, bad, "bad mac json")
+
+	var badType HardwareAddr
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullHardwareAddr(t, badType, "wrong type json")
+
+	var invalid HardwareAddr
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullHardwareAddr(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalHardwareAddr(t *testing.T) {
+	var h HardwareAddr
+	err := h.UnmarshalText([]byte("0000.5e00.5301"))
+	maybePanic(err)
+	assertHardwareAddr(t, h, "UnmarshalText() mac")
+
+	var blank HardwareAddr
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullHardwareAddr(t, blank, "UnmarshalText() empty mac")
+
+	var null HardwareAddr
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullHardwareAddr(t, null, `UnmarshalText() "null"`)
+
+	var invalid HardwareAddr
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalHardwareAddr(t *testing.T) {
+	h := HardwareAddrFrom(macValue)
+	data, err := json.Marshal(h)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(macJSON), "non-empty json marshal")
+
+	null := NewHardwareAddr(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalHardwareAddrText(t *testing.T) {
+	h := HardwareAddrFrom(macValue)
+	data, err := h.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, macString, "non-empty text marshal")
+
+	null := NewHardwareAddr(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestHardwareAddrPointer(t *testing.T) {
+	h := HardwareAddrFrom(macValue)
+	ptr := h.Ptr()
+	if !bytes.Equal(*ptr, macValue) {
+		t.Errorf("bad %s mac: %v ≠ %v\n", "pointer", ptr, macValue)
+	}
+
+	null := NewHardwareAddr(nil, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s mac: %v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestHardwareAddrIsZero(t *testing.T) {
+	h := HardwareAddrFrom(macValue)
+	if h.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewHardwareAddr(nil, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestHardwareAddrSetValid(t *testing.T) {
+	change := NewHardwareAddr(nil, false)
+	assertNullHardwareAddr(t, change, "SetValid()")
+	change.SetValid(macValue)
+	assertHardwareAddr(t, change, "SetValid()")
+}
+
+func TestHardwareAddrScan(t *testing.T) {
+	var h HardwareAddr
+	err := h.Scan(macString)
+	maybePanic(err)
+	assertHardwareAddr(t, h, "scanned string")
+
+	var text HardwareAddr
+	err = text.Scan([]byte("00-00-5E-00-53-01"))
+	maybePanic(err)
+	assertHardwareAddr(t, text, "scanned text bytes")
+
+	src := append([]byte{}, macValue...)
+	var bin HardwareAddr
+	err = bin.Scan(src)
+	maybePanic(err)
+	src[0] = 0xff
+	assertHardwareAddr(t, bin, "scanned binary")
+
+	var null HardwareAddr
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullHardwareAddr(t, null, "scanned null")
+
+	var invalid HardwareAddr
+	err = invalid.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var wrong HardwareAddr
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestHardwareAddrValue(t *testing.T) {
+	h := HardwareAddrFrom(macValue)
+	v, err := h.Value()
+	maybePanic(err)
+	if v != macString {
+		t.Errorf("bad Value(): %#v ≠ %s", v, macString)
+	}
+
+	null := NewHardwareAddr(macValue, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestHardwareAddrEqual(t *testing.T) {
+	h1 := NewHardwareAddr(macValue, false)
+	h2 := NewHardwareAddr(nil, false)
+	if !h1.Equal(h2) {
+		t.Error("null HardwareAddrs should be equal")
+	}
+
+	h1 = HardwareAddrFrom(macValue)
+	h2 = HardwareAddrFrom(append(net.HardwareAddr{}, macValue...))
+	if !h1.Equal(h2) {
+		t.Error("same HardwareAddrs should be equal")
+	}
+
+	h1 = HardwareAddrFrom(macValue)
+	h2 = NewHardwareAddr(macValue, false)
+	if h1.Equal(h2) {
+		t.Error("valid and null HardwareAddrs should not be equal")
+	}
+
+	h1 = HardwareAddrFrom(macValue)
+	h2 = HardwareAddrFrom(net.HardwareAddr{1, 2, 3, 4, 5, 6})
+	if h1.Equal(h2) {
+		t.Error("different HardwareAddrs should not be equal")
+	}
+}
+
+func assertHardwareAddr(t *testing.T, h HardwareAddr, from string) {
+	t.Helper()
+	if !bytes.Equal(h.HardwareAddr, macValue) {
+		t.Errorf("bad %s mac: %v ≠ %v\n", from, h.HardwareAddr, macValue)
+	}
+	if !h.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullHardwareAddr(t *testing.T, h HardwareAddr, from string) {
+	t.Helper()
+	if h.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
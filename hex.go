@@ -0,0 +1,421 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// Hex is a nullable []byte that is always represented as lowercase hexadecimal in JSON and text,
+// such as for hashes and digests. Upper case input is accepted.
+// Unlike Bytes, its encoding is independent of DefaultBytesEncoding.
+// For values of a fixed length, see FixedHex.
+// It does not consider empty values to be null.
+// It will decode to null, not empty, if null.
+type Hex struct {
+	NullBytes
+}
+
+// NewHex creates a new Hex.
+func NewHex(b []byte, valid bool) Hex {
+	return Hex{
+		NullBytes: NullBytes{
+			Bytes: b,
+			Valid: valid,
+		},
+	}
+}
+
+// HexFrom creates a new Hex that will always be valid.
+// A nil b will be considered empty, not null.
+func HexFrom(b []byte) Hex {
+	return NewHex(b, true)
+}
+
+// HexFromPtr creates a new Hex that will be null if b is nil.
+func HexFromPtr(b *[]byte) Hex {
+	if b == nil {
+		return NewHex(nil, false)
+	}
+	return NewHex(*b, true)
+}
+
+// ParseHex creates a new Hex by decoding the hexadecimal string s.
+func ParseHex(s string) (Hex, error) {
+	v, err := hex.DecodeString(s)
+	if err != nil {
+		return Hex{}, fmt.Errorf("null: couldn't decode hex: %w", err)
+	}
+	return HexFrom(v), nil
+}
+
+// Scan implements the Scanner interface.
+// Binary ([]byte) values are taken as the raw bytes,
+// while strings from text columns are decoded as hexadecimal.
+// Values are sent to the database as raw bytes.
+func (h *Hex) Scan(value interface{}) (err error) {
+	defer validateDecoded(h, &err)
+	value = driverval.Convert(value)
+	str, ok := value.(string)
+	if !ok {
+		return h.NullBytes.Scan(value)
+	}
+	v, err := hex.DecodeString(str)
+	if err != nil {
+		h.Valid = false
+		return fmt.Errorf("null: couldn't scan Hex: %w", err)
+	}
+	h.Bytes, h.Valid = v, true
+	return nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (h Hex) ValueOrZero() []byte {
+	if !h.Valid {
+		return nil
+	}
+	return h.Bytes
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports hexadecimal string and null input.
+// An empty string will not be considered null.
+func (h *Hex) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(h, &err)
+	if bytes.Equal(data, nullBytes) {
+		h.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := hex.DecodeString(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't decode hex: %w", err)
+	}
+
+	h.Bytes = v
+	h.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Hex is null, otherwise a lowercase hexadecimal string.
+func (h Hex) MarshalJSON() ([]byte, error) {
+	if !h.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(hex.EncodeToString(h.Bytes))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Hex if the input is DefaultNullText, which is blank by default, otherwise it decodes the input.
+func (h *Hex) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(h, &err)
+	if isNullTextOnly(text) {
+		h.Valid = false
+		return nil
+	}
+	v, err := hex.DecodeString(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	h.Bytes = v
+	h.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Hex is null, otherwise lowercase hexadecimal.
+func (h Hex) MarshalText() ([]byte, error) {
+	if !h.Valid {
+		return nullText(), nil
+	}
+	return []byte(hex.EncodeToString(h.Bytes)), nil
+}
+
+// SetValid changes this Hex's value and also sets it to be non-null.
+func (h *Hex) SetValid(v []byte) {
+	h.Bytes = v
+	h.Valid = true
+}
+
+// SetNull sets this Hex to null, leaving its value as is. Use Reset to clear the value too.
+func (h *Hex) SetNull() {
+	h.Valid = false
+}
+
+// Reset sets this Hex to null and clears its value.
+func (h *Hex) Reset() {
+	*h = Hex{}
+}
+
+// Ptr returns a pointer to this Hex's value, or a nil pointer if this Hex is null.
+func (h Hex) Ptr() *[]byte {
+	if !h.Valid {
+		return nil
+	}
+	return &h.Bytes
+}
+
+// SetPtr changes this Hex's value to the value v points to, or sets it to be null if v is nil, like HexFromPtr.
+func (h *Hex) SetPtr(v *[]byte) {
+	*h = HexFromPtr(v)
+}
+
+// IsZero returns true for invalid Hexes.
+// A non-null Hex with an empty value will not be considered zero.
+func (h Hex) IsZero() bool {
+	return !h.Valid
+}
+
+// IsZeroOrNull returns true for invalid Hexs, or valid Hexs with an empty value.
+func (h Hex) IsZeroOrNull() bool {
+	return !h.Valid || len(h.Bytes) == 0
+}
+
+// Equal returns true if both Hexes have the same contents or are both null.
+// Nil and empty values are considered equal.
+func (h Hex) Equal(other Hex) bool {
+	return h.Valid == other.Valid && (!h.Valid || bytes.Equal(h.Bytes, other.Bytes))
+}
+
+// FixedHex is a nullable byte array of type A, such as [32]byte for SHA-256 digests,
+// represented as lowercase hexadecimal in JSON and text.
+// Input that does not decode to exactly len(A) bytes is rejected.
+// A must be a byte array type. Using any other type results in an error when decoding.
+// It will decode to null, not zero, if null.
+type FixedHex[A any] struct {
+	sql.Null[A]
+}
+
+// NewFixedHex creates a new FixedHex.
+func NewFixedHex[A any](v A, valid bool) FixedHex[A] {
+	return FixedHex[A]{
+		Null: sql.Null[A]{
+			V:     v,
+			Valid: valid,
+		},
+	}
+}
+
+// FixedHexFrom creates a new FixedHex that will always be valid.
+func FixedHexFrom[A any](v A) FixedHex[A] {
+	return NewFixedHex(v, true)
+}
+
+// FixedHexFromPtr creates a new FixedHex that will be null if v is nil.
+func FixedHexFromPtr[A any](v *A) FixedHex[A] {
+	if v == nil {
+		var zero A
+		return NewFixedHex(zero, false)
+	}
+	return NewFixedHex(*v, true)
+}
+
+// ParseFixedHex creates a new FixedHex by decoding the hexadecimal string s.
+// It returns an error if s does not decode to exactly len(A) bytes.
+func ParseFixedHex[A any](s string) (FixedHex[A], error) {
+	v, err := decodeFixedHex[A]([]byte(s))
+	if err != nil {
+		return FixedHex[A]{}, fmt.Errorf("null: couldn't decode hex: %w", err)
+	}
+	return FixedHexFrom(v), nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (h FixedHex[A]) ValueOrZero() A {
+	if !h.Valid {
+		var zero A
+		return zero
+	}
+	return h.V
+}
+
+// Scan implements the Scanner interface.
+// Binary ([]byte) values must be exactly len(A) bytes long,
+// while strings from text columns are decoded as hexadecimal.
+// Values are sent to the database as raw bytes.
+func (h *FixedHex[A]) Scan(value interface{}) (err error) {
+	defer validateDecoded(h, &err)
+	value = driverval.Convert(value)
+	var v A
+	switch x := value.(type) {
+	case nil:
+		var zero A
+		h.V, h.Valid = zero, false
+		return nil
+	case []byte:
+		v, err = fixedHexFromBytes[A](x)
+	case string:
+		v, err = decodeFixedHex[A]([]byte(x))
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into FixedHex: %v", value, value)
+	}
+	if err != nil {
+		h.Valid = false
+		return fmt.Errorf("null: couldn't scan FixedHex: %w", err)
+	}
+	h.V, h.Valid = v, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as raw bytes.
+func (h FixedHex[A]) Value() (driver.Value, error) {
+	if !h.Valid {
+		return nil, nil
+	}
+	return fixedHexBytes(&h.V), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports hexadecimal string and null input.
+func (h *FixedHex[A]) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(h, &err)
+	if bytes.Equal(data, nullBytes) {
+		h.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := decodeFixedHex[A]([]byte(str))
+	if err != nil {
+		return fmt.Errorf("null: couldn't decode hex: %w", err)
+	}
+
+	h.V = v
+	h.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this FixedHex is null, otherwise a lowercase hexadecimal string.
+func (h FixedHex[A]) MarshalJSON() ([]byte, error) {
+	if !h.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(hex.EncodeToString(fixedHexBytes(&h.V)))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null FixedHex if the input is blank or DefaultNullText, otherwise it decodes the input.
+func (h *FixedHex[A]) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(h, &err)
+	if isNullText(text) {
+		h.Valid = false
+		return nil
+	}
+	v, err := decodeFixedHex[A](text)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	h.V = v
+	h.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this FixedHex is null, otherwise lowercase hexadecimal.
+func (h FixedHex[A]) MarshalText() ([]byte, error) {
+	if !h.Valid {
+		return nullText(), nil
+	}
+	return []byte(hex.EncodeToString(fixedHexBytes(&h.V))), nil
+}
+
+// SetValid changes this FixedHex's value and also sets it to be non-null.
+func (h *FixedHex[A]) SetValid(v A) {
+	h.V = v
+	h.Valid = true
+}
+
+// SetNull sets this FixedHex to null, leaving its value as is. Use Reset to clear the value too.
+func (h *FixedHex[A]) SetNull() {
+	h.Valid = false
+}
+
+// Reset sets this FixedHex to null and clears its value.
+func (h *FixedHex[A]) Reset() {
+	*h = FixedHex[A]{}
+}
+
+// Ptr returns a pointer to this FixedHex's value, or a nil pointer if this FixedHex is null.
+func (h FixedHex[A]) Ptr() *A {
+	if !h.Valid {
+		return nil
+	}
+	return &h.V
+}
+
+// SetPtr changes this FixedHex's value to the value v points to, or sets it to be null if v is nil, like FixedHexFromPtr.
+func (h *FixedHex[A]) SetPtr(v *A) {
+	*h = FixedHexFromPtr(v)
+}
+
+// IsZero returns true for invalid FixedHexes.
+// A non-null FixedHex with all bytes zero will not be considered zero.
+func (h FixedHex[A]) IsZero() bool {
+	return !h.Valid
+}
+
+// IsZeroOrNull returns true for invalid FixedHexs, or valid FixedHexs with all bytes zero.
+func (h FixedHex[A]) IsZeroOrNull() bool {
+	return !h.Valid || reflect.ValueOf(h.V).IsZero()
+}
+
+// Equal returns true if both FixedHexes have the same contents or are both null.
+func (h FixedHex[A]) Equal(other FixedHex[A]) bool {
+	return h.Valid == other.Valid && (!h.Valid || bytes.Equal(fixedHexBytes(&h.V), fixedHexBytes(&other.V)))
+}
+
+// fixedHexLen returns the length of the byte array type A.
+func fixedHexLen[A any]() (int, error) {
+	t := reflect.TypeOf((*A)(nil)).Elem()
+	if t.Kind() != reflect.Array || t.Elem().Kind() != reflect.Uint8 {
+		return 0, fmt.Errorf("FixedHex type must be a byte array, not %v", t)
+	}
+	return t.Len(), nil
+}
+
+// fixedHexBytes returns a copy of the contents of the byte array *v.
+func fixedHexBytes[A any](v *A) []byte {
+	rv := reflect.ValueOf(v).Elem()
+	if rv.Kind() != reflect.Array {
+		return nil
+	}
+	return append([]byte(nil), rv.Slice(0, rv.Len()).Bytes()...)
+}
+
+func fixedHexFromBytes[A any](b []byte) (A, error) {
+	var v A
+	n, err := fixedHexLen[A]()
+	if err != nil {
+		return v, err
+	}
+	if len(b) != n {
+		return v, fmt.Errorf("value must be %d bytes, got %d", n, len(b))
+	}
+	reflect.Copy(reflect.ValueOf(&v).Elem(), reflect.ValueOf(b))
+	return v, nil
+}
+
+func decodeFixedHex[A any](src []byte) (A, error) {
+	b := make([]byte, hex.DecodedLen(len(src)))
+	if _, err := hex.Decode(b, src); err != nil {
+		var zero A
+		return zero, err
+	}
+	return fixedHexFromBytes[A](b)
+}
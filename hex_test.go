@@ -0,0 +1,303 @@
+package null
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	hexValue       = []byte{0xde, 0xad, 0xbe, 0xef}
+	hexJSON        = []byte(`"deadbeef"`)
+	upperHexJSON   = []byte(`"DEADBEEF"`)
+	oddHexJSON     = []byte(`"deadbee"`)
+	invalidHexJSON = []byte(`"zz"`)
+
+	sha256Value = sha256.Sum256([]byte("hello"))
+	sha256Hex   = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+)
+
+func TestHexFrom(t *testing.T) {
+	h := HexFrom(hexValue)
+	assertHex(t, h, "HexFrom()")
+
+	empty := HexFrom(nil)
+	if !empty.Valid {
+		t.Error("HexFrom(nil)", "is invalid, but should be valid")
+	}
+}
+
+func TestHexFromPtr(t *testing.T) {
+	v := hexValue
+	h := HexFromPtr(&v)
+	assertHex(t, h, "HexFromPtr()")
+
+	null := HexFromPtr(nil)
+	assertNullHex(t, null, "HexFromPtr(nil)")
+}
+
+func TestUnmarshalHex(t *testing.T) {
+	var h Hex
+	err := json.Unmarshal(hexJSON, &h)
+	maybePanic(err)
+	assertHex(t, h, "hex json")
+
+	var upper Hex
+	err = json.Unmarshal(upperHexJSON, &upper)
+	maybePanic(err)
+	assertHex(t, upper, "upper case hex json")
+
+	var blank Hex
+	err = json.Unmarshal(blankBytesJSON, &blank)
+	maybePanic(err)
+	if !blank.Valid || len(blank.Bytes) != 0 {
+		t.Errorf("bad blank hex json: %#v", blank)
+	}
+
+	var null Hex
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullHex(t, null, "null json")
+
+	for _, bad := range [][]byte{oddHexJSON, invalidHexJSON, intJSON, invalidJSON} {
+		var invalid Hex
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullHex(t, invalid, "invalid json")
+	}
+}
+
+func TestMarshalHex(t *testing.T) {
+	h := HexFrom(hexValue)
+	data, err := json.Marshal(h)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(hexJSON), "non-empty json marshal")
+
+	data, err = h.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "deadbeef", "text marshal")
+
+	null := NewHex(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestHexText(t *testing.T) {
+	var h Hex
+	err := h.UnmarshalText([]byte("DeadBeef"))
+	maybePanic(err)
+	assertHex(t, h, "text unmarshal")
+
+	var blank Hex
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullHex(t, blank, "blank text unmarshal")
+
+	var bad Hex
+	if err := bad.UnmarshalText([]byte("xyz")); err == nil {
+		t.Error("expected error unmarshaling invalid text")
+	}
+}
+
+func TestParseHex(t *testing.T) {
+	h, err := ParseHex("deadbeef")
+	maybePanic(err)
+	assertHex(t, h, "ParseHex()")
+
+	if _, err := ParseHex("deadbee"); err == nil {
+		t.Error("expected error parsing odd-length hex")
+	}
+}
+
+func TestHexScanValue(t *testing.T) {
+	var text Hex
+	err := text.Scan("deadbeef")
+	maybePanic(err)
+	assertHex(t, text, "scanned text")
+
+	var bin Hex
+	err = bin.Scan([]byte{0xde, 0xad, 0xbe, 0xef})
+	maybePanic(err)
+	assertHex(t, bin, "scanned binary")
+
+	var null Hex
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullHex(t, null, "scanned null")
+
+	var bad Hex
+	if err := bad.Scan("zz"); err == nil {
+		t.Error("expected error scanning invalid hex")
+	}
+	assertNullHex(t, bad, "scanned bad hex")
+
+	v, err := bin.Value()
+	maybePanic(err)
+	if raw, ok := v.([]byte); !ok || !bytes.Equal(raw, hexValue) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+}
+
+func TestHexEqual(t *testing.T) {
+	if !NewHex(nil, false).Equal(NewHex(hexValue, false)) {
+		t.Error("null Hexes should be equal")
+	}
+	if !HexFrom(nil).Equal(HexFrom([]byte{})) {
+		t.Error("nil and empty Hexes should be equal")
+	}
+	if HexFrom(hexValue).Equal(NewHex(hexValue, false)) {
+		t.Error("valid and null Hexes should not be equal")
+	}
+	if HexFrom(hexValue).Equal(HexFrom([]byte("foo"))) {
+		t.Error("different Hexes should not be equal")
+	}
+}
+
+func TestFixedHex(t *testing.T) {
+	h, err := ParseFixedHex[[32]byte](sha256Hex)
+	maybePanic(err)
+	assertFixedHex(t, h, "ParseFixedHex()")
+
+	v := sha256Value
+	assertFixedHex(t, FixedHexFrom(sha256Value), "FixedHexFrom()")
+	assertFixedHex(t, FixedHexFromPtr(&v), "FixedHexFromPtr()")
+	if null := FixedHexFromPtr[[32]byte](nil); null.Valid || !null.IsZero() || null.Ptr() != nil {
+		t.Error("FixedHexFromPtr(nil)", "is valid, but should be invalid")
+	}
+
+	if _, err := ParseFixedHex[[32]byte]("deadbeef"); err == nil {
+		t.Error("expected error parsing hex of the wrong length")
+	}
+	if _, err := ParseFixedHex[string](sha256Hex); err == nil {
+		t.Error("expected error parsing into a non-array type")
+	}
+}
+
+func TestFixedHexJSON(t *testing.T) {
+	var h FixedHex[[32]byte]
+	err := json.Unmarshal([]byte(`"`+sha256Hex+`"`), &h)
+	maybePanic(err)
+	assertFixedHex(t, h, "fixed hex json")
+
+	data, err := json.Marshal(h)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"`+sha256Hex+`"`, "fixed hex json marshal")
+
+	var null FixedHex[[32]byte]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null fixed hex json marshal")
+
+	for _, bad := range [][]byte{hexJSON, blankBytesJSON, invalidHexJSON, intJSON} {
+		var invalid FixedHex[[32]byte]
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+	}
+}
+
+func TestFixedHexText(t *testing.T) {
+	var h FixedHex[[32]byte]
+	err := h.UnmarshalText([]byte(sha256Hex))
+	maybePanic(err)
+	assertFixedHex(t, h, "fixed hex text")
+
+	data, err := h.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, sha256Hex, "fixed hex text marshal")
+
+	var blank FixedHex[[32]byte]
+	err = blank.UnmarshalText(nil)
+	maybePanic(err)
+	if blank.Valid {
+		t.Error("blank text", "is valid, but should be invalid")
+	}
+}
+
+func TestFixedHexScanValue(t *testing.T) {
+	var text FixedHex[[32]byte]
+	err := text.Scan(sha256Hex)
+	maybePanic(err)
+	assertFixedHex(t, text, "scanned text")
+
+	var bin FixedHex[[32]byte]
+	err = bin.Scan(sha256Value[:])
+	maybePanic(err)
+	assertFixedHex(t, bin, "scanned binary")
+
+	var short FixedHex[[32]byte]
+	if err := short.Scan(hexValue); err == nil {
+		t.Error("expected error scanning binary of the wrong length")
+	}
+	if short.Valid {
+		t.Error("short scan", "is valid, but should be invalid")
+	}
+
+	v, err := bin.Value()
+	maybePanic(err)
+	if raw, ok := v.([]byte); !ok || !bytes.Equal(raw, sha256Value[:]) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	var null FixedHex[[32]byte]
+	err = null.Scan(nil)
+	maybePanic(err)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestFixedHexEqual(t *testing.T) {
+	a := FixedHexFrom(sha256Value)
+	var b FixedHex[[32]byte]
+	b.SetValid(sha256Value)
+	if !a.Equal(b) {
+		t.Error("equal FixedHexes should be equal")
+	}
+	if a.Equal(NewFixedHex(sha256Value, false)) {
+		t.Error("valid and null FixedHexes should not be equal")
+	}
+	if !NewFixedHex([4]byte{1}, false).Equal(NewFixedHex([4]byte{2}, false)) {
+		t.Error("null FixedHexes should be equal")
+	}
+}
+
+func assertHex(t *testing.T, h Hex, from string) {
+	t.Helper()
+	if !bytes.Equal(h.Bytes, hexValue) {
+		t.Errorf("bad %s bytes: %x ≠ %x\n", from, h.Bytes, hexValue)
+	}
+	if !h.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullHex(t *testing.T, h Hex, from string) {
+	t.Helper()
+	if h.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertFixedHex(t *testing.T, h FixedHex[[32]byte], from string) {
+	t.Helper()
+	if h.V != sha256Value {
+		t.Errorf("bad %s value: %x ≠ %x\n", from, h.V, sha256Value)
+	}
+	if !h.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // Int is an nullable int64.
@@ -47,10 +49,34 @@ func (i Int) ValueOrZero() int64 {
 	return i.Int64
 }
 
+// Scan implements the Scanner interface.
+// In addition to what sql.NullInt64 accepts, it accepts float64 values without a fractional part.
+func (i *Int) Scan(value interface{}) (err error) {
+	defer validateDecoded(i, &err)
+	value = driverval.Convert(value)
+	if f, ok := value.(float64); ok {
+		n, err := scanInt(f, 64)
+		if err != nil {
+			i.Valid = false
+			return err
+		}
+		i.Int64, i.Valid = n, true
+		return nil
+	}
+	return i.NullInt64.Scan(value)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number, string, and null input.
 // 0 will not be considered a null Int.
-func (i *Int) UnmarshalJSON(data []byte) error {
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict,
+// and integral numbers such as 1.0 or 1e3 are accepted if it is JSONLenient.
+func (i *Int) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(i, &err)
+	return i.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (i *Int) unmarshalJSONWith(data []byte, d JSONDecoding) error {
 	if bytes.Equal(data, nullBytes) {
 		i.Valid = false
 		return nil
@@ -59,15 +85,22 @@ func (i *Int) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &i.Int64); err != nil {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
+			if n, ok, err := d.intFromFloatJSON(data, 64); ok {
+				if err != nil {
+					return err
+				}
+				i.Int64, i.Valid = n, true
+				return nil
+			}
 			// special case: accept string input
-			if typeError.Value != "string" {
-				return fmt.Errorf("null: JSON input is invalid type (need int or string): %w", err)
+			if typeError.Value != "string" || !d.numberStrings() {
+				return fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("int"), err)
 			}
 			var str string
 			if err := json.Unmarshal(data, &str); err != nil {
 				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
 			}
-			n, err := strconv.ParseInt(str, 10, 64)
+			n, err := DefaultNumberFormat.parseInt(str, 64)
 			if err != nil {
 				return fmt.Errorf("null: couldn't convert string to int: %w", err)
 			}
@@ -83,16 +116,16 @@ func (i *Int) UnmarshalJSON(data []byte) error {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Int if the input is blank.
+// It will unmarshal to a null Int if the input is blank or DefaultNullText.
 // It will return an error if the input is not an integer, blank, or "null".
-func (i *Int) UnmarshalText(text []byte) error {
+func (i *Int) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(i, &err)
 	str := string(text)
-	if str == "" || str == "null" {
+	if isNullText(text) || str == "null" {
 		i.Valid = false
 		return nil
 	}
-	var err error
-	i.Int64, err = strconv.ParseInt(string(text), 10, 64)
+	i.Int64, err = DefaultNumberFormat.parseInt(string(text), 64)
 	if err != nil {
 		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
 	}
@@ -110,20 +143,41 @@ func (i Int) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string if this Int is null.
+// It will encode DefaultNullText, blank by default, if this Int is null.
 func (i Int) MarshalText() ([]byte, error) {
 	if !i.Valid {
-		return []byte{}, nil
+		return nullText(), nil
 	}
 	return []byte(strconv.FormatInt(i.Int64, 10)), nil
 }
 
+// FormatBase returns this Int's value in the given base, which must be between 2 and 36,
+// such as "0x1f" for 31 in base 16. Bases 2, 8, and 16 have a prefix like Go literals,
+// which UnmarshalText accepts if DefaultNumberFormat.BasePrefixes is set.
+// It returns a blank string if this Int is null.
+func (i Int) FormatBase(base int) string {
+	if !i.Valid {
+		return ""
+	}
+	return formatIntBase(i.Int64, base)
+}
+
 // SetValid changes this Int's value and also sets it to be non-null.
 func (i *Int) SetValid(n int64) {
 	i.Int64 = n
 	i.Valid = true
 }
 
+// SetNull sets this Int to null, leaving its value as is. Use Reset to clear the value too.
+func (i *Int) SetNull() {
+	i.Valid = false
+}
+
+// Reset sets this Int to null and clears its value.
+func (i *Int) Reset() {
+	*i = Int{}
+}
+
 // Ptr returns a pointer to this Int's value, or a nil pointer if this Int is null.
 func (i Int) Ptr() *int64 {
 	if !i.Valid {
@@ -132,13 +186,56 @@ func (i Int) Ptr() *int64 {
 	return &i.Int64
 }
 
+// SetPtr changes this Int's value to the value v points to, or sets it to be null if v is nil, like IntFromPtr.
+func (i *Int) SetPtr(v *int64) {
+	*i = IntFromPtr(v)
+}
+
 // IsZero returns true for invalid Ints, for future omitempty support (Go 1.4?)
 // A non-null Int with a 0 value will not be considered zero.
 func (i Int) IsZero() bool {
 	return !i.Valid
 }
 
+// IsZeroOrNull returns true for invalid Ints, or valid Ints with a 0 value.
+func (i Int) IsZeroOrNull() bool {
+	return !i.Valid || i.Int64 == 0
+}
+
 // Equal returns true if both ints have the same value or are both null.
 func (i Int) Equal(other Int) bool {
 	return i.Valid == other.Valid && (!i.Valid || i.Int64 == other.Int64)
 }
+
+// scanInt converts a driver value into a signed integer that fits in bitSize bits.
+// It accepts integers, integral floats, and decimal strings or bytes.
+func scanInt(value interface{}, bitSize int) (int64, error) {
+	var n int64
+	switch v := value.(type) {
+	case int64:
+		n = v
+	case float64:
+		var ok bool
+		if n, ok = driverval.FloatToInt(v); !ok {
+			return 0, fmt.Errorf("null: couldn't scan value %v into int%d: not an integer in range", v, bitSize)
+		}
+	case []byte:
+		return scanIntString(string(v), bitSize)
+	case string:
+		return scanIntString(v, bitSize)
+	default:
+		return 0, fmt.Errorf("null: couldn't scan type %T into int%d: %v", value, bitSize, value)
+	}
+	if bitSize < 64 && (n < -1<<(bitSize-1) || n > 1<<(bitSize-1)-1) {
+		return 0, fmt.Errorf("null: couldn't scan value %d into int%d: out of range", n, bitSize)
+	}
+	return n, nil
+}
+
+func scanIntString(str string, bitSize int) (int64, error) {
+	n, err := strconv.ParseInt(str, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("null: couldn't scan int%d: %w", bitSize, err)
+	}
+	return n, nil
+}
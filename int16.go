@@ -0,0 +1,209 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// Int16 is an nullable int16.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Int16 struct {
+	sql.NullInt16
+}
+
+// NewInt16 creates a new Int16
+func NewInt16(i int16, valid bool) Int16 {
+	return Int16{
+		NullInt16: sql.NullInt16{
+			Int16: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int16From creates a new Int16 that will always be valid.
+func Int16From(i int16) Int16 {
+	return NewInt16(i, true)
+}
+
+// Int16FromPtr creates a new Int16 that be null if i is nil.
+func Int16FromPtr(i *int16) Int16 {
+	if i == nil {
+		return NewInt16(0, false)
+	}
+	return NewInt16(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int16) ValueOrZero() int16 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int16
+}
+
+// Scan implements the Scanner interface.
+// In addition to what sql.NullInt16 accepts, it accepts float64 values without a fractional part.
+func (i *Int16) Scan(value interface{}) (err error) {
+	defer validateDecoded(i, &err)
+	value = driverval.Convert(value)
+	if f, ok := value.(float64); ok {
+		n, err := scanInt(f, 16)
+		if err != nil {
+			i.Valid = false
+			return err
+		}
+		i.Int16, i.Valid = int16(n), true
+		return nil
+	}
+	return i.NullInt16.Scan(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Int16.
+// It will return an error if the input overflows int16.
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict,
+// and integral numbers such as 1.0 or 1e3 are accepted if it is JSONLenient.
+func (i *Int16) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(i, &err)
+	return i.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (i *Int16) unmarshalJSONWith(data []byte, d JSONDecoding) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &i.Int16); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			if n, ok, err := d.intFromFloatJSON(data, 16); ok {
+				if err != nil {
+					return err
+				}
+				i.Int16, i.Valid = int16(n), true
+				return nil
+			}
+			// special case: accept string input
+			if typeError.Value != "string" || !d.numberStrings() {
+				return fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("int16"), err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := DefaultNumberFormat.parseInt(str, 16)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to int16: %w", err)
+			}
+			i.Int16 = int16(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int16 if the input is blank or DefaultNullText.
+// It will return an error if the input is not an integer, blank, or "null",
+// or if it overflows int16.
+func (i *Int16) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(i, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := DefaultNumberFormat.parseInt(str, 16)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	i.Int16 = int16(n)
+	i.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Int16 is null.
+func (i Int16) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+}
+
+// FormatBase returns this Int16's value in the given base, like Int.FormatBase.
+// It returns a blank string if this Int16 is null.
+func (i Int16) FormatBase(base int) string {
+	if !i.Valid {
+		return ""
+	}
+	return formatIntBase(int64(i.Int16), base)
+}
+
+// SetValid changes this Int16's value and also sets it to be non-null.
+func (i *Int16) SetValid(n int16) {
+	i.Int16 = n
+	i.Valid = true
+}
+
+// SetNull sets this Int16 to null, leaving its value as is. Use Reset to clear the value too.
+func (i *Int16) SetNull() {
+	i.Valid = false
+}
+
+// Reset sets this Int16 to null and clears its value.
+func (i *Int16) Reset() {
+	*i = Int16{}
+}
+
+// Ptr returns a pointer to this Int16's value, or a nil pointer if this Int16 is null.
+func (i Int16) Ptr() *int16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int16
+}
+
+// SetPtr changes this Int16's value to the value v points to, or sets it to be null if v is nil, like Int16FromPtr.
+func (i *Int16) SetPtr(v *int16) {
+	*i = Int16FromPtr(v)
+}
+
+// IsZero returns true for invalid Int16s.
+// A non-null Int16 with a 0 value will not be considered zero.
+func (i Int16) IsZero() bool {
+	return !i.Valid
+}
+
+// IsZeroOrNull returns true for invalid Int16s, or valid Int16s with a 0 value.
+func (i Int16) IsZeroOrNull() bool {
+	return !i.Valid || i.Int16 == 0
+}
+
+// Equal returns true if both Int16s have the same value or are both null.
+func (i Int16) Equal(other Int16) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int16 == other.Int16)
+}
@@ -0,0 +1,275 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	int16JSON       = []byte(`12345`)
+	int16StringJSON = []byte(`"12345"`)
+)
+
+func TestInt16From(t *testing.T) {
+	i := Int16From(12345)
+	assertInt16(t, i, "Int16From()")
+
+	zero := Int16From(0)
+	if !zero.Valid {
+		t.Error("Int16From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestInt16FromPtr(t *testing.T) {
+	n := int16(12345)
+	i := Int16FromPtr(&n)
+	assertInt16(t, i, "Int16FromPtr()")
+
+	null := Int16FromPtr(nil)
+	assertNullInt16(t, null, "Int16FromPtr(nil)")
+}
+
+func TestUnmarshalInt16(t *testing.T) {
+	var i Int16
+	err := json.Unmarshal(int16JSON, &i)
+	maybePanic(err)
+	assertInt16(t, i, "int16 json")
+
+	var si Int16
+	err = json.Unmarshal(int16StringJSON, &si)
+	maybePanic(err)
+	assertInt16(t, si, "int16 string json")
+
+	var null Int16
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt16(t, null, "null json")
+
+	var badType Int16
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullInt16(t, badType, "wrong type json")
+
+	var negative Int16
+	err = json.Unmarshal([]byte(`-1`), &negative)
+	maybePanic(err)
+	if negative.Int16 != -1 || !negative.Valid {
+		t.Errorf("bad negative int16 json: %#v", negative)
+	}
+
+	var invalid Int16
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullInt16(t, invalid, "invalid json")
+}
+
+func TestUnmarshalInt16Overflow(t *testing.T) {
+	overflow := uint64(math.MaxInt16)
+
+	var i Int16
+	err := json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	maybePanic(err)
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	maybePanic(err)
+
+	overflow++
+	err = json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows int16")
+	}
+	err = json.Unmarshal([]byte(`"`+strconv.FormatUint(overflow, 10)+`"`), &i)
+	if err == nil {
+		panic("err should be present; decoded string value overflows int16")
+	}
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	if err == nil {
+		panic("err should be present; decoded text value overflows int16")
+	}
+}
+
+func TestTextUnmarshalInt16(t *testing.T) {
+	var i Int16
+	err := i.UnmarshalText([]byte("12345"))
+	maybePanic(err)
+	assertInt16(t, i, "UnmarshalText() int16")
+
+	var blank Int16
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullInt16(t, blank, "UnmarshalText() empty int16")
+
+	var null Int16
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullInt16(t, null, `UnmarshalText() "null"`)
+
+	var invalid Int16
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalInt16(t *testing.T) {
+	i := Int16From(12345)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty json marshal")
+
+	null := NewInt16(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalInt16Text(t *testing.T) {
+	i := Int16From(12345)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty text marshal")
+
+	null := NewInt16(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestInt16Pointer(t *testing.T) {
+	i := Int16From(12345)
+	ptr := i.Ptr()
+	if *ptr != 12345 {
+		t.Errorf("bad %s int16: %#v ≠ %d\n", "pointer", ptr, 12345)
+	}
+
+	null := NewInt16(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int16: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestInt16IsZero(t *testing.T) {
+	i := Int16From(12345)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewInt16(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewInt16(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestInt16SetValid(t *testing.T) {
+	change := NewInt16(0, false)
+	assertNullInt16(t, change, "SetValid()")
+	change.SetValid(12345)
+	assertInt16(t, change, "SetValid()")
+}
+
+func TestInt16Scan(t *testing.T) {
+	var i Int16
+	err := i.Scan(int64(12345))
+	maybePanic(err)
+	assertInt16(t, i, "scanned int16")
+
+	var si Int16
+	err = si.Scan([]byte("12345"))
+	maybePanic(err)
+	assertInt16(t, si, "scanned int16 bytes")
+
+	var null Int16
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt16(t, null, "scanned null")
+
+	var underflow Int16
+	err = underflow.Scan(int64(math.MinInt16) - 1)
+	if err == nil {
+		t.Error("expected error scanning out of range value")
+	}
+
+	var overflow Int16
+	err = overflow.Scan(int64(math.MaxInt16) + 1)
+	if err == nil {
+		t.Error("expected error scanning out of range value")
+	}
+
+	var wrong Int16
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error scanning wrong type")
+	}
+}
+
+func TestInt16Value(t *testing.T) {
+	i := Int16From(12345)
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(12345) {
+		t.Errorf("bad Value(): %#v ≠ %d", v, 12345)
+	}
+
+	null := NewInt16(0, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestInt16Equal(t *testing.T) {
+	i1 := NewInt16(10, false)
+	i2 := NewInt16(20, false)
+	if !i1.Equal(i2) {
+		t.Error("null Int16s should be equal")
+	}
+
+	i1 = NewInt16(10, true)
+	i2 = NewInt16(10, true)
+	if !i1.Equal(i2) {
+		t.Error("same Int16s should be equal")
+	}
+
+	i1 = NewInt16(10, true)
+	i2 = NewInt16(10, false)
+	if i1.Equal(i2) {
+		t.Error("valid and null Int16s should not be equal")
+	}
+
+	i1 = NewInt16(10, true)
+	i2 = NewInt16(20, true)
+	if i1.Equal(i2) {
+		t.Error("different Int16s should not be equal")
+	}
+}
+
+func assertInt16(t *testing.T, i Int16, from string) {
+	t.Helper()
+	if i.Int16 != 12345 {
+		t.Errorf("bad %s int16: %d ≠ %d\n", from, i.Int16, 12345)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt16(t *testing.T, i Int16, from string) {
+	t.Helper()
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,209 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// Int32 is an nullable int32.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Int32 struct {
+	sql.NullInt32
+}
+
+// NewInt32 creates a new Int32
+func NewInt32(i int32, valid bool) Int32 {
+	return Int32{
+		NullInt32: sql.NullInt32{
+			Int32: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int32From creates a new Int32 that will always be valid.
+func Int32From(i int32) Int32 {
+	return NewInt32(i, true)
+}
+
+// Int32FromPtr creates a new Int32 that be null if i is nil.
+func Int32FromPtr(i *int32) Int32 {
+	if i == nil {
+		return NewInt32(0, false)
+	}
+	return NewInt32(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int32) ValueOrZero() int32 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int32
+}
+
+// Scan implements the Scanner interface.
+// In addition to what sql.NullInt32 accepts, it accepts float64 values without a fractional part.
+func (i *Int32) Scan(value interface{}) (err error) {
+	defer validateDecoded(i, &err)
+	value = driverval.Convert(value)
+	if f, ok := value.(float64); ok {
+		n, err := scanInt(f, 32)
+		if err != nil {
+			i.Valid = false
+			return err
+		}
+		i.Int32, i.Valid = int32(n), true
+		return nil
+	}
+	return i.NullInt32.Scan(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Int32.
+// It will return an error if the input overflows int32.
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict,
+// and integral numbers such as 1.0 or 1e3 are accepted if it is JSONLenient.
+func (i *Int32) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(i, &err)
+	return i.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (i *Int32) unmarshalJSONWith(data []byte, d JSONDecoding) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &i.Int32); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			if n, ok, err := d.intFromFloatJSON(data, 32); ok {
+				if err != nil {
+					return err
+				}
+				i.Int32, i.Valid = int32(n), true
+				return nil
+			}
+			// special case: accept string input
+			if typeError.Value != "string" || !d.numberStrings() {
+				return fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("int32"), err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := DefaultNumberFormat.parseInt(str, 32)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to int32: %w", err)
+			}
+			i.Int32 = int32(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int32 if the input is blank or DefaultNullText.
+// It will return an error if the input is not an integer, blank, or "null",
+// or if it overflows int32.
+func (i *Int32) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(i, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := DefaultNumberFormat.parseInt(str, 32)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	i.Int32 = int32(n)
+	i.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Int32 is null.
+func (i Int32) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+}
+
+// FormatBase returns this Int32's value in the given base, like Int.FormatBase.
+// It returns a blank string if this Int32 is null.
+func (i Int32) FormatBase(base int) string {
+	if !i.Valid {
+		return ""
+	}
+	return formatIntBase(int64(i.Int32), base)
+}
+
+// SetValid changes this Int32's value and also sets it to be non-null.
+func (i *Int32) SetValid(n int32) {
+	i.Int32 = n
+	i.Valid = true
+}
+
+// SetNull sets this Int32 to null, leaving its value as is. Use Reset to clear the value too.
+func (i *Int32) SetNull() {
+	i.Valid = false
+}
+
+// Reset sets this Int32 to null and clears its value.
+func (i *Int32) Reset() {
+	*i = Int32{}
+}
+
+// Ptr returns a pointer to this Int32's value, or a nil pointer if this Int32 is null.
+func (i Int32) Ptr() *int32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int32
+}
+
+// SetPtr changes this Int32's value to the value v points to, or sets it to be null if v is nil, like Int32FromPtr.
+func (i *Int32) SetPtr(v *int32) {
+	*i = Int32FromPtr(v)
+}
+
+// IsZero returns true for invalid Int32s.
+// A non-null Int32 with a 0 value will not be considered zero.
+func (i Int32) IsZero() bool {
+	return !i.Valid
+}
+
+// IsZeroOrNull returns true for invalid Int32s, or valid Int32s with a 0 value.
+func (i Int32) IsZeroOrNull() bool {
+	return !i.Valid || i.Int32 == 0
+}
+
+// Equal returns true if both Int32s have the same value or are both null.
+func (i Int32) Equal(other Int32) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int32 == other.Int32)
+}
@@ -0,0 +1,275 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	int32JSON       = []byte(`12345`)
+	int32StringJSON = []byte(`"12345"`)
+)
+
+func TestInt32From(t *testing.T) {
+	i := Int32From(12345)
+	assertInt32(t, i, "Int32From()")
+
+	zero := Int32From(0)
+	if !zero.Valid {
+		t.Error("Int32From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestInt32FromPtr(t *testing.T) {
+	n := int32(12345)
+	i := Int32FromPtr(&n)
+	assertInt32(t, i, "Int32FromPtr()")
+
+	null := Int32FromPtr(nil)
+	assertNullInt32(t, null, "Int32FromPtr(nil)")
+}
+
+func TestUnmarshalInt32(t *testing.T) {
+	var i Int32
+	err := json.Unmarshal(int32JSON, &i)
+	maybePanic(err)
+	assertInt32(t, i, "int32 json")
+
+	var si Int32
+	err = json.Unmarshal(int32StringJSON, &si)
+	maybePanic(err)
+	assertInt32(t, si, "int32 string json")
+
+	var null Int32
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt32(t, null, "null json")
+
+	var badType Int32
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullInt32(t, badType, "wrong type json")
+
+	var negative Int32
+	err = json.Unmarshal([]byte(`-1`), &negative)
+	maybePanic(err)
+	if negative.Int32 != -1 || !negative.Valid {
+		t.Errorf("bad negative int32 json: %#v", negative)
+	}
+
+	var invalid Int32
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullInt32(t, invalid, "invalid json")
+}
+
+func TestUnmarshalInt32Overflow(t *testing.T) {
+	overflow := uint64(math.MaxInt32)
+
+	var i Int32
+	err := json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	maybePanic(err)
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	maybePanic(err)
+
+	overflow++
+	err = json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows int32")
+	}
+	err = json.Unmarshal([]byte(`"`+strconv.FormatUint(overflow, 10)+`"`), &i)
+	if err == nil {
+		panic("err should be present; decoded string value overflows int32")
+	}
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	if err == nil {
+		panic("err should be present; decoded text value overflows int32")
+	}
+}
+
+func TestTextUnmarshalInt32(t *testing.T) {
+	var i Int32
+	err := i.UnmarshalText([]byte("12345"))
+	maybePanic(err)
+	assertInt32(t, i, "UnmarshalText() int32")
+
+	var blank Int32
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullInt32(t, blank, "UnmarshalText() empty int32")
+
+	var null Int32
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullInt32(t, null, `UnmarshalText() "null"`)
+
+	var invalid Int32
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalInt32(t *testing.T) {
+	i := Int32From(12345)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty json marshal")
+
+	null := NewInt32(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalInt32Text(t *testing.T) {
+	i := Int32From(12345)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty text marshal")
+
+	null := NewInt32(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestInt32Pointer(t *testing.T) {
+	i := Int32From(12345)
+	ptr := i.Ptr()
+	if *ptr != 12345 {
+		t.Errorf("bad %s int32: %#v ≠ %d\n", "pointer", ptr, 12345)
+	}
+
+	null := NewInt32(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int32: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestInt32IsZero(t *testing.T) {
+	i := Int32From(12345)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewInt32(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewInt32(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestInt32SetValid(t *testing.T) {
+	change := NewInt32(0, false)
+	assertNullInt32(t, change, "SetValid()")
+	change.SetValid(12345)
+	assertInt32(t, change, "SetValid()")
+}
+
+func TestInt32Scan(t *testing.T) {
+	var i Int32
+	err := i.Scan(int64(12345))
+	maybePanic(err)
+	assertInt32(t, i, "scanned int32")
+
+	var si Int32
+	err = si.Scan([]byte("12345"))
+	maybePanic(err)
+	assertInt32(t, si, "scanned int32 bytes")
+
+	var null Int32
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt32(t, null, "scanned null")
+
+	var underflow Int32
+	err = underflow.Scan(int64(math.MinInt32) - 1)
+	if err == nil {
+		t.Error("expected error scanning out of range value")
+	}
+
+	var overflow Int32
+	err = overflow.Scan(int64(math.MaxInt32) + 1)
+	if err == nil {
+		t.Error("expected error scanning out of range value")
+	}
+
+	var wrong Int32
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error scanning wrong type")
+	}
+}
+
+func TestInt32Value(t *testing.T) {
+	i := Int32From(12345)
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(12345) {
+		t.Errorf("bad Value(): %#v ≠ %d", v, 12345)
+	}
+
+	null := NewInt32(0, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestInt32Equal(t *testing.T) {
+	i1 := NewInt32(10, false)
+	i2 := NewInt32(20, false)
+	if !i1.Equal(i2) {
+		t.Error("null Int32s should be equal")
+	}
+
+	i1 = NewInt32(10, true)
+	i2 = NewInt32(10, true)
+	if !i1.Equal(i2) {
+		t.Error("same Int32s should be equal")
+	}
+
+	i1 = NewInt32(10, true)
+	i2 = NewInt32(10, false)
+	if i1.Equal(i2) {
+		t.Error("valid and null Int32s should not be equal")
+	}
+
+	i1 = NewInt32(10, true)
+	i2 = NewInt32(20, true)
+	if i1.Equal(i2) {
+		t.Error("different Int32s should not be equal")
+	}
+}
+
+func assertInt32(t *testing.T, i Int32, from string) {
+	t.Helper()
+	if i.Int32 != 12345 {
+		t.Errorf("bad %s int32: %d ≠ %d\n", from, i.Int32, 12345)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt32(t *testing.T, i Int32, from string) {
+	t.Helper()
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,228 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullInt8 represents an int8 that may be null.
+// NullInt8 implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullInt8 struct {
+	Int8  int8
+	Valid bool // Valid is true if Int8 is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (n *NullInt8) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	if value == nil {
+		n.Int8, n.Valid = 0, false
+		return nil
+	}
+	v, err := scanInt(value, 8)
+	if err != nil {
+		return err
+	}
+	n.Int8, n.Valid = int8(v), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullInt8) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.Int8), nil
+}
+
+// IsZero returns true for invalid NullInt8s.
+func (n NullInt8) IsZero() bool {
+	return !n.Valid
+}
+
+// Int8 is an nullable int8.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Int8 struct {
+	NullInt8
+}
+
+// NewInt8 creates a new Int8
+func NewInt8(i int8, valid bool) Int8 {
+	return Int8{
+		NullInt8: NullInt8{
+			Int8:  i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int8From creates a new Int8 that will always be valid.
+func Int8From(i int8) Int8 {
+	return NewInt8(i, true)
+}
+
+// Int8FromPtr creates a new Int8 that be null if i is nil.
+func Int8FromPtr(i *int8) Int8 {
+	if i == nil {
+		return NewInt8(0, false)
+	}
+	return NewInt8(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int8) ValueOrZero() int8 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int8
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Int8.
+// It will return an error if the input overflows int8.
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict,
+// and integral numbers such as 1.0 or 1e3 are accepted if it is JSONLenient.
+func (i *Int8) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(i, &err)
+	return i.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (i *Int8) unmarshalJSONWith(data []byte, d JSONDecoding) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &i.Int8); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			if n, ok, err := d.intFromFloatJSON(data, 8); ok {
+				if err != nil {
+					return err
+				}
+				i.Int8, i.Valid = int8(n), true
+				return nil
+			}
+			// special case: accept string input
+			if typeError.Value != "string" || !d.numberStrings() {
+				return fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("int8"), err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := DefaultNumberFormat.parseInt(str, 8)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to int8: %w", err)
+			}
+			i.Int8 = int8(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int8 if the input is blank or DefaultNullText.
+// It will return an error if the input is not an integer, blank, or "null",
+// or if it overflows int8.
+func (i *Int8) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(i, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := DefaultNumberFormat.parseInt(str, 8)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	i.Int8 = int8(n)
+	i.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int8), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Int8 is null.
+func (i Int8) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int8), 10)), nil
+}
+
+// FormatBase returns this Int8's value in the given base, like Int.FormatBase.
+// It returns a blank string if this Int8 is null.
+func (i Int8) FormatBase(base int) string {
+	if !i.Valid {
+		return ""
+	}
+	return formatIntBase(int64(i.Int8), base)
+}
+
+// SetValid changes this Int8's value and also sets it to be non-null.
+func (i *Int8) SetValid(n int8) {
+	i.Int8 = n
+	i.Valid = true
+}
+
+// SetNull sets this Int8 to null, leaving its value as is. Use Reset to clear the value too.
+func (i *Int8) SetNull() {
+	i.Valid = false
+}
+
+// Reset sets this Int8 to null and clears its value.
+func (i *Int8) Reset() {
+	*i = Int8{}
+}
+
+// Ptr returns a pointer to this Int8's value, or a nil pointer if this Int8 is null.
+func (i Int8) Ptr() *int8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int8
+}
+
+// SetPtr changes this Int8's value to the value v points to, or sets it to be null if v is nil, like Int8FromPtr.
+func (i *Int8) SetPtr(v *int8) {
+	*i = Int8FromPtr(v)
+}
+
+// IsZero returns true for invalid Int8s.
+// A non-null Int8 with a 0 value will not be considered zero.
+func (i Int8) IsZero() bool {
+	return !i.Valid
+}
+
+// IsZeroOrNull returns true for invalid Int8s, or valid Int8s with a 0 value.
+func (i Int8) IsZeroOrNull() bool {
+	return !i.Valid || i.Int8 == 0
+}
+
+// Equal returns true if both Int8s have the same value or are both null.
+func (i Int8) Equal(other Int8) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int8 == other.Int8)
+}
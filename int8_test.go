@@ -0,0 +1,275 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	int8JSON       = []byte(`123`)
+	int8StringJSON = []byte(`"123"`)
+)
+
+func TestInt8From(t *testing.T) {
+	i := Int8From(123)
+	assertInt8(t, i, "Int8From()")
+
+	zero := Int8From(0)
+	if !zero.Valid {
+		t.Error("Int8From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestInt8FromPtr(t *testing.T) {
+	n := int8(123)
+	i := Int8FromPtr(&n)
+	assertInt8(t, i, "Int8FromPtr()")
+
+	null := Int8FromPtr(nil)
+	assertNullInt8(t, null, "Int8FromPtr(nil)")
+}
+
+func TestUnmarshalInt8(t *testing.T) {
+	var i Int8
+	err := json.Unmarshal(int8JSON, &i)
+	maybePanic(err)
+	assertInt8(t, i, "int8 json")
+
+	var si Int8
+	err = json.Unmarshal(int8StringJSON, &si)
+	maybePanic(err)
+	assertInt8(t, si, "int8 string json")
+
+	var null Int8
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt8(t, null, "null json")
+
+	var badType Int8
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullInt8(t, badType, "wrong type json")
+
+	var negative Int8
+	err = json.Unmarshal([]byte(`-1`), &negative)
+	maybePanic(err)
+	if negative.Int8 != -1 || !negative.Valid {
+		t.Errorf("bad negative int8 json: %#v", negative)
+	}
+
+	var invalid Int8
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullInt8(t, invalid, "invalid json")
+}
+
+func TestUnmarshalInt8Overflow(t *testing.T) {
+	overflow := uint64(math.MaxInt8)
+
+	var i Int8
+	err := json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	maybePanic(err)
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	maybePanic(err)
+
+	overflow++
+	err = json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows int8")
+	}
+	err = json.Unmarshal([]byte(`"`+strconv.FormatUint(overflow, 10)+`"`), &i)
+	if err == nil {
+		panic("err should be present; decoded string value overflows int8")
+	}
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	if err == nil {
+		panic("err should be present; decoded text value overflows int8")
+	}
+}
+
+func TestTextUnmarshalInt8(t *testing.T) {
+	var i Int8
+	err := i.UnmarshalText([]byte("123"))
+	maybePanic(err)
+	assertInt8(t, i, "UnmarshalText() int8")
+
+	var blank Int8
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullInt8(t, blank, "UnmarshalText() empty int8")
+
+	var null Int8
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullInt8(t, null, `UnmarshalText() "null"`)
+
+	var invalid Int8
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalInt8(t *testing.T) {
+	i := Int8From(123)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty json marshal")
+
+	null := NewInt8(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalInt8Text(t *testing.T) {
+	i := Int8From(123)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty text marshal")
+
+	null := NewInt8(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestInt8Pointer(t *testing.T) {
+	i := Int8From(123)
+	ptr := i.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s int8: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewInt8(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int8: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestInt8IsZero(t *testing.T) {
+	i := Int8From(123)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewInt8(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewInt8(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestInt8SetValid(t *testing.T) {
+	change := NewInt8(0, false)
+	assertNullInt8(t, change, "SetValid()")
+	change.SetValid(123)
+	assertInt8(t, change, "SetValid()")
+}
+
+func TestInt8Scan(t *testing.T) {
+	var i Int8
+	err := i.Scan(int64(123))
+	maybePanic(err)
+	assertInt8(t, i, "scanned int8")
+
+	var si Int8
+	err = si.Scan([]byte("123"))
+	maybePanic(err)
+	assertInt8(t, si, "scanned int8 bytes")
+
+	var null Int8
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt8(t, null, "scanned null")
+
+	var underflow Int8
+	err = underflow.Scan(int64(math.MinInt8) - 1)
+	if err == nil {
+		t.Error("expected error scanning out of range value")
+	}
+
+	var overflow Int8
+	err = overflow.Scan(int64(math.MaxInt8) + 1)
+	if err == nil {
+		t.Error("expected error scanning out of range value")
+	}
+
+	var wrong Int8
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error scanning wrong type")
+	}
+}
+
+func TestInt8Value(t *testing.T) {
+	i := Int8From(123)
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(123) {
+		t.Errorf("bad Value(): %#v ≠ %d", v, 123)
+	}
+
+	null := NewInt8(0, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestInt8Equal(t *testing.T) {
+	i1 := NewInt8(10, false)
+	i2 := NewInt8(20, false)
+	if !i1.Equal(i2) {
+		t.Error("null Int8s should be equal")
+	}
+
+	i1 = NewInt8(10, true)
+	i2 = NewInt8(10, true)
+	if !i1.Equal(i2) {
+		t.Error("same Int8s should be equal")
+	}
+
+	i1 = NewInt8(10, true)
+	i2 = NewInt8(10, false)
+	if i1.Equal(i2) {
+		t.Error("valid and null Int8s should not be equal")
+	}
+
+	i1 = NewInt8(10, true)
+	i2 = NewInt8(20, true)
+	if i1.Equal(i2) {
+		t.Error("different Int8s should not be equal")
+	}
+}
+
+func assertInt8(t *testing.T, i Int8, from string) {
+	t.Helper()
+	if i.Int8 != 123 {
+		t.Errorf("bad %s int8: %d ≠ %d\n", from, i.Int8, 123)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt8(t *testing.T, i Int8, from string) {
+	t.Helper()
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
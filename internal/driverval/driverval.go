@@ -0,0 +1,91 @@
+// Package driverval converts values scanned from database drivers for the null and zero packages.
+package driverval
+
+import (
+	"database/sql/driver"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Convert converts a scanned value into one of the driver.Value types, if possible.
+// Some drivers return other types: clickhouse-go returns sized integers, float32, big.Int,
+// and decimal types, as well as pointers to them for Nullable columns.
+// Pointers are dereferenced, with nil becoming NULL.
+// Integers become int64, or uint64 if they don't fit, and float32 becomes float64.
+// big.Int becomes an integer if it fits, or a decimal string otherwise.
+// Other driver.Valuer types, such as decimals, are converted with their Value method.
+// Values of other types are returned unchanged.
+func Convert(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil, int64, float64, bool, []byte, string, time.Time:
+		return value
+	case big.Int:
+		return bigInt(&v)
+	case *big.Int:
+		if v == nil {
+			return nil
+		}
+		return bigInt(v)
+	case driver.Valuer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return nil
+		}
+		if dv, err := v.Value(); err == nil {
+			return dv
+		}
+		return value
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		return Convert(rv.Elem().Interface())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := rv.Uint(); u > math.MaxInt64 {
+			return u
+		}
+		return int64(rv.Uint())
+	case reflect.Float32:
+		// use the shortest decimal representation, so that float32(0.1) becomes 0.1
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(rv.Float(), 'g', -1, 32), 64)
+		return f
+	case reflect.Float64:
+		return rv.Float()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.String:
+		return rv.String()
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes()
+		}
+	}
+	return value
+}
+
+// FloatToInt converts f to an int64 if it has no fractional part and is in range.
+// SQLite drivers return float64 for anything stored as REAL, even in integer columns.
+func FloatToInt(f float64) (int64, bool) {
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+func bigInt(i *big.Int) interface{} {
+	switch {
+	case i.IsInt64():
+		return i.Int64()
+	case i.IsUint64():
+		return i.Uint64()
+	}
+	return i.String()
+}
@@ -0,0 +1,426 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// countryCodes is the set of officially assigned ISO 3166-1 alpha-2 country codes.
+var countryCodes = isoCodeSet(`
+	AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ
+	BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ
+	CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ
+	DE DJ DK DM DO DZ
+	EC EE EG EH ER ES ET
+	FI FJ FK FM FO FR
+	GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY
+	HK HM HN HR HT HU
+	ID IE IL IM IN IO IQ IR IS IT
+	JE JM JO JP
+	KE KG KH KI KM KN KP KR KW KY KZ
+	LA LB LC LI LK LR LS LT LU LV LY
+	MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ
+	NA NC NE NF NG NI NL NO NP NR NU NZ
+	OM
+	PA PE PF PG PH PK PL PM PN PR PS PT PW PY
+	QA
+	RE RO RS RU RW
+	SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ
+	TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ
+	UA UG UM US UY UZ
+	VA VC VE VG VI VN VU
+	WF WS
+	YE YT
+	ZA ZM ZW
+`)
+
+// currencyCodes is the set of active ISO 4217 currency codes, including funds and precious metals.
+var currencyCodes = isoCodeSet(`
+	AED AFN ALL AMD ANG AOA ARS AUD AWG AZN
+	BAM BBD BDT BGN BHD BIF BMD BND BOB BOV BRL BSD BTN BWP BYN BZD
+	CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUC CUP CVE CZK
+	DJF DKK DOP DZD
+	EGP ERN ETB EUR
+	FJD FKP
+	GBP GEL GHS GIP GMD GNF GTQ GYD
+	HKD HNL HTG HUF
+	IDR ILS INR IQD IRR ISK
+	JMD JOD JPY
+	KES KGS KHR KMF KPW KRW KWD KYD KZT
+	LAK LBP LKR LRD LSL LYD
+	MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR MZN
+	NAD NGN NIO NOK NPR NZD
+	OMR
+	PAB PEN PGK PHP PKR PLN PYG
+	QAR
+	RON RSD RUB RWF
+	SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN SVC SYP SZL
+	THB TJS TMT TND TOP TRY TTD TWD TZS
+	UAH UGX USD USN UYI UYU UYW UZS
+	VED VES VND VUV
+	WST
+	XAF XAG XAU XBA XBB XBC XBD XCD XCG XDR XOF XPD XPF XPT XSU XTS XUA XXX
+	YER
+	ZAR ZMW ZWG ZWL
+`)
+
+func isoCodeSet(codes string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, code := range strings.Fields(codes) {
+		set[code] = struct{}{}
+	}
+	return set
+}
+
+// parseISOCode canonicalizes code to upper case and checks that it is in set.
+func parseISOCode(code string, set map[string]struct{}, kind string) (string, error) {
+	upper := strings.ToUpper(strings.TrimSpace(code))
+	if _, ok := set[upper]; !ok {
+		return "", fmt.Errorf("invalid %s code: %q", kind, code)
+	}
+	return upper, nil
+}
+
+// scanISOCode scans a string or []byte value into a code in set, returning "", false for nil.
+func scanISOCode(value interface{}, set map[string]struct{}, kind, typeName string) (string, bool, error) {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		return "", false, nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return "", false, fmt.Errorf("null: couldn't scan type %T into %s: %v", value, typeName, value)
+	}
+	code, err := parseISOCode(str, set, kind)
+	if err != nil {
+		return "", false, fmt.Errorf("null: couldn't scan %s: %w", typeName, err)
+	}
+	return code, true, nil
+}
+
+// unmarshalISOCodeJSON decodes a JSON string into a code in set, returning "", false for null.
+func unmarshalISOCodeJSON(data []byte, set map[string]struct{}, kind string) (string, bool, error) {
+	if bytes.Equal(data, nullBytes) {
+		return "", false, nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return "", false, fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	code, err := parseISOCode(str, set, kind)
+	if err != nil {
+		return "", false, fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return code, true, nil
+}
+
+// NullCountryCode represents an ISO 3166-1 alpha-2 country code that may be null.
+// NullCountryCode implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullCountryCode struct {
+	// Code is an upper case country code, such as "US".
+	Code  string
+	Valid bool // Valid is true if Code is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the value is not an assigned country code.
+func (n *NullCountryCode) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	code, valid, err := scanISOCode(value, countryCodes, "country", "CountryCode")
+	if err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Code, n.Valid = code, valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as upper case strings.
+func (n NullCountryCode) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Code, nil
+}
+
+// IsZero returns true for invalid NullCountryCodes.
+func (n NullCountryCode) IsZero() bool {
+	return !n.Valid
+}
+
+// CountryCode is a nullable ISO 3166-1 alpha-2 country code, such as "US".
+// Codes are case insensitive and are stored in upper case.
+// Input that is not an officially assigned code is rejected.
+// It will decode to null, not an empty string, if null.
+type CountryCode struct {
+	NullCountryCode
+}
+
+// NewCountryCode creates a new CountryCode.
+// It will be null if valid is false or code is not an assigned country code.
+func NewCountryCode(code string, valid bool) CountryCode {
+	if !valid {
+		return CountryCode{}
+	}
+	c, err := ParseCountryCode(code)
+	if err != nil {
+		return CountryCode{}
+	}
+	return c
+}
+
+// ParseCountryCode creates a new CountryCode from a string such as "us".
+// It returns an error if code is not an assigned country code.
+func ParseCountryCode(code string) (CountryCode, error) {
+	upper, err := parseISOCode(code, countryCodes, "country")
+	if err != nil {
+		return CountryCode{}, fmt.Errorf("null: couldn't parse CountryCode: %w", err)
+	}
+	return CountryCode{NullCountryCode: NullCountryCode{Code: upper, Valid: true}}, nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise an empty string.
+func (c CountryCode) ValueOrZero() string {
+	if !c.Valid {
+		return ""
+	}
+	return c.Code
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports country code strings and null input.
+func (c *CountryCode) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(c, &err)
+	code, valid, err := unmarshalISOCodeJSON(data, countryCodes, "country")
+	if err != nil {
+		return err
+	}
+	c.Code, c.Valid = code, valid
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this CountryCode is null.
+func (c CountryCode) MarshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(c.Code)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null CountryCode if the input is blank or DefaultNullText.
+func (c *CountryCode) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(c, &err)
+	if isNullText(text) {
+		c.Valid = false
+		return nil
+	}
+	code, err := parseISOCode(string(text), countryCodes, "country")
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	c.Code = code
+	c.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this CountryCode is null.
+func (c CountryCode) MarshalText() ([]byte, error) {
+	if !c.Valid {
+		return nullText(), nil
+	}
+	return []byte(c.Code), nil
+}
+
+// SetNull sets this CountryCode to null, leaving its value as is. Use Reset to clear the value too.
+func (c *CountryCode) SetNull() {
+	c.Valid = false
+}
+
+// Reset sets this CountryCode to null and clears its value.
+func (c *CountryCode) Reset() {
+	*c = CountryCode{}
+}
+
+// IsZero returns true for invalid CountryCodes.
+func (c CountryCode) IsZero() bool {
+	return !c.Valid
+}
+
+// IsZeroOrNull returns true for invalid CountryCodes, or valid CountryCodes with an empty code.
+func (c CountryCode) IsZeroOrNull() bool {
+	return !c.Valid || c.Code == ""
+}
+
+// Equal returns true if both CountryCodes are the same code or are both null.
+func (c CountryCode) Equal(other CountryCode) bool {
+	return c.Valid == other.Valid && (!c.Valid || c.Code == other.Code)
+}
+
+// NullCurrencyCode represents an ISO 4217 currency code that may be null.
+// NullCurrencyCode implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullCurrencyCode struct {
+	// Code is an upper case currency code, such as "USD".
+	Code  string
+	Valid bool // Valid is true if Code is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the value is not an active currency code.
+func (n *NullCurrencyCode) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	code, valid, err := scanISOCode(value, currencyCodes, "currency", "CurrencyCode")
+	if err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Code, n.Valid = code, valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as upper case strings.
+func (n NullCurrencyCode) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Code, nil
+}
+
+// IsZero returns true for invalid NullCurrencyCodes.
+func (n NullCurrencyCode) IsZero() bool {
+	return !n.Valid
+}
+
+// CurrencyCode is a nullable ISO 4217 currency code, such as "USD".
+// Codes are case insensitive and are stored in upper case.
+// Input that is not an active code is rejected.
+// It will decode to null, not an empty string, if null.
+type CurrencyCode struct {
+	NullCurrencyCode
+}
+
+// NewCurrencyCode creates a new CurrencyCode.
+// It will be null if valid is false or code is not an active currency code.
+func NewCurrencyCode(code string, valid bool) CurrencyCode {
+	if !valid {
+		return CurrencyCode{}
+	}
+	c, err := ParseCurrencyCode(code)
+	if err != nil {
+		return CurrencyCode{}
+	}
+	return c
+}
+
+// ParseCurrencyCode creates a new CurrencyCode from a string such as "usd".
+// It returns an error if code is not an active currency code.
+func ParseCurrencyCode(code string) (CurrencyCode, error) {
+	upper, err := parseISOCode(code, currencyCodes, "currency")
+	if err != nil {
+		return CurrencyCode{}, fmt.Errorf("null: couldn't parse CurrencyCode: %w", err)
+	}
+	return CurrencyCode{NullCurrencyCode: NullCurrencyCode{Code: upper, Valid: true}}, nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise an empty string.
+func (c CurrencyCode) ValueOrZero() string {
+	if !c.Valid {
+		return ""
+	}
+	return c.Code
+}
+
+// MinorUnits returns the number of minor unit digits of this currency, as used by Money.
+// See MoneyMinorUnits. It returns 0 if this CurrencyCode is null.
+func (c CurrencyCode) MinorUnits() int {
+	if !c.Valid {
+		return 0
+	}
+	return currencyDigits(c.Code)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports currency code strings and null input.
+func (c *CurrencyCode) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(c, &err)
+	code, valid, err := unmarshalISOCodeJSON(data, currencyCodes, "currency")
+	if err != nil {
+		return err
+	}
+	c.Code, c.Valid = code, valid
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this CurrencyCode is null.
+func (c CurrencyCode) MarshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(c.Code)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null CurrencyCode if the input is blank or DefaultNullText.
+func (c *CurrencyCode) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(c, &err)
+	if isNullText(text) {
+		c.Valid = false
+		return nil
+	}
+	code, err := parseISOCode(string(text), currencyCodes, "currency")
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	c.Code = code
+	c.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this CurrencyCode is null.
+func (c CurrencyCode) MarshalText() ([]byte, error) {
+	if !c.Valid {
+		return nullText(), nil
+	}
+	return []byte(c.Code), nil
+}
+
+// SetNull sets this CurrencyCode to null, leaving its value as is. Use Reset to clear the value too.
+func (c *CurrencyCode) SetNull() {
+	c.Valid = false
+}
+
+// Reset sets this CurrencyCode to null and clears its value.
+func (c *CurrencyCode) Reset() {
+	*c = CurrencyCode{}
+}
+
+// IsZero returns true for invalid CurrencyCodes.
+func (c CurrencyCode) IsZero() bool {
+	return !c.Valid
+}
+
+// IsZeroOrNull returns true for invalid CurrencyCodes, or valid CurrencyCodes with an empty code.
+func (c CurrencyCode) IsZeroOrNull() bool {
+	return !c.Valid || c.Code == ""
+}
+
+// Equal returns true if both CurrencyCodes are the same code or are both null.
+func (c CurrencyCode) Equal(other CurrencyCode) bool {
+	return c.Valid == other.Valid && (!c.Valid || c.Code == other.Code)
+}
@@ -0,0 +1,267 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestISOCodeSets(t *testing.T) {
+	if len(countryCodes) != 249 {
+		t.Errorf("expected 249 country codes, got %d", len(countryCodes))
+	}
+	for code := range MoneyMinorUnits {
+		if _, ok := currencyCodes[code]; !ok {
+			t.Errorf("MoneyMinorUnits currency %s is not in currencyCodes", code)
+		}
+	}
+}
+
+func TestParseCountryCode(t *testing.T) {
+	c, err := ParseCountryCode("us")
+	maybePanic(err)
+	assertCountryCode(t, c, "US", "ParseCountryCode()")
+
+	for _, bad := range []string{"", "U", "USA", "ZZ", "U1", "üs"} {
+		if _, err := ParseCountryCode(bad); err == nil {
+			t.Errorf("ParseCountryCode(%q): expected error", bad)
+		}
+	}
+
+	assertCountryCode(t, NewCountryCode("jp", true), "JP", "NewCountryCode()")
+	if NewCountryCode("JP", false).Valid || NewCountryCode("XX", true).Valid {
+		t.Error("NewCountryCode() should be null")
+	}
+}
+
+func TestCountryCodeJSON(t *testing.T) {
+	var c CountryCode
+	err := json.Unmarshal([]byte(`"gb"`), &c)
+	maybePanic(err)
+	assertCountryCode(t, c, "GB", "country code json")
+
+	data, err := json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"GB"`, "country code json marshal")
+
+	var null CountryCode
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	for _, bad := range [][]byte{[]byte(`"UK"`), []byte(`""`), intJSON, invalidJSON} {
+		var invalid CountryCode
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+	}
+}
+
+func TestCountryCodeText(t *testing.T) {
+	var c CountryCode
+	err := c.UnmarshalText([]byte("de"))
+	maybePanic(err)
+	assertCountryCode(t, c, "DE", "text unmarshal")
+	data, err := c.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "DE", "text marshal")
+
+	var blank CountryCode
+	err = blank.UnmarshalText(nil)
+	maybePanic(err)
+	if blank.Valid {
+		t.Error("blank text", "is valid, but should be invalid")
+	}
+	data, err = blank.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	if err := c.UnmarshalText([]byte("XX")); err == nil {
+		t.Error("expected error unmarshaling invalid text")
+	}
+}
+
+func TestCountryCodeScanValue(t *testing.T) {
+	var c CountryCode
+	err := c.Scan([]byte("fr"))
+	maybePanic(err)
+	assertCountryCode(t, c, "FR", "scanned bytes")
+
+	v, err := c.Value()
+	maybePanic(err)
+	if v != "FR" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	err = c.Scan(nil)
+	maybePanic(err)
+	if c.Valid {
+		t.Error("scanned null", "is valid, but should be invalid")
+	}
+	v, err = c.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+
+	var bad CountryCode
+	if err := bad.Scan("XX"); err == nil || bad.Valid {
+		t.Error("expected error scanning invalid country code")
+	}
+	if err := bad.Scan(int64(1)); err == nil {
+		t.Error("expected error scanning int64")
+	}
+}
+
+func TestCountryCodeEqual(t *testing.T) {
+	if !NewCountryCode("us", true).Equal(NewCountryCode("US", true)) {
+		t.Error("same CountryCodes should be equal")
+	}
+	if NewCountryCode("US", true).Equal(NewCountryCode("CA", true)) || NewCountryCode("US", true).Equal(CountryCode{}) {
+		t.Error("different CountryCodes should not be equal")
+	}
+	if !(CountryCode{}).Equal(CountryCode{}) || !(CountryCode{}).IsZero() || NewCountryCode("US", true).IsZero() {
+		t.Error("bad null CountryCodes")
+	}
+	if (CountryCode{}).ValueOrZero() != "" || NewCountryCode("US", true).ValueOrZero() != "US" {
+		t.Error("bad ValueOrZero()")
+	}
+}
+
+func TestParseCurrencyCode(t *testing.T) {
+	c, err := ParseCurrencyCode("usd")
+	maybePanic(err)
+	assertCurrencyCode(t, c, "USD", "ParseCurrencyCode()")
+
+	for _, bad := range []string{"", "US", "USDD", "ABC", "U$D"} {
+		if _, err := ParseCurrencyCode(bad); err == nil {
+			t.Errorf("ParseCurrencyCode(%q): expected error", bad)
+		}
+	}
+
+	assertCurrencyCode(t, NewCurrencyCode("eur", true), "EUR", "NewCurrencyCode()")
+	if NewCurrencyCode("EUR", false).Valid || NewCurrencyCode("ABC", true).Valid {
+		t.Error("NewCurrencyCode() should be null")
+	}
+}
+
+func TestCurrencyCodeMinorUnits(t *testing.T) {
+	tests := map[string]int{"USD": 2, "JPY": 0, "KWD": 3}
+	for code, want := range tests {
+		if got := NewCurrencyCode(code, true).MinorUnits(); got != want {
+			t.Errorf("MinorUnits() of %s = %d, want %d", code, got, want)
+		}
+	}
+	if (CurrencyCode{}).MinorUnits() != 0 {
+		t.Error("MinorUnits() of null CurrencyCode should be 0")
+	}
+}
+
+func TestCurrencyCodeJSON(t *testing.T) {
+	var c CurrencyCode
+	err := json.Unmarshal([]byte(`"jpy"`), &c)
+	maybePanic(err)
+	assertCurrencyCode(t, c, "JPY", "currency code json")
+
+	data, err := json.Marshal(c)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"JPY"`, "currency code json marshal")
+
+	var null CurrencyCode
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	for _, bad := range [][]byte{[]byte(`"ABC"`), []byte(`""`), intJSON, invalidJSON} {
+		var invalid CurrencyCode
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+	}
+}
+
+func TestCurrencyCodeText(t *testing.T) {
+	var c CurrencyCode
+	err := c.UnmarshalText([]byte("gbp"))
+	maybePanic(err)
+	assertCurrencyCode(t, c, "GBP", "text unmarshal")
+	data, err := c.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "GBP", "text marshal")
+
+	var blank CurrencyCode
+	err = blank.UnmarshalText(nil)
+	maybePanic(err)
+	if blank.Valid {
+		t.Error("blank text", "is valid, but should be invalid")
+	}
+
+	if err := c.UnmarshalText([]byte("ABC")); err == nil {
+		t.Error("expected error unmarshaling invalid text")
+	}
+}
+
+func TestCurrencyCodeScanValue(t *testing.T) {
+	var c CurrencyCode
+	err := c.Scan("chf")
+	maybePanic(err)
+	assertCurrencyCode(t, c, "CHF", "scanned string")
+
+	v, err := c.Value()
+	maybePanic(err)
+	if v != "CHF" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	err = c.Scan(nil)
+	maybePanic(err)
+	if c.Valid {
+		t.Error("scanned null", "is valid, but should be invalid")
+	}
+
+	var bad CurrencyCode
+	if err := bad.Scan([]byte("ABC")); err == nil || bad.Valid {
+		t.Error("expected error scanning invalid currency code")
+	}
+}
+
+func TestCurrencyCodeEqual(t *testing.T) {
+	if !NewCurrencyCode("usd", true).Equal(NewCurrencyCode("USD", true)) {
+		t.Error("same CurrencyCodes should be equal")
+	}
+	if NewCurrencyCode("USD", true).Equal(NewCurrencyCode("CAD", true)) || NewCurrencyCode("USD", true).Equal(CurrencyCode{}) {
+		t.Error("different CurrencyCodes should not be equal")
+	}
+	if !(CurrencyCode{}).Equal(CurrencyCode{}) || !(CurrencyCode{}).IsZero() || NewCurrencyCode("USD", true).IsZero() {
+		t.Error("bad null CurrencyCodes")
+	}
+}
+
+func assertCountryCode(t *testing.T, c CountryCode, want, from string) {
+	t.Helper()
+	if c.Code != want {
+		t.Errorf("bad %s country code: %q ≠ %q\n", from, c.Code, want)
+	}
+	if !c.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertCurrencyCode(t *testing.T, c CurrencyCode, want, from string) {
+	t.Helper()
+	if c.Code != want {
+		t.Errorf("bad %s currency code: %q ≠ %q\n", from, c.Code, want)
+	}
+	if !c.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
@@ -0,0 +1,192 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullJSON represents a raw JSON document that may be null.
+// NullJSON implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullJSON struct {
+	JSON  json.RawMessage
+	Valid bool // Valid is true if JSON is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports JSON and JSONB columns scanned as bytes or strings,
+// and will return an error if the input is not valid JSON.
+func (n *NullJSON) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		n.JSON, n.Valid = nil, false
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into JSON: %v", value, value)
+	}
+	if !json.Valid(data) {
+		return errors.New("null: couldn't scan JSON: invalid JSON input")
+	}
+	n.JSON, n.Valid = append(json.RawMessage{}, data...), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as strings, which drivers accept for JSON and JSONB columns.
+func (n NullJSON) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return string(n.JSON), nil
+}
+
+// IsZero returns true for invalid NullJSONs.
+func (n NullJSON) IsZero() bool {
+	return !n.Valid
+}
+
+// JSON is a nullable raw JSON document.
+// It will decode to null if null, not the JSON value null.
+type JSON struct {
+	NullJSON
+}
+
+// NewJSON creates a new JSON.
+// The input is not validated.
+func NewJSON(data json.RawMessage, valid bool) JSON {
+	return JSON{
+		NullJSON: NullJSON{
+			JSON:  data,
+			Valid: valid,
+		},
+	}
+}
+
+// JSONFrom creates a new JSON that will always be valid.
+// The input is not validated.
+func JSONFrom(data json.RawMessage) JSON {
+	return NewJSON(data, true)
+}
+
+// JSONFromPtr creates a new JSON that will be null if data is nil.
+// The input is not validated.
+func JSONFromPtr(data *json.RawMessage) JSON {
+	if data == nil {
+		return NewJSON(nil, false)
+	}
+	return NewJSON(*data, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (j JSON) ValueOrZero() json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return j.JSON
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It stores a copy of any valid JSON input as-is.
+// The JSON literal null will produce a null JSON.
+func (j *JSON) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(j, &err)
+	if bytes.Equal(data, nullBytes) {
+		j.Valid = false
+		return nil
+	}
+
+	if !json.Valid(data) {
+		return errors.New("null: couldn't unmarshal JSON: invalid JSON input")
+	}
+
+	j.JSON = append(json.RawMessage{}, data...)
+	j.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this JSON is null or empty, otherwise the document as-is.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if !j.Valid || len(j.JSON) == 0 {
+		return []byte("null"), nil
+	}
+	return j.JSON, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null JSON if the input is blank, "null", or DefaultNullText.
+// It will return an error if the input is not valid JSON.
+func (j *JSON) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(j, &err)
+	if isNullText(text) {
+		j.Valid = false
+		return nil
+	}
+	return j.UnmarshalJSON(text)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this JSON is null.
+func (j JSON) MarshalText() ([]byte, error) {
+	if !j.Valid {
+		return nullText(), nil
+	}
+	return j.JSON, nil
+}
+
+// SetValid changes this JSON's value and also sets it to be non-null.
+// The input is not validated.
+func (j *JSON) SetValid(data json.RawMessage) {
+	j.JSON = data
+	j.Valid = true
+}
+
+// SetNull sets this JSON to null, leaving its value as is. Use Reset to clear the value too.
+func (j *JSON) SetNull() {
+	j.Valid = false
+}
+
+// Reset sets this JSON to null and clears its value.
+func (j *JSON) Reset() {
+	*j = JSON{}
+}
+
+// Ptr returns a pointer to this JSON's value, or a nil pointer if this JSON is null.
+func (j JSON) Ptr() *json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return &j.JSON
+}
+
+// SetPtr changes this JSON's value to the value v points to, or sets it to be null if v is nil, like JSONFromPtr.
+func (j *JSON) SetPtr(v *json.RawMessage) {
+	*j = JSONFromPtr(v)
+}
+
+// IsZero returns true for invalid JSONs.
+func (j JSON) IsZero() bool {
+	return !j.Valid
+}
+
+// IsZeroOrNull returns true for invalid JSONs, or valid JSONs with an empty value.
+func (j JSON) IsZeroOrNull() bool {
+	return !j.Valid || len(j.JSON) == 0
+}
+
+// Equal returns true if both JSONs have identical raw documents or are both null.
+// Documents that differ only in whitespace or key order are not considered equal.
+func (j JSON) Equal(other JSON) bool {
+	return j.Valid == other.Valid && (!j.Valid || bytes.Equal(j.JSON, other.JSON))
+}
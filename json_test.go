@@ -0,0 +1,228 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	rawJSON = []byte(`{"hello":["world",1,true]}`)
+)
+
+type jsonInStruct struct {
+	Attrs JSON `json:"attrs"`
+}
+
+func TestJSONFrom(t *testing.T) {
+	j := JSONFrom(rawJSON)
+	assertJSON(t, j, "JSONFrom()")
+}
+
+func TestJSONFromPtr(t *testing.T) {
+	raw := json.RawMessage(rawJSON)
+	j := JSONFromPtr(&raw)
+	assertJSON(t, j, "JSONFromPtr()")
+
+	null := JSONFromPtr(nil)
+	assertNullJSON(t, null, "JSONFromPtr(nil)")
+}
+
+func TestUnmarshalJSONDocument(t *testing.T) {
+	var s jsonInStruct
+	err := json.Unmarshal([]byte(`{"attrs":`+string(rawJSON)+`}`), &s)
+	maybePanic(err)
+	assertJSON(t, s.Attrs, "json in struct")
+
+	var str JSON
+	err = json.Unmarshal(stringJSON, &str)
+	maybePanic(err)
+	if !str.Valid || string(str.JSON) != `"test"` {
+		t.Errorf("bad string json: %s", str.JSON)
+	}
+
+	var null JSON
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullJSON(t, null, "null json")
+
+	var invalid JSON
+	err = invalid.UnmarshalJSON(invalidJSON)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullJSON(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalJSONDocument(t *testing.T) {
+	var j JSON
+	err := j.UnmarshalText(rawJSON)
+	maybePanic(err)
+	assertJSON(t, j, "UnmarshalText() json")
+
+	var blank JSON
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullJSON(t, blank, "UnmarshalText() empty json")
+
+	var null JSON
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullJSON(t, null, `UnmarshalText() "null"`)
+
+	var invalid JSON
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalJSONDocument(t *testing.T) {
+	data, err := json.Marshal(jsonInStruct{Attrs: JSONFrom(rawJSON)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"attrs":`+string(rawJSON)+`}`, "non-empty json marshal")
+
+	data, err = json.Marshal(jsonInStruct{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"attrs":null}`, "null json marshal")
+
+	data, err = json.Marshal(JSONFrom(nil))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "empty json marshal")
+}
+
+func TestMarshalJSONDocumentText(t *testing.T) {
+	j := JSONFrom(rawJSON)
+	data, err := j.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, string(rawJSON), "non-empty text marshal")
+
+	null := NewJSON(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestJSONPointer(t *testing.T) {
+	j := JSONFrom(rawJSON)
+	ptr := j.Ptr()
+	if !bytes.Equal(*ptr, rawJSON) {
+		t.Errorf("bad %s json: %s ≠ %s\n", "pointer", *ptr, rawJSON)
+	}
+
+	null := NewJSON(nil, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s json: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestJSONIsZero(t *testing.T) {
+	j := JSONFrom(rawJSON)
+	if j.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewJSON(nil, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestJSONSetValid(t *testing.T) {
+	change := NewJSON(nil, false)
+	assertNullJSON(t, change, "SetValid()")
+	change.SetValid(rawJSON)
+	assertJSON(t, change, "SetValid()")
+}
+
+func TestJSONScan(t *testing.T) {
+	src := append([]byte{}, rawJSON...)
+	var j JSON
+	err := j.Scan(src)
+	maybePanic(err)
+	src[0] = '['
+	assertJSON(t, j, "scanned bytes")
+
+	var s JSON
+	err = s.Scan(string(rawJSON))
+	maybePanic(err)
+	assertJSON(t, s, "scanned string")
+
+	var null JSON
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullJSON(t, null, "scanned null")
+
+	var invalid JSON
+	err = invalid.Scan([]byte("hello"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullJSON(t, invalid, "scanned invalid")
+
+	var wrong JSON
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	j := JSONFrom(rawJSON)
+	v, err := j.Value()
+	maybePanic(err)
+	if v != string(rawJSON) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	null := NewJSON(rawJSON, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	j1 := NewJSON(rawJSON, false)
+	j2 := NewJSON(nil, false)
+	if !j1.Equal(j2) {
+		t.Error("null JSONs should be equal")
+	}
+
+	j1 = JSONFrom(rawJSON)
+	j2 = JSONFrom(append([]byte{}, rawJSON...))
+	if !j1.Equal(j2) {
+		t.Error("same JSONs should be equal")
+	}
+
+	j1 = JSONFrom(rawJSON)
+	j2 = NewJSON(rawJSON, false)
+	if j1.Equal(j2) {
+		t.Error("valid and null JSONs should not be equal")
+	}
+
+	j1 = JSONFrom(rawJSON)
+	j2 = JSONFrom([]byte(`{}`))
+	if j1.Equal(j2) {
+		t.Error("different JSONs should not be equal")
+	}
+}
+
+func assertJSON(t *testing.T, j JSON, from string) {
+	t.Helper()
+	if !bytes.Equal(j.JSON, rawJSON) {
+		t.Errorf("bad %s json: %s ≠ %s\n", from, j.JSON, rawJSON)
+	}
+	if !j.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullJSON(t *testing.T, j JSON, from string) {
+	t.Helper()
+	if j.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,102 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// JSONDecoding is a policy for which JSON input UnmarshalJSON accepts besides the JSON type each type encodes to.
+type JSONDecoding int
+
+const (
+	// JSONCompatible accepts numbers encoded as strings, such as "42", for the integer and float types
+	// and UnixSeconds and UnixMillis, as earlier versions did.
+	JSONCompatible JSONDecoding = iota
+	// JSONStrict only accepts the JSON type each type encodes to, and null,
+	// so that clients sending the wrong type get an error.
+	JSONStrict
+	// JSONLenient accepts everything JSONCompatible does, and also booleans encoded as strings,
+	// such as "true" or "0", and as the numbers 0 and 1. The integer types also accept numbers
+	// with a fraction or exponent, such as 1.0 or 1e3, from clients that encode every number as a float,
+	// as long as they are integers in range.
+	JSONLenient
+)
+
+// DefaultJSONDecoding is the policy used by UnmarshalJSON.
+// It defaults to JSONCompatible.
+var DefaultJSONDecoding = JSONCompatible
+
+// jsonDecoder is implemented by types whose UnmarshalJSON depends on DefaultJSONDecoding.
+type jsonDecoder interface {
+	unmarshalJSONWith(data []byte, d JSONDecoding) error
+	SetNull()
+}
+
+// Unmarshal decodes data into v, which should be one of this package's types, using this policy instead of DefaultJSONDecoding.
+// Types that accept the same input under every policy are decoded with their UnmarshalJSON method.
+// Either way, the validator registered with RegisterValidator is called, as with UnmarshalJSON.
+//
+// To use a different policy for a particular field, define a type that embeds one of this package's types
+// and implements json.Unmarshaler by calling Unmarshal. With encoding/json/v2, such a type must also implement
+// UnmarshalJSONFrom, which is otherwise promoted and takes precedence.
+func (d JSONDecoding) Unmarshal(data []byte, v json.Unmarshaler) (err error) {
+	if u, ok := v.(jsonDecoder); ok {
+		defer validateDecoded(u, &err)
+		return u.unmarshalJSONWith(data, d)
+	}
+	return v.UnmarshalJSON(data)
+}
+
+// numberStrings returns true if this policy accepts numbers encoded as strings.
+func (d JSONDecoding) numberStrings() bool {
+	return d != JSONStrict
+}
+
+// numberInput describes the input accepted for numbers of type typ, for error messages.
+func (d JSONDecoding) numberInput(typ string) string {
+	if !d.numberStrings() {
+		return typ
+	}
+	return typ + " or string"
+}
+
+// floatJSON returns the value of data if it is a JSON number with a fraction or exponent, such as 1.0 or 1e3,
+// and this policy accepts them for the integer types.
+// It returns false for other input, such as plain integers, which should be decoded as usual.
+func (d JSONDecoding) floatJSON(data []byte) (float64, bool) {
+	if d != JSONLenient || len(data) == 0 || data[0] == '"' || !bytes.ContainsAny(data, ".eE") || !json.Valid(data) {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(string(data), 64)
+	return f, err == nil
+}
+
+// intFromFloatJSON is like floatJSON, converting the number to a signed integer that fits in bitSize bits.
+func (d JSONDecoding) intFromFloatJSON(data []byte, bitSize int) (int64, bool, error) {
+	f, ok := d.floatJSON(data)
+	if !ok {
+		return 0, false, nil
+	}
+	n, ok := driverval.FloatToInt(f)
+	if !ok || (bitSize < 64 && (n < -1<<(bitSize-1) || n > 1<<(bitSize-1)-1)) {
+		return 0, true, fmt.Errorf("null: JSON input is not an integer in range of int%d: %s", bitSize, data)
+	}
+	return n, true, nil
+}
+
+// uintFromFloatJSON is like floatJSON, converting the number to an unsigned integer that fits in bitSize bits.
+func (d JSONDecoding) uintFromFloatJSON(data []byte, bitSize int) (uint64, bool, error) {
+	f, ok := d.floatJSON(data)
+	if !ok {
+		return 0, false, nil
+	}
+	if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 || (bitSize < 64 && uint64(f)>>uint(bitSize) != 0) {
+		return 0, true, fmt.Errorf("null: JSON input is not an integer in range of uint%d: %s", bitSize, data)
+	}
+	return uint64(f), true, nil
+}
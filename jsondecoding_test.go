@@ -0,0 +1,138 @@
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestJSONDecoding(t *testing.T) {
+	defer func() { DefaultJSONDecoding = JSONCompatible }()
+	for _, test := range []struct {
+		dst        json.Unmarshaler
+		input      string
+		compatible bool
+		strict     bool
+		lenient    bool
+	}{
+		{new(Int), `1`, true, true, true},
+		{new(Int), `"1"`, true, false, true},
+		{new(Int8), `"1"`, true, false, true},
+		{new(Int16), `"1"`, true, false, true},
+		{new(Int32), `"1"`, true, false, true},
+		{new(Uint), `"1"`, true, false, true},
+		{new(Uint8), `"1"`, true, false, true},
+		{new(Uint16), `"1"`, true, false, true},
+		{new(Uint32), `"1"`, true, false, true},
+		{new(Float), `"1.5"`, true, false, true},
+		{new(UnixSeconds), `"1"`, true, false, true},
+		{new(UnixMillis), `"1"`, true, false, true},
+		{new(Int), `true`, false, false, false},
+		{new(Bool), `true`, true, true, true},
+		{new(Bool), `1`, false, false, true},
+		{new(Bool), `0`, false, false, true},
+		{new(Bool), `2`, false, false, false},
+		{new(Bool), `"true"`, false, false, true},
+		{new(Bool), `"yes"`, false, false, false},
+		{new(Bool), `[]`, false, false, false},
+		{new(String), `"1"`, true, true, true},
+	} {
+		for _, mode := range []struct {
+			d    JSONDecoding
+			want bool
+		}{{JSONCompatible, test.compatible}, {JSONStrict, test.strict}, {JSONLenient, test.lenient}} {
+			DefaultJSONDecoding = mode.d
+			err := json.Unmarshal([]byte(test.input), test.dst)
+			if (err == nil) != mode.want {
+				t.Errorf("%T, policy %d: unmarshal %s: got error %v", test.dst, mode.d, test.input, err)
+			}
+		}
+	}
+
+	DefaultJSONDecoding = JSONLenient
+	var b Bool
+	err := json.Unmarshal([]byte(`1`), &b)
+	maybePanic(err)
+	assertBool(t, b, "lenient number bool")
+	err = json.Unmarshal([]byte(`"f"`), &b)
+	maybePanic(err)
+	assertFalseBool(t, b, "lenient string bool")
+}
+
+// strictInt overrides DefaultJSONDecoding for one field.
+type strictInt struct {
+	Int
+}
+
+func (i *strictInt) UnmarshalJSON(data []byte) error {
+	return JSONStrict.Unmarshal(data, &i.Int)
+}
+
+func TestJSONDecodingOverride(t *testing.T) {
+	var v struct {
+		Strict  strictInt
+		Default Int
+	}
+	if err := json.Unmarshal([]byte(`{"Strict":"1","Default":"1"}`), &v); err == nil {
+		t.Error("expected error for string in strict field")
+	}
+	err := json.Unmarshal([]byte(`{"Strict":1,"Default":"1"}`), &v)
+	maybePanic(err)
+	if !v.Strict.Equal(IntFrom(1)) || !v.Default.Equal(IntFrom(1)) {
+		t.Errorf("bad values: %v %v", v.Strict, v.Default)
+	}
+
+	var s String
+	err = JSONStrict.Unmarshal([]byte(`"test"`), &s)
+	maybePanic(err)
+	assertStr(t, s, "policy for type without options")
+}
+
+func TestJSONLenientIntegralFloats(t *testing.T) {
+	for _, test := range []struct {
+		dst   json.Unmarshaler
+		input string
+		want  interface{}
+	}{
+		{new(Int), `1.0`, IntFrom(1)},
+		{new(Int), `1e3`, IntFrom(1000)},
+		{new(Int), `-2.5E1`, IntFrom(-25)},
+		{new(Int), `-9.223372036854775808e18`, IntFrom(-1 << 63)},
+		{new(Int8), `-128.0`, Int8From(-128)},
+		{new(Int16), `3e4`, Int16From(30000)},
+		{new(Int32), `2.0`, Int32From(2)},
+		{new(Uint), `1.8446744073709550e19`, UintFrom(18446744073709549568)},
+		{new(Uint8), `2.55e2`, Uint8From(255)},
+		{new(Uint16), `6e4`, Uint16From(60000)},
+		{new(Uint32), `4e9`, Uint32From(4000000000)},
+	} {
+		if err := JSONCompatible.Unmarshal([]byte(test.input), test.dst); err == nil {
+			t.Errorf("%T: %s should need JSONLenient", test.dst, test.input)
+		}
+		if err := JSONLenient.Unmarshal([]byte(test.input), test.dst); err != nil {
+			t.Errorf("%T: unmarshal %s: %v", test.dst, test.input, err)
+			continue
+		}
+		if got := reflect.ValueOf(test.dst).Elem().Interface(); got != test.want {
+			t.Errorf("%T: unmarshal %s: got %v, want %v", test.dst, test.input, got, test.want)
+		}
+	}
+
+	for _, test := range []struct {
+		dst   json.Unmarshaler
+		input string
+	}{
+		{new(Int), `1.5`},
+		{new(Int), `9.3e18`},
+		{new(Int), `1e400`},
+		{new(Int8), `1.28e2`},
+		{new(Uint), `-1.0`},
+		{new(Uint), `1.9e19`},
+		{new(Uint8), `256.0`},
+		{new(Uint32), `0.5`},
+	} {
+		if err := JSONLenient.Unmarshal([]byte(test.input), test.dst); err == nil {
+			t.Errorf("%T: expected error for %s", test.dst, test.input)
+		}
+	}
+}
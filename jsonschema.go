@@ -0,0 +1,379 @@
+package null
+
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JSONSchema returns a JSON Schema describing the JSON encoding of values of v's type, such as a struct with fields of this package's types,
+// for OpenAPI and JSON Schema documents. It can be marshaled to JSON as is.
+// This package's types are nullable, such as {"type":["integer","null"]} for Int, with formats and patterns where they apply,
+// such as "date-time" for Time, and enum values for Enum. Schemas follow the current settings, such as DefaultDurationFormat.
+//
+// Other types are described like encoding/json encodes them: structs as objects with properties named by their json struct tags,
+// which are required unless tagged omitempty or omitzero, pointers, slices, and maps as nullable,
+// and time.Time as a "date-time" string. Recursive types and types with their own MarshalJSON method are described by the empty schema,
+// which allows any value.
+func JSONSchema(v interface{}) map[string]interface{} {
+	return JSONSchemaOf(reflect.TypeOf(v))
+}
+
+// JSONSchemaOf is like JSONSchema, but takes a type, such as reflect.TypeFor[null.Int]().
+func JSONSchemaOf(typ reflect.Type) map[string]interface{} {
+	s := schemaReflector{seen: make(map[reflect.Type]bool)}
+	return s.schema(typ)
+}
+
+// schemaReflector builds JSON schemas for JSONSchema.
+type schemaReflector struct {
+	// seen holds the structs being described, to stop at recursive types.
+	seen map[reflect.Type]bool
+}
+
+// schemaer is implemented by the generic types whose schemas depend on T beyond its JSON encoding.
+type schemaer interface {
+	jsonSchema() map[string]interface{}
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage(nil))
+	numberType        = reflect.TypeOf(json.Number(""))
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	packagePath       = reflect.TypeOf(Int{}).PkgPath()
+)
+
+// schema returns the schema of typ.
+func (s schemaReflector) schema(typ reflect.Type) map[string]interface{} {
+	if typ == nil {
+		return map[string]interface{}{}
+	}
+	if sch, ok := s.nullTypeSchema(typ); ok {
+		return nullableSchema(sch)
+	}
+	switch {
+	case typ == timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case typ == rawMessageType:
+		return map[string]interface{}{}
+	case typ == numberType:
+		return map[string]interface{}{"type": "number"}
+	case typ.Implements(jsonMarshalerType) || reflect.PointerTo(typ).Implements(jsonMarshalerType):
+		return map[string]interface{}{}
+	case typ.Implements(textMarshalerType) || reflect.PointerTo(typ).Implements(textMarshalerType):
+		return map[string]interface{}{"type": "string"}
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return integerSchema(typ)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return integerSchema(typ)
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Pointer:
+		return nullableSchema(s.schema(typ.Elem()))
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return nullableSchema(map[string]interface{}{"type": "string", "contentEncoding": "base64"})
+		}
+		return nullableSchema(map[string]interface{}{"type": "array", "items": s.schema(typ.Elem())})
+	case reflect.Array:
+		return map[string]interface{}{"type": "array", "items": s.schema(typ.Elem()), "minItems": typ.Len(), "maxItems": typ.Len()}
+	case reflect.Map:
+		return nullableSchema(map[string]interface{}{"type": "object", "additionalProperties": s.schema(typ.Elem())})
+	case reflect.Struct:
+		return s.structSchema(typ)
+	}
+	return map[string]interface{}{}
+}
+
+// integerSchema returns the schema of an integer type, with its range if it is narrower than int64.
+func integerSchema(typ reflect.Type) map[string]interface{} {
+	sch := map[string]interface{}{"type": "integer"}
+	switch typ.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		bits := typ.Bits()
+		sch["minimum"], sch["maximum"] = int64(-1)<<(bits-1), int64(1)<<(bits-1)-1
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		sch["minimum"] = 0
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		sch["minimum"], sch["maximum"] = 0, uint64(1)<<typ.Bits()-1
+	}
+	return sch
+}
+
+// structSchema returns the schema of a struct as an object, with properties named like encoding/json does.
+func (s schemaReflector) structSchema(typ reflect.Type) map[string]interface{} {
+	if s.seen[typ] {
+		return map[string]interface{}{}
+	}
+	s.seen[typ] = true
+	defer delete(s.seen, typ)
+
+	properties := make(map[string]interface{})
+	var required []string
+	s.addProperties(typ, properties, &required)
+	sch := map[string]interface{}{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		sch["required"] = required
+	}
+	return sch
+}
+
+// addProperties adds the properties of the fields of typ, including those of embedded structs.
+func (s schemaReflector) addProperties(typ reflect.Type, properties map[string]interface{}, required *[]string) {
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				s.addProperties(ft, properties, required)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		properties[name] = s.schema(f.Type)
+		if !strings.Contains(","+opts+",", ",omitempty,") && !strings.Contains(","+opts+",", ",omitzero,") {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// nullTypeSchema returns the schema of typ without null, if it is one of this package's types.
+func (s schemaReflector) nullTypeSchema(typ reflect.Type) (map[string]interface{}, bool) {
+	if typ.PkgPath() != packagePath {
+		return nil, false
+	}
+	if fn, ok := nullTypeSchemas[typ]; ok {
+		return fn(), true
+	}
+	if sch, ok := reflect.Zero(typ).Interface().(schemaer); ok {
+		return sch.jsonSchema(), true
+	}
+	// the other generic types embed sql.Null[T], and are encoded like T
+	v, ok := typ.FieldByName("V")
+	if typ.Kind() != reflect.Struct || !ok || len(v.Index) < 2 {
+		return nil, false
+	}
+	switch v.Type.Kind() {
+	case reflect.Slice:
+		if v.Type.Elem().Kind() != reflect.Uint8 {
+			return map[string]interface{}{"type": "array", "items": s.schema(v.Type.Elem())}, true
+		}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": s.schema(v.Type.Elem())}, true
+	}
+	return s.schema(v.Type), true
+}
+
+// nullableSchema adds null to the types of sch. The empty schema already allows null.
+func nullableSchema(sch map[string]interface{}) map[string]interface{} {
+	switch t := sch["type"].(type) {
+	case string:
+		sch["type"] = []string{t, "null"}
+	case []string:
+		for _, name := range t {
+			if name == "null" {
+				return sch
+			}
+		}
+		sch["type"] = append(t, "null")
+	}
+	if enum, ok := sch["enum"].([]interface{}); ok {
+		sch["enum"] = append(enum, nil)
+	}
+	return sch
+}
+
+// nullTypeSchemas returns the schemas of the non-generic types, without null. They are functions to follow the current settings.
+var nullTypeSchemas = map[reflect.Type]func() map[string]interface{}{
+	reflect.TypeOf(Addr{}): stringSchema("", ""),
+	reflect.TypeOf(Any{}): func() map[string]interface{} {
+		return map[string]interface{}{}
+	},
+	reflect.TypeOf(Base64{}): func() map[string]interface{} {
+		if DefaultBase64Encoding == Base64URL {
+			return map[string]interface{}{"type": "string", "contentEncoding": "base64url"}
+		}
+		return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+	},
+	reflect.TypeOf(BigInt{}):   stringSchema("", "^-?[0-9]+$"),
+	reflect.TypeOf(Bool{}):     simpleSchema("boolean"),
+	reflect.TypeOf(ByteSize{}): stringSchema("", ""),
+	reflect.TypeOf(Bytes{}): func() map[string]interface{} {
+		if DefaultBytesEncoding == BytesHex {
+			return map[string]interface{}{"type": "string", "pattern": "^([0-9a-f]{2})*$"}
+		}
+		return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+	},
+	reflect.TypeOf(Complex{}): func() map[string]interface{} {
+		if DefaultComplexFormat == ComplexString {
+			return map[string]interface{}{"type": "string"}
+		}
+		return objectSchema(map[string]interface{}{"re": map[string]interface{}{"type": "number"}, "im": map[string]interface{}{"type": "number"}}, "re", "im")
+	},
+	reflect.TypeOf(CountryCode{}):  stringSchema("", "^[A-Z]{2}$"),
+	reflect.TypeOf(CurrencyCode{}): stringSchema("", "^[A-Z]{3}$"),
+	reflect.TypeOf(Date{}):         stringSchema("date", ""),
+	reflect.TypeOf(Duration{}): func() map[string]interface{} {
+		switch DefaultDurationFormat {
+		case DurationNanoseconds:
+			return map[string]interface{}{"type": "integer"}
+		case DurationSeconds:
+			return map[string]interface{}{"type": "number"}
+		}
+		return map[string]interface{}{"type": "string"}
+	},
+	reflect.TypeOf(Email{}):        stringSchema("email", ""),
+	reflect.TypeOf(FileMode{}):     stringSchema("", "^0[0-7]+$"),
+	reflect.TypeOf(Float{}):        simpleSchema("number"),
+	reflect.TypeOf(HardwareAddr{}): stringSchema("", ""),
+	reflect.TypeOf(Hex{}):          stringSchema("", "^([0-9a-f]{2})*$"),
+	reflect.TypeOf(Int{}):          simpleSchema("integer"),
+	reflect.TypeOf(Int8{}):         rangeSchema(math.MinInt8, math.MaxInt8),
+	reflect.TypeOf(Int16{}):        rangeSchema(math.MinInt16, math.MaxInt16),
+	reflect.TypeOf(Int32{}):        rangeSchema(math.MinInt32, math.MaxInt32),
+	reflect.TypeOf(Int64Range{}):   stringSchema("", ""),
+	reflect.TypeOf(JSON{}): func() map[string]interface{} {
+		return map[string]interface{}{}
+	},
+	reflect.TypeOf(KSUID{}):       stringSchema("", "^[0-9A-Za-z]{27}$"),
+	reflect.TypeOf(LanguageTag{}): stringSchema("", ""),
+	reflect.TypeOf(Money{}): func() map[string]interface{} {
+		return objectSchema(map[string]interface{}{
+			"amount":   map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+(\\.[0-9]+)?$"},
+			"currency": map[string]interface{}{"type": "string", "pattern": "^[A-Z]{3}$"},
+		}, "amount", "currency")
+	},
+	reflect.TypeOf(Percent{}): simpleSchema("number"),
+	reflect.TypeOf(Point{}): func() map[string]interface{} {
+		if DefaultPointFormat == PointArray {
+			return map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "number"}, "minItems": 2, "maxItems": 2}
+		}
+		return objectSchema(map[string]interface{}{"lat": map[string]interface{}{"type": "number"}, "lng": map[string]interface{}{"type": "number"}}, "lat", "lng")
+	},
+	reflect.TypeOf(Prefix{}): stringSchema("", ""),
+	reflect.TypeOf(Regexp{}): stringSchema("regex", ""),
+	reflect.TypeOf(Semver{}): stringSchema("", ""),
+	reflect.TypeOf(String{}): simpleSchema("string"),
+	reflect.TypeOf(Time{}): func() map[string]interface{} {
+		switch DefaultTimeOptions.Format {
+		case "":
+			return map[string]interface{}{"type": "string", "format": "date-time"}
+		case TimeLayoutUnix:
+			return map[string]interface{}{"type": "integer"}
+		}
+		return map[string]interface{}{"type": "string"}
+	},
+	reflect.TypeOf(TimeOfDay{}):   stringSchema("", "^[0-9]{2}:[0-9]{2}:[0-9]{2}(\\.[0-9]+)?$"),
+	reflect.TypeOf(TimeRange{}):   stringSchema("", ""),
+	reflect.TypeOf(Uint{}):        rangeSchema(0, nil),
+	reflect.TypeOf(Uint8{}):       rangeSchema(0, math.MaxUint8),
+	reflect.TypeOf(Uint16{}):      rangeSchema(0, math.MaxUint16),
+	reflect.TypeOf(Uint32{}):      rangeSchema(0, math.MaxUint32),
+	reflect.TypeOf(ULID{}):        stringSchema("", "^[0-9A-HJKMNP-TV-Z]{26}$"),
+	reflect.TypeOf(UnixMillis{}):  simpleSchema("integer"),
+	reflect.TypeOf(UnixSeconds{}): simpleSchema("integer"),
+	reflect.TypeOf(URL{}):         stringSchema("uri", ""),
+}
+
+func simpleSchema(typ string) func() map[string]interface{} {
+	return func() map[string]interface{} {
+		return map[string]interface{}{"type": typ}
+	}
+}
+
+// stringSchema returns a function returning the schema of a string with the given format and pattern, if not blank.
+func stringSchema(format, pattern string) func() map[string]interface{} {
+	return func() map[string]interface{} {
+		sch := map[string]interface{}{"type": "string"}
+		if format != "" {
+			sch["format"] = format
+		}
+		if pattern != "" {
+			sch["pattern"] = pattern
+		}
+		return sch
+	}
+}
+
+// rangeSchema returns a function returning the schema of an integer from min to max. A nil max is unbounded.
+func rangeSchema(min, max interface{}) func() map[string]interface{} {
+	return func() map[string]interface{} {
+		sch := map[string]interface{}{"type": "integer", "minimum": min}
+		if max != nil {
+			sch["maximum"] = max
+		}
+		return sch
+	}
+}
+
+func objectSchema(properties map[string]interface{}, required ...string) map[string]interface{} {
+	return map[string]interface{}{"type": "object", "properties": properties, "required": required}
+}
+
+// jsonSchema returns the schema of Enum[T], with its registered values.
+func (Enum[T]) jsonSchema() map[string]interface{} {
+	values := EnumValues[T]()
+	enum := make([]interface{}, len(values))
+	for i, v := range values {
+		enum[i] = v
+	}
+	var zero T
+	typ := "string"
+	if reflect.TypeOf(zero).Kind() == reflect.Int {
+		typ = "integer"
+	}
+	return map[string]interface{}{"type": typ, "enum": enum}
+}
+
+// jsonSchema returns the schema of Flags[T]: an array of its registered names, or otherwise an integer.
+func (Flags[T]) jsonSchema() map[string]interface{} {
+	names, ok := lookupFlagNames[T]()
+	if !ok {
+		var zero T
+		return integerSchema(reflect.TypeOf(zero))
+	}
+	sorted := make([]string, 0, len(names))
+	for _, name := range names {
+		sorted = append(sorted, name)
+	}
+	slices.Sort(sorted)
+	enum := make([]interface{}, len(sorted))
+	for i, name := range sorted {
+		enum[i] = name
+	}
+	return map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string", "enum": enum}, "uniqueItems": true}
+}
+
+// jsonSchema returns the schema of FixedHex[A]: hexadecimal of exactly len(A) bytes.
+func (FixedHex[A]) jsonSchema() map[string]interface{} {
+	var zero A
+	size := reflect.TypeOf(&zero).Elem().Size()
+	return map[string]interface{}{"type": "string", "pattern": "^[0-9a-f]{" + strconv.Itoa(2*int(size)) + "}$"}
+}
@@ -0,0 +1,104 @@
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type schemaModel struct {
+	ID       int64                `json:"id"`
+	Name     String               `json:"name"`
+	Age      Uint8                `json:"age,omitempty"`
+	Created  Time                 `json:"created,omitzero"`
+	State    Enum[testState]      `json:"state"`
+	Perms    Flags[testNamedPerm] `json:"perms"`
+	Digest   FixedHex[[4]byte]    `json:"digest"`
+	Tags     Slice[string]        `json:"tags"`
+	Meta     Map[string, Int]     `json:"meta"`
+	Ratio    Optional[float64]    `json:"ratio"`
+	Parent   *schemaModel         `json:"parent"`
+	Updated  time.Time            `json:"updated"`
+	Ignored  String               `json:"-"`
+	internal int
+	schemaEmbedded
+}
+
+type schemaEmbedded struct {
+	Note String
+}
+
+func TestJSONSchema(t *testing.T) {
+	got := JSONSchema(schemaModel{})
+	want := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"id":      map[string]interface{}{"type": "integer"},
+			"name":    map[string]interface{}{"type": []string{"string", "null"}},
+			"age":     map[string]interface{}{"type": []string{"integer", "null"}, "minimum": 0, "maximum": 255},
+			"created": map[string]interface{}{"type": []string{"string", "null"}, "format": "date-time"},
+			"state":   map[string]interface{}{"type": []string{"string", "null"}, "enum": []interface{}{testStateOpen, testStateClosed, nil}},
+			"perms": map[string]interface{}{
+				"type":        []string{"array", "null"},
+				"items":       map[string]interface{}{"type": "string", "enum": []interface{}{"admin", "read", "write"}},
+				"uniqueItems": true,
+			},
+			"digest": map[string]interface{}{"type": []string{"string", "null"}, "pattern": "^[0-9a-f]{8}$"},
+			"tags":   map[string]interface{}{"type": []string{"array", "null"}, "items": map[string]interface{}{"type": "string"}},
+			"meta": map[string]interface{}{
+				"type":                 []string{"object", "null"},
+				"additionalProperties": map[string]interface{}{"type": []string{"integer", "null"}},
+			},
+			"ratio":   map[string]interface{}{"type": []string{"number", "null"}},
+			"parent":  map[string]interface{}{},
+			"updated": map[string]interface{}{"type": "string", "format": "date-time"},
+			"Note":    map[string]interface{}{"type": []string{"string", "null"}},
+		},
+		"required": []string{"id", "name", "state", "perms", "digest", "tags", "meta", "ratio", "parent", "updated", "Note"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		gotJSON, _ := json.Marshal(got)
+		wantJSON, _ := json.Marshal(want)
+		t.Errorf("bad JSONSchema:\n%s\nwant:\n%s", gotJSON, wantJSON)
+	}
+}
+
+func TestJSONSchemaTypes(t *testing.T) {
+	typ := reflect.TypeOf(allTypes{})
+	for i := 0; i < typ.NumField(); i++ {
+		ft := typ.Field(i).Type
+		sch := JSONSchemaOf(ft)
+		if _, err := json.Marshal(sch); err != nil {
+			t.Errorf("JSONSchema(%v) can't be marshaled: %v", ft, err)
+		}
+		if len(sch) == 0 {
+			continue
+		}
+		types, ok := sch["type"].([]string)
+		if !ok || types[len(types)-1] != "null" {
+			t.Errorf("JSONSchema(%v) isn't nullable: %v", ft, sch)
+		}
+	}
+}
+
+func TestJSONSchemaFormats(t *testing.T) {
+	defer func(format DurationFormat) { DefaultDurationFormat = format }(DefaultDurationFormat)
+	DefaultDurationFormat = DurationSeconds
+	want := map[string]interface{}{"type": []string{"number", "null"}}
+	if got := JSONSchema(Duration{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("bad JSONSchema(Duration) with DurationSeconds: %v, want %v", got, want)
+	}
+
+	defer func(format PointFormat) { DefaultPointFormat = format }(DefaultPointFormat)
+	DefaultPointFormat = PointArray
+	want = map[string]interface{}{
+		"type":     []string{"array", "null"},
+		"items":    map[string]interface{}{"type": "number"},
+		"minItems": 2,
+		"maxItems": 2,
+	}
+	if got := JSONSchemaOf(reflect.TypeOf(Point{})); !reflect.DeepEqual(got, want) {
+		t.Errorf("bad JSONSchema(Point) with PointArray: %v, want %v", got, want)
+	}
+}
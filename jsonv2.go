@@ -0,0 +1,663 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package null
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+)
+
+// This file implements the MarshalerTo and UnmarshalerFrom interfaces from encoding/json/v2,
+// which are available from Go 1.27 unless disabled with GOEXPERIMENT=nojsonv2.
+// They produce the same output as MarshalJSON and accept the same input as UnmarshalJSON.
+// Bool, String, and the integer types write their tokens directly to the encoder,
+// while other types, and all types when decoding, go through MarshalJSON and UnmarshalJSON.
+// Combined with IsZero, null values are omitted by the omitzero option.
+
+// marshalJSONTo writes the JSON encoding of m to enc.
+func marshalJSONTo(enc *jsontext.Encoder, m json.Marshaler) error {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(data)
+}
+
+// unmarshalJSONFrom reads the next JSON value from dec into u.
+func unmarshalJSONFrom(dec *jsontext.Decoder, u json.Unmarshaler) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Addr is null.
+func (a Addr) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, a)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (a *Addr) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, a)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Any is null.
+func (a Any) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, a)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (a *Any) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, a)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Base64 is null.
+func (b Base64) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, b)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (b *Base64) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this BigInt is null.
+func (b BigInt) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, b)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (b *BigInt) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !b.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Bool(b.Bool))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (b *Bool) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this ByteSize is null.
+func (b ByteSize) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, b)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (b *ByteSize) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Bytes is null.
+func (b Bytes) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, b)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (b *Bytes) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Complex is null.
+func (c Complex) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, c)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (c *Complex) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, c)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Date is null.
+func (d Date) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, d)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (d *Date) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, d)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Duration is null.
+func (d Duration) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, d)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (d *Duration) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, d)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Email is null.
+func (e Email) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, e)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (e *Email) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, e)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Enum is null.
+func (e Enum[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, e)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (e *Enum[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, e)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this FileMode is null.
+func (m FileMode) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, m)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (m *FileMode) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, m)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Flags is null.
+func (f Flags[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, f)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (f *Flags[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, f)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Float is null.
+func (f Float) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, f)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (f *Float) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, f)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this HardwareAddr is null.
+func (h HardwareAddr) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, h)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (h *HardwareAddr) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, h)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Hex is null.
+func (h Hex) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, h)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (h *Hex) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, h)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this FixedHex is null.
+func (h FixedHex[A]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, h)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (h *FixedHex[A]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, h)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Int is null.
+func (i Int) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(i.Int64))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (i *Int) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(int64(i.Int16)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (i *Int16) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(int64(i.Int32)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (i *Int32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(int64(i.Int8)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (i *Int8) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this CountryCode is null.
+func (c CountryCode) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, c)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (c *CountryCode) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, c)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this CurrencyCode is null.
+func (c CurrencyCode) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, c)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (c *CurrencyCode) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, c)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this JSON is null.
+func (j JSON) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, j)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (j *JSON) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, j)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this KSUID is null.
+func (k KSUID) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, k)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (k *KSUID) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, k)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this LanguageTag is null.
+func (l LanguageTag) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, l)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (l *LanguageTag) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, l)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Map is null.
+func (m Map[K, V]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, m)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (m *Map[K, V]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, m)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Money is null.
+func (m Money) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, m)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (m *Money) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, m)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Optional is null.
+func (o Optional[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, o)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (o *Optional[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, o)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Percent is null.
+func (p Percent) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, p)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (p *Percent) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, p)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Point is null.
+func (p Point) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, p)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (p *Point) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, p)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Prefix is null.
+func (p Prefix) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, p)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (p *Prefix) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, p)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Int64Range is null.
+func (r Int64Range) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, r)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (r *Int64Range) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, r)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this TimeRange is null.
+func (r TimeRange) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, r)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (r *TimeRange) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, r)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Regexp is null.
+func (r Regexp) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, r)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (r *Regexp) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, r)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Semver is null.
+func (s Semver) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, s)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (s *Semver) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, s)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Slice is null.
+func (s Slice[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, s)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (s *Slice[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, s)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this String is null.
+func (s String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !s.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.String(s.String))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (s *String) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, s)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Time is null.
+func (t Time) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, t)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (t *Time) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, t)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this TimeOfDay is null.
+func (t TimeOfDay) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, t)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (t *TimeOfDay) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, t)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Uint is null.
+func (i Uint) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Uint(i.Uint64))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (i *Uint) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Uint16 is null.
+func (i Uint16) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Uint(uint64(i.Uint16)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (i *Uint16) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Uint32 is null.
+func (i Uint32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Uint(uint64(i.Uint32)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (i *Uint32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Uint8 is null.
+func (i Uint8) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !i.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Uint(uint64(i.Uint8)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (i *Uint8) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this ULID is null.
+func (u ULID) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, u)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (u *ULID) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, u)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this UnixSeconds is null.
+func (u UnixSeconds) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, u)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (u *UnixSeconds) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, u)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this UnixMillis is null.
+func (u UnixMillis) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, u)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (u *UnixMillis) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, u)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this URL is null.
+func (u URL) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, u)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (u *URL) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, u)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Value is null.
+func (v Value[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, v)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (v *Value[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, v)
+}
@@ -0,0 +1,95 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package null
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"reflect"
+	"testing"
+)
+
+// mysqlTime must override the methods promoted from Time to keep its options with json/v2.
+func (t mysqlTime) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, t)
+}
+
+func (t *mysqlTime) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, t)
+}
+
+// strictInt must likewise override UnmarshalJSONFrom to keep its decoding policy.
+func (i *strictInt) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+func TestJSONv2RoundTrip(t *testing.T) {
+	for _, in := range []allTypes{exampleAllTypes(), {}} {
+		rv := reflect.ValueOf(in)
+		for i := 0; i < rv.NumField(); i++ {
+			name := rv.Type().Field(i).Name
+			field := rv.Field(i)
+			want, err := field.Interface().(json.Marshaler).MarshalJSON()
+			maybePanic(err)
+			data, err := jsonv2.Marshal(field.Interface())
+			maybePanic(err)
+			assertJSONEquals(t, data, string(want), name+" json/v2 Marshal")
+
+			out := reflect.New(field.Type())
+			err = jsonv2.Unmarshal(data, out.Interface())
+			maybePanic(err)
+			got, err := out.Elem().Interface().(json.Marshaler).MarshalJSON()
+			maybePanic(err)
+			assertJSONEquals(t, got, string(want), name+" json/v2 Unmarshal")
+		}
+	}
+}
+
+func TestJSONv2Struct(t *testing.T) {
+	want := exampleAllTypes()
+	data, err := jsonv2.Marshal(want)
+	maybePanic(err)
+	var got allTypes
+	err = jsonv2.Unmarshal(data, &got)
+	maybePanic(err)
+	assertAllTypesEqual(t, got, want, "json/v2")
+}
+
+func TestJSONv2OmitZero(t *testing.T) {
+	type omit struct {
+		Int    Int    `json:",omitzero"`
+		String String `json:",omitzero"`
+		Bool   Bool
+	}
+
+	data, err := jsonv2.Marshal(omit{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Bool":null}`, "omitzero null")
+
+	data, err = jsonv2.Marshal(omit{Int: IntFrom(0), String: StringFrom(""), Bool: BoolFrom(false)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"Int":0,"String":"","Bool":false}`, "omitzero valid")
+}
+
+func TestJSONv2UnmarshalError(t *testing.T) {
+	var i Int
+	if err := jsonv2.Unmarshal([]byte(`{"a":1}`), &i); err == nil {
+		t.Error("expected error for object into Int")
+	}
+	var s String
+	if err := jsonv2.Unmarshal([]byte(`"test`), &s); err == nil {
+		t.Error("expected error for truncated string")
+	}
+}
+
+func TestJSONv2EscapeHTML(t *testing.T) {
+	// encoding/json options still apply to values written by MarshalJSONTo
+	s := StringFrom("<test>")
+	data, err := json.Marshal(s)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"\u003ctest\u003e"`, "json escaped")
+	data, err = jsonv2.Marshal(s)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"<test>"`, "json/v2 unescaped")
+}
@@ -0,0 +1,264 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+const (
+	// ksuidLen is the length of a KSUID's string form.
+	ksuidLen = 27
+	// ksuidEpoch is the Unix time of a KSUID timestamp of 0.
+	ksuidEpoch = 1400000000
+)
+
+// NullKSUID represents a KSUID that may be null.
+// NullKSUID implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullKSUID struct {
+	KSUID [20]byte
+	Valid bool // Valid is true if KSUID is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports 27 character strings, and 20 byte binary values such as BINARY(20) columns.
+func (n *NullKSUID) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var id [20]byte
+	var err error
+	switch v := value.(type) {
+	case nil:
+		n.KSUID, n.Valid = [20]byte{}, false
+		return nil
+	case []byte:
+		if len(v) == len(id) {
+			copy(id[:], v)
+		} else {
+			id, err = parseKSUID(string(v))
+		}
+	case string:
+		id, err = parseKSUID(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into KSUID: %v", value, value)
+	}
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan KSUID: %w", err)
+	}
+	n.KSUID, n.Valid = id, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as 27 character strings.
+func (n NullKSUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return formatKSUID(n.KSUID), nil
+}
+
+// IsZero returns true for invalid NullKSUIDs.
+func (n NullKSUID) IsZero() bool {
+	return !n.Valid
+}
+
+// KSUID is a nullable KSUID (K-Sortable Unique IDentifier), as specified by https://github.com/segmentio/ksuid.
+// It encodes as a 27 character base62 string in JSON and text.
+// It does not consider the all-zero KSUID to be null.
+// It will decode to null, not zero, if null.
+type KSUID struct {
+	NullKSUID
+}
+
+// NewKSUID creates a new KSUID.
+func NewKSUID(id [20]byte, valid bool) KSUID {
+	return KSUID{
+		NullKSUID: NullKSUID{
+			KSUID: id,
+			Valid: valid,
+		},
+	}
+}
+
+// KSUIDFrom creates a new KSUID that will always be valid.
+func KSUIDFrom(id [20]byte) KSUID {
+	return NewKSUID(id, true)
+}
+
+// KSUIDFromPtr creates a new KSUID that will be null if id is nil.
+func KSUIDFromPtr(id *[20]byte) KSUID {
+	if id == nil {
+		return NewKSUID([20]byte{}, false)
+	}
+	return NewKSUID(*id, true)
+}
+
+// ParseKSUID creates a new KSUID from its 27 character string form.
+func ParseKSUID(s string) (KSUID, error) {
+	id, err := parseKSUID(s)
+	if err != nil {
+		return KSUID{}, fmt.Errorf("null: couldn't parse KSUID: %w", err)
+	}
+	return KSUIDFrom(id), nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (k KSUID) ValueOrZero() [20]byte {
+	if !k.Valid {
+		return [20]byte{}
+	}
+	return k.KSUID
+}
+
+// Time returns the timestamp encoded in this KSUID, with second precision,
+// or the zero time if this KSUID is null.
+func (k KSUID) Time() time.Time {
+	if !k.Valid {
+		return time.Time{}
+	}
+	return time.Unix(int64(binary.BigEndian.Uint32(k.KSUID[:4]))+ksuidEpoch, 0).UTC()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports KSUID strings and null input.
+func (k *KSUID) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(k, &err)
+	if bytes.Equal(data, nullBytes) {
+		k.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	id, err := parseKSUID(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	k.KSUID = id
+	k.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this KSUID is null.
+func (k KSUID) MarshalJSON() ([]byte, error) {
+	if !k.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + formatKSUID(k.KSUID) + `"`), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null KSUID if the input is blank or DefaultNullText.
+func (k *KSUID) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(k, &err)
+	if isNullText(text) {
+		k.Valid = false
+		return nil
+	}
+	id, err := parseKSUID(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	k.KSUID = id
+	k.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this KSUID is null.
+func (k KSUID) MarshalText() ([]byte, error) {
+	if !k.Valid {
+		return nullText(), nil
+	}
+	return []byte(formatKSUID(k.KSUID)), nil
+}
+
+// SetValid changes this KSUID's value and also sets it to be non-null.
+func (k *KSUID) SetValid(v [20]byte) {
+	k.KSUID = v
+	k.Valid = true
+}
+
+// SetNull sets this KSUID to null, leaving its value as is. Use Reset to clear the value too.
+func (k *KSUID) SetNull() {
+	k.Valid = false
+}
+
+// Reset sets this KSUID to null and clears its value.
+func (k *KSUID) Reset() {
+	*k = KSUID{}
+}
+
+// Ptr returns a pointer to this KSUID's value, or a nil pointer if this KSUID is null.
+func (k KSUID) Ptr() *[20]byte {
+	if !k.Valid {
+		return nil
+	}
+	return &k.KSUID
+}
+
+// SetPtr changes this KSUID's value to the value v points to, or sets it to be null if v is nil, like KSUIDFromPtr.
+func (k *KSUID) SetPtr(v *[20]byte) {
+	*k = KSUIDFromPtr(v)
+}
+
+// IsZero returns true for invalid KSUIDs.
+// A non-null all-zero KSUID will not be considered zero.
+func (k KSUID) IsZero() bool {
+	return !k.Valid
+}
+
+// IsZeroOrNull returns true for invalid KSUIDs, or valid KSUIDs with all bytes zero.
+func (k KSUID) IsZeroOrNull() bool {
+	return !k.Valid || k.KSUID == [20]byte{}
+}
+
+// Equal returns true if both KSUIDs have the same value or are both null.
+func (k KSUID) Equal(other KSUID) bool {
+	return k.Valid == other.Valid && (!k.Valid || k.KSUID == other.KSUID)
+}
+
+// swapCase swaps the case of ASCII letters.
+// KSUIDs use the base62 alphabet 0-9A-Za-z, while math/big uses 0-9a-zA-Z.
+func swapCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - ('a' - 'A')
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		}
+		return r
+	}, s)
+}
+
+func formatKSUID(id [20]byte) string {
+	str := swapCase(new(big.Int).SetBytes(id[:]).Text(62))
+	return strings.Repeat("0", ksuidLen-len(str)) + str
+}
+
+func parseKSUID(str string) ([20]byte, error) {
+	var id [20]byte
+	if len(str) != ksuidLen || !isAlphanumeric(str) {
+		return id, fmt.Errorf("invalid KSUID: %q", str)
+	}
+	n, ok := new(big.Int).SetString(swapCase(str), 62)
+	if !ok || n.BitLen() > len(id)*8 {
+		return id, fmt.Errorf("invalid KSUID: %q", str)
+	}
+	n.FillBytes(id[:])
+	return id, nil
+}
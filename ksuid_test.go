@@ -0,0 +1,185 @@
+package null
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	ksuidString = "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+	ksuidValue  = mustHexArray20("0669F7EFB5A1CD34B5F99D1154FB6853345C9735")
+	ksuidJSON   = []byte(`"0ujtsYcgvSTl8PAuAdqWYSMnLOv"`)
+)
+
+func TestParseKSUID(t *testing.T) {
+	k, err := ParseKSUID(ksuidString)
+	maybePanic(err)
+	assertKSUID(t, k, "ParseKSUID()")
+
+	max, err := ParseKSUID("aWgEPTl1tmebfsQzFP4bxwgy80V")
+	maybePanic(err)
+	for _, b := range max.KSUID {
+		if b != 0xff {
+			t.Fatalf("bad max KSUID: %x", max.KSUID)
+		}
+	}
+
+	for _, bad := range []string{"", "0ujtsYcgvSTl8PAuAdqWYSMnLO", "0ujtsYcgvSTl8PAuAdqWYSMnLOvv", "0ujtsYcgvSTl8PAuAdqWYSMnLO-", "aWgEPTl1tmebfsQzFP4bxwgy80W", "zzzzzzzzzzzzzzzzzzzzzzzzzzz"} {
+		if _, err := ParseKSUID(bad); err == nil {
+			t.Errorf("ParseKSUID(%q): expected error", bad)
+		}
+	}
+}
+
+func TestKSUIDFromPtr(t *testing.T) {
+	v := ksuidValue
+	assertKSUID(t, KSUIDFromPtr(&v), "KSUIDFromPtr()")
+	assertNullKSUID(t, KSUIDFromPtr(nil), "KSUIDFromPtr(nil)")
+}
+
+func TestKSUIDTime(t *testing.T) {
+	want := time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC)
+	if got := KSUIDFrom(ksuidValue).Time(); !got.Equal(want) {
+		t.Errorf("bad Time(): %v ≠ %v", got, want)
+	}
+	if !NewKSUID(ksuidValue, false).Time().IsZero() {
+		t.Error("null KSUID should have zero Time()")
+	}
+}
+
+func TestKSUIDJSON(t *testing.T) {
+	var k KSUID
+	err := json.Unmarshal(ksuidJSON, &k)
+	maybePanic(err)
+	assertKSUID(t, k, "ksuid json")
+
+	data, err := json.Marshal(k)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(ksuidJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(KSUIDFrom([20]byte{}))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"000000000000000000000000000"`, "zero json marshal")
+
+	var null KSUID
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullKSUID(t, null, "null json")
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	for _, bad := range [][]byte{blankStringJSON, []byte(`"not a ksuid"`), intJSON, invalidJSON} {
+		var invalid KSUID
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullKSUID(t, invalid, "invalid json")
+	}
+}
+
+func TestKSUIDText(t *testing.T) {
+	data, err := KSUIDFrom(ksuidValue).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, ksuidString, "text marshal")
+
+	var k KSUID
+	err = k.UnmarshalText([]byte(ksuidString))
+	maybePanic(err)
+	assertKSUID(t, k, "text unmarshal")
+
+	var blank KSUID
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullKSUID(t, blank, "blank text unmarshal")
+
+	data, err = NewKSUID(ksuidValue, false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestKSUIDScanValue(t *testing.T) {
+	for _, in := range []interface{}{ksuidString, []byte(ksuidString), ksuidValue[:]} {
+		var k KSUID
+		err := k.Scan(in)
+		maybePanic(err)
+		assertKSUID(t, k, "scanned")
+	}
+
+	var null KSUID
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullKSUID(t, null, "scanned null")
+
+	for _, bad := range []interface{}{"x", []byte{1, 2, 3}, int64(1)} {
+		var invalid KSUID
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %v", bad)
+		}
+		assertNullKSUID(t, invalid, "invalid scan")
+	}
+
+	v, err := KSUIDFrom(ksuidValue).Value()
+	maybePanic(err)
+	if v != ksuidString {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestKSUIDPointerEqual(t *testing.T) {
+	k := KSUIDFrom(ksuidValue)
+	if ptr := k.Ptr(); ptr == nil || *ptr != ksuidValue {
+		t.Errorf("bad pointer: %v", ptr)
+	}
+	if NewKSUID(ksuidValue, false).Ptr() != nil {
+		t.Error("null Ptr() should be nil")
+	}
+	if k.ValueOrZero() != ksuidValue || NewKSUID(ksuidValue, false).ValueOrZero() != [20]byte{} {
+		t.Error("bad ValueOrZero()")
+	}
+
+	var change KSUID
+	change.SetValid(ksuidValue)
+	assertKSUID(t, change, "SetValid()")
+
+	if !k.Equal(change) || !NewKSUID(ksuidValue, false).Equal(KSUID{}) {
+		t.Error("KSUIDs should be equal")
+	}
+	if k.Equal(KSUIDFrom([20]byte{})) || KSUIDFrom([20]byte{}).Equal(KSUID{}) {
+		t.Error("KSUIDs should not be equal")
+	}
+	if KSUIDFrom([20]byte{}).IsZero() || !(KSUID{}).IsZero() {
+		t.Error("bad IsZero()")
+	}
+}
+
+func assertKSUID(t *testing.T, k KSUID, from string) {
+	t.Helper()
+	if k.KSUID != ksuidValue {
+		t.Errorf("bad %s KSUID: %x ≠ %x\n", from, k.KSUID, ksuidValue)
+	}
+	if !k.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullKSUID(t *testing.T, k KSUID, from string) {
+	t.Helper()
+	if k.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func mustHexArray20(s string) (a [20]byte) {
+	if n, err := hex.Decode(a[:], []byte(s)); err != nil || n != len(a) {
+		panic("bad hex: " + s)
+	}
+	return
+}
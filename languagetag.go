@@ -0,0 +1,337 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullLanguageTag represents a BCP 47 language tag that may be null.
+// NullLanguageTag implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullLanguageTag struct {
+	// Tag is a canonically cased language tag, such as "en-US" or "zh-Hant-TW".
+	Tag   string
+	Valid bool // Valid is true if Tag is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the value is not a well-formed language tag.
+func (n *NullLanguageTag) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		n.Tag, n.Valid = "", false
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into LanguageTag: %v", value, value)
+	}
+	tag, err := parseLanguageTag(str)
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan LanguageTag: %w", err)
+	}
+	n.Tag, n.Valid = tag.String(), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as canonically cased tag strings.
+func (n NullLanguageTag) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Tag, nil
+}
+
+// IsZero returns true for invalid NullLanguageTags.
+func (n NullLanguageTag) IsZero() bool {
+	return !n.Valid
+}
+
+// LanguageTag is a nullable BCP 47 (RFC 5646) language tag, such as "en-US".
+// Tags are checked to be well-formed and canonically cased: "EN_us" becomes "en-US".
+// Underscores are accepted as separators, as in POSIX locales.
+// Tags are not checked against the IANA subtag registry.
+// It will decode to null, not an empty string, if null.
+type LanguageTag struct {
+	NullLanguageTag
+}
+
+// NewLanguageTag creates a new LanguageTag.
+// It will be null if valid is false or tag is not a well-formed language tag.
+func NewLanguageTag(tag string, valid bool) LanguageTag {
+	if !valid {
+		return LanguageTag{}
+	}
+	t, err := ParseLanguageTag(tag)
+	if err != nil {
+		return LanguageTag{}
+	}
+	return t
+}
+
+// ParseLanguageTag creates a new LanguageTag from a string such as "en-US".
+// It returns an error if tag is not a well-formed language tag.
+func ParseLanguageTag(tag string) (LanguageTag, error) {
+	t, err := parseLanguageTag(tag)
+	if err != nil {
+		return LanguageTag{}, fmt.Errorf("null: couldn't parse LanguageTag: %w", err)
+	}
+	return LanguageTag{NullLanguageTag: NullLanguageTag{Tag: t.String(), Valid: true}}, nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise an empty string.
+func (l LanguageTag) ValueOrZero() string {
+	if !l.Valid {
+		return ""
+	}
+	return l.Tag
+}
+
+// Language returns the primary language subtag, such as "en" for "en-US".
+// It returns an empty string if this LanguageTag is null or a private use tag.
+func (l LanguageTag) Language() string {
+	return l.parsed().language
+}
+
+// Script returns the script subtag, such as "Hant" for "zh-Hant-TW", or an empty string if there is none.
+func (l LanguageTag) Script() string {
+	return l.parsed().script
+}
+
+// Region returns the region subtag, such as "US" for "en-US", or an empty string if there is none.
+func (l LanguageTag) Region() string {
+	return l.parsed().region
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports language tag strings and null input.
+func (l *LanguageTag) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(l, &err)
+	if bytes.Equal(data, nullBytes) {
+		l.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	tag, err := parseLanguageTag(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	l.Tag = tag.String()
+	l.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this LanguageTag is null.
+func (l LanguageTag) MarshalJSON() ([]byte, error) {
+	if !l.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(l.Tag)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null LanguageTag if the input is blank or DefaultNullText.
+func (l *LanguageTag) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(l, &err)
+	if isNullText(text) {
+		l.Valid = false
+		return nil
+	}
+	tag, err := parseLanguageTag(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	l.Tag = tag.String()
+	l.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this LanguageTag is null.
+func (l LanguageTag) MarshalText() ([]byte, error) {
+	if !l.Valid {
+		return nullText(), nil
+	}
+	return []byte(l.Tag), nil
+}
+
+// SetNull sets this LanguageTag to null, leaving its value as is. Use Reset to clear the value too.
+func (l *LanguageTag) SetNull() {
+	l.Valid = false
+}
+
+// Reset sets this LanguageTag to null and clears its value.
+func (l *LanguageTag) Reset() {
+	*l = LanguageTag{}
+}
+
+// IsZero returns true for invalid LanguageTags.
+func (l LanguageTag) IsZero() bool {
+	return !l.Valid
+}
+
+// IsZeroOrNull returns true for invalid LanguageTags, or valid LanguageTags with an empty tag.
+func (l LanguageTag) IsZeroOrNull() bool {
+	return !l.Valid || l.Tag == ""
+}
+
+// Equal returns true if both LanguageTags are the same tag or are both null.
+func (l LanguageTag) Equal(other LanguageTag) bool {
+	return l.Valid == other.Valid && (!l.Valid || l.Tag == other.Tag)
+}
+
+func (l LanguageTag) parsed() languageTag {
+	if !l.Valid {
+		return languageTag{}
+	}
+	tag, _ := parseLanguageTag(l.Tag)
+	return tag
+}
+
+type languageTag struct {
+	language string
+	extlang  []string
+	script   string
+	region   string
+	// variants, extensions, and private use subtags, in their original order
+	rest []string
+}
+
+func (t languageTag) String() string {
+	parts := make([]string, 0, 3+len(t.extlang)+len(t.rest))
+	if t.language != "" {
+		parts = append(parts, t.language)
+	}
+	parts = append(parts, t.extlang...)
+	if t.script != "" {
+		parts = append(parts, t.script)
+	}
+	if t.region != "" {
+		parts = append(parts, t.region)
+	}
+	parts = append(parts, t.rest...)
+	return strings.Join(parts, "-")
+}
+
+// parseLanguageTag parses a well-formed language tag, as specified by RFC 5646 section 2.1,
+// and canonicalizes its case as recommended by section 2.1.1.
+// Irregular grandfathered tags such as "i-klingon" are not supported.
+func parseLanguageTag(str string) (languageTag, error) {
+	var t languageTag
+	subtags := strings.Split(strings.ReplaceAll(str, "_", "-"), "-")
+	for _, s := range subtags {
+		if len(s) == 0 || len(s) > 8 || !isAlphanumeric(s) {
+			return languageTag{}, fmt.Errorf("invalid language tag: %q", str)
+		}
+	}
+	for i := range subtags {
+		subtags[i] = strings.ToLower(subtags[i])
+	}
+
+	i := 0
+	if subtags[0] != "x" {
+		// language
+		lang := subtags[0]
+		if len(lang) < 2 || !isAlpha(lang) {
+			return languageTag{}, fmt.Errorf("invalid language tag: %q", str)
+		}
+		t.language = lang
+		i++
+		// extlang
+		for len(lang) <= 3 && len(t.extlang) < 3 && i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]) {
+			t.extlang = append(t.extlang, subtags[i])
+			i++
+		}
+		// script
+		if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+			t.script = strings.ToUpper(subtags[i][:1]) + subtags[i][1:]
+			i++
+		}
+		// region
+		if i < len(subtags) && ((len(subtags[i]) == 2 && isAlpha(subtags[i])) || (len(subtags[i]) == 3 && isDigits(subtags[i]))) {
+			t.region = strings.ToUpper(subtags[i])
+			i++
+		}
+		// variants
+		seen := make(map[string]bool)
+		for i < len(subtags) && isLanguageVariant(subtags[i]) {
+			if seen[subtags[i]] {
+				return languageTag{}, fmt.Errorf("invalid language tag: duplicate variant %q: %q", subtags[i], str)
+			}
+			seen[subtags[i]] = true
+			t.rest = append(t.rest, subtags[i])
+			i++
+		}
+		// extensions
+		for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+			singleton := subtags[i]
+			if seen[singleton] {
+				return languageTag{}, fmt.Errorf("invalid language tag: duplicate extension %q: %q", singleton, str)
+			}
+			seen[singleton] = true
+			t.rest = append(t.rest, singleton)
+			i++
+			start := i
+			for i < len(subtags) && len(subtags[i]) >= 2 {
+				t.rest = append(t.rest, subtags[i])
+				i++
+			}
+			if i == start {
+				return languageTag{}, fmt.Errorf("invalid language tag: empty extension %q: %q", singleton, str)
+			}
+		}
+	}
+	// private use
+	if i < len(subtags) && subtags[i] == "x" {
+		if i == len(subtags)-1 {
+			return languageTag{}, fmt.Errorf("invalid language tag: empty private use: %q", str)
+		}
+		t.rest = append(t.rest, subtags[i:]...)
+		i = len(subtags)
+	}
+	if i != len(subtags) {
+		return languageTag{}, fmt.Errorf("invalid language tag: unexpected subtag %q: %q", subtags[i], str)
+	}
+	return t, nil
+}
+
+func isLanguageVariant(s string) bool {
+	return len(s) >= 5 || (len(s) == 4 && s[0] >= '0' && s[0] <= '9')
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
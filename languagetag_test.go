@@ -0,0 +1,193 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	languageTagString = "zh-Hant-TW"
+	languageTagJSON   = []byte(`"` + languageTagString + `"`)
+)
+
+func TestParseLanguageTag(t *testing.T) {
+	tests := map[string]string{
+		"zh-Hant-TW":                   "zh-Hant-TW",
+		"ZH-hANT-tw":                   "zh-Hant-TW",
+		"en_us":                        "en-US",
+		"en":                           "en",
+		"es-419":                       "es-419",
+		"sl-rozaj-biske":               "sl-rozaj-biske",
+		"de-CH-1901":                   "de-CH-1901",
+		"zh-yue-HK":                    "zh-yue-HK",
+		"en-US-u-ca-gregory-x-private": "en-US-u-ca-gregory-x-private",
+		"sr-Latn-RS-A-ABC-B-DEF":       "sr-Latn-RS-a-abc-b-def",
+		"x-whatever":                   "x-whatever",
+		"hawaii":                       "hawaii",
+	}
+	for in, want := range tests {
+		l, err := ParseLanguageTag(in)
+		if err != nil {
+			t.Errorf("ParseLanguageTag(%q): unexpected error: %v", in, err)
+			continue
+		}
+		if !l.Valid || l.Tag != want {
+			t.Errorf("ParseLanguageTag(%q) = %q, want %q", in, l.Tag, want)
+		}
+	}
+
+	for _, bad := range []string{
+		"", "e", "en-", "-en", "en--US", "toolongtag", "en-US-", "1en", "en-a", "en-a-bc-a-de",
+		"de-1901-1901", "en-x", "en-US-GB", "en-ü",
+	} {
+		if _, err := ParseLanguageTag(bad); err == nil {
+			t.Errorf("ParseLanguageTag(%q): expected error", bad)
+		}
+	}
+
+	assertLanguageTag(t, NewLanguageTag("zh-hant-tw", true), "NewLanguageTag()")
+	assertNullLanguageTag(t, NewLanguageTag("zh-Hant-TW", false), "NewLanguageTag() invalid")
+	assertNullLanguageTag(t, NewLanguageTag("--", true), "NewLanguageTag() malformed")
+}
+
+func TestLanguageTagAccessors(t *testing.T) {
+	l, err := ParseLanguageTag(languageTagString)
+	maybePanic(err)
+	if l.Language() != "zh" || l.Script() != "Hant" || l.Region() != "TW" {
+		t.Errorf("bad accessors: %q %q %q", l.Language(), l.Script(), l.Region())
+	}
+
+	var null LanguageTag
+	if null.Language() != "" || null.Script() != "" || null.Region() != "" || null.ValueOrZero() != "" {
+		t.Error("null accessors should be empty")
+	}
+	if l.ValueOrZero() != languageTagString {
+		t.Error("bad ValueOrZero()")
+	}
+}
+
+func TestUnmarshalLanguageTag(t *testing.T) {
+	var l LanguageTag
+	err := json.Unmarshal([]byte(`"zh_hant_tw"`), &l)
+	maybePanic(err)
+	assertLanguageTag(t, l, "language tag json")
+
+	var null LanguageTag
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullLanguageTag(t, null, "null json")
+
+	for _, bad := range [][]byte{[]byte(`"en--US"`), []byte(`""`), intJSON, invalidJSON} {
+		var invalid LanguageTag
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullLanguageTag(t, invalid, "invalid json")
+	}
+}
+
+func TestMarshalLanguageTag(t *testing.T) {
+	l, err := ParseLanguageTag(languageTagString)
+	maybePanic(err)
+	data, err := json.Marshal(l)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(languageTagJSON), "non-empty json marshal")
+
+	data, err = l.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, languageTagString, "text marshal")
+
+	var null LanguageTag
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestLanguageTagText(t *testing.T) {
+	var l LanguageTag
+	err := l.UnmarshalText([]byte("ZH-HANT-TW"))
+	maybePanic(err)
+	assertLanguageTag(t, l, "text unmarshal")
+
+	var blank LanguageTag
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullLanguageTag(t, blank, "blank text unmarshal")
+
+	var bad LanguageTag
+	if err := bad.UnmarshalText([]byte("en-")); err == nil {
+		t.Error("expected error unmarshaling invalid text")
+	}
+}
+
+func TestLanguageTagScanValue(t *testing.T) {
+	var l LanguageTag
+	err := l.Scan("zh-hant-tw")
+	maybePanic(err)
+	assertLanguageTag(t, l, "scanned string")
+
+	var b LanguageTag
+	err = b.Scan([]byte("zh-Hant-TW"))
+	maybePanic(err)
+	assertLanguageTag(t, b, "scanned bytes")
+
+	var null LanguageTag
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullLanguageTag(t, null, "scanned null")
+
+	var bad LanguageTag
+	if err := bad.Scan("not a tag"); err == nil {
+		t.Error("expected error scanning invalid tag")
+	}
+	if err := bad.Scan(int64(1)); err == nil {
+		t.Error("expected error scanning int64")
+	}
+
+	v, err := l.Value()
+	maybePanic(err)
+	if v != languageTagString {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestLanguageTagEqual(t *testing.T) {
+	a, b := NewLanguageTag("en-us", true), NewLanguageTag("EN-US", true)
+	if !a.Equal(b) {
+		t.Error("same LanguageTags should be equal")
+	}
+	if a.Equal(NewLanguageTag("en-GB", true)) {
+		t.Error("different LanguageTags should not be equal")
+	}
+	if a.Equal(LanguageTag{}) {
+		t.Error("valid and null LanguageTags should not be equal")
+	}
+	if !(LanguageTag{}).Equal(LanguageTag{}) || !(LanguageTag{}).IsZero() || a.IsZero() {
+		t.Error("bad null LanguageTags")
+	}
+}
+
+func assertLanguageTag(t *testing.T, l LanguageTag, from string) {
+	t.Helper()
+	if l.Tag != languageTagString {
+		t.Errorf("bad %s language tag: %q ≠ %q\n", from, l.Tag, languageTagString)
+	}
+	if !l.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullLanguageTag(t *testing.T, l LanguageTag, from string) {
+	t.Helper()
+	if l.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,206 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// Map is a nullable map of K to V.
+// It distinguishes null from an empty map: a null Map encodes to JSON null,
+// while a valid Map encodes to an object, even if it is nil.
+// It will decode to null, not an empty map, if null.
+type Map[K comparable, V any] struct {
+	sql.Null[map[K]V]
+}
+
+// NewMap creates a new Map.
+func NewMap[K comparable, V any](m map[K]V, valid bool) Map[K, V] {
+	return Map[K, V]{
+		Null: sql.Null[map[K]V]{
+			V:     m,
+			Valid: valid,
+		},
+	}
+}
+
+// MapFrom creates a new Map that will always be valid.
+// A nil map is considered empty, not null.
+func MapFrom[K comparable, V any](m map[K]V) Map[K, V] {
+	return NewMap(m, true)
+}
+
+// MapFromPtr creates a new Map that will be null if m is nil.
+func MapFromPtr[K comparable, V any](m *map[K]V) Map[K, V] {
+	if m == nil {
+		return NewMap[K, V](nil, false)
+	}
+	return NewMap(*m, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (m Map[K, V]) ValueOrZero() map[K]V {
+	if !m.Valid {
+		return nil
+	}
+	return m.V
+}
+
+// Get returns the value for key and whether it was present.
+// It is safe to call on a null Map, which has no keys.
+func (m Map[K, V]) Get(key K) (V, bool) {
+	if !m.Valid {
+		var zero V
+		return zero, false
+	}
+	v, ok := m.V[key]
+	return v, ok
+}
+
+// Len returns the number of keys in this Map, or 0 if it is null.
+func (m Map[K, V]) Len() int {
+	if !m.Valid {
+		return 0
+	}
+	return len(m.V)
+}
+
+// Scan implements the Scanner interface.
+// It supports JSON objects, such as JSON or JSONB columns.
+func (m *Map[K, V]) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		m.V, m.Valid = nil, false
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Map: %v", value, value)
+	}
+
+	var v map[K]V
+	if err := json.Unmarshal(data, &v); err != nil {
+		m.Valid = false
+		return fmt.Errorf("null: couldn't scan Map: %w", err)
+	}
+	if v == nil {
+		// JSON null in a non-null column
+		v = map[K]V{}
+	}
+	m.V, m.Valid = v, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as JSON object strings.
+func (m Map[K, V]) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports object and null input.
+// An empty object will not be considered null.
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		m.V, m.Valid = nil, false
+		return nil
+	}
+
+	var v map[K]V
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	m.V = v
+	m.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Map is null, and {} if it is valid but empty.
+func (m Map[K, V]) MarshalJSON() ([]byte, error) {
+	if !m.Valid {
+		return []byte("null"), nil
+	}
+	if m.V == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(m.V)
+}
+
+// SetValid changes this Map's value and also sets it to be non-null.
+func (m *Map[K, V]) SetValid(v map[K]V) {
+	m.V = v
+	m.Valid = true
+}
+
+// SetNull sets this Map to null, leaving its value as is. Use Reset to clear the value too.
+func (m *Map[K, V]) SetNull() {
+	m.Valid = false
+}
+
+// Reset sets this Map to null and clears its value.
+func (m *Map[K, V]) Reset() {
+	*m = Map[K, V]{}
+}
+
+// Ptr returns a pointer to this Map's value, or a nil pointer if this Map is null.
+func (m Map[K, V]) Ptr() *map[K]V {
+	if !m.Valid {
+		return nil
+	}
+	return &m.V
+}
+
+// SetPtr changes this Map's value to the value v points to, or sets it to be null if v is nil, like MapFromPtr.
+func (m *Map[K, V]) SetPtr(v *map[K]V) {
+	*m = MapFromPtr(v)
+}
+
+// IsZero returns true for invalid Maps.
+// A non-null Map with no keys will not be considered zero.
+func (m Map[K, V]) IsZero() bool {
+	return !m.Valid
+}
+
+// IsZeroOrNull returns true for invalid Maps, or valid Maps with no keys.
+func (m Map[K, V]) IsZeroOrNull() bool {
+	return !m.Valid || len(m.V) == 0
+}
+
+// Equal returns true if both Maps have the same keys and values or are both null.
+// Nil and empty maps are equal. Values are compared with reflect.DeepEqual.
+func (m Map[K, V]) Equal(other Map[K, V]) bool {
+	if m.Valid != other.Valid {
+		return false
+	}
+	if !m.Valid {
+		return true
+	}
+	if len(m.V) != len(other.V) {
+		return false
+	}
+	for k, v := range m.V {
+		ov, ok := other.V[k]
+		if !ok || !reflect.DeepEqual(v, ov) {
+			return false
+		}
+	}
+	return true
+}
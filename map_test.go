@@ -0,0 +1,182 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	mapJSON      = []byte(`{"env":"prod","team":"core"}`)
+	emptyMapJSON = []byte(`{}`)
+	mapValue     = map[string]string{"env": "prod", "team": "core"}
+)
+
+func TestMapFrom(t *testing.T) {
+	m := MapFrom(mapValue)
+	assertMap(t, m, "MapFrom()")
+
+	empty := MapFrom[string, string](nil)
+	if !empty.Valid {
+		t.Error("MapFrom(nil)", "is invalid, but should be valid")
+	}
+
+	v := map[string]string{"env": "prod", "team": "core"}
+	p := MapFromPtr(&v)
+	assertMap(t, p, "MapFromPtr()")
+
+	null := MapFromPtr[string, string](nil)
+	assertNullMap(t, null, "MapFromPtr(nil)")
+}
+
+func TestMapGet(t *testing.T) {
+	m := MapFrom(mapValue)
+	if v, ok := m.Get("env"); v != "prod" || !ok {
+		t.Errorf("bad Get(): %q %v", v, ok)
+	}
+	if v, ok := m.Get("missing"); v != "" || ok {
+		t.Errorf("bad Get() of missing key: %q %v", v, ok)
+	}
+
+	var null Map[string, string]
+	if v, ok := null.Get("env"); v != "" || ok {
+		t.Errorf("bad Get() of null Map: %q %v", v, ok)
+	}
+	if null.Len() != 0 || m.Len() != 2 {
+		t.Error("bad Len()")
+	}
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	var m Map[string, string]
+	err := json.Unmarshal(mapJSON, &m)
+	maybePanic(err)
+	assertMap(t, m, "map json")
+
+	var empty Map[string, string]
+	err = json.Unmarshal(emptyMapJSON, &empty)
+	maybePanic(err)
+	if !empty.Valid || empty.Len() != 0 {
+		t.Errorf("bad empty map json: %#v", empty)
+	}
+
+	var ints Map[int, float64]
+	err = json.Unmarshal([]byte(`{"1":1.5}`), &ints)
+	maybePanic(err)
+	if v, ok := ints.Get(1); v != 1.5 || !ok {
+		t.Errorf("bad int key map json: %#v", ints)
+	}
+
+	var null Map[string, string]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullMap(t, null, "null json")
+
+	var badType Map[string, string]
+	err = json.Unmarshal(sliceJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullMap(t, badType, "wrong type json")
+}
+
+func TestMarshalMap(t *testing.T) {
+	data, err := json.Marshal(MapFrom(mapValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(mapJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(MapFrom[string, string](nil))
+	maybePanic(err)
+	assertJSONEquals(t, data, "{}", "empty json marshal")
+
+	data, err = json.Marshal(NewMap(mapValue, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMapScanValue(t *testing.T) {
+	var m Map[string, string]
+	err := m.Scan(mapJSON)
+	maybePanic(err)
+	assertMap(t, m, "scanned bytes")
+
+	var s Map[string, string]
+	err = s.Scan(string(mapJSON))
+	maybePanic(err)
+	assertMap(t, s, "scanned string")
+
+	var jsonNull Map[string, string]
+	err = jsonNull.Scan("null")
+	maybePanic(err)
+	if !jsonNull.Valid || jsonNull.V == nil {
+		t.Errorf("bad scanned JSON null: %#v", jsonNull)
+	}
+
+	var null Map[string, string]
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullMap(t, null, "scanned null")
+
+	for _, bad := range []interface{}{`["a"]`, `{"a":1}`, "hello", int64(1)} {
+		var invalid Map[string, string]
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("Scan(%#v): expected error", bad)
+		}
+		assertNullMap(t, invalid, "scanned invalid")
+	}
+
+	v, err := m.Value()
+	maybePanic(err)
+	if v != string(mapJSON) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestMapMisc(t *testing.T) {
+	m := NewMap[string, string](nil, false)
+	if !m.IsZero() || m.Ptr() != nil || m.ValueOrZero() != nil {
+		t.Error("bad null Map")
+	}
+	m.SetValid(mapValue)
+	assertMap(t, m, "SetValid()")
+	if m.IsZero() || len(*m.Ptr()) != 2 || m.ValueOrZero()["env"] != "prod" {
+		t.Error("bad valid Map")
+	}
+
+	if !MapFrom[string, string](nil).Equal(MapFrom(map[string]string{})) {
+		t.Error("nil and empty Maps should be equal")
+	}
+	if MapFrom[string, string](nil).Equal(NewMap[string, string](nil, false)) {
+		t.Error("empty and null Maps should not be equal")
+	}
+	if MapFrom(map[string]string{"a": "b"}).Equal(MapFrom(map[string]string{"a": "c"})) {
+		t.Error("different Maps should not be equal")
+	}
+	if MapFrom(map[string]string{"a": "b"}).Equal(MapFrom(map[string]string{"b": "b"})) {
+		t.Error("Maps with different keys should not be equal")
+	}
+	if !MapFrom(mapValue).Equal(MapFrom(map[string]string{"team": "core", "env": "prod"})) {
+		t.Error("same Maps should be equal")
+	}
+}
+
+func assertMap(t *testing.T, m Map[string, string], from string) {
+	t.Helper()
+	if len(m.V) != 2 || m.V["env"] != "prod" || m.V["team"] != "core" {
+		t.Errorf("bad %s map: %#v\n", from, m.V)
+	}
+	if !m.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullMap(t *testing.T, m Map[string, string], from string) {
+	t.Helper()
+	if m.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,98 @@
+package null
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MergeMode is how Merge treats explicitly null Optional fields of a patch.
+type MergeMode int
+
+const (
+	// MergeValid skips null Optionals, like other null fields, so that only valid values are copied.
+	MergeValid MergeMode = iota
+	// MergePresent copies null Optionals too, setting the destination field to null,
+	// so that an explicit null in a PATCH body clears the field while an omitted one leaves it alone.
+	MergePresent
+)
+
+// Merge copies the valid fields of patch to the matching fields of the struct pointed to by dst, skipping null ones,
+// for applying partial updates such as HTTP PATCH bodies. Unset Optional fields are always skipped,
+// and null Optionals are copied as null if mode is MergePresent, for three-state semantics.
+// dst must be a pointer to a struct, and patch a struct or a pointer to one, possibly of different types.
+//
+// Fields are matched by their null struct tag, or else by field name. Fields tagged with "-" and unexported fields are skipped.
+// Fields are null if their IsZero method returns true, which for the zero package includes zero values,
+// and fields of other types if they are the zero value, such as a nil pointer.
+// Fields are copied as is if they have the same type. Otherwise, Optional fields are converted to T, *T, Value[T],
+// or types with a SetValid(T) method, with null becoming the zero value,
+// and other fields like FromPointers, or like ToPointers if the destination field has no SetValid method,
+// so a *string or String patch field can set a String, Optional[string], or string field.
+// Pointers are dereferenced to set fields of the type they point to.
+func Merge(dst, patch interface{}, mode MergeMode) error {
+	return merge("Merge", dst, patch, func(v reflect.Value) bool {
+		return mergeable(v, mode)
+	})
+}
+
+// merge copies the fields of patch for which include returns true to the matching fields of dst, for Merge and ApplyPatch.
+func merge(caller string, dst, patch interface{}, include func(reflect.Value) bool) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("null: %s needs a pointer to a struct, got %T", caller, dst)
+	}
+	dv = dv.Elem()
+	pv := reflect.Indirect(reflect.ValueOf(patch))
+	if pv.Kind() != reflect.Struct {
+		return fmt.Errorf("null: %s needs a struct patch, got %T", caller, patch)
+	}
+
+	dstFields := make(map[string][]int)
+	for _, f := range structFields(dv.Type(), "null") {
+		dstFields[f.name] = f.index
+	}
+	for _, f := range structFields(pv.Type(), "null") {
+		v := pv.FieldByIndex(f.index)
+		if !include(v) {
+			continue
+		}
+		index, ok := dstFields[f.name]
+		if !ok {
+			return fmt.Errorf("null: %s destination has no field %s", caller, f.name)
+		}
+		if err := mergeField(dv.FieldByIndex(index), v); err != nil {
+			return fmt.Errorf("null: %s couldn't copy field %s: %w", caller, f.name, err)
+		}
+	}
+	return nil
+}
+
+// mergeable reports whether Merge copies v, a field of a patch.
+func mergeable(v reflect.Value, mode MergeMode) bool {
+	switch x := v.Interface().(type) {
+	case optionalField:
+		return x.isPresent() && (mode == MergePresent || !x.IsNull())
+	case interface{ IsZero() bool }:
+		return !x.IsZero()
+	}
+	return !v.IsZero()
+}
+
+// mergeField sets field to v, converting it if needed.
+func mergeField(field, v reflect.Value) error {
+	if v.Type().AssignableTo(field.Type()) {
+		field.Set(v)
+		return nil
+	}
+	if v.Kind() == reflect.Pointer && v.Type().Elem().AssignableTo(field.Type()) {
+		field.Set(v.Elem())
+		return nil
+	}
+	if opt, ok := v.Interface().(optionalField); ok {
+		return opt.applyTo(field)
+	}
+	if set := field.Addr().MethodByName("SetValid"); set.IsValid() {
+		return fromPointer(field, v)
+	}
+	return toPointer(field, v)
+}
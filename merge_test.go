@@ -0,0 +1,87 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+)
+
+type mergeModel struct {
+	Name  String
+	Count Uint
+	Note  String
+	Email string
+	Tags  Slice[string]
+}
+
+type mergePatch struct {
+	Name  Optional[string]
+	Count Uint
+	Note  Optional[string]
+	Email *string `null:"Email"`
+	Tags  Slice[string]
+}
+
+func TestMerge(t *testing.T) {
+	original := mergeModel{Name: StringFrom("old"), Count: UintFrom(1), Note: StringFrom("note"), Email: "old@example.com", Tags: SliceFrom([]string{"a"})}
+	email := "new@example.com"
+	patch := mergePatch{Name: OptionalFrom("new"), Note: OptionalNull[string](), Email: &email}
+
+	dst := original
+	if err := Merge(&dst, patch, MergeValid); err != nil {
+		t.Fatal(err)
+	}
+	want := original
+	want.Name, want.Email = StringFrom("new"), email
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("bad MergeValid:\n%#v\nwant\n%#v", dst, want)
+	}
+
+	dst = original
+	if err := Merge(&dst, &patch, MergePresent); err != nil {
+		t.Fatal(err)
+	}
+	want.Note = String{}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("bad MergePresent:\n%#v\nwant\n%#v", dst, want)
+	}
+
+	dst = original
+	if err := Merge(&dst, mergeModel{Count: UintFrom(2), Email: "x@example.com"}, MergeValid); err != nil {
+		t.Fatal(err)
+	}
+	want = original
+	want.Count, want.Email = UintFrom(2), "x@example.com"
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("bad Merge of same type:\n%#v\nwant\n%#v", dst, want)
+	}
+
+	if err := Merge(&dst, struct{ Missing Int }{IntFrom(1)}, MergeValid); err == nil {
+		t.Error("expected error for missing field")
+	}
+	if err := Merge(dst, patch, MergeValid); err == nil {
+		t.Error("expected error for non-pointer dst")
+	}
+}
+
+func TestMergeApplyPatch(t *testing.T) {
+	type tagged struct {
+		Nickname Optional[string] `null:"Note"`
+		Count    Uint
+	}
+	patch := tagged{Nickname: OptionalNull[string](), Count: UintFrom(3)}
+	original := mergeModel{Name: StringFrom("a"), Note: StringFrom("b")}
+
+	merged, applied := original, original
+	if err := Merge(&merged, patch, MergePresent); err != nil {
+		t.Fatal(err)
+	}
+	if err := ApplyPatch(&applied, patch); err != nil {
+		t.Fatal(err)
+	}
+	if merged.Note.Valid || applied.Note.Valid {
+		t.Errorf("tagged Optional should clear Note: %#v, %#v", merged, applied)
+	}
+	if !merged.Count.Equal(UintFrom(3)) || applied.Count.Valid {
+		t.Errorf("only Merge should copy non-Optional fields: %#v, %#v", merged, applied)
+	}
+}
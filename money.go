@@ -0,0 +1,339 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// MoneyMinorUnits maps ISO 4217 currency codes to their number of minor unit digits,
+// for currencies that don't use 2. It is used to format and parse Money amounts.
+// Entries may be added for other currencies.
+var MoneyMinorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// NullMoney represents an amount of money in a currency that may be null.
+// NullMoney implements the Scanner interface so
+// it can be used as a scan destination for composite values such as "(1234,USD)".
+type NullMoney struct {
+	// Amount is in the currency's minor units, such as cents.
+	Amount int64
+	// Currency is an ISO 4217 currency code, such as "USD".
+	Currency string
+	Valid    bool // Valid is true if Amount and Currency are not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports composite values of the amount in minor units and the currency, such as "(1234,USD)",
+// as returned by PostgreSQL for a composite type of (bigint, char(3)).
+// To store Money in two columns, see Money.Split and MoneyFromColumns.
+func (n *NullMoney) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var err error
+	switch v := value.(type) {
+	case nil:
+		n.Amount, n.Currency, n.Valid = 0, "", false
+		return nil
+	case []byte:
+		n.Amount, n.Currency, err = parseMoneyComposite(string(v))
+	case string:
+		n.Amount, n.Currency, err = parseMoneyComposite(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Money: %v", value, value)
+	}
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan Money: %w", err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as composite strings, such as "(1234,USD)".
+func (n NullMoney) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return "(" + strconv.FormatInt(n.Amount, 10) + "," + n.Currency + ")", nil
+}
+
+// IsZero returns true for invalid NullMoneys.
+func (n NullMoney) IsZero() bool {
+	return !n.Valid
+}
+
+// Money is a nullable amount of money in a particular currency.
+// It does not consider zero amounts to be null.
+// It will decode to null, not zero, if null.
+type Money struct {
+	NullMoney
+}
+
+// NewMoney creates a new Money from an amount in minor units and a currency code.
+func NewMoney(amount int64, currency string, valid bool) Money {
+	return Money{
+		NullMoney: NullMoney{
+			Amount:   amount,
+			Currency: currency,
+			Valid:    valid,
+		},
+	}
+}
+
+// MoneyFrom creates a new Money that will always be valid.
+// The amount is in the currency's minor units, such as cents.
+func MoneyFrom(amount int64, currency string) Money {
+	return NewMoney(amount, currency, true)
+}
+
+// ParseMoney creates a new Money from a decimal amount such as "12.34" and a currency code.
+// It returns an error if the currency isn't an active ISO 4217 code in upper case, such as "XYZ",
+// or if the amount has more decimal places than the currency allows.
+func ParseMoney(amount, currency string) (Money, error) {
+	if err := checkCurrency(currency); err != nil {
+		return Money{}, fmt.Errorf("null: couldn't parse Money: %w", err)
+	}
+	minor, err := parseMoneyAmount(amount, currency)
+	if err != nil {
+		return Money{}, fmt.Errorf("null: couldn't parse Money: %w", err)
+	}
+	return MoneyFrom(minor, currency), nil
+}
+
+// MoneyFromColumns creates a new Money from an amount and currency stored in separate columns.
+// It will be null if either is null.
+func MoneyFromColumns(amount Int, currency String) Money {
+	return NewMoney(amount.Int64, currency.String, amount.Valid && currency.Valid)
+}
+
+// Split returns the amount in minor units and the currency code as separate values,
+// for storing in two columns. Both will be null if this Money is null.
+func (m Money) Split() (Int, String) {
+	return NewInt(m.Amount, m.Valid), NewString(m.Currency, m.Valid)
+}
+
+type moneyJSON struct {
+	Amount   json.Number `json:"amount"`
+	Currency string      `json:"currency"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports {"amount":"12.34","currency":"USD"} objects and null input.
+// The amount may be a string or a number.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		m.Valid = false
+		return nil
+	}
+
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	parsed, err := ParseMoney(string(v.Amount), v.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Money is null,
+// otherwise an object such as {"amount":"12.34","currency":"USD"}.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if !m.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{
+		Amount:   m.AmountString(),
+		Currency: m.Currency,
+	})
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Money if the input is blank, "null", or DefaultNullText.
+// It supports an amount followed by a currency, such as "12.34 USD".
+func (m *Money) UnmarshalText(text []byte) error {
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		m.Valid = false
+		return nil
+	}
+	amount, currency, ok := strings.Cut(str, " ")
+	if !ok {
+		return fmt.Errorf("null: couldn't unmarshal text: missing currency: %q", str)
+	}
+	parsed, err := ParseMoney(amount, currency)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	*m = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Money is null,
+// otherwise the amount followed by the currency, such as "12.34 USD".
+func (m Money) MarshalText() ([]byte, error) {
+	if !m.Valid {
+		return nullText(), nil
+	}
+	return []byte(m.AmountString() + " " + m.Currency), nil
+}
+
+// AmountString returns this Money's amount as a decimal string, such as "12.34",
+// using the number of minor unit digits of its currency.
+// It returns an empty string if this Money is null.
+func (m Money) AmountString() string {
+	if !m.Valid {
+		return ""
+	}
+	return formatMoneyAmount(m.Amount, currencyDigits(m.Currency))
+}
+
+// SetValid changes this Money's value and also sets it to be non-null.
+func (m *Money) SetValid(amount int64, currency string) {
+	m.Amount = amount
+	m.Currency = currency
+	m.Valid = true
+}
+
+// SetNull sets this Money to null, leaving its value as is. Use Reset to clear the value too.
+func (m *Money) SetNull() {
+	m.Valid = false
+}
+
+// Reset sets this Money to null and clears its value.
+func (m *Money) Reset() {
+	*m = Money{}
+}
+
+// IsZero returns true for invalid Money.
+// A non-null Money with a 0 amount will not be considered zero.
+func (m Money) IsZero() bool {
+	return !m.Valid
+}
+
+// IsZeroOrNull returns true for invalid Moneys, or valid Moneys with a 0 amount.
+func (m Money) IsZeroOrNull() bool {
+	return !m.Valid || m.Amount == 0
+}
+
+// Equal returns true if both Money have the same amount and currency or are both null.
+func (m Money) Equal(other Money) bool {
+	return m.Valid == other.Valid && (!m.Valid || (m.Amount == other.Amount && m.Currency == other.Currency))
+}
+
+func currencyDigits(currency string) int {
+	if digits, ok := MoneyMinorUnits[currency]; ok {
+		return digits
+	}
+	return 2
+}
+
+// checkCurrency returns an error if currency isn't an active ISO 4217 code in upper case, as listed for CurrencyCode.
+func checkCurrency(currency string) error {
+	if _, ok := currencyCodes[currency]; !ok {
+		return fmt.Errorf("invalid currency code: %q", currency)
+	}
+	return nil
+}
+
+// parseMoneyAmount parses a decimal amount such as "-12.34" into minor units of currency.
+func parseMoneyAmount(str, currency string) (int64, error) {
+	digits := currencyDigits(currency)
+	whole, frac, _ := strings.Cut(str, ".")
+	if len(frac) > digits {
+		return 0, fmt.Errorf("amount %q has more than %d decimal places for %s", str, digits, currency)
+	}
+	neg := strings.HasPrefix(whole, "-")
+	if neg || strings.HasPrefix(whole, "+") {
+		whole = whole[1:]
+	}
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("invalid amount: %q", str)
+	}
+	if !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("invalid amount: %q", str)
+	}
+	frac += strings.Repeat("0", digits-len(frac))
+	n, err := strconv.ParseUint(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount: %w", err)
+	}
+	if neg {
+		if n > math.MaxInt64+1 {
+			return 0, fmt.Errorf("amount out of range: %q", str)
+		}
+		return int64(-n), nil
+	}
+	if n > math.MaxInt64 {
+		return 0, fmt.Errorf("amount out of range: %q", str)
+	}
+	return int64(n), nil
+}
+
+func isDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func formatMoneyAmount(minor int64, digits int) string {
+	var sign string
+	abs := uint64(minor)
+	if minor < 0 {
+		sign = "-"
+		abs = -abs
+	}
+	str := strconv.FormatUint(abs, 10)
+	if digits == 0 {
+		return sign + str
+	}
+	if len(str) <= digits {
+		str = strings.Repeat("0", digits-len(str)+1) + str
+	}
+	return sign + str[:len(str)-digits] + "." + str[len(str)-digits:]
+}
+
+// parseMoneyComposite parses a composite value such as "(1234,USD)".
+func parseMoneyComposite(str string) (int64, string, error) {
+	if !strings.HasPrefix(str, "(") || !strings.HasSuffix(str, ")") {
+		return 0, "", fmt.Errorf("invalid composite value: %q", str)
+	}
+	amount, currency, ok := strings.Cut(str[1:len(str)-1], ",")
+	if !ok {
+		return 0, "", fmt.Errorf("invalid composite value: %q", str)
+	}
+	if amount == "" || currency == "" {
+		return 0, "", errors.New("composite value has null fields")
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(amount), 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+	currency = strings.Trim(strings.TrimSpace(currency), `"`)
+	if err := checkCurrency(currency); err != nil {
+		return 0, "", err
+	}
+	return n, currency, nil
+}
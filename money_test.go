@@ -0,0 +1,248 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+var (
+	moneyJSONValue  = []byte(`{"amount":"12.34","currency":"USD"}`)
+	moneyNumberJSON = []byte(`{"amount":12.34,"currency":"USD"}`)
+)
+
+func TestParseMoney(t *testing.T) {
+	table := []struct {
+		amount   string
+		currency string
+		minor    int64
+	}{
+		{"12.34", "USD", 1234},
+		{"12.3", "USD", 1230},
+		{"12", "USD", 1200},
+		{"-0.05", "USD", -5},
+		{".5", "EUR", 50},
+		{"+7", "EUR", 700},
+		{"1234", "JPY", 1234},
+		{"1.234", "KWD", 1234},
+		{"-92233720368547758.08", "USD", math.MinInt64},
+		{"92233720368547758.07", "USD", math.MaxInt64},
+	}
+	for _, tc := range table {
+		m, err := ParseMoney(tc.amount, tc.currency)
+		if err != nil {
+			t.Errorf("ParseMoney(%q, %q): %v", tc.amount, tc.currency, err)
+			continue
+		}
+		if m.Amount != tc.minor || m.Currency != tc.currency || !m.Valid {
+			t.Errorf("ParseMoney(%q, %q) = %v, want %d", tc.amount, tc.currency, m, tc.minor)
+		}
+	}
+
+	for _, bad := range [][2]string{
+		{"12.345", "USD"},
+		{"12.3", "JPY"},
+		{"12,34", "USD"},
+		{"", "USD"},
+		{"-", "USD"},
+		{"12.34", "usd"},
+		{"12.34", "US"},
+		{"12.34", "XYZ"},
+		{"12.34", "ABC"},
+		{"92233720368547758.08", "USD"},
+	} {
+		if _, err := ParseMoney(bad[0], bad[1]); err == nil {
+			t.Errorf("ParseMoney(%q, %q): expected error", bad[0], bad[1])
+		}
+	}
+}
+
+func TestMoneyAmountString(t *testing.T) {
+	table := []struct {
+		m    Money
+		want string
+	}{
+		{MoneyFrom(1234, "USD"), "12.34"},
+		{MoneyFrom(5, "USD"), "0.05"},
+		{MoneyFrom(-5, "USD"), "-0.05"},
+		{MoneyFrom(0, "USD"), "0.00"},
+		{MoneyFrom(1234, "JPY"), "1234"},
+		{MoneyFrom(1234, "BHD"), "1.234"},
+		{MoneyFrom(math.MinInt64, "USD"), "-92233720368547758.08"},
+		{NewMoney(1234, "USD", false), ""},
+	}
+	for _, tc := range table {
+		if got := tc.m.AmountString(); got != tc.want {
+			t.Errorf("AmountString() of %v = %q, want %q", tc.m, got, tc.want)
+		}
+	}
+}
+
+func TestUnmarshalMoney(t *testing.T) {
+	var m Money
+	err := json.Unmarshal(moneyJSONValue, &m)
+	maybePanic(err)
+	assertMoney(t, m, "money json")
+
+	var num Money
+	err = json.Unmarshal(moneyNumberJSON, &num)
+	maybePanic(err)
+	assertMoney(t, num, "money number json")
+
+	var null Money
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullMoney(t, null, "null json")
+
+	var badCurrency Money
+	err = json.Unmarshal([]byte(`{"amount":"12.34","currency":"dollars"}`), &badCurrency)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullMoney(t, badCurrency, "bad currency json")
+
+	var badAmount Money
+	err = json.Unmarshal([]byte(`{"amount":"twelve","currency":"USD"}`), &badAmount)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullMoney(t, badAmount, "bad amount json")
+
+	var badType Money
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullMoney(t, badType, "wrong type json")
+}
+
+func TestMarshalMoney(t *testing.T) {
+	m := MoneyFrom(1234, "USD")
+	data, err := json.Marshal(m)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(moneyJSONValue), "non-empty json marshal")
+
+	null := NewMoney(0, "", false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMoneyText(t *testing.T) {
+	m := MoneyFrom(1234, "USD")
+	data, err := m.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12.34 USD", "non-empty text marshal")
+
+	var text Money
+	err = text.UnmarshalText([]byte("12.34 USD"))
+	maybePanic(err)
+	assertMoney(t, text, "UnmarshalText() money")
+
+	null := NewMoney(0, "", false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var blank Money
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullMoney(t, blank, "UnmarshalText() empty money")
+
+	var invalid Money
+	err = invalid.UnmarshalText([]byte("12.34"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMoneyScanValue(t *testing.T) {
+	var m Money
+	err := m.Scan("(1234,USD)")
+	maybePanic(err)
+	assertMoney(t, m, "scanned composite")
+
+	var quoted Money
+	err = quoted.Scan([]byte(`(1234,"USD")`))
+	maybePanic(err)
+	assertMoney(t, quoted, "scanned quoted composite")
+
+	var null Money
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullMoney(t, null, "scanned null")
+
+	for _, bad := range []interface{}{"1234,USD", "(,USD)", "(12.34,USD)", "(1234,usd)", "(1234,XYZ)", int64(1234)} {
+		var invalid Money
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("Scan(%#v): expected error", bad)
+		}
+		assertNullMoney(t, invalid, "scanned invalid")
+	}
+
+	v, err := m.Value()
+	maybePanic(err)
+	if v != "(1234,USD)" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestMoneyColumns(t *testing.T) {
+	amount, currency := MoneyFrom(1234, "USD").Split()
+	if amount != IntFrom(1234) || currency != StringFrom("USD") {
+		t.Errorf("bad Split(): %v %v", amount, currency)
+	}
+	assertMoney(t, MoneyFromColumns(amount, currency), "MoneyFromColumns()")
+
+	amount, currency = NewMoney(1234, "USD", false).Split()
+	if amount.Valid || currency.Valid {
+		t.Error("Split() of null Money should be null")
+	}
+	assertNullMoney(t, MoneyFromColumns(IntFrom(1234), String{}), "MoneyFromColumns() with null currency")
+}
+
+func TestMoneyIsZeroEqual(t *testing.T) {
+	null := NewMoney(0, "", false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	zero := MoneyFrom(0, "USD")
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	if !MoneyFrom(1234, "USD").Equal(MoneyFrom(1234, "USD")) {
+		t.Error("same Money should be equal")
+	}
+	if MoneyFrom(1234, "USD").Equal(MoneyFrom(1234, "EUR")) {
+		t.Error("Money in different currencies should not be equal")
+	}
+	if !null.Equal(NewMoney(5, "EUR", false)) {
+		t.Error("null Money should be equal")
+	}
+
+	null.SetValid(1234, "USD")
+	assertMoney(t, null, "SetValid()")
+}
+
+func assertMoney(t *testing.T, m Money, from string) {
+	t.Helper()
+	if m.Amount != 1234 || m.Currency != "USD" {
+		t.Errorf("bad %s money: %d %s ≠ %d %s\n", from, m.Amount, m.Currency, 1234, "USD")
+	}
+	if !m.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullMoney(t *testing.T, m Money, from string) {
+	t.Helper()
+	if m.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,54 @@
+//go:build mysql
+
+package null
+
+import (
+	"database/sql"
+	"math"
+	"os"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// TestMySQLUnsigned round trips Uint through a BIGINT UNSIGNED column.
+// It needs a MySQL server: set NULL_MYSQL_DSN to a go-sql-driver/mysql DSN, such as "root@tcp(localhost:3306)/test".
+func TestMySQLUnsigned(t *testing.T) {
+	dsn := os.Getenv("NULL_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("NULL_MYSQL_DSN not set")
+	}
+	defer func() { DefaultUintValueFormat = UintValueUint64 }()
+
+	for _, interpolate := range []string{"false", "true"} {
+		db, err := sql.Open("mysql", dsn+"?interpolateParams="+interpolate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		// temporary tables belong to a single connection
+		db.SetMaxOpenConns(1)
+		if _, err := db.Exec("CREATE TEMPORARY TABLE null_uint (id INT PRIMARY KEY, v BIGINT UNSIGNED NULL)"); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, format := range []UintValueFormat{UintValueUint64, UintValueString, UintValueInt64OrString} {
+			DefaultUintValueFormat = format
+			rows := []Uint{UintFrom(math.MaxUint64), UintFrom(math.MaxInt64 + 1), UintFrom(0), {}}
+			for id, v := range rows {
+				if _, err := db.Exec("REPLACE INTO null_uint (id, v) VALUES (?, ?)", id, v); err != nil {
+					t.Fatalf("interpolate %s, format %d: insert %v: %v", interpolate, format, v, err)
+				}
+			}
+			for id, want := range rows {
+				var got Uint
+				if err := db.QueryRow("SELECT v FROM null_uint WHERE id = ?", id).Scan(&got); err != nil {
+					t.Fatalf("interpolate %s, format %d: select: %v", interpolate, format, err)
+				}
+				if !got.Equal(want) {
+					t.Errorf("interpolate %s, format %d: got %v, want %v", interpolate, format, got, want)
+				}
+			}
+		}
+	}
+}
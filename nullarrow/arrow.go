@@ -0,0 +1,610 @@
+// Package nullarrow converts between slices of null types and Apache Arrow arrays using github.com/apache/arrow-go.
+// Null values become entries in the array's validity bitmap, so no pointers are involved either way.
+// It lives in its own package so that the null package stays free of dependencies.
+//
+// Each type maps to the Arrow type closest to its SQL value (see Value):
+// null.Int as int64, null.Uint8 as uint8, null.Float as float64, null.String as utf8, null.Bytes as binary,
+// null.Time as a UTC timestamp with microsecond precision, null.Date as date32, and null.Duration as a nanosecond duration.
+// The zero types map to the same Arrow types as their null counterparts, with invalid values stored as null.
+//
+// Functions such as FromInts and Ints convert a single column.
+// NewRecordBatch and FromRecordBatch convert a slice of structs, such as rows scanned from a database,
+// with one column for each exported field.
+package nullarrow
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+// TimestampType is the Arrow type used for null.Time and zero.Time.
+// When reading, timestamps of any unit are accepted.
+var TimestampType = &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+
+// DurationType is the Arrow type used for null.Duration.
+// When reading, durations of any unit are accepted.
+var DurationType = &arrow.DurationType{Unit: arrow.Nanosecond}
+
+// FromInts builds an int64 array from col.
+func FromInts(mem memory.Allocator, col []null.Int) *array.Int64 {
+	b := array.NewInt64Builder(mem)
+	defer b.Release()
+	build(b, col, appendInt)
+	return b.NewInt64Array()
+}
+
+// Ints returns the values of arr.
+func Ints(arr *array.Int64) []null.Int {
+	return read(arr, intAt)
+}
+
+// FromInt8s builds an int8 array from col.
+func FromInt8s(mem memory.Allocator, col []null.Int8) *array.Int8 {
+	b := array.NewInt8Builder(mem)
+	defer b.Release()
+	build(b, col, appendInt8)
+	return b.NewInt8Array()
+}
+
+// Int8s returns the values of arr.
+func Int8s(arr *array.Int8) []null.Int8 {
+	return read(arr, int8At)
+}
+
+// FromInt16s builds an int16 array from col.
+func FromInt16s(mem memory.Allocator, col []null.Int16) *array.Int16 {
+	b := array.NewInt16Builder(mem)
+	defer b.Release()
+	build(b, col, appendInt16)
+	return b.NewInt16Array()
+}
+
+// Int16s returns the values of arr.
+func Int16s(arr *array.Int16) []null.Int16 {
+	return read(arr, int16At)
+}
+
+// FromInt32s builds an int32 array from col.
+func FromInt32s(mem memory.Allocator, col []null.Int32) *array.Int32 {
+	b := array.NewInt32Builder(mem)
+	defer b.Release()
+	build(b, col, appendInt32)
+	return b.NewInt32Array()
+}
+
+// Int32s returns the values of arr.
+func Int32s(arr *array.Int32) []null.Int32 {
+	return read(arr, int32At)
+}
+
+// FromUints builds a uint64 array from col.
+func FromUints(mem memory.Allocator, col []null.Uint) *array.Uint64 {
+	b := array.NewUint64Builder(mem)
+	defer b.Release()
+	build(b, col, appendUint)
+	return b.NewUint64Array()
+}
+
+// Uints returns the values of arr.
+func Uints(arr *array.Uint64) []null.Uint {
+	return read(arr, uintAt)
+}
+
+// FromUint8s builds a uint8 array from col.
+func FromUint8s(mem memory.Allocator, col []null.Uint8) *array.Uint8 {
+	b := array.NewUint8Builder(mem)
+	defer b.Release()
+	build(b, col, appendUint8)
+	return b.NewUint8Array()
+}
+
+// Uint8s returns the values of arr.
+func Uint8s(arr *array.Uint8) []null.Uint8 {
+	return read(arr, uint8At)
+}
+
+// FromUint16s builds a uint16 array from col.
+func FromUint16s(mem memory.Allocator, col []null.Uint16) *array.Uint16 {
+	b := array.NewUint16Builder(mem)
+	defer b.Release()
+	build(b, col, appendUint16)
+	return b.NewUint16Array()
+}
+
+// Uint16s returns the values of arr.
+func Uint16s(arr *array.Uint16) []null.Uint16 {
+	return read(arr, uint16At)
+}
+
+// FromUint32s builds a uint32 array from col.
+func FromUint32s(mem memory.Allocator, col []null.Uint32) *array.Uint32 {
+	b := array.NewUint32Builder(mem)
+	defer b.Release()
+	build(b, col, appendUint32)
+	return b.NewUint32Array()
+}
+
+// Uint32s returns the values of arr.
+func Uint32s(arr *array.Uint32) []null.Uint32 {
+	return read(arr, uint32At)
+}
+
+// FromFloats builds a float64 array from col.
+func FromFloats(mem memory.Allocator, col []null.Float) *array.Float64 {
+	b := array.NewFloat64Builder(mem)
+	defer b.Release()
+	build(b, col, appendFloat)
+	return b.NewFloat64Array()
+}
+
+// Floats returns the values of arr.
+func Floats(arr *array.Float64) []null.Float {
+	return read(arr, floatAt)
+}
+
+// FromBools builds a boolean array from col.
+func FromBools(mem memory.Allocator, col []null.Bool) *array.Boolean {
+	b := array.NewBooleanBuilder(mem)
+	defer b.Release()
+	build(b, col, appendBool)
+	return b.NewBooleanArray()
+}
+
+// Bools returns the values of arr.
+func Bools(arr *array.Boolean) []null.Bool {
+	return read(arr, boolAt)
+}
+
+// FromStrings builds a utf8 array from col.
+func FromStrings(mem memory.Allocator, col []null.String) *array.String {
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+	build(b, col, appendString)
+	return b.NewStringArray()
+}
+
+// Strings returns the values of arr.
+// They are copied, so they remain valid after arr is released.
+func Strings(arr *array.String) []null.String {
+	return read(arr, stringAt)
+}
+
+// FromBytes builds a binary array from col.
+func FromBytes(mem memory.Allocator, col []null.Bytes) *array.Binary {
+	b := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+	defer b.Release()
+	build(b, col, appendBytes)
+	return b.NewBinaryArray()
+}
+
+// Bytes returns the values of arr.
+// They are copied, so they remain valid after arr is released.
+func Bytes(arr *array.Binary) []null.Bytes {
+	return read(arr, bytesAt)
+}
+
+// FromTimes builds a timestamp array of TimestampType from col.
+func FromTimes(mem memory.Allocator, col []null.Time) *array.Timestamp {
+	b := array.NewTimestampBuilder(mem, TimestampType)
+	defer b.Release()
+	build(b, col, appendTime)
+	return b.NewTimestampArray()
+}
+
+// Times returns the values of arr, in UTC.
+func Times(arr *array.Timestamp) []null.Time {
+	return read(arr, timeAt)
+}
+
+// FromDates builds a date32 array from col.
+func FromDates(mem memory.Allocator, col []null.Date) *array.Date32 {
+	b := array.NewDate32Builder(mem)
+	defer b.Release()
+	build(b, col, appendDate)
+	return b.NewDate32Array()
+}
+
+// Dates returns the values of arr.
+func Dates(arr *array.Date32) []null.Date {
+	return read(arr, dateAt)
+}
+
+// FromDurations builds a duration array of DurationType from col.
+func FromDurations(mem memory.Allocator, col []null.Duration) *array.Duration {
+	b := array.NewDurationBuilder(mem, DurationType)
+	defer b.Release()
+	build(b, col, appendDuration)
+	return b.NewDurationArray()
+}
+
+// Durations returns the values of arr.
+func Durations(arr *array.Duration) []null.Duration {
+	return read(arr, durationAt)
+}
+
+func build[B array.Builder, T any](b B, col []T, add func(B, T)) {
+	b.Reserve(len(col))
+	for _, v := range col {
+		add(b, v)
+	}
+}
+
+func read[A arrow.Array, T any](arr A, at func(A, int) T) []T {
+	col := make([]T, arr.Len())
+	for i := range col {
+		if arr.IsValid(i) {
+			col[i] = at(arr, i)
+		}
+	}
+	return col
+}
+
+func appendInt(b *array.Int64Builder, v null.Int) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Int64)
+}
+
+func intAt(arr *array.Int64, i int) null.Int {
+	return null.IntFrom(arr.Value(i))
+}
+
+func appendInt8(b *array.Int8Builder, v null.Int8) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Int8)
+}
+
+func int8At(arr *array.Int8, i int) null.Int8 {
+	return null.Int8From(arr.Value(i))
+}
+
+func appendInt16(b *array.Int16Builder, v null.Int16) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Int16)
+}
+
+func int16At(arr *array.Int16, i int) null.Int16 {
+	return null.Int16From(arr.Value(i))
+}
+
+func appendInt32(b *array.Int32Builder, v null.Int32) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Int32)
+}
+
+func int32At(arr *array.Int32, i int) null.Int32 {
+	return null.Int32From(arr.Value(i))
+}
+
+func appendUint(b *array.Uint64Builder, v null.Uint) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Uint64)
+}
+
+func uintAt(arr *array.Uint64, i int) null.Uint {
+	return null.UintFrom(arr.Value(i))
+}
+
+func appendUint8(b *array.Uint8Builder, v null.Uint8) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Uint8)
+}
+
+func uint8At(arr *array.Uint8, i int) null.Uint8 {
+	return null.Uint8From(arr.Value(i))
+}
+
+func appendUint16(b *array.Uint16Builder, v null.Uint16) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Uint16)
+}
+
+func uint16At(arr *array.Uint16, i int) null.Uint16 {
+	return null.Uint16From(arr.Value(i))
+}
+
+func appendUint32(b *array.Uint32Builder, v null.Uint32) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Uint32)
+}
+
+func uint32At(arr *array.Uint32, i int) null.Uint32 {
+	return null.Uint32From(arr.Value(i))
+}
+
+func appendFloat(b *array.Float64Builder, v null.Float) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Float64)
+}
+
+func floatAt(arr *array.Float64, i int) null.Float {
+	return null.FloatFrom(arr.Value(i))
+}
+
+func appendBool(b *array.BooleanBuilder, v null.Bool) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Bool)
+}
+
+func boolAt(arr *array.Boolean, i int) null.Bool {
+	return null.BoolFrom(arr.Value(i))
+}
+
+func appendString(b *array.StringBuilder, v null.String) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.String)
+}
+
+func stringAt(arr *array.String, i int) null.String {
+	// Value points into the array's buffer
+	return null.StringFrom(strings.Clone(arr.Value(i)))
+}
+
+func appendBytes(b *array.BinaryBuilder, v null.Bytes) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(v.Bytes)
+}
+
+func bytesAt(arr *array.Binary, i int) null.Bytes {
+	return null.BytesFrom(append([]byte{}, arr.Value(i)...))
+}
+
+func appendTime(b *array.TimestampBuilder, v null.Time) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.AppendTime(v.Time)
+}
+
+func timeAt(arr *array.Timestamp, i int) null.Time {
+	unit := arr.DataType().(*arrow.TimestampType).Unit
+	return null.TimeFrom(arr.Value(i).ToTime(unit))
+}
+
+func appendDate(b *array.Date32Builder, v null.Date) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(arrow.Date32FromTime(time.Date(v.Year, v.Month, v.Day, 0, 0, 0, 0, time.UTC)))
+}
+
+func dateAt(arr *array.Date32, i int) null.Date {
+	return null.DateFrom(arr.Value(i).ToTime())
+}
+
+func appendDuration(b *array.DurationBuilder, v null.Duration) {
+	if !v.Valid {
+		b.AppendNull()
+		return
+	}
+	b.Append(arrow.Duration(v.Duration))
+}
+
+func durationAt(arr *array.Duration, i int) null.Duration {
+	unit := arr.DataType().(*arrow.DurationType).Unit
+	return null.DurationFrom(time.Duration(arr.Value(i)) * unit.Multiplier())
+}
+
+// column converts one struct field.
+type column struct {
+	dataType arrow.DataType
+	// append appends the field pointed to by ptr
+	append func(b array.Builder, ptr any)
+	// set sets the field pointed to by ptr to value i of arr
+	set func(arr arrow.Array, i int, ptr any)
+}
+
+var columns = make(map[reflect.Type]column)
+
+func init() {
+	register(arrow.PrimitiveTypes.Int64, appendInt, intAt)
+	register(arrow.PrimitiveTypes.Int8, appendInt8, int8At)
+	register(arrow.PrimitiveTypes.Int16, appendInt16, int16At)
+	register(arrow.PrimitiveTypes.Int32, appendInt32, int32At)
+	register(arrow.PrimitiveTypes.Uint64, appendUint, uintAt)
+	register(arrow.PrimitiveTypes.Uint8, appendUint8, uint8At)
+	register(arrow.PrimitiveTypes.Uint16, appendUint16, uint16At)
+	register(arrow.PrimitiveTypes.Uint32, appendUint32, uint32At)
+	register(arrow.PrimitiveTypes.Float64, appendFloat, floatAt)
+	register(arrow.FixedWidthTypes.Boolean, appendBool, boolAt)
+	register(arrow.BinaryTypes.String, appendString, stringAt)
+	register(arrow.BinaryTypes.Binary, appendBytes, bytesAt)
+	register(TimestampType, appendTime, timeAt)
+	register(arrow.FixedWidthTypes.Date32, appendDate, dateAt)
+	register(DurationType, appendDuration, durationAt)
+
+	register(arrow.PrimitiveTypes.Int64, func(b *array.Int64Builder, v zero.Int) {
+		appendInt(b, null.Int{NullInt64: v.NullInt64})
+	}, func(arr *array.Int64, i int) zero.Int {
+		return zero.Int{NullInt64: intAt(arr, i).NullInt64}
+	})
+	register(arrow.PrimitiveTypes.Float64, func(b *array.Float64Builder, v zero.Float) {
+		appendFloat(b, null.Float{NullFloat64: v.NullFloat64})
+	}, func(arr *array.Float64, i int) zero.Float {
+		return zero.Float{NullFloat64: floatAt(arr, i).NullFloat64}
+	})
+	register(arrow.FixedWidthTypes.Boolean, func(b *array.BooleanBuilder, v zero.Bool) {
+		appendBool(b, null.Bool{NullBool: v.NullBool})
+	}, func(arr *array.Boolean, i int) zero.Bool {
+		return zero.Bool{NullBool: boolAt(arr, i).NullBool}
+	})
+	register(arrow.BinaryTypes.String, func(b *array.StringBuilder, v zero.String) {
+		appendString(b, null.String{NullString: v.NullString})
+	}, func(arr *array.String, i int) zero.String {
+		return zero.String{NullString: stringAt(arr, i).NullString}
+	})
+	register(TimestampType, func(b *array.TimestampBuilder, v zero.Time) {
+		appendTime(b, null.Time{NullTime: v.NullTime})
+	}, func(arr *array.Timestamp, i int) zero.Time {
+		return zero.Time{NullTime: timeAt(arr, i).NullTime}
+	})
+}
+
+func register[B array.Builder, A arrow.Array, T any](dataType arrow.DataType, add func(B, T), at func(A, int) T) {
+	columns[reflect.TypeOf((*T)(nil)).Elem()] = column{
+		dataType: dataType,
+		append: func(b array.Builder, ptr any) {
+			add(b.(B), *ptr.(*T))
+		},
+		set: func(arr arrow.Array, i int, ptr any) {
+			if arr.IsValid(i) {
+				*ptr.(*T) = at(arr.(A), i)
+			} else {
+				var zero T
+				*ptr.(*T) = zero
+			}
+		},
+	}
+}
+
+type structField struct {
+	index []int
+	column
+}
+
+func fieldsOf(typ reflect.Type) ([]structField, *arrow.Schema, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("nullarrow: %v is not a struct", typ)
+	}
+	var fields []structField
+	var arrowFields []arrow.Field
+	for _, f := range reflect.VisibleFields(typ) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("arrow"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		col, ok := columns[f.Type]
+		if !ok {
+			return nil, nil, fmt.Errorf("nullarrow: unsupported type %v for field %s", f.Type, f.Name)
+		}
+		fields = append(fields, structField{index: f.Index, column: col})
+		arrowFields = append(arrowFields, arrow.Field{Name: name, Type: col.dataType, Nullable: true})
+	}
+	return fields, arrow.NewSchema(arrowFields, nil), nil
+}
+
+// SchemaOf returns the Arrow schema for T, which must be a struct of null types.
+// Each exported field becomes a nullable column,
+// named after the arrow struct tag or the field name if there isn't one.
+// Fields tagged with arrow:"-" are skipped.
+func SchemaOf[T any]() (*arrow.Schema, error) {
+	_, schema, err := fieldsOf(reflect.TypeOf((*T)(nil)).Elem())
+	return schema, err
+}
+
+// NewRecordBatch builds a record batch from rows, with the schema returned by SchemaOf.
+// The caller must release it.
+func NewRecordBatch[T any](mem memory.Allocator, rows []T) (arrow.RecordBatch, error) {
+	fields, schema, err := fieldsOf(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	cols := make([]arrow.Array, len(fields))
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+	rv := reflect.ValueOf(rows)
+	for i, f := range fields {
+		b := array.NewBuilder(mem, f.dataType)
+		b.Reserve(len(rows))
+		for j := range rows {
+			f.append(b, rv.Index(j).FieldByIndex(f.index).Addr().Interface())
+		}
+		cols[i] = b.NewArray()
+		b.Release()
+	}
+	return array.NewRecordBatch(schema, cols, int64(len(rows))), nil
+}
+
+// FromRecordBatch returns the rows of rec, matching columns to the fields of T by name.
+// Fields without a matching column are left null.
+// It returns an error if a column's type doesn't match its field.
+func FromRecordBatch[T any](rec arrow.RecordBatch) ([]T, error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	fields, schema, err := fieldsOf(typ)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]T, rec.NumRows())
+	rv := reflect.ValueOf(rows)
+	for i, f := range fields {
+		name := schema.Field(i).Name
+		idx := rec.Schema().FieldIndices(name)
+		if len(idx) == 0 {
+			continue
+		}
+		arr := rec.Column(idx[0])
+		if !compatible(arr.DataType(), f.dataType) {
+			return nil, fmt.Errorf("nullarrow: can't read %v column %s into %v", arr.DataType(), name, typ.FieldByIndex(f.index).Type)
+		}
+		for j := range rows {
+			f.set(arr, j, rv.Index(j).FieldByIndex(f.index).Addr().Interface())
+		}
+	}
+	return rows, nil
+}
+
+// compatible reports whether arrays of type got can be read as want.
+// Timestamps and durations can have any unit.
+func compatible(got, want arrow.DataType) bool {
+	switch want.(type) {
+	case *arrow.TimestampType, *arrow.DurationType:
+		return got.ID() == want.ID()
+	}
+	return arrow.TypeEqual(got, want)
+}
@@ -0,0 +1,217 @@
+package nullarrow
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+type record struct {
+	Bool     null.Bool
+	Bytes    null.Bytes
+	Date     null.Date
+	Duration null.Duration
+	Float    null.Float
+	Int      null.Int `arrow:"id"`
+	Int8     null.Int8
+	Int16    null.Int16
+	Int32    null.Int32
+	String   null.String
+	Time     null.Time
+	Uint     null.Uint
+	Uint8    null.Uint8
+	Uint16   null.Uint16
+	Uint32   null.Uint32
+	ZFloat   zero.Float
+	ZInt     zero.Int
+	ZString  zero.String
+	ZTime    zero.Time
+	Skipped  null.String `arrow:"-"`
+	ignored  int
+}
+
+func example() record {
+	return record{
+		Bool:     null.BoolFrom(false),
+		Bytes:    null.BytesFrom([]byte{0, 1, 2}),
+		Date:     null.NewDate(1969, time.July, 20, true),
+		Duration: null.DurationFrom(-90 * time.Second),
+		Float:    null.FloatFrom(1.5),
+		Int:      null.IntFrom(-300),
+		Int8:     null.Int8From(-8),
+		Int16:    null.Int16From(-16),
+		Int32:    null.Int32From(-32),
+		String:   null.StringFrom("hello"),
+		Time:     null.TimeFrom(time.Date(2024, 5, 6, 7, 8, 9, 123456000, time.UTC)),
+		Uint:     null.UintFrom(math.MaxUint64),
+		Uint8:    null.Uint8From(200),
+		Uint16:   null.Uint16From(60000),
+		Uint32:   null.Uint32From(math.MaxUint32),
+		ZFloat:   zero.FloatFrom(2.5),
+		ZInt:     zero.IntFrom(7),
+		ZString:  zero.StringFrom("zero"),
+		ZTime:    zero.TimeFrom(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+func TestRecordBatch(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rows := []record{example(), {}, example()}
+	rows[2].Int = null.IntFrom(1)
+	rows[2].ZString = zero.String{}
+
+	rec, err := NewRecordBatch(mem, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rec.Release()
+
+	if rec.NumRows() != 3 || rec.NumCols() != 19 {
+		t.Fatalf("bad shape: %d rows, %d columns", rec.NumRows(), rec.NumCols())
+	}
+	if name := rec.ColumnName(5); name != "id" {
+		t.Errorf("bad column name: %s", name)
+	}
+	for i := range rec.Columns() {
+		if rec.Column(i).NullN() != 1 && !(rec.ColumnName(i) == "ZString" && rec.Column(i).NullN() == 2) {
+			t.Errorf("%s: got %d nulls", rec.ColumnName(i), rec.Column(i).NullN())
+		}
+	}
+
+	got, err := FromRecordBatch[record](rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(rows) {
+		t.Fatalf("got %d rows, want %d", len(got), len(rows))
+	}
+	for i := range rows {
+		assertRecord(t, got[i], rows[i])
+	}
+}
+
+func TestSchemaOf(t *testing.T) {
+	schema, err := SchemaOf[record]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	field, ok := schema.FieldsByName("Time")
+	if !ok || !arrow.TypeEqual(field[0].Type, TimestampType) || !field[0].Nullable {
+		t.Errorf("bad Time field: %v", field)
+	}
+	if _, ok := schema.FieldsByName("Skipped"); ok {
+		t.Error("Skipped should be skipped")
+	}
+
+	if _, err := SchemaOf[struct{ V null.Value[int] }](); err == nil {
+		t.Error("expected error for unsupported type")
+	}
+	if _, err := SchemaOf[int](); err == nil {
+		t.Error("expected error for non-struct")
+	}
+}
+
+func TestArrays(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ints := []null.Int{null.IntFrom(1), {}, null.IntFrom(math.MinInt64)}
+	intArr := FromInts(mem, ints)
+	defer intArr.Release()
+	if intArr.NullN() != 1 || !intArr.IsNull(1) || intArr.Value(2) != math.MinInt64 {
+		t.Errorf("bad int array: %v", intArr)
+	}
+	for i, v := range Ints(intArr) {
+		if !v.Equal(ints[i]) {
+			t.Errorf("Ints[%d]: got %v, want %v", i, v, ints[i])
+		}
+	}
+
+	strs := []null.String{null.StringFrom(""), {}, null.StringFrom("hello")}
+	strArr := FromStrings(mem, strs)
+	gotStrs := Strings(strArr)
+	strArr.Release()
+	for i, v := range gotStrs {
+		if !v.Equal(strs[i]) {
+			t.Errorf("Strings[%d]: got %v, want %v", i, v, strs[i])
+		}
+	}
+}
+
+func TestTimeUnits(t *testing.T) {
+	mem := memory.NewGoAllocator()
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+
+	b := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Nanosecond})
+	defer b.Release()
+	b.AppendTime(now)
+	b.AppendNull()
+	arr := b.NewTimestampArray()
+	defer arr.Release()
+	times := Times(arr)
+	if !times[0].Equal(null.TimeFrom(now)) || times[1].Valid {
+		t.Errorf("bad times: %v", times)
+	}
+
+	db := array.NewDurationBuilder(mem, &arrow.DurationType{Unit: arrow.Millisecond})
+	defer db.Release()
+	db.Append(1500)
+	darr := db.NewDurationArray()
+	defer darr.Release()
+	if got := Durations(darr); !got[0].Equal(null.DurationFrom(1500 * time.Millisecond)) {
+		t.Errorf("bad duration: %v", got)
+	}
+
+	// microsecond precision
+	timeArr := FromTimes(mem, []null.Time{null.TimeFrom(now)})
+	defer timeArr.Release()
+	if got := Times(timeArr)[0]; !got.Time.Equal(now.Truncate(time.Microsecond)) {
+		t.Errorf("bad time: %v", got)
+	}
+}
+
+func TestFromRecordBatchMismatch(t *testing.T) {
+	mem := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.StringBuilder).Append("1")
+	rec := b.NewRecordBatch()
+	defer rec.Release()
+
+	if _, err := FromRecordBatch[record](rec); err == nil {
+		t.Error("expected error for string column into null.Int")
+	}
+
+	// missing columns are left null
+	got, err := FromRecordBatch[struct{ Other null.String }](rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Other.Valid {
+		t.Errorf("bad rows: %v", got)
+	}
+}
+
+func assertRecord(t *testing.T, got, want record) {
+	t.Helper()
+	if !got.Bool.Equal(want.Bool) || !got.Bytes.Equal(want.Bytes) || !got.Date.Equal(want.Date) ||
+		!got.Duration.Equal(want.Duration) || !got.Float.Equal(want.Float) || !got.Int.Equal(want.Int) ||
+		!got.Int8.Equal(want.Int8) || !got.Int16.Equal(want.Int16) || !got.Int32.Equal(want.Int32) ||
+		!got.String.Equal(want.String) || !got.Time.Equal(want.Time) || !got.Uint.Equal(want.Uint) ||
+		!got.Uint8.Equal(want.Uint8) || !got.Uint16.Equal(want.Uint16) || !got.Uint32.Equal(want.Uint32) ||
+		!got.ZFloat.Equal(want.ZFloat) || !got.ZInt.Equal(want.ZInt) || !got.ZString.Equal(want.ZString) ||
+		!got.ZTime.Equal(want.ZTime) {
+		t.Errorf("mismatch:\n got: %+v\nwant: %+v", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+// Package nullcmp provides options for comparing the null, zero, and nulldecimal types with github.com/google/go-cmp.
+// It lives in its own package so that the null package stays free of dependencies.
+//
+// With Options, null values are equal to each other regardless of the value left in them,
+// such as by SetNull, and valid values are compared by value with their Equal methods.
+// NullEqualsZero also considers null values equal to valid zero values, for tests that don't care about the difference:
+//
+//	if diff := cmp.Diff(want, got, nullcmp.NullEqualsZero()); diff != "" {
+//		t.Errorf("mismatch (-want +got):\n%s", diff)
+//	}
+//
+// The two options apply to the same values, so they can't be combined.
+package nullcmp
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// modulePath is the import path of the null package, which the zero and nulldecimal packages are under.
+const modulePath = "gopkg.in/guregu/null.v4"
+
+// Options returns an option that compares the null, zero, and nulldecimal types with their Equal methods,
+// so that null values are equal, valid values are equal if they have the same value, and null and valid values are not equal.
+// The zero types already consider null equal to the zero value.
+func Options() cmp.Option {
+	return cmp.FilterValues(isNullType, cmp.Comparer(equal))
+}
+
+// NullEqualsZero returns an option like Options that also considers null values equal to valid zero values,
+// such as a null Int and null.IntFrom(0), according to their IsZeroOrNull methods.
+// An unset Optional is equal to a null or zero one too.
+func NullEqualsZero() cmp.Option {
+	return cmp.FilterValues(isNullType, cmp.Comparer(func(x, y interface{}) bool {
+		if isZeroOrNull(x) && isZeroOrNull(y) {
+			return true
+		}
+		return equal(x, y)
+	}))
+}
+
+// Equal is like cmp.Equal with Options, followed by opts.
+func Equal(x, y interface{}, opts ...cmp.Option) bool {
+	return cmp.Equal(x, y, append([]cmp.Option{Options()}, opts...)...)
+}
+
+// Diff is like cmp.Diff with Options, followed by opts.
+func Diff(x, y interface{}, opts ...cmp.Option) string {
+	return cmp.Diff(x, y, append([]cmp.Option{Options()}, opts...)...)
+}
+
+// isNullType returns true if x and y are of the same type from the null module, with Equal and IsZeroOrNull methods.
+func isNullType(x, y interface{}) bool {
+	typ := reflect.TypeOf(x)
+	if typ != reflect.TypeOf(y) || !fromModule(typ) {
+		return false
+	}
+	m, ok := typ.MethodByName("Equal")
+	if !ok || m.Type.NumIn() != 2 || m.Type.In(1) != typ || m.Type.NumOut() != 1 || m.Type.Out(0).Kind() != reflect.Bool {
+		return false
+	}
+	_, ok = x.(interface{ IsZeroOrNull() bool })
+	return ok
+}
+
+// fromModule returns true if typ is declared in the null package or one of its subpackages.
+func fromModule(typ reflect.Type) bool {
+	pkg := typ.PkgPath()
+	return pkg == modulePath || strings.HasPrefix(pkg, modulePath+"/")
+}
+
+// equal calls x's Equal method with y, which isNullType has checked is of the same type.
+func equal(x, y interface{}) bool {
+	return reflect.ValueOf(x).MethodByName("Equal").Call([]reflect.Value{reflect.ValueOf(y)})[0].Bool()
+}
+
+func isZeroOrNull(v interface{}) bool {
+	return v.(interface{ IsZeroOrNull() bool }).IsZeroOrNull()
+}
@@ -0,0 +1,82 @@
+package nullcmp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/nulldecimal"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+type record struct {
+	Name    null.String
+	Count   *null.Int
+	Tags    null.Slice[string]
+	Score   zero.Float
+	Price   nulldecimal.Decimal
+	Comment null.Optional[string]
+}
+
+func TestOptions(t *testing.T) {
+	stale := null.IntFrom(42)
+	stale.SetNull()
+	null1, null2 := null.Int{}, stale
+
+	a := record{Name: null.NewString("old", false), Count: &null1, Price: nulldecimal.DecimalFrom(decimal.RequireFromString("1.5"))}
+	b := record{Count: &null2, Price: nulldecimal.DecimalFrom(decimal.RequireFromString("1.50"))}
+	if !Equal(a, b) {
+		t.Errorf("nulls should be equal: %s", Diff(a, b))
+	}
+
+	b.Name = null.StringFrom("")
+	if Equal(a, b) {
+		t.Error("null and a blank string should not be equal")
+	}
+	if diff := Diff(a, b); !strings.Contains(diff, "Name") {
+		t.Errorf("diff should mention Name: %s", diff)
+	}
+
+	b.Name = null.String{}
+	b.Tags = null.SliceFrom([]string{"a"})
+	if Equal(a, b) {
+		t.Error("null and a valid slice should not be equal")
+	}
+	a.Tags = null.SliceFrom([]string{"a"})
+	b.Score = zero.NewFloat(0, true)
+	if !Equal(a, b) {
+		t.Errorf("zero types should consider null equal to zero: %s", Diff(a, b))
+	}
+}
+
+func TestNullEqualsZero(t *testing.T) {
+	zeroCount := null.IntFrom(0)
+	a := record{Count: &null.Int{}, Comment: null.OptionalNull[string]()}
+	b := record{Name: null.StringFrom(""), Count: &zeroCount, Tags: null.SliceFrom[string](nil)}
+	if !cmp.Equal(a, b, NullEqualsZero()) {
+		t.Errorf("null should equal zero: %s", cmp.Diff(a, b, NullEqualsZero()))
+	}
+	if cmp.Equal(a, b, Options()) {
+		t.Error("null should not equal zero with Options")
+	}
+
+	b.Name = null.StringFrom("x")
+	if cmp.Equal(a, b, NullEqualsZero()) {
+		t.Error("null should not equal a non-zero value")
+	}
+}
+
+func TestOtherTypes(t *testing.T) {
+	type embedded struct {
+		null.Int
+	}
+	// embedded types have Equal promoted from null.Int, which takes a null.Int, so they're compared field by field
+	if !Equal(embedded{null.NewInt(1, false)}, embedded{null.NewInt(2, false)}) {
+		t.Error("embedded nulls should be equal")
+	}
+	if !Equal([]int{1}, []int{1}) || Equal("a", "b") {
+		t.Error("other types should be compared as usual")
+	}
+}
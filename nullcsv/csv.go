@@ -0,0 +1,251 @@
+// Package nullcsv reads and writes structs of null types as CSV records using encoding/csv.
+// The first record is a header of column names, taken from the csv struct tag or the field name.
+// Fields tagged with csv:"-" are skipped.
+//
+// Values are written in their text form (see MarshalText), or as JSON for types without one such as null.Map.
+// Null values are written as the Writer's Null string, which is blank by default,
+// and blank cells or cells matching the Reader's Null string are read as null,
+// so a valid blank null.String is read back as null.
+// Like SQL, the zero types write zero values as null.
+package nullcsv
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// column is a struct field stored as a CSV column.
+type column struct {
+	name  string
+	index int
+}
+
+func columnsOf(typ reflect.Type) ([]column, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nullcsv: %s is not a struct", typ)
+	}
+	var cols []column
+	seen := make(map[string]bool)
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("csv"), ",")
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		ptr := reflect.PointerTo(f.Type)
+		if !f.Type.Implements(valuerType) || !(ptr.Implements(textUnmarshalerType) || ptr.Implements(jsonUnmarshalerType)) {
+			return nil, fmt.Errorf("nullcsv: unsupported type %s for field %s", f.Type, f.Name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("nullcsv: duplicate column %q in %s", name, typ)
+		}
+		seen[name] = true
+		cols = append(cols, column{name: name, index: i})
+	}
+	return cols, nil
+}
+
+var (
+	valuerType          = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// Writer writes structs of null types as CSV records.
+type Writer[T any] struct {
+	// Null is written for null values. It defaults to a blank cell.
+	// A common alternative is `\N`.
+	Null string
+
+	w       *csv.Writer
+	cols    []column
+	err     error
+	started bool
+	record  []string
+}
+
+// NewWriter creates a Writer for T, which must be a struct of null types.
+// The csv.Writer can be configured beforehand, for example to change its separator.
+func NewWriter[T any](w *csv.Writer) *Writer[T] {
+	cols, err := columnsOf(reflect.TypeOf((*T)(nil)).Elem())
+	return &Writer[T]{w: w, cols: cols, err: err}
+}
+
+// Write writes row as a CSV record, preceded by the header if it is the first row.
+// Records are buffered, so Flush must be called to make sure they are written.
+func (w *Writer[T]) Write(row T) error {
+	if err := w.WriteHeader(); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(&row).Elem()
+	w.record = w.record[:0]
+	for _, col := range w.cols {
+		cell, err := w.format(v.Field(col.index))
+		if err != nil {
+			return fmt.Errorf("nullcsv: couldn't write %s: %w", col.name, err)
+		}
+		w.record = append(w.record, cell)
+	}
+	return w.w.Write(w.record)
+}
+
+// WriteAll writes rows as CSV records and flushes the underlying writer.
+func (w *Writer[T]) WriteAll(rows []T) error {
+	if err := w.WriteHeader(); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// WriteHeader writes the header record, if it hasn't been written yet.
+// It is called automatically by Write.
+func (w *Writer[T]) WriteHeader() error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.started {
+		return nil
+	}
+	w.started = true
+	header := make([]string, len(w.cols))
+	for i, col := range w.cols {
+		header[i] = col.name
+	}
+	return w.w.Write(header)
+}
+
+// Flush writes any buffered records to the underlying io.Writer.
+func (w *Writer[T]) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *Writer[T]) format(field reflect.Value) (string, error) {
+	val, err := field.Interface().(driver.Valuer).Value()
+	if err != nil {
+		return "", err
+	}
+	if val == nil {
+		return w.Null, nil
+	}
+	var text []byte
+	if m, ok := field.Interface().(encoding.TextMarshaler); ok {
+		text, err = m.MarshalText()
+	} else {
+		text, err = json.Marshal(field.Interface())
+	}
+	return string(text), err
+}
+
+// Reader reads structs of null types from CSV records.
+type Reader[T any] struct {
+	// Null is read as null, as well as blank cells.
+	// A common choice is `\N`.
+	Null string
+
+	r      *csv.Reader
+	cols   []column
+	err    error
+	fields []int // struct field index of each CSV column, or -1
+}
+
+// NewReader creates a Reader for T, which must be a struct of null types.
+// The csv.Reader can be configured beforehand, for example to change its separator.
+// Columns are matched to fields by name from the header record.
+// Columns without a matching field are ignored, and fields without a column are left null.
+func NewReader[T any](r *csv.Reader) *Reader[T] {
+	cols, err := columnsOf(reflect.TypeOf((*T)(nil)).Elem())
+	return &Reader[T]{r: r, cols: cols, err: err}
+}
+
+// Read reads the next record.
+// It returns io.EOF when there are no more records.
+func (r *Reader[T]) Read() (T, error) {
+	var row T
+	if err := r.readHeader(); err != nil {
+		return row, err
+	}
+	record, err := r.r.Read()
+	if err != nil {
+		return row, err
+	}
+	v := reflect.ValueOf(&row).Elem()
+	for i, cell := range record {
+		if i >= len(r.fields) || r.fields[i] < 0 {
+			continue
+		}
+		col := r.cols[r.fields[i]]
+		if err := r.parse(v.Field(col.index), cell); err != nil {
+			line, _ := r.r.FieldPos(i)
+			return row, fmt.Errorf("nullcsv: line %d: couldn't read %s: %w", line, col.name, err)
+		}
+	}
+	return row, nil
+}
+
+// ReadAll reads all remaining records.
+func (r *Reader[T]) ReadAll() ([]T, error) {
+	var rows []T
+	for {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return rows, err
+		}
+		rows = append(rows, row)
+	}
+}
+
+func (r *Reader[T]) readHeader() error {
+	if r.err != nil || r.fields != nil {
+		return r.err
+	}
+	header, err := r.r.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.EOF
+		}
+		return fmt.Errorf("nullcsv: couldn't read header: %w", err)
+	}
+	r.fields = make([]int, len(header))
+	for i, name := range header {
+		r.fields[i] = -1
+		for j, col := range r.cols {
+			if col.name == name {
+				r.fields[i] = j
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Reader[T]) parse(field reflect.Value, cell string) error {
+	if cell == "" || cell == r.Null {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	ptr := field.Addr().Interface()
+	if u, ok := ptr.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(cell))
+	}
+	return ptr.(json.Unmarshaler).UnmarshalJSON([]byte(cell))
+}
@@ -0,0 +1,124 @@
+package nullcsv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+type record struct {
+	ID      null.Int    `csv:"id"`
+	Name    null.String `csv:"name"`
+	Score   null.Float  `csv:"score"`
+	Active  null.Bool   `csv:"active"`
+	Born    null.Date   `csv:"born"`
+	Updated null.Time   `csv:"updated"`
+	Tags    null.Map[string, int]
+	Count   zero.Int
+	Skipped null.String `csv:"-"`
+}
+
+func TestRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	in := []record{
+		{
+			ID:      null.IntFrom(1),
+			Name:    null.StringFrom(`O'Brien, "Pat"`),
+			Score:   null.FloatFrom(1.5),
+			Active:  null.BoolFrom(false),
+			Born:    null.DateFrom(now),
+			Updated: null.TimeFrom(now),
+			Tags:    null.MapFrom(map[string]int{"a": 1}),
+			Count:   zero.IntFrom(7),
+		},
+		{ID: null.IntFrom(2)},
+	}
+	for _, sentinel := range []string{"", `\N`} {
+		var buf bytes.Buffer
+		w := NewWriter[record](csv.NewWriter(&buf))
+		w.Null = sentinel
+		if err := w.WriteAll(in); err != nil {
+			t.Fatal(err)
+		}
+
+		r := NewReader[record](csv.NewReader(&buf))
+		r.Null = sentinel
+		out, err := r.ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertEqualJSON(t, out, in)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter[record](csv.NewWriter(&buf))
+	w.Null = `\N`
+	err := w.Write(record{ID: null.IntFrom(1), Name: null.StringFrom("a,b"), Tags: null.MapFrom(map[string]int{"x": 2})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	want := "id,name,score,active,born,updated,Tags,Count\n" +
+		`1,"a,b",\N,\N,\N,\N,"{""x"":2}",\N` + "\n"
+	if buf.String() != want {
+		t.Errorf("bad CSV:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestReader(t *testing.T) {
+	src := "extra,name,id,score\n" +
+		`x,\N,1,` + "\n" +
+		`y,"",2,2.5` + "\n"
+	r := NewReader[record](csv.NewReader(strings.NewReader(src)))
+	r.Null = `\N`
+	out, err := r.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []record{
+		{ID: null.IntFrom(1)},
+		{ID: null.IntFrom(2), Score: null.FloatFrom(2.5)},
+	}
+	assertEqualJSON(t, out, want)
+
+	r = NewReader[record](csv.NewReader(strings.NewReader("id\n1\nhello\n")))
+	if _, err := r.ReadAll(); err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("expected error on line 3, got %v", err)
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	w := NewWriter[struct{ N int }](csv.NewWriter(&bytes.Buffer{}))
+	if err := w.Write(struct{ N int }{}); err == nil {
+		t.Error("expected error for unsupported field type")
+	}
+	r := NewReader[struct{ N int }](csv.NewReader(strings.NewReader("N\n1\n")))
+	if _, err := r.Read(); err == nil {
+		t.Error("expected error for unsupported field type")
+	}
+}
+
+func assertEqualJSON(t *testing.T, got, want interface{}) {
+	t.Helper()
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(gotJSON, wantJSON) {
+		t.Errorf("mismatch:\n got: %s\nwant: %s", gotJSON, wantJSON)
+	}
+}
@@ -0,0 +1,24 @@
+package nulldecimal
+
+// This file implements AppendJSON and AppendText, which append the same output as MarshalJSON and MarshalText
+// to a byte slice instead of allocating a new one, so that encoders can reuse a buffer.
+// AppendText implements encoding.TextAppender.
+
+// AppendJSON appends the JSON encoding of this Decimal to dst, the same as MarshalJSON.
+func (d Decimal) AppendJSON(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	dst = append(dst, '"')
+	dst = append(dst, d.Decimal.String()...)
+	return append(dst, '"'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (d Decimal) AppendText(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return dst, nil
+	}
+	return append(dst, d.Decimal.String()...), nil
+}
@@ -0,0 +1,24 @@
+package nulldecimal
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDecimalAppend(t *testing.T) {
+	prefix := []byte("prefix:")
+	for _, d := range []Decimal{DecimalFrom(decimalValue), DecimalFrom(decimal.New(-5, -3)), NewDecimal(decimalValue, false)} {
+		want, err := d.MarshalJSON()
+		maybePanic(err)
+		got, err := d.AppendJSON(prefix[:len(prefix):len(prefix)])
+		maybePanic(err)
+		assertJSONEquals(t, got, string(prefix)+string(want), "AppendJSON")
+
+		want, err = d.MarshalText()
+		maybePanic(err)
+		got, err = d.AppendText(prefix[:len(prefix):len(prefix)])
+		maybePanic(err)
+		assertJSONEquals(t, got, string(prefix)+string(want), "AppendText")
+	}
+}
@@ -0,0 +1,68 @@
+package nulldecimal
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// This file implements the UnionConverter interface from github.com/hamba/avro,
+// so that pointers to Decimal can be encoded as Avro unions such as
+// ["null", {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}].
+
+// ToAny implements avro.UnionConverter.
+// It will encode null if this Decimal is null, otherwise an Avro decimal.
+func (d Decimal) ToAny() (any, error) {
+	if !d.Valid {
+		return new(any), nil
+	}
+	r := d.Decimal.Rat()
+	return &r, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports null, Avro decimals, strings, and other numbers.
+func (d *Decimal) FromAny(payload any) error {
+	v := payload
+	switch x := payload.(type) {
+	case *big.Rat:
+		d.Decimal, d.Valid = decimal.NewFromBigRat(x, ratScale(x)), true
+		return nil
+	case int:
+		v = int64(x)
+	case int32:
+		v = int64(x)
+	}
+	if err := d.Scan(v); err != nil {
+		d.Valid = false
+		return fmt.Errorf("nulldecimal: couldn't unmarshal Avro: %w", err)
+	}
+	return nil
+}
+
+// ratScale returns the number of decimal places needed to represent r exactly,
+// or decimal.DivisionPrecision if r has no finite decimal representation.
+func ratScale(r *big.Rat) int32 {
+	// r.Denom() is reduced, so r is a finite decimal iff it only has factors of 2 and 5
+	denom := new(big.Int).Set(r.Denom())
+	var twos, fives int32
+	for denom.Bit(0) == 0 {
+		denom.Rsh(denom, 1)
+		twos++
+	}
+	five := big.NewInt(5)
+	q, m := new(big.Int), new(big.Int)
+	for {
+		q.QuoRem(denom, five, m)
+		if m.Sign() != 0 {
+			break
+		}
+		denom.Set(q)
+		fives++
+	}
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return int32(decimal.DivisionPrecision)
+	}
+	return max(twos, fives)
+}
@@ -0,0 +1,34 @@
+//go:build avro
+
+package nulldecimal
+
+import (
+	"testing"
+
+	"github.com/hamba/avro/v2"
+)
+
+func TestDecimalHamba(t *testing.T) {
+	schema := avro.MustParse(`{
+		"type": "record",
+		"name": "rec",
+		"fields": [
+			{"name": "price", "type": ["null", {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 4}]},
+			{"name": "missing", "type": ["null", {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 4}]}
+		]
+	}`)
+	type rec struct {
+		Price   *Decimal `avro:"price"`
+		Missing *Decimal `avro:"missing"`
+	}
+	price := DecimalFrom(decimalValue)
+	data, err := avro.Marshal(schema, rec{Price: &price, Missing: &Decimal{}})
+	maybePanic(err)
+	var out rec
+	err = avro.Unmarshal(schema, data, &out)
+	maybePanic(err)
+	if out.Price == nil || out.Missing != nil {
+		t.Fatalf("bad decoded record: %+v", out)
+	}
+	assertDecimal(t, *out.Price, "hamba/avro")
+}
@@ -0,0 +1,51 @@
+package nulldecimal
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDecimalAvro(t *testing.T) {
+	v, err := DecimalFrom(decimalValue).ToAny()
+	maybePanic(err)
+	r, ok := v.(**big.Rat)
+	if !ok || (*r).RatString() != "123456789/10000" {
+		t.Fatalf("bad ToAny: %#v", v)
+	}
+	var d Decimal
+	err = d.FromAny(*r)
+	maybePanic(err)
+	assertDecimal(t, d, "FromAny big.Rat")
+
+	err = d.FromAny(nil)
+	maybePanic(err)
+	assertNullDecimal(t, d, "FromAny nil")
+
+	err = d.FromAny("12345.6789")
+	maybePanic(err)
+	assertDecimal(t, d, "FromAny string")
+
+	if v, err := NewDecimal(decimalValue, false).ToAny(); err != nil || *v.(*any) != nil {
+		t.Errorf("null should convert to nil: %#v %v", v, err)
+	}
+	if err := d.FromAny(true); err == nil {
+		t.Error("expected error for boolean into Decimal")
+	}
+}
+
+func TestRatScale(t *testing.T) {
+	for _, tc := range []struct {
+		rat  *big.Rat
+		want int32
+	}{
+		{big.NewRat(5, 1), 0},
+		{big.NewRat(3, 2), 1},
+		{big.NewRat(1, 8), 3},
+		{big.NewRat(123456789, 10000), 4},
+		{big.NewRat(1, 3), 16},
+	} {
+		if got := ratScale(tc.rat); got != tc.want {
+			t.Errorf("ratScale(%s) = %d, want %d", tc.rat, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package nulldecimal
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// MarshalBinary and GobEncode use the same stable binary encoding as the null package:
+// a single 0 byte if null, otherwise the byte 5 (string) followed by the decimal's string form.
+const (
+	binaryNull   byte = 0
+	binaryString byte = 5
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	if !d.Valid {
+		return []byte{binaryNull}, nil
+	}
+	return append([]byte{binaryString}, d.Decimal.String()...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	switch {
+	case len(data) == 1 && data[0] == binaryNull:
+		d.Decimal, d.Valid = decimal.Decimal{}, false
+		return nil
+	case len(data) > 1 && data[0] == binaryString:
+		dec, err := decimal.NewFromString(string(data[1:]))
+		if err != nil {
+			return fmt.Errorf("nulldecimal: couldn't decode binary: %w", err)
+		}
+		d.Decimal, d.Valid = dec, true
+		return nil
+	}
+	return fmt.Errorf("nulldecimal: couldn't decode binary: invalid data")
+}
+
+// GobEncode implements gob.GobEncoder.
+func (d Decimal) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (d *Decimal) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
@@ -0,0 +1,35 @@
+package nulldecimal
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestDecimalGob(t *testing.T) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(DecimalFrom(decimalValue))
+	maybePanic(err)
+	var d Decimal
+	err = gob.NewDecoder(&buf).Decode(&d)
+	maybePanic(err)
+	assertDecimal(t, d, "gob")
+
+	data, err := DecimalFrom(decimalValue).MarshalBinary()
+	maybePanic(err)
+	if string(data) != "\x0512345.6789" {
+		t.Errorf("bad binary encoding: %q", data)
+	}
+
+	data, err = NewDecimal(decimalValue, false).MarshalBinary()
+	maybePanic(err)
+	err = d.UnmarshalBinary(data)
+	maybePanic(err)
+	assertNullDecimal(t, d, "binary null")
+
+	for _, bad := range [][]byte{nil, {5}, {5, 'x'}, {1, 1}} {
+		if err := d.UnmarshalBinary(bad); err == nil {
+			t.Errorf("expected error decoding %v", bad)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+//go:build bson
+
+package nulldecimal
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// This file implements bson.ValueMarshaler and bson.ValueUnmarshaler from the MongoDB driver.
+// It is only built with the bson build tag:
+//
+//	go build -tags bson
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode null if this Decimal is null, otherwise a BSON Decimal128.
+// Decimals with more than 34 significant digits can't be encoded.
+func (d Decimal) MarshalBSONValue() (byte, []byte, error) {
+	if !d.Valid {
+		return byte(bson.TypeNull), nil, nil
+	}
+	dec, err := bson.ParseDecimal128(d.Decimal.String())
+	if err != nil {
+		return 0, nil, fmt.Errorf("nulldecimal: couldn't marshal BSON: %w", err)
+	}
+	typ, data, err := bson.MarshalValue(dec)
+	return byte(typ), data, err
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports null, BSON Decimal128s, strings, and other numbers.
+func (d *Decimal) UnmarshalBSONValue(typ byte, data []byte) error {
+	raw := bson.RawValue{Type: bson.Type(typ), Value: data}
+	var v interface{}
+	switch raw.Type {
+	case bson.TypeNull, bson.TypeUndefined:
+		v = nil
+	case bson.TypeDecimal128:
+		v = raw.Decimal128().String()
+	case bson.TypeString:
+		v = raw.StringValue()
+	case bson.TypeDouble:
+		v = raw.Double()
+	case bson.TypeInt32:
+		v = int64(raw.Int32())
+	case bson.TypeInt64:
+		v = raw.Int64()
+	default:
+		return fmt.Errorf("nulldecimal: couldn't unmarshal BSON type %s", raw.Type)
+	}
+	if err := d.Scan(v); err != nil {
+		d.Valid = false
+		return fmt.Errorf("nulldecimal: couldn't unmarshal BSON: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+//go:build bson
+
+package nulldecimal
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestDecimalBSON(t *testing.T) {
+	type doc struct {
+		D Decimal
+	}
+	data, err := bson.Marshal(doc{DecimalFrom(decimalValue)})
+	maybePanic(err)
+	if typ := bson.Raw(data).Lookup("d").Type; typ != bson.TypeDecimal128 {
+		t.Errorf("bad BSON type: %s", typ)
+	}
+	var out doc
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	assertDecimal(t, out.D, "bson round trip")
+
+	data, err = bson.Marshal(doc{})
+	maybePanic(err)
+	if typ := bson.Raw(data).Lookup("d").Type; typ != bson.TypeNull {
+		t.Errorf("bad null BSON type: %s", typ)
+	}
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	assertNullDecimal(t, out.D, "bson null")
+
+	for _, v := range []interface{}{"12345.6789", 12345.6789} {
+		data, err := bson.Marshal(bson.D{{Key: "d", Value: v}})
+		maybePanic(err)
+		var out doc
+		err = bson.Unmarshal(data, &out)
+		maybePanic(err)
+		assertDecimal(t, out.D, "bson number")
+	}
+
+	tooLong := DecimalFrom(decimal.RequireFromString("1234567890123456789012345678901234567890"))
+	if _, err := bson.Marshal(doc{tooLong}); err == nil {
+		t.Error("expected error marshaling decimal with too many digits")
+	}
+}
@@ -0,0 +1,56 @@
+//go:build gocql
+
+package nulldecimal
+
+import (
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"github.com/shopspring/decimal"
+	"gopkg.in/inf.v0"
+)
+
+// This file implements gocql.Marshaler and gocql.Unmarshaler from the Cassandra driver.
+// It is only built with the gocql build tag:
+//
+//	go build -tags gocql
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode null if this Decimal is null.
+// Decimal columns are encoded exactly; other columns, such as text, are given the decimal string.
+func (d Decimal) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	var v interface{} = d.Decimal.String()
+	if info.Type() == gocql.TypeDecimal {
+		v = inf.NewDecBig(d.Decimal.Coefficient(), inf.Scale(-d.Decimal.Exponent()))
+	}
+	data, err := gocql.Marshal(info, v)
+	if err != nil {
+		return nil, fmt.Errorf("nulldecimal: couldn't marshal CQL: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports null, decimals, and any CQL value that can be read as a decimal string, such as text and varint.
+func (d *Decimal) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if data == nil {
+		*d = Decimal{}
+		return nil
+	}
+	if info.Type() == gocql.TypeDecimal {
+		dec := new(inf.Dec)
+		if err := gocql.Unmarshal(info, data, dec); err != nil {
+			return fmt.Errorf("nulldecimal: couldn't unmarshal CQL: %w", err)
+		}
+		*d = DecimalFrom(decimal.NewFromBigInt(dec.UnscaledBig(), -int32(dec.Scale())))
+		return nil
+	}
+	var str string
+	if err := gocql.Unmarshal(info, data, &str); err != nil {
+		return fmt.Errorf("nulldecimal: couldn't unmarshal CQL: %w", err)
+	}
+	return d.Scan(str)
+}
@@ -0,0 +1,36 @@
+//go:build gocql
+
+package nulldecimal
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/shopspring/decimal"
+)
+
+func TestCQLRoundTrip(t *testing.T) {
+	for _, typ := range []gocql.Type{gocql.TypeDecimal, gocql.TypeText} {
+		info := gocql.NewNativeType(4, typ, "")
+		for _, in := range []Decimal{DecimalFrom(decimal.RequireFromString("-1234.5678")), DecimalFrom(decimal.New(5, 3)), {}} {
+			data, err := in.MarshalCQL(info)
+			maybePanic(err)
+			out := DecimalFrom(decimal.NewFromInt(1))
+			err = out.UnmarshalCQL(info, data)
+			maybePanic(err)
+			if !out.Equal(in) {
+				t.Errorf("%s: bad round trip: %v ≠ %v", info, out, in)
+			}
+		}
+	}
+
+	varint := gocql.NewNativeType(4, gocql.TypeVarint, "")
+	data, err := DecimalFrom(decimal.NewFromInt(42)).MarshalCQL(varint)
+	maybePanic(err)
+	var out Decimal
+	err = out.UnmarshalCQL(varint, data)
+	maybePanic(err)
+	if !out.Equal(DecimalFrom(decimal.NewFromInt(42))) {
+		t.Errorf("bad varint: %v", out)
+	}
+}
@@ -0,0 +1,349 @@
+// Package nulldecimal contains a nullable arbitrary-precision decimal type
+// backed by github.com/shopspring/decimal, with convenient support for JSON and text marshaling.
+// It lives in its own package so that the null package stays free of dependencies.
+// Like the null package, it will always encode to null if null.
+package nulldecimal
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// nullBytes is a JSON null literal
+var nullBytes = []byte("null")
+
+// Decimal is a nullable decimal.Decimal.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Decimal struct {
+	decimal.NullDecimal
+}
+
+// NewDecimal creates a new Decimal.
+func NewDecimal(d decimal.Decimal, valid bool) Decimal {
+	return Decimal{
+		NullDecimal: decimal.NullDecimal{
+			Decimal: d,
+			Valid:   valid,
+		},
+	}
+}
+
+// DecimalFrom creates a new Decimal that will always be valid.
+func DecimalFrom(d decimal.Decimal) Decimal {
+	return NewDecimal(d, true)
+}
+
+// DecimalFromPtr creates a new Decimal that will be null if d is nil.
+func DecimalFromPtr(d *decimal.Decimal) Decimal {
+	if d == nil {
+		return NewDecimal(decimal.Decimal{}, false)
+	}
+	return NewDecimal(*d, true)
+}
+
+// DecimalFromSQLNull creates a new Decimal from a sql.Null[decimal.Decimal].
+func DecimalFromSQLNull(n sql.Null[decimal.Decimal]) Decimal {
+	return NewDecimal(n.V, n.Valid)
+}
+
+// DecimalFromString creates a new Decimal from its string representation.
+// It will return an error if s is not a valid decimal number.
+func DecimalFromString(s string) (Decimal, error) {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("nulldecimal: couldn't parse decimal: %w", err)
+	}
+	return DecimalFrom(d), nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Decimal) ValueOrZero() decimal.Decimal {
+	if !d.Valid {
+		return decimal.Decimal{}
+	}
+	return d.Decimal
+}
+
+// Scan implements the Scanner interface.
+// It supports NUMERIC and DECIMAL columns sent as text or bytes,
+// as well as integer and floating point driver values.
+// It also accepts decimal.Decimal, sized integers, and pointers to them,
+// which clickhouse-go returns for Decimal and Nullable columns.
+func (d *Decimal) Scan(value interface{}) error {
+	var err error
+	switch v := value.(type) {
+	case nil:
+		d.Decimal, d.Valid = decimal.Decimal{}, false
+		return nil
+	case decimal.Decimal:
+		d.Decimal = v
+	case decimal.NullDecimal:
+		d.Decimal, d.Valid = v.Decimal, v.Valid
+		return nil
+	case []byte:
+		d.Decimal, err = decimal.NewFromString(strings.TrimSpace(string(v)))
+	case string:
+		d.Decimal, err = decimal.NewFromString(strings.TrimSpace(v))
+	case int64:
+		d.Decimal = decimal.NewFromInt(v)
+	case uint64:
+		d.Decimal = decimal.NewFromBigInt(new(big.Int).SetUint64(v), 0)
+	case float64:
+		d.Decimal = decimal.NewFromFloat(v)
+	case float32:
+		d.Decimal = decimal.NewFromFloat32(v)
+	case *big.Int:
+		if v == nil {
+			d.Decimal, d.Valid = decimal.Decimal{}, false
+			return nil
+		}
+		d.Decimal = decimal.NewFromBigInt(v, 0)
+	default:
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Pointer:
+			if rv.IsNil() {
+				d.Decimal, d.Valid = decimal.Decimal{}, false
+				return nil
+			}
+			return d.Scan(rv.Elem().Interface())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			d.Decimal = decimal.NewFromInt(rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			d.Decimal = decimal.NewFromBigInt(new(big.Int).SetUint64(rv.Uint()), 0)
+		default:
+			err = fmt.Errorf("unsupported type %T", value)
+		}
+	}
+	if err != nil {
+		d.Valid = false
+		return fmt.Errorf("nulldecimal: couldn't scan decimal: %w", err)
+	}
+	d.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as strings to avoid losing precision.
+func (d Decimal) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Decimal.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string, number, and null input.
+// 0 will not be considered a null Decimal.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		d.Valid = false
+		return nil
+	}
+
+	if err := d.Decimal.UnmarshalJSON(data); err != nil {
+		return fmt.Errorf("nulldecimal: couldn't unmarshal JSON: %w", err)
+	}
+
+	d.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Decimal is null, otherwise a string
+// so that no precision is lost to floating point rounding.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.Decimal.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Decimal if the input is blank or "null".
+// It will return an error if the input is not a decimal number, blank, or "null".
+func (d *Decimal) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		d.Valid = false
+		return nil
+	}
+	var err error
+	d.Decimal, err = decimal.NewFromString(str)
+	if err != nil {
+		return fmt.Errorf("nulldecimal: couldn't unmarshal text: %w", err)
+	}
+	d.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Decimal is null.
+func (d Decimal) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Decimal.String()), nil
+}
+
+// SetValid changes this Decimal's value and also sets it to be non-null.
+func (d *Decimal) SetValid(v decimal.Decimal) {
+	d.Decimal = v
+	d.Valid = true
+}
+
+// SetNull sets this Decimal to null, leaving its value as is. Use Reset to clear the value too.
+func (d *Decimal) SetNull() {
+	d.Valid = false
+}
+
+// Reset sets this Decimal to null and clears its value.
+func (d *Decimal) Reset() {
+	*d = Decimal{}
+}
+
+// Ptr returns a pointer to this Decimal's value, or a nil pointer if this Decimal is null.
+func (d Decimal) Ptr() *decimal.Decimal {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Decimal
+}
+
+// SetPtr changes this Decimal's value to the value v points to, or sets it to be null if v is nil, like DecimalFromPtr.
+func (d *Decimal) SetPtr(v *decimal.Decimal) {
+	*d = DecimalFromPtr(v)
+}
+
+// ToSQLNull returns this Decimal as a sql.Null[decimal.Decimal].
+func (d Decimal) ToSQLNull() sql.Null[decimal.Decimal] {
+	if !d.Valid {
+		return sql.Null[decimal.Decimal]{}
+	}
+	return sql.Null[decimal.Decimal]{V: d.Decimal, Valid: true}
+}
+
+// IsZero returns true for invalid Decimals.
+// A non-null Decimal with a 0 value will not be considered zero.
+func (d Decimal) IsZero() bool {
+	return !d.Valid
+}
+
+// IsZeroOrNull returns true for invalid Decimals, or valid Decimals with a 0 value.
+func (d Decimal) IsZeroOrNull() bool {
+	return !d.Valid || d.Decimal.IsZero()
+}
+
+// Equal returns true if both Decimals have the same numeric value or are both null.
+// Values with different exponents, such as 1.5 and 1.50, are equal.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Valid == other.Valid && (!d.Valid || d.Decimal.Equal(other.Decimal))
+}
+
+// Map returns the result of fn applied to this Decimal's value, or null if this Decimal is null.
+func (d Decimal) Map(fn func(decimal.Decimal) decimal.Decimal) Decimal {
+	if !d.Valid {
+		return d
+	}
+	return DecimalFrom(fn(d.Decimal))
+}
+
+// Filter returns this Decimal if it is null or fn returns true for its value, otherwise null.
+func (d Decimal) Filter(fn func(decimal.Decimal) bool) Decimal {
+	if !d.Valid || fn(d.Decimal) {
+		return d
+	}
+	return Decimal{}
+}
+
+// Or returns this Decimal if it is valid, otherwise other.
+func (d Decimal) Or(other Decimal) Decimal {
+	if d.Valid {
+		return d
+	}
+	return other
+}
+
+// OrElse returns this Decimal's value if it is valid, otherwise fallback.
+func (d Decimal) OrElse(fallback decimal.Decimal) decimal.Decimal {
+	if !d.Valid {
+		return fallback
+	}
+	return d.Decimal
+}
+
+// Get returns this Decimal's value and true if it is valid, otherwise the zero value and false.
+func (d Decimal) Get() (decimal.Decimal, bool) {
+	if !d.Valid {
+		return decimal.Decimal{}, false
+	}
+	return d.Decimal, true
+}
+
+// MustGet returns this Decimal's value, and panics if it is null.
+func (d Decimal) MustGet() decimal.Decimal {
+	if !d.Valid {
+		panic("nulldecimal: MustGet called on a null Decimal")
+	}
+	return d.Decimal
+}
+
+// Compare compares two Decimals, ordering null before any valid value.
+// The result will be 0 if d == other, -1 if d < other, and +1 if d > other.
+func (d Decimal) Compare(other Decimal) int {
+	switch {
+	case !d.Valid && !other.Valid:
+		return 0
+	case !d.Valid:
+		return -1
+	case !other.Valid:
+		return 1
+	}
+	return d.Decimal.Cmp(other.Decimal)
+}
+
+// Less reports whether this Decimal sorts before other, with null first.
+func (d Decimal) Less(other Decimal) bool {
+	return d.Compare(other) < 0
+}
+
+// String returns this Decimal's value as text, like MarshalText, or "<null>" if it is null.
+func (d Decimal) String() string {
+	if !d.Valid {
+		return "<null>"
+	}
+	return d.Decimal.String()
+}
+
+// LogValue implements slog.LogValuer, logging this Decimal's value as text, or nil if it is null.
+func (d Decimal) LogValue() slog.Value {
+	if !d.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(d.Decimal.String())
+}
+
+// Generate implements quick.Generator, returning a random Decimal like RandomDecimal, or null one in four times.
+func (Decimal) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(RandomDecimal(r, 0.25))
+}
+
+// RandomDecimal returns a random valid Decimal with two decimal places from -10,000,000 to 10,000,000,
+// or null with a probability of nullProbability, for test fixtures and load generators.
+func RandomDecimal(r *rand.Rand, nullProbability float64) Decimal {
+	if r.Float64() < nullProbability {
+		return Decimal{}
+	}
+	return DecimalFrom(decimal.New(r.Int63n(2e9+1)-1e9, -2))
+}
@@ -0,0 +1,427 @@
+package nulldecimal
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	decimalJSON       = []byte(`"12345.6789"`)
+	decimalNumberJSON = []byte(`12345.6789`)
+	decimalValue      = decimal.RequireFromString("12345.6789")
+	nullJSON          = []byte(`null`)
+	invalidJSON       = []byte(`:)`)
+)
+
+func TestDecimalFrom(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	assertDecimal(t, d, "DecimalFrom()")
+
+	zero := DecimalFrom(decimal.Zero)
+	if !zero.Valid {
+		t.Error("DecimalFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestDecimalFromPtr(t *testing.T) {
+	v := decimalValue
+	d := DecimalFromPtr(&v)
+	assertDecimal(t, d, "DecimalFromPtr()")
+
+	null := DecimalFromPtr(nil)
+	assertNullDecimal(t, null, "DecimalFromPtr(nil)")
+}
+
+func TestDecimalFromString(t *testing.T) {
+	d, err := DecimalFromString("12345.6789")
+	maybePanic(err)
+	assertDecimal(t, d, "DecimalFromString()")
+
+	_, err = DecimalFromString("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestUnmarshalDecimal(t *testing.T) {
+	var d Decimal
+	err := json.Unmarshal(decimalJSON, &d)
+	maybePanic(err)
+	assertDecimal(t, d, "decimal string json")
+
+	var num Decimal
+	err = json.Unmarshal(decimalNumberJSON, &num)
+	maybePanic(err)
+	assertDecimal(t, num, "decimal number json")
+
+	var null Decimal
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDecimal(t, null, "null json")
+
+	var badType Decimal
+	err = json.Unmarshal([]byte(`true`), &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullDecimal(t, badType, "wrong type json")
+
+	var invalid Decimal
+	err = json.Unmarshal(invalidJSON, &invalid)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected json.SyntaxError, not %T", err)
+	}
+	assertNullDecimal(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalDecimal(t *testing.T) {
+	var d Decimal
+	err := d.UnmarshalText([]byte("12345.6789"))
+	maybePanic(err)
+	assertDecimal(t, d, "UnmarshalText() decimal")
+
+	var blank Decimal
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDecimal(t, blank, "UnmarshalText() empty decimal")
+
+	var null Decimal
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullDecimal(t, null, `UnmarshalText() "null"`)
+
+	var invalid Decimal
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalDecimal(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"12345.6789"`, "non-empty json marshal")
+
+	null := NewDecimal(decimal.Zero, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalDecimalText(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	data, err := d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345.6789", "non-empty text marshal")
+
+	null := NewDecimal(decimal.Zero, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestDecimalPointer(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	ptr := d.Ptr()
+	if !ptr.Equal(decimalValue) {
+		t.Errorf("bad %s decimal: %v ≠ %v\n", "pointer", ptr, decimalValue)
+	}
+
+	null := NewDecimal(decimal.Zero, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s decimal: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestDecimalIsZero(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	if d.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewDecimal(decimal.Zero, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewDecimal(decimal.Zero, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	if d.IsZeroOrNull() {
+		t.Errorf("IsZeroOrNull() should be false")
+	}
+	if !null.IsZeroOrNull() || !zero.IsZeroOrNull() {
+		t.Errorf("IsZeroOrNull() should be true")
+	}
+}
+
+func TestDecimalSetValid(t *testing.T) {
+	change := NewDecimal(decimal.Zero, false)
+	assertNullDecimal(t, change, "SetValid()")
+	change.SetValid(decimalValue)
+	assertDecimal(t, change, "SetValid()")
+}
+
+func TestDecimalScan(t *testing.T) {
+	var d Decimal
+	err := d.Scan([]byte("12345.6789"))
+	maybePanic(err)
+	assertDecimal(t, d, "scanned bytes")
+
+	var s Decimal
+	err = s.Scan("12345.6789")
+	maybePanic(err)
+	assertDecimal(t, s, "scanned string")
+
+	var i Decimal
+	err = i.Scan(int64(12345))
+	maybePanic(err)
+	if !i.Decimal.Equal(decimal.NewFromInt(12345)) || !i.Valid {
+		t.Errorf("bad scanned int64: %v", i)
+	}
+
+	var u Decimal
+	err = u.Scan(uint64(18446744073709551615))
+	maybePanic(err)
+	if u.Decimal.String() != "18446744073709551615" || !u.Valid {
+		t.Errorf("bad scanned uint64: %v", u)
+	}
+
+	var f Decimal
+	err = f.Scan(12345.6789)
+	maybePanic(err)
+	assertDecimal(t, f, "scanned float64")
+
+	var native Decimal
+	err = native.Scan(decimalValue)
+	maybePanic(err)
+	assertDecimal(t, native, "scanned decimal.Decimal")
+
+	ptr := decimalValue
+	var p Decimal
+	err = p.Scan(&ptr)
+	maybePanic(err)
+	assertDecimal(t, p, "scanned *decimal.Decimal")
+
+	var sized Decimal
+	err = sized.Scan(uint32(12345))
+	maybePanic(err)
+	if !sized.Valid || sized.Decimal.String() != "12345" {
+		t.Errorf("bad scanned uint32: %v", sized)
+	}
+
+	var null Decimal
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullDecimal(t, null, "scanned null")
+
+	var nilPtr Decimal
+	err = nilPtr.Scan((*decimal.Decimal)(nil))
+	maybePanic(err)
+	assertNullDecimal(t, nilPtr, "scanned nil *decimal.Decimal")
+
+	var wrong Decimal
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullDecimal(t, wrong, "scanned wrong type")
+}
+
+func TestDecimalValue(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	v, err := d.Value()
+	maybePanic(err)
+	if v != "12345.6789" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	null := NewDecimal(decimal.Zero, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestDecimalEqual(t *testing.T) {
+	d1 := NewDecimal(decimalValue, false)
+	d2 := NewDecimal(decimal.Zero, false)
+	assertDecimalEqualIsTrue(t, d1, d2)
+
+	d1 = DecimalFrom(decimal.RequireFromString("1.5"))
+	d2 = DecimalFrom(decimal.RequireFromString("1.50"))
+	assertDecimalEqualIsTrue(t, d1, d2)
+
+	d1 = NewDecimal(decimalValue, true)
+	d2 = NewDecimal(decimalValue, false)
+	assertDecimalEqualIsFalse(t, d1, d2)
+
+	d1 = DecimalFrom(decimalValue)
+	d2 = DecimalFrom(decimal.Zero)
+	assertDecimalEqualIsFalse(t, d1, d2)
+}
+
+func maybePanic(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assertDecimal(t *testing.T, d Decimal, from string) {
+	t.Helper()
+	if !d.Decimal.Equal(decimalValue) {
+		t.Errorf("bad %s decimal: %v ≠ %v\n", from, d.Decimal, decimalValue)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDecimal(t *testing.T, d Decimal, from string) {
+	t.Helper()
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertJSONEquals(t *testing.T, data []byte, cmp string, from string) {
+	t.Helper()
+	if string(data) != cmp {
+		t.Errorf("bad %s data: %s ≠ %s\n", from, data, cmp)
+	}
+}
+
+func assertDecimalEqualIsTrue(t *testing.T, a, b Decimal) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Decimal{%v, Valid:%t} and Decimal{%v, Valid:%t} should return true", a.Decimal, a.Valid, b.Decimal, b.Valid)
+	}
+}
+
+func assertDecimalEqualIsFalse(t *testing.T, a, b Decimal) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Decimal{%v, Valid:%t} and Decimal{%v, Valid:%t} should return false", a.Decimal, a.Valid, b.Decimal, b.Valid)
+	}
+}
+
+func TestDecimalSQLNull(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	n := d.ToSQLNull()
+	if !n.Valid || !n.V.Equal(decimalValue) {
+		t.Errorf("bad sql.Null: %#v", n)
+	}
+	assertDecimal(t, DecimalFromSQLNull(n), "DecimalFromSQLNull")
+	assertNullDecimal(t, DecimalFromSQLNull(Decimal{}.ToSQLNull()), "DecimalFromSQLNull null")
+}
+
+func TestDecimalTransform(t *testing.T) {
+	if got := DecimalFrom(decimalValue).Map(decimal.Decimal.Neg); !got.Valid || !got.Decimal.Equal(decimalValue.Neg()) {
+		t.Errorf("Map(): got %v", got)
+	}
+	if got := DecimalFrom(decimalValue).Filter(decimal.Decimal.IsNegative); got.Valid {
+		t.Errorf("Filter(): got %v", got)
+	}
+	assertDecimal(t, Decimal{}.Or(DecimalFrom(decimalValue)), "Or")
+	if got := (Decimal{}).OrElse(decimalValue); !got.Equal(decimalValue) {
+		t.Errorf("OrElse(): got %v", got)
+	}
+}
+
+func TestDecimalGet(t *testing.T) {
+	if v, ok := DecimalFrom(decimalValue).Get(); !v.Equal(decimalValue) || !ok {
+		t.Errorf("Get(): got %v, %v", v, ok)
+	}
+	if _, ok := (Decimal{}).Get(); ok {
+		t.Error("null Get() should not be ok")
+	}
+	if v := DecimalFrom(decimalValue).MustGet(); !v.Equal(decimalValue) {
+		t.Errorf("MustGet(): got %v", v)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustGet() of null should panic")
+		}
+	}()
+	Decimal{}.MustGet()
+}
+
+func TestDecimalSetNull(t *testing.T) {
+	d := DecimalFrom(decimalValue)
+	d.SetNull()
+	assertNullDecimal(t, d, "SetNull()")
+	d.Reset()
+	if !d.Decimal.Equal(decimal.Decimal{}) {
+		t.Errorf("Reset() should clear the value, got %v", d.Decimal)
+	}
+}
+
+func TestDecimalSetPtr(t *testing.T) {
+	var d Decimal
+	v := decimalValue
+	d.SetPtr(&v)
+	assertDecimal(t, d, "SetPtr()")
+	d.SetPtr(nil)
+	assertNullDecimal(t, d, "SetPtr(nil)")
+}
+
+func TestDecimalCompare(t *testing.T) {
+	a, b := DecimalFrom(decimal.RequireFromString("1.5")), DecimalFrom(decimal.RequireFromString("1.50"))
+	if a.Compare(b) != 0 || a.Less(b) {
+		t.Error("1.5 and 1.50 should compare equal")
+	}
+	if !(Decimal{}).Less(DecimalFrom(decimal.RequireFromString("-1"))) || a.Compare(Decimal{}) != 1 {
+		t.Error("null should be less than any valid value")
+	}
+}
+
+func TestDecimalString(t *testing.T) {
+	if got := DecimalFrom(decimalValue).String(); got != "12345.6789" {
+		t.Errorf("bad String(): %q", got)
+	}
+	if got := (Decimal{}).String(); got != "<null>" {
+		t.Errorf("bad null String(): %q", got)
+	}
+}
+
+func TestDecimalLogValue(t *testing.T) {
+	if v := DecimalFrom(decimalValue).LogValue(); v.Kind() != slog.KindString || v.String() != "12345.6789" {
+		t.Errorf("bad LogValue(): %v", v)
+	}
+	if v := (Decimal{}).LogValue(); v.Kind() != slog.KindAny || v.Any() != nil {
+		t.Errorf("bad null LogValue(): %v", v)
+	}
+}
+
+func TestDecimalGenerate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var null int
+	for i := 0; i < 100; i++ {
+		v := Decimal{}.Generate(r, 10).Interface().(Decimal)
+		if !v.Valid {
+			null++
+			continue
+		}
+		var again Decimal
+		if err := again.UnmarshalText([]byte(v.String())); err != nil || !again.Equal(v) {
+			t.Errorf("%v doesn't round trip: %v, %v", v, again, err)
+		}
+	}
+	if null == 0 || null == 100 {
+		t.Errorf("expected a mix of valid and null values, got %d null", null)
+	}
+	if v := RandomDecimal(r, 1); v.Valid {
+		t.Errorf("nullProbability 1 should always be null: %v", v)
+	}
+}
@@ -0,0 +1,42 @@
+//go:build dynamodb
+
+package nulldecimal
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// This file implements attributevalue.Marshaler and attributevalue.Unmarshaler
+// from github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue.
+// It is only built with the dynamodb build tag:
+//
+//	go build -tags dynamodb
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode NULL if this Decimal is null, otherwise a number.
+// DynamoDB numbers have up to 38 significant digits.
+func (d Decimal) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	if !d.Valid {
+		return &types.AttributeValueMemberNULL{Value: true}, nil
+	}
+	return &types.AttributeValueMemberN{Value: d.Decimal.String()}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports NULL, numbers, and strings.
+func (d *Decimal) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	var v interface{}
+	switch x := av.(type) {
+	case nil, *types.AttributeValueMemberNULL:
+		v = nil
+	case *types.AttributeValueMemberN:
+		v = x.Value
+	case *types.AttributeValueMemberS:
+		v = x.Value
+	default:
+		return fmt.Errorf("nulldecimal: couldn't unmarshal DynamoDB attribute of type %T", av)
+	}
+	return d.Scan(v)
+}
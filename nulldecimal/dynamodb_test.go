@@ -0,0 +1,30 @@
+//go:build dynamodb
+
+package nulldecimal
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/shopspring/decimal"
+)
+
+func TestDynamoDBRoundTrip(t *testing.T) {
+	for _, in := range []Decimal{DecimalFrom(decimal.RequireFromString("-1234.5678")), {}} {
+		av, err := attributevalue.Marshal(in)
+		maybePanic(err)
+		out := DecimalFrom(decimal.NewFromInt(1))
+		err = attributevalue.Unmarshal(av, &out)
+		maybePanic(err)
+		if !out.Equal(in) {
+			t.Errorf("bad round trip: %v ≠ %v", out, in)
+		}
+	}
+
+	av, err := attributevalue.Marshal(DecimalFrom(decimal.RequireFromString("0.125")))
+	maybePanic(err)
+	if n, ok := av.(*types.AttributeValueMemberN); !ok || n.Value != "0.125" {
+		t.Errorf("bad attribute: %#v", av)
+	}
+}
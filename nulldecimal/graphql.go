@@ -0,0 +1,41 @@
+package nulldecimal
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// This file implements the Marshaler and Unmarshaler interfaces from github.com/99designs/gqlgen,
+// so that Decimal can be bound directly as a GraphQL scalar.
+// Values are converted to and from the same representation as JSON.
+
+// marshalGQL writes the JSON encoding of m to w, or null if m can't be encoded.
+func marshalGQL(w io.Writer, m json.Marshaler) {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		data = nullBytes
+	}
+	_, _ = w.Write(data)
+}
+
+// unmarshalGQL decodes a GraphQL input value into u via its JSON representation.
+func unmarshalGQL(u json.Unmarshaler, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("nulldecimal: couldn't unmarshal GraphQL: %w", err)
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode null if this Decimal is null.
+func (d Decimal) MarshalGQL(w io.Writer) {
+	marshalGQL(w, d)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports anything this Decimal can be unmarshaled from as JSON.
+func (d *Decimal) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(d, input)
+}
@@ -0,0 +1,33 @@
+package nulldecimal
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestDecimalGraphQL(t *testing.T) {
+	var buf bytes.Buffer
+	DecimalFrom(decimalValue).MarshalGQL(&buf)
+	if buf.String() != `"12345.6789"` {
+		t.Errorf("bad MarshalGQL: %s", buf.String())
+	}
+	buf.Reset()
+	NewDecimal(decimalValue, false).MarshalGQL(&buf)
+	if buf.String() != "null" {
+		t.Errorf("bad null MarshalGQL: %s", buf.String())
+	}
+
+	var d Decimal
+	for _, v := range []interface{}{"12345.6789", json.Number("12345.6789"), 12345.6789} {
+		err := d.UnmarshalGQL(v)
+		maybePanic(err)
+		assertDecimal(t, d, "UnmarshalGQL")
+	}
+	err := d.UnmarshalGQL(nil)
+	maybePanic(err)
+	assertNullDecimal(t, d, "UnmarshalGQL nil")
+	if err := d.UnmarshalGQL(true); err == nil {
+		t.Error("expected error for boolean into Decimal")
+	}
+}
@@ -0,0 +1,30 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package nulldecimal
+
+import (
+	"encoding/json/jsontext"
+)
+
+// This file implements the MarshalerTo and UnmarshalerFrom interfaces from encoding/json/v2,
+// which are available from Go 1.27 unless disabled with GOEXPERIMENT=nojsonv2.
+// They produce the same output as MarshalJSON and accept the same input as UnmarshalJSON.
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Decimal is null, otherwise a string.
+func (d Decimal) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !d.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.String(d.Decimal.String()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (d *Decimal) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalJSON(data)
+}
@@ -0,0 +1,37 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package nulldecimal
+
+import (
+	jsonv2 "encoding/json/v2"
+	"testing"
+)
+
+func TestDecimalJSONv2(t *testing.T) {
+	data, err := jsonv2.Marshal(DecimalFrom(decimalValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"12345.6789"`, "json/v2 marshal")
+	data, err = jsonv2.Marshal(NewDecimal(decimalValue, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "json/v2 null marshal")
+
+	var d Decimal
+	for _, in := range []string{`"12345.6789"`, "12345.6789"} {
+		err = jsonv2.Unmarshal([]byte(in), &d)
+		maybePanic(err)
+		assertDecimal(t, d, "json/v2 unmarshal "+in)
+	}
+	err = jsonv2.Unmarshal([]byte("null"), &d)
+	maybePanic(err)
+	assertNullDecimal(t, d, "json/v2 null unmarshal")
+	if err := jsonv2.Unmarshal([]byte("true"), &d); err == nil {
+		t.Error("expected error for boolean into Decimal")
+	}
+
+	type omit struct {
+		D Decimal `json:",omitzero"`
+	}
+	data, err = jsonv2.Marshal(omit{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "{}", "json/v2 omitzero")
+}
@@ -0,0 +1,57 @@
+//go:build spanner
+
+package nulldecimal
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/shopspring/decimal"
+)
+
+// This file implements spanner.Encoder and spanner.Decoder from the Cloud Spanner client.
+// It is only built with the spanner build tag:
+//
+//	go build -tags spanner
+
+// spannerScale is the number of digits after the decimal point in a Spanner NUMERIC.
+const spannerScale = 9
+
+// DecimalFromSpanner creates a new Decimal from a spanner.NullNumeric.
+func DecimalFromSpanner(n spanner.NullNumeric) Decimal {
+	if !n.Valid {
+		return Decimal{}
+	}
+	return DecimalFrom(decimal.NewFromBigRat(&n.Numeric, spannerScale))
+}
+
+// ToSpanner returns this Decimal as a spanner.NullNumeric.
+func (d Decimal) ToSpanner() spanner.NullNumeric {
+	if !d.Valid {
+		return spanner.NullNumeric{}
+	}
+	return spanner.NullNumeric{Numeric: *d.Decimal.Rat(), Valid: true}
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Decimal is null, otherwise a NUMERIC.
+// Spanner rounds digits after the 9th decimal place.
+func (d Decimal) EncodeSpanner() (interface{}, error) {
+	return d.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null, NUMERIC and STRING values, which Spanner sends as strings, and FLOAT64 values.
+func (d *Decimal) DecodeSpanner(input interface{}) error {
+	var v interface{}
+	switch x := input.(type) {
+	case *string, *float64, *bool:
+		// Spanner passes NULL as a typed nil pointer
+		v = nil
+	case string, float64:
+		v = x
+	default:
+		return fmt.Errorf("nulldecimal: couldn't decode Spanner value of type %T", input)
+	}
+	return d.Scan(v)
+}
@@ -0,0 +1,33 @@
+//go:build spanner
+
+package nulldecimal
+
+import (
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	"github.com/shopspring/decimal"
+)
+
+func TestSpannerRoundTrip(t *testing.T) {
+	for _, in := range []Decimal{DecimalFrom(decimal.RequireFromString("-1234.5678")), {}} {
+		row, err := spanner.NewRow([]string{"d"}, []interface{}{in})
+		maybePanic(err)
+		out := DecimalFrom(decimal.NewFromInt(1))
+		err = row.Column(0, &out)
+		maybePanic(err)
+		if !out.Equal(in) {
+			t.Errorf("bad round trip: %v ≠ %v", out, in)
+		}
+	}
+}
+
+func TestSpannerConversions(t *testing.T) {
+	d := DecimalFrom(decimal.RequireFromString("0.125"))
+	if got := DecimalFromSpanner(d.ToSpanner()); !got.Equal(d) {
+		t.Errorf("bad conversion round trip: %v", got)
+	}
+	if DecimalFromSpanner(spanner.NullNumeric{}).Valid || (Decimal{}).ToSpanner().Valid {
+		t.Error("null should convert to null")
+	}
+}
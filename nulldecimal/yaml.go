@@ -0,0 +1,63 @@
+//go:build yaml
+
+package nulldecimal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// This file implements yaml.Marshaler and yaml.Unmarshaler from gopkg.in/yaml.v3.
+// It is only built with the yaml build tag:
+//
+//	go build -tags yaml
+//
+// Values are converted to and from the same representation as JSON.
+
+func marshalYAML(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("nulldecimal: couldn't marshal YAML: %w", err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("nulldecimal: couldn't marshal YAML: %w", err)
+	}
+	node := doc.Content[0]
+	plainYAML(node)
+	return node, nil
+}
+
+// plainYAML clears the JSON quoting style from node and its children.
+func plainYAML(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		plainYAML(child)
+	}
+}
+
+func unmarshalYAML(v interface{}, node *yaml.Node) error {
+	var x interface{}
+	if err := node.Decode(&x); err != nil {
+		return fmt.Errorf("nulldecimal: couldn't unmarshal YAML: %w", err)
+	}
+	data, err := json.Marshal(x)
+	if err != nil {
+		return fmt.Errorf("nulldecimal: couldn't unmarshal YAML: %w", err)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Decimal is null.
+func (d Decimal) MarshalYAML() (interface{}, error) {
+	return marshalYAML(d)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Decimal can be unmarshaled from as JSON.
+func (d *Decimal) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(d, node)
+}
@@ -0,0 +1,34 @@
+//go:build yaml
+
+package nulldecimal
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestDecimalYAML(t *testing.T) {
+	type config struct {
+		D Decimal
+	}
+	data, err := yaml.Marshal(config{DecimalFrom(decimalValue)})
+	maybePanic(err)
+	if string(data) != "d: \"12345.6789\"\n" {
+		t.Errorf("bad YAML: %s", data)
+	}
+	var out config
+	err = yaml.Unmarshal(data, &out)
+	maybePanic(err)
+	assertDecimal(t, out.D, "yaml string")
+
+	err = yaml.Unmarshal([]byte("d: 12345.6789"), &out)
+	maybePanic(err)
+	assertDecimal(t, out.D, "yaml number")
+
+	data, err = yaml.Marshal(config{})
+	maybePanic(err)
+	if string(data) != "d: null\n" {
+		t.Errorf("bad null YAML: %s", data)
+	}
+}
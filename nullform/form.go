@@ -0,0 +1,158 @@
+// Package nullform decodes HTML form and query string values into structs of null types.
+// Keys are taken from the form struct tag, or the field name if there isn't one,
+// and fields tagged with form:"-" are skipped.
+//
+// Missing keys decode as null. Empty values decode as null too, unless configured otherwise with Decoder.Empty.
+// Other values are decoded with UnmarshalText, or Scan for types without it such as null.Map.
+// null.Bool also accepts "on", which browsers send for checked checkboxes.
+//
+// Decoder implements gin's binding.Binding interface:
+//
+//	c.ShouldBindWith(&form, nullform.DefaultDecoder)
+//
+// and can be used from an echo Binder:
+//
+//	func (b binder) Bind(i any, c echo.Context) error {
+//		return nullform.Bind(c.Request(), i)
+//	}
+package nullform
+
+import (
+	"database/sql"
+	"encoding"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// EmptyMode controls how empty values are decoded.
+type EmptyMode int
+
+const (
+	// EmptyNull decodes empty values as null.
+	EmptyNull EmptyMode = iota
+	// EmptyValue scans empty values as a blank string, so that a null.String becomes valid and blank.
+	// Types that can't be scanned from a blank string, such as null.Int, will return an error.
+	EmptyValue
+	// EmptyError returns an error for empty values.
+	EmptyError
+)
+
+// MaxMemory is the maximum number of bytes of a multipart form stored in memory by Bind.
+const MaxMemory = 32 << 20
+
+// Decoder decodes form values into structs of null types.
+// Its zero value is ready to use.
+type Decoder struct {
+	// Empty controls how empty values are decoded for each field type.
+	// Types that aren't in the map use EmptyNull. For example:
+	//
+	//	dec.Empty = map[reflect.Type]nullform.EmptyMode{
+	//		reflect.TypeOf(null.String{}): nullform.EmptyValue,
+	//	}
+	Empty map[reflect.Type]EmptyMode
+}
+
+// DefaultDecoder is the Decoder used by Decode, DecodeMultipart, and Bind.
+var DefaultDecoder = &Decoder{}
+
+// Decode decodes values into dst, which must be a pointer to a struct of null types, using DefaultDecoder.
+func Decode(dst any, values url.Values) error {
+	return DefaultDecoder.Decode(dst, values)
+}
+
+// DecodeMultipart decodes the values of a multipart form into dst using DefaultDecoder.
+// Files are ignored.
+func DecodeMultipart(dst any, form *multipart.Form) error {
+	return DefaultDecoder.DecodeMultipart(dst, form)
+}
+
+// Bind parses the form of r and decodes it into dst using DefaultDecoder.
+func Bind(r *http.Request, dst any) error {
+	return DefaultDecoder.Bind(r, dst)
+}
+
+// Decode decodes values into dst, which must be a pointer to a struct of null types.
+// If a key has more than one value, the first is used.
+func (d *Decoder) Decode(dst any, values url.Values) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("nullform: can't decode into %T", dst)
+	}
+	v = v.Elem()
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		key, _, _ := strings.Cut(f.Tag.Get("form"), ",")
+		if !f.IsExported() || key == "-" {
+			continue
+		}
+		if key == "" {
+			key = f.Name
+		}
+		field := v.Field(i)
+		if _, ok := field.Addr().Interface().(sql.Scanner); !ok {
+			return fmt.Errorf("nullform: unsupported type %s for field %s", f.Type, f.Name)
+		}
+		vals, ok := values[key]
+		if !ok || len(vals) == 0 {
+			field.Set(reflect.Zero(f.Type))
+			continue
+		}
+		if err := d.decodeValue(field, vals[0]); err != nil {
+			return fmt.Errorf("nullform: couldn't decode %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// DecodeMultipart decodes the values of a multipart form into dst.
+// Files are ignored.
+func (d *Decoder) DecodeMultipart(dst any, form *multipart.Form) error {
+	if form == nil {
+		return d.Decode(dst, nil)
+	}
+	return d.Decode(dst, url.Values(form.Value))
+}
+
+// Bind parses the form of r and decodes it into dst.
+// For multipart forms, at most MaxMemory bytes are stored in memory.
+func (d *Decoder) Bind(r *http.Request, dst any) error {
+	if err := r.ParseMultipartForm(MaxMemory); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return fmt.Errorf("nullform: %w", err)
+	}
+	return d.Decode(dst, r.Form)
+}
+
+// Name returns "nullform". It implements gin's binding.Binding together with Bind.
+func (d *Decoder) Name() string {
+	return "nullform"
+}
+
+func (d *Decoder) decodeValue(field reflect.Value, value string) error {
+	ptr := field.Addr().Interface()
+	if value == "" {
+		switch d.Empty[field.Type()] {
+		case EmptyValue:
+			return ptr.(sql.Scanner).Scan("")
+		case EmptyError:
+			return errors.New("empty value")
+		}
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	if b, ok := ptr.(*null.Bool); ok && value == "on" {
+		*b = null.BoolFrom(true)
+		return nil
+	}
+	if u, ok := ptr.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(value))
+	}
+	return ptr.(sql.Scanner).Scan(value)
+}
@@ -0,0 +1,124 @@
+package nullform
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+type form struct {
+	Name     null.String `form:"name"`
+	Age      null.Int    `form:"age"`
+	Agree    null.Bool   `form:"agree"`
+	Born     null.Date   `form:"born"`
+	Tags     null.Map[string, int]
+	Nickname zero.String `form:"nickname"`
+	Ignored  null.String `form:"-"`
+	private  int
+}
+
+func TestDecode(t *testing.T) {
+	values := url.Values{
+		"name":  {"Gopher", "ignored"},
+		"age":   {""},
+		"agree": {"on"},
+		"born":  {"2009-11-10"},
+		"Tags":  {`{"a":1}`},
+	}
+	out := form{Age: null.IntFrom(1), Nickname: zero.StringFrom("old")}
+	if err := Decode(&out, values); err != nil {
+		t.Fatal(err)
+	}
+	want := form{
+		Name:  null.StringFrom("Gopher"),
+		Agree: null.BoolFrom(true),
+		Born:  null.DateFrom(time.Date(2009, 11, 10, 0, 0, 0, 0, time.UTC)),
+		Tags:  null.MapFrom(map[string]int{"a": 1}),
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("bad decode:\n got: %#v\nwant: %#v", out, want)
+	}
+
+	if err := Decode(&out, url.Values{"age": {"hello"}}); err == nil || !strings.Contains(err.Error(), "age") {
+		t.Errorf("expected error for age, got %v", err)
+	}
+	if err := Decode(out, values); err == nil {
+		t.Error("expected error decoding into non-pointer")
+	}
+	if err := Decode(&struct{ N int }{}, values); err == nil {
+		t.Error("expected error for unsupported field type")
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	dec := &Decoder{Empty: map[reflect.Type]EmptyMode{
+		reflect.TypeOf(null.String{}): EmptyValue,
+		reflect.TypeOf(null.Int{}):    EmptyError,
+	}}
+	var out form
+	if err := dec.Decode(&out, url.Values{"name": {""}, "born": {""}}); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Name.Equal(null.StringFrom("")) || out.Born.Valid {
+		t.Errorf("bad empty values: %#v", out)
+	}
+	if err := dec.Decode(&out, url.Values{"age": {""}}); err == nil {
+		t.Error("expected error for empty age")
+	}
+}
+
+func TestBind(t *testing.T) {
+	req := httptest.NewRequest("POST", "/?age=42", strings.NewReader("name=Gopher"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var out form
+	if err := Bind(req, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Name.Equal(null.StringFrom("Gopher")) || !out.Age.Equal(null.IntFrom(42)) {
+		t.Errorf("bad bind: %#v", out)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	if err := mw.WriteField("age", "7"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	req = httptest.NewRequest("POST", "/", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	out = form{}
+	if err := DefaultDecoder.Bind(req, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Age.Equal(null.IntFrom(7)) || out.Name.Valid {
+		t.Errorf("bad multipart bind: %#v", out)
+	}
+
+	// gin's binding.Binding
+	var _ interface {
+		Name() string
+		Bind(*http.Request, any) error
+	} = DefaultDecoder
+}
+
+func TestDecodeMultipart(t *testing.T) {
+	var out form
+	err := DecodeMultipart(&out, &multipart.Form{Value: map[string][]string{"name": {"Gopher"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !out.Name.Equal(null.StringFrom("Gopher")) {
+		t.Errorf("bad multipart decode: %#v", out)
+	}
+}
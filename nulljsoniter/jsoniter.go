@@ -0,0 +1,196 @@
+// Package nulljsoniter registers an extension for github.com/json-iterator/go,
+// so that the null, zero, and nulldecimal types are encoded and decoded with their own JSON methods
+// instead of jsoniter's reflection path, which treats them as structs in some configurations.
+// It lives in its own package so that the null package stays free of dependencies.
+// Import it for its side effects:
+//
+//	import _ "gopkg.in/guregu/null.v4/nulljsoniter"
+//
+// Values are encoded with AppendJSON directly into the stream's buffer, and decoded with UnmarshalJSON,
+// so the output is the same as encoding/json.
+// Null values are considered empty, so they are omitted by the omitempty option.
+//
+// easyjson doesn't need an extension: its generated code calls MarshalJSON and UnmarshalJSON
+// for fields of types that implement them, which every type in these packages does.
+package nulljsoniter
+
+import (
+	"encoding/json"
+	"reflect"
+	"unsafe"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/modern-go/reflect2"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+func init() {
+	register[null.Addr]()
+	register[null.Any]()
+	register[null.Base64]()
+	register[null.BigInt]()
+	register[null.Bool]()
+	register[null.ByteSize]()
+	register[null.Bytes]()
+	register[null.Complex]()
+	register[null.Date]()
+	register[null.Duration]()
+	register[null.Email]()
+	register[null.FileMode]()
+	register[null.Float]()
+	register[null.HardwareAddr]()
+	register[null.Hex]()
+	register[null.Int]()
+	register[null.Int16]()
+	register[null.Int32]()
+	register[null.Int8]()
+	register[null.CountryCode]()
+	register[null.CurrencyCode]()
+	register[null.JSON]()
+	register[null.KSUID]()
+	register[null.LanguageTag]()
+	register[null.Money]()
+	register[null.Percent]()
+	register[null.Point]()
+	register[null.Prefix]()
+	register[null.Int64Range]()
+	register[null.TimeRange]()
+	register[null.Regexp]()
+	register[null.Semver]()
+	register[null.String]()
+	register[null.Time]()
+	register[null.TimeOfDay]()
+	register[null.Uint]()
+	register[null.Uint16]()
+	register[null.Uint32]()
+	register[null.Uint8]()
+	register[null.ULID]()
+	register[null.UnixSeconds]()
+	register[null.UnixMillis]()
+	register[null.URL]()
+
+	register[zero.Bool]()
+	register[zero.Float]()
+	register[zero.Int]()
+	register[zero.String]()
+	register[zero.Time]()
+
+	jsoniter.RegisterExtension(&Extension{})
+}
+
+// Extension is the jsoniter extension registered by this package.
+// It handles every type from the null, zero, and nulldecimal packages that implements AppendJSON,
+// including instantiations of generic types such as null.Value[T].
+type Extension struct {
+	jsoniter.DummyExtension
+}
+
+type jsonAppender interface {
+	AppendJSON(dst []byte) ([]byte, error)
+	IsZero() bool
+}
+
+var (
+	codecs = make(map[reflect.Type]codec)
+
+	appenderType    = reflect.TypeOf((*jsonAppender)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+	packages = map[string]bool{
+		reflect.TypeOf(null.Int{}).PkgPath():                  true,
+		reflect.TypeOf(zero.Int{}).PkgPath():                  true,
+		reflect.TypeOf(null.Int{}).PkgPath() + "/nulldecimal": true,
+	}
+)
+
+type codec interface {
+	jsoniter.ValEncoder
+	jsoniter.ValDecoder
+}
+
+// register adds a codec for T that doesn't need reflection.
+func register[T jsonAppender, PT interface {
+	*T
+	json.Unmarshaler
+}]() {
+	codecs[reflect.TypeOf((*T)(nil)).Elem()] = typedCodec[T, PT]{}
+}
+
+func (ext *Extension) codecOf(typ reflect2.Type) codec {
+	t := typ.Type1()
+	if c, ok := codecs[t]; ok {
+		return c
+	}
+	if packages[t.PkgPath()] && t.Implements(appenderType) && reflect.PointerTo(t).Implements(unmarshalerType) {
+		return reflectCodec{typ: typ}
+	}
+	return nil
+}
+
+// CreateEncoder implements jsoniter.Extension.
+func (ext *Extension) CreateEncoder(typ reflect2.Type) jsoniter.ValEncoder {
+	return ext.codecOf(typ)
+}
+
+// CreateDecoder implements jsoniter.Extension.
+func (ext *Extension) CreateDecoder(typ reflect2.Type) jsoniter.ValDecoder {
+	return ext.codecOf(typ)
+}
+
+type typedCodec[T jsonAppender, PT interface {
+	*T
+	json.Unmarshaler
+}] struct{}
+
+func (typedCodec[T, PT]) IsEmpty(ptr unsafe.Pointer) bool {
+	return (*(*T)(ptr)).IsZero()
+}
+
+func (typedCodec[T, PT]) Encode(ptr unsafe.Pointer, stream *jsoniter.Stream) {
+	encode(*(*T)(ptr), stream)
+}
+
+func (typedCodec[T, PT]) Decode(ptr unsafe.Pointer, iter *jsoniter.Iterator) {
+	decode(PT(ptr), iter)
+}
+
+// reflectCodec handles types that weren't registered, such as generic types.
+type reflectCodec struct {
+	typ reflect2.Type
+}
+
+func (c reflectCodec) IsEmpty(ptr unsafe.Pointer) bool {
+	return c.typ.UnsafeIndirect(ptr).(jsonAppender).IsZero()
+}
+
+func (c reflectCodec) Encode(ptr unsafe.Pointer, stream *jsoniter.Stream) {
+	encode(c.typ.UnsafeIndirect(ptr).(jsonAppender), stream)
+}
+
+func (c reflectCodec) Decode(ptr unsafe.Pointer, iter *jsoniter.Iterator) {
+	decode(c.typ.PackEFace(ptr).(json.Unmarshaler), iter)
+}
+
+func encode(v jsonAppender, stream *jsoniter.Stream) {
+	buf, err := v.AppendJSON(stream.Buffer())
+	if err != nil {
+		if stream.Error == nil {
+			stream.Error = err
+		}
+		return
+	}
+	stream.SetBuffer(buf)
+}
+
+func decode(u json.Unmarshaler, iter *jsoniter.Iterator) {
+	// skip whitespace before capturing the value
+	iter.WhatIsNext()
+	data := iter.SkipAndReturnBytes()
+	if iter.Error != nil {
+		return
+	}
+	if err := u.UnmarshalJSON(data); err != nil {
+		iter.ReportError("nulljsoniter", err.Error())
+	}
+}
@@ -0,0 +1,169 @@
+package nulljsoniter
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/nulldecimal"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+type record struct {
+	Base64  null.Base64
+	Bool    null.Bool
+	Bytes   null.Bytes
+	Decimal nulldecimal.Decimal
+	Float   null.Float
+	Int     null.Int
+	Int8    null.Int8
+	Money   null.Money
+	Range   null.Int64Range
+	String  null.String
+	Time    null.Time
+	Uint    null.Uint
+	Uint8   null.Uint8
+	ULID    null.ULID
+	Value   null.Value[int]
+	ZBool   zero.Bool
+	ZInt    zero.Int
+	ZString zero.String
+	ZTime   zero.Time
+}
+
+type omitRecord struct {
+	Int     null.Int        `json:",omitempty"`
+	String  null.String     `json:",omitempty"`
+	Value   null.Value[int] `json:",omitempty"`
+	ZString zero.String     `json:",omitempty"`
+	Bool    null.Bool
+}
+
+var configs = map[string]jsoniter.API{
+	"ConfigDefault":                       jsoniter.ConfigDefault,
+	"ConfigCompatibleWithStandardLibrary": jsoniter.ConfigCompatibleWithStandardLibrary,
+	"ConfigFastest":                       jsoniter.ConfigFastest,
+}
+
+func example() record {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+	dec, err := nulldecimal.DecimalFromString("12.345")
+	if err != nil {
+		panic(err)
+	}
+	return record{
+		Base64:  null.Base64From([]byte("hello")),
+		Bool:    null.BoolFrom(false),
+		Bytes:   null.BytesFrom([]byte{0, 1, 2}),
+		Decimal: dec,
+		Float:   null.FloatFrom(1.5),
+		Int:     null.IntFrom(-300),
+		Int8:    null.Int8From(-8),
+		Money:   null.MoneyFrom(1050, "USD"),
+		Range:   null.Int64RangeFrom(1, 5),
+		String:  null.StringFrom("hello <world>"),
+		Time:    null.TimeFrom(now),
+		Uint:    null.UintFrom(math.MaxUint64),
+		Uint8:   null.Uint8From(200),
+		ULID:    null.ULIDFrom([16]byte{1, 2, 3}),
+		Value:   null.ValueFrom(42),
+		ZBool:   zero.BoolFrom(true),
+		ZInt:    zero.IntFrom(7),
+		ZString: zero.StringFrom("zero"),
+		ZTime:   zero.TimeFrom(now),
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for name, api := range configs {
+		for _, in := range []record{example(), {}} {
+			want, err := json.Marshal(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := api.Marshal(in)
+			if err != nil {
+				t.Fatal(name, err)
+			}
+			if name == "ConfigCompatibleWithStandardLibrary" && string(data) != string(want) {
+				t.Errorf("%s: mismatch:\n got: %s\nwant: %s", name, data, want)
+			}
+
+			var out record
+			if err := api.Unmarshal(data, &out); err != nil {
+				t.Fatal(name, err)
+			}
+			assertEqualJSON(t, out, in)
+		}
+	}
+}
+
+func TestOmitEmpty(t *testing.T) {
+	for name, api := range configs {
+		data, err := api.Marshal(omitRecord{})
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if string(data) != `{"Bool":null}` {
+			t.Errorf("%s: bad omitempty output: %s", name, data)
+		}
+
+		data, err = api.Marshal(omitRecord{Int: null.IntFrom(0), String: null.StringFrom(""), Value: null.ValueFrom(0), ZString: zero.StringFrom("a")})
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if string(data) != `{"Int":0,"String":"","Value":0,"ZString":"a","Bool":null}` {
+			t.Errorf("%s: bad omitempty output: %s", name, data)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	var out record
+	data := []byte(`{ "Int" : 5 , "String":	"a"
+		, "Value":null, "Decimal": "1.5", "ZInt": null }`)
+	if err := jsoniter.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Int.Equal(null.IntFrom(5)) || !out.String.Equal(null.StringFrom("a")) || out.Value.Valid || out.ZInt.Valid {
+		t.Errorf("bad decoded values: %#v", out)
+	}
+	if out.Decimal.Decimal.String() != "1.5" {
+		t.Errorf("bad decoded decimal: %v", out.Decimal)
+	}
+
+	for _, bad := range []string{
+		`{"Int":"hello"}`,
+		`{"Int":{"a":1}}`,
+		`{"Value":"x"}`,
+		`{"Int":`,
+	} {
+		if err := jsoniter.Unmarshal([]byte(bad), &out); err == nil {
+			t.Errorf("expected error decoding %s", bad)
+		}
+	}
+}
+
+func TestEncodeError(t *testing.T) {
+	if _, err := jsoniter.Marshal(record{Float: null.FloatFrom(math.NaN())}); err == nil {
+		t.Error("expected error for NaN")
+	}
+}
+
+func assertEqualJSON(t *testing.T, got, want interface{}) {
+	t.Helper()
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(gotJSON) != string(wantJSON) {
+		t.Errorf("mismatch:\n got: %s\nwant: %s", gotJSON, wantJSON)
+	}
+}
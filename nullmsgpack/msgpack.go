@@ -0,0 +1,147 @@
+// Package nullmsgpack registers MessagePack encoders and decoders for the null and zero types
+// with github.com/vmihailenco/msgpack/v5, so that null values are encoded as nil instead of structs.
+// It lives in its own package so that the null package stays free of dependencies.
+// Import it for its side effects:
+//
+//	import _ "gopkg.in/guregu/null.v4/nullmsgpack"
+//
+// Valid values of null types are encoded as the MessagePack equivalent of their SQL value (see Value),
+// and can be decoded from anything they can be scanned from.
+// Like JSON, the zero types encode null as the zero value, and decode zero values as null.
+// Generic types such as null.Value[T], and types from other packages such as nulldecimal.Decimal,
+// must be registered with Register.
+package nullmsgpack
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+func init() {
+	Register[null.Addr]()
+	Register[null.Any]()
+	Register[null.Base64]()
+	Register[null.BigInt]()
+	Register[null.Bool]()
+	Register[null.ByteSize]()
+	Register[null.Bytes]()
+	Register[null.Complex]()
+	Register[null.Date]()
+	Register[null.Duration]()
+	Register[null.Email]()
+	Register[null.FileMode]()
+	Register[null.Float]()
+	Register[null.HardwareAddr]()
+	Register[null.Hex]()
+	Register[null.Int]()
+	Register[null.Int16]()
+	Register[null.Int32]()
+	Register[null.Int8]()
+	Register[null.CountryCode]()
+	Register[null.CurrencyCode]()
+	Register[null.JSON]()
+	Register[null.KSUID]()
+	Register[null.LanguageTag]()
+	Register[null.Money]()
+	Register[null.Percent]()
+	Register[null.Point]()
+	Register[null.Prefix]()
+	Register[null.Int64Range]()
+	Register[null.TimeRange]()
+	Register[null.Regexp]()
+	Register[null.Semver]()
+	Register[null.String]()
+	Register[null.Time]()
+	Register[null.TimeOfDay]()
+	Register[null.Uint]()
+	Register[null.Uint16]()
+	Register[null.Uint32]()
+	Register[null.Uint8]()
+	Register[null.ULID]()
+	Register[null.UnixSeconds]()
+	Register[null.UnixMillis]()
+	Register[null.URL]()
+
+	registerZero(zero.BoolFrom, zero.Bool.ValueOrZero)
+	registerZero(zero.FloatFrom, zero.Float.ValueOrZero)
+	registerZero(zero.IntFrom, zero.Int.ValueOrZero)
+	registerZero(zero.StringFrom, zero.String.ValueOrZero)
+	registerZero(zero.TimeFrom, zero.Time.ValueOrZero)
+}
+
+// Register registers MessagePack encoding for T, which must implement driver.Valuer and sql.Scanner.
+// It must be called before T is first encoded or decoded, typically from an init function.
+// For example:
+//
+//	nullmsgpack.Register[null.Value[int]]()
+func Register[T driver.Valuer, PT interface {
+	*T
+	sql.Scanner
+}]() {
+	var v T
+	msgpack.Register(v, encodeValuer, decodeScanner)
+}
+
+func encodeValuer(enc *msgpack.Encoder, v reflect.Value) error {
+	val, err := v.Interface().(driver.Valuer).Value()
+	if err != nil {
+		return fmt.Errorf("nullmsgpack: couldn't encode %s: %w", v.Type(), err)
+	}
+	if val == nil {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(val)
+}
+
+func decodeScanner(dec *msgpack.Decoder, v reflect.Value) error {
+	x, err := dec.DecodeInterface()
+	if err != nil {
+		return err
+	}
+	// convert to driver value types
+	switch n := x.(type) {
+	case int8:
+		x = int64(n)
+	case int16:
+		x = int64(n)
+	case int32:
+		x = int64(n)
+	case uint8:
+		x = int64(n)
+	case uint16:
+		x = int64(n)
+	case uint32:
+		x = int64(n)
+	case float32:
+		x = float64(n)
+	case nil, bool, int64, uint64, float64, string, []byte, time.Time:
+	default:
+		return fmt.Errorf("nullmsgpack: couldn't decode %T into %s", x, v.Type())
+	}
+	return v.Addr().Interface().(sql.Scanner).Scan(x)
+}
+
+// registerZero registers MessagePack encoding for a zero type, using its constructor and ValueOrZero.
+func registerZero[T, V any](from func(V) T, value func(T) V) {
+	var v T
+	msgpack.Register(v,
+		func(enc *msgpack.Encoder, v reflect.Value) error {
+			return enc.Encode(value(v.Interface().(T)))
+		},
+		func(dec *msgpack.Decoder, v reflect.Value) error {
+			var x V
+			if err := dec.Decode(&x); err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(from(x)))
+			return nil
+		},
+	)
+}
@@ -0,0 +1,153 @@
+package nullmsgpack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+type record struct {
+	Base64  null.Base64
+	Bool    null.Bool
+	Bytes   null.Bytes
+	Float   null.Float
+	Int     null.Int
+	Int8    null.Int8
+	Money   null.Money
+	Range   null.Int64Range
+	String  null.String
+	Time    null.Time
+	Uint    null.Uint
+	Uint8   null.Uint8
+	ULID    null.ULID
+	Value   null.Value[int]
+	ZBool   zero.Bool
+	ZInt    zero.Int
+	ZString zero.String
+	ZTime   zero.Time
+}
+
+func init() {
+	Register[null.Value[int]]()
+}
+
+func TestRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+	in := record{
+		Base64:  null.Base64From([]byte("hello")),
+		Bool:    null.BoolFrom(false),
+		Bytes:   null.BytesFrom([]byte{0, 1, 2}),
+		Float:   null.FloatFrom(1.5),
+		Int:     null.IntFrom(-300),
+		Int8:    null.Int8From(-8),
+		Money:   null.MoneyFrom(1050, "USD"),
+		Range:   null.Int64RangeFrom(1, 5),
+		String:  null.StringFrom("hello"),
+		Time:    null.TimeFrom(now),
+		Uint:    null.UintFrom(math.MaxUint64),
+		Uint8:   null.Uint8From(200),
+		ULID:    null.ULIDFrom([16]byte{1, 2, 3}),
+		Value:   null.ValueFrom(42),
+		ZBool:   zero.BoolFrom(true),
+		ZInt:    zero.IntFrom(7),
+		ZString: zero.StringFrom("zero"),
+		ZTime:   zero.TimeFrom(now),
+	}
+	data, err := msgpack.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out record
+	if err := msgpack.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	assertEqualJSON(t, out, in)
+}
+
+func TestNull(t *testing.T) {
+	data, err := msgpack.Marshal(record{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]interface{}
+	if err := msgpack.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range m {
+		switch k {
+		case "ZBool":
+			if v != false {
+				t.Errorf("%s: got %#v, want false", k, v)
+			}
+		case "ZInt":
+			if fmt.Sprint(v) != "0" {
+				t.Errorf("%s: got %#v, want 0", k, v)
+			}
+		case "ZString":
+			if v != "" {
+				t.Errorf("%s: got %#v, want blank string", k, v)
+			}
+		case "ZTime":
+			if tt, ok := v.(time.Time); !ok || !tt.IsZero() {
+				t.Errorf("%s: got %#v, want zero time", k, v)
+			}
+		default:
+			if v != nil {
+				t.Errorf("%s: got %#v, want nil", k, v)
+			}
+		}
+	}
+
+	out := record{Int: null.IntFrom(1), Uint: null.UintFrom(1), ZInt: zero.IntFrom(1)}
+	if err := msgpack.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Int.Valid || out.Uint.Valid || out.ZInt.Valid || out.ZTime.Valid {
+		t.Errorf("nil should decode as null: %#v", out)
+	}
+}
+
+func TestDecodeTypes(t *testing.T) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	if err := enc.Encode(map[string]interface{}{"Int": uint16(500), "Float": float32(0.5), "Uint": int8(3)}); err != nil {
+		t.Fatal(err)
+	}
+	var out record
+	if err := msgpack.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Int.Equal(null.IntFrom(500)) || !out.Float.Equal(null.FloatFrom(0.5)) || !out.Uint.Equal(null.UintFrom(3)) {
+		t.Errorf("bad decoded values: %#v", out)
+	}
+
+	bad, err := msgpack.Marshal(map[string]interface{}{"Int": []int{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msgpack.Unmarshal(bad, &out); err == nil {
+		t.Error("expected error decoding array into Int")
+	}
+}
+
+func assertEqualJSON(t *testing.T, got, want interface{}) {
+	t.Helper()
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(gotJSON, wantJSON) {
+		t.Errorf("mismatch:\n got: %s\nwant: %s", gotJSON, wantJSON)
+	}
+}
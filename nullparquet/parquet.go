@@ -0,0 +1,440 @@
+// Package nullparquet reads and writes structs of null types as Parquet files using github.com/parquet-go/parquet-go.
+// Each exported field becomes an OPTIONAL column, and null values are stored as Parquet nulls.
+// It lives in its own package so that the null package stays free of dependencies.
+//
+// Fields are stored as the Parquet logical type closest to their SQL value (see Value):
+// null.Int as INT(64), null.Int8 as INT(8), null.Time as a TIMESTAMP with microsecond precision,
+// null.Date as DATE, null.String as STRING, and so on.
+// Column names are taken from the parquet struct tag, or the field name if there isn't one,
+// and fields tagged with parquet:"-" are skipped.
+// Like SQL, the zero types store zero values as null.
+// Generic types such as null.Value[T], and types from other packages such as nulldecimal.Decimal,
+// must be registered with Register.
+package nullparquet
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/format"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+var nodes = make(map[reflect.Type]parquet.Node)
+
+func init() {
+	str := parquet.String()
+	Register[null.Addr](str)
+	Register[null.Any](str)
+	Register[null.Base64](parquet.Leaf(parquet.ByteArrayType))
+	Register[null.BigInt](str)
+	Register[null.Bool](parquet.Leaf(parquet.BooleanType))
+	Register[null.ByteSize](parquet.Int(64))
+	Register[null.Bytes](parquet.Leaf(parquet.ByteArrayType))
+	Register[null.Complex](str)
+	Register[null.Date](parquet.Date())
+	Register[null.Duration](parquet.Int(64))
+	Register[null.Email](str)
+	Register[null.FileMode](parquet.Uint(32))
+	Register[null.Float](parquet.Leaf(parquet.DoubleType))
+	Register[null.HardwareAddr](str)
+	Register[null.Hex](parquet.Leaf(parquet.ByteArrayType))
+	Register[null.Int](parquet.Int(64))
+	Register[null.Int16](parquet.Int(16))
+	Register[null.Int32](parquet.Int(32))
+	Register[null.Int8](parquet.Int(8))
+	Register[null.CountryCode](str)
+	Register[null.CurrencyCode](str)
+	Register[null.JSON](parquet.JSON())
+	Register[null.KSUID](str)
+	Register[null.LanguageTag](str)
+	Register[null.Money](str)
+	Register[null.Percent](parquet.Leaf(parquet.DoubleType))
+	Register[null.Point](str)
+	Register[null.Prefix](str)
+	Register[null.Int64Range](str)
+	Register[null.TimeRange](str)
+	Register[null.Regexp](str)
+	Register[null.Semver](str)
+	Register[null.String](str)
+	Register[null.Time](parquet.Timestamp(parquet.Microsecond))
+	Register[null.TimeOfDay](str)
+	Register[null.Uint](parquet.Uint(64))
+	Register[null.Uint16](parquet.Uint(16))
+	Register[null.Uint32](parquet.Uint(32))
+	Register[null.Uint8](parquet.Uint(8))
+	Register[null.ULID](str)
+	Register[null.UnixSeconds](parquet.Timestamp(parquet.Millisecond))
+	Register[null.UnixMillis](parquet.Timestamp(parquet.Millisecond))
+	Register[null.URL](str)
+
+	Register[zero.Bool](parquet.Leaf(parquet.BooleanType))
+	Register[zero.Float](parquet.Leaf(parquet.DoubleType))
+	Register[zero.Int](parquet.Int(64))
+	Register[zero.String](str)
+	Register[zero.Time](parquet.Timestamp(parquet.Microsecond))
+}
+
+// Register registers T, which must implement driver.Valuer and sql.Scanner, to be stored as node.
+// node should be a leaf node such as parquet.String(); it is always made optional.
+// Register must be called before a schema containing T is first used, typically from an init function.
+// For example:
+//
+//	nullparquet.Register[null.Value[int]](parquet.Int(64))
+//	nullparquet.Register[nulldecimal.Decimal](parquet.String())
+func Register[T driver.Valuer, PT interface {
+	*T
+	sql.Scanner
+}](node parquet.Node) {
+	nodes[reflect.TypeOf((*T)(nil)).Elem()] = node
+}
+
+// column is a struct field stored as a Parquet column.
+type column struct {
+	index int
+	typ   parquet.Type
+}
+
+// codec converts between structs and Parquet rows.
+type codec struct {
+	schema  *parquet.Schema
+	columns []column // in schema column order
+}
+
+func codecOf(typ reflect.Type) *codec {
+	if typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("nullparquet: %s is not a struct", typ))
+	}
+	group := make(parquet.Group)
+	fields := make(map[string]int)
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("parquet"), ",")
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		node, ok := nodes[f.Type]
+		if !ok {
+			panic(fmt.Sprintf("nullparquet: unsupported type %s for field %s", f.Type, f.Name))
+		}
+		if _, dup := group[name]; dup {
+			panic(fmt.Sprintf("nullparquet: duplicate column %q in %s", name, typ))
+		}
+		group[name] = parquet.Optional(node)
+		fields[name] = i
+	}
+	c := &codec{schema: parquet.NewSchema(typ.Name(), group)}
+	for _, path := range c.schema.Columns() {
+		leaf, _ := c.schema.Lookup(path...)
+		c.columns = append(c.columns, column{index: fields[path[0]], typ: leaf.Node.Type()})
+	}
+	return c
+}
+
+// SchemaOf returns the Parquet schema for model, which must be a struct of null types.
+// It panics if a field's type is not supported.
+func SchemaOf(model any) *parquet.Schema {
+	return codecOf(reflect.TypeOf(model)).schema
+}
+
+func (c *codec) deconstruct(row parquet.Row, v reflect.Value) (parquet.Row, error) {
+	for i, col := range c.columns {
+		val, err := v.Field(col.index).Interface().(driver.Valuer).Value()
+		if err != nil {
+			return row, fmt.Errorf("nullparquet: couldn't encode %s: %w", v.Type().Field(col.index).Name, err)
+		}
+		if val == nil {
+			row = append(row, parquet.NullValue().Level(0, 0, i))
+			continue
+		}
+		pv, err := toParquet(col.typ, val)
+		if err != nil {
+			return row, fmt.Errorf("nullparquet: couldn't encode %s: %w", v.Type().Field(col.index).Name, err)
+		}
+		row = append(row, pv.Level(0, 1, i))
+	}
+	return row, nil
+}
+
+func (c *codec) reconstruct(v reflect.Value, row parquet.Row) error {
+	for _, pv := range row {
+		i := pv.Column()
+		if i < 0 || i >= len(c.columns) {
+			continue
+		}
+		col := c.columns[i]
+		var x any
+		if !pv.IsNull() {
+			x = fromParquet(col.typ, pv)
+		}
+		dst := v.Field(col.index).Addr().Interface()
+		if err := dst.(sql.Scanner).Scan(x); err != nil {
+			return fmt.Errorf("nullparquet: couldn't decode %s: %w", v.Type().Field(col.index).Name, err)
+		}
+	}
+	return nil
+}
+
+// toParquet converts a driver value to a Parquet value of type typ.
+func toParquet(typ parquet.Type, v driver.Value) (parquet.Value, error) {
+	logical := typ.LogicalType()
+	switch typ.Kind() {
+	case parquet.Boolean:
+		if b, ok := v.(bool); ok {
+			return parquet.BooleanValue(b), nil
+		}
+	case parquet.Int32:
+		if isDate(logical) {
+			t, err := asDate(v)
+			if err != nil {
+				return parquet.Value{}, err
+			}
+			return parquet.Int32Value(int32(t.Unix() / 86400)), nil
+		}
+		if n, ok := v.(int64); ok {
+			return parquet.Int32Value(int32(n)), nil
+		}
+	case parquet.Int64:
+		if unit, ok := timestampUnit(logical); ok {
+			t, ok := v.(time.Time)
+			if !ok {
+				break
+			}
+			return parquet.Int64Value(unixIn(t, unit)), nil
+		}
+		switch n := v.(type) {
+		case int64:
+			return parquet.Int64Value(n), nil
+		case uint64:
+			return parquet.Int64Value(int64(n)), nil
+		}
+	case parquet.Float:
+		if f, ok := v.(float64); ok {
+			return parquet.FloatValue(float32(f)), nil
+		}
+	case parquet.Double:
+		if f, ok := v.(float64); ok {
+			return parquet.DoubleValue(f), nil
+		}
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		var b []byte
+		switch x := v.(type) {
+		case []byte:
+			b = x
+		case string:
+			b = []byte(x)
+		default:
+			return parquet.Value{}, fmt.Errorf("can't store %T as %s", v, typ)
+		}
+		if typ.Kind() == parquet.FixedLenByteArray {
+			return parquet.FixedLenByteArrayValue(b), nil
+		}
+		return parquet.ByteArrayValue(b), nil
+	}
+	return parquet.Value{}, fmt.Errorf("can't store %T as %s", v, typ)
+}
+
+// fromParquet converts a non-null Parquet value of type typ to a driver value.
+func fromParquet(typ parquet.Type, v parquet.Value) any {
+	logical := typ.LogicalType()
+	switch typ.Kind() {
+	case parquet.Boolean:
+		return v.Boolean()
+	case parquet.Int32:
+		if isDate(logical) {
+			return time.Unix(int64(v.Int32())*86400, 0).UTC()
+		}
+		if isUnsigned(logical) {
+			return int64(v.Uint32())
+		}
+		return int64(v.Int32())
+	case parquet.Int64:
+		if unit, ok := timestampUnit(logical); ok {
+			return timeIn(v.Int64(), unit)
+		}
+		if isUnsigned(logical) {
+			return v.Uint64()
+		}
+		return v.Int64()
+	case parquet.Float:
+		return float64(v.Float())
+	case parquet.Double:
+		return v.Double()
+	}
+	if logical != nil {
+		switch logical.Value.(type) {
+		case *format.StringType, *format.EnumType, *format.JsonType:
+			return string(v.ByteArray())
+		}
+	}
+	return bytes.Clone(v.ByteArray())
+}
+
+func isDate(logical *format.LogicalType) bool {
+	if logical == nil {
+		return false
+	}
+	_, ok := logical.Value.(*format.DateType)
+	return ok
+}
+
+func isUnsigned(logical *format.LogicalType) bool {
+	if logical == nil {
+		return false
+	}
+	i, ok := logical.Value.(*format.IntType)
+	return ok && !i.IsSigned
+}
+
+func timestampUnit(logical *format.LogicalType) (format.TimeUnit, bool) {
+	if logical == nil {
+		return format.TimeUnit{}, false
+	}
+	ts, ok := logical.Value.(*format.TimestampType)
+	if !ok {
+		return format.TimeUnit{}, false
+	}
+	return ts.Unit, true
+}
+
+func unixIn(t time.Time, unit format.TimeUnit) int64 {
+	switch unit.Value.(type) {
+	case *format.MilliSeconds:
+		return t.UnixMilli()
+	case *format.NanoSeconds:
+		return t.UnixNano()
+	}
+	return t.UnixMicro()
+}
+
+func timeIn(n int64, unit format.TimeUnit) time.Time {
+	switch unit.Value.(type) {
+	case *format.MilliSeconds:
+		return time.UnixMilli(n).UTC()
+	case *format.NanoSeconds:
+		return time.Unix(0, n).UTC()
+	}
+	return time.UnixMicro(n).UTC()
+}
+
+// asDate converts a driver value holding a date to midnight UTC.
+func asDate(v driver.Value) (time.Time, error) {
+	switch x := v.(type) {
+	case time.Time:
+		return time.Date(x.Year(), x.Month(), x.Day(), 0, 0, 0, 0, time.UTC), nil
+	case string:
+		return time.Parse("2006-01-02", x)
+	}
+	return time.Time{}, fmt.Errorf("can't store %T as DATE", v)
+}
+
+// Writer writes structs of null types to a Parquet file.
+type Writer[T any] struct {
+	w     *parquet.Writer
+	codec *codec
+	rows  []parquet.Row
+}
+
+// NewWriter creates a Writer for T, which must be a struct of null types.
+// The schema is derived from T and doesn't need to be passed as an option.
+// It panics if a field's type is not supported.
+func NewWriter[T any](output io.Writer, options ...parquet.WriterOption) *Writer[T] {
+	c := codecOf(reflect.TypeOf((*T)(nil)).Elem())
+	options = append([]parquet.WriterOption{c.schema}, options...)
+	return &Writer[T]{
+		w:     parquet.NewWriter(output, options...),
+		codec: c,
+	}
+}
+
+// Schema returns the Parquet schema of the file being written.
+func (w *Writer[T]) Schema() *parquet.Schema {
+	return w.codec.schema
+}
+
+// Write writes rows to the file, returning the number of rows written.
+func (w *Writer[T]) Write(rows []T) (int, error) {
+	w.rows = w.rows[:0]
+	for i := range rows {
+		row, err := w.codec.deconstruct(nil, reflect.ValueOf(&rows[i]).Elem())
+		if err != nil {
+			return 0, err
+		}
+		w.rows = append(w.rows, row)
+	}
+	return w.w.WriteRows(w.rows)
+}
+
+// Flush flushes buffered rows to the current row group.
+func (w *Writer[T]) Flush() error {
+	return w.w.Flush()
+}
+
+// Close flushes any buffered rows and writes the file footer.
+// It does not close the underlying io.Writer.
+func (w *Writer[T]) Close() error {
+	return w.w.Close()
+}
+
+// Reader reads structs of null types from a Parquet file.
+type Reader[T any] struct {
+	r     *parquet.Reader
+	codec *codec
+	rows  []parquet.Row
+}
+
+// NewReader creates a Reader for T, which must be a struct of null types.
+// Columns are matched by name, and converted from the file's schema if it differs from T's.
+// It panics if a field's type is not supported.
+func NewReader[T any](input io.ReaderAt, options ...parquet.ReaderOption) *Reader[T] {
+	c := codecOf(reflect.TypeOf((*T)(nil)).Elem())
+	options = append([]parquet.ReaderOption{c.schema}, options...)
+	return &Reader[T]{
+		r:     parquet.NewReader(input, options...),
+		codec: c,
+	}
+}
+
+// Schema returns the Parquet schema rows are read as.
+func (r *Reader[T]) Schema() *parquet.Schema {
+	return r.codec.schema
+}
+
+// NumRows returns the number of rows in the file.
+func (r *Reader[T]) NumRows() int64 {
+	return r.r.NumRows()
+}
+
+// Read reads up to len(rows) rows, returning the number of rows read.
+// It returns io.EOF when there are no more rows.
+func (r *Reader[T]) Read(rows []T) (int, error) {
+	if cap(r.rows) < len(rows) {
+		r.rows = make([]parquet.Row, len(rows))
+	}
+	r.rows = r.rows[:len(rows)]
+	n, err := r.r.ReadRows(r.rows)
+	for i := 0; i < n; i++ {
+		var zero T
+		rows[i] = zero
+		if err := r.codec.reconstruct(reflect.ValueOf(&rows[i]).Elem(), r.rows[i]); err != nil {
+			return i, err
+		}
+	}
+	return n, err
+}
+
+// Close closes the reader.
+func (r *Reader[T]) Close() error {
+	return r.r.Close()
+}
@@ -0,0 +1,171 @@
+package nullparquet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+type record struct {
+	Bool     null.Bool
+	Bytes    null.Bytes
+	Date     null.Date
+	Duration null.Duration
+	Float    null.Float
+	Int      null.Int `parquet:"int_col"`
+	Int8     null.Int8
+	JSON     null.JSON
+	Money    null.Money
+	String   null.String
+	Time     null.Time
+	Uint     null.Uint
+	Uint32   null.Uint32
+	Millis   null.UnixMillis
+	Value    null.Value[int]
+	ZInt     zero.Int
+	ZString  zero.String
+	Skipped  null.String `parquet:"-"`
+	private  int
+}
+
+func init() {
+	Register[null.Value[int]](parquet.Int(64))
+}
+
+func TestRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456000, time.UTC)
+	in := []record{
+		{
+			Bool:     null.BoolFrom(false),
+			Bytes:    null.BytesFrom([]byte{0, 1, 2}),
+			Date:     null.DateFrom(now),
+			Duration: null.DurationFrom(90 * time.Second),
+			Float:    null.FloatFrom(1.5),
+			Int:      null.IntFrom(-300),
+			Int8:     null.Int8From(-8),
+			JSON:     null.JSONFrom([]byte(`{"a":1}`)),
+			Money:    null.MoneyFrom(1050, "USD"),
+			String:   null.StringFrom("hello"),
+			Time:     null.TimeFrom(now),
+			Uint:     null.UintFrom(math.MaxUint64),
+			Uint32:   null.Uint32From(math.MaxUint32),
+			Millis:   null.UnixMillisFrom(now.Truncate(time.Millisecond)),
+			Value:    null.ValueFrom(42),
+			ZInt:     zero.IntFrom(7),
+			ZString:  zero.StringFrom("zero"),
+		},
+		{},
+	}
+	data := write(t, in)
+
+	r := NewReader[record](bytes.NewReader(data))
+	defer r.Close()
+	if r.NumRows() != 2 {
+		t.Fatalf("bad row count: %d", r.NumRows())
+	}
+	out := make([]record, 3)
+	n, err := r.Read(out)
+	if err != nil && !errors.Is(err, io.EOF) {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("read %d rows, want 2", n)
+	}
+	assertEqualJSON(t, out[:n], in)
+}
+
+func TestSchema(t *testing.T) {
+	schema := SchemaOf(record{})
+	want := map[string]string{
+		"Bool":     "BOOLEAN",
+		"Date":     "DATE",
+		"Int8":     "INT(8,true)",
+		"int_col":  "INT(64,true)",
+		"String":   "STRING",
+		"Time":     "TIMESTAMP(isAdjustedToUTC=true,unit=MICROS)",
+		"Uint":     "INT(64,false)",
+		"ZString":  "STRING",
+		"Skipped":  "",
+		"private":  "",
+		"Duration": "INT(64,true)",
+	}
+	for name, typ := range want {
+		leaf, ok := schema.Lookup(name)
+		if typ == "" {
+			if ok {
+				t.Errorf("%s should not be a column", name)
+			}
+			continue
+		}
+		if !ok {
+			t.Errorf("missing column %s", name)
+			continue
+		}
+		if !leaf.Node.Optional() {
+			t.Errorf("%s should be optional", name)
+		}
+		if got := leaf.Node.Type().String(); got != typ {
+			t.Errorf("%s: type %s, want %s", name, got, typ)
+		}
+	}
+}
+
+func TestNull(t *testing.T) {
+	data := write(t, []record{{ZInt: zero.IntFrom(0), ZString: zero.StringFrom("")}})
+	rows := make([]parquet.Row, 1)
+	r := parquet.NewReader(bytes.NewReader(data))
+	defer r.Close()
+	if n, err := r.ReadRows(rows); n != 1 {
+		t.Fatal(n, err)
+	}
+	for _, v := range rows[0] {
+		if !v.IsNull() {
+			t.Errorf("column %d should be null: %v", v.Column(), v)
+		}
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for unsupported field type")
+		}
+	}()
+	SchemaOf(struct{ Int int }{})
+}
+
+func write(t *testing.T, rows []record) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := NewWriter[record](&buf)
+	if n, err := w.Write(rows); err != nil || n != len(rows) {
+		t.Fatalf("wrote %d rows: %v", n, err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func assertEqualJSON(t *testing.T, got, want interface{}) {
+	t.Helper()
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(gotJSON, wantJSON) {
+		t.Errorf("mismatch:\n got: %s\nwant: %s", gotJSON, wantJSON)
+	}
+}
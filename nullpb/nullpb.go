@@ -0,0 +1,276 @@
+// Package nullpb converts between null types and the protobuf well-known types:
+// the wrappers in google.golang.org/protobuf/types/known/wrapperspb, Timestamp, and Duration.
+// A nil message converts to null, and null converts to a nil message.
+// It lives in its own package so that the null package stays free of dependencies.
+package nullpb
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gopkg.in/guregu/null.v4"
+)
+
+// BoolFromWrapper creates a Bool from a BoolValue.
+func BoolFromWrapper(w *wrapperspb.BoolValue) null.Bool {
+	if w == nil {
+		return null.Bool{}
+	}
+	return null.BoolFrom(w.Value)
+}
+
+// BoolToWrapper creates a BoolValue from a Bool.
+func BoolToWrapper(b null.Bool) *wrapperspb.BoolValue {
+	if !b.Valid {
+		return nil
+	}
+	return wrapperspb.Bool(b.Bool)
+}
+
+// BytesFromWrapper creates a Bytes from a BytesValue.
+func BytesFromWrapper(w *wrapperspb.BytesValue) null.Bytes {
+	if w == nil {
+		return null.Bytes{}
+	}
+	return null.BytesFrom(w.Value)
+}
+
+// BytesToWrapper creates a BytesValue from a Bytes.
+func BytesToWrapper(b null.Bytes) *wrapperspb.BytesValue {
+	if !b.Valid {
+		return nil
+	}
+	return wrapperspb.Bytes(b.Bytes)
+}
+
+// FloatFromWrapper creates a Float from a DoubleValue.
+func FloatFromWrapper(w *wrapperspb.DoubleValue) null.Float {
+	if w == nil {
+		return null.Float{}
+	}
+	return null.FloatFrom(w.Value)
+}
+
+// FloatToWrapper creates a DoubleValue from a Float.
+func FloatToWrapper(f null.Float) *wrapperspb.DoubleValue {
+	if !f.Valid {
+		return nil
+	}
+	return wrapperspb.Double(f.Float64)
+}
+
+// FloatFromFloatWrapper creates a Float from a FloatValue.
+func FloatFromFloatWrapper(w *wrapperspb.FloatValue) null.Float {
+	if w == nil {
+		return null.Float{}
+	}
+	return null.FloatFrom(float64(w.Value))
+}
+
+// IntFromWrapper creates an Int from an Int64Value.
+func IntFromWrapper(w *wrapperspb.Int64Value) null.Int {
+	if w == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(w.Value)
+}
+
+// IntToWrapper creates an Int64Value from an Int.
+func IntToWrapper(i null.Int) *wrapperspb.Int64Value {
+	if !i.Valid {
+		return nil
+	}
+	return wrapperspb.Int64(i.Int64)
+}
+
+// Int32FromWrapper creates an Int32 from an Int32Value.
+func Int32FromWrapper(w *wrapperspb.Int32Value) null.Int32 {
+	if w == nil {
+		return null.Int32{}
+	}
+	return null.Int32From(w.Value)
+}
+
+// Int32ToWrapper creates an Int32Value from an Int32.
+func Int32ToWrapper(i null.Int32) *wrapperspb.Int32Value {
+	if !i.Valid {
+		return nil
+	}
+	return wrapperspb.Int32(i.Int32)
+}
+
+// Int16FromWrapper creates an Int16 from an Int32Value.
+// It returns an error if the value overflows int16.
+func Int16FromWrapper(w *wrapperspb.Int32Value) (null.Int16, error) {
+	if w == nil {
+		return null.Int16{}, nil
+	}
+	if w.Value < math.MinInt16 || w.Value > math.MaxInt16 {
+		return null.Int16{}, fmt.Errorf("nullpb: %d overflows Int16", w.Value)
+	}
+	return null.Int16From(int16(w.Value)), nil
+}
+
+// Int16ToWrapper creates an Int32Value from an Int16.
+func Int16ToWrapper(i null.Int16) *wrapperspb.Int32Value {
+	if !i.Valid {
+		return nil
+	}
+	return wrapperspb.Int32(int32(i.Int16))
+}
+
+// Int8FromWrapper creates an Int8 from an Int32Value.
+// It returns an error if the value overflows int8.
+func Int8FromWrapper(w *wrapperspb.Int32Value) (null.Int8, error) {
+	if w == nil {
+		return null.Int8{}, nil
+	}
+	if w.Value < math.MinInt8 || w.Value > math.MaxInt8 {
+		return null.Int8{}, fmt.Errorf("nullpb: %d overflows Int8", w.Value)
+	}
+	return null.Int8From(int8(w.Value)), nil
+}
+
+// Int8ToWrapper creates an Int32Value from an Int8.
+func Int8ToWrapper(i null.Int8) *wrapperspb.Int32Value {
+	if !i.Valid {
+		return nil
+	}
+	return wrapperspb.Int32(int32(i.Int8))
+}
+
+// StringFromWrapper creates a String from a StringValue.
+func StringFromWrapper(w *wrapperspb.StringValue) null.String {
+	if w == nil {
+		return null.String{}
+	}
+	return null.StringFrom(w.Value)
+}
+
+// StringToWrapper creates a StringValue from a String.
+func StringToWrapper(s null.String) *wrapperspb.StringValue {
+	if !s.Valid {
+		return nil
+	}
+	return wrapperspb.String(s.String)
+}
+
+// UintFromWrapper creates a Uint from a UInt64Value.
+func UintFromWrapper(w *wrapperspb.UInt64Value) null.Uint {
+	if w == nil {
+		return null.Uint{}
+	}
+	return null.UintFrom(w.Value)
+}
+
+// UintToWrapper creates a UInt64Value from a Uint.
+func UintToWrapper(u null.Uint) *wrapperspb.UInt64Value {
+	if !u.Valid {
+		return nil
+	}
+	return wrapperspb.UInt64(u.Uint64)
+}
+
+// Uint32FromWrapper creates a Uint32 from a UInt32Value.
+func Uint32FromWrapper(w *wrapperspb.UInt32Value) null.Uint32 {
+	if w == nil {
+		return null.Uint32{}
+	}
+	return null.Uint32From(w.Value)
+}
+
+// Uint32ToWrapper creates a UInt32Value from a Uint32.
+func Uint32ToWrapper(u null.Uint32) *wrapperspb.UInt32Value {
+	if !u.Valid {
+		return nil
+	}
+	return wrapperspb.UInt32(u.Uint32)
+}
+
+// Uint16FromWrapper creates a Uint16 from a UInt32Value.
+// It returns an error if the value overflows uint16.
+func Uint16FromWrapper(w *wrapperspb.UInt32Value) (null.Uint16, error) {
+	if w == nil {
+		return null.Uint16{}, nil
+	}
+	if w.Value > math.MaxUint16 {
+		return null.Uint16{}, fmt.Errorf("nullpb: %d overflows Uint16", w.Value)
+	}
+	return null.Uint16From(uint16(w.Value)), nil
+}
+
+// Uint16ToWrapper creates a UInt32Value from a Uint16.
+func Uint16ToWrapper(u null.Uint16) *wrapperspb.UInt32Value {
+	if !u.Valid {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32(u.Uint16))
+}
+
+// Uint8FromWrapper creates a Uint8 from a UInt32Value.
+// It returns an error if the value overflows uint8.
+func Uint8FromWrapper(w *wrapperspb.UInt32Value) (null.Uint8, error) {
+	if w == nil {
+		return null.Uint8{}, nil
+	}
+	if w.Value > math.MaxUint8 {
+		return null.Uint8{}, fmt.Errorf("nullpb: %d overflows Uint8", w.Value)
+	}
+	return null.Uint8From(uint8(w.Value)), nil
+}
+
+// Uint8ToWrapper creates a UInt32Value from a Uint8.
+func Uint8ToWrapper(u null.Uint8) *wrapperspb.UInt32Value {
+	if !u.Valid {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32(u.Uint8))
+}
+
+// TimeFromTimestamp creates a Time from a Timestamp.
+// It returns an error if the Timestamp is invalid.
+func TimeFromTimestamp(ts *timestamppb.Timestamp) (null.Time, error) {
+	if ts == nil {
+		return null.Time{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return null.Time{}, fmt.Errorf("nullpb: %w", err)
+	}
+	return null.TimeFrom(ts.AsTime()), nil
+}
+
+// TimeToTimestamp creates a Timestamp from a Time.
+func TimeToTimestamp(t null.Time) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+// DurationFromProto creates a Duration from a protobuf Duration.
+// It returns an error if the protobuf Duration is invalid or overflows time.Duration.
+func DurationFromProto(d *durationpb.Duration) (null.Duration, error) {
+	if d == nil {
+		return null.Duration{}, nil
+	}
+	if err := d.CheckValid(); err != nil {
+		return null.Duration{}, fmt.Errorf("nullpb: %w", err)
+	}
+	dur := d.AsDuration()
+	// AsDuration saturates instead of overflowing
+	if back := durationpb.New(dur); back.Seconds != d.Seconds || back.Nanos != d.Nanos {
+		return null.Duration{}, fmt.Errorf("nullpb: %v overflows time.Duration", d)
+	}
+	return null.DurationFrom(dur), nil
+}
+
+// DurationToProto creates a protobuf Duration from a Duration.
+func DurationToProto(d null.Duration) *durationpb.Duration {
+	if !d.Valid {
+		return nil
+	}
+	return durationpb.New(d.Duration)
+}
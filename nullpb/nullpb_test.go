@@ -0,0 +1,166 @@
+package nullpb
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestWrappers(t *testing.T) {
+	if b := BoolFromWrapper(wrapperspb.Bool(false)); !b.Equal(null.BoolFrom(false)) {
+		t.Errorf("bad Bool: %v", b)
+	}
+	if w := BoolToWrapper(null.BoolFrom(true)); w == nil || !w.Value {
+		t.Errorf("bad BoolValue: %v", w)
+	}
+	if b := BytesFromWrapper(wrapperspb.Bytes([]byte("hi"))); !b.Equal(null.BytesFrom([]byte("hi"))) {
+		t.Errorf("bad Bytes: %v", b)
+	}
+	if w := BytesToWrapper(null.BytesFrom([]byte("hi"))); w == nil || string(w.Value) != "hi" {
+		t.Errorf("bad BytesValue: %v", w)
+	}
+	if f := FloatFromWrapper(wrapperspb.Double(1.5)); !f.Equal(null.FloatFrom(1.5)) {
+		t.Errorf("bad Float: %v", f)
+	}
+	if f := FloatFromFloatWrapper(wrapperspb.Float(1.5)); !f.Equal(null.FloatFrom(1.5)) {
+		t.Errorf("bad Float from FloatValue: %v", f)
+	}
+	if w := FloatToWrapper(null.FloatFrom(1.5)); w == nil || w.Value != 1.5 {
+		t.Errorf("bad DoubleValue: %v", w)
+	}
+	if i := IntFromWrapper(wrapperspb.Int64(math.MinInt64)); !i.Equal(null.IntFrom(math.MinInt64)) {
+		t.Errorf("bad Int: %v", i)
+	}
+	if w := IntToWrapper(null.IntFrom(-1)); w == nil || w.Value != -1 {
+		t.Errorf("bad Int64Value: %v", w)
+	}
+	if i := Int32FromWrapper(wrapperspb.Int32(-32)); !i.Equal(null.Int32From(-32)) {
+		t.Errorf("bad Int32: %v", i)
+	}
+	if w := Int32ToWrapper(null.Int32From(-32)); w == nil || w.Value != -32 {
+		t.Errorf("bad Int32Value: %v", w)
+	}
+	if s := StringFromWrapper(wrapperspb.String("")); !s.Equal(null.StringFrom("")) {
+		t.Errorf("bad String: %v", s)
+	}
+	if w := StringToWrapper(null.StringFrom("hi")); w == nil || w.Value != "hi" {
+		t.Errorf("bad StringValue: %v", w)
+	}
+	if u := UintFromWrapper(wrapperspb.UInt64(math.MaxUint64)); !u.Equal(null.UintFrom(math.MaxUint64)) {
+		t.Errorf("bad Uint: %v", u)
+	}
+	if w := UintToWrapper(null.UintFrom(math.MaxUint64)); w == nil || w.Value != math.MaxUint64 {
+		t.Errorf("bad UInt64Value: %v", w)
+	}
+	if u := Uint32FromWrapper(wrapperspb.UInt32(32)); !u.Equal(null.Uint32From(32)) {
+		t.Errorf("bad Uint32: %v", u)
+	}
+	if w := Uint32ToWrapper(null.Uint32From(32)); w == nil || w.Value != 32 {
+		t.Errorf("bad UInt32Value: %v", w)
+	}
+}
+
+func TestNarrowWrappers(t *testing.T) {
+	i16, err := Int16FromWrapper(wrapperspb.Int32(math.MinInt16))
+	if err != nil || !i16.Equal(null.Int16From(math.MinInt16)) {
+		t.Errorf("bad Int16: %v %v", i16, err)
+	}
+	if w := Int16ToWrapper(i16); w == nil || w.Value != math.MinInt16 {
+		t.Errorf("bad Int16 wrapper: %v", w)
+	}
+	i8, err := Int8FromWrapper(wrapperspb.Int32(math.MaxInt8))
+	if err != nil || !i8.Equal(null.Int8From(math.MaxInt8)) {
+		t.Errorf("bad Int8: %v %v", i8, err)
+	}
+	if w := Int8ToWrapper(i8); w == nil || w.Value != math.MaxInt8 {
+		t.Errorf("bad Int8 wrapper: %v", w)
+	}
+	u16, err := Uint16FromWrapper(wrapperspb.UInt32(math.MaxUint16))
+	if err != nil || !u16.Equal(null.Uint16From(math.MaxUint16)) {
+		t.Errorf("bad Uint16: %v %v", u16, err)
+	}
+	if w := Uint16ToWrapper(u16); w == nil || w.Value != math.MaxUint16 {
+		t.Errorf("bad Uint16 wrapper: %v", w)
+	}
+	u8, err := Uint8FromWrapper(wrapperspb.UInt32(math.MaxUint8))
+	if err != nil || !u8.Equal(null.Uint8From(math.MaxUint8)) {
+		t.Errorf("bad Uint8: %v %v", u8, err)
+	}
+	if w := Uint8ToWrapper(u8); w == nil || w.Value != math.MaxUint8 {
+		t.Errorf("bad Uint8 wrapper: %v", w)
+	}
+
+	if _, err := Int16FromWrapper(wrapperspb.Int32(math.MaxInt16 + 1)); err == nil {
+		t.Error("expected Int16 overflow error")
+	}
+	if _, err := Int8FromWrapper(wrapperspb.Int32(math.MinInt8 - 1)); err == nil {
+		t.Error("expected Int8 overflow error")
+	}
+	if _, err := Uint16FromWrapper(wrapperspb.UInt32(math.MaxUint16 + 1)); err == nil {
+		t.Error("expected Uint16 overflow error")
+	}
+	if _, err := Uint8FromWrapper(wrapperspb.UInt32(math.MaxUint8 + 1)); err == nil {
+		t.Error("expected Uint8 overflow error")
+	}
+}
+
+func TestTimestampDuration(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+	tm, err := TimeFromTimestamp(timestamppb.New(now))
+	if err != nil || !tm.Equal(null.TimeFrom(now)) {
+		t.Errorf("bad Time: %v %v", tm, err)
+	}
+	if ts := TimeToTimestamp(tm); ts == nil || !ts.AsTime().Equal(now) {
+		t.Errorf("bad Timestamp: %v", ts)
+	}
+	if _, err := TimeFromTimestamp(&timestamppb.Timestamp{Nanos: -1}); err == nil {
+		t.Error("expected error for invalid Timestamp")
+	}
+
+	d, err := DurationFromProto(durationpb.New(-90 * time.Second))
+	if err != nil || !d.Equal(null.DurationFrom(-90*time.Second)) {
+		t.Errorf("bad Duration: %v %v", d, err)
+	}
+	if pb := DurationToProto(d); pb == nil || pb.AsDuration() != -90*time.Second {
+		t.Errorf("bad protobuf Duration: %v", pb)
+	}
+	if _, err := DurationFromProto(&durationpb.Duration{Seconds: 1, Nanos: -1}); err == nil {
+		t.Error("expected error for invalid Duration")
+	}
+	if _, err := DurationFromProto(&durationpb.Duration{Seconds: 315576000000}); err == nil {
+		t.Error("expected error for Duration overflowing time.Duration")
+	}
+}
+
+func TestNil(t *testing.T) {
+	if BoolFromWrapper(nil).Valid || BytesFromWrapper(nil).Valid || FloatFromWrapper(nil).Valid ||
+		FloatFromFloatWrapper(nil).Valid || IntFromWrapper(nil).Valid || Int32FromWrapper(nil).Valid ||
+		StringFromWrapper(nil).Valid || UintFromWrapper(nil).Valid || Uint32FromWrapper(nil).Valid {
+		t.Error("nil wrappers should convert to null")
+	}
+	for _, f := range []func() (bool, error){
+		func() (bool, error) { v, err := Int16FromWrapper(nil); return v.Valid, err },
+		func() (bool, error) { v, err := Int8FromWrapper(nil); return v.Valid, err },
+		func() (bool, error) { v, err := Uint16FromWrapper(nil); return v.Valid, err },
+		func() (bool, error) { v, err := Uint8FromWrapper(nil); return v.Valid, err },
+		func() (bool, error) { v, err := TimeFromTimestamp(nil); return v.Valid, err },
+		func() (bool, error) { v, err := DurationFromProto(nil); return v.Valid, err },
+	} {
+		if valid, err := f(); valid || err != nil {
+			t.Errorf("nil should convert to null: %t %v", valid, err)
+		}
+	}
+
+	if BoolToWrapper(null.Bool{}) != nil || BytesToWrapper(null.Bytes{}) != nil || FloatToWrapper(null.Float{}) != nil ||
+		IntToWrapper(null.Int{}) != nil || Int32ToWrapper(null.Int32{}) != nil || Int16ToWrapper(null.Int16{}) != nil ||
+		Int8ToWrapper(null.Int8{}) != nil || StringToWrapper(null.String{}) != nil || UintToWrapper(null.Uint{}) != nil ||
+		Uint32ToWrapper(null.Uint32{}) != nil || Uint16ToWrapper(null.Uint16{}) != nil || Uint8ToWrapper(null.Uint8{}) != nil ||
+		TimeToTimestamp(null.Time{}) != nil || DurationToProto(null.Duration{}) != nil {
+		t.Error("null should convert to nil")
+	}
+}
@@ -0,0 +1,552 @@
+// Package nullpgx registers the null and zero types with github.com/jackc/pgx/v5,
+// so that they are scanned and encoded with pgx's native codecs, including the binary protocol,
+// instead of going through sql.Scanner and driver.Valuer.
+// It lives in its own package so that the null package stays free of dependencies.
+//
+// Register must be called on each connection's type map, for example:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		nullpgx.Register(conn.TypeMap())
+//		return nil
+//	}
+//
+// The integer types, null.Float, null.Bool, null.String, null.Bytes, null.Base64, null.Hex,
+// null.Time, null.Date, null.Duration, null.TimeOfDay, and the zero types are supported.
+// null.Uint can be stored in numeric columns, or int8 columns if it fits.
+// Other types, and columns of types that don't match, still fall back to sql.Scanner and driver.Valuer.
+package nullpgx
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+// oids are the Postgres types whose codecs are wrapped by Register.
+var oids = []uint32{
+	pgtype.BoolOID,
+	pgtype.ByteaOID,
+	pgtype.NameOID,
+	pgtype.Int8OID,
+	pgtype.Int2OID,
+	pgtype.Int4OID,
+	pgtype.TextOID,
+	pgtype.Float4OID,
+	pgtype.Float8OID,
+	pgtype.BPCharOID,
+	pgtype.VarcharOID,
+	pgtype.DateOID,
+	pgtype.TimeOID,
+	pgtype.TimestampOID,
+	pgtype.TimestamptzOID,
+	pgtype.IntervalOID,
+	pgtype.NumericOID,
+}
+
+// Register registers codecs for the null and zero types with m.
+// It also sets the Postgres type used for each of them when the type of a parameter isn't known,
+// such as int8 for null.Int and numeric for null.Uint.
+// Calling it more than once on the same map has no further effect.
+func Register(m *pgtype.Map) {
+	for _, oid := range oids {
+		t, ok := m.TypeForOID(oid)
+		if !ok {
+			continue
+		}
+		if _, ok := t.Codec.(codec); ok {
+			continue
+		}
+		m.RegisterType(&pgtype.Type{Name: t.Name, OID: t.OID, Codec: codec{t.Codec}})
+	}
+
+	registerDefault[null.Int](m, "int8")
+	registerDefault[null.Int8](m, "int2")
+	registerDefault[null.Int16](m, "int2")
+	registerDefault[null.Int32](m, "int4")
+	registerDefault[null.Uint](m, "numeric")
+	registerDefault[null.Uint8](m, "int2")
+	registerDefault[null.Uint16](m, "int4")
+	registerDefault[null.Uint32](m, "int8")
+	registerDefault[null.Float](m, "float8")
+	registerDefault[null.Bool](m, "bool")
+	registerDefault[null.String](m, "text")
+	registerDefault[null.Bytes](m, "bytea")
+	registerDefault[null.Base64](m, "bytea")
+	registerDefault[null.Hex](m, "bytea")
+	registerDefault[null.Time](m, "timestamptz")
+	registerDefault[null.Date](m, "date")
+	registerDefault[null.Duration](m, "interval")
+	registerDefault[null.TimeOfDay](m, "time")
+
+	registerDefault[zero.Int](m, "int8")
+	registerDefault[zero.Float](m, "float8")
+	registerDefault[zero.Bool](m, "bool")
+	registerDefault[zero.String](m, "text")
+	registerDefault[zero.Time](m, "timestamptz")
+}
+
+func registerDefault[T any](m *pgtype.Map, name string) {
+	var v T
+	m.RegisterDefaultPgType(v, name)
+	m.RegisterDefaultPgType(&v, name)
+}
+
+// codec wraps a pgtype codec, converting the null types into values it understands.
+// It has to wrap the codec rather than use Map.TryWrapScanPlanFuncs, because pgx prefers sql.Scanner to those.
+type codec struct {
+	pgtype.Codec
+}
+
+func (c codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if v := encodeValue(value); v != nil {
+		if next := c.Codec.PlanEncode(m, oid, format, v); next != nil {
+			return encodePlan{next: next}
+		}
+	}
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+func (c codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if t := scanTarget(target); t != nil {
+		if next := c.Codec.PlanScan(m, oid, format, t); next != nil {
+			return scanPlan{next: next}
+		}
+	}
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+type encodePlan struct {
+	next pgtype.EncodePlan
+}
+
+func (p encodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	return p.next.Encode(encodeValue(value), buf)
+}
+
+type scanPlan struct {
+	next pgtype.ScanPlan
+}
+
+func (p scanPlan) Scan(src []byte, target any) error {
+	return p.next.Scan(src, scanTarget(target))
+}
+
+// encodeValue converts value into a type implementing pgtype's valuer interfaces, or returns nil.
+func encodeValue(value any) any {
+	switch v := value.(type) {
+	case null.Int:
+		return intValue(v)
+	case null.Int8:
+		return int8Value(v)
+	case null.Int16:
+		return int16Value(v)
+	case null.Int32:
+		return int32Value(v)
+	case null.Uint:
+		return uintValue(v)
+	case null.Uint8:
+		return uint8Value(v)
+	case null.Uint16:
+		return uint16Value(v)
+	case null.Uint32:
+		return uint32Value(v)
+	case null.Float:
+		return floatValue(v)
+	case null.Bool:
+		return boolValue(v)
+	case null.String:
+		return stringValue(v)
+	case null.Bytes:
+		return bytesValue(v.NullBytes)
+	case null.Base64:
+		return bytesValue(v.NullBytes)
+	case null.Hex:
+		return bytesValue(v.NullBytes)
+	case null.Time:
+		return timeValue(v)
+	case null.Date:
+		return dateValue(v)
+	case null.Duration:
+		return durationValue(v)
+	case null.TimeOfDay:
+		return timeOfDayValue(v)
+	case zero.Int:
+		return intValue(v)
+	case zero.Float:
+		return floatValue(v)
+	case zero.Bool:
+		return boolValue(v)
+	case zero.String:
+		return stringValue(v)
+	case zero.Time:
+		return timeValue(v)
+	}
+	return nil
+}
+
+// scanTarget converts target into a pointer implementing pgtype's scanner interfaces, or returns nil.
+// The pointer refers to the same memory as target.
+func scanTarget(target any) any {
+	switch t := target.(type) {
+	case *null.Int:
+		return (*intValue)(t)
+	case *null.Int8:
+		return (*int8Value)(t)
+	case *null.Int16:
+		return (*int16Value)(t)
+	case *null.Int32:
+		return (*int32Value)(t)
+	case *null.Uint:
+		return (*uintValue)(t)
+	case *null.Uint8:
+		return (*uint8Value)(t)
+	case *null.Uint16:
+		return (*uint16Value)(t)
+	case *null.Uint32:
+		return (*uint32Value)(t)
+	case *null.Float:
+		return (*floatValue)(t)
+	case *null.Bool:
+		return (*boolValue)(t)
+	case *null.String:
+		return (*stringValue)(t)
+	case *null.Bytes:
+		return (*bytesValue)(&t.NullBytes)
+	case *null.Base64:
+		return (*bytesValue)(&t.NullBytes)
+	case *null.Hex:
+		return (*bytesValue)(&t.NullBytes)
+	case *null.Time:
+		return (*timeValue)(t)
+	case *null.Date:
+		return (*dateValue)(t)
+	case *null.Duration:
+		return (*durationValue)(t)
+	case *null.TimeOfDay:
+		return (*timeOfDayValue)(t)
+	case *zero.Int:
+		return (*intValue)(t)
+	case *zero.Float:
+		return (*floatValue)(t)
+	case *zero.Bool:
+		return (*boolValue)(t)
+	case *zero.String:
+		return (*stringValue)(t)
+	case *zero.Time:
+		return (*timeValue)(t)
+	}
+	return nil
+}
+
+type intValue null.Int
+
+func (v *intValue) ScanInt64(n pgtype.Int8) error {
+	v.Int64, v.Valid = n.Int64, n.Valid
+	return nil
+}
+
+func (v intValue) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: v.Int64, Valid: v.Valid}, nil
+}
+
+type int8Value null.Int8
+
+func (v *int8Value) ScanInt64(n pgtype.Int8) error {
+	i, err := scanInt(n, math.MinInt8, math.MaxInt8, "Int8")
+	if err != nil {
+		return err
+	}
+	v.Int8, v.Valid = int8(i), n.Valid
+	return nil
+}
+
+func (v int8Value) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(v.Int8), Valid: v.Valid}, nil
+}
+
+type int16Value null.Int16
+
+func (v *int16Value) ScanInt64(n pgtype.Int8) error {
+	i, err := scanInt(n, math.MinInt16, math.MaxInt16, "Int16")
+	if err != nil {
+		return err
+	}
+	v.Int16, v.Valid = int16(i), n.Valid
+	return nil
+}
+
+func (v int16Value) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(v.Int16), Valid: v.Valid}, nil
+}
+
+type int32Value null.Int32
+
+func (v *int32Value) ScanInt64(n pgtype.Int8) error {
+	i, err := scanInt(n, math.MinInt32, math.MaxInt32, "Int32")
+	if err != nil {
+		return err
+	}
+	v.Int32, v.Valid = int32(i), n.Valid
+	return nil
+}
+
+func (v int32Value) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(v.Int32), Valid: v.Valid}, nil
+}
+
+// uintValue supports numeric columns, and int8 columns for values that fit.
+type uintValue null.Uint
+
+func (v *uintValue) ScanInt64(n pgtype.Int8) error {
+	i, err := scanInt(n, 0, math.MaxInt64, "Uint")
+	if err != nil {
+		return err
+	}
+	v.Uint64, v.Valid = uint64(i), n.Valid
+	return nil
+}
+
+func (v uintValue) Int64Value() (pgtype.Int8, error) {
+	if v.Valid && v.Uint64 > math.MaxInt64 {
+		return pgtype.Int8{}, fmt.Errorf("nullpgx: %d overflows int8", v.Uint64)
+	}
+	return pgtype.Int8{Int64: int64(v.Uint64), Valid: v.Valid}, nil
+}
+
+func (v *uintValue) ScanNumeric(n pgtype.Numeric) error {
+	if !n.Valid {
+		v.Uint64, v.Valid = 0, false
+		return nil
+	}
+	if n.NaN || n.InfinityModifier != pgtype.Finite {
+		return fmt.Errorf("nullpgx: can't scan non-finite numeric into Uint")
+	}
+	i := new(big.Int).Set(n.Int)
+	if n.Exp != 0 {
+		pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(n.Exp))), nil)
+		if n.Exp > 0 {
+			i.Mul(i, pow)
+		} else if _, rem := i.QuoRem(i, pow, new(big.Int)); rem.Sign() != 0 {
+			return fmt.Errorf("nullpgx: can't scan fractional numeric into Uint")
+		}
+	}
+	if !i.IsUint64() {
+		return fmt.Errorf("nullpgx: numeric %s overflows Uint", i)
+	}
+	v.Uint64, v.Valid = i.Uint64(), true
+	return nil
+}
+
+func (v uintValue) NumericValue() (pgtype.Numeric, error) {
+	if !v.Valid {
+		return pgtype.Numeric{}, nil
+	}
+	return pgtype.Numeric{Int: new(big.Int).SetUint64(v.Uint64), Valid: true}, nil
+}
+
+type uint8Value null.Uint8
+
+func (v *uint8Value) ScanInt64(n pgtype.Int8) error {
+	i, err := scanInt(n, 0, math.MaxUint8, "Uint8")
+	if err != nil {
+		return err
+	}
+	v.Uint8, v.Valid = uint8(i), n.Valid
+	return nil
+}
+
+func (v uint8Value) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(v.Uint8), Valid: v.Valid}, nil
+}
+
+type uint16Value null.Uint16
+
+func (v *uint16Value) ScanInt64(n pgtype.Int8) error {
+	i, err := scanInt(n, 0, math.MaxUint16, "Uint16")
+	if err != nil {
+		return err
+	}
+	v.Uint16, v.Valid = uint16(i), n.Valid
+	return nil
+}
+
+func (v uint16Value) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(v.Uint16), Valid: v.Valid}, nil
+}
+
+type uint32Value null.Uint32
+
+func (v *uint32Value) ScanInt64(n pgtype.Int8) error {
+	i, err := scanInt(n, 0, math.MaxUint32, "Uint32")
+	if err != nil {
+		return err
+	}
+	v.Uint32, v.Valid = uint32(i), n.Valid
+	return nil
+}
+
+func (v uint32Value) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(v.Uint32), Valid: v.Valid}, nil
+}
+
+// scanInt returns n's value, or an error if it's valid and outside of min and max.
+func scanInt(n pgtype.Int8, min, max int64, name string) (int64, error) {
+	if !n.Valid {
+		return 0, nil
+	}
+	if n.Int64 < min || n.Int64 > max {
+		return 0, fmt.Errorf("nullpgx: %d overflows %s", n.Int64, name)
+	}
+	return n.Int64, nil
+}
+
+type floatValue null.Float
+
+func (v *floatValue) ScanFloat64(n pgtype.Float8) error {
+	v.Float64, v.Valid = n.Float64, n.Valid
+	return nil
+}
+
+func (v floatValue) Float64Value() (pgtype.Float8, error) {
+	return pgtype.Float8{Float64: v.Float64, Valid: v.Valid}, nil
+}
+
+type boolValue null.Bool
+
+func (v *boolValue) ScanBool(b pgtype.Bool) error {
+	v.Bool, v.Valid = b.Bool, b.Valid
+	return nil
+}
+
+func (v boolValue) BoolValue() (pgtype.Bool, error) {
+	return pgtype.Bool{Bool: v.Bool, Valid: v.Valid}, nil
+}
+
+type stringValue null.String
+
+func (v *stringValue) ScanText(t pgtype.Text) error {
+	v.String, v.Valid = t.String, t.Valid
+	return nil
+}
+
+func (v stringValue) TextValue() (pgtype.Text, error) {
+	return pgtype.Text{String: v.String, Valid: v.Valid}, nil
+}
+
+type bytesValue null.NullBytes
+
+func (v *bytesValue) ScanBytes(b []byte) error {
+	if b == nil {
+		v.Bytes, v.Valid = nil, false
+		return nil
+	}
+	// b is only valid until the next database call
+	v.Bytes, v.Valid = append([]byte{}, b...), true
+	return nil
+}
+
+func (v bytesValue) BytesValue() ([]byte, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	if v.Bytes == nil {
+		return []byte{}, nil
+	}
+	return v.Bytes, nil
+}
+
+type timeValue null.Time
+
+func (v *timeValue) ScanTimestamptz(t pgtype.Timestamptz) error {
+	if t.Valid && t.InfinityModifier != pgtype.Finite {
+		return fmt.Errorf("nullpgx: can't scan infinite timestamptz into Time")
+	}
+	v.Time, v.Valid = t.Time, t.Valid
+	return nil
+}
+
+func (v timeValue) TimestamptzValue() (pgtype.Timestamptz, error) {
+	return pgtype.Timestamptz{Time: v.Time, Valid: v.Valid}, nil
+}
+
+func (v *timeValue) ScanTimestamp(t pgtype.Timestamp) error {
+	if t.Valid && t.InfinityModifier != pgtype.Finite {
+		return fmt.Errorf("nullpgx: can't scan infinite timestamp into Time")
+	}
+	v.Time, v.Valid = t.Time, t.Valid
+	return nil
+}
+
+func (v timeValue) TimestampValue() (pgtype.Timestamp, error) {
+	return pgtype.Timestamp{Time: v.Time, Valid: v.Valid}, nil
+}
+
+type dateValue null.Date
+
+func (v *dateValue) ScanDate(d pgtype.Date) error {
+	if !d.Valid {
+		*v = dateValue{}
+		return nil
+	}
+	if d.InfinityModifier != pgtype.Finite {
+		return fmt.Errorf("nullpgx: can't scan infinite date into Date")
+	}
+	*v = dateValue(null.DateFrom(d.Time))
+	return nil
+}
+
+func (v dateValue) DateValue() (pgtype.Date, error) {
+	return pgtype.Date{Time: null.Date(v).In(time.UTC), Valid: v.Valid}, nil
+}
+
+// durationValue stores Durations as intervals, which have microsecond precision.
+type durationValue null.Duration
+
+func (v *durationValue) ScanInterval(i pgtype.Interval) error {
+	if !i.Valid {
+		v.Duration, v.Valid = 0, false
+		return nil
+	}
+	if i.Months != 0 {
+		return fmt.Errorf("nullpgx: can't scan interval with months into Duration")
+	}
+	v.Duration = time.Duration(i.Days)*24*time.Hour + time.Duration(i.Microseconds)*time.Microsecond
+	v.Valid = true
+	return nil
+}
+
+func (v durationValue) IntervalValue() (pgtype.Interval, error) {
+	return pgtype.Interval{Microseconds: v.Duration.Microseconds(), Valid: v.Valid}, nil
+}
+
+type timeOfDayValue null.TimeOfDay
+
+func (v *timeOfDayValue) ScanTime(t pgtype.Time) error {
+	if !t.Valid {
+		*v = timeOfDayValue{}
+		return nil
+	}
+	d := time.Duration(t.Microseconds) * time.Microsecond
+	*v = timeOfDayValue(null.NewTimeOfDay(int(d/time.Hour), int(d/time.Minute%60), int(d/time.Second%60), int(d%time.Second), true))
+	return nil
+}
+
+func (v timeOfDayValue) TimeValue() (pgtype.Time, error) {
+	if !v.Valid {
+		return pgtype.Time{}, nil
+	}
+	micros := (int64(v.Hour)*3600+int64(v.Minute)*60+int64(v.Second))*1e6 + int64(v.Nanosecond)/1e3
+	return pgtype.Time{Microseconds: micros, Valid: true}, nil
+}
+
+func abs(n int32) int32 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,172 @@
+package nullpgx
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	Register(m)
+	return m
+}
+
+func roundTrip[T interface{ Equal(T) bool }](t *testing.T, m *pgtype.Map, oid uint32, v T) {
+	t.Helper()
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		// a non-nil buffer distinguishes empty values from null
+		buf, err := m.Encode(oid, format, v, []byte{})
+		if err != nil {
+			t.Errorf("%T (format %d): encode error: %v", v, format, err)
+			continue
+		}
+		var got T
+		if err := m.Scan(oid, format, buf, &got); err != nil {
+			t.Errorf("%T (format %d): scan error: %v", v, format, err)
+			continue
+		}
+		if !got.Equal(v) {
+			t.Errorf("%T (format %d): got %v, want %v", v, format, got, v)
+		}
+
+		var null T
+		buf, err = m.Encode(oid, format, null, []byte{})
+		if err != nil || buf != nil {
+			t.Errorf("%T (format %d): null should encode as nil: %v %v", v, format, buf, err)
+		}
+		got = v
+		if err := m.Scan(oid, format, nil, &got); err != nil {
+			t.Errorf("%T (format %d): scan null error: %v", v, format, err)
+		}
+		if !got.Equal(null) {
+			t.Errorf("%T (format %d): scanned null should be null: %v", v, format, got)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	m := newMap()
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456000, time.UTC)
+
+	roundTrip(t, m, pgtype.Int8OID, null.IntFrom(math.MinInt64))
+	roundTrip(t, m, pgtype.Int2OID, null.Int8From(-8))
+	roundTrip(t, m, pgtype.Int2OID, null.Int16From(math.MaxInt16))
+	roundTrip(t, m, pgtype.Int4OID, null.Int32From(-32))
+	roundTrip(t, m, pgtype.NumericOID, null.UintFrom(math.MaxUint64))
+	roundTrip(t, m, pgtype.Int8OID, null.UintFrom(math.MaxInt64))
+	roundTrip(t, m, pgtype.Int2OID, null.Uint8From(math.MaxUint8))
+	roundTrip(t, m, pgtype.Int4OID, null.Uint16From(math.MaxUint16))
+	roundTrip(t, m, pgtype.Int8OID, null.Uint32From(math.MaxUint32))
+	roundTrip(t, m, pgtype.Float8OID, null.FloatFrom(1.5))
+	roundTrip(t, m, pgtype.BoolOID, null.BoolFrom(false))
+	roundTrip(t, m, pgtype.TextOID, null.StringFrom(""))
+	roundTrip(t, m, pgtype.VarcharOID, null.StringFrom("hello"))
+	roundTrip(t, m, pgtype.ByteaOID, null.BytesFrom([]byte{0, 1, 2}))
+	roundTrip(t, m, pgtype.ByteaOID, null.Base64From([]byte{}))
+	roundTrip(t, m, pgtype.ByteaOID, null.HexFrom([]byte{0xff}))
+	roundTrip(t, m, pgtype.TimestamptzOID, null.TimeFrom(now))
+	roundTrip(t, m, pgtype.TimestampOID, null.TimeFrom(now))
+	roundTrip(t, m, pgtype.DateOID, null.NewDate(1969, time.July, 20, true))
+	roundTrip(t, m, pgtype.IntervalOID, null.DurationFrom(-36*time.Hour-time.Microsecond))
+	roundTrip(t, m, pgtype.TimeOID, null.NewTimeOfDay(23, 59, 58, 999999000, true))
+
+	roundTrip(t, m, pgtype.Int8OID, zero.IntFrom(7))
+	roundTrip(t, m, pgtype.Float8OID, zero.FloatFrom(2.5))
+	roundTrip(t, m, pgtype.BoolOID, zero.BoolFrom(true))
+	roundTrip(t, m, pgtype.TextOID, zero.StringFrom("zero"))
+	roundTrip(t, m, pgtype.TimestamptzOID, zero.TimeFrom(now))
+}
+
+func TestUintNumeric(t *testing.T) {
+	m := newMap()
+
+	for _, n := range []pgtype.Numeric{
+		{Int: new(big.Int).SetUint64(math.MaxUint64), Valid: true},
+		{Int: new(big.Int).Mul(new(big.Int).SetUint64(math.MaxUint64), big.NewInt(10)), Exp: -1, Valid: true},
+		{Int: big.NewInt(1200000), Exp: -3, Valid: true},
+		{Int: big.NewInt(12), Exp: 2, Valid: true},
+	} {
+		buf, err := m.Encode(pgtype.NumericOID, pgtype.BinaryFormatCode, n, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var u null.Uint
+		if err := m.Scan(pgtype.NumericOID, pgtype.BinaryFormatCode, buf, &u); err != nil {
+			t.Errorf("%v: scan error: %v", n, err)
+		}
+		if !u.Valid || (u.Uint64 != math.MaxUint64 && u.Uint64 != 1200) {
+			t.Errorf("%v: bad scan: %v", n, u)
+		}
+	}
+
+	for _, text := range []string{"-1", "18446744073709551616", "1.5", "NaN"} {
+		buf, err := m.Encode(pgtype.NumericOID, pgtype.TextFormatCode, text, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var u null.Uint
+		if err := m.Scan(pgtype.NumericOID, pgtype.TextFormatCode, buf, &u); err == nil {
+			t.Errorf("%s: expected error, got %v", text, u)
+		}
+	}
+
+	if _, err := m.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, null.UintFrom(math.MaxInt64+1), nil); err == nil {
+		t.Error("expected error encoding overflowing Uint into int8")
+	}
+	buf, err := m.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, int64(-1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var u null.Uint
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, buf, &u); err == nil {
+		t.Error("expected error scanning negative int8 into Uint")
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	m := newMap()
+	buf, err := m.Encode(pgtype.Int4OID, pgtype.BinaryFormatCode, int32(300), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var i null.Int8
+	if err := m.Scan(pgtype.Int4OID, pgtype.BinaryFormatCode, buf, &i); err == nil {
+		t.Errorf("expected error scanning 300 into Int8, got %v", i)
+	}
+	var u null.Uint8
+	if err := m.Scan(pgtype.Int4OID, pgtype.BinaryFormatCode, buf, &u); err == nil {
+		t.Errorf("expected error scanning 300 into Uint8, got %v", u)
+	}
+}
+
+func TestIntervalMonths(t *testing.T) {
+	m := newMap()
+	buf, err := m.Encode(pgtype.IntervalOID, pgtype.BinaryFormatCode, pgtype.Interval{Months: 1, Valid: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d null.Duration
+	if err := m.Scan(pgtype.IntervalOID, pgtype.BinaryFormatCode, buf, &d); err == nil {
+		t.Errorf("expected error scanning interval with months, got %v", d)
+	}
+}
+
+func TestDefaultTypes(t *testing.T) {
+	m := newMap()
+	for _, v := range []any{null.Int{}, &null.Uint{}, null.Date{}, zero.String{}} {
+		if _, ok := m.TypeForValue(v); !ok {
+			t.Errorf("no default type for %T", v)
+		}
+	}
+	typ, _ := m.TypeForValue(null.UintFrom(1))
+	if typ.OID != pgtype.NumericOID {
+		t.Errorf("bad default type for Uint: %s", typ.Name)
+	}
+}
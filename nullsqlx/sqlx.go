@@ -0,0 +1,116 @@
+// Package nullsqlx helps pass the null and zero types to github.com/jmoiron/sqlx.
+// It lives in its own package so that the null package stays free of dependencies.
+//
+// The types already work as sqlx and scany destinations without help:
+// Scan has a pointer receiver and the types implement sql.Scanner, so sqlx maps each one to a single column
+// instead of descending into its fields. Value has a value receiver, so they also work as parameters.
+//
+// NamedArgs converts the argument of a named query into driver values up front, so null values become nil.
+// This keeps parameters readable when they are logged, and avoids sqlx.In panicking on nil pointers,
+// which it calls Value on directly:
+//
+//	args, err := nullsqlx.NamedArgs(user)
+//	query, params, err := sqlx.Named(`UPDATE users SET name = :name WHERE id IN (:ids)`, args)
+//	query, params, err = sqlx.In(query, params...)
+package nullsqlx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
+)
+
+var (
+	mapperMu   sync.Mutex
+	mapper     *reflectx.Mapper
+	mapperFunc reflect.Value
+)
+
+// defaultMapper returns a mapper like the one sqlx uses by default, which follows changes to sqlx.NameMapper.
+func defaultMapper() *reflectx.Mapper {
+	mapperMu.Lock()
+	defer mapperMu.Unlock()
+	if fn := reflect.ValueOf(sqlx.NameMapper); mapper == nil || fn.Pointer() != mapperFunc.Pointer() {
+		mapper = reflectx.NewMapperFunc("db", sqlx.NameMapper)
+		mapperFunc = fn
+	}
+	return mapper
+}
+
+// NamedArgs converts arg, a struct, a pointer to one, or a map with string keys,
+// into a map of named parameters for sqlx's named queries, such as sqlx.Named and DB.NamedExec.
+// Values that implement driver.Valuer, including every null and zero type, are replaced with their driver values,
+// so that null values become nil. Nil pointers also become nil. Other values are kept as they are.
+//
+// Struct fields are named the way sqlx names them by default, by their db struct tag or else sqlx.NameMapper,
+// with nested structs named like "parent.child". Use NamedArgsMapper for a DB with a different mapper.
+func NamedArgs(arg interface{}) (map[string]interface{}, error) {
+	return NamedArgsMapper(defaultMapper(), arg)
+}
+
+// NamedArgsMapper is like NamedArgs, but names struct fields with the given mapper, such as DB.Mapper.
+func NamedArgsMapper(m *reflectx.Mapper, arg interface{}) (map[string]interface{}, error) {
+	rv := reflect.Indirect(reflect.ValueOf(arg))
+	args := make(map[string]interface{})
+	switch {
+	case rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String:
+		iter := rv.MapRange()
+		for iter.Next() {
+			name := iter.Key().String()
+			v, err := driverValue(reflect.ValueOf(iter.Value().Interface()))
+			if err != nil {
+				return nil, fmt.Errorf("nullsqlx: couldn't convert %s: %w", name, err)
+			}
+			args[name] = v
+		}
+	case rv.Kind() == reflect.Struct:
+		if err := structArgs(args, rv, m.TypeMap(rv.Type()).Tree); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("nullsqlx: NamedArgs needs a struct or a map with string keys, got %T", arg)
+	}
+	return args, nil
+}
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	valuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// structArgs adds the fields below fi to args, stopping at values that sqlx treats as a single column.
+func structArgs(args map[string]interface{}, root reflect.Value, fi *reflectx.FieldInfo) error {
+	for _, child := range fi.Children {
+		if child == nil {
+			continue
+		}
+		typ := reflectx.Deref(child.Field.Type)
+		if len(child.Children) > 0 && !typ.Implements(valuerType) && !reflect.PointerTo(typ).Implements(scannerType) {
+			if err := structArgs(args, root, child); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := driverValue(reflectx.FieldByIndexesReadOnly(root, child.Index))
+		if err != nil {
+			return fmt.Errorf("nullsqlx: couldn't convert %s: %w", child.Path, err)
+		}
+		args[child.Path] = v
+	}
+	return nil
+}
+
+func driverValue(v reflect.Value) (interface{}, error) {
+	if !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+		return nil, nil
+	}
+	if valuer, ok := v.Interface().(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return v.Interface(), nil
+}
@@ -0,0 +1,110 @@
+package nullsqlx
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+type address struct {
+	City null.String
+}
+
+type user struct {
+	ID      int         `db:"id"`
+	Name    null.String `db:"name"`
+	Age     *null.Int   `db:"age"`
+	Created null.Time   `db:"created"`
+	Score   zero.Float  `db:"score"`
+	Tags    null.Slice[string]
+	Home    address     `db:"home"`
+	Skip    null.String `db:"-"`
+}
+
+func TestNamedArgs(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	args, err := NamedArgs(&user{ID: 1, Created: null.TimeFrom(now), Home: address{null.StringFrom("Tokyo")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"id":        1,
+		"name":      nil,
+		"age":       nil,
+		"created":   now,
+		"score":     nil, // zero types send 0 as NULL
+		"tags":      nil,
+		"home.city": "Tokyo",
+	}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("bad args: %#v", args)
+	}
+
+	args, err = NamedArgs(map[string]interface{}{"a": null.IntFrom(1), "b": null.Int{}, "c": (*null.Int)(nil), "d": "x", "e": nil})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]interface{}{"a": int64(1), "b": nil, "c": nil, "d": "x", "e": nil}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("bad map args: %#v", args)
+	}
+
+	if _, err := NamedArgs(1); err == nil {
+		t.Error("expected error for int")
+	}
+}
+
+func TestNamedArgsIn(t *testing.T) {
+	args, err := NamedArgs(map[string]interface{}{"name": (*null.String)(nil), "ids": []int{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query, params, err := sqlx.Named("UPDATE users SET name = :name WHERE id IN (:ids)", args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query, params, err = sqlx.In(query, params...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query != "UPDATE users SET name = ? WHERE id IN (?, ?)" || !reflect.DeepEqual(params, []interface{}{nil, 1, 2}) {
+		t.Errorf("bad query: %s %#v", query, params)
+	}
+}
+
+func TestNamedArgsMapper(t *testing.T) {
+	m := reflectx.NewMapperFunc("json", strings.ToUpper)
+	args, err := NamedArgsMapper(m, struct {
+		Name null.String `json:"n"`
+		Age  null.Int
+	}{Age: null.IntFrom(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]interface{}{"n": nil, "AGE": int64(3)}; !reflect.DeepEqual(args, want) {
+		t.Errorf("bad args: %#v", args)
+	}
+}
+
+// sqlx scans each column into the field it maps to, so the null types must be columns rather than structs of fields.
+func TestMapperColumns(t *testing.T) {
+	m := reflectx.NewMapperFunc("db", strings.ToLower)
+	typ := reflect.TypeOf(user{})
+	names := []string{"id", "name", "age", "created", "score", "tags", "home.city"}
+	for i, index := range m.TraversalsByName(typ, names) {
+		if len(index) == 0 {
+			t.Errorf("%s: not mapped", names[i])
+			continue
+		}
+		field := typ.FieldByIndex(index)
+		if field.Type.PkgPath() == "database/sql" {
+			t.Errorf("%s: mapped into the embedded %s", names[i], field.Type)
+		}
+	}
+}
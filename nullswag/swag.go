@@ -0,0 +1,121 @@
+// Package nullswag provides type overrides for github.com/swaggo/swag,
+// so that generated OpenAPI documents describe the null and zero types as the primitives they encode to,
+// such as an integer with format int64 for null.Int or a date-time string for null.Time,
+// instead of objects with Valid and Int64 fields.
+//
+// swag reads overrides from a .swaggo file in the directory it runs in, or from the file given by --overridesFile.
+// This package includes one for the default settings, which can be copied or used in place:
+//
+//	swag init --overridesFile "$(go list -m -f '{{.Dir}}' gopkg.in/guregu/null.v4)/nullswag/.swaggo"
+//
+// Programs that change settings affecting JSON, such as null.DefaultDurationFormat, can write their own with WriteOverrides.
+// swag can't express that a primitive is nullable through overrides,
+// so fields that must be documented as such need an `extensions:"x-nullable"` struct tag.
+// Generic types, such as null.Value[T], and types made of more than one value, such as null.Money, aren't overridden,
+// and need a swaggertype struct tag, such as `swaggertype:"array,string"` for null.Slice[string].
+package nullswag
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+// Overrides returns the swag type overrides for the non-generic null and zero types with a single value,
+// mapping their full type names, such as "gopkg.in/guregu/null.v4.Int", to the Go types swag should document them as, such as "int64".
+// It follows the current settings, such as null.DefaultDurationFormat.
+func Overrides() map[string]string {
+	duration := "string"
+	switch null.DefaultDurationFormat {
+	case null.DurationNanoseconds:
+		duration = "int64"
+	case null.DurationSeconds:
+		duration = "float64"
+	}
+	timeType := "time.Time"
+	switch null.DefaultTimeOptions.Format {
+	case "":
+	case null.TimeLayoutUnix:
+		timeType = "int64"
+	default:
+		timeType = "string"
+	}
+
+	types := []struct {
+		v           interface{}
+		replacement string
+	}{
+		{null.Addr{}, "string"},
+		{null.Base64{}, "string"},
+		{null.BigInt{}, "string"},
+		{null.Bool{}, "bool"},
+		{null.ByteSize{}, "string"},
+		{null.Bytes{}, "string"},
+		{null.CountryCode{}, "string"},
+		{null.CurrencyCode{}, "string"},
+		{null.Date{}, "string"},
+		{null.Duration{}, duration},
+		{null.Email{}, "string"},
+		{null.FileMode{}, "string"},
+		{null.Float{}, "float64"},
+		{null.HardwareAddr{}, "string"},
+		{null.Hex{}, "string"},
+		{null.Int{}, "int64"},
+		{null.Int16{}, "int16"},
+		{null.Int32{}, "int32"},
+		{null.Int8{}, "int8"},
+		{null.Int64Range{}, "string"},
+		{null.KSUID{}, "string"},
+		{null.LanguageTag{}, "string"},
+		{null.Percent{}, "float64"},
+		{null.Prefix{}, "string"},
+		{null.Regexp{}, "string"},
+		{null.Semver{}, "string"},
+		{null.String{}, "string"},
+		{null.Time{}, timeType},
+		{null.TimeOfDay{}, "string"},
+		{null.TimeRange{}, "string"},
+		{null.Uint{}, "uint64"},
+		{null.Uint16{}, "uint16"},
+		{null.Uint32{}, "uint32"},
+		{null.Uint8{}, "uint8"},
+		{null.ULID{}, "string"},
+		{null.UnixMillis{}, "int64"},
+		{null.UnixSeconds{}, "int64"},
+		{null.URL{}, "string"},
+		{zero.Bool{}, "bool"},
+		{zero.Float{}, "float64"},
+		{zero.Int{}, "int64"},
+		{zero.String{}, "string"},
+		{zero.Time{}, timeType},
+	}
+	overrides := make(map[string]string, len(types))
+	for _, t := range types {
+		typ := reflect.TypeOf(t.v)
+		overrides[typ.PkgPath()+"."+typ.Name()] = t.replacement
+	}
+	return overrides
+}
+
+// WriteOverrides writes Overrides to w in the format of swag's .swaggo file, sorted by type name.
+func WriteOverrides(w io.Writer) error {
+	overrides := Overrides()
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if _, err := fmt.Fprintln(w, "// Type overrides for gopkg.in/guregu/null.v4, written by nullswag.WriteOverrides."); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if _, err := fmt.Fprintf(w, "replace %s %s\n", name, overrides[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package nullswag
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestOverrides(t *testing.T) {
+	overrides := Overrides()
+	for name, want := range map[string]string{
+		"gopkg.in/guregu/null.v4.Int":       "int64",
+		"gopkg.in/guregu/null.v4.Time":      "time.Time",
+		"gopkg.in/guregu/null.v4.Duration":  "string",
+		"gopkg.in/guregu/null.v4/zero.Bool": "bool",
+	} {
+		if got := overrides[name]; got != want {
+			t.Errorf("bad override for %s: %q, want %q", name, got, want)
+		}
+	}
+
+	defer func(format null.DurationFormat) { null.DefaultDurationFormat = format }(null.DefaultDurationFormat)
+	null.DefaultDurationFormat = null.DurationSeconds
+	if got := Overrides()["gopkg.in/guregu/null.v4.Duration"]; got != "float64" {
+		t.Errorf("bad override for Duration with DurationSeconds: %q", got)
+	}
+}
+
+func TestSwaggoFile(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteOverrides(&buf); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.ReadFile(".swaggo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(file, buf.Bytes()) {
+		t.Errorf(".swaggo is out of date, want:\n%s", buf.Bytes())
+	}
+}
@@ -0,0 +1,160 @@
+// Package nulltest provides assertion helpers for tests of code that uses the null, zero, and nulldecimal types.
+// Failures are reported with the values as text, such as "expected 42, got <null>",
+// instead of a comparison of their fields, so they are easy to read.
+//
+// Like testify's assert package, the helpers report failures with t.Errorf and return whether the assertion passed,
+// so that tests can stop early with:
+//
+//	if !nulltest.AssertValid(t, user.ID) {
+//		t.FailNow()
+//	}
+package nulltest
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// AssertValid asserts that v, one of the null, zero, or nulldecimal types or a pointer to one, is valid.
+func AssertValid(t testing.TB, v interface{}) bool {
+	t.Helper()
+	valid, ok := isValid(v)
+	switch {
+	case !ok:
+		t.Errorf("nulltest: AssertValid called with %T, which has no Valid field", v)
+		return false
+	case !valid:
+		t.Errorf("expected a valid %s, got <null>", typeName(v))
+		return false
+	}
+	return true
+}
+
+// AssertNull asserts that v, one of the null, zero, or nulldecimal types or a pointer to one, is null.
+// A nil pointer is considered null.
+func AssertNull(t testing.TB, v interface{}) bool {
+	t.Helper()
+	valid, ok := isValid(v)
+	switch {
+	case !ok:
+		t.Errorf("nulltest: AssertNull called with %T, which has no Valid field", v)
+		return false
+	case valid:
+		t.Errorf("expected a null %s, got %v", typeName(v), v)
+		return false
+	}
+	return true
+}
+
+// AssertEqual asserts that expected and actual are equal according to their Equal method,
+// such as two null values, or two valid values with the same value.
+func AssertEqual[T interface{ Equal(T) bool }](t testing.TB, expected, actual T) bool {
+	t.Helper()
+	if !expected.Equal(actual) {
+		t.Errorf("expected %s %v, got %v", typeName(expected), expected, actual)
+		return false
+	}
+	return true
+}
+
+// AssertEqualInt asserts that actual is valid and has the value expected.
+func AssertEqualInt(t testing.TB, expected int64, actual null.Int) bool {
+	t.Helper()
+	return assertEqualValue(t, expected, actual, eq[int64])
+}
+
+// AssertEqualUint asserts that actual is valid and has the value expected.
+func AssertEqualUint(t testing.TB, expected uint64, actual null.Uint) bool {
+	t.Helper()
+	return assertEqualValue(t, expected, actual, eq[uint64])
+}
+
+// AssertEqualFloat asserts that actual is valid and has the value expected.
+func AssertEqualFloat(t testing.TB, expected float64, actual null.Float) bool {
+	t.Helper()
+	return assertEqualValue(t, expected, actual, eq[float64])
+}
+
+// AssertEqualString asserts that actual is valid and has the value expected.
+// A blank string is not considered equal to null.
+func AssertEqualString(t testing.TB, expected string, actual null.String) bool {
+	t.Helper()
+	return assertEqualValue(t, expected, actual, eq[string])
+}
+
+// AssertEqualBool asserts that actual is valid and has the value expected.
+func AssertEqualBool(t testing.TB, expected bool, actual null.Bool) bool {
+	t.Helper()
+	return assertEqualValue(t, expected, actual, eq[bool])
+}
+
+// AssertEqualTime asserts that actual is valid and is the same instant as expected, as reported by time.Time's Equal method,
+// so that times in different locations can be equal.
+func AssertEqualTime(t testing.TB, expected time.Time, actual null.Time) bool {
+	t.Helper()
+	return assertEqualValue(t, expected, actual, time.Time.Equal)
+}
+
+// assertEqualValue asserts that actual is valid and its value equals expected according to equal.
+func assertEqualValue[T any, N interface{ Get() (T, bool) }](t testing.TB, expected T, actual N, equal func(T, T) bool) bool {
+	t.Helper()
+	v, ok := actual.Get()
+	switch {
+	case !ok:
+		t.Errorf("expected %s %s, got <null>", typeName(actual), format(expected))
+		return false
+	case !equal(expected, v):
+		t.Errorf("expected %s %s, got %s", typeName(actual), format(expected), format(v))
+		return false
+	}
+	return true
+}
+
+// format formats v for failure messages, quoting strings so that blank ones are visible.
+func format(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+func eq[T comparable](a, b T) bool {
+	return a == b
+}
+
+// isValid returns the Valid field of v, or false if v is a nil pointer.
+// It returns ok = false if v has no Valid field.
+func isValid(v interface{}) (valid, ok bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return false, rv.Type().Elem().Kind() == reflect.Struct
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return false, false
+	}
+	field := rv.FieldByName("Valid")
+	if !field.IsValid() || field.Kind() != reflect.Bool {
+		return false, false
+	}
+	return field.Bool(), true
+}
+
+// typeName returns the name of v's type, such as "null.Int", without any pointer.
+func typeName(v interface{}) string {
+	typ := reflect.TypeOf(v)
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ == nil {
+		return "value"
+	}
+	return typ.String()
+}
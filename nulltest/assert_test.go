@@ -0,0 +1,77 @@
+package nulltest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+// recorder records failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func check(t *testing.T, name string, fn func(t testing.TB) bool, want string) {
+	t.Helper()
+	r := &recorder{TB: t}
+	ok := fn(r)
+	var got string
+	if len(r.errors) > 0 {
+		got = r.errors[0]
+	}
+	if ok != (want == "") || got != want || len(r.errors) > 1 {
+		t.Errorf("%s: got %v, %q; want %q", name, ok, r.errors, want)
+	}
+}
+
+func TestAssertValid(t *testing.T) {
+	n := null.IntFrom(42)
+	check(t, "valid", func(t testing.TB) bool { return AssertValid(t, n) }, "")
+	check(t, "pointer", func(t testing.TB) bool { return AssertValid(t, &n) }, "")
+	check(t, "null", func(t testing.TB) bool { return AssertValid(t, null.Int{}) }, "expected a valid null.Int, got <null>")
+	check(t, "nil", func(t testing.TB) bool { return AssertValid(t, (*null.Money)(nil)) }, "expected a valid null.Money, got <null>")
+	check(t, "zero", func(t testing.TB) bool { return AssertValid(t, zero.StringFrom("")) }, "expected a valid zero.String, got <null>")
+	check(t, "bad type", func(t testing.TB) bool { return AssertValid(t, 42) }, "nulltest: AssertValid called with int, which has no Valid field")
+}
+
+func TestAssertNull(t *testing.T) {
+	check(t, "null", func(t testing.TB) bool { return AssertNull(t, null.NewString("stale", false)) }, "")
+	check(t, "nil", func(t testing.TB) bool { return AssertNull(t, (*null.Time)(nil)) }, "")
+	check(t, "valid", func(t testing.TB) bool { return AssertNull(t, null.UintFrom(42)) }, "expected a null null.Uint, got 42")
+	check(t, "pointer", func(t testing.TB) bool { return AssertNull(t, &null.String{}) }, "")
+	check(t, "bad type", func(t testing.TB) bool { return AssertNull(t, nil) }, "nulltest: AssertNull called with <nil>, which has no Valid field")
+}
+
+func TestAssertEqual(t *testing.T) {
+	check(t, "equal", func(t testing.TB) bool { return AssertEqual(t, null.IntFrom(1), null.IntFrom(1)) }, "")
+	check(t, "nulls", func(t testing.TB) bool { return AssertEqual(t, null.NewInt(1, false), null.Int{}) }, "")
+	check(t, "not equal", func(t testing.TB) bool { return AssertEqual(t, null.IntFrom(1), null.Int{}) }, "expected null.Int 1, got <null>")
+	check(t, "expected null", func(t testing.TB) bool { return AssertEqual(t, null.String{}, null.StringFrom("a")) }, "expected null.String <null>, got a")
+}
+
+func TestAssertEqualValue(t *testing.T) {
+	check(t, "uint", func(t testing.TB) bool { return AssertEqualUint(t, 42, null.UintFrom(42)) }, "")
+	check(t, "uint null", func(t testing.TB) bool { return AssertEqualUint(t, 42, null.Uint{}) }, "expected null.Uint 42, got <null>")
+	check(t, "uint wrong", func(t testing.TB) bool { return AssertEqualUint(t, 42, null.UintFrom(43)) }, "expected null.Uint 42, got 43")
+	check(t, "int", func(t testing.TB) bool { return AssertEqualInt(t, -1, null.IntFrom(-1)) }, "")
+	check(t, "float", func(t testing.TB) bool { return AssertEqualFloat(t, 1.5, null.FloatFrom(2)) }, "expected null.Float 1.5, got 2")
+	check(t, "string", func(t testing.TB) bool { return AssertEqualString(t, "", null.String{}) }, `expected null.String "", got <null>`)
+	check(t, "bool", func(t testing.TB) bool { return AssertEqualBool(t, false, null.BoolFrom(false)) }, "")
+
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	check(t, "time", func(t testing.TB) bool {
+		return AssertEqualTime(t, now, null.TimeFrom(now.In(time.FixedZone("JST", 9*60*60))))
+	}, "")
+	check(t, "time wrong", func(t testing.TB) bool { return AssertEqualTime(t, now, null.TimeFrom(now.Add(time.Second))) },
+		"expected null.Time 2024-05-06 07:08:09 +0000 UTC, got 2024-05-06 07:08:10 +0000 UTC")
+}
@@ -0,0 +1,28 @@
+package null
+
+// DefaultNullText is the text that MarshalText and AppendText encode null values as,
+// and that UnmarshalText decodes as null. It defaults to a blank string, as in earlier versions.
+//
+// A blank string is ambiguous for types with a valid blank value, such as String and Bytes,
+// which otherwise can't round-trip through text. Setting DefaultNullText to a sentinel such as "null" or `\N`
+// (as used by PostgreSQL's COPY and MySQL's LOAD DATA) makes these types decode blank text as a valid blank value instead.
+// Types that can't be blank, such as Int, still decode blank text as null.
+// The zero types encode zero values instead of null, so they are not affected.
+var DefaultNullText = ""
+
+// nullText returns DefaultNullText for MarshalText.
+func nullText() []byte {
+	return []byte(DefaultNullText)
+}
+
+// isNullText returns true if text should be decoded as null by a type that can't be blank:
+// if it is blank or DefaultNullText.
+func isNullText(text []byte) bool {
+	return len(text) == 0 || string(text) == DefaultNullText
+}
+
+// isNullTextOnly returns true if text is DefaultNullText.
+// Types with a valid blank value use it so that blank text is only null while DefaultNullText is blank.
+func isNullTextOnly(text []byte) bool {
+	return string(text) == DefaultNullText
+}
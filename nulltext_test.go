@@ -0,0 +1,91 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"net/url"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestNullText(t *testing.T) {
+	defer func() { DefaultNullText = "" }()
+	DefaultNullText = `\N`
+
+	example := reflect.ValueOf(exampleAllTypes())
+	for i := 0; i < example.NumField(); i++ {
+		name := example.Type().Field(i).Name
+		null, ok := reflect.Zero(example.Field(i).Type()).Interface().(encoding.TextMarshaler)
+		if !ok {
+			continue
+		}
+		text, err := null.MarshalText()
+		maybePanic(err)
+		if string(text) != `\N` {
+			t.Errorf("%s: null MarshalText: got %q, want %q", name, text, `\N`)
+		}
+		text, err = null.(encoding.TextAppender).AppendText([]byte("prefix:"))
+		maybePanic(err)
+		if string(text) != `prefix:\N` {
+			t.Errorf("%s: null AppendText: got %q, want %q", name, text, `prefix:\N`)
+		}
+
+		// a valid value becomes null
+		v := reflect.New(example.Field(i).Type())
+		v.Elem().Set(example.Field(i))
+		err = v.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(`\N`))
+		maybePanic(err)
+		if val, err := v.Elem().Interface().(driver.Valuer).Value(); err != nil || val != nil {
+			t.Errorf("%s: UnmarshalText(%q) should be null", name, `\N`)
+		}
+	}
+}
+
+func TestNullTextBlank(t *testing.T) {
+	defer func() { DefaultNullText = "" }()
+
+	for _, null := range []bool{false, true} {
+		DefaultNullText = ""
+		if null {
+			DefaultNullText = "null"
+		}
+		for _, v := range []encoding.TextUnmarshaler{
+			new(String), new(Bytes), new(Base64), new(Hex), new(Value[string]), new(Regexp), new(URL),
+		} {
+			err := v.UnmarshalText([]byte{})
+			maybePanic(err)
+			if got := reflect.ValueOf(v).Elem().Interface().(zeroer).IsZero(); got != !null {
+				t.Errorf("%T: blank input with DefaultNullText %q: null is %v, want %v", v, DefaultNullText, got, !null)
+			}
+		}
+	}
+
+	// blank is still null for types that can't be blank
+	DefaultNullText = "null"
+	var i Int
+	err := i.UnmarshalText([]byte{})
+	maybePanic(err)
+	assertNullInt(t, i, "blank text with DefaultNullText")
+
+	s := StringFrom("")
+	text, err := s.MarshalText()
+	maybePanic(err)
+	var out String
+	err = out.UnmarshalText(text)
+	maybePanic(err)
+	if !out.Valid || out.String != "" {
+		t.Errorf("blank String round trip: got %#v", out)
+	}
+
+	for _, in := range []encoding.TextMarshaler{RegexpFrom(regexp.MustCompile("")), URLFrom(&url.URL{})} {
+		text, err := in.MarshalText()
+		maybePanic(err)
+		out := reflect.New(reflect.TypeOf(in))
+		err = out.Interface().(encoding.TextUnmarshaler).UnmarshalText(text)
+		maybePanic(err)
+		if out.Elem().Interface().(zeroer).IsZero() {
+			t.Errorf("blank %T round trip: got null", in)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Package nullvalidate registers the null and zero types with github.com/go-playground/validator,
+// so that tags such as `validate:"omitempty,min=1,max=100"` apply to their values instead of the structs holding them.
+// It lives in its own package so that the null package stays free of dependencies.
+//
+//	validate := validator.New()
+//	nullvalidate.Register(validate)
+//
+//	type Order struct {
+//		Quantity null.Uint   `validate:"omitempty,min=1,max=100"`
+//		Email    null.String `validate:"required,email"`
+//	}
+//
+// Valid values are validated like pointers to their value, such as a *uint64 for null.Uint, and null values as nil,
+// so omitempty skips only null values, not valid zero values, and required fails for them, as with pointer fields.
+// Generic types such as null.Value[T] and nulldecimal.Decimal, which would add a dependency, can be registered with RegisterTypes.
+// Types made of more than one value, such as null.Money, null.Point, and the ranges, aren't registered,
+// so their fields are validated like any other struct's.
+package nullvalidate
+
+import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+// defaultTypes are the non-generic types that Register registers.
+var defaultTypes = []interface{}{
+	null.Addr{}, null.Any{}, null.Base64{}, null.BigInt{}, null.Bool{}, null.ByteSize{}, null.Bytes{},
+	null.Complex{}, null.CountryCode{}, null.CurrencyCode{}, null.Date{}, null.Duration{}, null.Email{},
+	null.FileMode{}, null.Float{}, null.HardwareAddr{}, null.Hex{}, null.Int{}, null.Int16{}, null.Int32{},
+	null.Int8{}, null.JSON{}, null.KSUID{}, null.LanguageTag{}, null.Percent{},
+	null.Prefix{}, null.Regexp{}, null.Semver{}, null.String{}, null.Time{}, null.TimeOfDay{},
+	null.Uint{}, null.Uint16{}, null.Uint32{}, null.Uint8{}, null.ULID{}, null.UnixMillis{},
+	null.UnixSeconds{}, null.URL{},
+	zero.Bool{}, zero.Float{}, zero.Int{}, zero.String{}, zero.Time{},
+}
+
+// Register registers ValueOf with v as the custom type function of the non-generic null and zero types with a single value.
+func Register(v *validator.Validate) {
+	v.RegisterCustomTypeFunc(ValueOf, defaultTypes...)
+}
+
+// RegisterTypes registers ValueOf with v as the custom type function of the given types,
+// for instances of the generic types, such as null.Value[int]{} or null.Slice[string]{}, and nulldecimal.Decimal{}.
+func RegisterTypes(v *validator.Validate, types ...interface{}) {
+	v.RegisterCustomTypeFunc(ValueOf, types...)
+}
+
+// ValueOf is a validator.CustomTypeFunc that returns a pointer to the value of field, one of this module's types, or nil if it is null.
+// Values are read with their Get method and returned as pointers so that omitempty tells valid zero values from null,
+// and decimals are converted to float64 so that min and max work.
+// Types without a Get method, such as null.Money, are always nil, so ValueOf shouldn't be registered for them.
+func ValueOf(field reflect.Value) interface{} {
+	get := field.MethodByName("Get")
+	if !get.IsValid() || get.Type().NumIn() != 0 || get.Type().NumOut() != 2 {
+		return nil
+	}
+	out := get.Call(nil)
+	if !out[1].Bool() {
+		return nil
+	}
+	if d, ok := out[0].Interface().(interface{ InexactFloat64() float64 }); ok {
+		f := d.InexactFloat64()
+		return &f
+	}
+	ptr := reflect.New(out[0].Type())
+	ptr.Elem().Set(out[0])
+	return ptr.Interface()
+}
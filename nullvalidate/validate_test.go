@@ -0,0 +1,72 @@
+package nullvalidate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v4"
+	"gopkg.in/guregu/null.v4/nulldecimal"
+	"gopkg.in/guregu/null.v4/zero"
+)
+
+type order struct {
+	Quantity null.Uint           `validate:"omitempty,min=1,max=100"`
+	Email    null.String         `validate:"required,email"`
+	Note     zero.String         `validate:"omitempty,max=5"`
+	Tags     null.Slice[string]  `validate:"omitempty,max=2"`
+	Price    nulldecimal.Decimal `validate:"omitempty,gt=0"`
+}
+
+func newValidate() *validator.Validate {
+	v := validator.New()
+	Register(v)
+	RegisterTypes(v, null.Slice[string]{}, nulldecimal.Decimal{})
+	return v
+}
+
+func TestRegister(t *testing.T) {
+	v := newValidate()
+	valid := []order{
+		{Email: null.StringFrom("a@example.com")},
+		{Quantity: null.UintFrom(100), Email: null.StringFrom("a@example.com"), Note: zero.StringFrom("hi")},
+		{Quantity: null.UintFrom(1), Email: null.StringFrom("a@example.com"), Tags: null.SliceFrom([]string{})},
+		{Email: null.StringFrom("a@example.com"), Tags: null.SliceFrom([]string{"a", "b"}), Price: nulldecimal.DecimalFrom(decimal.RequireFromString("0.01"))},
+	}
+	for _, o := range valid {
+		if err := v.Struct(o); err != nil {
+			t.Errorf("%+v: unexpected error: %v", o, err)
+		}
+	}
+
+	invalid := []order{
+		{},
+		{Email: null.StringFrom("not an email")},
+		{Quantity: null.UintFrom(0), Email: null.StringFrom("a@example.com")},
+		{Quantity: null.UintFrom(101), Email: null.StringFrom("a@example.com")},
+		{Email: null.StringFrom("a@example.com"), Note: zero.StringFrom("too long")},
+		{Email: null.StringFrom("a@example.com"), Tags: null.SliceFrom([]string{"a", "b", "c"})},
+		{Email: null.StringFrom("a@example.com"), Price: nulldecimal.DecimalFrom(decimal.Zero)},
+	}
+	for _, o := range invalid {
+		if err := v.Struct(o); err == nil {
+			t.Errorf("%+v: expected error", o)
+		}
+	}
+}
+
+func TestValueOf(t *testing.T) {
+	if v, ok := ValueOf(reflect.ValueOf(null.IntFrom(42))).(*int64); !ok || *v != 42 {
+		t.Errorf("bad value: %#v", v)
+	}
+	if v, ok := ValueOf(reflect.ValueOf(nulldecimal.DecimalFrom(decimal.RequireFromString("1.5")))).(*float64); !ok || *v != 1.5 {
+		t.Errorf("bad decimal value: %#v", v)
+	}
+	if v := ValueOf(reflect.ValueOf(null.Int{})); v != nil {
+		t.Errorf("null should be nil: %#v", v)
+	}
+	if v := ValueOf(reflect.ValueOf(null.MoneyFrom(100, "USD"))); v != nil {
+		t.Errorf("types without Get should be nil: %#v", v)
+	}
+}
@@ -0,0 +1,129 @@
+package null
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// NumberFormat describes how the integer types and Float parse numbers from text and JSON strings,
+// such as numbers entered by people in spreadsheets. JSON numbers and SQL values are not affected.
+// The zero value only accepts numbers as formatted by strconv.
+type NumberFormat struct {
+	// GroupSeparators lists the characters accepted as thousands separators, such as "," for "1,234",
+	// or " \u00a0" for "1 234" with a space or a non-breaking space. They are only accepted between digits
+	// before the decimal separator, and the digits between them are not otherwise checked.
+	GroupSeparators string
+	// DecimalSeparator separates the fraction of Float input, such as ',' for "1 234,56".
+	// If zero, it is '.'. It takes precedence over GroupSeparators.
+	DecimalSeparator rune
+	// BasePrefixes accepts integers with a base prefix like Go literals, such as "0x1F", "0o17", and "0b1010",
+	// as written by FormatBase. Integers without a prefix are still decimal, so "017" is 17.
+	BasePrefixes bool
+}
+
+// DefaultNumberFormat is the format used by UnmarshalText, and by UnmarshalJSON for numbers encoded as strings.
+// It defaults to the zero value, which only accepts numbers as formatted by strconv.
+var DefaultNumberFormat NumberFormat
+
+// normalize rewrites s in the format accepted by strconv, removing group separators and replacing the decimal separator.
+func (f NumberFormat) normalize(s string) string {
+	dec := f.DecimalSeparator
+	if dec == 0 {
+		dec = '.'
+	}
+	if f.GroupSeparators == "" && dec == '.' {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	var prev rune
+	fraction := false
+	for i, r := range s {
+		switch {
+		case !fraction && r == dec:
+			b.WriteByte('.')
+			fraction = true
+		case !fraction && strings.ContainsRune(f.GroupSeparators, r) && isDigit(prev) && isDigit(nextRune(s, i)):
+			// skip it
+		default:
+			b.WriteRune(r)
+		}
+		prev = r
+	}
+	return b.String()
+}
+
+func (f NumberFormat) parseInt(s string, bitSize int) (int64, error) {
+	n, err := strconv.ParseInt(f.normalize(s), f.base(s), bitSize)
+	return n, numberError(err, s)
+}
+
+func (f NumberFormat) parseUint(s string, bitSize int) (uint64, error) {
+	n, err := strconv.ParseUint(f.normalize(s), f.base(s), bitSize)
+	return n, numberError(err, s)
+}
+
+// base returns the base to parse the integer s in: 0, which lets strconv use the prefix, if s has a base prefix
+// and they are accepted, or else 10.
+func (f NumberFormat) base(s string) int {
+	if !f.BasePrefixes {
+		return 10
+	}
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	if len(s) > 2 && s[0] == '0' && strings.ContainsRune("xXoObB", rune(s[1])) {
+		return 0
+	}
+	return 10
+}
+
+func (f NumberFormat) parseFloat(s string) (float64, error) {
+	n, err := strconv.ParseFloat(f.normalize(s), 64)
+	return n, numberError(err, s)
+}
+
+// formatIntBase formats n in the given base, with a prefix like Go literals for bases 2, 8, and 16.
+func formatIntBase(n int64, base int) string {
+	if n < 0 {
+		return "-" + formatUintBase(uint64(-n), base)
+	}
+	return formatUintBase(uint64(n), base)
+}
+
+// formatUintBase is like formatIntBase for unsigned integers.
+func formatUintBase(n uint64, base int) string {
+	var prefix string
+	switch base {
+	case 2:
+		prefix = "0b"
+	case 8:
+		prefix = "0o"
+	case 16:
+		prefix = "0x"
+	}
+	return prefix + strconv.FormatUint(n, base)
+}
+
+// numberError reports the original input in strconv errors, instead of the normalized one.
+func numberError(err error, s string) error {
+	var numError *strconv.NumError
+	if errors.As(err, &numError) {
+		numError.Num = s
+	}
+	return err
+}
+
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+// nextRune returns the rune after the one at s[i], or utf8.RuneError at the end of s.
+func nextRune(s string, i int) rune {
+	_, size := utf8.DecodeRuneInString(s[i:])
+	r, _ := utf8.DecodeRuneInString(s[i+size:])
+	return r
+}
@@ -0,0 +1,176 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNumberFormat(t *testing.T) {
+	defer func() { DefaultNumberFormat = NumberFormat{} }()
+
+	DefaultNumberFormat = NumberFormat{GroupSeparators: ","}
+	var i Int
+	err := i.UnmarshalText([]byte("-1,234,567"))
+	maybePanic(err)
+	assertIntValue(t, i, "-1,234,567", -1234567)
+	err = json.Unmarshal([]byte(`"1,234"`), &i)
+	maybePanic(err)
+	assertIntValue(t, i, `"1,234"`, 1234)
+
+	var u Uint16
+	err = u.UnmarshalText([]byte("65,535"))
+	maybePanic(err)
+	if u.Uint16 != 65535 || !u.Valid {
+		t.Errorf("Uint16 65,535: got %v", u)
+	}
+
+	var f Float
+	err = f.UnmarshalText([]byte("1,234.5"))
+	maybePanic(err)
+	assertFloatValue(t, f, "1,234.5", 1234.5)
+
+	DefaultNumberFormat = NumberFormat{GroupSeparators: " \u00a0.", DecimalSeparator: ','}
+	for in, want := range map[string]float64{
+		"1 234,56":      1234.56,
+		"1\u00a0234,56": 1234.56,
+		"1.234.567,5":   1234567.5,
+		"-0,5":          -0.5,
+		"1,5e3":         1500,
+		`"12 345,6"`:    12345.6,
+	} {
+		if strings.HasPrefix(in, `"`) {
+			err = json.Unmarshal([]byte(in), &f)
+		} else {
+			err = f.UnmarshalText([]byte(in))
+		}
+		maybePanic(err)
+		assertFloatValue(t, f, in, want)
+	}
+	err = i.UnmarshalText([]byte("12 345"))
+	maybePanic(err)
+	assertIntValue(t, i, "12 345", 12345)
+
+	// numbers must still be numbers
+	for _, in := range []string{" 1", "1 ", "1  234", "1,234 5", "1 234,5,6"} {
+		if err := f.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("Float %q: expected error", in)
+		}
+	}
+	for _, in := range []string{"1,5", " 1", "1  234", "1 234,5"} {
+		if err := i.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("Int %q: expected error", in)
+		} else if !strings.Contains(err.Error(), `"`+in+`"`) {
+			t.Errorf("Int %q: error should mention the input: %v", in, err)
+		}
+	}
+	var i8 Int8
+	if err := i8.UnmarshalText([]byte("1 000")); err == nil {
+		t.Error("Int8 1 000: expected error")
+	}
+
+	// JSON numbers are not affected
+	err = json.Unmarshal([]byte(`1.5`), &f)
+	maybePanic(err)
+	assertFloatValue(t, f, "1.5", 1.5)
+}
+
+func assertIntValue(t *testing.T, i Int, from string, want int64) {
+	t.Helper()
+	if !i.Valid || i.Int64 != want {
+		t.Errorf("bad %s int: %v ≠ %d", from, i, want)
+	}
+}
+
+func assertFloatValue(t *testing.T, f Float, from string, want float64) {
+	t.Helper()
+	if !f.Valid || f.Float64 != want {
+		t.Errorf("bad %s float: %v ≠ %v", from, f, want)
+	}
+}
+
+func TestNumberFormatBasePrefixes(t *testing.T) {
+	defer func() { DefaultNumberFormat = NumberFormat{} }()
+
+	var i Int
+	if err := i.UnmarshalText([]byte("0x1F")); err == nil {
+		t.Error("0x1F should need BasePrefixes")
+	}
+
+	DefaultNumberFormat = NumberFormat{BasePrefixes: true}
+	for in, want := range map[string]int64{
+		"0x1F":      31,
+		"0X1f":      31,
+		"-0x80":     -128,
+		"+0o17":     15,
+		"0b1010":    10,
+		"0xFF_FF":   65535,
+		"017":       17,
+		"42":        42,
+		`"0x1F"`:    31,
+		`"-0b1010"`: -10,
+	} {
+		var err error
+		if strings.HasPrefix(in, `"`) {
+			err = json.Unmarshal([]byte(in), &i)
+		} else {
+			err = i.UnmarshalText([]byte(in))
+		}
+		maybePanic(err)
+		assertIntValue(t, i, in, want)
+	}
+
+	var u Uint8
+	err := u.UnmarshalText([]byte("0xff"))
+	maybePanic(err)
+	if !u.Valid || u.Uint8 != 255 {
+		t.Errorf("Uint8 0xff: got %v", u)
+	}
+	for _, in := range []string{"0x100", "-0x1", "0x", "0xg", "0_17"} {
+		if err := u.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("Uint8 %q: expected error", in)
+		}
+	}
+	var i8 Int8
+	err = json.Unmarshal([]byte(`"-0b10000000"`), &i8)
+	maybePanic(err)
+	if !i8.Valid || i8.Int8 != -128 {
+		t.Errorf("Int8 -0b10000000: got %v", i8)
+	}
+}
+
+func TestFormatBase(t *testing.T) {
+	defer func() { DefaultNumberFormat = NumberFormat{} }()
+	DefaultNumberFormat = NumberFormat{BasePrefixes: true}
+
+	for _, test := range []struct {
+		got, want string
+	}{
+		{IntFrom(31).FormatBase(16), "0x1f"},
+		{IntFrom(-31).FormatBase(16), "-0x1f"},
+		{IntFrom(-1 << 63).FormatBase(16), "-0x8000000000000000"},
+		{IntFrom(15).FormatBase(8), "0o17"},
+		{IntFrom(10).FormatBase(2), "0b1010"},
+		{IntFrom(35).FormatBase(36), "z"},
+		{IntFrom(42).FormatBase(10), "42"},
+		{Int8From(-128).FormatBase(2), "-0b10000000"},
+		{Int16From(-1).FormatBase(16), "-0x1"},
+		{Int32From(8).FormatBase(8), "0o10"},
+		{UintFrom(1<<64 - 1).FormatBase(16), "0xffffffffffffffff"},
+		{Uint8From(255).FormatBase(2), "0b11111111"},
+		{Uint16From(0).FormatBase(16), "0x0"},
+		{Uint32From(493).FormatBase(8), "0o755"},
+		{Int{}.FormatBase(16), ""},
+		{Uint{}.FormatBase(16), ""},
+	} {
+		if test.got != test.want {
+			t.Errorf("FormatBase: got %q, want %q", test.got, test.want)
+		}
+	}
+
+	// output can be parsed back
+	var i Int
+	err := i.UnmarshalText([]byte(IntFrom(-1 << 63).FormatBase(2)))
+	maybePanic(err)
+	assertIntValue(t, i, "FormatBase", -1<<63)
+}
@@ -0,0 +1,191 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+)
+
+// Optional is a nullable value of T that also records whether it was present at all.
+// It has three states: unset (the zero value), null, and a value.
+// It is intended for partial updates such as HTTP PATCH bodies,
+// where a field that was never sent should be left alone but an explicit null should clear it.
+// Unmarshaling, including of null, always makes it present. See Merge.
+// Apart from that, it behaves like Value.
+type Optional[T any] struct {
+	sql.Null[T]
+	// Present is true if this Optional was set, even to null.
+	Present bool
+}
+
+// OptionalFrom creates a new Optional that is present and valid.
+func OptionalFrom[T any](v T) Optional[T] {
+	return Optional[T]{Null: sql.Null[T]{V: v, Valid: true}, Present: true}
+}
+
+// OptionalNull creates a new Optional that is present and null.
+func OptionalNull[T any]() Optional[T] {
+	return Optional[T]{Present: true}
+}
+
+// value returns this Optional as a Value, which has the same behavior apart from presence.
+func (o Optional[T]) value() Value[T] {
+	return Value[T]{Null: o.Null}
+}
+
+// IsSet returns true if this Optional is present, either as null or a value.
+func (o Optional[T]) IsSet() bool {
+	return o.Present
+}
+
+// IsNull returns true if this Optional is present and null.
+func (o Optional[T]) IsNull() bool {
+	return o.Present && !o.Valid
+}
+
+// Unset resets this Optional to its unset state.
+func (o *Optional[T]) Unset() {
+	*o = Optional[T]{}
+}
+
+// SetValid changes this Optional's value and also sets it to be present and non-null.
+func (o *Optional[T]) SetValid(v T) {
+	*o = OptionalFrom(v)
+}
+
+// SetNull sets this Optional to be present and null.
+func (o *Optional[T]) SetNull() {
+	*o = OptionalNull[T]()
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (o Optional[T]) ValueOrZero() T {
+	return o.value().ValueOrZero()
+}
+
+// Ptr returns a pointer to this Optional's value, or a nil pointer if this Optional is unset or null.
+func (o Optional[T]) Ptr() *T {
+	return o.value().Ptr()
+}
+
+// Scan implements the Scanner interface.
+// Scanning always makes this Optional present.
+func (o *Optional[T]) Scan(value interface{}) (err error) {
+	defer validateDecoded(o, &err)
+	if err := o.Null.Scan(value); err != nil {
+		o.Valid = false
+		return fmt.Errorf("null: couldn't scan Optional: %w", err)
+	}
+	o.Present = true
+	return nil
+}
+
+// Value implements the driver Valuer interface, in the same way as Value.
+// Unset Optionals are sent as null.
+func (o Optional[T]) Value() (driver.Value, error) {
+	return o.value().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null input, and any input T can be decoded from.
+// Either way, this Optional will be present afterwards.
+func (o *Optional[T]) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(o, &err)
+	v := o.value()
+	if err := v.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	o.Null, o.Present = v.Null, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Optional is unset or null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	return o.value().MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, in the same way as Value.
+// DefaultNullText is null, and either way this Optional will be present afterwards.
+func (o *Optional[T]) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(o, &err)
+	v := o.value()
+	if err := v.UnmarshalText(text); err != nil {
+		return err
+	}
+	o.Null, o.Present = v.Null, true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Optional is unset or null.
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	return o.value().MarshalText()
+}
+
+// IsZero returns true if this Optional is unset.
+// Combined with the omitzero JSON option, unset Optionals are omitted while null ones encode to null.
+func (o Optional[T]) IsZero() bool {
+	return !o.Present
+}
+
+// IsZeroOrNull returns true for unset or null Optionals, or set Optionals with the zero value of T.
+func (o Optional[T]) IsZeroOrNull() bool {
+	return !o.Present || !o.Valid || reflect.ValueOf(&o.V).Elem().IsZero()
+}
+
+// Equal returns true if both Optionals are unset, both null, or have the same value.
+func (o Optional[T]) Equal(other Optional[T]) bool {
+	return o.Present == other.Present && o.value().Equal(other.value())
+}
+
+// applyTo assigns this Optional to dst, a settable field.
+func (o Optional[T]) applyTo(dst reflect.Value) error {
+	switch d := dst.Addr().Interface().(type) {
+	case *T:
+		*d = o.ValueOrZero()
+	case **T:
+		*d = o.Ptr()
+	case *Optional[T]:
+		*d = o
+	case *Value[T]:
+		*d = o.value()
+	case interface{ SetValid(T) }:
+		if o.Valid {
+			d.SetValid(o.V)
+		} else {
+			dst.Set(reflect.Zero(dst.Type()))
+		}
+	default:
+		return fmt.Errorf("can't assign %T to %s", o, dst.Type())
+	}
+	return nil
+}
+
+func (o Optional[T]) isPresent() bool {
+	return o.Present
+}
+
+type optionalField interface {
+	isPresent() bool
+	IsNull() bool
+	applyTo(dst reflect.Value) error
+}
+
+// ApplyPatch copies the present Optional fields of patch to the matching fields of dst, including null ones.
+// Unset fields are skipped, and other fields of patch are ignored.
+// dst must be a pointer to a struct, and patch a struct or a pointer to one.
+// Fields are matched like Merge: by their null struct tag, or else by field name.
+// An Optional[T] field can be applied to a field of type T (null becomes the zero value),
+// *T (null becomes nil), Value[T], Optional[T], or any type with a SetValid(T) method, such as String for Optional[string],
+// in which case null sets the field to its zero value.
+//
+// Deprecated: Use Merge with MergePresent, which is the same for patches made of Optional fields,
+// and also copies other valid fields.
+func ApplyPatch(dst, patch interface{}) error {
+	return merge("ApplyPatch", dst, patch, func(v reflect.Value) bool {
+		opt, ok := v.Interface().(optionalField)
+		return ok && opt.isPresent()
+	})
+}
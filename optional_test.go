@@ -0,0 +1,218 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testPatch struct {
+	Name     Optional[string] `json:"name"`
+	Age      Optional[int]    `json:"age"`
+	Nickname Optional[string] `json:"nickname"`
+	Email    Optional[string] `json:"email"`
+	Score    Optional[int64]  `json:"score"`
+	ignored  Optional[string]
+}
+
+type testUser struct {
+	Name     string
+	Age      *int
+	Nickname String
+	Email    Value[string]
+	Score    Int
+}
+
+func TestOptionalStates(t *testing.T) {
+	var unset Optional[string]
+	if unset.IsSet() || unset.IsNull() || unset.Valid || !unset.IsZero() {
+		t.Errorf("bad unset Optional: %#v", unset)
+	}
+
+	null := OptionalNull[string]()
+	if !null.IsSet() || !null.IsNull() || null.Valid || null.IsZero() {
+		t.Errorf("bad null Optional: %#v", null)
+	}
+
+	v := OptionalFrom("hello")
+	if !v.IsSet() || v.IsNull() || !v.Valid || v.V != "hello" || v.IsZero() {
+		t.Errorf("bad valid Optional: %#v", v)
+	}
+
+	v.SetNull()
+	if !v.Equal(null) {
+		t.Errorf("bad SetNull(): %#v", v)
+	}
+	v.SetValid("world")
+	if !v.Equal(OptionalFrom("world")) {
+		t.Errorf("bad SetValid(): %#v", v)
+	}
+	v.Unset()
+	if !v.Equal(unset) {
+		t.Errorf("bad Unset(): %#v", v)
+	}
+
+	if unset.Equal(null) || null.Equal(OptionalFrom("")) || OptionalFrom("a").Equal(OptionalFrom("b")) {
+		t.Error("different Optionals should not be equal")
+	}
+}
+
+func TestUnmarshalOptional(t *testing.T) {
+	var p testPatch
+	err := json.Unmarshal([]byte(`{"name":"Alice","nickname":null}`), &p)
+	maybePanic(err)
+
+	if !p.Name.Equal(OptionalFrom("Alice")) {
+		t.Errorf("bad value field: %#v", p.Name)
+	}
+	if !p.Nickname.IsNull() {
+		t.Errorf("bad null field: %#v", p.Nickname)
+	}
+	if p.Age.IsSet() || p.Email.IsSet() {
+		t.Errorf("absent fields should be unset: %#v %#v", p.Age, p.Email)
+	}
+
+	var bad Optional[int]
+	err = json.Unmarshal(stringJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	if bad.IsSet() {
+		t.Error("Optional should not be set after error")
+	}
+
+	var text Optional[int]
+	err = text.UnmarshalText([]byte("12345"))
+	maybePanic(err)
+	if !text.Equal(OptionalFrom(12345)) {
+		t.Errorf("bad UnmarshalText(): %#v", text)
+	}
+	var blank Optional[int]
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if !blank.IsNull() {
+		t.Errorf("bad blank UnmarshalText(): %#v", blank)
+	}
+}
+
+func TestMarshalOptional(t *testing.T) {
+	type out struct {
+		A Optional[string] `json:"a"`
+		B Optional[string] `json:"b,omitzero"`
+		C Optional[string] `json:"c,omitzero"`
+	}
+	data, err := json.Marshal(out{A: OptionalFrom("hello"), C: OptionalNull[string]()})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"a":"hello","c":null}`, "optional json marshal")
+
+	data, err = OptionalFrom("hello").MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "hello", "optional text marshal")
+	data, err = Optional[string]{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "unset text marshal")
+}
+
+func TestOptionalScanValue(t *testing.T) {
+	var o Optional[int64]
+	err := o.Scan(int64(12345))
+	maybePanic(err)
+	if !o.Equal(OptionalFrom(int64(12345))) {
+		t.Errorf("bad scanned Optional: %#v", o)
+	}
+	if v, err := o.Value(); v != int64(12345) || err != nil {
+		t.Errorf("bad Value(): %#v %v", v, err)
+	}
+
+	var null Optional[int64]
+	err = null.Scan(nil)
+	maybePanic(err)
+	if !null.IsNull() {
+		t.Errorf("bad scanned null Optional: %#v", null)
+	}
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Errorf("bad null Value(): %#v %v", v, err)
+	}
+
+	var bad Optional[int64]
+	if err := bad.Scan("hello"); err == nil {
+		t.Error("expected error")
+	}
+	if bad.IsSet() || bad.Valid {
+		t.Errorf("bad Optional after failed scan: %#v", bad)
+	}
+
+	if OptionalFrom(1).ValueOrZero() != 1 || OptionalNull[int]().ValueOrZero() != 0 {
+		t.Error("bad ValueOrZero()")
+	}
+	if *OptionalFrom(1).Ptr() != 1 || OptionalNull[int]().Ptr() != nil {
+		t.Error("bad Ptr()")
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	age := 30
+	user := testUser{
+		Name:     "Bob",
+		Age:      &age,
+		Nickname: StringFrom("bobby"),
+		Email:    ValueFrom("bob@example.com"),
+		Score:    IntFrom(10),
+	}
+
+	var p testPatch
+	maybePanic(json.Unmarshal([]byte(`{"name":"Robert","age":null,"nickname":null,"email":"rob@example.com"}`), &p))
+	p.ignored = OptionalFrom("ignored")
+	err := ApplyPatch(&user, &p)
+	maybePanic(err)
+
+	if user.Name != "Robert" {
+		t.Errorf("bad patched Name: %q", user.Name)
+	}
+	if user.Age != nil {
+		t.Errorf("bad patched Age: %v", *user.Age)
+	}
+	if user.Nickname.Valid {
+		t.Errorf("bad patched Nickname: %#v", user.Nickname)
+	}
+	if !user.Email.Equal(ValueFrom("rob@example.com")) {
+		t.Errorf("bad patched Email: %#v", user.Email)
+	}
+	if !user.Score.Equal(IntFrom(10)) {
+		t.Errorf("unset Score should not change: %#v", user.Score)
+	}
+
+	p = testPatch{Age: OptionalFrom(31), Score: OptionalFrom(int64(20)), Nickname: OptionalFrom("rob")}
+	err = ApplyPatch(&user, p)
+	maybePanic(err)
+	if user.Age == nil || *user.Age != 31 || !user.Score.Equal(IntFrom(20)) || !user.Nickname.Equal(StringFrom("rob")) {
+		t.Errorf("bad second patch: %#v", user)
+	}
+
+	p = testPatch{Name: OptionalNull[string]()}
+	maybePanic(ApplyPatch(&user, p))
+	if user.Name != "" {
+		t.Errorf("null should set plain field to zero: %q", user.Name)
+	}
+
+	type wrongType struct {
+		Name Optional[int]
+	}
+	if err := ApplyPatch(&user, wrongType{Name: OptionalFrom(1)}); err == nil {
+		t.Error("expected error for mismatched type")
+	}
+	type missing struct {
+		Missing Optional[int]
+	}
+	if err := ApplyPatch(&user, missing{Missing: OptionalFrom(1)}); err == nil {
+		t.Error("expected error for missing field")
+	}
+	if err := ApplyPatch(&user, missing{}); err != nil {
+		t.Error("unset missing fields should be skipped:", err)
+	}
+	if err := ApplyPatch(user, p); err == nil {
+		t.Error("expected error for non-pointer destination")
+	}
+	if err := ApplyPatch(&user, "hello"); err == nil {
+		t.Error("expected error for non-struct patch")
+	}
+}
@@ -0,0 +1,262 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// PercentRange is the inclusive range of ratios that Percent accepts.
+type PercentRange struct {
+	Min, Max float64
+}
+
+// DefaultPercentRange is the range of ratios that Percent accepts when unmarshaling, scanning, and parsing.
+// It defaults to 0 to 1, or 0% to 100%. Use an infinite bound for no limit,
+// such as Max: math.Inf(1) for growth rates that can exceed 100%.
+var DefaultPercentRange = PercentRange{Min: 0, Max: 1}
+
+func (r PercentRange) check(ratio float64) error {
+	if math.IsNaN(ratio) || math.IsInf(ratio, 0) || ratio < r.Min || ratio > r.Max {
+		return fmt.Errorf("ratio %v out of range [%v, %v]", ratio, r.Min, r.Max)
+	}
+	return nil
+}
+
+// Percent is a nullable ratio, such as 0.125 for 12.5%.
+// It accepts ratios such as 0.125 and percentages such as "12.5%", and normalizes them to a ratio,
+// which it encodes as a number in JSON and text, the same as Float.
+// Input outside of DefaultPercentRange, which is 0 to 1 by default, is rejected.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Percent struct {
+	sql.NullFloat64
+}
+
+// NewPercent creates a new Percent from a ratio, such as 0.125 for 12.5%.
+func NewPercent(ratio float64, valid bool) Percent {
+	return Percent{
+		NullFloat64: sql.NullFloat64{
+			Float64: ratio,
+			Valid:   valid,
+		},
+	}
+}
+
+// PercentFrom creates a new Percent from a ratio that will always be valid.
+func PercentFrom(ratio float64) Percent {
+	return NewPercent(ratio, true)
+}
+
+// PercentFromPtr creates a new Percent from a ratio that will be null if ratio is nil.
+func PercentFromPtr(ratio *float64) Percent {
+	if ratio == nil {
+		return NewPercent(0, false)
+	}
+	return NewPercent(*ratio, true)
+}
+
+// ParsePercent creates a new Percent from a percentage such as "12.5%", or a ratio such as "0.125".
+// It returns an error if the ratio is not in DefaultPercentRange.
+func ParsePercent(s string) (Percent, error) {
+	ratio, err := parsePercent(s)
+	if err != nil {
+		return Percent{}, fmt.Errorf("null: couldn't parse Percent: %w", err)
+	}
+	return PercentFrom(ratio), nil
+}
+
+// ValueOrZero returns the inner ratio if valid, otherwise zero.
+func (p Percent) ValueOrZero() float64 {
+	if !p.Valid {
+		return 0
+	}
+	return p.Float64
+}
+
+// Scan implements the Scanner interface.
+// It supports numbers, which are ratios, and strings such as "12.5%" or "0.125".
+// It returns an error if the ratio is not in DefaultPercentRange.
+func (p *Percent) Scan(value interface{}) (err error) {
+	defer validateDecoded(p, &err)
+	value = driverval.Convert(value)
+	var ratio float64
+	switch v := value.(type) {
+	case nil:
+		p.Float64, p.Valid = 0, false
+		return nil
+	case []byte:
+		ratio, err = parsePercent(string(v))
+	case string:
+		ratio, err = parsePercent(v)
+	default:
+		var n sql.NullFloat64
+		if err := n.Scan(value); err != nil {
+			return fmt.Errorf("null: couldn't scan type %T into Percent: %w", value, err)
+		}
+		ratio = n.Float64
+		err = DefaultPercentRange.check(ratio)
+	}
+	if err != nil {
+		p.Valid = false
+		return fmt.Errorf("null: couldn't scan Percent: %w", err)
+	}
+	p.Float64, p.Valid = ratio, true
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number input, which is a ratio, strings such as "12.5%" or "0.125", and null input.
+// It returns an error if the ratio is not in DefaultPercentRange.
+func (p *Percent) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(p, &err)
+	if bytes.Equal(data, nullBytes) {
+		p.Valid = false
+		return nil
+	}
+
+	var ratio float64
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		ratio, err = parsePercent(str)
+	} else if err = json.Unmarshal(data, &ratio); err == nil {
+		err = DefaultPercentRange.check(ratio)
+	}
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	p.Float64 = ratio
+	p.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Percent is null, otherwise its ratio as a number.
+func (p Percent) MarshalJSON() ([]byte, error) {
+	return Float{p.NullFloat64}.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Percent if the input is blank, "null", or DefaultNullText,
+// otherwise it accepts percentages such as "12.5%" and ratios such as "0.125".
+func (p *Percent) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(p, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		p.Valid = false
+		return nil
+	}
+	ratio, err := parsePercent(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	p.Float64 = ratio
+	p.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Percent is null, otherwise its ratio.
+// Use FormatPercent for a percentage.
+func (p Percent) MarshalText() ([]byte, error) {
+	return Float{p.NullFloat64}.MarshalText()
+}
+
+// FormatPercent returns this Percent as a percentage, such as "12.5%" for 0.125.
+// It returns a blank string if this Percent is null.
+func (p Percent) FormatPercent() string {
+	if !p.Valid {
+		return ""
+	}
+	return formatPercent(p.Float64)
+}
+
+// SetValid changes this Percent's ratio and also sets it to be non-null.
+func (p *Percent) SetValid(ratio float64) {
+	p.Float64 = ratio
+	p.Valid = true
+}
+
+// SetNull sets this Percent to null, leaving its value as is. Use Reset to clear the value too.
+func (p *Percent) SetNull() {
+	p.Valid = false
+}
+
+// Reset sets this Percent to null and clears its value.
+func (p *Percent) Reset() {
+	*p = Percent{}
+}
+
+// Ptr returns a pointer to this Percent's ratio, or a nil pointer if this Percent is null.
+func (p Percent) Ptr() *float64 {
+	if !p.Valid {
+		return nil
+	}
+	return &p.Float64
+}
+
+// SetPtr changes this Percent's value to the value v points to, or sets it to be null if v is nil, like PercentFromPtr.
+func (p *Percent) SetPtr(v *float64) {
+	*p = PercentFromPtr(v)
+}
+
+// IsZero returns true for invalid Percents.
+// A non-null Percent with a 0 value will not be considered zero.
+func (p Percent) IsZero() bool {
+	return !p.Valid
+}
+
+// IsZeroOrNull returns true for invalid Percents, or valid Percents with a 0 value.
+func (p Percent) IsZeroOrNull() bool {
+	return !p.Valid || p.Float64 == 0
+}
+
+// Equal returns true if both Percents have the same ratio or are both null.
+// Like Float's Equal, it is not suitable for comparing the results of calculations.
+func (p Percent) Equal(other Percent) bool {
+	return p.Valid == other.Valid && (!p.Valid || p.Float64 == other.Float64)
+}
+
+// parsePercent parses a percentage such as "12.5%" or a ratio such as "0.125", and checks it is in DefaultPercentRange.
+func parsePercent(str string) (float64, error) {
+	s := strings.TrimSpace(str)
+	var ratio float64
+	var err error
+	if num, ok := strings.CutSuffix(s, "%"); ok {
+		num = strings.TrimSpace(num)
+		if strings.Trim(num, "+-.0123456789") == "" {
+			// shifting the exponent avoids the rounding of dividing by 100
+			ratio, err = strconv.ParseFloat(num+"e-2", 64)
+		} else if ratio, err = strconv.ParseFloat(num, 64); err == nil {
+			ratio /= 100
+		}
+	} else {
+		ratio, err = strconv.ParseFloat(s, 64)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid percentage: %q", str)
+	}
+	return ratio, DefaultPercentRange.check(ratio)
+}
+
+// formatPercent formats ratio as a percentage by shifting the exponent of its shortest representation,
+// since multiplying by 100 could round: 0.07 is 7%, not 7.000000000000001%.
+func formatPercent(ratio float64) string {
+	mantissa, exp, ok := strings.Cut(strconv.FormatFloat(ratio, 'e', -1, 64), "e")
+	n, err := strconv.Atoi(exp)
+	if !ok || err != nil {
+		return strconv.FormatFloat(ratio, 'f', -1, 64) + "%"
+	}
+	pct, _ := strconv.ParseFloat(mantissa+"e"+strconv.Itoa(n+2), 64)
+	return strconv.FormatFloat(pct, 'f', -1, 64) + "%"
+}
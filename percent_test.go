@@ -0,0 +1,246 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+var (
+	percentValue      = 0.125
+	percentJSON       = []byte(`0.125`)
+	percentStringJSON = []byte(`"12.5%"`)
+)
+
+func TestPercentFrom(t *testing.T) {
+	assertPercent(t, PercentFrom(percentValue), "PercentFrom()")
+
+	zero := PercentFrom(0)
+	if !zero.Valid {
+		t.Error("PercentFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestPercentFromPtr(t *testing.T) {
+	v := percentValue
+	assertPercent(t, PercentFromPtr(&v), "PercentFromPtr()")
+	assertNullPercent(t, PercentFromPtr(nil), "PercentFromPtr(nil)")
+}
+
+func TestParsePercent(t *testing.T) {
+	for in, want := range map[string]float64{
+		"12.5%":   0.125,
+		" 12.5 %": 0.125,
+		"0.125":   0.125,
+		"7%":      0.07,
+		"0.07":    0.07,
+		"0%":      0,
+		"100%":    1,
+		"1":       1,
+		"+50%":    0.5,
+		"5e1%":    0.5,
+		".5%":     0.005,
+	} {
+		p, err := ParsePercent(in)
+		if err != nil {
+			t.Errorf("ParsePercent(%q): unexpected error: %v", in, err)
+			continue
+		}
+		if !p.Valid || p.Float64 != want {
+			t.Errorf("ParsePercent(%q): got %v, want %v", in, p.Float64, want)
+		}
+	}
+
+	for _, bad := range []string{"", "%", "abc", "12.5%%", "150%", "1.5", "-0.1", "-10%", "NaN", "Inf%"} {
+		if _, err := ParsePercent(bad); err == nil {
+			t.Errorf("ParsePercent(%q): expected error", bad)
+		}
+	}
+}
+
+func TestDefaultPercentRange(t *testing.T) {
+	defer func(r PercentRange) { DefaultPercentRange = r }(DefaultPercentRange)
+	DefaultPercentRange = PercentRange{Min: -1, Max: math.Inf(1)}
+
+	for in, want := range map[string]float64{"250%": 2.5, "-30%": -0.3, "1.5": 1.5} {
+		p, err := ParsePercent(in)
+		if err != nil || p.Float64 != want {
+			t.Errorf("ParsePercent(%q): got %v, %v; want %v", in, p.Float64, err, want)
+		}
+	}
+	if _, err := ParsePercent("-101%"); err == nil {
+		t.Error("expected error parsing percentage below the minimum")
+	}
+	if _, err := ParsePercent("Inf"); err == nil {
+		t.Error("expected error parsing infinity")
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	for ratio, want := range map[float64]string{
+		0:      "0%",
+		1:      "100%",
+		0.125:  "12.5%",
+		0.07:   "7%",
+		0.001:  "0.1%",
+		0.0001: "0.01%",
+		2.5:    "250%",
+		-0.3:   "-30%",
+	} {
+		if got := PercentFrom(ratio).FormatPercent(); got != want {
+			t.Errorf("FormatPercent(%v): got %q, want %q", ratio, got, want)
+		}
+	}
+	if got := NewPercent(0, false).FormatPercent(); got != "" {
+		t.Errorf("null FormatPercent(): got %q, want blank", got)
+	}
+}
+
+func TestUnmarshalPercent(t *testing.T) {
+	var p Percent
+	err := json.Unmarshal(percentJSON, &p)
+	maybePanic(err)
+	assertPercent(t, p, "number json")
+
+	var str Percent
+	err = json.Unmarshal(percentStringJSON, &str)
+	maybePanic(err)
+	assertPercent(t, str, "string json")
+
+	var null Percent
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullPercent(t, null, "null json")
+
+	for _, bad := range [][]byte{[]byte(`1.5`), []byte(`-0.1`), []byte(`"150%"`), []byte(`""`), boolJSON, invalidJSON} {
+		var invalid Percent
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullPercent(t, invalid, "invalid json")
+	}
+}
+
+func TestMarshalPercent(t *testing.T) {
+	p := PercentFrom(percentValue)
+	data, err := json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(percentJSON), "non-empty json marshal")
+
+	null := NewPercent(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestPercentText(t *testing.T) {
+	p := PercentFrom(percentValue)
+	data, err := p.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0.125", "text marshal")
+
+	var text Percent
+	err = text.UnmarshalText([]byte("12.5%"))
+	maybePanic(err)
+	assertPercent(t, text, "text unmarshal")
+
+	var blank Percent
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullPercent(t, blank, "blank text unmarshal")
+
+	null := NewPercent(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var bad Percent
+	if err := bad.UnmarshalText([]byte("200%")); err == nil {
+		t.Error("expected error unmarshaling out of range text")
+	}
+}
+
+func TestPercentScanValue(t *testing.T) {
+	for _, in := range []interface{}{0.125, "12.5%", []byte("0.125")} {
+		var p Percent
+		err := p.Scan(in)
+		maybePanic(err)
+		assertPercent(t, p, "scanned")
+	}
+
+	var null Percent
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullPercent(t, null, "scanned null")
+
+	for _, bad := range []interface{}{1.5, int64(2), "x", "150%", true} {
+		var invalid Percent
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %v", bad)
+		}
+		assertNullPercent(t, invalid, "invalid scan")
+	}
+
+	v, err := PercentFrom(percentValue).Value()
+	maybePanic(err)
+	if v != percentValue {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestPercentPointer(t *testing.T) {
+	p := PercentFrom(percentValue)
+	if ptr := p.Ptr(); ptr == nil || *ptr != percentValue {
+		t.Errorf("bad pointer: %v", ptr)
+	}
+	if NewPercent(0, false).Ptr() != nil {
+		t.Error("null Ptr() should be nil")
+	}
+	if p.ValueOrZero() != percentValue || NewPercent(percentValue, false).ValueOrZero() != 0 {
+		t.Error("bad ValueOrZero()")
+	}
+}
+
+func TestPercentSetValid(t *testing.T) {
+	change := NewPercent(0, false)
+	assertNullPercent(t, change, "SetValid()")
+	change.SetValid(percentValue)
+	assertPercent(t, change, "SetValid()")
+}
+
+func TestPercentEqual(t *testing.T) {
+	if !PercentFrom(0.5).Equal(PercentFrom(0.5)) || !NewPercent(0.1, false).Equal(NewPercent(0.2, false)) {
+		t.Error("Percents should be equal")
+	}
+	if PercentFrom(0.5).Equal(PercentFrom(0.25)) || PercentFrom(0).Equal(NewPercent(0, false)) {
+		t.Error("Percents should not be equal")
+	}
+	if PercentFrom(0).IsZero() || !NewPercent(0, false).IsZero() {
+		t.Error("bad IsZero()")
+	}
+	if !PercentFrom(0).IsZeroOrNull() || PercentFrom(0.5).IsZeroOrNull() {
+		t.Error("bad IsZeroOrNull()")
+	}
+}
+
+func assertPercent(t *testing.T, p Percent, from string) {
+	t.Helper()
+	if p.Float64 != percentValue {
+		t.Errorf("bad %s ratio: %v ≠ %v\n", from, p.Float64, percentValue)
+	}
+	if !p.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullPercent(t *testing.T, p Percent, from string) {
+	t.Helper()
+	if p.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
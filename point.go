@@ -0,0 +1,355 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// PointFormat is a way of representing a Point as JSON.
+type PointFormat int
+
+const (
+	// PointObject encodes Points as objects, such as {"lat":35.6895,"lng":139.6917}.
+	PointObject PointFormat = iota
+	// PointArray encodes Points as [lng,lat] arrays, the coordinate order used by GeoJSON.
+	PointArray
+)
+
+// DefaultPointFormat is the format Point uses for JSON marshaling.
+// Both objects and arrays are always accepted when unmarshaling.
+// It defaults to PointObject.
+var DefaultPointFormat = PointObject
+
+// NullPoint represents a geographic point that may be null.
+// NullPoint implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullPoint struct {
+	Lat   float64 // Lat is the latitude, in degrees from -90 to 90
+	Lng   float64 // Lng is the longitude, in degrees from -180 to 180
+	Valid bool    // Valid is true if the point is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports WKT such as "POINT(139.6917 35.6895)", EWKT with an SRID prefix,
+// WKB and EWKB either as raw bytes or hex strings (as returned by PostGIS),
+// and MySQL's internal geometry format (an SRID followed by WKB).
+// Coordinates are always in longitude, latitude order, and must be in range.
+func (n *NullPoint) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var lat, lng float64
+	var err error
+	switch v := value.(type) {
+	case nil:
+		n.Lat, n.Lng, n.Valid = 0, 0, false
+		return nil
+	case []byte:
+		if isPointWKB(v) {
+			lat, lng, err = parsePointWKB(v)
+		} else {
+			lat, lng, err = parsePointString(string(v))
+		}
+	case string:
+		lat, lng, err = parsePointString(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Point: %v", value, value)
+	}
+	if err == nil {
+		err = checkPoint(lat, lng)
+	}
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan Point: %w", err)
+	}
+	n.Lat, n.Lng, n.Valid = lat, lng, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as WKT strings, such as "POINT(139.6917 35.6895)".
+func (n NullPoint) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return formatPointWKT(n.Lat, n.Lng), nil
+}
+
+// IsZero returns true for invalid NullPoints.
+func (n NullPoint) IsZero() bool {
+	return !n.Valid
+}
+
+// Point is a nullable geographic point, with a latitude and longitude in degrees.
+// It does not consider the point 0,0 to be null.
+// It will decode to null, not zero, if null.
+type Point struct {
+	NullPoint
+}
+
+// NewPoint creates a new Point.
+func NewPoint(lat, lng float64, valid bool) Point {
+	return Point{
+		NullPoint: NullPoint{
+			Lat:   lat,
+			Lng:   lng,
+			Valid: valid,
+		},
+	}
+}
+
+// PointFrom creates a new Point that will always be valid.
+// Use Check to validate its coordinates.
+func PointFrom(lat, lng float64) Point {
+	return NewPoint(lat, lng, true)
+}
+
+// Check returns an error if this Point is valid but its coordinates are out of range.
+// Scanning and unmarshaling already check this.
+func (p Point) Check() error {
+	if !p.Valid {
+		return nil
+	}
+	if err := checkPoint(p.Lat, p.Lng); err != nil {
+		return fmt.Errorf("null: invalid Point: %w", err)
+	}
+	return nil
+}
+
+type pointJSON struct {
+	Lat *float64 `json:"lat"`
+	Lng *float64 `json:"lng"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports {"lat":..,"lng":..} objects, [lng,lat] arrays, and null input.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		p.Valid = false
+		return nil
+	}
+
+	var lat, lng float64
+	switch {
+	case len(data) > 0 && data[0] == '[':
+		var coords []float64
+		if err := json.Unmarshal(data, &coords); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		if len(coords) != 2 {
+			return fmt.Errorf("null: Point array must have 2 coordinates, got %d", len(coords))
+		}
+		lng, lat = coords[0], coords[1]
+	case len(data) > 0 && data[0] == '{':
+		var obj pointJSON
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		if obj.Lat == nil || obj.Lng == nil {
+			return fmt.Errorf("null: Point object must have lat and lng: %s", data)
+		}
+		lat, lng = *obj.Lat, *obj.Lng
+	default:
+		return fmt.Errorf("null: JSON input is invalid type (need object or array): %s", data)
+	}
+	if err := checkPoint(lat, lng); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	p.Lat, p.Lng = lat, lng
+	p.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Point is null,
+// otherwise it uses DefaultPointFormat.
+func (p Point) MarshalJSON() ([]byte, error) {
+	if !p.Valid {
+		return []byte("null"), nil
+	}
+	if DefaultPointFormat == PointArray {
+		return json.Marshal([2]float64{p.Lng, p.Lat})
+	}
+	return json.Marshal(pointJSON{Lat: &p.Lat, Lng: &p.Lng})
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports WKT or EWKT, and will unmarshal to a null Point if the input is blank, "null", or DefaultNullText.
+func (p *Point) UnmarshalText(text []byte) error {
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		p.Valid = false
+		return nil
+	}
+	lat, lng, err := parsePointWKT(str)
+	if err == nil {
+		err = checkPoint(lat, lng)
+	}
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	p.Lat, p.Lng = lat, lng
+	p.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Point is null, otherwise WKT such as "POINT(139.6917 35.6895)".
+func (p Point) MarshalText() ([]byte, error) {
+	if !p.Valid {
+		return nullText(), nil
+	}
+	return []byte(formatPointWKT(p.Lat, p.Lng)), nil
+}
+
+// SetValid changes this Point's coordinates and also sets it to be non-null.
+func (p *Point) SetValid(lat, lng float64) {
+	p.Lat = lat
+	p.Lng = lng
+	p.Valid = true
+}
+
+// SetNull sets this Point to null, leaving its value as is. Use Reset to clear the value too.
+func (p *Point) SetNull() {
+	p.Valid = false
+}
+
+// Reset sets this Point to null and clears its value.
+func (p *Point) Reset() {
+	*p = Point{}
+}
+
+// IsZero returns true for invalid Points.
+// A non-null Point at 0,0 will not be considered zero.
+func (p Point) IsZero() bool {
+	return !p.Valid
+}
+
+// IsZeroOrNull returns true for invalid Points, or valid Points with a point at 0,0.
+func (p Point) IsZeroOrNull() bool {
+	return !p.Valid || p.Lat == 0 && p.Lng == 0
+}
+
+// Equal returns true if both Points have the same coordinates or are both null.
+func (p Point) Equal(other Point) bool {
+	return p.Valid == other.Valid && (!p.Valid || (p.Lat == other.Lat && p.Lng == other.Lng))
+}
+
+func checkPoint(lat, lng float64) error {
+	if !(lat >= -90 && lat <= 90) {
+		return fmt.Errorf("latitude out of range: %v", lat)
+	}
+	if !(lng >= -180 && lng <= 180) {
+		return fmt.Errorf("longitude out of range: %v", lng)
+	}
+	return nil
+}
+
+func formatPointWKT(lat, lng float64) string {
+	return "POINT(" + strconv.FormatFloat(lng, 'g', -1, 64) + " " + strconv.FormatFloat(lat, 'g', -1, 64) + ")"
+}
+
+// parsePointString parses WKT, EWKT, or hex-encoded WKB or EWKB.
+func parsePointString(str string) (lat, lng float64, err error) {
+	str = strings.TrimSpace(str)
+	if len(str) > 0 && len(str)%2 == 0 && strings.Trim(str, "0123456789abcdefABCDEF") == "" {
+		b, err := hex.DecodeString(str)
+		if err != nil {
+			return 0, 0, err
+		}
+		return parsePointWKB(b)
+	}
+	return parsePointWKT(str)
+}
+
+// parsePointWKT parses a point such as "POINT(139.6917 35.6895)" or "SRID=4326;POINT(139.6917 35.6895)".
+func parsePointWKT(str string) (lat, lng float64, err error) {
+	str = strings.TrimSpace(str)
+	if prefix, rest, ok := strings.Cut(str, ";"); ok && strings.HasPrefix(strings.ToUpper(prefix), "SRID=") {
+		str = strings.TrimSpace(rest)
+	}
+	if len(str) < 5 || !strings.EqualFold(str[:5], "POINT") {
+		return 0, 0, fmt.Errorf("not a WKT point: %q", str)
+	}
+	coords := strings.TrimSpace(str[5:])
+	if !strings.HasPrefix(coords, "(") || !strings.HasSuffix(coords, ")") {
+		return 0, 0, fmt.Errorf("not a WKT point: %q", str)
+	}
+	fields := strings.Fields(coords[1 : len(coords)-1])
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("WKT point must have 2 coordinates: %q", str)
+	}
+	if lng, err = strconv.ParseFloat(fields[0], 64); err != nil {
+		return 0, 0, err
+	}
+	if lat, err = strconv.ParseFloat(fields[1], 64); err != nil {
+		return 0, 0, err
+	}
+	return lat, lng, nil
+}
+
+const (
+	wkbPoint   = 1
+	wkbLittle  = 1
+	ewkbSRID   = 0x20000000
+	wkbLen     = 1 + 4 + 8 + 8 // byte order, type, x, y
+	sridWKBLen = 4 + wkbLen
+)
+
+// isPointWKB reports whether b looks like a binary point, rather than text.
+func isPointWKB(b []byte) bool {
+	return (len(b) == wkbLen && b[0] <= wkbLittle) || (len(b) == sridWKBLen && (hasEWKBSRID(b) || b[4] <= wkbLittle))
+}
+
+// parsePointWKB parses a WKB or EWKB point, or MySQL's format of a little-endian SRID followed by a WKB point.
+func parsePointWKB(b []byte) (lat, lng float64, err error) {
+	if len(b) == sridWKBLen && !hasEWKBSRID(b) {
+		// MySQL
+		b = b[4:]
+	}
+	if len(b) < 5 || b[0] > wkbLittle {
+		return 0, 0, errors.New("invalid WKB point")
+	}
+	var order binary.ByteOrder = binary.BigEndian
+	if b[0] == wkbLittle {
+		order = binary.LittleEndian
+	}
+	typ := order.Uint32(b[1:5])
+	b = b[5:]
+	if typ&ewkbSRID != 0 {
+		if len(b) < 4 {
+			return 0, 0, errors.New("invalid EWKB point")
+		}
+		b = b[4:]
+		typ &^= ewkbSRID
+	}
+	if typ != wkbPoint {
+		return 0, 0, fmt.Errorf("unsupported WKB geometry type: %d", typ)
+	}
+	if len(b) != 16 {
+		return 0, 0, errors.New("invalid WKB point")
+	}
+	lng = math.Float64frombits(order.Uint64(b[:8]))
+	lat = math.Float64frombits(order.Uint64(b[8:]))
+	return lat, lng, nil
+}
+
+func hasEWKBSRID(b []byte) bool {
+	if b[0] > wkbLittle {
+		return false
+	}
+	var order binary.ByteOrder = binary.BigEndian
+	if b[0] == wkbLittle {
+		order = binary.LittleEndian
+	}
+	return order.Uint32(b[1:5])&ewkbSRID != 0
+}
@@ -0,0 +1,235 @@
+package null
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+var (
+	pointLat        = 35.6895
+	pointLng        = 139.6917
+	pointObjectJSON = []byte(`{"lat":35.6895,"lng":139.6917}`)
+	pointArrayJSON  = []byte(`[139.6917,35.6895]`)
+	pointWKT        = "POINT(139.6917 35.6895)"
+)
+
+// pointWKBBytes encodes the test point as WKB, optionally with an EWKB SRID.
+func pointWKBBytes(order binary.AppendByteOrder, srid uint32) []byte {
+	b := []byte{0}
+	if order == binary.LittleEndian {
+		b[0] = 1
+	}
+	typ := uint32(1)
+	if srid != 0 {
+		typ |= 0x20000000
+	}
+	b = order.AppendUint32(b, typ)
+	if srid != 0 {
+		b = order.AppendUint32(b, srid)
+	}
+	b = order.AppendUint64(b, math.Float64bits(pointLng))
+	b = order.AppendUint64(b, math.Float64bits(pointLat))
+	return b
+}
+
+func TestPointFrom(t *testing.T) {
+	p := PointFrom(pointLat, pointLng)
+	assertPoint(t, p, "PointFrom()")
+	if err := p.Check(); err != nil {
+		t.Error("Check():", err)
+	}
+
+	for _, bad := range []Point{PointFrom(91, 0), PointFrom(0, -180.5), PointFrom(math.NaN(), 0)} {
+		if err := bad.Check(); err == nil {
+			t.Errorf("Check() of %v,%v should return an error", bad.Lat, bad.Lng)
+		}
+	}
+	if err := NewPoint(100, 200, false).Check(); err != nil {
+		t.Error("Check() of null Point should not return an error:", err)
+	}
+}
+
+func TestUnmarshalPoint(t *testing.T) {
+	var obj Point
+	err := json.Unmarshal(pointObjectJSON, &obj)
+	maybePanic(err)
+	assertPoint(t, obj, "object json")
+
+	var arr Point
+	err = json.Unmarshal(pointArrayJSON, &arr)
+	maybePanic(err)
+	assertPoint(t, arr, "array json")
+
+	var null Point
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullPoint(t, null, "null json")
+
+	for _, bad := range []string{`[1]`, `[1,2,3]`, `{"lat":1}`, `{"lat":95,"lng":0}`, `[190,0]`, `"POINT(1 2)"`, `1`, `:)`} {
+		var invalid Point
+		if err := json.Unmarshal([]byte(bad), &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullPoint(t, invalid, "invalid json")
+	}
+}
+
+func TestMarshalPoint(t *testing.T) {
+	p := PointFrom(pointLat, pointLng)
+	data, err := json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(pointObjectJSON), "object json marshal")
+
+	DefaultPointFormat = PointArray
+	defer func() { DefaultPointFormat = PointObject }()
+	data, err = json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(pointArrayJSON), "array json marshal")
+
+	null := NewPoint(0, 0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestPointText(t *testing.T) {
+	p := PointFrom(pointLat, pointLng)
+	data, err := p.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, pointWKT, "text marshal")
+
+	var text Point
+	err = text.UnmarshalText([]byte("SRID=4326;point (139.6917  35.6895)"))
+	maybePanic(err)
+	assertPoint(t, text, "ewkt text unmarshal")
+
+	var blank Point
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullPoint(t, blank, "blank text unmarshal")
+
+	null := NewPoint(0, 0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	for _, bad := range []string{"POINT EMPTY", "LINESTRING(0 0, 1 1)", "POINT(1)", "POINT(0 100)"} {
+		var invalid Point
+		if err := invalid.UnmarshalText([]byte(bad)); err == nil {
+			t.Errorf("expected error unmarshaling %q", bad)
+		}
+	}
+}
+
+func TestPointScan(t *testing.T) {
+	mysql := binary.LittleEndian.AppendUint32(nil, 4326)
+	mysql = append(mysql, pointWKBBytes(binary.LittleEndian, 0)...)
+	mysqlNoSRID := append([]byte{0, 0, 0, 0}, pointWKBBytes(binary.LittleEndian, 0)...)
+
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{"wkt string", pointWKT},
+		{"wkt bytes", []byte(pointWKT)},
+		{"ewkt", "SRID=4326;" + pointWKT},
+		{"wkb little endian", pointWKBBytes(binary.LittleEndian, 0)},
+		{"wkb big endian", pointWKBBytes(binary.BigEndian, 0)},
+		{"ewkb", pointWKBBytes(binary.LittleEndian, 4326)},
+		{"ewkb hex", hex.EncodeToString(pointWKBBytes(binary.LittleEndian, 4326))},
+		{"ewkb hex bytes", []byte(hex.EncodeToString(pointWKBBytes(binary.BigEndian, 4326)))},
+		{"mysql", mysql},
+		{"mysql without srid", mysqlNoSRID},
+	}
+	for _, test := range tests {
+		var p Point
+		err := p.Scan(test.input)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		assertPoint(t, p, test.name)
+	}
+
+	var null Point
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullPoint(t, null, "scanned null")
+
+	lineString := pointWKBBytes(binary.LittleEndian, 0)
+	lineString[1] = 2
+	for _, bad := range []interface{}{"POINT(200 0)", lineString, []byte{1, 2, 3}, "00", int64(1)} {
+		var invalid Point
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %v", bad)
+		}
+		assertNullPoint(t, invalid, "invalid scan")
+	}
+}
+
+func TestPointValue(t *testing.T) {
+	p := PointFrom(pointLat, pointLng)
+	v, err := p.Value()
+	maybePanic(err)
+	if v != pointWKT {
+		t.Errorf("bad Value(): %#v ≠ %#v", v, pointWKT)
+	}
+
+	null := NewPoint(0, 0, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestPointSetValid(t *testing.T) {
+	change := NewPoint(0, 0, false)
+	assertNullPoint(t, change, "SetValid()")
+	change.SetValid(pointLat, pointLng)
+	assertPoint(t, change, "SetValid()")
+}
+
+func TestPointIsZero(t *testing.T) {
+	if PointFrom(0, 0).IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+	if !NewPoint(0, 0, false).IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestPointEqual(t *testing.T) {
+	if !PointFrom(pointLat, pointLng).Equal(PointFrom(pointLat, pointLng)) {
+		t.Error("same Points should be equal")
+	}
+	if !NewPoint(1, 2, false).Equal(NewPoint(3, 4, false)) {
+		t.Error("null Points should be equal")
+	}
+	if PointFrom(pointLat, pointLng).Equal(PointFrom(pointLng, pointLat)) {
+		t.Error("different Points should not be equal")
+	}
+	if PointFrom(0, 0).Equal(NewPoint(0, 0, false)) {
+		t.Error("valid and null Points should not be equal")
+	}
+}
+
+func assertPoint(t *testing.T, p Point, from string) {
+	t.Helper()
+	if p.Lat != pointLat || p.Lng != pointLng {
+		t.Errorf("bad %s point: %v,%v ≠ %v,%v\n", from, p.Lat, p.Lng, pointLat, pointLng)
+	}
+	if !p.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullPoint(t *testing.T, p Point, from string) {
+	t.Helper()
+	if p.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,132 @@
+package null
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// FromPointers sets the fields of the struct pointed to by dst, such as a model with fields of this package's types,
+// from the matching fields of the struct src, such as a legacy model with *uint64 and *string fields.
+// This way, code can migrate from pointer-based models one struct at a time.
+// Fields are matched by their null struct tag, or else by field name. Fields tagged with "-" and unexported fields are skipped,
+// and fields of dst with no matching field in src are left unchanged.
+//
+// A nil pointer sets the field to null, and other values set it to be valid with the value they point to,
+// converted to the field's value type if needed, so an *int can set an Int.
+// Fields of the same type are copied as is. Fields of this package's types can be converted this way if they have a SetValid method
+// with one argument, which all but Money, Point, and the ranges do, and so can the zero and nulldecimal types.
+func FromPointers(src, dst interface{}) error {
+	return convertStruct(src, dst, "FromPointers", fromPointer)
+}
+
+// ToPointers is the inverse of FromPointers. It sets the fields of the struct pointed to by dst, such as a legacy model
+// with *uint64 and *string fields, from the matching fields of the struct src, such as a model with fields of this package's types.
+// Null values set the field to nil, and valid values set it to a new pointer to the value, converted to the pointer's type if needed.
+// Fields that aren't pointers are set to the value, or to the zero value if it is null.
+// Values are read with their Get method, which all types with a single value have.
+func ToPointers(src, dst interface{}) error {
+	return convertStruct(src, dst, "ToPointers", toPointer)
+}
+
+// convertStruct sets the fields of the struct pointed to by dst from the matching fields of src with convert.
+func convertStruct(src, dst interface{}, name string, convert func(field, v reflect.Value) error) error {
+	sv := reflect.Indirect(reflect.ValueOf(src))
+	if sv.Kind() != reflect.Struct {
+		return fmt.Errorf("null: %s needs a struct, got %T", name, src)
+	}
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("null: %s needs a pointer to a struct, got %T", name, dst)
+	}
+	dv = dv.Elem()
+
+	srcFields := make(map[string][]int)
+	for _, f := range structFields(sv.Type(), "null") {
+		srcFields[f.name] = f.index
+	}
+	for _, f := range structFields(dv.Type(), "null") {
+		index, ok := srcFields[f.name]
+		if !ok {
+			continue
+		}
+		field, v := dv.FieldByIndex(f.index), sv.FieldByIndex(index)
+		if v.Type().AssignableTo(field.Type()) {
+			field.Set(v)
+			continue
+		}
+		if err := convert(field, v); err != nil {
+			return fmt.Errorf("null: couldn't convert field %s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+// fromPointer sets field, one of this package's types, from v, a pointer or value.
+func fromPointer(field, v reflect.Value) error {
+	set := field.Addr().MethodByName("SetValid")
+	if !set.IsValid() || set.Type().NumIn() != 1 {
+		return fmt.Errorf("can't convert %s to %s", v.Type(), field.Type())
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			field.SetZero()
+			// for Optional, null is different from unset
+			if setNull := field.Addr().MethodByName("SetNull"); setNull.IsValid() {
+				setNull.Call(nil)
+			}
+			return nil
+		}
+		// values such as *big.Int are pointers already
+		if !v.Type().AssignableTo(set.Type().In(0)) {
+			v = v.Elem()
+		}
+	}
+	arg := reflect.New(set.Type().In(0)).Elem()
+	if err := setConverted(arg, v); err != nil {
+		return err
+	}
+	set.Call([]reflect.Value{arg})
+	return nil
+}
+
+// toPointer sets field, a pointer or value, from v, one of this package's types.
+func toPointer(field, v reflect.Value) error {
+	get := v.MethodByName("Get")
+	if !get.IsValid() || get.Type().NumIn() != 0 || get.Type().NumOut() != 2 {
+		return fmt.Errorf("can't convert %s to %s", v.Type(), field.Type())
+	}
+	out := get.Call(nil)
+	if !out[1].Bool() {
+		field.SetZero()
+		return nil
+	}
+	if field.Kind() != reflect.Pointer || out[0].Type().AssignableTo(field.Type()) {
+		return setConverted(field, out[0])
+	}
+	p := reflect.New(field.Type().Elem())
+	if err := setConverted(p.Elem(), out[0]); err != nil {
+		return err
+	}
+	field.Set(p)
+	return nil
+}
+
+// setConverted is like setField, but returns an error instead of wrapping around for integers out of the field's range.
+func setConverted(field, v reflect.Value) error {
+	var overflow bool
+	switch {
+	case v.CanInt() && field.CanInt():
+		overflow = field.OverflowInt(v.Int())
+	case v.CanInt() && field.CanUint():
+		overflow = v.Int() < 0 || field.OverflowUint(uint64(v.Int()))
+	case v.CanUint() && field.CanInt():
+		overflow = v.Uint() > math.MaxInt64 || field.OverflowInt(int64(v.Uint()))
+	case v.CanUint() && field.CanUint():
+		overflow = field.OverflowUint(v.Uint())
+	}
+	if overflow {
+		return fmt.Errorf("%v is out of range for %s", v, field.Type())
+	}
+	return setField(field, v.Interface())
+}
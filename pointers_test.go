@@ -0,0 +1,90 @@
+package null
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type pointerModel struct {
+	ID       uint64
+	Count    *uint64
+	Name     *string
+	Age      *int
+	Created  *time.Time
+	Big      *big.Int
+	Nickname *string `null:"Alias"`
+	Tags     []string
+	Note     *string
+	Ignored  string
+}
+
+type nullModel struct {
+	ID      uint64
+	Count   Uint
+	Name    String
+	Age     Int8
+	Created Time
+	Big     BigInt
+	Alias   String
+	Tags    Slice[string]
+	Note    Optional[string]
+	Other   String
+}
+
+func TestFromPointers(t *testing.T) {
+	count, name, age := uint64(42), "hello", 30
+	created := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	src := pointerModel{ID: 1, Count: &count, Name: &name, Age: &age, Created: &created, Big: big.NewInt(12345), Tags: []string{"a"}}
+	dst := nullModel{Other: StringFrom("unchanged")}
+	if err := FromPointers(src, &dst); err != nil {
+		t.Fatal(err)
+	}
+	want := nullModel{
+		ID:      1,
+		Count:   UintFrom(42),
+		Name:    StringFrom("hello"),
+		Age:     Int8From(30),
+		Created: TimeFrom(created),
+		Big:     BigIntFrom(big.NewInt(12345)),
+		Tags:    SliceFrom([]string{"a"}),
+		Note:    OptionalNull[string](),
+		Other:   StringFrom("unchanged"),
+	}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("bad FromPointers:\n%#v\nwant\n%#v", dst, want)
+	}
+
+	var back pointerModel
+	if err := ToPointers(dst, &back); err != nil {
+		t.Fatal(err)
+	}
+	src.Nickname = nil
+	if !reflect.DeepEqual(back, src) {
+		t.Errorf("bad ToPointers:\n%#v\nwant\n%#v", back, src)
+	}
+
+	dst.Age = Int8{}
+	back.Age = &age
+	if err := ToPointers(dst, &back); err != nil || back.Age != nil {
+		t.Errorf("null should set a nil pointer: %v, %v", back.Age, err)
+	}
+}
+
+func TestFromPointersErrors(t *testing.T) {
+	tooBig := 1000
+	if err := FromPointers(pointerModel{Age: &tooBig}, &nullModel{}); err == nil {
+		t.Error("expected error for out of range value")
+	}
+	var dst struct{ Name Int }
+	if err := FromPointers(pointerModel{Name: new(string)}, &dst); err == nil {
+		t.Error("expected error for string to Int")
+	}
+	if err := FromPointers(pointerModel{}, nullModel{}); err == nil {
+		t.Error("expected error for non-pointer dst")
+	}
+	if err := ToPointers(1, &pointerModel{}); err == nil {
+		t.Error("expected error for non-struct src")
+	}
+}
@@ -0,0 +1,227 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullPrefix represents a netip.Prefix that may be null.
+// NullPrefix implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullPrefix struct {
+	Prefix netip.Prefix
+	Valid  bool // Valid is true if Prefix is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports the textual form of a prefix, as used by Postgres CIDR and INET columns.
+// Addresses without a prefix length are considered to be single host prefixes.
+func (n *NullPrefix) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		n.Prefix, n.Valid = netip.Prefix{}, false
+		return nil
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Prefix: %v", value, value)
+	}
+	if !strings.Contains(str, "/") {
+		addr, err := netip.ParseAddr(str)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan Prefix: %w", err)
+		}
+		n.Prefix, n.Valid = netip.PrefixFrom(addr, addr.BitLen()), true
+		return nil
+	}
+	prefix, err := netip.ParsePrefix(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't scan Prefix: %w", err)
+	}
+	n.Prefix, n.Valid = prefix, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent in their textual form.
+func (n NullPrefix) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Prefix.String(), nil
+}
+
+// IsZero returns true if this NullPrefix is null.
+func (n NullPrefix) IsZero() bool {
+	return !n.Valid
+}
+
+// Prefix is a nullable netip.Prefix, for network (CIDR) values.
+// It will decode to null, not the zero Prefix, if null.
+type Prefix struct {
+	NullPrefix
+}
+
+// NewPrefix creates a new Prefix.
+func NewPrefix(p netip.Prefix, valid bool) Prefix {
+	return Prefix{
+		NullPrefix: NullPrefix{
+			Prefix: p,
+			Valid:  valid,
+		},
+	}
+}
+
+// PrefixFrom creates a new Prefix that will always be valid.
+func PrefixFrom(p netip.Prefix) Prefix {
+	return NewPrefix(p, true)
+}
+
+// PrefixFromPtr creates a new Prefix that will be null if p is nil.
+func PrefixFromPtr(p *netip.Prefix) Prefix {
+	if p == nil {
+		return NewPrefix(netip.Prefix{}, false)
+	}
+	return NewPrefix(*p, true)
+}
+
+// ParsePrefix parses s into a new Prefix that will always be valid.
+// It will return an error if s is not a prefix in CIDR notation, like "192.0.2.0/24".
+func ParsePrefix(s string) (Prefix, error) {
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return Prefix{}, fmt.Errorf("null: couldn't parse Prefix: %w", err)
+	}
+	return PrefixFrom(p), nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero netip.Prefix.
+func (p Prefix) ValueOrZero() netip.Prefix {
+	if !p.Valid {
+		return netip.Prefix{}
+	}
+	return p.Prefix
+}
+
+// Contains reports whether this Prefix includes addr.
+// It returns false if either this Prefix or addr is null.
+func (p Prefix) Contains(addr Addr) bool {
+	return p.Valid && addr.Valid && p.Prefix.Contains(addr.Addr)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+// It will return an error if the input is not a prefix in CIDR notation.
+func (p *Prefix) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(p, &err)
+	if bytes.Equal(data, nullBytes) {
+		p.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := netip.ParsePrefix(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	p.Prefix = v
+	p.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Prefix is null.
+func (p Prefix) MarshalJSON() ([]byte, error) {
+	if !p.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(p.Prefix.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Prefix if the input is blank, "null", or DefaultNullText.
+// It will return an error if the input is not a prefix in CIDR notation, blank, or "null".
+func (p *Prefix) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(p, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		p.Valid = false
+		return nil
+	}
+	v, err := netip.ParsePrefix(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	p.Prefix = v
+	p.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Prefix is null.
+func (p Prefix) MarshalText() ([]byte, error) {
+	if !p.Valid {
+		return nullText(), nil
+	}
+	return []byte(p.Prefix.String()), nil
+}
+
+// SetValid changes this Prefix's value and also sets it to be non-null.
+func (p *Prefix) SetValid(v netip.Prefix) {
+	p.Prefix = v
+	p.Valid = true
+}
+
+// SetNull sets this Prefix to null, leaving its value as is. Use Reset to clear the value too.
+func (p *Prefix) SetNull() {
+	p.Valid = false
+}
+
+// Reset sets this Prefix to null and clears its value.
+func (p *Prefix) Reset() {
+	*p = Prefix{}
+}
+
+// Ptr returns a pointer to this Prefix's value, or a nil pointer if this Prefix is null.
+func (p Prefix) Ptr() *netip.Prefix {
+	if !p.Valid {
+		return nil
+	}
+	return &p.Prefix
+}
+
+// SetPtr changes this Prefix's value to the value v points to, or sets it to be null if v is nil, like PrefixFromPtr.
+func (p *Prefix) SetPtr(v *netip.Prefix) {
+	*p = PrefixFromPtr(v)
+}
+
+// IsZero returns true for invalid Prefixes.
+// A non-null Prefix with a zero value will not be considered zero.
+func (p Prefix) IsZero() bool {
+	return !p.Valid
+}
+
+// IsZeroOrNull returns true for invalid Prefixs, or valid Prefixs with the zero netip.Prefix.
+func (p Prefix) IsZeroOrNull() bool {
+	return !p.Valid || !p.Prefix.IsValid()
+}
+
+// Equal returns true if both Prefixes have the same address and length or are both null.
+// Prefixes are not masked before comparison, so 192.0.2.1/24 and 192.0.2.0/24 are not equal.
+func (p Prefix) Equal(other Prefix) bool {
+	return p.Valid == other.Valid && (!p.Valid || p.Prefix == other.Prefix)
+}
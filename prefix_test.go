@@ -0,0 +1,266 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+var (
+	prefixString = "192.0.2.0/24"
+	prefixJSON   = []byte(`"` + prefixString + `"`)
+	prefixValue  = netip.MustParsePrefix(prefixString)
+)
+
+func TestPrefixFrom(t *testing.T) {
+	p := PrefixFrom(prefixValue)
+	assertPrefix(t, p, "PrefixFrom()")
+}
+
+func TestPrefixFromPtr(t *testing.T) {
+	v := prefixValue
+	p := PrefixFromPtr(&v)
+	assertPrefix(t, p, "PrefixFromPtr()")
+
+	null := PrefixFromPtr(nil)
+	assertNullPrefix(t, null, "PrefixFromPtr(nil)")
+}
+
+func TestParsePrefix(t *testing.T) {
+	p, err := ParsePrefix(prefixString)
+	maybePanic(err)
+	assertPrefix(t, p, "ParsePrefix()")
+
+	_, err = ParsePrefix(addrString)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestPrefixContains(t *testing.T) {
+	p := PrefixFrom(prefixValue)
+	if !p.Contains(AddrFrom(addrValue)) {
+		t.Error("Contains() should be true")
+	}
+	if p.Contains(AddrFrom(addr6Value)) {
+		t.Error("Contains() should be false for an address outside the prefix")
+	}
+	if p.Contains(NewAddr(addrValue, false)) {
+		t.Error("Contains() should be false for a null Addr")
+	}
+
+	null := NewPrefix(prefixValue, false)
+	if null.Contains(AddrFrom(addrValue)) {
+		t.Error("Contains() should be false for a null Prefix")
+	}
+}
+
+func TestUnmarshalPrefix(t *testing.T) {
+	var p Prefix
+	err := json.Unmarshal(prefixJSON, &p)
+	maybePanic(err)
+	assertPrefix(t, p, "prefix json")
+
+	var null Prefix
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullPrefix(t, null, "null json")
+
+	var bad Prefix
+	err = json.Unmarshal(addrJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullPrefix(t, bad, "bad prefix json")
+
+	var badType Prefix
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullPrefix(t, badType, "wrong type json")
+
+	var invalid Prefix
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullPrefix(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalPrefix(t *testing.T) {
+	var p Prefix
+	err := p.UnmarshalText([]byte(prefixString))
+	maybePanic(err)
+	assertPrefix(t, p, "UnmarshalText() prefix")
+
+	var blank Prefix
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullPrefix(t, blank, "UnmarshalText() empty prefix")
+
+	var null Prefix
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullPrefix(t, null, `UnmarshalText() "null"`)
+
+	var invalid Prefix
+	err = invalid.UnmarshalText([]byte("192.0.2.0/33"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalPrefix(t *testing.T) {
+	p := PrefixFrom(prefixValue)
+	data, err := json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(prefixJSON), "non-empty json marshal")
+
+	null := NewPrefix(netip.Prefix{}, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalPrefixText(t *testing.T) {
+	p := PrefixFrom(prefixValue)
+	data, err := p.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, prefixString, "non-empty text marshal")
+
+	null := NewPrefix(netip.Prefix{}, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestPrefixPointer(t *testing.T) {
+	p := PrefixFrom(prefixValue)
+	ptr := p.Ptr()
+	if *ptr != prefixValue {
+		t.Errorf("bad %s prefix: %v ≠ %v\n", "pointer", ptr, prefixValue)
+	}
+
+	null := NewPrefix(netip.Prefix{}, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s prefix: %v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestPrefixIsZero(t *testing.T) {
+	p := PrefixFrom(prefixValue)
+	if p.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewPrefix(netip.Prefix{}, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestPrefixSetValid(t *testing.T) {
+	change := NewPrefix(netip.Prefix{}, false)
+	assertNullPrefix(t, change, "SetValid()")
+	change.SetValid(prefixValue)
+	assertPrefix(t, change, "SetValid()")
+}
+
+func TestPrefixScan(t *testing.T) {
+	var p Prefix
+	err := p.Scan(prefixString)
+	maybePanic(err)
+	assertPrefix(t, p, "scanned string")
+
+	var b Prefix
+	err = b.Scan([]byte(prefixString))
+	maybePanic(err)
+	assertPrefix(t, b, "scanned bytes")
+
+	var host Prefix
+	err = host.Scan(addrString)
+	maybePanic(err)
+	if !host.Valid || host.Prefix != netip.MustParsePrefix(addrString+"/32") {
+		t.Errorf("bad scanned host: %v", host.Prefix)
+	}
+
+	var null Prefix
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullPrefix(t, null, "scanned null")
+
+	var invalid Prefix
+	err = invalid.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var wrong Prefix
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestPrefixValue(t *testing.T) {
+	p := PrefixFrom(prefixValue)
+	v, err := p.Value()
+	maybePanic(err)
+	if v != prefixString {
+		t.Errorf("bad Value(): %#v ≠ %s", v, prefixString)
+	}
+
+	null := NewPrefix(prefixValue, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestPrefixEqual(t *testing.T) {
+	p1 := NewPrefix(prefixValue, false)
+	p2 := NewPrefix(netip.Prefix{}, false)
+	if !p1.Equal(p2) {
+		t.Error("null Prefixes should be equal")
+	}
+
+	p1 = PrefixFrom(prefixValue)
+	p2 = PrefixFrom(netip.MustParsePrefix(prefixString))
+	if !p1.Equal(p2) {
+		t.Error("same Prefixes should be equal")
+	}
+
+	p1 = PrefixFrom(prefixValue)
+	p2 = NewPrefix(prefixValue, false)
+	if p1.Equal(p2) {
+		t.Error("valid and null Prefixes should not be equal")
+	}
+
+	p1 = PrefixFrom(prefixValue)
+	p2 = PrefixFrom(netip.MustParsePrefix("192.0.2.0/25"))
+	if p1.Equal(p2) {
+		t.Error("different Prefixes should not be equal")
+	}
+}
+
+func assertPrefix(t *testing.T, p Prefix, from string) {
+	t.Helper()
+	if p.Prefix != prefixValue {
+		t.Errorf("bad %s prefix: %v ≠ %v\n", from, p.Prefix, prefixValue)
+	}
+	if !p.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullPrefix(t *testing.T, p Prefix, from string) {
+	t.Helper()
+	if p.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,287 @@
+package null
+
+import "math/rand"
+
+// The Random functions return random values for test fixtures and load generators.
+// They are null with a probability of nullProbability, from 0 to 1, and are otherwise valid values
+// like those returned by the Generate methods, such as assigned country codes and times from 1970 to 2100.
+
+// Random returns a random valid value of T, or null with a probability of nullProbability.
+// Null values are the zero value of T, so Random[Optional[T]] returns unset values; use RandomOptional for explicit nulls.
+//
+//	users := make([]User, 100)
+//	for i := range users {
+//		users[i].Age = null.Random[null.Uint8](r, 0.1)
+//	}
+func Random[T randomizer[T]](r *rand.Rand, nullProbability float64) T {
+	var v T
+	if r.Float64() >= nullProbability {
+		v = v.randomValid(r, generateSize)
+	}
+	return v
+}
+
+// RandomAddr returns a random valid Addr, or null with a probability of nullProbability.
+func RandomAddr(r *rand.Rand, nullProbability float64) Addr {
+	return Random[Addr](r, nullProbability)
+}
+
+// RandomAny returns a random valid Any, or null with a probability of nullProbability.
+func RandomAny(r *rand.Rand, nullProbability float64) Any {
+	return Random[Any](r, nullProbability)
+}
+
+// RandomArray returns a random valid Array, or null with a probability of nullProbability.
+// It is also null if quick.Value can't generate its values.
+func RandomArray[T any](r *rand.Rand, nullProbability float64) Array[T] {
+	return Random[Array[T]](r, nullProbability)
+}
+
+// RandomBase64 returns a random valid Base64, or null with a probability of nullProbability.
+func RandomBase64(r *rand.Rand, nullProbability float64) Base64 {
+	return Random[Base64](r, nullProbability)
+}
+
+// RandomBigInt returns a random valid BigInt, or null with a probability of nullProbability.
+func RandomBigInt(r *rand.Rand, nullProbability float64) BigInt {
+	return Random[BigInt](r, nullProbability)
+}
+
+// RandomBool returns a random valid Bool, or null with a probability of nullProbability.
+func RandomBool(r *rand.Rand, nullProbability float64) Bool {
+	return Random[Bool](r, nullProbability)
+}
+
+// RandomBytes returns a random valid Bytes, or null with a probability of nullProbability.
+func RandomBytes(r *rand.Rand, nullProbability float64) Bytes {
+	return Random[Bytes](r, nullProbability)
+}
+
+// RandomByteSize returns a random valid ByteSize, or null with a probability of nullProbability.
+func RandomByteSize(r *rand.Rand, nullProbability float64) ByteSize {
+	return Random[ByteSize](r, nullProbability)
+}
+
+// RandomComplex returns a random valid Complex, or null with a probability of nullProbability.
+func RandomComplex(r *rand.Rand, nullProbability float64) Complex {
+	return Random[Complex](r, nullProbability)
+}
+
+// RandomCountryCode returns a random valid CountryCode, or null with a probability of nullProbability.
+func RandomCountryCode(r *rand.Rand, nullProbability float64) CountryCode {
+	return Random[CountryCode](r, nullProbability)
+}
+
+// RandomCurrencyCode returns a random valid CurrencyCode, or null with a probability of nullProbability.
+func RandomCurrencyCode(r *rand.Rand, nullProbability float64) CurrencyCode {
+	return Random[CurrencyCode](r, nullProbability)
+}
+
+// RandomDate returns a random valid Date, or null with a probability of nullProbability.
+func RandomDate(r *rand.Rand, nullProbability float64) Date {
+	return Random[Date](r, nullProbability)
+}
+
+// RandomDuration returns a random valid Duration, or null with a probability of nullProbability.
+func RandomDuration(r *rand.Rand, nullProbability float64) Duration {
+	return Random[Duration](r, nullProbability)
+}
+
+// RandomEmail returns a random valid Email, or null with a probability of nullProbability.
+func RandomEmail(r *rand.Rand, nullProbability float64) Email {
+	return Random[Email](r, nullProbability)
+}
+
+// RandomEnum returns a random valid Enum, or null with a probability of nullProbability.
+// It is always null if no values are registered for T.
+func RandomEnum[T ~string | ~int](r *rand.Rand, nullProbability float64) Enum[T] {
+	return Random[Enum[T]](r, nullProbability)
+}
+
+// RandomFileMode returns a random valid FileMode, or null with a probability of nullProbability.
+func RandomFileMode(r *rand.Rand, nullProbability float64) FileMode {
+	return Random[FileMode](r, nullProbability)
+}
+
+// RandomFixedHex returns a random valid FixedHex, or null with a probability of nullProbability.
+// It is also null if quick.Value can't generate its values.
+func RandomFixedHex[A any](r *rand.Rand, nullProbability float64) FixedHex[A] {
+	return Random[FixedHex[A]](r, nullProbability)
+}
+
+// RandomFlags returns a random valid Flags, or null with a probability of nullProbability.
+func RandomFlags[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](r *rand.Rand, nullProbability float64) Flags[T] {
+	return Random[Flags[T]](r, nullProbability)
+}
+
+// RandomFloat returns a random valid Float, or null with a probability of nullProbability.
+func RandomFloat(r *rand.Rand, nullProbability float64) Float {
+	return Random[Float](r, nullProbability)
+}
+
+// RandomHardwareAddr returns a random valid HardwareAddr, or null with a probability of nullProbability.
+func RandomHardwareAddr(r *rand.Rand, nullProbability float64) HardwareAddr {
+	return Random[HardwareAddr](r, nullProbability)
+}
+
+// RandomHex returns a random valid Hex, or null with a probability of nullProbability.
+func RandomHex(r *rand.Rand, nullProbability float64) Hex {
+	return Random[Hex](r, nullProbability)
+}
+
+// RandomInt returns a random valid Int, or null with a probability of nullProbability.
+func RandomInt(r *rand.Rand, nullProbability float64) Int {
+	return Random[Int](r, nullProbability)
+}
+
+// RandomInt16 returns a random valid Int16, or null with a probability of nullProbability.
+func RandomInt16(r *rand.Rand, nullProbability float64) Int16 {
+	return Random[Int16](r, nullProbability)
+}
+
+// RandomInt32 returns a random valid Int32, or null with a probability of nullProbability.
+func RandomInt32(r *rand.Rand, nullProbability float64) Int32 {
+	return Random[Int32](r, nullProbability)
+}
+
+// RandomInt64Range returns a random valid Int64Range, or null with a probability of nullProbability.
+func RandomInt64Range(r *rand.Rand, nullProbability float64) Int64Range {
+	return Random[Int64Range](r, nullProbability)
+}
+
+// RandomInt8 returns a random valid Int8, or null with a probability of nullProbability.
+func RandomInt8(r *rand.Rand, nullProbability float64) Int8 {
+	return Random[Int8](r, nullProbability)
+}
+
+// RandomJSON returns a random valid JSON, or null with a probability of nullProbability.
+func RandomJSON(r *rand.Rand, nullProbability float64) JSON {
+	return Random[JSON](r, nullProbability)
+}
+
+// RandomKSUID returns a random valid KSUID, or null with a probability of nullProbability.
+func RandomKSUID(r *rand.Rand, nullProbability float64) KSUID {
+	return Random[KSUID](r, nullProbability)
+}
+
+// RandomLanguageTag returns a random valid LanguageTag, or null with a probability of nullProbability.
+func RandomLanguageTag(r *rand.Rand, nullProbability float64) LanguageTag {
+	return Random[LanguageTag](r, nullProbability)
+}
+
+// RandomMap returns a random valid Map, or null with a probability of nullProbability.
+// It is also null if quick.Value can't generate its values.
+func RandomMap[K comparable, V any](r *rand.Rand, nullProbability float64) Map[K, V] {
+	return Random[Map[K, V]](r, nullProbability)
+}
+
+// RandomMoney returns a random valid Money, or null with a probability of nullProbability.
+func RandomMoney(r *rand.Rand, nullProbability float64) Money {
+	return Random[Money](r, nullProbability)
+}
+
+// RandomOptional returns a random valid Optional, or an explicitly null one with a probability of nullProbability.
+// It is also null if quick.Value can't generate T. It never returns an unset Optional.
+func RandomOptional[T any](r *rand.Rand, nullProbability float64) Optional[T] {
+	if r.Float64() < nullProbability {
+		return OptionalNull[T]()
+	}
+	return Optional[T]{}.randomValid(r, generateSize)
+}
+
+// RandomPercent returns a random valid Percent, or null with a probability of nullProbability.
+func RandomPercent(r *rand.Rand, nullProbability float64) Percent {
+	return Random[Percent](r, nullProbability)
+}
+
+// RandomPoint returns a random valid Point, or null with a probability of nullProbability.
+func RandomPoint(r *rand.Rand, nullProbability float64) Point {
+	return Random[Point](r, nullProbability)
+}
+
+// RandomPrefix returns a random valid Prefix, or null with a probability of nullProbability.
+func RandomPrefix(r *rand.Rand, nullProbability float64) Prefix {
+	return Random[Prefix](r, nullProbability)
+}
+
+// RandomRegexp returns a random valid Regexp, or null with a probability of nullProbability.
+func RandomRegexp(r *rand.Rand, nullProbability float64) Regexp {
+	return Random[Regexp](r, nullProbability)
+}
+
+// RandomSemver returns a random valid Semver, or null with a probability of nullProbability.
+func RandomSemver(r *rand.Rand, nullProbability float64) Semver {
+	return Random[Semver](r, nullProbability)
+}
+
+// RandomSlice returns a random valid Slice, or null with a probability of nullProbability.
+// It is also null if quick.Value can't generate its values.
+func RandomSlice[T any](r *rand.Rand, nullProbability float64) Slice[T] {
+	return Random[Slice[T]](r, nullProbability)
+}
+
+// RandomString returns a random valid String, or null with a probability of nullProbability.
+func RandomString(r *rand.Rand, nullProbability float64) String {
+	return Random[String](r, nullProbability)
+}
+
+// RandomTime returns a random valid Time, or null with a probability of nullProbability.
+func RandomTime(r *rand.Rand, nullProbability float64) Time {
+	return Random[Time](r, nullProbability)
+}
+
+// RandomTimeOfDay returns a random valid TimeOfDay, or null with a probability of nullProbability.
+func RandomTimeOfDay(r *rand.Rand, nullProbability float64) TimeOfDay {
+	return Random[TimeOfDay](r, nullProbability)
+}
+
+// RandomTimeRange returns a random valid TimeRange, or null with a probability of nullProbability.
+func RandomTimeRange(r *rand.Rand, nullProbability float64) TimeRange {
+	return Random[TimeRange](r, nullProbability)
+}
+
+// RandomUint returns a random valid Uint, or null with a probability of nullProbability.
+func RandomUint(r *rand.Rand, nullProbability float64) Uint {
+	return Random[Uint](r, nullProbability)
+}
+
+// RandomUint16 returns a random valid Uint16, or null with a probability of nullProbability.
+func RandomUint16(r *rand.Rand, nullProbability float64) Uint16 {
+	return Random[Uint16](r, nullProbability)
+}
+
+// RandomUint32 returns a random valid Uint32, or null with a probability of nullProbability.
+func RandomUint32(r *rand.Rand, nullProbability float64) Uint32 {
+	return Random[Uint32](r, nullProbability)
+}
+
+// RandomUint8 returns a random valid Uint8, or null with a probability of nullProbability.
+func RandomUint8(r *rand.Rand, nullProbability float64) Uint8 {
+	return Random[Uint8](r, nullProbability)
+}
+
+// RandomULID returns a random valid ULID, or null with a probability of nullProbability.
+func RandomULID(r *rand.Rand, nullProbability float64) ULID {
+	return Random[ULID](r, nullProbability)
+}
+
+// RandomUnixMillis returns a random valid UnixMillis, or null with a probability of nullProbability.
+func RandomUnixMillis(r *rand.Rand, nullProbability float64) UnixMillis {
+	return Random[UnixMillis](r, nullProbability)
+}
+
+// RandomUnixSeconds returns a random valid UnixSeconds, or null with a probability of nullProbability.
+func RandomUnixSeconds(r *rand.Rand, nullProbability float64) UnixSeconds {
+	return Random[UnixSeconds](r, nullProbability)
+}
+
+// RandomURL returns a random valid URL, or null with a probability of nullProbability.
+func RandomURL(r *rand.Rand, nullProbability float64) URL {
+	return Random[URL](r, nullProbability)
+}
+
+// RandomValue returns a random valid Value, or null with a probability of nullProbability.
+// It is also null if quick.Value can't generate its values.
+func RandomValue[T any](r *rand.Rand, nullProbability float64) Value[T] {
+	return Random[Value[T]](r, nullProbability)
+}
@@ -0,0 +1,36 @@
+package null
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		if u := RandomUint(r, 0); !u.Valid {
+			t.Error("nullProbability 0 should always be valid")
+		}
+		if s := RandomSlice[string](r, 1); s.Valid {
+			t.Errorf("nullProbability 1 should always be null: %v", s)
+		}
+		if o := RandomOptional[int](r, 1); !o.Present || o.Valid {
+			t.Errorf("RandomOptional should be explicitly null: %#v", o)
+		}
+		if c := RandomCountryCode(r, 0); !c.Valid {
+			t.Errorf("bad country code: %v", c)
+		} else if _, err := ParseCountryCode(c.Code); err != nil {
+			t.Error(err)
+		}
+	}
+
+	var null int
+	for i := 0; i < 1000; i++ {
+		if !Random[Time](r, 0.3).Valid {
+			null++
+		}
+	}
+	if null < 200 || null > 400 {
+		t.Errorf("expected about 300 null values, got %d", null)
+	}
+}
@@ -0,0 +1,668 @@
+package null
+
+import (
+	"bytes"
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// Int64Range is a nullable range of int64s, such as a Postgres int8range.
+// A null bound is unbounded (infinite).
+// Like Postgres, ranges are canonicalized to an inclusive lower bound and an exclusive upper bound,
+// so "(1,5]" becomes "[2,6)", and ranges with no values become empty.
+// It encodes as a range literal such as "[1,5)" in JSON and text.
+// It will decode to null, not an empty range, if null.
+type Int64Range struct {
+	Lower    Int  // Lower is the lower bound, or null if unbounded
+	Upper    Int  // Upper is the upper bound, or null if unbounded
+	LowerInc bool // LowerInc is true if Lower is included in the range
+	UpperInc bool // UpperInc is true if Upper is included in the range
+	Empty    bool // Empty is true if the range has no values, in which case the bounds are unused
+	Valid    bool // Valid is true if the range is not NULL
+}
+
+// Int64RangeFrom creates a new Int64Range of lower up to but not including upper, that will always be valid.
+// It will be empty if upper <= lower.
+func Int64RangeFrom(lower, upper int64) Int64Range {
+	return NewInt64Range(IntFrom(lower), IntFrom(upper), true, false)
+}
+
+// NewInt64Range creates a new valid, canonicalized Int64Range. Null bounds are unbounded.
+func NewInt64Range(lower, upper Int, lowerInc, upperInc bool) Int64Range {
+	r := Int64Range{Lower: lower, Upper: upper, LowerInc: lowerInc, UpperInc: upperInc, Valid: true}
+	r.canonicalize()
+	return r
+}
+
+// EmptyInt64Range creates a new valid Int64Range with no values.
+func EmptyInt64Range() Int64Range {
+	return Int64Range{Empty: true, Valid: true}
+}
+
+// ParseInt64Range creates a new Int64Range from a range literal such as "[1,5)", "(,10]", or "empty".
+func ParseInt64Range(s string) (Int64Range, error) {
+	r, err := parseInt64Range(s)
+	if err != nil {
+		return Int64Range{}, fmt.Errorf("null: couldn't parse Int64Range: %w", err)
+	}
+	return r, nil
+}
+
+func (r *Int64Range) canonicalize() {
+	if r.Empty {
+		*r = EmptyInt64Range()
+		return
+	}
+	if !r.Lower.Valid {
+		r.LowerInc = false
+	} else if !r.LowerInc {
+		if r.Lower.Int64 == math.MaxInt64 {
+			*r = EmptyInt64Range()
+			return
+		}
+		r.Lower.Int64++
+		r.LowerInc = true
+	}
+	if !r.Upper.Valid {
+		r.UpperInc = false
+	} else if r.UpperInc {
+		if r.Upper.Int64 != math.MaxInt64 {
+			r.Upper.Int64++
+			r.UpperInc = false
+		}
+	}
+	if r.Lower.Valid && r.Upper.Valid && (r.Upper.Int64 < r.Lower.Int64 || (r.Upper.Int64 == r.Lower.Int64 && !r.UpperInc)) {
+		*r = EmptyInt64Range()
+	}
+}
+
+func (r Int64Range) bounds() rangeBounds[int64] {
+	return rangeBounds[int64]{
+		lower: r.Lower.Int64, upper: r.Upper.Int64,
+		hasLower: r.Lower.Valid, hasUpper: r.Upper.Valid,
+		lowerInc: r.LowerInc, upperInc: r.UpperInc,
+		empty: !r.Valid || r.Empty,
+	}
+}
+
+// Contains returns true if v is in this range.
+// Null and empty ranges contain nothing.
+func (r Int64Range) Contains(v int64) bool {
+	return r.bounds().contains(v, cmp.Compare[int64])
+}
+
+// Overlaps returns true if both ranges have a value in common.
+// Null and empty ranges overlap nothing.
+func (r Int64Range) Overlaps(other Int64Range) bool {
+	return r.bounds().overlaps(other.bounds(), cmp.Compare[int64])
+}
+
+// Scan implements the Scanner interface.
+// It supports range literals such as "[1,5)", as returned by Postgres.
+func (r *Int64Range) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		*r = Int64Range{}
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Int64Range: %v", value, value)
+	}
+	v, err := parseInt64Range(str)
+	if err != nil {
+		r.Valid = false
+		return fmt.Errorf("null: couldn't scan Int64Range: %w", err)
+	}
+	*r = v
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as range literals such as "[1,5)".
+func (r Int64Range) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// String returns the range literal of this range, such as "[1,5)" or "empty",
+// or an empty string if it is null.
+func (r Int64Range) String() string {
+	if !r.Valid {
+		return ""
+	}
+	return r.bounds().literal(func(v int64) string {
+		return strconv.FormatInt(v, 10)
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports range literal strings and null input.
+func (r *Int64Range) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		*r = Int64Range{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := parseInt64Range(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	*r = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this range is null, otherwise a range literal such as "[1,5)".
+func (r Int64Range) MarshalJSON() ([]byte, error) {
+	if !r.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(r.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int64Range if the input is blank or DefaultNullText.
+func (r *Int64Range) UnmarshalText(text []byte) error {
+	if isNullText(text) {
+		*r = Int64Range{}
+		return nil
+	}
+	v, err := parseInt64Range(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	*r = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this range is null, otherwise a range literal.
+func (r Int64Range) MarshalText() ([]byte, error) {
+	if !r.Valid {
+		return nullText(), nil
+	}
+	return []byte(r.String()), nil
+}
+
+// SetNull sets this Int64Range to null, leaving its value as is. Use Reset to clear the value too.
+func (r *Int64Range) SetNull() {
+	r.Valid = false
+}
+
+// Reset sets this Int64Range to null and clears its value.
+func (r *Int64Range) Reset() {
+	*r = Int64Range{}
+}
+
+// IsZero returns true for invalid Int64Ranges.
+// A non-null empty range will not be considered zero.
+func (r Int64Range) IsZero() bool {
+	return !r.Valid
+}
+
+// IsZeroOrNull returns true for invalid Int64Ranges, or valid Int64Ranges with an empty range.
+func (r Int64Range) IsZeroOrNull() bool {
+	return !r.Valid || r.Empty
+}
+
+// Equal returns true if both ranges have the same bounds, are both empty, or are both null.
+func (r Int64Range) Equal(other Int64Range) bool {
+	return r.Valid == other.Valid && (!r.Valid || r.String() == other.String())
+}
+
+func parseInt64Range(str string) (Int64Range, error) {
+	lit, err := parseRangeLiteral(str)
+	if err != nil {
+		return Int64Range{}, err
+	}
+	if lit.empty {
+		return EmptyInt64Range(), nil
+	}
+	var lower, upper Int
+	if lit.hasLower {
+		n, err := strconv.ParseInt(strings.TrimSpace(lit.lower), 10, 64)
+		if err != nil {
+			return Int64Range{}, err
+		}
+		lower = IntFrom(n)
+	}
+	if lit.hasUpper {
+		n, err := strconv.ParseInt(strings.TrimSpace(lit.upper), 10, 64)
+		if err != nil {
+			return Int64Range{}, err
+		}
+		upper = IntFrom(n)
+	}
+	return NewInt64Range(lower, upper, lit.lowerInc, lit.upperInc), nil
+}
+
+// TimeRange is a nullable range of times, such as a Postgres tstzrange.
+// A null bound is unbounded (infinite).
+// It encodes as a range literal such as `["2024-01-01T00:00:00Z","2025-01-01T00:00:00Z")` in JSON and text.
+// It will decode to null, not an empty range, if null.
+type TimeRange struct {
+	Lower    Time // Lower is the lower bound, or null if unbounded
+	Upper    Time // Upper is the upper bound, or null if unbounded
+	LowerInc bool // LowerInc is true if Lower is included in the range
+	UpperInc bool // UpperInc is true if Upper is included in the range
+	Empty    bool // Empty is true if the range has no values, in which case the bounds are unused
+	Valid    bool // Valid is true if the range is not NULL
+}
+
+// TimeRangeFrom creates a new TimeRange from lower up to but not including upper, that will always be valid.
+// It will be empty if upper is not after lower.
+func TimeRangeFrom(lower, upper time.Time) TimeRange {
+	return NewTimeRange(TimeFrom(lower), TimeFrom(upper), true, false)
+}
+
+// NewTimeRange creates a new valid TimeRange. Null bounds are unbounded.
+// It will be empty if it contains no times.
+func NewTimeRange(lower, upper Time, lowerInc, upperInc bool) TimeRange {
+	r := TimeRange{Lower: lower, Upper: upper, LowerInc: lowerInc && lower.Valid, UpperInc: upperInc && upper.Valid, Valid: true}
+	if lower.Valid && upper.Valid {
+		if c := lower.Time.Compare(upper.Time); c > 0 || (c == 0 && !(r.LowerInc && r.UpperInc)) {
+			return EmptyTimeRange()
+		}
+	}
+	return r
+}
+
+// EmptyTimeRange creates a new valid TimeRange with no values.
+func EmptyTimeRange() TimeRange {
+	return TimeRange{Empty: true, Valid: true}
+}
+
+// ParseTimeRange creates a new TimeRange from a range literal such as
+// `["2024-01-01 00:00:00+00","2025-01-01 00:00:00+00")` or "empty".
+// Bounds may be RFC 3339 or in Postgres's output format, and "infinity" and "-infinity" are unbounded.
+func ParseTimeRange(s string) (TimeRange, error) {
+	r, err := parseTimeRange(s)
+	if err != nil {
+		return TimeRange{}, fmt.Errorf("null: couldn't parse TimeRange: %w", err)
+	}
+	return r, nil
+}
+
+func (r TimeRange) bounds() rangeBounds[time.Time] {
+	return rangeBounds[time.Time]{
+		lower: r.Lower.Time, upper: r.Upper.Time,
+		hasLower: r.Lower.Valid, hasUpper: r.Upper.Valid,
+		lowerInc: r.LowerInc, upperInc: r.UpperInc,
+		empty: !r.Valid || r.Empty,
+	}
+}
+
+// Contains returns true if t is in this range.
+// Null and empty ranges contain nothing.
+func (r TimeRange) Contains(t time.Time) bool {
+	return r.bounds().contains(t, time.Time.Compare)
+}
+
+// Overlaps returns true if both ranges have a time in common.
+// Null and empty ranges overlap nothing.
+func (r TimeRange) Overlaps(other TimeRange) bool {
+	return r.bounds().overlaps(other.bounds(), time.Time.Compare)
+}
+
+// Scan implements the Scanner interface.
+// It supports range literals, as returned by Postgres.
+func (r *TimeRange) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		*r = TimeRange{}
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into TimeRange: %v", value, value)
+	}
+	v, err := parseTimeRange(str)
+	if err != nil {
+		r.Valid = false
+		return fmt.Errorf("null: couldn't scan TimeRange: %w", err)
+	}
+	*r = v
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as range literals with RFC 3339 bounds.
+func (r TimeRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// String returns the range literal of this range, with quoted RFC 3339 bounds, or "empty".
+// It returns an empty string if this range is null.
+func (r TimeRange) String() string {
+	if !r.Valid {
+		return ""
+	}
+	return r.bounds().literal(func(t time.Time) string {
+		return `"` + t.Format(time.RFC3339Nano) + `"`
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports range literal strings and null input.
+func (r *TimeRange) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		*r = TimeRange{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := parseTimeRange(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	*r = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this range is null, otherwise a range literal string.
+func (r TimeRange) MarshalJSON() ([]byte, error) {
+	if !r.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(r.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null TimeRange if the input is blank or DefaultNullText.
+func (r *TimeRange) UnmarshalText(text []byte) error {
+	if isNullText(text) {
+		*r = TimeRange{}
+		return nil
+	}
+	v, err := parseTimeRange(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	*r = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this range is null, otherwise a range literal.
+func (r TimeRange) MarshalText() ([]byte, error) {
+	if !r.Valid {
+		return nullText(), nil
+	}
+	return []byte(r.String()), nil
+}
+
+// SetNull sets this TimeRange to null, leaving its value as is. Use Reset to clear the value too.
+func (r *TimeRange) SetNull() {
+	r.Valid = false
+}
+
+// Reset sets this TimeRange to null and clears its value.
+func (r *TimeRange) Reset() {
+	*r = TimeRange{}
+}
+
+// IsZero returns true for invalid TimeRanges.
+// A non-null empty range will not be considered zero.
+func (r TimeRange) IsZero() bool {
+	return !r.Valid
+}
+
+// IsZeroOrNull returns true for invalid TimeRanges, or valid TimeRanges with an empty range.
+func (r TimeRange) IsZeroOrNull() bool {
+	return !r.Valid || r.Empty
+}
+
+// Equal returns true if both ranges have the same bounds, are both empty, or are both null.
+// Bounds are compared with time.Time's Equal method.
+func (r TimeRange) Equal(other TimeRange) bool {
+	if r.Valid != other.Valid || r.Empty != other.Empty {
+		return false
+	}
+	if !r.Valid || r.Empty {
+		return true
+	}
+	return r.Lower.Equal(other.Lower) && r.Upper.Equal(other.Upper) &&
+		r.LowerInc == other.LowerInc && r.UpperInc == other.UpperInc
+}
+
+// rangeTimeLayouts are the layouts accepted for TimeRange bounds, in addition to RFC 3339.
+var rangeTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07:00:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+func parseRangeTime(str string) (Time, error) {
+	str = strings.TrimSpace(str)
+	switch strings.ToLower(str) {
+	case "infinity", "-infinity":
+		return Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, str); err == nil {
+		return TimeFrom(t), nil
+	}
+	for _, layout := range rangeTimeLayouts {
+		if t, err := time.Parse(layout, str); err == nil {
+			return TimeFrom(t), nil
+		}
+	}
+	return Time{}, fmt.Errorf("invalid time range bound: %q", str)
+}
+
+func parseTimeRange(str string) (TimeRange, error) {
+	lit, err := parseRangeLiteral(str)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	if lit.empty {
+		return EmptyTimeRange(), nil
+	}
+	var lower, upper Time
+	if lit.hasLower {
+		if lower, err = parseRangeTime(lit.lower); err != nil {
+			return TimeRange{}, err
+		}
+	}
+	if lit.hasUpper {
+		if upper, err = parseRangeTime(lit.upper); err != nil {
+			return TimeRange{}, err
+		}
+	}
+	return NewTimeRange(lower, upper, lit.lowerInc, lit.upperInc), nil
+}
+
+// rangeBounds is a range of T, for sharing range operations between types.
+type rangeBounds[T any] struct {
+	lower, upper       T
+	hasLower, hasUpper bool
+	lowerInc, upperInc bool
+	empty              bool
+}
+
+func (r rangeBounds[T]) contains(v T, cmp func(a, b T) int) bool {
+	if r.empty {
+		return false
+	}
+	if r.hasLower {
+		if c := cmp(v, r.lower); c < 0 || (c == 0 && !r.lowerInc) {
+			return false
+		}
+	}
+	if r.hasUpper {
+		if c := cmp(v, r.upper); c > 0 || (c == 0 && !r.upperInc) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r rangeBounds[T]) overlaps(other rangeBounds[T], cmp func(a, b T) int) bool {
+	if r.empty || other.empty {
+		return false
+	}
+	// each range must start before the other ends
+	startsBefore := func(a, b rangeBounds[T]) bool {
+		if !a.hasLower || !b.hasUpper {
+			return true
+		}
+		c := cmp(a.lower, b.upper)
+		return c < 0 || (c == 0 && a.lowerInc && b.upperInc)
+	}
+	return startsBefore(r, other) && startsBefore(other, r)
+}
+
+func (r rangeBounds[T]) literal(format func(T) string) string {
+	if r.empty {
+		return "empty"
+	}
+	var sb strings.Builder
+	if r.lowerInc {
+		sb.WriteByte('[')
+	} else {
+		sb.WriteByte('(')
+	}
+	if r.hasLower {
+		sb.WriteString(format(r.lower))
+	}
+	sb.WriteByte(',')
+	if r.hasUpper {
+		sb.WriteString(format(r.upper))
+	}
+	if r.upperInc {
+		sb.WriteByte(']')
+	} else {
+		sb.WriteByte(')')
+	}
+	return sb.String()
+}
+
+// rangeLiteral is a parsed Postgres range literal, with its bounds unquoted.
+type rangeLiteral struct {
+	lower, upper       string
+	hasLower, hasUpper bool
+	lowerInc, upperInc bool
+	empty              bool
+}
+
+// parseRangeLiteral parses a Postgres range literal such as "[1,5)", `("a","b"]`, or "empty".
+// Empty bounds are unbounded. Bounds may be double-quoted, with quotes escaped by doubling or a backslash.
+func parseRangeLiteral(str string) (rangeLiteral, error) {
+	var lit rangeLiteral
+	s := strings.TrimSpace(str)
+	if strings.EqualFold(s, "empty") {
+		lit.empty = true
+		return lit, nil
+	}
+	if len(s) < 3 {
+		return lit, fmt.Errorf("invalid range: %q", str)
+	}
+	switch s[0] {
+	case '[':
+		lit.lowerInc = true
+	case '(':
+	default:
+		return lit, fmt.Errorf("invalid range: %q", str)
+	}
+	switch s[len(s)-1] {
+	case ']':
+		lit.upperInc = true
+	case ')':
+	default:
+		return lit, fmt.Errorf("invalid range: %q", str)
+	}
+	inner := s[1 : len(s)-1]
+
+	lower, rest, hasLower, err := parseRangeBound(inner)
+	if err != nil {
+		return lit, fmt.Errorf("invalid range: %q: %w", str, err)
+	}
+	if len(rest) == 0 || rest[0] != ',' {
+		return lit, fmt.Errorf("invalid range: %q", str)
+	}
+	upper, rest, hasUpper, err := parseRangeBound(rest[1:])
+	if err != nil {
+		return lit, fmt.Errorf("invalid range: %q: %w", str, err)
+	}
+	if rest != "" {
+		return lit, fmt.Errorf("invalid range: %q", str)
+	}
+	lit.lower, lit.hasLower = lower, hasLower
+	lit.upper, lit.hasUpper = upper, hasUpper
+	if !lit.hasLower {
+		lit.lowerInc = false
+	}
+	if !lit.hasUpper {
+		lit.upperInc = false
+	}
+	return lit, nil
+}
+
+// parseRangeBound parses a bound from the start of s, up to the next unquoted comma or the end.
+func parseRangeBound(s string) (bound, rest string, ok bool, err error) {
+	var sb strings.Builder
+	quoted := false
+	i := 0
+	for ; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			if i+1 == len(s) {
+				return "", "", false, errors.New("unterminated escape")
+			}
+			i++
+			sb.WriteByte(s[i])
+			ok = true
+		case c == '"':
+			if quoted && i+1 < len(s) && s[i+1] == '"' {
+				sb.WriteByte('"')
+				i++
+				continue
+			}
+			quoted = !quoted
+			ok = true
+		case c == ',' && !quoted:
+			return sb.String(), s[i:], ok, nil
+		default:
+			sb.WriteByte(c)
+			ok = true
+		}
+	}
+	if quoted {
+		return "", "", false, errors.New("unterminated quote")
+	}
+	return sb.String(), s[i:], ok, nil
+}
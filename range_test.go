@@ -0,0 +1,325 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseInt64Range(t *testing.T) {
+	tests := map[string]string{
+		"[1,5)":      "[1,5)",
+		"(1,5]":      "[2,6)",
+		"[1,5]":      "[1,6)",
+		" ( 0 , 1 )": "empty",
+		"[5,5)":      "empty",
+		"[5,5]":      "[5,6)",
+		"[6,5)":      "empty",
+		"(,10]":      "(,11)",
+		"[3,)":       "[3,)",
+		"(,)":        "(,)",
+		"[,]":        "(,)",
+		"EMPTY":      "empty",
+		`["1","5")`:  "[1,5)",
+	}
+	for in, want := range tests {
+		r, err := ParseInt64Range(in)
+		if err != nil {
+			t.Errorf("ParseInt64Range(%q): unexpected error: %v", in, err)
+			continue
+		}
+		if !r.Valid || r.String() != want {
+			t.Errorf("ParseInt64Range(%q) = %q, want %q", in, r.String(), want)
+		}
+	}
+
+	for _, bad := range []string{"", "1,5", "[1,5", "1,5)", "[1;5)", "[a,5)", "[1,5,6)", `["1,5)`, "[1,5)x"} {
+		if _, err := ParseInt64Range(bad); err == nil {
+			t.Errorf("ParseInt64Range(%q): expected error", bad)
+		}
+	}
+
+	max, err := ParseInt64Range("[1,9223372036854775807]")
+	maybePanic(err)
+	if !max.Contains(math.MaxInt64) {
+		t.Error("range with inclusive MaxInt64 upper bound should contain it")
+	}
+	if r := Int64RangeFrom(5, 1); !r.Valid || !r.Empty {
+		t.Errorf("Int64RangeFrom(5, 1) should be empty: %#v", r)
+	}
+}
+
+func TestInt64RangeContains(t *testing.T) {
+	r := Int64RangeFrom(1, 5)
+	for v, want := range map[int64]bool{0: false, 1: true, 4: true, 5: false} {
+		if got := r.Contains(v); got != want {
+			t.Errorf("%s Contains(%d) = %t, want %t", r, v, got, want)
+		}
+	}
+
+	unbounded := NewInt64Range(Int{}, IntFrom(0), false, false)
+	if !unbounded.Contains(math.MinInt64) || unbounded.Contains(0) {
+		t.Error("bad unbounded Contains()")
+	}
+	if (Int64Range{}).Contains(0) || EmptyInt64Range().Contains(0) {
+		t.Error("null and empty ranges should contain nothing")
+	}
+}
+
+func TestInt64RangeOverlaps(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"[1,5)", "[4,8)", true},
+		{"[1,5)", "[5,8)", false},
+		{"[1,5]", "[5,8)", true},
+		{"(1,2)", "[1,3)", false},
+		{"(,)", "[100,101)", true},
+		{"[1,5)", "empty", false},
+		{"(,3)", "[2,)", true},
+	}
+	for _, test := range tests {
+		a, b := mustInt64Range(test.a), mustInt64Range(test.b)
+		if got := a.Overlaps(b); got != test.want {
+			t.Errorf("%s Overlaps(%s) = %t, want %t", test.a, test.b, got, test.want)
+		}
+		if got := b.Overlaps(a); got != test.want {
+			t.Errorf("%s Overlaps(%s) = %t, want %t", test.b, test.a, got, test.want)
+		}
+	}
+	if (Int64Range{}).Overlaps(mustInt64Range("(,)")) {
+		t.Error("null range should overlap nothing")
+	}
+}
+
+func TestInt64RangeJSON(t *testing.T) {
+	var r Int64Range
+	err := json.Unmarshal([]byte(`"(1,5]"`), &r)
+	maybePanic(err)
+	if !r.Equal(Int64RangeFrom(2, 6)) {
+		t.Errorf("bad unmarshaled range: %s", r)
+	}
+
+	data, err := json.Marshal(r)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"[2,6)"`, "range json marshal")
+
+	var null Int64Range
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null range json marshal")
+
+	for _, bad := range [][]byte{[]byte(`"[1,"`), intJSON, invalidJSON} {
+		var invalid Int64Range
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+	}
+}
+
+func TestInt64RangeText(t *testing.T) {
+	var r Int64Range
+	err := r.UnmarshalText([]byte("[1,5)"))
+	maybePanic(err)
+	data, err := r.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "[1,5)", "text marshal")
+
+	err = r.UnmarshalText(nil)
+	maybePanic(err)
+	if r.Valid {
+		t.Error("blank text", "is valid, but should be invalid")
+	}
+	data, err = r.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestInt64RangeScanValue(t *testing.T) {
+	var r Int64Range
+	err := r.Scan([]byte("[1,5)"))
+	maybePanic(err)
+	if !r.Equal(Int64RangeFrom(1, 5)) {
+		t.Errorf("bad scanned range: %s", r)
+	}
+	v, err := r.Value()
+	maybePanic(err)
+	if v != "[1,5)" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	err = r.Scan(nil)
+	maybePanic(err)
+	if r.Valid || !r.IsZero() {
+		t.Error("scanned null", "is valid, but should be invalid")
+	}
+	v, err = r.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+
+	if err := r.Scan("bad"); err == nil || r.Valid {
+		t.Error("expected error scanning bad range")
+	}
+	if err := r.Scan(int64(1)); err == nil {
+		t.Error("expected error scanning int64")
+	}
+}
+
+func TestInt64RangeEqual(t *testing.T) {
+	if !mustInt64Range("(0,4]").Equal(mustInt64Range("[1,5)")) {
+		t.Error("equivalent ranges should be equal")
+	}
+	if !mustInt64Range("[3,3)").Equal(EmptyInt64Range()) {
+		t.Error("empty ranges should be equal")
+	}
+	if mustInt64Range("[1,5)").Equal(mustInt64Range("[1,6)")) || EmptyInt64Range().Equal(Int64Range{}) {
+		t.Error("different ranges should not be equal")
+	}
+	if EmptyInt64Range().IsZero() {
+		t.Error("empty range should not be zero")
+	}
+}
+
+func TestParseTimeRange(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	want := TimeRangeFrom(jan, feb)
+
+	for _, in := range []string{
+		`["2024-01-01 00:00:00+00","2024-02-01 00:00:00+00")`,
+		`["2024-01-01 09:00:00+09:00","2024-02-01 00:00:00+00")`,
+		`[2024-01-01T00:00:00Z,2024-02-01T00:00:00Z)`,
+	} {
+		r, err := ParseTimeRange(in)
+		if err != nil {
+			t.Errorf("ParseTimeRange(%q): unexpected error: %v", in, err)
+			continue
+		}
+		if !r.Equal(want) {
+			t.Errorf("ParseTimeRange(%q) = %s, want %s", in, r, want)
+		}
+	}
+
+	unbounded, err := ParseTimeRange(`(-infinity,"2024-02-01 00:00:00+00"]`)
+	maybePanic(err)
+	if unbounded.Lower.Valid || !unbounded.UpperInc || !unbounded.Upper.Time.Equal(feb) {
+		t.Errorf("bad unbounded range: %#v", unbounded)
+	}
+
+	empty, err := ParseTimeRange(`["2024-02-01 00:00:00+00","2024-01-01 00:00:00+00")`)
+	maybePanic(err)
+	if !empty.Valid || !empty.Empty {
+		t.Errorf("backwards range should be empty: %#v", empty)
+	}
+
+	for _, bad := range []string{"", "[x,y)", `["2024-01-01",)`, `["",)`} {
+		if _, err := ParseTimeRange(bad); err == nil {
+			t.Errorf("ParseTimeRange(%q): expected error", bad)
+		}
+	}
+}
+
+func TestTimeRangeContainsOverlaps(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := jan.AddDate(0, 1, 0)
+	mar := jan.AddDate(0, 2, 0)
+	r := TimeRangeFrom(jan, feb)
+
+	if !r.Contains(jan) || !r.Contains(feb.Add(-time.Nanosecond)) || r.Contains(feb) || r.Contains(jan.Add(-time.Nanosecond)) {
+		t.Error("bad Contains()")
+	}
+	if (TimeRange{}).Contains(jan) || EmptyTimeRange().Contains(jan) {
+		t.Error("null and empty ranges should contain nothing")
+	}
+
+	if r.Overlaps(TimeRangeFrom(feb, mar)) {
+		t.Error("adjacent ranges should not overlap")
+	}
+	if !r.Overlaps(NewTimeRange(Time{}, TimeFrom(jan), false, true)) {
+		t.Error("range ending at an inclusive bound should overlap")
+	}
+	if !r.Overlaps(TimeRangeFrom(jan.AddDate(0, 0, 15), mar)) {
+		t.Error("intersecting ranges should overlap")
+	}
+	if r.Overlaps(TimeRange{}) || r.Overlaps(EmptyTimeRange()) {
+		t.Error("null and empty ranges should overlap nothing")
+	}
+}
+
+func TestTimeRangeJSON(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := TimeRangeFrom(jan, jan.AddDate(0, 1, 0))
+	literal := `["2024-01-01T00:00:00Z","2024-02-01T00:00:00Z")`
+
+	data, err := json.Marshal(r)
+	maybePanic(err)
+	want, _ := json.Marshal(literal)
+	assertJSONEquals(t, data, string(want), "range json marshal")
+
+	var back TimeRange
+	err = json.Unmarshal(data, &back)
+	maybePanic(err)
+	if !back.Equal(r) {
+		t.Errorf("bad unmarshaled range: %s", back)
+	}
+
+	var null TimeRange
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null range json marshal")
+
+	text, err := r.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, text, literal, "range text marshal")
+	var fromText TimeRange
+	err = fromText.UnmarshalText(text)
+	maybePanic(err)
+	if !fromText.Equal(r) {
+		t.Errorf("bad text unmarshaled range: %s", fromText)
+	}
+}
+
+func TestTimeRangeScanValue(t *testing.T) {
+	var r TimeRange
+	err := r.Scan(`["2024-01-01 00:00:00+00",)`)
+	maybePanic(err)
+	if !r.Valid || !r.Lower.Valid || r.Upper.Valid {
+		t.Errorf("bad scanned range: %#v", r)
+	}
+	v, err := r.Value()
+	maybePanic(err)
+	if v != `["2024-01-01T00:00:00Z",)` {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	err = r.Scan(nil)
+	maybePanic(err)
+	if r.Valid {
+		t.Error("scanned null", "is valid, but should be invalid")
+	}
+	if err := r.Scan("nope"); err == nil || r.Valid {
+		t.Error("expected error scanning bad range")
+	}
+}
+
+func mustInt64Range(s string) Int64Range {
+	r, err := ParseInt64Range(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,188 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Redis clients such as go-redis encode this package's types with MarshalBinary,
+// which suits single keys (see binary.go) but not hashes, whose fields are read back as text and can't be null.
+// RedisHash and RedisLoad convert structs to and from the fields of a Redis hash instead.
+// They only use built-in types, so no build tag is needed.
+
+// RedisHash converts a struct, or a pointer to one, into the fields of a Redis hash,
+// for use with HSET, such as go-redis's Client.HSet. Null values are omitted,
+// as are zero values of other types if the field is tagged with omitempty.
+// Use RedisNullFields to find the fields to remove with HDEL when updating an existing hash.
+//
+// Fields are named by their redis struct tag, or else by field name.
+// Fields tagged with "-" and unexported fields are skipped.
+// Values are encoded with MarshalText if they implement encoding.TextMarshaler,
+// so go-redis's Scan can read them back into a struct, or else as what would be sent to an SQL database (see Value).
+func RedisHash(v interface{}) (map[string]string, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: RedisHash needs a struct, got %T", v)
+	}
+	hash := make(map[string]string)
+	for _, f := range structFields(rv.Type(), "redis") {
+		field := rv.FieldByIndex(f.index)
+		if f.omitEmpty && field.IsZero() {
+			continue
+		}
+		s, ok, err := redisString(field)
+		if err != nil {
+			return nil, fmt.Errorf("null: couldn't convert Redis field %s: %w", f.name, err)
+		}
+		if ok {
+			hash[f.name] = s
+		}
+	}
+	return hash, nil
+}
+
+// RedisNullFields returns the names of the fields of a struct, or a pointer to one, that RedisHash omits because they are null.
+// Removing them with HDEL after HSET makes an existing hash match the struct.
+func RedisNullFields(v interface{}) ([]string, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: RedisNullFields needs a struct, got %T", v)
+	}
+	var nulls []string
+	for _, f := range structFields(rv.Type(), "redis") {
+		val, err := redisValue(rv.FieldByIndex(f.index))
+		if err != nil {
+			return nil, fmt.Errorf("null: couldn't convert Redis field %s: %w", f.name, err)
+		}
+		if val == nil {
+			nulls = append(nulls, f.name)
+		}
+	}
+	return nulls, nil
+}
+
+// RedisLoad sets the fields of the struct pointed to by dst from the fields of a Redis hash,
+// such as the result of HGETALL. Fields missing from hash are set to their zero value, which is null for this package's types.
+// Fields that implement encoding.TextUnmarshaler use UnmarshalText, except that a blank field is scanned
+// if the type accepts blank strings, so that blank Strings stay valid. Fields that implement sql.Scanner scan the string,
+// and fields of basic types such as int and string are parsed.
+func RedisLoad(hash map[string]string, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("null: RedisLoad needs a pointer to a struct, got %T", dst)
+	}
+	rv = rv.Elem()
+	for _, f := range structFields(rv.Type(), "redis") {
+		field := rv.FieldByIndex(f.index)
+		s, ok := hash[f.name]
+		if !ok {
+			field.SetZero()
+			continue
+		}
+		if err := loadRedisString(field, s); err != nil {
+			return fmt.Errorf("null: couldn't load Redis field %s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+// redisValue returns the driver value of a field that implements driver.Valuer, nil for a nil pointer,
+// or else the field's value.
+func redisValue(field reflect.Value) (interface{}, error) {
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return nil, nil
+		}
+		field = field.Elem()
+	}
+	if v, ok := field.Interface().(driver.Valuer); ok {
+		return v.Value()
+	}
+	return field.Interface(), nil
+}
+
+// redisString encodes a field as the value of a Redis hash field, returning false if it is null.
+func redisString(field reflect.Value) (string, bool, error) {
+	val, err := redisValue(field)
+	if err != nil || val == nil {
+		return "", false, err
+	}
+	field = reflect.Indirect(field)
+	if m, ok := field.Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), true, err
+	}
+
+	switch x := val.(type) {
+	case []byte:
+		return string(x), true, nil
+	case time.Time:
+		return x.Format(time.RFC3339Nano), true, nil
+	}
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits()), true, nil
+	}
+	return "", false, fmt.Errorf("can't encode %s as a string", field.Type())
+}
+
+func loadRedisString(field reflect.Value, s string) error {
+	if field.Kind() == reflect.Pointer {
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+	ptr := field.Addr().Interface()
+	if u, ok := ptr.(encoding.TextUnmarshaler); ok {
+		if sc, ok := ptr.(sql.Scanner); ok && s == "" && sc.Scan(s) == nil {
+			return nil
+		}
+		return u.UnmarshalText([]byte(s))
+	}
+	if sc, ok := ptr.(sql.Scanner); ok {
+		return sc.Scan(s)
+	}
+
+	var err error
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(s)
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		i, err = strconv.ParseInt(s, 10, field.Type().Bits())
+		field.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		u, err = strconv.ParseUint(s, 10, field.Type().Bits())
+		field.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(s, field.Type().Bits())
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("can't decode a string into %s", field.Type())
+		}
+		field.SetBytes([]byte(s))
+	default:
+		return fmt.Errorf("can't decode a string into %s", field.Type())
+	}
+	return err
+}
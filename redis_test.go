@@ -0,0 +1,69 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRedisRoundTrip(t *testing.T) {
+	in := exampleAllTypes()
+	hash, err := RedisHash(&in)
+	maybePanic(err)
+	var out allTypes
+	err = RedisLoad(hash, &out)
+	maybePanic(err)
+	assertAllTypesEqual(t, out, in, "redis round trip")
+
+	hash, err = RedisHash(allTypes{})
+	maybePanic(err)
+	if len(hash) != 0 {
+		t.Errorf("null fields should be omitted: %v", hash)
+	}
+}
+
+func TestRedisHash(t *testing.T) {
+	type user struct {
+		ID       int     `redis:"id"`
+		Name     String  `redis:"name"`
+		Nickname String  `redis:"nickname"`
+		Age      *Int    `redis:"age"`
+		Score    float64 `redis:"score,omitempty"`
+		Admin    Bool
+		Secret   String `redis:"-"`
+	}
+	age := IntFrom(30)
+	in := user{ID: 1, Name: StringFrom(""), Age: &age, Admin: BoolFrom(false), Secret: StringFrom("x")}
+	hash, err := RedisHash(in)
+	maybePanic(err)
+	want := map[string]string{"id": "1", "name": "", "age": "30", "Admin": "false"}
+	if !reflect.DeepEqual(hash, want) {
+		t.Errorf("bad hash: %#v", hash)
+	}
+
+	nulls, err := RedisNullFields(user{Age: &Int{}})
+	maybePanic(err)
+	if want := []string{"name", "nickname", "age", "Admin"}; !reflect.DeepEqual(nulls, want) {
+		t.Errorf("bad null fields: %v ≠ %v", nulls, want)
+	}
+
+	out := user{Nickname: StringFrom("stale"), Score: 1}
+	err = RedisLoad(hash, &out)
+	maybePanic(err)
+	if out.ID != 1 || !out.Name.Equal(StringFrom("")) || out.Nickname.Valid || !out.Age.Equal(age) ||
+		out.Score != 0 || !out.Admin.Equal(BoolFrom(false)) {
+		t.Errorf("bad loaded struct: %#v", out)
+	}
+
+	if err := RedisLoad(map[string]string{"id": "x"}, &out); err == nil {
+		t.Error("expected error for bad int")
+	}
+	if err := RedisLoad(map[string]string{"age": ""}, &out); err != nil || out.Age == nil || out.Age.Valid {
+		t.Errorf("blank Int should load as null: %v %v", out.Age, err)
+	}
+	if _, err := RedisHash(1); err == nil {
+		t.Error("expected error for non-struct")
+	}
+	if err := RedisLoad(nil, out); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+}
@@ -0,0 +1,221 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullRegexp represents a *regexp.Regexp that may be null.
+// NullRegexp implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullRegexp struct {
+	Regexp *regexp.Regexp
+	Valid  bool // Valid is true if Regexp is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It will return the compile error if the input isn't a valid regular expression.
+func (n *NullRegexp) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		n.Regexp, n.Valid = nil, false
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Regexp: %v", value, value)
+	}
+	re, err := regexp.Compile(str)
+	if err != nil {
+		n.Regexp, n.Valid = nil, false
+		return fmt.Errorf("null: couldn't scan Regexp: %w", err)
+	}
+	n.Regexp, n.Valid = re, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as their pattern strings.
+func (n NullRegexp) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Regexp.String(), nil
+}
+
+// IsZero returns true for invalid NullRegexps.
+func (n NullRegexp) IsZero() bool {
+	return !n.Valid
+}
+
+// Regexp is a nullable *regexp.Regexp, using RE2 syntax.
+// It is compiled when scanned or unmarshaled, and encodes as its pattern string.
+// It will decode to null, not an empty pattern, if null.
+// Copies of a Regexp share the same underlying *regexp.Regexp, which is safe for concurrent use.
+type Regexp struct {
+	NullRegexp
+}
+
+// NewRegexp creates a new Regexp.
+func NewRegexp(re *regexp.Regexp, valid bool) Regexp {
+	return Regexp{
+		NullRegexp: NullRegexp{
+			Regexp: re,
+			Valid:  valid && re != nil,
+		},
+	}
+}
+
+// RegexpFrom creates a new Regexp that will be null if re is nil.
+func RegexpFrom(re *regexp.Regexp) Regexp {
+	return NewRegexp(re, re != nil)
+}
+
+// CompileRegexp compiles pattern into a new Regexp that will always be valid.
+// It will return the compile error if pattern isn't a valid regular expression.
+func CompileRegexp(pattern string) (Regexp, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return Regexp{}, fmt.Errorf("null: couldn't compile Regexp: %w", err)
+	}
+	return RegexpFrom(re), nil
+}
+
+// MustRegexp is like CompileRegexp but panics if pattern can't be compiled.
+// It is intended for use with constant input, such as in variable initialization.
+func MustRegexp(pattern string) Regexp {
+	re, err := CompileRegexp(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return re
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (r Regexp) ValueOrZero() *regexp.Regexp {
+	if !r.Valid {
+		return nil
+	}
+	return r.Regexp
+}
+
+// MatchString reports whether s contains any match of this Regexp.
+// A null Regexp matches nothing.
+func (r Regexp) MatchString(s string) bool {
+	return r.Valid && r.Regexp.MatchString(s)
+}
+
+// Match reports whether b contains any match of this Regexp.
+// A null Regexp matches nothing.
+func (r Regexp) Match(b []byte) bool {
+	return r.Valid && r.Regexp.Match(b)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports pattern strings and null input.
+// It will return the compile error if the input isn't a valid regular expression.
+func (r *Regexp) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(r, &err)
+	if bytes.Equal(data, nullBytes) {
+		r.Regexp, r.Valid = nil, false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	re, err := regexp.Compile(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	r.Regexp = re
+	r.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Regexp is null, otherwise its pattern string.
+func (r Regexp) MarshalJSON() ([]byte, error) {
+	if !r.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(r.Regexp.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Regexp if the input is DefaultNullText, which is blank by default.
+// It will return the compile error if the input isn't a valid regular expression.
+func (r *Regexp) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(r, &err)
+	if isNullTextOnly(text) {
+		r.Regexp, r.Valid = nil, false
+		return nil
+	}
+	re, err := regexp.Compile(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	r.Regexp = re
+	r.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Regexp is null, otherwise its pattern string.
+func (r Regexp) MarshalText() ([]byte, error) {
+	if !r.Valid {
+		return nullText(), nil
+	}
+	return []byte(r.Regexp.String()), nil
+}
+
+// SetValid changes this Regexp's value and also sets it to be non-null.
+// A nil v will set this Regexp to null.
+func (r *Regexp) SetValid(v *regexp.Regexp) {
+	r.Regexp = v
+	r.Valid = v != nil
+}
+
+// SetNull sets this Regexp to null, leaving its value as is. Use Reset to clear the value too.
+func (r *Regexp) SetNull() {
+	r.Valid = false
+}
+
+// Reset sets this Regexp to null and clears its value.
+func (r *Regexp) Reset() {
+	*r = Regexp{}
+}
+
+// Ptr returns this Regexp's value, or a nil pointer if this Regexp is null.
+func (r Regexp) Ptr() *regexp.Regexp {
+	if !r.Valid {
+		return nil
+	}
+	return r.Regexp
+}
+
+// IsZero returns true for invalid Regexps.
+func (r Regexp) IsZero() bool {
+	return !r.Valid
+}
+
+// IsZeroOrNull returns true for invalid Regexps, or valid Regexps with an empty pattern.
+func (r Regexp) IsZeroOrNull() bool {
+	return !r.Valid || r.Regexp == nil || r.Regexp.String() == ""
+}
+
+// Equal returns true if both Regexps have the same pattern or are both null.
+func (r Regexp) Equal(other Regexp) bool {
+	return r.Valid == other.Valid && (!r.Valid || r.Regexp.String() == other.Regexp.String())
+}
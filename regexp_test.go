@@ -0,0 +1,206 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"regexp/syntax"
+	"testing"
+)
+
+var (
+	regexpPattern = `^[a-z]+\d*$`
+	regexpJSON    = []byte(`"^[a-z]+\\d*$"`)
+	badRegexp     = `a(b`
+)
+
+func TestCompileRegexp(t *testing.T) {
+	r, err := CompileRegexp(regexpPattern)
+	maybePanic(err)
+	assertRegexp(t, r, "CompileRegexp()")
+
+	_, err = CompileRegexp(badRegexp)
+	var syntaxErr *syntax.Error
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("expected wrapped *syntax.Error, not %T", err)
+	}
+
+	assertRegexp(t, RegexpFrom(regexp.MustCompile(regexpPattern)), "RegexpFrom()")
+	assertNullRegexp(t, RegexpFrom(nil), "RegexpFrom(nil)")
+	assertNullRegexp(t, NewRegexp(regexp.MustCompile(regexpPattern), false), "NewRegexp() invalid")
+}
+
+func TestMustRegexp(t *testing.T) {
+	assertRegexp(t, MustRegexp(regexpPattern), "MustRegexp()")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustRegexp() should panic")
+		}
+	}()
+	MustRegexp(badRegexp)
+}
+
+func TestRegexpMatch(t *testing.T) {
+	r := MustRegexp(regexpPattern)
+	if !r.MatchString("abc123") || r.MatchString("ABC") {
+		t.Error("bad MatchString()")
+	}
+	if !r.Match([]byte("abc")) || r.Match([]byte("123")) {
+		t.Error("bad Match()")
+	}
+
+	var null Regexp
+	if null.MatchString("") || null.Match(nil) {
+		t.Error("null Regexp should match nothing")
+	}
+}
+
+func TestUnmarshalRegexp(t *testing.T) {
+	var r Regexp
+	err := json.Unmarshal(regexpJSON, &r)
+	maybePanic(err)
+	assertRegexp(t, r, "regexp json")
+
+	var empty Regexp
+	err = json.Unmarshal(blankStringJSON, &empty)
+	maybePanic(err)
+	if !empty.Valid || empty.Regexp.String() != "" {
+		t.Errorf("bad empty regexp json: %#v", empty)
+	}
+
+	var null Regexp
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullRegexp(t, null, "null json")
+
+	var bad Regexp
+	err = json.Unmarshal([]byte(`"`+badRegexp+`"`), &bad)
+	var syntaxErr *syntax.Error
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("expected wrapped *syntax.Error, not %T", err)
+	}
+	assertNullRegexp(t, bad, "bad regexp json")
+
+	var badType Regexp
+	if err := json.Unmarshal(intJSON, &badType); err == nil {
+		t.Error("expected error unmarshaling number")
+	}
+}
+
+func TestMarshalRegexp(t *testing.T) {
+	r := MustRegexp(regexpPattern)
+	data, err := json.Marshal(r)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(regexpJSON), "non-empty json marshal")
+
+	data, err = r.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, regexpPattern, "text marshal")
+
+	var null Regexp
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestRegexpText(t *testing.T) {
+	var r Regexp
+	err := r.UnmarshalText([]byte(regexpPattern))
+	maybePanic(err)
+	assertRegexp(t, r, "text unmarshal")
+
+	err = r.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullRegexp(t, r, "blank text unmarshal")
+
+	if err := r.UnmarshalText([]byte(badRegexp)); err == nil {
+		t.Error("expected error unmarshaling bad pattern")
+	}
+}
+
+func TestRegexpScanValue(t *testing.T) {
+	var r Regexp
+	err := r.Scan(regexpPattern)
+	maybePanic(err)
+	assertRegexp(t, r, "scanned string")
+
+	var b Regexp
+	err = b.Scan([]byte(regexpPattern))
+	maybePanic(err)
+	assertRegexp(t, b, "scanned bytes")
+
+	v, err := r.Value()
+	maybePanic(err)
+	if v != regexpPattern {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	var null Regexp
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullRegexp(t, null, "scanned null")
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+
+	err = b.Scan(badRegexp)
+	var syntaxErr *syntax.Error
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("expected wrapped *syntax.Error, not %T", err)
+	}
+	assertNullRegexp(t, b, "scanned bad pattern")
+	if err := b.Scan(int64(1)); err == nil {
+		t.Error("expected error scanning int64")
+	}
+}
+
+func TestRegexpSetValid(t *testing.T) {
+	var r Regexp
+	r.SetValid(regexp.MustCompile(regexpPattern))
+	assertRegexp(t, r, "SetValid()")
+	if r.Ptr() != r.Regexp || r.ValueOrZero() != r.Regexp {
+		t.Error("bad Ptr() or ValueOrZero()")
+	}
+
+	r.SetValid(nil)
+	assertNullRegexp(t, r, "SetValid(nil)")
+	if r.Ptr() != nil || r.ValueOrZero() != nil {
+		t.Error("bad null Ptr() or ValueOrZero()")
+	}
+}
+
+func TestRegexpEqual(t *testing.T) {
+	if !MustRegexp(regexpPattern).Equal(MustRegexp(regexpPattern)) {
+		t.Error("Regexps with the same pattern should be equal")
+	}
+	if MustRegexp("a").Equal(MustRegexp("b")) || MustRegexp("a").Equal(Regexp{}) {
+		t.Error("different Regexps should not be equal")
+	}
+	if !(Regexp{}).Equal(Regexp{}) || !(Regexp{}).IsZero() || MustRegexp("a").IsZero() {
+		t.Error("bad null Regexps")
+	}
+}
+
+func assertRegexp(t *testing.T, r Regexp, from string) {
+	t.Helper()
+	if !r.Valid {
+		t.Error(from, "is invalid, but should be valid")
+		return
+	}
+	if r.Regexp.String() != regexpPattern {
+		t.Errorf("bad %s pattern: %q ≠ %q\n", from, r.Regexp.String(), regexpPattern)
+	}
+}
+
+func assertNullRegexp(t *testing.T, r Regexp, from string) {
+	t.Helper()
+	if r.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
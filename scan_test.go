@@ -0,0 +1,210 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// SQLite drivers return int64, float64, string, or []byte depending on how a value was stored,
+// regardless of the column's declared type.
+func TestScanIntegralFloat(t *testing.T) {
+	for _, test := range []struct {
+		dst   sql.Scanner
+		n     int64
+		check func(sql.Scanner) bool
+	}{
+		{new(Int), 1700000000, func(s sql.Scanner) bool { return s.(*Int).Equal(IntFrom(1700000000)) }},
+		{new(Int8), 100, func(s sql.Scanner) bool { return s.(*Int8).Equal(Int8From(100)) }},
+		{new(Int16), 30000, func(s sql.Scanner) bool { return s.(*Int16).Equal(Int16From(30000)) }},
+		{new(Int32), 1700000000, func(s sql.Scanner) bool { return s.(*Int32).Equal(Int32From(1700000000)) }},
+		{new(Uint), 1700000000, func(s sql.Scanner) bool { return s.(*Uint).Equal(UintFrom(1700000000)) }},
+		{new(Uint8), 200, func(s sql.Scanner) bool { return s.(*Uint8).Equal(Uint8From(200)) }},
+		{new(Uint16), 60000, func(s sql.Scanner) bool { return s.(*Uint16).Equal(Uint16From(60000)) }},
+		{new(Uint32), 1700000000, func(s sql.Scanner) bool { return s.(*Uint32).Equal(Uint32From(1700000000)) }},
+		{new(Duration), 1700000000, func(s sql.Scanner) bool { return s.(*Duration).Equal(DurationFrom(1700000000)) }},
+		{new(ByteSize), 1 << 30, func(s sql.Scanner) bool { return s.(*ByteSize).Equal(ByteSizeFrom(1 << 30)) }},
+		{new(FileMode), 0644, func(s sql.Scanner) bool { return s.(*FileMode).Equal(FileModeFrom(0644)) }},
+		{new(Flags[uint16]), 60000, func(s sql.Scanner) bool { return s.(*Flags[uint16]).Equal(FlagsFrom[uint16](60000)) }},
+		{new(BigInt), 1700000000, func(s sql.Scanner) bool { return s.(*BigInt).Equal(BigIntFrom(big.NewInt(1700000000))) }},
+		{new(UnixSeconds), 1700000000, func(s sql.Scanner) bool {
+			return s.(*UnixSeconds).Equal(UnixSecondsFrom(time.Unix(1700000000, 0)))
+		}},
+	} {
+		for _, src := range []interface{}{test.n, float64(test.n)} {
+			if err := test.dst.Scan(src); err != nil {
+				t.Errorf("%T: Scan(%#v): %v", test.dst, src, err)
+				continue
+			}
+			if !test.check(test.dst) {
+				t.Errorf("%T: Scan(%#v): got %v", test.dst, src, test.dst)
+			}
+		}
+		if err := test.dst.Scan(float64(test.n) + 0.5); err == nil {
+			t.Errorf("%T: expected error for fractional float", test.dst)
+		}
+	}
+}
+
+func TestScanFloatRange(t *testing.T) {
+	var i Int
+	err := i.Scan(float64(math.MinInt64))
+	maybePanic(err)
+	if !i.Equal(IntFrom(math.MinInt64)) {
+		t.Errorf("bad min int64: %v", i)
+	}
+	for _, bad := range []float64{math.MaxInt64, math.Inf(1), math.NaN()} {
+		if err := i.Scan(bad); err == nil {
+			t.Errorf("Int: expected error for %v", bad)
+		}
+	}
+
+	var u Uint
+	err = u.Scan(float64(1 << 63))
+	maybePanic(err)
+	if !u.Equal(UintFrom(1 << 63)) {
+		t.Errorf("bad 1<<63: %v", u)
+	}
+	for _, bad := range []float64{-1, math.MaxUint64, math.NaN()} {
+		if err := u.Scan(bad); err == nil {
+			t.Errorf("Uint: expected error for %v", bad)
+		}
+	}
+
+	var i8 Int8
+	if err := i8.Scan(float64(128)); err == nil {
+		t.Error("Int8: expected error for 128")
+	}
+
+	var b BigInt
+	err = b.Scan(1e30)
+	maybePanic(err)
+	if want, _ := new(big.Int).SetString("1000000000000000019884624838656", 10); b.BigInt.Cmp(want) != 0 {
+		t.Errorf("bad BigInt from float: %v", b.BigInt)
+	}
+}
+
+func TestScanSQLiteBool(t *testing.T) {
+	for _, test := range []struct {
+		src  interface{}
+		want bool
+	}{
+		{int64(1), true},
+		{int64(0), false},
+		{float64(1), true},
+		{float64(0), false},
+		{"1", true},
+		{"false", false},
+		{[]byte("0"), false},
+	} {
+		var b Bool
+		err := b.Scan(test.src)
+		maybePanic(err)
+		if !b.Equal(BoolFrom(test.want)) {
+			t.Errorf("Scan(%#v): got %v", test.src, b)
+		}
+	}
+	for _, bad := range []interface{}{float64(0.5), float64(2), int64(2)} {
+		var b Bool
+		if err := b.Scan(bad); err == nil {
+			t.Errorf("expected error for %#v", bad)
+		}
+	}
+}
+
+// valuer stands in for driver.Valuer types like decimal.Decimal.
+type valuer string
+
+func (v valuer) Value() (driver.Value, error) {
+	return string(v), nil
+}
+
+// clickhouse-go returns sized integers and float32, big.Int for Int128 and wider,
+// and pointers for Nullable columns.
+func TestScanDriverTypes(t *testing.T) {
+	u64 := uint64(math.MaxUint64)
+	i8 := int8(-8)
+	var nilU64 *uint64
+	str := "hello"
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	for _, test := range []struct {
+		dst  sql.Scanner
+		src  interface{}
+		want interface{}
+	}{
+		{new(Int), int32(-32), IntFrom(-32)},
+		{new(Int), &i8, IntFrom(-8)},
+		{new(Int), big.NewInt(math.MinInt64), IntFrom(math.MinInt64)},
+		{new(Int8), uint8(100), Int8From(100)},
+		{new(Int16), uint16(30000), Int16From(30000)},
+		{new(Int32), &i8, Int32From(-8)},
+		{new(Uint), &u64, UintFrom(math.MaxUint64)},
+		{new(Uint), *new(big.Int).SetUint64(math.MaxUint64), UintFrom(math.MaxUint64)},
+		{new(Uint8), uint8(200), Uint8From(200)},
+		{new(Uint32), uint32(math.MaxUint32), Uint32From(math.MaxUint32)},
+		{new(Float), float32(0.1), FloatFrom(0.1)},
+		{new(Float), valuer("1.25"), FloatFrom(1.25)},
+		{new(String), &str, StringFrom("hello")},
+		{new(Bool), uint8(1), BoolFrom(true)},
+		{new(Time), &now, TimeFrom(now)},
+		{new(Date), &now, DateFrom(now)},
+		{new(BigInt), new(big.Int).Lsh(big.NewInt(1), 100), BigIntFrom(new(big.Int).Lsh(big.NewInt(1), 100))},
+		{new(Duration), int32(1000), DurationFrom(1000)},
+		{new(Uint), nilU64, Uint{}},
+		{new(String), (*string)(nil), String{}},
+	} {
+		if err := test.dst.Scan(test.src); err != nil {
+			t.Errorf("%T: Scan(%T): %v", test.dst, test.src, err)
+			continue
+		}
+		if got := reflect.ValueOf(test.dst).Elem().Interface(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%T: Scan(%T): got %v, want %v", test.dst, test.src, got, test.want)
+		}
+	}
+
+	var i8dst Int8
+	if err := i8dst.Scan(uint16(300)); err == nil {
+		t.Error("expected error for overflowing uint16 into Int8")
+	}
+	var idst Int
+	if err := idst.Scan(&u64); err == nil {
+		t.Error("expected error for overflowing *uint64 into Int")
+	}
+}
+
+// sqlx and scany scan into the address of each field and pass field values as parameters,
+// so Scan needs a pointer receiver and Value a value receiver, which also makes nil pointers usable as NULL.
+func TestScannerReceivers(t *testing.T) {
+	scanner := reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	valuer := reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	typ := reflect.TypeOf(struct {
+		allTypes
+		Array Array[int]
+	}{})
+	var types []reflect.Type
+	for i := 0; i < typ.Field(0).Type.NumField(); i++ {
+		types = append(types, typ.Field(0).Type.Field(i).Type)
+	}
+	types = append(types, typ.Field(1).Type)
+
+	for _, ft := range types {
+		if !reflect.PointerTo(ft).Implements(scanner) {
+			t.Errorf("%s: *%s should implement sql.Scanner", ft, ft)
+		}
+		if ft.Implements(scanner) {
+			t.Errorf("%s: Scan should have a pointer receiver", ft)
+		}
+		if !ft.Implements(valuer) {
+			t.Errorf("%s: Value should have a value receiver", ft)
+		}
+		v, err := driver.DefaultParameterConverter.ConvertValue(reflect.Zero(reflect.PointerTo(ft)).Interface())
+		if err != nil || v != nil {
+			t.Errorf("%s: nil pointer should convert to NULL: %v %v", ft, v, err)
+		}
+	}
+}
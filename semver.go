@@ -0,0 +1,361 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullSemver represents a semantic version that may be null.
+// NullSemver implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullSemver struct {
+	// Version is a canonical semantic version string, such as "1.2.3-beta.1".
+	Version string
+	Valid   bool // Valid is true if Version is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the value is not a valid semantic version.
+func (n *NullSemver) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		n.Version, n.Valid = "", false
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Semver: %v", value, value)
+	}
+	ver, err := parseSemver(str)
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan Semver: %w", err)
+	}
+	n.Version, n.Valid = ver.String(), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as canonical version strings.
+func (n NullSemver) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Version, nil
+}
+
+// IsZero returns true for invalid NullSemvers.
+func (n NullSemver) IsZero() bool {
+	return !n.Valid
+}
+
+// Semver is a nullable semantic version, as specified by https://semver.org.
+// A leading "v" is accepted, but versions are stored without it.
+// It will decode to null, not an empty string, if null.
+type Semver struct {
+	NullSemver
+}
+
+// NewSemver creates a new Semver.
+// It will be null if valid is false or version is not a valid semantic version.
+func NewSemver(version string, valid bool) Semver {
+	if !valid {
+		return Semver{}
+	}
+	s, err := ParseSemver(version)
+	if err != nil {
+		return Semver{}
+	}
+	return s
+}
+
+// ParseSemver creates a new Semver from a version string such as "1.2.3".
+// It returns an error if version is not a valid semantic version.
+func ParseSemver(version string) (Semver, error) {
+	ver, err := parseSemver(version)
+	if err != nil {
+		return Semver{}, fmt.Errorf("null: couldn't parse Semver: %w", err)
+	}
+	return Semver{NullSemver: NullSemver{Version: ver.String(), Valid: true}}, nil
+}
+
+// MustSemver is like ParseSemver but panics if version is invalid.
+func MustSemver(version string) Semver {
+	s, err := ParseSemver(version)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// ValueOrZero returns the inner value if valid, otherwise an empty string.
+func (s Semver) ValueOrZero() string {
+	if !s.Valid {
+		return ""
+	}
+	return s.Version
+}
+
+// Major returns the major version, or 0 if this Semver is null.
+func (s Semver) Major() uint64 {
+	return s.parsed().major
+}
+
+// Minor returns the minor version, or 0 if this Semver is null.
+func (s Semver) Minor() uint64 {
+	return s.parsed().minor
+}
+
+// Patch returns the patch version, or 0 if this Semver is null.
+func (s Semver) Patch() uint64 {
+	return s.parsed().patch
+}
+
+// Prerelease returns the pre-release part of the version without the leading hyphen,
+// such as "beta.1", or an empty string if there is none.
+func (s Semver) Prerelease() string {
+	return strings.Join(s.parsed().pre, ".")
+}
+
+// Compare compares two Semvers by precedence.
+// The result will be 0 if s == other, -1 if s < other, and +1 if s > other.
+// Build metadata is ignored. Null is considered less than any valid version.
+func (s Semver) Compare(other Semver) int {
+	switch {
+	case !s.Valid && !other.Valid:
+		return 0
+	case !s.Valid:
+		return -1
+	case !other.Valid:
+		return 1
+	}
+	return s.parsed().compare(other.parsed())
+}
+
+// Less reports whether this Semver has lower precedence than other, with null first.
+func (s Semver) Less(other Semver) bool {
+	return s.Compare(other) < 0
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports version strings and null input.
+func (s *Semver) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(s, &err)
+	if bytes.Equal(data, nullBytes) {
+		s.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	ver, err := parseSemver(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	s.Version = ver.String()
+	s.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Semver is null.
+func (s Semver) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s.Version)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Semver if the input is blank or DefaultNullText.
+func (s *Semver) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(s, &err)
+	if isNullText(text) {
+		s.Valid = false
+		return nil
+	}
+	ver, err := parseSemver(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	s.Version = ver.String()
+	s.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Semver is null.
+func (s Semver) MarshalText() ([]byte, error) {
+	if !s.Valid {
+		return nullText(), nil
+	}
+	return []byte(s.Version), nil
+}
+
+// SetNull sets this Semver to null, leaving its value as is. Use Reset to clear the value too.
+func (s *Semver) SetNull() {
+	s.Valid = false
+}
+
+// Reset sets this Semver to null and clears its value.
+func (s *Semver) Reset() {
+	*s = Semver{}
+}
+
+// IsZero returns true for invalid Semvers.
+func (s Semver) IsZero() bool {
+	return !s.Valid
+}
+
+// IsZeroOrNull returns true for invalid Semvers, or valid Semvers with an empty version.
+func (s Semver) IsZeroOrNull() bool {
+	return !s.Valid || s.Version == ""
+}
+
+// Equal returns true if both Semvers are the same version or are both null.
+// Unlike Compare, build metadata is significant.
+func (s Semver) Equal(other Semver) bool {
+	return s.Valid == other.Valid && (!s.Valid || s.Version == other.Version)
+}
+
+func (s Semver) parsed() semver {
+	if !s.Valid {
+		return semver{}
+	}
+	ver, _ := parseSemver(s.Version)
+	return ver
+}
+
+type semver struct {
+	major, minor, patch uint64
+	pre                 []string
+	build               string
+}
+
+func (v semver) String() string {
+	str := strconv.FormatUint(v.major, 10) + "." + strconv.FormatUint(v.minor, 10) + "." + strconv.FormatUint(v.patch, 10)
+	if len(v.pre) > 0 {
+		str += "-" + strings.Join(v.pre, ".")
+	}
+	if v.build != "" {
+		str += "+" + v.build
+	}
+	return str
+}
+
+func (v semver) compare(other semver) int {
+	if c := compareUint64(v.major, other.major); c != 0 {
+		return c
+	}
+	if c := compareUint64(v.minor, other.minor); c != 0 {
+		return c
+	}
+	if c := compareUint64(v.patch, other.patch); c != 0 {
+		return c
+	}
+	// a version without a pre-release has higher precedence
+	switch {
+	case len(v.pre) == 0 && len(other.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(other.pre) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.pre) && i < len(other.pre); i++ {
+		a, b := v.pre[i], other.pre[i]
+		an, aErr := strconv.ParseUint(a, 10, 64)
+		bn, bErr := strconv.ParseUint(b, 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if c := compareUint64(an, bn); c != 0 {
+				return c
+			}
+		case aErr == nil:
+			// numeric identifiers have lower precedence
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(a, b); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareUint64(uint64(len(v.pre)), uint64(len(other.pre)))
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func parseSemver(str string) (semver, error) {
+	var v semver
+	rest := strings.TrimPrefix(str, "v")
+	rest, v.build, _ = strings.Cut(rest, "+")
+	rest, pre, hasPre := strings.Cut(rest, "-")
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return semver{}, fmt.Errorf("invalid semantic version: %q", str)
+	}
+	nums := make([]uint64, 3)
+	for i, part := range parts {
+		if part == "" || !isDigits(part) || (len(part) > 1 && part[0] == '0') {
+			return semver{}, fmt.Errorf("invalid semantic version: %q", str)
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return semver{}, fmt.Errorf("invalid semantic version: %q", str)
+		}
+		nums[i] = n
+	}
+	v.major, v.minor, v.patch = nums[0], nums[1], nums[2]
+	if hasPre {
+		v.pre = strings.Split(pre, ".")
+		for _, ident := range v.pre {
+			if !isSemverIdent(ident) || (isDigits(ident) && len(ident) > 1 && ident[0] == '0') {
+				return semver{}, fmt.Errorf("invalid semantic version pre-release: %q", str)
+			}
+		}
+	}
+	if strings.Contains(str, "+") {
+		for _, ident := range strings.Split(v.build, ".") {
+			if !isSemverIdent(ident) {
+				return semver{}, fmt.Errorf("invalid semantic version build metadata: %q", str)
+			}
+		}
+	}
+	return v, nil
+}
+
+func isSemverIdent(ident string) bool {
+	if ident == "" {
+		return false
+	}
+	for i := 0; i < len(ident); i++ {
+		c := ident[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
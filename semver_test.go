@@ -0,0 +1,227 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	semverString = "1.2.3-beta.1+build.5"
+	semverJSON   = []byte(`"` + semverString + `"`)
+)
+
+func TestParseSemver(t *testing.T) {
+	s, err := ParseSemver(semverString)
+	maybePanic(err)
+	assertSemver(t, s, "ParseSemver()")
+
+	v, err := ParseSemver("v" + semverString)
+	maybePanic(err)
+	assertSemver(t, v, `ParseSemver() with "v"`)
+
+	for _, bad := range []string{
+		"", "1", "1.2", "1.2.3.4", "01.2.3", "1.02.3", "1.2.-3", "a.b.c",
+		"1.2.3-", "1.2.3-01", "1.2.3-beta..1", "1.2.3+", "1.2.3+build_5",
+	} {
+		if _, err := ParseSemver(bad); err == nil {
+			t.Errorf("ParseSemver(%q): expected error", bad)
+		}
+	}
+
+	if NewSemver("bad", true).Valid {
+		t.Error("NewSemver() with invalid version should be null")
+	}
+	assertSemver(t, NewSemver(semverString, true), "NewSemver()")
+	assertNullSemver(t, NewSemver(semverString, false), "NewSemver() invalid")
+}
+
+func TestMustSemver(t *testing.T) {
+	assertSemver(t, MustSemver(semverString), "MustSemver()")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustSemver() should panic")
+		}
+	}()
+	MustSemver("1.2")
+}
+
+func TestSemverAccessors(t *testing.T) {
+	s := MustSemver(semverString)
+	if s.Major() != 1 || s.Minor() != 2 || s.Patch() != 3 || s.Prerelease() != "beta.1" {
+		t.Errorf("bad accessors: %d %d %d %q", s.Major(), s.Minor(), s.Patch(), s.Prerelease())
+	}
+
+	var null Semver
+	if null.Major() != 0 || null.Minor() != 0 || null.Patch() != 0 || null.Prerelease() != "" {
+		t.Error("null accessors should be zero")
+	}
+	if null.ValueOrZero() != "" || s.ValueOrZero() != semverString {
+		t.Error("bad ValueOrZero()")
+	}
+}
+
+func TestSemverCompare(t *testing.T) {
+	// in order of precedence, from semver.org
+	versions := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0", "10.0.0",
+	}
+	var null Semver
+	for i, a := range versions {
+		sa := MustSemver(a)
+		if c := sa.Compare(null); c != 1 {
+			t.Errorf("%s compared to null = %d", a, c)
+		}
+		if c := null.Compare(sa); c != -1 {
+			t.Errorf("null compared to %s = %d", a, c)
+		}
+		for j, b := range versions {
+			want := compareUint64(uint64(i), uint64(j))
+			if c := sa.Compare(MustSemver(b)); c != want {
+				t.Errorf("%s compared to %s = %d, want %d", a, b, c, want)
+			}
+		}
+	}
+	if c := null.Compare(Semver{}); c != 0 {
+		t.Errorf("null compared to null = %d", c)
+	}
+	if c := MustSemver("1.0.0+a").Compare(MustSemver("1.0.0+b")); c != 0 {
+		t.Errorf("build metadata should be ignored, got %d", c)
+	}
+}
+
+func TestUnmarshalSemver(t *testing.T) {
+	var s Semver
+	err := json.Unmarshal(semverJSON, &s)
+	maybePanic(err)
+	assertSemver(t, s, "semver json")
+
+	var null Semver
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullSemver(t, null, "null json")
+
+	var bad Semver
+	err = json.Unmarshal(stringJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullSemver(t, bad, "bad semver json")
+
+	var badType Semver
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullSemver(t, badType, "wrong type json")
+}
+
+func TestSemverText(t *testing.T) {
+	var s Semver
+	err := s.UnmarshalText([]byte("v" + semverString))
+	maybePanic(err)
+	assertSemver(t, s, "UnmarshalText() semver")
+
+	data, err := s.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, semverString, "non-empty text marshal")
+
+	var blank Semver
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullSemver(t, blank, "UnmarshalText() empty semver")
+
+	data, err = blank.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var invalid Semver
+	err = invalid.UnmarshalText([]byte("1.2"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalSemver(t *testing.T) {
+	data, err := json.Marshal(MustSemver(semverString))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(semverJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(Semver{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestSemverScanValue(t *testing.T) {
+	var s Semver
+	err := s.Scan("v" + semverString)
+	maybePanic(err)
+	assertSemver(t, s, "scanned string")
+
+	var b Semver
+	err = b.Scan([]byte(semverString))
+	maybePanic(err)
+	assertSemver(t, b, "scanned bytes")
+
+	var null Semver
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullSemver(t, null, "scanned null")
+
+	var invalid Semver
+	err = invalid.Scan("1.2")
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullSemver(t, invalid, "scanned invalid")
+
+	var wrong Semver
+	err = wrong.Scan(int64(1))
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	v, err := s.Value()
+	maybePanic(err)
+	if v != semverString {
+		t.Errorf("bad Value(): %#v ≠ %q", v, semverString)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestSemverIsZeroEqual(t *testing.T) {
+	if !(Semver{}).IsZero() || MustSemver("0.0.0").IsZero() {
+		t.Error("bad IsZero()")
+	}
+	if !MustSemver("v1.0.0").Equal(MustSemver("1.0.0")) {
+		t.Error("same Semvers should be equal")
+	}
+	if MustSemver("1.0.0+a").Equal(MustSemver("1.0.0+b")) {
+		t.Error("Semvers with different build metadata should not be equal")
+	}
+	if MustSemver("1.0.0").Equal(Semver{}) {
+		t.Error("valid and null Semvers should not be equal")
+	}
+}
+
+func assertSemver(t *testing.T, s Semver, from string) {
+	t.Helper()
+	if s.Version != semverString {
+		t.Errorf("bad %s semver: %q ≠ %q\n", from, s.Version, semverString)
+	}
+	if !s.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullSemver(t *testing.T, s Semver, from string) {
+	t.Helper()
+	if s.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,436 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// SliceFormat is a way of representing a Slice in the database.
+type SliceFormat int
+
+const (
+	// SliceJSON sends Slices as JSON arrays, such as `["a","b"]`.
+	SliceJSON SliceFormat = iota
+	// SlicePostgres sends Slices as PostgreSQL array literals, such as `{"a","b"}`.
+	SlicePostgres
+)
+
+// DefaultSliceFormat is the format Slice uses for driver values.
+// Both formats are always accepted when scanning.
+// It defaults to SliceJSON.
+var DefaultSliceFormat = SliceJSON
+
+// Slice is a nullable slice of T.
+// It distinguishes null from an empty slice: a null Slice encodes to JSON null,
+// while a valid Slice encodes to an array, even if it is nil.
+// It will decode to null, not an empty slice, if null.
+type Slice[T any] struct {
+	sql.Null[[]T]
+}
+
+// NewSlice creates a new Slice.
+func NewSlice[T any](v []T, valid bool) Slice[T] {
+	return Slice[T]{
+		Null: sql.Null[[]T]{
+			V:     v,
+			Valid: valid,
+		},
+	}
+}
+
+// SliceFrom creates a new Slice that will always be valid.
+// A nil slice is considered empty, not null.
+func SliceFrom[T any](v []T) Slice[T] {
+	return NewSlice(v, true)
+}
+
+// SliceFromPtr creates a new Slice that will be null if v is nil.
+func SliceFromPtr[T any](v *[]T) Slice[T] {
+	if v == nil {
+		return NewSlice[T](nil, false)
+	}
+	return NewSlice(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (s Slice[T]) ValueOrZero() []T {
+	if !s.Valid {
+		return nil
+	}
+	return s.V
+}
+
+// Len returns the number of elements in this Slice, or 0 if it is null.
+func (s Slice[T]) Len() int {
+	if !s.Valid {
+		return 0
+	}
+	return len(s.V)
+}
+
+// Scan implements the Scanner interface.
+// It supports JSON arrays and one-dimensional PostgreSQL array literals, such as `{a,"b c",NULL}`.
+// Elements of array literals are scanned into T with its Scan method if it has one,
+// and bytea hex elements such as `\x0102` are decoded for byte slice types such as []byte and Bytes.
+// The JSON text null scans as a null Slice.
+func (s *Slice[T]) Scan(value interface{}) (err error) {
+	defer validateDecoded(s, &err)
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		s.V, s.Valid = nil, false
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Slice: %v", value, value)
+	}
+
+	if strings.HasPrefix(str, "{") {
+		s.V, err = scanPostgresArray[T](str)
+	} else if strings.TrimSpace(str) == "null" {
+		s.V, s.Valid = nil, false
+		return nil
+	} else {
+		s.V = nil
+		err = json.Unmarshal([]byte(str), &s.V)
+	}
+	if err != nil {
+		s.Valid = false
+		return fmt.Errorf("null: couldn't scan Slice: %w", err)
+	}
+	if s.V == nil {
+		s.V = []T{}
+	}
+	s.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as strings, formatted according to DefaultSliceFormat.
+func (s Slice[T]) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	if DefaultSliceFormat == SlicePostgres {
+		return formatPostgresArray(s.V)
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input.
+// An empty array will not be considered null.
+func (s *Slice[T]) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(s, &err)
+	if bytes.Equal(data, nullBytes) {
+		s.V, s.Valid = nil, false
+		return nil
+	}
+
+	var v []T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if v == nil {
+		v = []T{}
+	}
+
+	s.V = v
+	s.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Slice is null, and [] if it is valid but empty.
+func (s Slice[T]) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	if s.V == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.V)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Slice if the input is blank or DefaultNullText,
+// otherwise the input is decoded as a JSON array.
+func (s *Slice[T]) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(s, &err)
+	if isNullText(text) {
+		s.V, s.Valid = nil, false
+		return nil
+	}
+	if err := s.UnmarshalJSON(text); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Slice is null, otherwise a JSON array.
+func (s Slice[T]) MarshalText() ([]byte, error) {
+	if !s.Valid {
+		return nullText(), nil
+	}
+	return s.MarshalJSON()
+}
+
+// SetValid changes this Slice's value and also sets it to be non-null.
+func (s *Slice[T]) SetValid(v []T) {
+	s.V = v
+	s.Valid = true
+}
+
+// SetNull sets this Slice to null, leaving its value as is. Use Reset to clear the value too.
+func (s *Slice[T]) SetNull() {
+	s.Valid = false
+}
+
+// Reset sets this Slice to null and clears its value.
+func (s *Slice[T]) Reset() {
+	*s = Slice[T]{}
+}
+
+// Ptr returns a pointer to this Slice's value, or a nil pointer if this Slice is null.
+func (s Slice[T]) Ptr() *[]T {
+	if !s.Valid {
+		return nil
+	}
+	return &s.V
+}
+
+// SetPtr changes this Slice's value to the value v points to, or sets it to be null if v is nil, like SliceFromPtr.
+func (s *Slice[T]) SetPtr(v *[]T) {
+	*s = SliceFromPtr(v)
+}
+
+// IsZero returns true for invalid Slices.
+// A non-null Slice with no elements will not be considered zero.
+func (s Slice[T]) IsZero() bool {
+	return !s.Valid
+}
+
+// IsZeroOrNull returns true for invalid Slices, or valid Slices with no elements.
+func (s Slice[T]) IsZeroOrNull() bool {
+	return !s.Valid || len(s.V) == 0
+}
+
+// Equal returns true if both Slices have the same elements or are both null.
+// Nil and empty slices are equal. Elements are compared with reflect.DeepEqual.
+func (s Slice[T]) Equal(other Slice[T]) bool {
+	if s.Valid != other.Valid {
+		return false
+	}
+	if !s.Valid {
+		return true
+	}
+	if len(s.V) != len(other.V) {
+		return false
+	}
+	for i := range s.V {
+		if !reflect.DeepEqual(s.V[i], other.V[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// scanPostgresArray parses a one-dimensional PostgreSQL array literal into a []T.
+func scanPostgresArray[T any](str string) ([]T, error) {
+	elems, err := parsePostgresArray(str)
+	if err != nil {
+		return nil, err
+	}
+	bytea := bytesElem[T]()
+	v := make([]T, len(elems))
+	for i, elem := range elems {
+		var src interface{}
+		if elem != nil {
+			src = *elem
+			if hexStr, ok := strings.CutPrefix(*elem, `\x`); bytea && ok {
+				b, err := hex.DecodeString(hexStr)
+				if err != nil {
+					return nil, fmt.Errorf("element %d: %w", i, err)
+				}
+				src = b
+			}
+		}
+		if scanner, ok := any(&v[i]).(sql.Scanner); ok {
+			if err := scanner.Scan(src); err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			continue
+		}
+		if src == nil {
+			return nil, fmt.Errorf("element %d: NULL element in array of %T", i, v[i])
+		}
+		var n sql.Null[T]
+		if err := n.Scan(src); err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		v[i] = n.V
+	}
+	return v, nil
+}
+
+// bytesElem returns true if T is sent to databases as []byte,
+// so that formatPostgresArray writes its elements as bytea hex, such as `\x0102`.
+func bytesElem[T any]() bool {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+		return true
+	}
+	// types such as Bytes are only sent as []byte when valid
+	if rv.Kind() == reflect.Struct {
+		if valid := rv.FieldByName("Valid"); valid.IsValid() && valid.Kind() == reflect.Bool && valid.CanSet() {
+			valid.SetBool(true)
+		}
+	}
+	valuer, ok := any(v).(driver.Valuer)
+	if !ok {
+		return false
+	}
+	x, err := valuer.Value()
+	_, ok = x.([]byte)
+	return err == nil && ok
+}
+
+// parsePostgresArray splits a one-dimensional PostgreSQL array literal into its elements.
+// NULL elements are returned as nil.
+func parsePostgresArray(str string) ([]*string, error) {
+	if !strings.HasPrefix(str, "{") || !strings.HasSuffix(str, "}") {
+		return nil, fmt.Errorf("invalid array literal: %q", str)
+	}
+	inner := str[1 : len(str)-1]
+	if inner == "" {
+		return []*string{}, nil
+	}
+
+	var elems []*string
+	for i := 0; i <= len(inner); {
+		if i < len(inner) && inner[i] == '{' {
+			return nil, errors.New("multi-dimensional arrays are not supported")
+		}
+		var elem strings.Builder
+		quoted := i < len(inner) && inner[i] == '"'
+		if quoted {
+			i++
+			for ; i < len(inner) && inner[i] != '"'; i++ {
+				if inner[i] == '\\' {
+					i++
+					if i == len(inner) {
+						break
+					}
+				}
+				elem.WriteByte(inner[i])
+			}
+			if i == len(inner) {
+				return nil, fmt.Errorf("unterminated quoted element in array literal: %q", str)
+			}
+			i++ // closing quote
+		} else {
+			for ; i < len(inner) && inner[i] != ','; i++ {
+				if inner[i] == '"' || inner[i] == '{' || inner[i] == '}' {
+					return nil, fmt.Errorf("invalid array literal: %q", str)
+				}
+				elem.WriteByte(inner[i])
+			}
+		}
+		if i < len(inner) && inner[i] != ',' {
+			return nil, fmt.Errorf("invalid array literal: %q", str)
+		}
+		i++ // comma
+
+		s := elem.String()
+		if !quoted && strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("empty element in array literal: %q", str)
+		}
+		if !quoted && strings.EqualFold(s, "NULL") {
+			elems = append(elems, nil)
+		} else {
+			if !quoted {
+				s = strings.TrimSpace(s)
+			}
+			elems = append(elems, &s)
+		}
+	}
+	return elems, nil
+}
+
+// formatPostgresArray formats v as a one-dimensional PostgreSQL array literal.
+func formatPostgresArray[T any](v []T) (string, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		var elem driver.Value
+		var err error
+		if valuer, ok := any(v[i]).(driver.Valuer); ok {
+			elem, err = valuer.Value()
+		} else if rv := reflect.ValueOf(v[i]); rv.CanUint() {
+			// the default converter rejects uint64 values with the high bit set
+			elem = rv.Uint()
+		} else {
+			elem, err = driver.DefaultParameterConverter.ConvertValue(v[i])
+		}
+		if err != nil {
+			return "", fmt.Errorf("null: couldn't convert Slice element %d: %w", i, err)
+		}
+		switch x := elem.(type) {
+		case nil:
+			b.WriteString("NULL")
+		case string:
+			writePostgresArrayString(&b, x)
+		case []byte:
+			writePostgresArrayString(&b, `\x`+hex.EncodeToString(x))
+		case int64:
+			b.WriteString(strconv.FormatInt(x, 10))
+		case uint64:
+			b.WriteString(strconv.FormatUint(x, 10))
+		case float64:
+			b.WriteString(strconv.FormatFloat(x, 'g', -1, 64))
+		case bool:
+			b.WriteString(strconv.FormatBool(x))
+		case time.Time:
+			writePostgresArrayString(&b, x.Format(time.RFC3339Nano))
+		default:
+			return "", fmt.Errorf("null: couldn't convert Slice element %d: unsupported type %T", i, elem)
+		}
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+func writePostgresArrayString(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+}
@@ -0,0 +1,275 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var (
+	sliceJSON      = []byte(`["a","b c"]`)
+	emptySliceJSON = []byte(`[]`)
+)
+
+func TestSliceFrom(t *testing.T) {
+	s := SliceFrom([]string{"a", "b c"})
+	assertSlice(t, s, "SliceFrom()")
+
+	empty := SliceFrom[string](nil)
+	if !empty.Valid {
+		t.Error("SliceFrom(nil)", "is invalid, but should be valid")
+	}
+
+	v := []string{"a", "b c"}
+	p := SliceFromPtr(&v)
+	assertSlice(t, p, "SliceFromPtr()")
+
+	null := SliceFromPtr[string](nil)
+	assertNullSlice(t, null, "SliceFromPtr(nil)")
+}
+
+func TestUnmarshalSlice(t *testing.T) {
+	var s Slice[string]
+	err := json.Unmarshal(sliceJSON, &s)
+	maybePanic(err)
+	assertSlice(t, s, "slice json")
+
+	var empty Slice[string]
+	err = json.Unmarshal(emptySliceJSON, &empty)
+	maybePanic(err)
+	if !empty.Valid || empty.V == nil || len(empty.V) != 0 {
+		t.Errorf("bad empty slice json: %#v", empty)
+	}
+
+	var null Slice[string]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullSlice(t, null, "null json")
+
+	var badType Slice[string]
+	err = json.Unmarshal(stringJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullSlice(t, badType, "wrong type json")
+
+	var badElem Slice[int]
+	err = json.Unmarshal(sliceJSON, &badElem)
+	if err == nil {
+		t.Error("expected error")
+	}
+	if badElem.Valid {
+		t.Error("wrong element type json", "is valid, but should be invalid")
+	}
+
+	type patch struct {
+		Tags Slice[string] `json:"tags"`
+	}
+	var absent, cleared patch
+	maybePanic(json.Unmarshal([]byte(`{}`), &absent))
+	maybePanic(json.Unmarshal([]byte(`{"tags":[]}`), &cleared))
+	if absent.Tags.Valid || !cleared.Tags.Valid || cleared.Tags.Len() != 0 {
+		t.Errorf("absent and cleared lists should differ: %#v %#v", absent.Tags, cleared.Tags)
+	}
+}
+
+func TestMarshalSlice(t *testing.T) {
+	data, err := json.Marshal(SliceFrom([]string{"a", "b c"}))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(sliceJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(SliceFrom[string](nil))
+	maybePanic(err)
+	assertJSONEquals(t, data, "[]", "empty json marshal")
+
+	data, err = json.Marshal(NewSlice([]string{"a"}, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestSliceText(t *testing.T) {
+	var s Slice[string]
+	err := s.UnmarshalText(sliceJSON)
+	maybePanic(err)
+	assertSlice(t, s, "UnmarshalText() slice")
+
+	var blank Slice[string]
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullSlice(t, blank, "UnmarshalText() empty slice")
+
+	var invalid Slice[string]
+	err = invalid.UnmarshalText([]byte("a,b"))
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	data, err := s.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, string(sliceJSON), "non-empty text marshal")
+
+	data, err = blank.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestSliceScan(t *testing.T) {
+	var s Slice[string]
+	err := s.Scan(sliceJSON)
+	maybePanic(err)
+	assertSlice(t, s, "scanned json")
+
+	var pg Slice[string]
+	err = pg.Scan(`{a,"b c"}`)
+	maybePanic(err)
+	assertSlice(t, pg, "scanned array literal")
+
+	var escaped Slice[string]
+	err = escaped.Scan([]byte(`{"a\"b","c\\d","NULL",e f}`))
+	maybePanic(err)
+	want := []string{`a"b`, `c\d`, "NULL", "e f"}
+	if !escaped.Equal(SliceFrom(want)) {
+		t.Errorf("bad scanned escaped array literal: %#v", escaped.V)
+	}
+
+	var ints Slice[int64]
+	err = ints.Scan("{1,2,3}")
+	maybePanic(err)
+	if !ints.Equal(SliceFrom([]int64{1, 2, 3})) {
+		t.Errorf("bad scanned int array literal: %#v", ints.V)
+	}
+
+	var nullable Slice[Int]
+	err = nullable.Scan("{1,NULL}")
+	maybePanic(err)
+	if !nullable.Equal(SliceFrom([]Int{IntFrom(1), {}})) {
+		t.Errorf("bad scanned nullable array literal: %#v", nullable.V)
+	}
+
+	var empty Slice[string]
+	err = empty.Scan("{}")
+	maybePanic(err)
+	if !empty.Valid || empty.V == nil || len(empty.V) != 0 {
+		t.Errorf("bad scanned empty array literal: %#v", empty)
+	}
+
+	var null Slice[string]
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullSlice(t, null, "scanned null")
+
+	jsonNull := SliceFrom([]string{"a"})
+	err = jsonNull.Scan("null")
+	maybePanic(err)
+	assertNullSlice(t, jsonNull, "scanned JSON null")
+
+	for _, bad := range []interface{}{`{a,NULL}`, `{{a},{b}}`, `{"a}`, `{a,}`, `{a"b}`, `["a",1]`, "a,b", int64(1)} {
+		var invalid Slice[string]
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("Scan(%#v): expected error", bad)
+		}
+		assertNullSlice(t, invalid, "scanned invalid")
+	}
+
+	var badInts Slice[int64]
+	if err := badInts.Scan("{1,x}"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestSliceValue(t *testing.T) {
+	defer func() { DefaultSliceFormat = SliceJSON }()
+
+	s := SliceFrom([]string{"a", `b "c"`})
+	v, err := s.Value()
+	maybePanic(err)
+	if v != `["a","b \"c\""]` {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	DefaultSliceFormat = SlicePostgres
+	v, err = s.Value()
+	maybePanic(err)
+	if v != `{"a","b \"c\""}` {
+		t.Errorf("bad Postgres Value(): %#v", v)
+	}
+
+	var roundtrip Slice[string]
+	maybePanic(roundtrip.Scan(v))
+	if !roundtrip.Equal(s) {
+		t.Errorf("bad Postgres round trip: %#v", roundtrip.V)
+	}
+
+	mixed := SliceFrom([]Int{IntFrom(1), {}})
+	v, err = mixed.Value()
+	maybePanic(err)
+	if v != `{1,NULL}` {
+		t.Errorf("bad Postgres Value() with nulls: %#v", v)
+	}
+
+	raw := SliceFrom([][]byte{{1, 2}, {}})
+	v, err = raw.Value()
+	maybePanic(err)
+	if v != `{"\\x0102","\\x"}` {
+		t.Errorf("bad Postgres Value() with bytes: %#v", v)
+	}
+	var rawRoundtrip Slice[[]byte]
+	maybePanic(rawRoundtrip.Scan(v))
+	if !rawRoundtrip.Equal(raw) {
+		t.Errorf("bad Postgres round trip with bytes: %#v", rawRoundtrip.V)
+	}
+
+	bs := SliceFrom([]Bytes{BytesFrom([]byte{1, 2}), {}})
+	v, err = bs.Value()
+	maybePanic(err)
+	var bsRoundtrip Slice[Bytes]
+	maybePanic(bsRoundtrip.Scan(v))
+	if !bsRoundtrip.Equal(bs) {
+		t.Errorf("bad Postgres round trip with Bytes: %#v", bsRoundtrip.V)
+	}
+
+	null := NewSlice([]string{"a"}, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestSliceMisc(t *testing.T) {
+	s := NewSlice[string](nil, false)
+	if !s.IsZero() || s.Ptr() != nil || s.ValueOrZero() != nil || s.Len() != 0 {
+		t.Error("bad null Slice")
+	}
+	s.SetValid([]string{"a", "b c"})
+	assertSlice(t, s, "SetValid()")
+	if s.IsZero() || len(*s.Ptr()) != 2 || s.Len() != 2 {
+		t.Error("bad valid Slice")
+	}
+
+	if !SliceFrom[string](nil).Equal(SliceFrom([]string{})) {
+		t.Error("nil and empty Slices should be equal")
+	}
+	if SliceFrom[string](nil).Equal(NewSlice[string](nil, false)) {
+		t.Error("empty and null Slices should not be equal")
+	}
+	if SliceFrom([]string{"a"}).Equal(SliceFrom([]string{"b"})) {
+		t.Error("different Slices should not be equal")
+	}
+}
+
+func assertSlice(t *testing.T, s Slice[string], from string) {
+	t.Helper()
+	if len(s.V) != 2 || s.V[0] != "a" || s.V[1] != "b c" {
+		t.Errorf("bad %s slice: %#v\n", from, s.V)
+	}
+	if !s.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullSlice(t *testing.T, s Slice[string], from string) {
+	t.Helper()
+	if s.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
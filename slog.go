@@ -0,0 +1,411 @@
+package null
+
+import "log/slog"
+
+// The LogValue methods implement slog.LogValuer, so that values are logged as scalars
+// instead of groups of their fields. Numbers, bools, times, and durations are logged as such,
+// and other types as text like their String methods. Null values are logged as nil,
+// which slog.JSONHandler encodes as null.
+
+// nullLogValue is returned by the LogValue methods for null values.
+var nullLogValue = slog.AnyValue(nil)
+
+// LogValue implements slog.LogValuer, logging this Addr as text, or nil if it is null.
+func (a Addr) LogValue() slog.Value {
+	if !a.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(a.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Any as its value, or nil if it is null.
+func (a Any) LogValue() slog.Value {
+	if !a.Valid {
+		return nullLogValue
+	}
+	return slog.AnyValue(a.Any)
+}
+
+// LogValue implements slog.LogValuer, logging this Base64 as text, or nil if it is null.
+func (b Base64) LogValue() slog.Value {
+	if !b.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(b.String())
+}
+
+// LogValue implements slog.LogValuer, logging this BigInt as text, or nil if it is null.
+func (b BigInt) LogValue() slog.Value {
+	if !b.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(b.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Bool as a bool, or nil if it is null.
+func (b Bool) LogValue() slog.Value {
+	if !b.Valid {
+		return nullLogValue
+	}
+	return slog.BoolValue(b.Bool)
+}
+
+// LogValue implements slog.LogValuer, logging this Bytes as text, or nil if it is null.
+func (b Bytes) LogValue() slog.Value {
+	if !b.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(b.String())
+}
+
+// LogValue implements slog.LogValuer, logging this ByteSize as a number of bytes, or nil if it is null.
+func (b ByteSize) LogValue() slog.Value {
+	if !b.Valid {
+		return nullLogValue
+	}
+	return slog.Uint64Value(b.Size)
+}
+
+// LogValue implements slog.LogValuer, logging this Complex as text, or nil if it is null.
+func (c Complex) LogValue() slog.Value {
+	if !c.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(c.String())
+}
+
+// LogValue implements slog.LogValuer, logging this CountryCode as text, or nil if it is null.
+func (c CountryCode) LogValue() slog.Value {
+	if !c.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(c.String())
+}
+
+// LogValue implements slog.LogValuer, logging this CurrencyCode as text, or nil if it is null.
+func (c CurrencyCode) LogValue() slog.Value {
+	if !c.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(c.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Date as text, or nil if it is null.
+func (d Date) LogValue() slog.Value {
+	if !d.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(d.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Duration as a duration, or nil if it is null.
+func (d Duration) LogValue() slog.Value {
+	if !d.Valid {
+		return nullLogValue
+	}
+	return slog.DurationValue(d.Duration)
+}
+
+// LogValue implements slog.LogValuer, logging this Email as text, or nil if it is null.
+func (e Email) LogValue() slog.Value {
+	if !e.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(e.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Enum as its value, or nil if it is null.
+func (e Enum[T]) LogValue() slog.Value {
+	if !e.Valid {
+		return nullLogValue
+	}
+	return slog.AnyValue(e.V)
+}
+
+// LogValue implements slog.LogValuer, logging this FileMode as text, or nil if it is null.
+func (m FileMode) LogValue() slog.Value {
+	if !m.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(m.String())
+}
+
+// LogValue implements slog.LogValuer, logging this FixedHex as text, or nil if it is null.
+func (h FixedHex[A]) LogValue() slog.Value {
+	if !h.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(h.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Flags as text, or nil if it is null.
+func (f Flags[T]) LogValue() slog.Value {
+	if !f.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(f.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Float as a number, or nil if it is null.
+func (f Float) LogValue() slog.Value {
+	if !f.Valid {
+		return nullLogValue
+	}
+	return slog.Float64Value(f.Float64)
+}
+
+// LogValue implements slog.LogValuer, logging this HardwareAddr as text, or nil if it is null.
+func (h HardwareAddr) LogValue() slog.Value {
+	if !h.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(h.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Hex as text, or nil if it is null.
+func (h Hex) LogValue() slog.Value {
+	if !h.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(h.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Int as a number, or nil if it is null.
+func (i Int) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+	return slog.Int64Value(i.Int64)
+}
+
+// LogValue implements slog.LogValuer, logging this Int16 as a number, or nil if it is null.
+func (i Int16) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+	return slog.Int64Value(int64(i.Int16))
+}
+
+// LogValue implements slog.LogValuer, logging this Int32 as a number, or nil if it is null.
+func (i Int32) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+	return slog.Int64Value(int64(i.Int32))
+}
+
+// LogValue implements slog.LogValuer, logging this Int64Range as text, or nil if it is null.
+func (r Int64Range) LogValue() slog.Value {
+	if !r.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(r.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Int8 as a number, or nil if it is null.
+func (i Int8) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+	return slog.Int64Value(int64(i.Int8))
+}
+
+// LogValue implements slog.LogValuer, logging this JSON as text, or nil if it is null.
+func (j JSON) LogValue() slog.Value {
+	if !j.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(j.String())
+}
+
+// LogValue implements slog.LogValuer, logging this KSUID as text, or nil if it is null.
+func (k KSUID) LogValue() slog.Value {
+	if !k.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(k.String())
+}
+
+// LogValue implements slog.LogValuer, logging this LanguageTag as text, or nil if it is null.
+func (l LanguageTag) LogValue() slog.Value {
+	if !l.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(l.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Map as its value, or nil if it is null.
+func (m Map[K, V]) LogValue() slog.Value {
+	if !m.Valid {
+		return nullLogValue
+	}
+	return slog.AnyValue(m.V)
+}
+
+// LogValue implements slog.LogValuer, logging this Money as text, or nil if it is null.
+func (m Money) LogValue() slog.Value {
+	if !m.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(m.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Optional's value, or nil if it is unset or null.
+func (o Optional[T]) LogValue() slog.Value {
+	if !o.Present || !o.Valid {
+		return nullLogValue
+	}
+	return slog.AnyValue(o.V)
+}
+
+// LogValue implements slog.LogValuer, logging this Percent as a ratio, or nil if it is null.
+func (p Percent) LogValue() slog.Value {
+	if !p.Valid {
+		return nullLogValue
+	}
+	return slog.Float64Value(p.Float64)
+}
+
+// LogValue implements slog.LogValuer, logging this Point as text, or nil if it is null.
+func (p Point) LogValue() slog.Value {
+	if !p.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(p.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Prefix as text, or nil if it is null.
+func (p Prefix) LogValue() slog.Value {
+	if !p.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(p.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Regexp as text, or nil if it is null.
+func (r Regexp) LogValue() slog.Value {
+	if !r.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(r.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Semver as text, or nil if it is null.
+func (s Semver) LogValue() slog.Value {
+	if !s.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(s.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Slice as its value, or nil if it is null.
+func (s Slice[T]) LogValue() slog.Value {
+	if !s.Valid {
+		return nullLogValue
+	}
+	return slog.AnyValue(s.V)
+}
+
+// LogValue implements slog.LogValuer, logging this String as a string, or nil if it is null.
+func (s String) LogValue() slog.Value {
+	if !s.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(s.String)
+}
+
+// LogValue implements slog.LogValuer, logging this Time as a time, or nil if it is null.
+func (t Time) LogValue() slog.Value {
+	if !t.Valid {
+		return nullLogValue
+	}
+	return slog.TimeValue(t.Time)
+}
+
+// LogValue implements slog.LogValuer, logging this TimeOfDay as text, or nil if it is null.
+func (t TimeOfDay) LogValue() slog.Value {
+	if !t.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(t.String())
+}
+
+// LogValue implements slog.LogValuer, logging this TimeRange as text, or nil if it is null.
+func (r TimeRange) LogValue() slog.Value {
+	if !r.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(r.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Uint as a number, or nil if it is null.
+func (i Uint) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+	return slog.Uint64Value(i.Uint64)
+}
+
+// LogValue implements slog.LogValuer, logging this Uint16 as a number, or nil if it is null.
+func (i Uint16) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+	return slog.Uint64Value(uint64(i.Uint16))
+}
+
+// LogValue implements slog.LogValuer, logging this Uint32 as a number, or nil if it is null.
+func (i Uint32) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+	return slog.Uint64Value(uint64(i.Uint32))
+}
+
+// LogValue implements slog.LogValuer, logging this Uint8 as a number, or nil if it is null.
+func (i Uint8) LogValue() slog.Value {
+	if !i.Valid {
+		return nullLogValue
+	}
+	return slog.Uint64Value(uint64(i.Uint8))
+}
+
+// LogValue implements slog.LogValuer, logging this ULID as text, or nil if it is null.
+func (u ULID) LogValue() slog.Value {
+	if !u.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(u.String())
+}
+
+// LogValue implements slog.LogValuer, logging this UnixMillis as a time, or nil if it is null.
+func (u UnixMillis) LogValue() slog.Value {
+	if !u.Valid {
+		return nullLogValue
+	}
+	return slog.TimeValue(u.Time)
+}
+
+// LogValue implements slog.LogValuer, logging this UnixSeconds as a time, or nil if it is null.
+func (u UnixSeconds) LogValue() slog.Value {
+	if !u.Valid {
+		return nullLogValue
+	}
+	return slog.TimeValue(u.Time)
+}
+
+// LogValue implements slog.LogValuer, logging this URL as text, or nil if it is null.
+func (u URL) LogValue() slog.Value {
+	if !u.Valid {
+		return nullLogValue
+	}
+	return slog.StringValue(u.String())
+}
+
+// LogValue implements slog.LogValuer, logging this Value as its value, or nil if it is null.
+func (v Value[T]) LogValue() slog.Value {
+	if !v.Valid {
+		return nullLogValue
+	}
+	return slog.AnyValue(v.V)
+}
@@ -0,0 +1,64 @@
+package null
+
+import (
+	"bytes"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("",
+		"int", IntFrom(42),
+		"uint", UintFrom(7),
+		"null", NewUint(7, false),
+		"float", FloatFrom(1.5),
+		"bool", BoolFrom(true),
+		"string", StringFrom("hi"),
+		"duration", DurationFrom(time.Second),
+		"size", ByteSizeFrom(1024),
+		"date", DateFrom(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)),
+		"slice", SliceFrom([]int{1, 2}),
+		"optional", Optional[int]{},
+	)
+	want := `{"int":42,"uint":7,"null":null,"float":1.5,"bool":true,"string":"hi","duration":1000000000,"size":1024,"date":"2024-03-01","slice":[1,2],"optional":null}`
+	if got := strings.TrimSpace(buf.String()); got != want {
+		t.Errorf("bad log output:\n got: %s\nwant: %s", got, want)
+	}
+
+	buf.Reset()
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("msg", "n", NewInt(0, false), "t", TimeFrom(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)))
+	if got := buf.String(); !strings.Contains(got, " n=<nil> t=2024-03-01T12:00:00.000Z") {
+		t.Errorf("bad text log output: %s", got)
+	}
+}
+
+func TestAllTypesLogValue(t *testing.T) {
+	example := reflect.ValueOf(exampleAllTypes())
+	typ := example.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		valid, ok := example.Field(i).Interface().(slog.LogValuer)
+		if !ok {
+			t.Errorf("%s: doesn't implement slog.LogValuer", f.Name)
+			continue
+		}
+		if v := valid.LogValue(); v.Kind() == slog.KindAny && v.Any() == nil {
+			t.Errorf("%s: valid value logged as nil", f.Name)
+		}
+		if v := reflect.Zero(f.Type).Interface().(slog.LogValuer).LogValue(); v.Kind() != slog.KindAny || v.Any() != nil {
+			t.Errorf("%s: null value should be logged as nil, got %v", f.Name, v)
+		}
+	}
+}
@@ -0,0 +1,833 @@
+//go:build spanner
+
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"cloud.google.com/go/spanner"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// This file implements spanner.Encoder and spanner.Decoder from the Cloud Spanner client,
+// so that this package's types can be used in Spanner rows, mutations, and statement parameters.
+// It also converts between this package's types and Spanner's NullInt64, NullString, and so on.
+// It is only built with the spanner build tag, so that the null package stays free of dependencies:
+//
+//	go build -tags spanner
+//
+// Values are encoded as the Spanner equivalent of what would be sent to an SQL database (see Value),
+// except that Bytes, Hex, and Base64 are always BYTES, Date is DATE, JSON is JSON, and BigInt is NUMERIC.
+// Null values are encoded as a NULL of the matching Spanner type.
+// Types whose Spanner type depends on their contents, such as Any, Enum, Map, and Slice, encode an untyped NULL.
+// Spanner arrays are not supported.
+
+// encodeSpanner returns the driver value of v, or null if v is null.
+func encodeSpanner(v driver.Valuer, null interface{}) (interface{}, error) {
+	val, err := v.Value()
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't encode Spanner value: %w", err)
+	}
+	if val == nil {
+		return null, nil
+	}
+	return val, nil
+}
+
+// spannerValue converts a value passed to spanner.Decoder into the equivalent driver value.
+// Spanner passes NULL as a typed nil pointer.
+func spannerValue(input interface{}) (interface{}, error) {
+	switch v := input.(type) {
+	case *string:
+		if v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	case *float64:
+		if v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	case *bool:
+		if v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	case *structpb.ListValue:
+		return nil, errors.New("arrays are not supported")
+	}
+	return input, nil
+}
+
+// decodeSpanner converts a Spanner value into the equivalent driver value and scans it into s.
+// INT64, NUMERIC, DATE, TIMESTAMP, and JSON values are passed to Scan as strings.
+func decodeSpanner(s sql.Scanner, input interface{}) error {
+	v, err := spannerValue(input)
+	if err != nil {
+		return fmt.Errorf("null: couldn't decode Spanner value: %w", err)
+	}
+	return s.Scan(v)
+}
+
+// decodeSpannerBytes is like decodeSpanner, but decodes strings as base64, which is how Spanner sends BYTES.
+func decodeSpannerBytes(s sql.Scanner, input interface{}) error {
+	v, err := spannerValue(input)
+	if err != nil {
+		return fmt.Errorf("null: couldn't decode Spanner value: %w", err)
+	}
+	if str, ok := v.(string); ok {
+		b, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return fmt.Errorf("null: couldn't decode Spanner BYTES: %w", err)
+		}
+		v = b
+	}
+	return s.Scan(v)
+}
+
+// decodeSpannerTime is like decodeSpanner, but parses RFC 3339 strings, which is how Spanner sends TIMESTAMP.
+func decodeSpannerTime(s sql.Scanner, input interface{}) error {
+	v, err := spannerValue(input)
+	if err != nil {
+		return fmt.Errorf("null: couldn't decode Spanner value: %w", err)
+	}
+	if str, ok := v.(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, str); err == nil {
+			v = t
+		}
+	}
+	return s.Scan(v)
+}
+
+// decodeSpannerInt is like decodeSpanner, but parses integer strings, which is how Spanner sends INT64,
+// for types that would otherwise scan strings in another format.
+func decodeSpannerInt(s sql.Scanner, input interface{}) error {
+	v, err := spannerValue(input)
+	if err != nil {
+		return fmt.Errorf("null: couldn't decode Spanner value: %w", err)
+	}
+	if str, ok := v.(string); ok {
+		if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+			v = n
+		}
+	}
+	return s.Scan(v)
+}
+
+// decodeSpannerNumeric is like decodeSpanner, but converts NUMERIC strings with only zeros after the decimal point,
+// such as "5.000000000", into integer strings.
+func decodeSpannerNumeric(s sql.Scanner, input interface{}) error {
+	v, err := spannerValue(input)
+	if err != nil {
+		return fmt.Errorf("null: couldn't decode Spanner value: %w", err)
+	}
+	if str, ok := v.(string); ok {
+		if r, ok := new(big.Rat).SetString(str); ok && r.IsInt() {
+			v = r.Num().String()
+		}
+	}
+	return s.Scan(v)
+}
+
+// BoolFromSpanner creates a new Bool from a spanner.NullBool.
+func BoolFromSpanner(n spanner.NullBool) Bool {
+	return NewBool(n.Bool, n.Valid)
+}
+
+// ToSpanner returns this Bool as a spanner.NullBool.
+func (b Bool) ToSpanner() spanner.NullBool {
+	return spanner.NullBool{Bool: b.Bool, Valid: b.Valid}
+}
+
+// DateFromSpanner creates a new Date from a spanner.NullDate.
+func DateFromSpanner(n spanner.NullDate) Date {
+	return NewDate(n.Date.Year, n.Date.Month, n.Date.Day, n.Valid)
+}
+
+// ToSpanner returns this Date as a spanner.NullDate.
+func (d Date) ToSpanner() spanner.NullDate {
+	if !d.Valid {
+		return spanner.NullDate{}
+	}
+	return spanner.NullDate{Date: civil.Date{Year: d.Year, Month: d.Month, Day: d.Day}, Valid: true}
+}
+
+// FloatFromSpanner creates a new Float from a spanner.NullFloat64.
+func FloatFromSpanner(n spanner.NullFloat64) Float {
+	return NewFloat(n.Float64, n.Valid)
+}
+
+// ToSpanner returns this Float as a spanner.NullFloat64.
+func (f Float) ToSpanner() spanner.NullFloat64 {
+	return spanner.NullFloat64{Float64: f.Float64, Valid: f.Valid}
+}
+
+// IntFromSpanner creates a new Int from a spanner.NullInt64.
+func IntFromSpanner(n spanner.NullInt64) Int {
+	return NewInt(n.Int64, n.Valid)
+}
+
+// ToSpanner returns this Int as a spanner.NullInt64.
+func (i Int) ToSpanner() spanner.NullInt64 {
+	return spanner.NullInt64{Int64: i.Int64, Valid: i.Valid}
+}
+
+// JSONFromSpanner creates a new JSON from a spanner.NullJSON, marshaling its value as JSON.
+func JSONFromSpanner(n spanner.NullJSON) (JSON, error) {
+	if !n.Valid {
+		return JSON{}, nil
+	}
+	data, err := json.Marshal(n.Value)
+	if err != nil {
+		return JSON{}, fmt.Errorf("null: couldn't marshal Spanner JSON: %w", err)
+	}
+	return JSONFrom(data), nil
+}
+
+// ToSpanner returns this JSON as a spanner.NullJSON holding a json.RawMessage.
+func (j JSON) ToSpanner() spanner.NullJSON {
+	if !j.Valid {
+		return spanner.NullJSON{}
+	}
+	return spanner.NullJSON{Value: j.JSON, Valid: true}
+}
+
+// StringFromSpanner creates a new String from a spanner.NullString.
+func StringFromSpanner(n spanner.NullString) String {
+	return NewString(n.StringVal, n.Valid)
+}
+
+// ToSpanner returns this String as a spanner.NullString.
+func (s String) ToSpanner() spanner.NullString {
+	return spanner.NullString{StringVal: s.String, Valid: s.Valid}
+}
+
+// TimeFromSpanner creates a new Time from a spanner.NullTime.
+func TimeFromSpanner(n spanner.NullTime) Time {
+	return NewTime(n.Time, n.Valid)
+}
+
+// ToSpanner returns this Time as a spanner.NullTime.
+func (t Time) ToSpanner() spanner.NullTime {
+	return spanner.NullTime{Time: t.Time, Valid: t.Valid}
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Addr is null.
+func (a Addr) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(a, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Addr can be scanned from.
+func (a *Addr) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(a, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Any is null.
+func (a Any) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(a, nil)
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Any can be scanned from.
+func (a *Any) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(a, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Base64 is null.
+func (b Base64) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(b, []byte(nil))
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Base64 can be scanned from.
+func (b *Base64) DecodeSpanner(input interface{}) error {
+	return decodeSpannerBytes(b, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode a NUMERIC, or null if this BigInt is null.
+func (b BigInt) EncodeSpanner() (interface{}, error) {
+	if !b.Valid {
+		return (*big.Rat)(nil), nil
+	}
+	return new(big.Rat).SetInt(b.BigInt), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this BigInt can be scanned from.
+func (b *BigInt) DecodeSpanner(input interface{}) error {
+	return decodeSpannerNumeric(b, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Bool is null.
+func (b Bool) EncodeSpanner() (interface{}, error) {
+	return b.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Bool can be scanned from.
+func (b *Bool) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(b, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this ByteSize is null.
+func (b ByteSize) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(b, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this ByteSize can be scanned from.
+func (b *ByteSize) DecodeSpanner(input interface{}) error {
+	return decodeSpannerInt(b, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Bytes is null.
+func (b Bytes) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(b, []byte(nil))
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Bytes can be scanned from.
+func (b *Bytes) DecodeSpanner(input interface{}) error {
+	return decodeSpannerBytes(b, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Complex is null.
+func (c Complex) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(c, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Complex can be scanned from.
+func (c *Complex) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(c, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Date is null.
+func (d Date) EncodeSpanner() (interface{}, error) {
+	return d.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Date can be scanned from.
+func (d *Date) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(d, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Duration is null.
+func (d Duration) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(d, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Duration can be scanned from.
+func (d *Duration) DecodeSpanner(input interface{}) error {
+	return decodeSpannerInt(d, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Email is null.
+func (e Email) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(e, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Email can be scanned from.
+func (e *Email) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(e, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Enum is null.
+func (e Enum[T]) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(e, nil)
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Enum can be scanned from.
+func (e *Enum[T]) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(e, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this FileMode is null.
+func (m FileMode) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(m, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this FileMode can be scanned from.
+func (m *FileMode) DecodeSpanner(input interface{}) error {
+	return decodeSpannerInt(m, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Flags is null.
+func (f Flags[T]) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(f, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Flags can be scanned from.
+func (f *Flags[T]) DecodeSpanner(input interface{}) error {
+	return decodeSpannerInt(f, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Float is null.
+func (f Float) EncodeSpanner() (interface{}, error) {
+	return f.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Float can be scanned from.
+func (f *Float) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(f, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this HardwareAddr is null.
+func (h HardwareAddr) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(h, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this HardwareAddr can be scanned from.
+func (h *HardwareAddr) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(h, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Hex is null.
+func (h Hex) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(h, []byte(nil))
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Hex can be scanned from.
+func (h *Hex) DecodeSpanner(input interface{}) error {
+	return decodeSpannerBytes(h, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this FixedHex is null.
+func (h FixedHex[A]) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(h, []byte(nil))
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this FixedHex can be scanned from.
+func (h *FixedHex[A]) DecodeSpanner(input interface{}) error {
+	return decodeSpannerBytes(h, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Int is null.
+func (i Int) EncodeSpanner() (interface{}, error) {
+	return i.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Int can be scanned from.
+func (i *Int) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(i, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Int16 is null.
+func (i Int16) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(i, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Int16 can be scanned from.
+func (i *Int16) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(i, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Int32 is null.
+func (i Int32) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(i, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Int32 can be scanned from.
+func (i *Int32) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(i, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Int8 is null.
+func (i Int8) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(i, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Int8 can be scanned from.
+func (i *Int8) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(i, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this CountryCode is null.
+func (c CountryCode) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(c, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this CountryCode can be scanned from.
+func (c *CountryCode) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(c, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this CurrencyCode is null.
+func (c CurrencyCode) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(c, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this CurrencyCode can be scanned from.
+func (c *CurrencyCode) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(c, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this JSON is null.
+func (j JSON) EncodeSpanner() (interface{}, error) {
+	return j.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this JSON can be scanned from.
+func (j *JSON) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(j, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this KSUID is null.
+func (k KSUID) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(k, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this KSUID can be scanned from.
+func (k *KSUID) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(k, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this LanguageTag is null.
+func (l LanguageTag) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(l, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this LanguageTag can be scanned from.
+func (l *LanguageTag) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(l, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Map is null.
+func (m Map[K, V]) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(m, nil)
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Map can be scanned from.
+func (m *Map[K, V]) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(m, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Money is null.
+func (m Money) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(m, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Money can be scanned from.
+func (m *Money) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(m, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Optional is null.
+func (o Optional[T]) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(o, nil)
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Optional can be scanned from.
+func (o *Optional[T]) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(o, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Percent is null.
+func (p Percent) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(p, spanner.NullFloat64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Percent can be scanned from.
+func (p *Percent) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(p, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Point is null.
+func (p Point) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(p, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Point can be scanned from.
+func (p *Point) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(p, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Prefix is null.
+func (p Prefix) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(p, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Prefix can be scanned from.
+func (p *Prefix) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(p, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Int64Range is null.
+func (r Int64Range) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(r, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Int64Range can be scanned from.
+func (r *Int64Range) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(r, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this TimeRange is null.
+func (r TimeRange) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(r, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this TimeRange can be scanned from.
+func (r *TimeRange) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(r, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Regexp is null.
+func (r Regexp) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(r, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Regexp can be scanned from.
+func (r *Regexp) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(r, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Semver is null.
+func (s Semver) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(s, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Semver can be scanned from.
+func (s *Semver) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(s, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Slice is null.
+func (s Slice[T]) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(s, nil)
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Slice can be scanned from.
+func (s *Slice[T]) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(s, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this String is null.
+func (s String) EncodeSpanner() (interface{}, error) {
+	return s.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this String can be scanned from.
+func (s *String) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(s, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Time is null.
+func (t Time) EncodeSpanner() (interface{}, error) {
+	return t.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Time can be scanned from.
+func (t *Time) DecodeSpanner(input interface{}) error {
+	return decodeSpannerTime(t, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this TimeOfDay is null.
+func (t TimeOfDay) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(t, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this TimeOfDay can be scanned from.
+func (t *TimeOfDay) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(t, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Uint is null, and returns an error if it doesn't fit in an INT64.
+// Unlike Value, it doesn't depend on DefaultUintValueFormat.
+func (i Uint) EncodeSpanner() (interface{}, error) {
+	if !i.Valid {
+		return spanner.NullInt64{}, nil
+	}
+	if i.Uint64 > math.MaxInt64 {
+		return nil, fmt.Errorf("null: Uint value %d overflows Spanner INT64", i.Uint64)
+	}
+	return int64(i.Uint64), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Uint can be scanned from.
+func (i *Uint) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(i, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Uint16 is null.
+func (i Uint16) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(i, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Uint16 can be scanned from.
+func (i *Uint16) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(i, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Uint32 is null.
+func (i Uint32) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(i, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Uint32 can be scanned from.
+func (i *Uint32) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(i, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Uint8 is null.
+func (i Uint8) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(i, spanner.NullInt64{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Uint8 can be scanned from.
+func (i *Uint8) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(i, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this ULID is null.
+func (u ULID) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(u, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this ULID can be scanned from.
+func (u *ULID) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(u, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this UnixSeconds is null.
+func (u UnixSeconds) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(u, spanner.NullTime{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this UnixSeconds can be scanned from.
+func (u *UnixSeconds) DecodeSpanner(input interface{}) error {
+	return decodeSpannerTime(u, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this UnixMillis is null.
+func (u UnixMillis) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(u, spanner.NullTime{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this UnixMillis can be scanned from.
+func (u *UnixMillis) DecodeSpanner(input interface{}) error {
+	return decodeSpannerTime(u, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this URL is null.
+func (u URL) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(u, spanner.NullString{})
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this URL can be scanned from.
+func (u *URL) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(u, input)
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Value is null.
+func (v Value[T]) EncodeSpanner() (interface{}, error) {
+	return encodeSpanner(v, nil)
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Value can be scanned from.
+func (v *Value[T]) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(v, input)
+}
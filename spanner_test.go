@@ -0,0 +1,187 @@
+//go:build spanner
+
+package null
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"cloud.google.com/go/spanner"
+	sppb "cloud.google.com/go/spanner/apiv1/spannerpb"
+)
+
+// spannerRow encodes every field of v as a column of a Spanner row, the same way the client encodes parameters.
+func spannerRow(v interface{}) (*spanner.Row, error) {
+	rv := reflect.ValueOf(v)
+	names := make([]string, rv.NumField())
+	values := make([]interface{}, rv.NumField())
+	for i := range names {
+		names[i] = rv.Type().Field(i).Name
+		values[i] = rv.Field(i).Interface()
+	}
+	return spanner.NewRow(names, values)
+}
+
+func TestSpannerRoundTrip(t *testing.T) {
+	in := exampleAllTypes()
+	row, err := spannerRow(in)
+	maybePanic(err)
+	var out allTypes
+	err = row.ToStruct(&out)
+	maybePanic(err)
+
+	assertAllTypesEqual(t, out, in, "spanner round trip")
+}
+
+func TestSpannerTypes(t *testing.T) {
+	in := exampleAllTypes()
+	row, err := spannerRow(in)
+	maybePanic(err)
+	for name, want := range map[string]sppb.TypeCode{
+		"Base64": sppb.TypeCode_BYTES,
+		"BigInt": sppb.TypeCode_NUMERIC,
+		"Date":   sppb.TypeCode_DATE,
+		"Hex":    sppb.TypeCode_BYTES,
+		"Int8":   sppb.TypeCode_INT64,
+		"JSON":   sppb.TypeCode_JSON,
+		"Time":   sppb.TypeCode_TIMESTAMP,
+		"Uint":   sppb.TypeCode_INT64,
+		"URL":    sppb.TypeCode_STRING,
+	} {
+		i, err := row.ColumnIndex(name)
+		maybePanic(err)
+		if got := row.ColumnType(i).GetCode(); got != want {
+			t.Errorf("%s: encoded as %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestSpannerNull(t *testing.T) {
+	rv := reflect.ValueOf(exampleAllTypes())
+	for i := 0; i < rv.NumField(); i++ {
+		name := rv.Type().Field(i).Name
+		ptr := reflect.New(rv.Field(i).Type())
+		ptr.Elem().Set(rv.Field(i))
+		if _, err := ptr.Elem().Interface().(spanner.Encoder).EncodeSpanner(); err != nil {
+			t.Errorf("%s: EncodeSpanner: %v", name, err)
+		}
+		// Spanner passes NULL as a typed nil pointer
+		if err := ptr.Interface().(spanner.Decoder).DecodeSpanner((*string)(nil)); err != nil {
+			t.Errorf("%s: DecodeSpanner: %v", name, err)
+		}
+		if ptr.Elem().FieldByName("Valid").Bool() {
+			t.Errorf("%s: NULL should decode to null", name)
+		}
+	}
+
+	// typed NULL round trips
+	in := struct {
+		Int    Int
+		Uint   Uint
+		Bytes  Bytes
+		String String
+		Time   Time
+		Date   Date
+		JSON   JSON
+		BigInt BigInt
+	}{}
+	row, err := spannerRow(in)
+	maybePanic(err)
+	out := in
+	out.Int = IntFrom(1)
+	out.Bytes = BytesFrom([]byte("x"))
+	err = row.ToStruct(&out)
+	maybePanic(err)
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("bad null round trip: %#v", out)
+	}
+}
+
+func TestSpannerDecode(t *testing.T) {
+	var i Int
+	err := i.DecodeSpanner("9007199254740993")
+	maybePanic(err)
+	if !i.Equal(IntFrom(9007199254740993)) {
+		t.Errorf("bad Int from INT64 string: %v", i)
+	}
+
+	var b Bytes
+	err = b.DecodeSpanner("aGVsbG8=")
+	maybePanic(err)
+	if !b.Equal(BytesFrom([]byte("hello"))) {
+		t.Errorf("bad Bytes from base64: %v", b)
+	}
+
+	var d Duration
+	err = d.DecodeSpanner("1000")
+	maybePanic(err)
+	if !d.Equal(DurationFrom(1000)) {
+		t.Errorf("bad Duration from INT64 string: %v", d)
+	}
+
+	var u UnixSeconds
+	err = u.DecodeSpanner("2024-05-06T07:08:09Z")
+	maybePanic(err)
+	if u.Time.Unix() != 1714979289 {
+		t.Errorf("bad UnixSeconds from TIMESTAMP: %v", u)
+	}
+
+	var s Slice[int]
+	if err := s.DecodeSpanner(spannerList()); err == nil {
+		t.Error("expected error for Spanner array")
+	}
+
+	if _, err := UintFrom(math.MaxUint64).EncodeSpanner(); err == nil {
+		t.Error("expected error for Uint overflowing INT64")
+	}
+}
+
+// spannerList returns a Spanner ARRAY value, as passed to spanner.Decoder.
+func spannerList() interface{} {
+	row, err := spanner.NewRow([]string{"a"}, []interface{}{[]int64{1, 2}})
+	maybePanic(err)
+	return row.ColumnValue(0).GetListValue()
+}
+
+func TestSpannerConversions(t *testing.T) {
+	if got := IntFromSpanner(spanner.NullInt64{Int64: 5, Valid: true}); !got.Equal(IntFrom(5)) {
+		t.Errorf("bad IntFromSpanner: %v", got)
+	}
+	if got := IntFrom(5).ToSpanner(); got != (spanner.NullInt64{Int64: 5, Valid: true}) {
+		t.Errorf("bad Int.ToSpanner: %v", got)
+	}
+	if got := StringFromSpanner(spanner.NullString{}); got.Valid {
+		t.Errorf("bad StringFromSpanner: %v", got)
+	}
+	if got := StringFrom("hi").ToSpanner(); got != (spanner.NullString{StringVal: "hi", Valid: true}) {
+		t.Errorf("bad String.ToSpanner: %v", got)
+	}
+	now := exampleAllTypes().Time.Time
+	if got := TimeFromSpanner(spanner.NullTime{Time: now, Valid: true}); !got.Equal(TimeFrom(now)) {
+		t.Errorf("bad TimeFromSpanner: %v", got)
+	}
+	if got := FloatFrom(1.5).ToSpanner(); got != (spanner.NullFloat64{Float64: 1.5, Valid: true}) {
+		t.Errorf("bad Float.ToSpanner: %v", got)
+	}
+	if got := BoolFromSpanner(spanner.NullBool{Bool: true, Valid: true}); !got.Equal(BoolFrom(true)) {
+		t.Errorf("bad BoolFromSpanner: %v", got)
+	}
+	date := civil.Date{Year: 2024, Month: 5, Day: 6}
+	if got := DateFromSpanner(spanner.NullDate{Date: date, Valid: true}); !got.Equal(NewDate(2024, 5, 6, true)) {
+		t.Errorf("bad DateFromSpanner: %v", got)
+	}
+	if got := NewDate(2024, 5, 6, true).ToSpanner(); got != (spanner.NullDate{Date: date, Valid: true}) {
+		t.Errorf("bad Date.ToSpanner: %v", got)
+	}
+
+	j, err := JSONFromSpanner(spanner.NullJSON{Value: map[string]int{"a": 1}, Valid: true})
+	maybePanic(err)
+	if !j.Equal(JSONFrom([]byte(`{"a":1}`))) {
+		t.Errorf("bad JSONFromSpanner: %s", j.JSON)
+	}
+	if got := JSONFrom([]byte(`{"a":1}`)).ToSpanner(); !got.Valid || got.String() != `{"a":1}` {
+		t.Errorf("bad JSON.ToSpanner: %v", got)
+	}
+}
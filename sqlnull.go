@@ -0,0 +1,502 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io/fs"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// The FromSQLNull constructors and ToSQLNull methods convert between this package's types
+// and the standard library's generic sql.Null[T], for code that uses both.
+// Types made of more than one value, such as Money, Point, and the ranges, have no sql.Null[T] equivalent.
+
+// sqlNull returns v as a sql.Null[T], with the zero value if it is not valid.
+func sqlNull[T any](v T, valid bool) sql.Null[T] {
+	if !valid {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{V: v, Valid: true}
+}
+
+// AddrFromSQLNull creates a new Addr from a sql.Null[netip.Addr].
+func AddrFromSQLNull(n sql.Null[netip.Addr]) Addr {
+	return NewAddr(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Addr as a sql.Null[netip.Addr].
+func (a Addr) ToSQLNull() sql.Null[netip.Addr] {
+	return sqlNull(a.Addr, a.Valid)
+}
+
+// AnyFromSQLNull creates a new Any from a sql.Null[interface{}].
+func AnyFromSQLNull(n sql.Null[interface{}]) Any {
+	return NewAny(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Any as a sql.Null[interface{}].
+func (a Any) ToSQLNull() sql.Null[interface{}] {
+	return sqlNull(a.Any, a.Valid)
+}
+
+// ArrayFromSQLNull creates a new Array from a sql.Null[[]T].
+func ArrayFromSQLNull[T any](n sql.Null[[]T]) Array[T] {
+	return NewArray(n.V, n.Valid)
+}
+
+// Base64FromSQLNull creates a new Base64 from a sql.Null[[]byte].
+func Base64FromSQLNull(n sql.Null[[]byte]) Base64 {
+	return NewBase64(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Base64 as a sql.Null[[]byte].
+func (b Base64) ToSQLNull() sql.Null[[]byte] {
+	return sqlNull(b.Bytes, b.Valid)
+}
+
+// BigIntFromSQLNull creates a new BigInt from a sql.Null[*big.Int].
+func BigIntFromSQLNull(n sql.Null[*big.Int]) BigInt {
+	return NewBigInt(n.V, n.Valid)
+}
+
+// ToSQLNull returns this BigInt as a sql.Null[*big.Int].
+func (b BigInt) ToSQLNull() sql.Null[*big.Int] {
+	return sqlNull(b.BigInt, b.Valid)
+}
+
+// BoolFromSQLNull creates a new Bool from a sql.Null[bool].
+func BoolFromSQLNull(n sql.Null[bool]) Bool {
+	return NewBool(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Bool as a sql.Null[bool].
+func (b Bool) ToSQLNull() sql.Null[bool] {
+	return sqlNull(b.Bool, b.Valid)
+}
+
+// ByteSizeFromSQLNull creates a new ByteSize from a sql.Null[uint64].
+func ByteSizeFromSQLNull(n sql.Null[uint64]) ByteSize {
+	return NewByteSize(n.V, n.Valid)
+}
+
+// ToSQLNull returns this ByteSize as a sql.Null[uint64].
+func (b ByteSize) ToSQLNull() sql.Null[uint64] {
+	return sqlNull(b.Size, b.Valid)
+}
+
+// BytesFromSQLNull creates a new Bytes from a sql.Null[[]byte].
+func BytesFromSQLNull(n sql.Null[[]byte]) Bytes {
+	return NewBytes(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Bytes as a sql.Null[[]byte].
+func (b Bytes) ToSQLNull() sql.Null[[]byte] {
+	return sqlNull(b.Bytes, b.Valid)
+}
+
+// ComplexFromSQLNull creates a new Complex from a sql.Null[complex128].
+func ComplexFromSQLNull(n sql.Null[complex128]) Complex {
+	return NewComplex(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Complex as a sql.Null[complex128].
+func (c Complex) ToSQLNull() sql.Null[complex128] {
+	return sqlNull(c.Complex, c.Valid)
+}
+
+// DateFromSQLNull creates a new Date from a sql.Null[time.Time].
+// The date is taken from the time in its own location.
+func DateFromSQLNull(n sql.Null[time.Time]) Date {
+	if !n.Valid {
+		return Date{}
+	}
+	return DateFrom(n.V)
+}
+
+// ToSQLNull returns this Date as a sql.Null[time.Time].
+// The time is midnight UTC.
+func (d Date) ToSQLNull() sql.Null[time.Time] {
+	return sqlNull(d.In(time.UTC), d.Valid)
+}
+
+// DurationFromSQLNull creates a new Duration from a sql.Null[time.Duration].
+func DurationFromSQLNull(n sql.Null[time.Duration]) Duration {
+	return NewDuration(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Duration as a sql.Null[time.Duration].
+func (d Duration) ToSQLNull() sql.Null[time.Duration] {
+	return sqlNull(d.Duration, d.Valid)
+}
+
+// EmailFromSQLNull creates a new Email from a sql.Null[string].
+func EmailFromSQLNull(n sql.Null[string]) Email {
+	return NewEmail(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Email as a sql.Null[string].
+func (e Email) ToSQLNull() sql.Null[string] {
+	return sqlNull(e.Address, e.Valid)
+}
+
+// EnumFromSQLNull creates a new Enum from a sql.Null[T].
+func EnumFromSQLNull[T ~string | ~int](n sql.Null[T]) Enum[T] {
+	return NewEnum(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Enum as a sql.Null[T].
+func (e Enum[T]) ToSQLNull() sql.Null[T] {
+	return sqlNull(e.V, e.Valid)
+}
+
+// FileModeFromSQLNull creates a new FileMode from a sql.Null[fs.FileMode].
+func FileModeFromSQLNull(n sql.Null[fs.FileMode]) FileMode {
+	return NewFileMode(n.V, n.Valid)
+}
+
+// ToSQLNull returns this FileMode as a sql.Null[fs.FileMode].
+func (m FileMode) ToSQLNull() sql.Null[fs.FileMode] {
+	return sqlNull(m.FileMode, m.Valid)
+}
+
+// FlagsFromSQLNull creates a new Flags from a sql.Null[T].
+func FlagsFromSQLNull[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](n sql.Null[T]) Flags[T] {
+	return NewFlags(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Flags as a sql.Null[T].
+func (f Flags[T]) ToSQLNull() sql.Null[T] {
+	return sqlNull(f.V, f.Valid)
+}
+
+// FloatFromSQLNull creates a new Float from a sql.Null[float64].
+func FloatFromSQLNull(n sql.Null[float64]) Float {
+	return NewFloat(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Float as a sql.Null[float64].
+func (f Float) ToSQLNull() sql.Null[float64] {
+	return sqlNull(f.Float64, f.Valid)
+}
+
+// HardwareAddrFromSQLNull creates a new HardwareAddr from a sql.Null[net.HardwareAddr].
+func HardwareAddrFromSQLNull(n sql.Null[net.HardwareAddr]) HardwareAddr {
+	return NewHardwareAddr(n.V, n.Valid)
+}
+
+// ToSQLNull returns this HardwareAddr as a sql.Null[net.HardwareAddr].
+func (h HardwareAddr) ToSQLNull() sql.Null[net.HardwareAddr] {
+	return sqlNull(h.HardwareAddr, h.Valid)
+}
+
+// HexFromSQLNull creates a new Hex from a sql.Null[[]byte].
+func HexFromSQLNull(n sql.Null[[]byte]) Hex {
+	return NewHex(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Hex as a sql.Null[[]byte].
+func (h Hex) ToSQLNull() sql.Null[[]byte] {
+	return sqlNull(h.Bytes, h.Valid)
+}
+
+// FixedHexFromSQLNull creates a new FixedHex from a sql.Null[A].
+func FixedHexFromSQLNull[A any](n sql.Null[A]) FixedHex[A] {
+	return NewFixedHex(n.V, n.Valid)
+}
+
+// ToSQLNull returns this FixedHex as a sql.Null[A].
+func (h FixedHex[A]) ToSQLNull() sql.Null[A] {
+	return sqlNull(h.V, h.Valid)
+}
+
+// IntFromSQLNull creates a new Int from a sql.Null[int64].
+func IntFromSQLNull(n sql.Null[int64]) Int {
+	return NewInt(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Int as a sql.Null[int64].
+func (i Int) ToSQLNull() sql.Null[int64] {
+	return sqlNull(i.Int64, i.Valid)
+}
+
+// Int16FromSQLNull creates a new Int16 from a sql.Null[int16].
+func Int16FromSQLNull(n sql.Null[int16]) Int16 {
+	return NewInt16(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Int16 as a sql.Null[int16].
+func (i Int16) ToSQLNull() sql.Null[int16] {
+	return sqlNull(i.Int16, i.Valid)
+}
+
+// Int32FromSQLNull creates a new Int32 from a sql.Null[int32].
+func Int32FromSQLNull(n sql.Null[int32]) Int32 {
+	return NewInt32(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Int32 as a sql.Null[int32].
+func (i Int32) ToSQLNull() sql.Null[int32] {
+	return sqlNull(i.Int32, i.Valid)
+}
+
+// Int8FromSQLNull creates a new Int8 from a sql.Null[int8].
+func Int8FromSQLNull(n sql.Null[int8]) Int8 {
+	return NewInt8(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Int8 as a sql.Null[int8].
+func (i Int8) ToSQLNull() sql.Null[int8] {
+	return sqlNull(i.Int8, i.Valid)
+}
+
+// CountryCodeFromSQLNull creates a new CountryCode from a sql.Null[string].
+func CountryCodeFromSQLNull(n sql.Null[string]) CountryCode {
+	return NewCountryCode(n.V, n.Valid)
+}
+
+// ToSQLNull returns this CountryCode as a sql.Null[string].
+func (c CountryCode) ToSQLNull() sql.Null[string] {
+	return sqlNull(c.Code, c.Valid)
+}
+
+// CurrencyCodeFromSQLNull creates a new CurrencyCode from a sql.Null[string].
+func CurrencyCodeFromSQLNull(n sql.Null[string]) CurrencyCode {
+	return NewCurrencyCode(n.V, n.Valid)
+}
+
+// ToSQLNull returns this CurrencyCode as a sql.Null[string].
+func (c CurrencyCode) ToSQLNull() sql.Null[string] {
+	return sqlNull(c.Code, c.Valid)
+}
+
+// JSONFromSQLNull creates a new JSON from a sql.Null[json.RawMessage].
+func JSONFromSQLNull(n sql.Null[json.RawMessage]) JSON {
+	return NewJSON(n.V, n.Valid)
+}
+
+// ToSQLNull returns this JSON as a sql.Null[json.RawMessage].
+func (j JSON) ToSQLNull() sql.Null[json.RawMessage] {
+	return sqlNull(j.JSON, j.Valid)
+}
+
+// KSUIDFromSQLNull creates a new KSUID from a sql.Null[[20]byte].
+func KSUIDFromSQLNull(n sql.Null[[20]byte]) KSUID {
+	return NewKSUID(n.V, n.Valid)
+}
+
+// ToSQLNull returns this KSUID as a sql.Null[[20]byte].
+func (k KSUID) ToSQLNull() sql.Null[[20]byte] {
+	return sqlNull(k.KSUID, k.Valid)
+}
+
+// LanguageTagFromSQLNull creates a new LanguageTag from a sql.Null[string].
+func LanguageTagFromSQLNull(n sql.Null[string]) LanguageTag {
+	return NewLanguageTag(n.V, n.Valid)
+}
+
+// ToSQLNull returns this LanguageTag as a sql.Null[string].
+func (l LanguageTag) ToSQLNull() sql.Null[string] {
+	return sqlNull(l.Tag, l.Valid)
+}
+
+// MapFromSQLNull creates a new Map from a sql.Null[map[K]V].
+func MapFromSQLNull[K comparable, V any](n sql.Null[map[K]V]) Map[K, V] {
+	return NewMap(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Map as a sql.Null[map[K]V].
+func (m Map[K, V]) ToSQLNull() sql.Null[map[K]V] {
+	return sqlNull(m.V, m.Valid)
+}
+
+// OptionalFromSQLNull creates a new Optional from a sql.Null[T].
+// The Optional is always present.
+func OptionalFromSQLNull[T any](n sql.Null[T]) Optional[T] {
+	return Optional[T]{Null: n, Present: true}
+}
+
+// ToSQLNull returns this Optional as a sql.Null[T].
+// Optionals that are not present are converted to null.
+func (o Optional[T]) ToSQLNull() sql.Null[T] {
+	return sqlNull(o.V, o.Present && o.Valid)
+}
+
+// PercentFromSQLNull creates a new Percent from a sql.Null[float64] holding a ratio.
+func PercentFromSQLNull(n sql.Null[float64]) Percent {
+	return NewPercent(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Percent's ratio as a sql.Null[float64].
+func (p Percent) ToSQLNull() sql.Null[float64] {
+	return sqlNull(p.Float64, p.Valid)
+}
+
+// PrefixFromSQLNull creates a new Prefix from a sql.Null[netip.Prefix].
+func PrefixFromSQLNull(n sql.Null[netip.Prefix]) Prefix {
+	return NewPrefix(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Prefix as a sql.Null[netip.Prefix].
+func (p Prefix) ToSQLNull() sql.Null[netip.Prefix] {
+	return sqlNull(p.Prefix, p.Valid)
+}
+
+// RegexpFromSQLNull creates a new Regexp from a sql.Null[*regexp.Regexp].
+func RegexpFromSQLNull(n sql.Null[*regexp.Regexp]) Regexp {
+	return NewRegexp(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Regexp as a sql.Null[*regexp.Regexp].
+func (r Regexp) ToSQLNull() sql.Null[*regexp.Regexp] {
+	return sqlNull(r.Regexp, r.Valid)
+}
+
+// SemverFromSQLNull creates a new Semver from a sql.Null[string].
+func SemverFromSQLNull(n sql.Null[string]) Semver {
+	return NewSemver(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Semver as a sql.Null[string].
+func (s Semver) ToSQLNull() sql.Null[string] {
+	return sqlNull(s.Version, s.Valid)
+}
+
+// SliceFromSQLNull creates a new Slice from a sql.Null[[]T].
+func SliceFromSQLNull[T any](n sql.Null[[]T]) Slice[T] {
+	return NewSlice(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Slice as a sql.Null[[]T].
+func (s Slice[T]) ToSQLNull() sql.Null[[]T] {
+	return sqlNull(s.V, s.Valid)
+}
+
+// StringFromSQLNull creates a new String from a sql.Null[string].
+func StringFromSQLNull(n sql.Null[string]) String {
+	return NewString(n.V, n.Valid)
+}
+
+// ToSQLNull returns this String as a sql.Null[string].
+func (s String) ToSQLNull() sql.Null[string] {
+	return sqlNull(s.String, s.Valid)
+}
+
+// TimeFromSQLNull creates a new Time from a sql.Null[time.Time].
+func TimeFromSQLNull(n sql.Null[time.Time]) Time {
+	return NewTime(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Time as a sql.Null[time.Time].
+func (t Time) ToSQLNull() sql.Null[time.Time] {
+	return sqlNull(t.Time, t.Valid)
+}
+
+// TimeOfDayFromSQLNull creates a new TimeOfDay from a sql.Null[time.Time].
+// The time of day is taken from the time in its own location.
+func TimeOfDayFromSQLNull(n sql.Null[time.Time]) TimeOfDay {
+	if !n.Valid {
+		return TimeOfDay{}
+	}
+	return TimeOfDayFrom(n.V)
+}
+
+// ToSQLNull returns this TimeOfDay as a sql.Null[time.Time].
+// The time is on January 1 of year 0 in UTC.
+func (t TimeOfDay) ToSQLNull() sql.Null[time.Time] {
+	return sqlNull(t.time(), t.Valid)
+}
+
+// UintFromSQLNull creates a new Uint from a sql.Null[uint64].
+func UintFromSQLNull(n sql.Null[uint64]) Uint {
+	return NewUint(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Uint as a sql.Null[uint64].
+func (i Uint) ToSQLNull() sql.Null[uint64] {
+	return sqlNull(i.Uint64, i.Valid)
+}
+
+// Uint16FromSQLNull creates a new Uint16 from a sql.Null[uint16].
+func Uint16FromSQLNull(n sql.Null[uint16]) Uint16 {
+	return NewUint16(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Uint16 as a sql.Null[uint16].
+func (i Uint16) ToSQLNull() sql.Null[uint16] {
+	return sqlNull(i.Uint16, i.Valid)
+}
+
+// Uint32FromSQLNull creates a new Uint32 from a sql.Null[uint32].
+func Uint32FromSQLNull(n sql.Null[uint32]) Uint32 {
+	return NewUint32(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Uint32 as a sql.Null[uint32].
+func (i Uint32) ToSQLNull() sql.Null[uint32] {
+	return sqlNull(i.Uint32, i.Valid)
+}
+
+// Uint8FromSQLNull creates a new Uint8 from a sql.Null[uint8].
+func Uint8FromSQLNull(n sql.Null[uint8]) Uint8 {
+	return NewUint8(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Uint8 as a sql.Null[uint8].
+func (i Uint8) ToSQLNull() sql.Null[uint8] {
+	return sqlNull(i.Uint8, i.Valid)
+}
+
+// ULIDFromSQLNull creates a new ULID from a sql.Null[[16]byte].
+func ULIDFromSQLNull(n sql.Null[[16]byte]) ULID {
+	return NewULID(n.V, n.Valid)
+}
+
+// ToSQLNull returns this ULID as a sql.Null[[16]byte].
+func (u ULID) ToSQLNull() sql.Null[[16]byte] {
+	return sqlNull(u.ULID, u.Valid)
+}
+
+// UnixSecondsFromSQLNull creates a new UnixSeconds from a sql.Null[time.Time].
+func UnixSecondsFromSQLNull(n sql.Null[time.Time]) UnixSeconds {
+	return NewUnixSeconds(n.V, n.Valid)
+}
+
+// ToSQLNull returns this UnixSeconds as a sql.Null[time.Time].
+func (u UnixSeconds) ToSQLNull() sql.Null[time.Time] {
+	return sqlNull(u.Time, u.Valid)
+}
+
+// UnixMillisFromSQLNull creates a new UnixMillis from a sql.Null[time.Time].
+func UnixMillisFromSQLNull(n sql.Null[time.Time]) UnixMillis {
+	return NewUnixMillis(n.V, n.Valid)
+}
+
+// ToSQLNull returns this UnixMillis as a sql.Null[time.Time].
+func (u UnixMillis) ToSQLNull() sql.Null[time.Time] {
+	return sqlNull(u.Time, u.Valid)
+}
+
+// URLFromSQLNull creates a new URL from a sql.Null[*url.URL].
+func URLFromSQLNull(n sql.Null[*url.URL]) URL {
+	return NewURL(n.V, n.Valid)
+}
+
+// ToSQLNull returns this URL as a sql.Null[*url.URL].
+func (u URL) ToSQLNull() sql.Null[*url.URL] {
+	return sqlNull(u.URL, u.Valid)
+}
+
+// ValueFromSQLNull creates a new Value from a sql.Null[T].
+func ValueFromSQLNull[T any](n sql.Null[T]) Value[T] {
+	return NewValue(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Value as a sql.Null[T].
+func (v Value[T]) ToSQLNull() sql.Null[T] {
+	return sqlNull(v.V, v.Valid)
+}
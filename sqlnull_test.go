@@ -0,0 +1,101 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSQLNull(t *testing.T) {
+	for _, in := range []allTypes{exampleAllTypes(), {}} {
+		checkSQLNull(t, in.Addr, AddrFromSQLNull, "Addr")
+		checkSQLNull(t, in.Any, AnyFromSQLNull, "Any")
+		checkSQLNull(t, in.Base64, Base64FromSQLNull, "Base64")
+		checkSQLNull(t, in.BigInt, BigIntFromSQLNull, "BigInt")
+		checkSQLNull(t, in.Bool, BoolFromSQLNull, "Bool")
+		checkSQLNull(t, in.ByteSize, ByteSizeFromSQLNull, "ByteSize")
+		checkSQLNull(t, in.Bytes, BytesFromSQLNull, "Bytes")
+		checkSQLNull(t, in.Complex, ComplexFromSQLNull, "Complex")
+		checkSQLNull(t, in.Date, DateFromSQLNull, "Date")
+		checkSQLNull(t, in.Duration, DurationFromSQLNull, "Duration")
+		checkSQLNull(t, in.Email, EmailFromSQLNull, "Email")
+		checkSQLNull(t, in.Enum, EnumFromSQLNull[testState], "Enum")
+		checkSQLNull(t, in.FileMode, FileModeFromSQLNull, "FileMode")
+		checkSQLNull(t, in.Flags, FlagsFromSQLNull[uint8], "Flags")
+		checkSQLNull(t, in.Float, FloatFromSQLNull, "Float")
+		checkSQLNull(t, in.HardwareAddr, HardwareAddrFromSQLNull, "HardwareAddr")
+		checkSQLNull(t, in.Hex, HexFromSQLNull, "Hex")
+		checkSQLNull(t, in.FixedHex, FixedHexFromSQLNull[[4]byte], "FixedHex")
+		checkSQLNull(t, in.Int, IntFromSQLNull, "Int")
+		checkSQLNull(t, in.Int16, Int16FromSQLNull, "Int16")
+		checkSQLNull(t, in.Int32, Int32FromSQLNull, "Int32")
+		checkSQLNull(t, in.Int8, Int8FromSQLNull, "Int8")
+		checkSQLNull(t, in.CountryCode, CountryCodeFromSQLNull, "CountryCode")
+		checkSQLNull(t, in.CurrencyCode, CurrencyCodeFromSQLNull, "CurrencyCode")
+		checkSQLNull(t, in.JSON, JSONFromSQLNull, "JSON")
+		checkSQLNull(t, in.KSUID, KSUIDFromSQLNull, "KSUID")
+		checkSQLNull(t, in.LanguageTag, LanguageTagFromSQLNull, "LanguageTag")
+		checkSQLNull(t, in.Map, MapFromSQLNull[string, int], "Map")
+		checkSQLNull(t, in.Optional, OptionalFromSQLNull[string], "Optional")
+		checkSQLNull(t, in.Percent, PercentFromSQLNull, "Percent")
+		checkSQLNull(t, in.Prefix, PrefixFromSQLNull, "Prefix")
+		checkSQLNull(t, in.Regexp, RegexpFromSQLNull, "Regexp")
+		checkSQLNull(t, in.Semver, SemverFromSQLNull, "Semver")
+		checkSQLNull(t, in.Slice, SliceFromSQLNull[int], "Slice")
+		checkSQLNull(t, in.String, StringFromSQLNull, "String")
+		checkSQLNull(t, in.Time, TimeFromSQLNull, "Time")
+		checkSQLNull(t, in.TimeOfDay, TimeOfDayFromSQLNull, "TimeOfDay")
+		checkSQLNull(t, in.Uint, UintFromSQLNull, "Uint")
+		checkSQLNull(t, in.Uint16, Uint16FromSQLNull, "Uint16")
+		checkSQLNull(t, in.Uint32, Uint32FromSQLNull, "Uint32")
+		checkSQLNull(t, in.Uint8, Uint8FromSQLNull, "Uint8")
+		checkSQLNull(t, in.ULID, ULIDFromSQLNull, "ULID")
+		checkSQLNull(t, in.UnixSeconds, UnixSecondsFromSQLNull, "UnixSeconds")
+		checkSQLNull(t, in.UnixMillis, UnixMillisFromSQLNull, "UnixMillis")
+		checkSQLNull(t, in.URL, URLFromSQLNull, "URL")
+		checkSQLNull(t, in.Value, ValueFromSQLNull[int], "Value")
+	}
+}
+
+func TestSQLNullValues(t *testing.T) {
+	n := IntFrom(5).ToSQLNull()
+	if n != (sql.Null[int64]{V: 5, Valid: true}) {
+		t.Errorf("bad sql.Null: %#v", n)
+	}
+	if n := (Int{}).ToSQLNull(); n != (sql.Null[int64]{}) {
+		t.Errorf("null Int should convert to an invalid sql.Null: %#v", n)
+	}
+	if i := IntFromSQLNull(sql.Null[int64]{V: 5}); i.Valid {
+		t.Error("invalid sql.Null should convert to a null Int")
+	}
+
+	if n := OptionalNull[string]().ToSQLNull(); n.Valid {
+		t.Errorf("null Optional should be invalid: %#v", n)
+	}
+	if o := OptionalFromSQLNull(sql.Null[string]{}); !o.Present || o.Valid {
+		t.Errorf("Optional from invalid sql.Null should be present and null: %#v", o)
+	}
+
+	loc := time.FixedZone("JST", 9*60*60)
+	d := DateFromSQLNull(sql.Null[time.Time]{V: time.Date(2024, 5, 6, 1, 0, 0, 0, loc), Valid: true})
+	if !d.Equal(NewDate(2024, time.May, 6, true)) {
+		t.Errorf("bad Date: %v", d)
+	}
+	if got := d.ToSQLNull().V; !got.Equal(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad Date time: %v", got)
+	}
+}
+
+// checkSQLNull checks that v survives a round trip through sql.Null[V].
+func checkSQLNull[T interface {
+	json.Marshaler
+	ToSQLNull() sql.Null[V]
+}, V any](t *testing.T, v T, from func(sql.Null[V]) T, name string) {
+	t.Helper()
+	want, err := v.MarshalJSON()
+	maybePanic(err)
+	got, err := from(v.ToSQLNull()).MarshalJSON()
+	maybePanic(err)
+	assertJSONEquals(t, got, string(want), name+" sql.Null round trip")
+}
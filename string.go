@@ -9,6 +9,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // nullBytes is a JSON null literal
@@ -51,9 +53,17 @@ func NewString(s string, valid bool) String {
 	}
 }
 
+// Scan implements the Scanner interface.
+// Unlike sql.NullString, it also accepts pointers, such as the *string clickhouse-go returns for Nullable(String) columns.
+func (s *String) Scan(value interface{}) (err error) {
+	defer validateDecoded(s, &err)
+	return s.NullString.Scan(driverval.Convert(value))
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports string and null input. Blank string input does not produce a null String.
-func (s *String) UnmarshalJSON(data []byte) error {
+func (s *String) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(s, &err)
 	if bytes.Equal(data, nullBytes) {
 		s.Valid = false
 		return nil
@@ -77,19 +87,23 @@ func (s String) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string when this String is null.
+// It will encode DefaultNullText, blank by default, when this String is null.
 func (s String) MarshalText() ([]byte, error) {
 	if !s.Valid {
-		return []byte{}, nil
+		return nullText(), nil
 	}
 	return []byte(s.String), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null String if the input is a blank string.
-func (s *String) UnmarshalText(text []byte) error {
+// It will unmarshal to a null String if the input is DefaultNullText, which is blank by default.
+func (s *String) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(s, &err)
 	s.String = string(text)
-	s.Valid = s.String != ""
+	s.Valid = !isNullTextOnly(text)
+	if !s.Valid {
+		s.String = ""
+	}
 	return nil
 }
 
@@ -99,6 +113,16 @@ func (s *String) SetValid(v string) {
 	s.Valid = true
 }
 
+// SetNull sets this String to null, leaving its value as is. Use Reset to clear the value too.
+func (s *String) SetNull() {
+	s.Valid = false
+}
+
+// Reset sets this String to null and clears its value.
+func (s *String) Reset() {
+	*s = String{}
+}
+
 // Ptr returns a pointer to this String's value, or a nil pointer if this String is null.
 func (s String) Ptr() *string {
 	if !s.Valid {
@@ -107,11 +131,21 @@ func (s String) Ptr() *string {
 	return &s.String
 }
 
+// SetPtr changes this String's value to the value v points to, or sets it to be null if v is nil, like StringFromPtr.
+func (s *String) SetPtr(v *string) {
+	*s = StringFromPtr(v)
+}
+
 // IsZero returns true for null strings, for potential future omitempty support.
 func (s String) IsZero() bool {
 	return !s.Valid
 }
 
+// IsZeroOrNull returns true for invalid Strings, or valid Strings with a blank string.
+func (s String) IsZeroOrNull() bool {
+	return !s.Valid || s.String == ""
+}
+
 // Equal returns true if both strings have the same value or are both null.
 func (s String) Equal(other String) bool {
 	return s.Valid == other.Valid && (!s.Valid || s.String == other.String)
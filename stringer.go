@@ -0,0 +1,287 @@
+package null
+
+import (
+	"encoding"
+	"fmt"
+)
+
+// The String methods return values as text, so that they print as such with fmt and in logs,
+// instead of as structs like {{42 true}}. Null values are printed as "<null>".
+// String has no String method, because that is the name of its value field; it implements fmt.Formatter instead.
+// The ranges already have String methods, which return a blank string for null.
+
+// nullString is returned by the String methods for null values.
+const nullString = "<null>"
+
+// textString returns the text of m for the String methods, or nullString if it is not valid.
+func textString(m encoding.TextMarshaler, valid bool) string {
+	if !valid {
+		return nullString
+	}
+	text, err := m.MarshalText()
+	if err != nil {
+		return fmt.Sprintf("%%!v(ERROR=%v)", err)
+	}
+	return string(text)
+}
+
+// String returns this Addr's value as text, like MarshalText, or "<null>" if it is null.
+func (a Addr) String() string {
+	return textString(a, a.Valid)
+}
+
+// String returns this Any's value as JSON, or "<null>" if it is null.
+func (a Any) String() string {
+	if !a.Valid {
+		return nullString
+	}
+	data, err := a.MarshalJSON()
+	if err != nil {
+		return fmt.Sprintf("%%!v(ERROR=%v)", err)
+	}
+	return string(data)
+}
+
+// String returns this Base64's value as text, like MarshalText, or "<null>" if it is null.
+func (b Base64) String() string {
+	return textString(b, b.Valid)
+}
+
+// String returns this BigInt's value as text, like MarshalText, or "<null>" if it is null.
+func (b BigInt) String() string {
+	return textString(b, b.Valid)
+}
+
+// String returns this Bool's value as text, like MarshalText, or "<null>" if it is null.
+func (b Bool) String() string {
+	return textString(b, b.Valid)
+}
+
+// String returns this Bytes's value as text, like MarshalText, or "<null>" if it is null.
+func (b Bytes) String() string {
+	return textString(b, b.Valid)
+}
+
+// String returns this ByteSize's value as text, like MarshalText, or "<null>" if it is null.
+func (b ByteSize) String() string {
+	return textString(b, b.Valid)
+}
+
+// String returns this Complex's value as text, like MarshalText, or "<null>" if it is null.
+func (c Complex) String() string {
+	return textString(c, c.Valid)
+}
+
+// String returns this CountryCode's value as text, like MarshalText, or "<null>" if it is null.
+func (c CountryCode) String() string {
+	return textString(c, c.Valid)
+}
+
+// String returns this CurrencyCode's value as text, like MarshalText, or "<null>" if it is null.
+func (c CurrencyCode) String() string {
+	return textString(c, c.Valid)
+}
+
+// String returns this Date's value as text, like MarshalText, or "<null>" if it is null.
+func (d Date) String() string {
+	return textString(d, d.Valid)
+}
+
+// String returns this Duration's value as text, like MarshalText, or "<null>" if it is null.
+func (d Duration) String() string {
+	return textString(d, d.Valid)
+}
+
+// String returns this Email's value as text, like MarshalText, or "<null>" if it is null.
+func (e Email) String() string {
+	return textString(e, e.Valid)
+}
+
+// String returns this Enum's value as text, like MarshalText, or "<null>" if it is null.
+func (e Enum[T]) String() string {
+	return textString(e, e.Valid)
+}
+
+// String returns this FileMode's value as text, like MarshalText, or "<null>" if it is null.
+func (m FileMode) String() string {
+	return textString(m, m.Valid)
+}
+
+// String returns this FixedHex's value as text, like MarshalText, or "<null>" if it is null.
+func (h FixedHex[A]) String() string {
+	return textString(h, h.Valid)
+}
+
+// String returns this Flags's value as text, like MarshalText, or "<null>" if it is null.
+func (f Flags[T]) String() string {
+	return textString(f, f.Valid)
+}
+
+// String returns this Float's value as text, like MarshalText, or "<null>" if it is null.
+func (f Float) String() string {
+	return textString(f, f.Valid)
+}
+
+// String returns this HardwareAddr's value as text, like MarshalText, or "<null>" if it is null.
+func (h HardwareAddr) String() string {
+	return textString(h, h.Valid)
+}
+
+// String returns this Hex's value as text, like MarshalText, or "<null>" if it is null.
+func (h Hex) String() string {
+	return textString(h, h.Valid)
+}
+
+// String returns this Int's value as text, like MarshalText, or "<null>" if it is null.
+func (i Int) String() string {
+	return textString(i, i.Valid)
+}
+
+// String returns this Int16's value as text, like MarshalText, or "<null>" if it is null.
+func (i Int16) String() string {
+	return textString(i, i.Valid)
+}
+
+// String returns this Int32's value as text, like MarshalText, or "<null>" if it is null.
+func (i Int32) String() string {
+	return textString(i, i.Valid)
+}
+
+// String returns this Int8's value as text, like MarshalText, or "<null>" if it is null.
+func (i Int8) String() string {
+	return textString(i, i.Valid)
+}
+
+// String returns this JSON's value as text, like MarshalText, or "<null>" if it is null.
+func (j JSON) String() string {
+	return textString(j, j.Valid)
+}
+
+// String returns this KSUID's value as text, like MarshalText, or "<null>" if it is null.
+func (k KSUID) String() string {
+	return textString(k, k.Valid)
+}
+
+// String returns this LanguageTag's value as text, like MarshalText, or "<null>" if it is null.
+func (l LanguageTag) String() string {
+	return textString(l, l.Valid)
+}
+
+// String returns this Map's value as JSON, or "<null>" if it is null.
+func (m Map[K, V]) String() string {
+	if !m.Valid {
+		return nullString
+	}
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return fmt.Sprintf("%%!v(ERROR=%v)", err)
+	}
+	return string(data)
+}
+
+// String returns this Money's value as text, like MarshalText, or "<null>" if it is null.
+func (m Money) String() string {
+	return textString(m, m.Valid)
+}
+
+// String returns this Optional's value as text, like MarshalText, "<null>" if it is null, or "<unset>" if it is unset.
+func (o Optional[T]) String() string {
+	if !o.Present {
+		return "<unset>"
+	}
+	return textString(o, o.Valid)
+}
+
+// String returns this Percent's value as text, like MarshalText, or "<null>" if it is null.
+func (p Percent) String() string {
+	return textString(p, p.Valid)
+}
+
+// String returns this Point's value as text, like MarshalText, or "<null>" if it is null.
+func (p Point) String() string {
+	return textString(p, p.Valid)
+}
+
+// String returns this Prefix's value as text, like MarshalText, or "<null>" if it is null.
+func (p Prefix) String() string {
+	return textString(p, p.Valid)
+}
+
+// String returns this Regexp's value as text, like MarshalText, or "<null>" if it is null.
+func (r Regexp) String() string {
+	return textString(r, r.Valid)
+}
+
+// String returns this Semver's value as text, like MarshalText, or "<null>" if it is null.
+func (s Semver) String() string {
+	return textString(s, s.Valid)
+}
+
+// String returns this Slice's value as text, like MarshalText, or "<null>" if it is null.
+func (s Slice[T]) String() string {
+	return textString(s, s.Valid)
+}
+
+// String returns this Time's value as text, like MarshalText, or "<null>" if it is null.
+func (t Time) String() string {
+	return textString(t, t.Valid)
+}
+
+// String returns this TimeOfDay's value as text, like MarshalText, or "<null>" if it is null.
+func (t TimeOfDay) String() string {
+	return textString(t, t.Valid)
+}
+
+// String returns this Uint's value as text, like MarshalText, or "<null>" if it is null.
+func (i Uint) String() string {
+	return textString(i, i.Valid)
+}
+
+// String returns this Uint16's value as text, like MarshalText, or "<null>" if it is null.
+func (i Uint16) String() string {
+	return textString(i, i.Valid)
+}
+
+// String returns this Uint32's value as text, like MarshalText, or "<null>" if it is null.
+func (i Uint32) String() string {
+	return textString(i, i.Valid)
+}
+
+// String returns this Uint8's value as text, like MarshalText, or "<null>" if it is null.
+func (i Uint8) String() string {
+	return textString(i, i.Valid)
+}
+
+// String returns this ULID's value as text, like MarshalText, or "<null>" if it is null.
+func (u ULID) String() string {
+	return textString(u, u.Valid)
+}
+
+// String returns this UnixMillis's value as text, like MarshalText, or "<null>" if it is null.
+func (u UnixMillis) String() string {
+	return textString(u, u.Valid)
+}
+
+// String returns this UnixSeconds's value as text, like MarshalText, or "<null>" if it is null.
+func (u UnixSeconds) String() string {
+	return textString(u, u.Valid)
+}
+
+// String returns this URL's value as text, like MarshalText, or "<null>" if it is null.
+func (u URL) String() string {
+	return textString(u, u.Valid)
+}
+
+// String returns this Value's value as text, like MarshalText, or "<null>" if it is null.
+func (v Value[T]) String() string {
+	return textString(v, v.Valid)
+}
+
+// Format implements fmt.Formatter, printing this String's value with the given verb and flags, or "<null>" if it is null.
+func (s String) Format(f fmt.State, verb rune) {
+	if !s.Valid {
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), nullString)
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), s.String)
+}
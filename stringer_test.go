@@ -0,0 +1,70 @@
+package null
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	for _, test := range []struct {
+		in   interface{}
+		want string
+	}{
+		{UintFrom(42), "42"},
+		{NewUint(42, false), "<null>"},
+		{FloatFrom(1.5), "1.5"},
+		{BoolFrom(true), "true"},
+		{ByteSizeFrom(10 << 20), "10MiB"},
+		{StringFrom("hello"), "hello"},
+		{NewString("hello", false), "<null>"},
+		{MapFrom(map[string]int{"a": 1}), `{"a":1}`},
+		{OptionalFrom("x"), "x"},
+		{OptionalNull[string](), "<null>"},
+		{Optional[string]{}, "<unset>"},
+		{MoneyFrom(1250, "USD"), string(must(MoneyFrom(1250, "USD").MarshalText()))},
+	} {
+		if got := fmt.Sprint(test.in); got != test.want {
+			t.Errorf("fmt.Sprint(%#v): got %q, want %q", test.in, got, test.want)
+		}
+	}
+
+	if got := fmt.Sprintf("%q|%5s|%v", StringFrom("a"), NewString("", false), IntFrom(7)); got != `"a"|<null>|7` {
+		t.Errorf("bad formatting: %s", got)
+	}
+	if got := fmt.Sprintf("%v", struct{ N Int }{IntFrom(7)}); got != "{7}" {
+		t.Errorf("bad formatting of struct field: %s", got)
+	}
+}
+
+func TestAllTypesString(t *testing.T) {
+	example := reflect.ValueOf(exampleAllTypes())
+	typ := example.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		valid, null := example.Field(i).Interface(), reflect.Zero(f.Type).Interface()
+		var text []byte
+		var err error
+		if m, ok := valid.(encoding.TextMarshaler); ok {
+			text, err = m.MarshalText()
+		} else {
+			text, err = valid.(json.Marshaler).MarshalJSON()
+		}
+		maybePanic(err)
+		if got := fmt.Sprint(valid); got != string(text) {
+			t.Errorf("%s: fmt.Sprint(): got %q, want %q", f.Name, got, text)
+		}
+		want := "<null>"
+		switch f.Name {
+		case "Int64Range", "TimeRange":
+			want = ""
+		case "Optional":
+			want = "<unset>"
+		}
+		if got := fmt.Sprint(null); got != want {
+			t.Errorf("%s: fmt.Sprint() of null: got %q, want %q", f.Name, got, want)
+		}
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // Time is a nullable time.Time. It supports SQL and JSON serialization.
@@ -15,12 +18,290 @@ type Time struct {
 	sql.NullTime
 }
 
-// Value implements the driver Valuer interface.
-func (t Time) Value() (driver.Value, error) {
+// TimeLayoutUnix can be used as a TimeOptions layout or format
+// for Unix timestamps in seconds, such as 1356124881.
+const TimeLayoutUnix = "unix"
+
+// TimeOptions controls how Time values are parsed and formatted.
+// The zero value behaves like time.Time: RFC 3339 strings are used for JSON and text,
+// and time.Time values are sent to the database.
+//
+// Time uses DefaultTimeOptions. To use different options for a particular field,
+// define a type that embeds Time and implements the relevant interfaces
+// by calling the TimeOptions methods. With encoding/json/v2, such a type must also implement
+// MarshalJSONTo and UnmarshalJSONFrom, which are otherwise promoted from Time and take precedence.
+type TimeOptions struct {
+	// Layouts are additional layouts accepted when unmarshaling and scanning strings,
+	// tried in order after RFC 3339. TimeLayoutUnix also accepts JSON numbers.
+	Layouts []string
+	// Format is the layout used when marshaling. If set, Value will send
+	// formatted strings (or integers, for TimeLayoutUnix) instead of time.Time.
+	Format string
+	// Location is used for parsing layouts without time zone information,
+	// and times are converted to it before being formatted.
+	// A nil Location means UTC when parsing, and no conversion when formatting.
+	Location *time.Location
+	// ScanLocation is used instead of Location when scanning strings without time zone information
+	// and Unix timestamps, such as MySQL DATETIME values read without the driver's parseTime option.
+	// A nil ScanLocation means Location.
+	ScanLocation *time.Location
+}
+
+// DefaultTimeOptions are the options used by Time.
+var DefaultTimeOptions TimeOptions
+
+// Parse parses str as RFC 3339, or any of the options' layouts.
+// If every layout fails, the RFC 3339 error is returned.
+func (o TimeOptions) Parse(str string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, str)
+	if err == nil {
+		return t, nil
+	}
+	loc := o.location()
+	for _, layout := range o.Layouts {
+		if layout == TimeLayoutUnix {
+			if secs, err := strconv.ParseInt(str, 10, 64); err == nil {
+				return time.Unix(secs, 0).In(loc), nil
+			}
+			continue
+		}
+		if t, err := time.ParseInLocation(layout, str, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// sqlTimeLayouts are the layouts of times that drivers return as strings when they don't parse them,
+// such as MySQL without parseTime, SQLite, and Postgres's text format. They are tried after the options' layouts when scanning.
+var sqlTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// parseScanned parses a string scanned from a database like Parse,
+// or in one of sqlTimeLayouts, using ScanLocation for layouts without time zone information.
+func (o TimeOptions) parseScanned(str string) (time.Time, error) {
+	if o.ScanLocation != nil {
+		o.Location = o.ScanLocation
+	}
+	t, err := o.Parse(str)
+	if err == nil {
+		return t, nil
+	}
+	for _, layout := range sqlTimeLayouts {
+		if t, err := time.ParseInLocation(layout, str, o.location()); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+func (o TimeOptions) location() *time.Location {
+	if o.Location == nil {
+		return time.UTC
+	}
+	return o.Location
+}
+
+func (o TimeOptions) acceptsUnix() bool {
+	for _, layout := range o.Layouts {
+		if layout == TimeLayoutUnix {
+			return true
+		}
+	}
+	return false
+}
+
+func (o TimeOptions) format(t time.Time) string {
+	if o.Location != nil {
+		t = t.In(o.Location)
+	}
+	if o.Format == TimeLayoutUnix {
+		return strconv.FormatInt(t.Unix(), 10)
+	}
+	return t.Format(o.Format)
+}
+
+// ScanTime implements the Scanner interface for t using these options.
+// It supports time.Time values, integers as Unix timestamps in seconds, and strings in any accepted layout.
+// Strings are also accepted in the formats of drivers that don't parse times themselves, such as
+// "2006-01-02 15:04:05.999999" from MySQL without parseTime, with or without a time zone offset.
+// Times without time zone information, including Unix timestamps, are in ScanLocation.
+func (o TimeOptions) ScanTime(t *Time, value interface{}) error {
+	value = driverval.Convert(value)
+	var err error
+	switch v := value.(type) {
+	case nil:
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	case time.Time:
+		t.Time = v
+	case []byte:
+		t.Time, err = o.parseScanned(string(v))
+	case string:
+		t.Time, err = o.parseScanned(v)
+	case int64:
+		if o.ScanLocation != nil {
+			o.Location = o.ScanLocation
+		}
+		t.Time = time.Unix(v, 0).In(o.location())
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into Time: %v", value, value)
+	}
+	if err != nil {
+		t.Valid = false
+		return fmt.Errorf("null: couldn't scan Time: %w", err)
+	}
+	t.Valid = true
+	return nil
+}
+
+// TimeValue implements the driver Valuer interface for t using these options.
+func (o TimeOptions) TimeValue(t Time) (driver.Value, error) {
 	if !t.Valid {
 		return nil, nil
 	}
-	return t.Time, nil
+	switch o.Format {
+	case "":
+		return t.Time, nil
+	case TimeLayoutUnix:
+		return t.Time.Unix(), nil
+	}
+	return o.format(t.Time), nil
+}
+
+// UnmarshalTimeJSON implements json.Unmarshaler for t using these options.
+func (o TimeOptions) UnmarshalTimeJSON(t *Time, data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		t.Valid = false
+		return nil
+	}
+
+	var str string
+	switch {
+	case len(data) > 0 && data[0] == '"':
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+	case o.acceptsUnix():
+		var secs int64
+		if err := json.Unmarshal(data, &secs); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		str = strconv.FormatInt(secs, 10)
+	default:
+		// let time.Time report the error
+		if err := json.Unmarshal(data, &t.Time); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
+		t.Valid = true
+		return nil
+	}
+
+	v, err := o.Parse(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	t.Time = v
+	t.Valid = true
+	return nil
+}
+
+// MarshalTimeJSON implements json.Marshaler for t using these options.
+func (o TimeOptions) MarshalTimeJSON(t Time) ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	switch o.Format {
+	case "":
+		return t.Time.MarshalJSON()
+	case TimeLayoutUnix:
+		return []byte(o.format(t.Time)), nil
+	}
+	return json.Marshal(o.format(t.Time))
+}
+
+// UnmarshalTimeText implements encoding.TextUnmarshaler for t using these options.
+func (o TimeOptions) UnmarshalTimeText(t *Time, text []byte) error {
+	str := string(text)
+	// allowing "null" is for backwards compatibility with v3
+	if isNullText(text) || str == "null" {
+		t.Valid = false
+		return nil
+	}
+	v, err := o.Parse(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	t.Time = v
+	t.Valid = true
+	return nil
+}
+
+// MarshalTimeText implements encoding.TextMarshaler for t using these options.
+func (o TimeOptions) MarshalTimeText(t Time) ([]byte, error) {
+	if !t.Valid {
+		return nullText(), nil
+	}
+	if o.Format == "" {
+		return t.Time.MarshalText()
+	}
+	return []byte(o.format(t.Time)), nil
+}
+
+// AppendTimeJSON appends the same JSON as MarshalTimeJSON to dst.
+func (o TimeOptions) AppendTimeJSON(dst []byte, t Time) ([]byte, error) {
+	if !t.Valid {
+		return append(dst, nullBytes...), nil
+	}
+	switch o.Format {
+	case "":
+		text, err := t.Time.AppendText(append(dst, '"'))
+		if err != nil {
+			// for the same error as MarshalTimeJSON
+			_, err = t.Time.MarshalJSON()
+			return dst, err
+		}
+		return append(text, '"'), nil
+	case TimeLayoutUnix:
+		return strconv.AppendInt(dst, t.Time.Unix(), 10), nil
+	}
+	return appendJSONString(dst, o.format(t.Time)), nil
+}
+
+// AppendTimeText appends the same text as MarshalTimeText to dst.
+func (o TimeOptions) AppendTimeText(dst []byte, t Time) ([]byte, error) {
+	if !t.Valid {
+		return append(dst, DefaultNullText...), nil
+	}
+	if o.Format == "" {
+		return t.Time.AppendText(dst)
+	}
+	if o.Format == TimeLayoutUnix {
+		return strconv.AppendInt(dst, t.Time.Unix(), 10), nil
+	}
+	ti := t.Time
+	if o.Location != nil {
+		ti = ti.In(o.Location)
+	}
+	return ti.AppendFormat(dst, o.Format), nil
+}
+
+// Scan implements the Scanner interface.
+// It supports time.Time values, Unix timestamps, and strings in the layouts of DefaultTimeOptions or common driver formats.
+func (t *Time) Scan(value interface{}) (err error) {
+	defer validateDecoded(t, &err)
+	return DefaultTimeOptions.ScanTime(t, value)
+}
+
+// Value implements the driver Valuer interface.
+// It sends time.Time values, unless DefaultTimeOptions has a Format.
+func (t Time) Value() (driver.Value, error) {
+	return DefaultTimeOptions.TimeValue(t)
 }
 
 // NewTime creates a new Time.
@@ -56,53 +337,33 @@ func (t Time) ValueOrZero() time.Time {
 
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this time is null.
+// The encoding can be changed with DefaultTimeOptions.
 func (t Time) MarshalJSON() ([]byte, error) {
-	if !t.Valid {
-		return []byte("null"), nil
-	}
-	return t.Time.MarshalJSON()
+	return DefaultTimeOptions.MarshalTimeJSON(t)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports string and null input.
-func (t *Time) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, nullBytes) {
-		t.Valid = false
-		return nil
-	}
-
-	if err := json.Unmarshal(data, &t.Time); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
-	}
-
-	t.Valid = true
-	return nil
+// Strings may be RFC 3339, or any of the layouts in DefaultTimeOptions.
+func (t *Time) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(t, &err)
+	return DefaultTimeOptions.UnmarshalTimeJSON(t, data)
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It returns an empty string if invalid, otherwise time.Time's MarshalText.
+// It returns DefaultNullText if invalid, otherwise time.Time's MarshalText
+// or the format set in DefaultTimeOptions.
 func (t Time) MarshalText() ([]byte, error) {
-	if !t.Valid {
-		return []byte{}, nil
-	}
-	return t.Time.MarshalText()
+	return DefaultTimeOptions.MarshalTimeText(t)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It has backwards compatibility with v3 in that the string "null" is considered equivalent to an empty string
 // and unmarshaling will succeed. This may be removed in a future version.
-func (t *Time) UnmarshalText(text []byte) error {
-	str := string(text)
-	// allowing "null" is for backwards compatibility with v3
-	if str == "" || str == "null" {
-		t.Valid = false
-		return nil
-	}
-	if err := t.Time.UnmarshalText(text); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
-	}
-	t.Valid = true
-	return nil
+// The input may be RFC 3339, or any of the layouts in DefaultTimeOptions.
+func (t *Time) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(t, &err)
+	return DefaultTimeOptions.UnmarshalTimeText(t, text)
 }
 
 // SetValid changes this Time's value and sets it to be non-null.
@@ -111,6 +372,16 @@ func (t *Time) SetValid(v time.Time) {
 	t.Valid = true
 }
 
+// SetNull sets this Time to null, leaving its value as is. Use Reset to clear the value too.
+func (t *Time) SetNull() {
+	t.Valid = false
+}
+
+// Reset sets this Time to null and clears its value.
+func (t *Time) Reset() {
+	*t = Time{}
+}
+
 // Ptr returns a pointer to this Time's value, or a nil pointer if this Time is null.
 func (t Time) Ptr() *time.Time {
 	if !t.Valid {
@@ -119,12 +390,22 @@ func (t Time) Ptr() *time.Time {
 	return &t.Time
 }
 
+// SetPtr changes this Time's value to the value v points to, or sets it to be null if v is nil, like TimeFromPtr.
+func (t *Time) SetPtr(v *time.Time) {
+	*t = TimeFromPtr(v)
+}
+
 // IsZero returns true for invalid Times, hopefully for future omitempty support.
 // A non-null Time with a zero value will not be considered zero.
 func (t Time) IsZero() bool {
 	return !t.Valid
 }
 
+// IsZeroOrNull returns true for invalid Times, or valid Times with the zero time.
+func (t Time) IsZeroOrNull() bool {
+	return !t.Valid || t.Time.IsZero()
+}
+
 // Equal returns true if both Time objects encode the same time or are both null.
 // Two times can be equal even if they are in different locations.
 // For example, 6:00 +0200 CEST and 4:00 UTC are Equal.
@@ -160,12 +160,69 @@ func TestTimeScanValue(t *testing.T) {
 	}
 
 	var wrong Time
-	err = wrong.Scan(int64(42))
+	err = wrong.Scan(true)
 	if err == nil {
 		t.Error("expected error")
 	}
 }
 
+// Drivers that don't parse times return them as strings or Unix timestamps.
+func TestTimeScanDriverFormats(t *testing.T) {
+	tokyo := time.FixedZone("Tokyo", 9*60*60)
+	want := time.Date(2012, 12, 21, 21, 21, 21, 123456000, time.UTC)
+	for _, src := range []interface{}{
+		"2012-12-21 21:21:21.123456",
+		[]byte("2012-12-21 21:21:21.123456"),
+		"2012-12-21T21:21:21.123456",
+		"2012-12-21T21:21:21.123456Z",
+		"2012-12-21 21:21:21.123456+00:00",
+		"2012-12-22 06:21:21.123456+09",
+	} {
+		var ti Time
+		err := ti.Scan(src)
+		maybePanic(err)
+		if !ti.Valid || !ti.Time.Equal(want) {
+			t.Errorf("Scan(%#v): got %v, want %v", src, ti, want)
+		}
+	}
+
+	var date Time
+	err := date.Scan("2012-12-21")
+	maybePanic(err)
+	if !date.Time.Equal(time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad scanned date: %v", date)
+	}
+
+	var unix Time
+	err = unix.Scan(int64(1356124881))
+	maybePanic(err)
+	assertTime(t, unix, "scanned unix")
+	if unix.Time.Location() != time.UTC {
+		t.Errorf("unix time should be in UTC: %v", unix.Time.Location())
+	}
+
+	opts := TimeOptions{ScanLocation: tokyo, Format: time.RFC3339}
+	var local Time
+	err = opts.ScanTime(&local, "2012-12-22 06:21:21.123456")
+	maybePanic(err)
+	if !local.Time.Equal(want) {
+		t.Errorf("bad time in ScanLocation: %v", local)
+	}
+	err = opts.ScanTime(&local, int64(1356124881))
+	maybePanic(err)
+	if local.Time.Location() != tokyo {
+		t.Errorf("unix time should be in ScanLocation: %v", local.Time.Location())
+	}
+	if text, _ := opts.MarshalTimeText(local); string(text) != "2012-12-22T06:21:21+09:00" {
+		t.Errorf("ScanLocation shouldn't affect formatting: %s", text)
+	}
+
+	var bad Time
+	if err := bad.Scan("21/12/2012"); err == nil || bad.Valid {
+		t.Error("expected error for unknown layout")
+	}
+}
+
 func TestTimeValueOrZero(t *testing.T) {
 	valid := TimeFrom(timeValue1)
 	if valid.ValueOrZero() != valid.Time || valid.ValueOrZero().IsZero() {
@@ -298,3 +355,142 @@ func assertTimeExactEqualIsFalse(t *testing.T, a, b Time) {
 		t.Errorf("ExactEqual() of Time{%v, Valid:%t} and Time{%v, Valid:%t} should return false", a.Time, a.Valid, b.Time, b.Valid)
 	}
 }
+
+// mysqlTime is an example of using TimeOptions for a particular type.
+type mysqlTime struct {
+	Time
+}
+
+var mysqlTimeOptions = TimeOptions{
+	Layouts: []string{"2006-01-02 15:04:05"},
+	Format:  "2006-01-02 15:04:05",
+}
+
+func (t *mysqlTime) UnmarshalJSON(data []byte) error {
+	return mysqlTimeOptions.UnmarshalTimeJSON(&t.Time, data)
+}
+
+func (t mysqlTime) MarshalJSON() ([]byte, error) {
+	return mysqlTimeOptions.MarshalTimeJSON(t.Time)
+}
+
+func TestTimeOptionsLayouts(t *testing.T) {
+	DefaultTimeOptions = TimeOptions{Layouts: []string{"2006-01-02 15:04:05", TimeLayoutUnix}}
+	defer func() { DefaultTimeOptions = TimeOptions{} }()
+
+	var rfc Time
+	err := json.Unmarshal(timeJSON, &rfc)
+	maybePanic(err)
+	assertTime(t, rfc, "RFC 3339 json with layouts")
+
+	var mysql Time
+	err = json.Unmarshal([]byte(`"2012-12-21 21:21:21"`), &mysql)
+	maybePanic(err)
+	assertTime(t, mysql, "datetime json")
+
+	var unix Time
+	err = json.Unmarshal([]byte(`1356124881`), &unix)
+	maybePanic(err)
+	assertTime(t, unix, "unix json")
+
+	var unixStr Time
+	err = json.Unmarshal([]byte(`"1356124881"`), &unixStr)
+	maybePanic(err)
+	assertTime(t, unixStr, "unix string json")
+
+	var text Time
+	err = text.UnmarshalText([]byte("2012-12-21 21:21:21"))
+	maybePanic(err)
+	assertTime(t, text, "datetime text")
+
+	var scanned Time
+	err = scanned.Scan([]byte("2012-12-21 21:21:21"))
+	maybePanic(err)
+	assertTime(t, scanned, "scanned datetime")
+
+	var scannedUnix Time
+	err = scannedUnix.Scan(int64(1356124881))
+	maybePanic(err)
+	assertTime(t, scannedUnix, "scanned unix")
+
+	var bad Time
+	err = json.Unmarshal([]byte(`"21/12/2012"`), &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTime(t, bad, "bad layout json")
+
+	var float Time
+	err = json.Unmarshal(floatJSON, &float)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestTimeOptionsLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+1", 60*60)
+	DefaultTimeOptions = TimeOptions{
+		Layouts:  []string{"2006-01-02 15:04:05"},
+		Format:   "2006-01-02 15:04:05",
+		Location: loc,
+	}
+	defer func() { DefaultTimeOptions = TimeOptions{} }()
+
+	var ti Time
+	err := ti.UnmarshalText([]byte("2012-12-21 22:21:21"))
+	maybePanic(err)
+	if !ti.Time.Equal(timeValue1) || ti.Time.Location() != loc {
+		t.Errorf("bad datetime text in location: %v", ti.Time)
+	}
+
+	data, err := TimeFrom(timeValue1).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "2012-12-21 22:21:21", "text marshal in location")
+}
+
+func TestTimeOptionsFormat(t *testing.T) {
+	defer func() { DefaultTimeOptions = TimeOptions{} }()
+
+	DefaultTimeOptions = TimeOptions{Format: "2006-01-02 15:04:05"}
+	ti := TimeFrom(timeValue1)
+	data, err := json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21 21:21:21"`, "formatted json marshal")
+	data, err = ti.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "2012-12-21 21:21:21", "formatted text marshal")
+	if v, err := ti.Value(); v != "2012-12-21 21:21:21" || err != nil {
+		t.Error("bad formatted value or err:", v, err)
+	}
+
+	DefaultTimeOptions = TimeOptions{Format: TimeLayoutUnix}
+	data, err = json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, "1356124881", "unix json marshal")
+	if v, err := ti.Value(); v != int64(1356124881) || err != nil {
+		t.Error("bad unix value or err:", v, err)
+	}
+
+	null := NewTime(timeValue1, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestTimeOptionsPerType(t *testing.T) {
+	var ti mysqlTime
+	err := json.Unmarshal([]byte(`"2012-12-21 21:21:21"`), &ti)
+	maybePanic(err)
+	assertTime(t, ti.Time, "per type json")
+
+	data, err := json.Marshal(ti)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21 21:21:21"`, "per type json marshal")
+
+	// the package defaults should be unaffected
+	var def Time
+	err = json.Unmarshal([]byte(`"2012-12-21 21:21:21"`), &def)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,253 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// timeOfDayLayout is the layout used for formatting times of day.
+// Fractional seconds are only included when non-zero.
+const timeOfDayLayout = "15:04:05.999999999"
+
+// NullTimeOfDay represents a wall clock time that may be null.
+// It has no date or time zone component.
+// NullTimeOfDay implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullTimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+	Valid      bool // Valid is true if the time is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports time.Time values, using the clock time in the time's own location,
+// and "15:04:05" strings with optional fractional seconds.
+func (n *NullTimeOfDay) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var t time.Time
+	switch v := value.(type) {
+	case nil:
+		n.Hour, n.Minute, n.Second, n.Nanosecond, n.Valid = 0, 0, 0, 0, false
+		return nil
+	case time.Time:
+		t = v
+	case []byte:
+		var err error
+		if t, err = parseTimeOfDay(string(v)); err != nil {
+			return fmt.Errorf("null: couldn't scan TimeOfDay: %w", err)
+		}
+	case string:
+		var err error
+		if t, err = parseTimeOfDay(v); err != nil {
+			return fmt.Errorf("null: couldn't scan TimeOfDay: %w", err)
+		}
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into TimeOfDay: %v", value, value)
+	}
+	n.Hour, n.Minute, n.Second = t.Clock()
+	n.Nanosecond = t.Nanosecond()
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as "15:04:05" strings, with fractional seconds if non-zero.
+func (n NullTimeOfDay) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.format(), nil
+}
+
+// IsZero returns true if this NullTimeOfDay is null.
+func (n NullTimeOfDay) IsZero() bool {
+	return !n.Valid
+}
+
+func (n NullTimeOfDay) time() time.Time {
+	return time.Date(0, 1, 1, n.Hour, n.Minute, n.Second, n.Nanosecond, time.UTC)
+}
+
+func (n NullTimeOfDay) format() string {
+	return n.time().Format(timeOfDayLayout)
+}
+
+// parseTimeOfDay parses "15:04:05" with optional fractional seconds, or "15:04".
+func parseTimeOfDay(str string) (time.Time, error) {
+	t, err := time.Parse("15:04:05", str)
+	if err != nil {
+		var err2 error
+		if t, err2 = time.Parse("15:04", str); err2 != nil {
+			return time.Time{}, err
+		}
+	}
+	return t, nil
+}
+
+// TimeOfDay is a nullable wall clock time, for TIME columns.
+// It has no date or time zone component.
+// It will decode to null, not midnight, if null.
+type TimeOfDay struct {
+	NullTimeOfDay
+}
+
+// NewTimeOfDay creates a new TimeOfDay.
+func NewTimeOfDay(hour, min, sec, nsec int, valid bool) TimeOfDay {
+	return TimeOfDay{
+		NullTimeOfDay: NullTimeOfDay{
+			Hour:       hour,
+			Minute:     min,
+			Second:     sec,
+			Nanosecond: nsec,
+			Valid:      valid,
+		},
+	}
+}
+
+// TimeOfDayFrom creates a new TimeOfDay from the clock time of t in its own location.
+// It will always be valid.
+func TimeOfDayFrom(t time.Time) TimeOfDay {
+	hour, min, sec := t.Clock()
+	return NewTimeOfDay(hour, min, sec, t.Nanosecond(), true)
+}
+
+// TimeOfDayFromPtr creates a new TimeOfDay that will be null if t is nil.
+func TimeOfDayFromPtr(t *time.Time) TimeOfDay {
+	if t == nil {
+		return NewTimeOfDay(0, 0, 0, 0, false)
+	}
+	return TimeOfDayFrom(*t)
+}
+
+// ParseTimeOfDay parses a "15:04:05" string, with optional fractional seconds,
+// or a "15:04" string into a new TimeOfDay that will always be valid.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	t, err := parseTimeOfDay(s)
+	if err != nil {
+		return TimeOfDay{}, fmt.Errorf("null: couldn't parse TimeOfDay: %w", err)
+	}
+	return TimeOfDayFrom(t), nil
+}
+
+// On returns the time on the given date in loc,
+// or the zero time if this TimeOfDay is null.
+func (t TimeOfDay) On(year int, month time.Month, day int, loc *time.Location) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return time.Date(year, month, day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+// Before reports whether this TimeOfDay is earlier in the day than other.
+// It returns false if either is null.
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.Valid && other.Valid && t.time().Before(other.time())
+}
+
+// After reports whether this TimeOfDay is later in the day than other.
+// It returns false if either is null.
+func (t TimeOfDay) After(other TimeOfDay) bool {
+	return t.Valid && other.Valid && t.time().After(other.time())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports "15:04:05" strings, with optional fractional seconds, and null input.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(t, &err)
+	if bytes.Equal(data, nullBytes) {
+		t.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := parseTimeOfDay(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	*t = TimeOfDayFrom(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this TimeOfDay is null, otherwise a "15:04:05" string
+// with fractional seconds if non-zero.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.format())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null TimeOfDay if the input is blank, "null", or DefaultNullText.
+// It will return an error if the input is not a "15:04:05" time, blank, or "null".
+func (t *TimeOfDay) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(t, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		t.Valid = false
+		return nil
+	}
+	v, err := parseTimeOfDay(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	*t = TimeOfDayFrom(v)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this TimeOfDay is null.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return nullText(), nil
+	}
+	return []byte(t.format()), nil
+}
+
+// SetValid changes this TimeOfDay's value and also sets it to be non-null.
+func (t *TimeOfDay) SetValid(hour, min, sec, nsec int) {
+	*t = NewTimeOfDay(hour, min, sec, nsec, true)
+}
+
+// SetNull sets this TimeOfDay to null, leaving its value as is. Use Reset to clear the value too.
+func (t *TimeOfDay) SetNull() {
+	t.Valid = false
+}
+
+// Reset sets this TimeOfDay to null and clears its value.
+func (t *TimeOfDay) Reset() {
+	*t = TimeOfDay{}
+}
+
+// SetPtr changes this TimeOfDay's value to the value v points to, or sets it to be null if v is nil, like TimeOfDayFromPtr.
+func (t *TimeOfDay) SetPtr(v *time.Time) {
+	*t = TimeOfDayFromPtr(v)
+}
+
+// IsZero returns true for invalid TimeOfDays.
+// A non-null TimeOfDay at midnight will not be considered zero.
+func (t TimeOfDay) IsZero() bool {
+	return !t.Valid
+}
+
+// IsZeroOrNull returns true for invalid TimeOfDays, or valid TimeOfDays with a midnight value.
+func (t TimeOfDay) IsZeroOrNull() bool {
+	return !t.Valid || t.Hour == 0 && t.Minute == 0 && t.Second == 0 && t.Nanosecond == 0
+}
+
+// Equal returns true if both TimeOfDays are the same time or are both null.
+func (t TimeOfDay) Equal(other TimeOfDay) bool {
+	return t.Valid == other.Valid && (!t.Valid || t.time().Equal(other.time()))
+}
@@ -0,0 +1,276 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	timeOfDayString     = "21:21:21"
+	timeOfDayJSON       = []byte(`"` + timeOfDayString + `"`)
+	timeOfDayFracString = "21:21:21.5"
+)
+
+func TestTimeOfDayFrom(t *testing.T) {
+	tod := TimeOfDayFrom(timeValue1)
+	assertTimeOfDay(t, tod, "TimeOfDayFrom()")
+}
+
+func TestTimeOfDayFromPtr(t *testing.T) {
+	tod := TimeOfDayFromPtr(&timeValue1)
+	assertTimeOfDay(t, tod, "TimeOfDayFromPtr()")
+
+	null := TimeOfDayFromPtr(nil)
+	assertNullTimeOfDay(t, null, "TimeOfDayFromPtr(nil)")
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	tod, err := ParseTimeOfDay(timeOfDayString)
+	maybePanic(err)
+	assertTimeOfDay(t, tod, "ParseTimeOfDay()")
+
+	frac, err := ParseTimeOfDay(timeOfDayFracString)
+	maybePanic(err)
+	if frac.Nanosecond != 500000000 {
+		t.Errorf("bad fractional seconds: %d", frac.Nanosecond)
+	}
+
+	short, err := ParseTimeOfDay("21:21")
+	maybePanic(err)
+	if !short.Equal(NewTimeOfDay(21, 21, 0, 0, true)) {
+		t.Errorf("bad short time: %v", short)
+	}
+
+	_, err = ParseTimeOfDay("25:00:00")
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestTimeOfDayCompare(t *testing.T) {
+	early := NewTimeOfDay(9, 0, 0, 0, true)
+	late := NewTimeOfDay(17, 30, 0, 0, true)
+	null := NewTimeOfDay(0, 0, 0, 0, false)
+
+	if !early.Before(late) || early.After(late) {
+		t.Error("9:00 should be before 17:30")
+	}
+	if !late.After(early) || late.Before(early) {
+		t.Error("17:30 should be after 9:00")
+	}
+	if early.Before(null) || early.After(null) || null.Before(early) || null.After(early) {
+		t.Error("comparisons with null should be false")
+	}
+}
+
+func TestTimeOfDayOn(t *testing.T) {
+	tod := NewTimeOfDay(21, 21, 21, 0, true)
+	if got := tod.On(2012, 12, 21, time.UTC); !got.Equal(timeValue1) {
+		t.Errorf("bad On(): %v ≠ %v", got, timeValue1)
+	}
+
+	null := NewTimeOfDay(21, 21, 21, 0, false)
+	if !null.On(2012, 12, 21, time.UTC).IsZero() {
+		t.Error("On() should return the zero time for a null TimeOfDay")
+	}
+}
+
+func TestUnmarshalTimeOfDay(t *testing.T) {
+	var tod TimeOfDay
+	err := json.Unmarshal(timeOfDayJSON, &tod)
+	maybePanic(err)
+	assertTimeOfDay(t, tod, "time of day json")
+
+	var null TimeOfDay
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullTimeOfDay(t, null, "null json")
+
+	var bad TimeOfDay
+	err = json.Unmarshal(timeJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullTimeOfDay(t, bad, "bad time of day json")
+
+	var badType TimeOfDay
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullTimeOfDay(t, badType, "wrong type json")
+
+	var invalid TimeOfDay
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullTimeOfDay(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalTimeOfDay(t *testing.T) {
+	var tod TimeOfDay
+	err := tod.UnmarshalText([]byte(timeOfDayString))
+	maybePanic(err)
+	assertTimeOfDay(t, tod, "UnmarshalText() time of day")
+
+	var blank TimeOfDay
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullTimeOfDay(t, blank, "UnmarshalText() empty time of day")
+
+	var null TimeOfDay
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullTimeOfDay(t, null, `UnmarshalText() "null"`)
+
+	var invalid TimeOfDay
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalTimeOfDay(t *testing.T) {
+	tod := NewTimeOfDay(21, 21, 21, 0, true)
+	data, err := json.Marshal(tod)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(timeOfDayJSON), "non-empty json marshal")
+
+	frac := NewTimeOfDay(21, 21, 21, 500000000, true)
+	data, err = json.Marshal(frac)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"`+timeOfDayFracString+`"`, "fractional json marshal")
+
+	null := NewTimeOfDay(0, 0, 0, 0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalTimeOfDayText(t *testing.T) {
+	tod := NewTimeOfDay(21, 21, 21, 0, true)
+	data, err := tod.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, timeOfDayString, "non-empty text marshal")
+
+	null := NewTimeOfDay(0, 0, 0, 0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestTimeOfDayIsZero(t *testing.T) {
+	midnight := NewTimeOfDay(0, 0, 0, 0, true)
+	if midnight.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewTimeOfDay(0, 0, 0, 0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestTimeOfDaySetValid(t *testing.T) {
+	change := NewTimeOfDay(0, 0, 0, 0, false)
+	assertNullTimeOfDay(t, change, "SetValid()")
+	change.SetValid(21, 21, 21, 0)
+	assertTimeOfDay(t, change, "SetValid()")
+}
+
+func TestTimeOfDayScan(t *testing.T) {
+	var tod TimeOfDay
+	err := tod.Scan(timeValue1)
+	maybePanic(err)
+	assertTimeOfDay(t, tod, "scanned time")
+
+	var s TimeOfDay
+	err = s.Scan(timeOfDayString)
+	maybePanic(err)
+	assertTimeOfDay(t, s, "scanned string")
+
+	var b TimeOfDay
+	err = b.Scan([]byte(timeOfDayString + ".000000"))
+	maybePanic(err)
+	assertTimeOfDay(t, b, "scanned bytes")
+
+	var null TimeOfDay
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullTimeOfDay(t, null, "scanned null")
+
+	var invalid TimeOfDay
+	err = invalid.Scan("hello")
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var wrong TimeOfDay
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestTimeOfDayValue(t *testing.T) {
+	tod := NewTimeOfDay(21, 21, 21, 0, true)
+	v, err := tod.Value()
+	maybePanic(err)
+	if v != timeOfDayString {
+		t.Errorf("bad Value(): %#v ≠ %s", v, timeOfDayString)
+	}
+
+	null := NewTimeOfDay(21, 21, 21, 0, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestTimeOfDayEqual(t *testing.T) {
+	t1 := NewTimeOfDay(21, 21, 21, 0, false)
+	t2 := NewTimeOfDay(0, 0, 0, 0, false)
+	if !t1.Equal(t2) {
+		t.Error("null TimeOfDays should be equal")
+	}
+
+	t1 = NewTimeOfDay(21, 21, 21, 0, true)
+	t2 = TimeOfDayFrom(timeValue1)
+	if !t1.Equal(t2) {
+		t.Error("same TimeOfDays should be equal")
+	}
+
+	t1 = NewTimeOfDay(21, 21, 21, 0, true)
+	t2 = NewTimeOfDay(21, 21, 21, 0, false)
+	if t1.Equal(t2) {
+		t.Error("valid and null TimeOfDays should not be equal")
+	}
+
+	t1 = NewTimeOfDay(21, 21, 21, 0, true)
+	t2 = NewTimeOfDay(21, 21, 21, 1, true)
+	if t1.Equal(t2) {
+		t.Error("different TimeOfDays should not be equal")
+	}
+}
+
+func assertTimeOfDay(t *testing.T, tod TimeOfDay, from string) {
+	t.Helper()
+	if tod.Hour != 21 || tod.Minute != 21 || tod.Second != 21 || tod.Nanosecond != 0 {
+		t.Errorf("bad %s time of day: %s ≠ %s\n", from, tod.format(), timeOfDayString)
+	}
+	if !tod.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullTimeOfDay(t *testing.T, tod TimeOfDay, from string) {
+	t.Helper()
+	if tod.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
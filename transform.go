@@ -0,0 +1,1546 @@
+package null
+
+import (
+	"encoding/json"
+	"io/fs"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// The Map, Filter, Or, and OrElse methods transform values and provide fallbacks
+// without checking Valid first, and can be chained: u.Filter(positive).Map(double).OrElse(1).
+// Types made of more than one value, such as Money, Point, and the ranges, only have Or.
+// Map does not validate its result, in the same way as the New constructors.
+// Bool has no fallback Or, because Bool.Or is logical disjunction; use OrElse instead.
+
+// Map returns the result of fn applied to this Addr's value, or null if this Addr is null.
+func (a Addr) Map(fn func(netip.Addr) netip.Addr) Addr {
+	if !a.Valid {
+		return a
+	}
+	return NewAddr(fn(a.Addr), true)
+}
+
+// Filter returns this Addr if it is null or fn returns true for its value, otherwise null.
+func (a Addr) Filter(fn func(netip.Addr) bool) Addr {
+	if !a.Valid || fn(a.Addr) {
+		return a
+	}
+	return Addr{}
+}
+
+// Or returns this Addr if it is valid, otherwise other.
+func (a Addr) Or(other Addr) Addr {
+	if a.Valid {
+		return a
+	}
+	return other
+}
+
+// OrElse returns this Addr's value if it is valid, otherwise fallback.
+func (a Addr) OrElse(fallback netip.Addr) netip.Addr {
+	if !a.Valid {
+		return fallback
+	}
+	return a.Addr
+}
+
+// Map returns the result of fn applied to this Any's value, or null if this Any is null.
+func (a Any) Map(fn func(interface{}) interface{}) Any {
+	if !a.Valid {
+		return a
+	}
+	return NewAny(fn(a.Any), true)
+}
+
+// Filter returns this Any if it is null or fn returns true for its value, otherwise null.
+func (a Any) Filter(fn func(interface{}) bool) Any {
+	if !a.Valid || fn(a.Any) {
+		return a
+	}
+	return Any{}
+}
+
+// Or returns this Any if it is valid, otherwise other.
+func (a Any) Or(other Any) Any {
+	if a.Valid {
+		return a
+	}
+	return other
+}
+
+// OrElse returns this Any's value if it is valid, otherwise fallback.
+func (a Any) OrElse(fallback interface{}) interface{} {
+	if !a.Valid {
+		return fallback
+	}
+	return a.Any
+}
+
+// Map returns the result of fn applied to this Array's value, or null if this Array is null.
+func (a Array[T]) Map(fn func([]T) []T) Array[T] {
+	if !a.Valid {
+		return a
+	}
+	return NewArray(fn(a.V), true)
+}
+
+// Filter returns this Array if it is null or fn returns true for its value, otherwise null.
+func (a Array[T]) Filter(fn func([]T) bool) Array[T] {
+	if !a.Valid || fn(a.V) {
+		return a
+	}
+	return Array[T]{}
+}
+
+// Or returns this Array if it is valid, otherwise other.
+func (a Array[T]) Or(other Array[T]) Array[T] {
+	if a.Valid {
+		return a
+	}
+	return other
+}
+
+// OrElse returns this Array's value if it is valid, otherwise fallback.
+func (a Array[T]) OrElse(fallback []T) []T {
+	if !a.Valid {
+		return fallback
+	}
+	return a.V
+}
+
+// Map returns the result of fn applied to this Base64's value, or null if this Base64 is null.
+func (b Base64) Map(fn func([]byte) []byte) Base64 {
+	if !b.Valid {
+		return b
+	}
+	return NewBase64(fn(b.Bytes), true)
+}
+
+// Filter returns this Base64 if it is null or fn returns true for its value, otherwise null.
+func (b Base64) Filter(fn func([]byte) bool) Base64 {
+	if !b.Valid || fn(b.Bytes) {
+		return b
+	}
+	return Base64{}
+}
+
+// Or returns this Base64 if it is valid, otherwise other.
+func (b Base64) Or(other Base64) Base64 {
+	if b.Valid {
+		return b
+	}
+	return other
+}
+
+// OrElse returns this Base64's value if it is valid, otherwise fallback.
+func (b Base64) OrElse(fallback []byte) []byte {
+	if !b.Valid {
+		return fallback
+	}
+	return b.Bytes
+}
+
+// Map returns the result of fn applied to this BigInt's value, or null if this BigInt is null.
+func (b BigInt) Map(fn func(*big.Int) *big.Int) BigInt {
+	if !b.Valid {
+		return b
+	}
+	return NewBigInt(fn(b.BigInt), true)
+}
+
+// Filter returns this BigInt if it is null or fn returns true for its value, otherwise null.
+func (b BigInt) Filter(fn func(*big.Int) bool) BigInt {
+	if !b.Valid || fn(b.BigInt) {
+		return b
+	}
+	return BigInt{}
+}
+
+// Or returns this BigInt if it is valid, otherwise other.
+func (b BigInt) Or(other BigInt) BigInt {
+	if b.Valid {
+		return b
+	}
+	return other
+}
+
+// OrElse returns this BigInt's value if it is valid, otherwise fallback.
+func (b BigInt) OrElse(fallback *big.Int) *big.Int {
+	if !b.Valid {
+		return fallback
+	}
+	return b.BigInt
+}
+
+// Map returns the result of fn applied to this Bool's value, or null if this Bool is null.
+func (b Bool) Map(fn func(bool) bool) Bool {
+	if !b.Valid {
+		return b
+	}
+	return NewBool(fn(b.Bool), true)
+}
+
+// Filter returns this Bool if it is null or fn returns true for its value, otherwise null.
+func (b Bool) Filter(fn func(bool) bool) Bool {
+	if !b.Valid || fn(b.Bool) {
+		return b
+	}
+	return Bool{}
+}
+
+// OrElse returns this Bool's value if it is valid, otherwise fallback.
+func (b Bool) OrElse(fallback bool) bool {
+	if !b.Valid {
+		return fallback
+	}
+	return b.Bool
+}
+
+// Map returns the result of fn applied to this Bytes's value, or null if this Bytes is null.
+func (b Bytes) Map(fn func([]byte) []byte) Bytes {
+	if !b.Valid {
+		return b
+	}
+	return NewBytes(fn(b.Bytes), true)
+}
+
+// Filter returns this Bytes if it is null or fn returns true for its value, otherwise null.
+func (b Bytes) Filter(fn func([]byte) bool) Bytes {
+	if !b.Valid || fn(b.Bytes) {
+		return b
+	}
+	return Bytes{}
+}
+
+// Or returns this Bytes if it is valid, otherwise other.
+func (b Bytes) Or(other Bytes) Bytes {
+	if b.Valid {
+		return b
+	}
+	return other
+}
+
+// OrElse returns this Bytes's value if it is valid, otherwise fallback.
+func (b Bytes) OrElse(fallback []byte) []byte {
+	if !b.Valid {
+		return fallback
+	}
+	return b.Bytes
+}
+
+// Map returns the result of fn applied to this ByteSize's value, or null if this ByteSize is null.
+func (b ByteSize) Map(fn func(uint64) uint64) ByteSize {
+	if !b.Valid {
+		return b
+	}
+	return NewByteSize(fn(b.Size), true)
+}
+
+// Filter returns this ByteSize if it is null or fn returns true for its value, otherwise null.
+func (b ByteSize) Filter(fn func(uint64) bool) ByteSize {
+	if !b.Valid || fn(b.Size) {
+		return b
+	}
+	return ByteSize{}
+}
+
+// Or returns this ByteSize if it is valid, otherwise other.
+func (b ByteSize) Or(other ByteSize) ByteSize {
+	if b.Valid {
+		return b
+	}
+	return other
+}
+
+// OrElse returns this ByteSize's value if it is valid, otherwise fallback.
+func (b ByteSize) OrElse(fallback uint64) uint64 {
+	if !b.Valid {
+		return fallback
+	}
+	return b.Size
+}
+
+// Map returns the result of fn applied to this Complex's value, or null if this Complex is null.
+func (c Complex) Map(fn func(complex128) complex128) Complex {
+	if !c.Valid {
+		return c
+	}
+	return NewComplex(fn(c.Complex), true)
+}
+
+// Filter returns this Complex if it is null or fn returns true for its value, otherwise null.
+func (c Complex) Filter(fn func(complex128) bool) Complex {
+	if !c.Valid || fn(c.Complex) {
+		return c
+	}
+	return Complex{}
+}
+
+// Or returns this Complex if it is valid, otherwise other.
+func (c Complex) Or(other Complex) Complex {
+	if c.Valid {
+		return c
+	}
+	return other
+}
+
+// OrElse returns this Complex's value if it is valid, otherwise fallback.
+func (c Complex) OrElse(fallback complex128) complex128 {
+	if !c.Valid {
+		return fallback
+	}
+	return c.Complex
+}
+
+// Map returns the result of fn applied to this CountryCode's value, or null if this CountryCode is null.
+func (c CountryCode) Map(fn func(string) string) CountryCode {
+	if !c.Valid {
+		return c
+	}
+	return NewCountryCode(fn(c.Code), true)
+}
+
+// Filter returns this CountryCode if it is null or fn returns true for its value, otherwise null.
+func (c CountryCode) Filter(fn func(string) bool) CountryCode {
+	if !c.Valid || fn(c.Code) {
+		return c
+	}
+	return CountryCode{}
+}
+
+// Or returns this CountryCode if it is valid, otherwise other.
+func (c CountryCode) Or(other CountryCode) CountryCode {
+	if c.Valid {
+		return c
+	}
+	return other
+}
+
+// OrElse returns this CountryCode's value if it is valid, otherwise fallback.
+func (c CountryCode) OrElse(fallback string) string {
+	if !c.Valid {
+		return fallback
+	}
+	return c.Code
+}
+
+// Map returns the result of fn applied to this CurrencyCode's value, or null if this CurrencyCode is null.
+func (c CurrencyCode) Map(fn func(string) string) CurrencyCode {
+	if !c.Valid {
+		return c
+	}
+	return NewCurrencyCode(fn(c.Code), true)
+}
+
+// Filter returns this CurrencyCode if it is null or fn returns true for its value, otherwise null.
+func (c CurrencyCode) Filter(fn func(string) bool) CurrencyCode {
+	if !c.Valid || fn(c.Code) {
+		return c
+	}
+	return CurrencyCode{}
+}
+
+// Or returns this CurrencyCode if it is valid, otherwise other.
+func (c CurrencyCode) Or(other CurrencyCode) CurrencyCode {
+	if c.Valid {
+		return c
+	}
+	return other
+}
+
+// OrElse returns this CurrencyCode's value if it is valid, otherwise fallback.
+func (c CurrencyCode) OrElse(fallback string) string {
+	if !c.Valid {
+		return fallback
+	}
+	return c.Code
+}
+
+// Map returns the result of fn applied to this Date's value, or null if this Date is null.
+func (d Date) Map(fn func(time.Time) time.Time) Date {
+	if !d.Valid {
+		return d
+	}
+	return DateFrom(fn(d.In(time.UTC)))
+}
+
+// Filter returns this Date if it is null or fn returns true for its value, otherwise null.
+func (d Date) Filter(fn func(time.Time) bool) Date {
+	if !d.Valid || fn(d.In(time.UTC)) {
+		return d
+	}
+	return Date{}
+}
+
+// Or returns this Date if it is valid, otherwise other.
+func (d Date) Or(other Date) Date {
+	if d.Valid {
+		return d
+	}
+	return other
+}
+
+// OrElse returns this Date's value if it is valid, otherwise fallback.
+func (d Date) OrElse(fallback time.Time) time.Time {
+	if !d.Valid {
+		return fallback
+	}
+	return d.In(time.UTC)
+}
+
+// Map returns the result of fn applied to this Duration's value, or null if this Duration is null.
+func (d Duration) Map(fn func(time.Duration) time.Duration) Duration {
+	if !d.Valid {
+		return d
+	}
+	return NewDuration(fn(d.Duration), true)
+}
+
+// Filter returns this Duration if it is null or fn returns true for its value, otherwise null.
+func (d Duration) Filter(fn func(time.Duration) bool) Duration {
+	if !d.Valid || fn(d.Duration) {
+		return d
+	}
+	return Duration{}
+}
+
+// Or returns this Duration if it is valid, otherwise other.
+func (d Duration) Or(other Duration) Duration {
+	if d.Valid {
+		return d
+	}
+	return other
+}
+
+// OrElse returns this Duration's value if it is valid, otherwise fallback.
+func (d Duration) OrElse(fallback time.Duration) time.Duration {
+	if !d.Valid {
+		return fallback
+	}
+	return d.Duration
+}
+
+// Map returns the result of fn applied to this Email's value, or null if this Email is null.
+func (e Email) Map(fn func(string) string) Email {
+	if !e.Valid {
+		return e
+	}
+	return NewEmail(fn(e.Address), true)
+}
+
+// Filter returns this Email if it is null or fn returns true for its value, otherwise null.
+func (e Email) Filter(fn func(string) bool) Email {
+	if !e.Valid || fn(e.Address) {
+		return e
+	}
+	return Email{}
+}
+
+// Or returns this Email if it is valid, otherwise other.
+func (e Email) Or(other Email) Email {
+	if e.Valid {
+		return e
+	}
+	return other
+}
+
+// OrElse returns this Email's value if it is valid, otherwise fallback.
+func (e Email) OrElse(fallback string) string {
+	if !e.Valid {
+		return fallback
+	}
+	return e.Address
+}
+
+// Map returns the result of fn applied to this Enum's value, or null if this Enum is null.
+func (e Enum[T]) Map(fn func(T) T) Enum[T] {
+	if !e.Valid {
+		return e
+	}
+	return NewEnum(fn(e.V), true)
+}
+
+// Filter returns this Enum if it is null or fn returns true for its value, otherwise null.
+func (e Enum[T]) Filter(fn func(T) bool) Enum[T] {
+	if !e.Valid || fn(e.V) {
+		return e
+	}
+	return Enum[T]{}
+}
+
+// Or returns this Enum if it is valid, otherwise other.
+func (e Enum[T]) Or(other Enum[T]) Enum[T] {
+	if e.Valid {
+		return e
+	}
+	return other
+}
+
+// OrElse returns this Enum's value if it is valid, otherwise fallback.
+func (e Enum[T]) OrElse(fallback T) T {
+	if !e.Valid {
+		return fallback
+	}
+	return e.V
+}
+
+// Map returns the result of fn applied to this FileMode's value, or null if this FileMode is null.
+func (m FileMode) Map(fn func(fs.FileMode) fs.FileMode) FileMode {
+	if !m.Valid {
+		return m
+	}
+	return NewFileMode(fn(m.FileMode), true)
+}
+
+// Filter returns this FileMode if it is null or fn returns true for its value, otherwise null.
+func (m FileMode) Filter(fn func(fs.FileMode) bool) FileMode {
+	if !m.Valid || fn(m.FileMode) {
+		return m
+	}
+	return FileMode{}
+}
+
+// Or returns this FileMode if it is valid, otherwise other.
+func (m FileMode) Or(other FileMode) FileMode {
+	if m.Valid {
+		return m
+	}
+	return other
+}
+
+// OrElse returns this FileMode's value if it is valid, otherwise fallback.
+func (m FileMode) OrElse(fallback fs.FileMode) fs.FileMode {
+	if !m.Valid {
+		return fallback
+	}
+	return m.FileMode
+}
+
+// Map returns the result of fn applied to this FixedHex's value, or null if this FixedHex is null.
+func (h FixedHex[A]) Map(fn func(A) A) FixedHex[A] {
+	if !h.Valid {
+		return h
+	}
+	return NewFixedHex(fn(h.V), true)
+}
+
+// Filter returns this FixedHex if it is null or fn returns true for its value, otherwise null.
+func (h FixedHex[A]) Filter(fn func(A) bool) FixedHex[A] {
+	if !h.Valid || fn(h.V) {
+		return h
+	}
+	return FixedHex[A]{}
+}
+
+// Or returns this FixedHex if it is valid, otherwise other.
+func (h FixedHex[A]) Or(other FixedHex[A]) FixedHex[A] {
+	if h.Valid {
+		return h
+	}
+	return other
+}
+
+// OrElse returns this FixedHex's value if it is valid, otherwise fallback.
+func (h FixedHex[A]) OrElse(fallback A) A {
+	if !h.Valid {
+		return fallback
+	}
+	return h.V
+}
+
+// Map returns the result of fn applied to this Flags's value, or null if this Flags is null.
+func (f Flags[T]) Map(fn func(T) T) Flags[T] {
+	if !f.Valid {
+		return f
+	}
+	return NewFlags(fn(f.V), true)
+}
+
+// Filter returns this Flags if it is null or fn returns true for its value, otherwise null.
+func (f Flags[T]) Filter(fn func(T) bool) Flags[T] {
+	if !f.Valid || fn(f.V) {
+		return f
+	}
+	return Flags[T]{}
+}
+
+// Or returns this Flags if it is valid, otherwise other.
+func (f Flags[T]) Or(other Flags[T]) Flags[T] {
+	if f.Valid {
+		return f
+	}
+	return other
+}
+
+// OrElse returns this Flags's value if it is valid, otherwise fallback.
+func (f Flags[T]) OrElse(fallback T) T {
+	if !f.Valid {
+		return fallback
+	}
+	return f.V
+}
+
+// Map returns the result of fn applied to this Float's value, or null if this Float is null.
+func (f Float) Map(fn func(float64) float64) Float {
+	if !f.Valid {
+		return f
+	}
+	return NewFloat(fn(f.Float64), true)
+}
+
+// Filter returns this Float if it is null or fn returns true for its value, otherwise null.
+func (f Float) Filter(fn func(float64) bool) Float {
+	if !f.Valid || fn(f.Float64) {
+		return f
+	}
+	return Float{}
+}
+
+// Or returns this Float if it is valid, otherwise other.
+func (f Float) Or(other Float) Float {
+	if f.Valid {
+		return f
+	}
+	return other
+}
+
+// OrElse returns this Float's value if it is valid, otherwise fallback.
+func (f Float) OrElse(fallback float64) float64 {
+	if !f.Valid {
+		return fallback
+	}
+	return f.Float64
+}
+
+// Map returns the result of fn applied to this HardwareAddr's value, or null if this HardwareAddr is null.
+func (h HardwareAddr) Map(fn func(net.HardwareAddr) net.HardwareAddr) HardwareAddr {
+	if !h.Valid {
+		return h
+	}
+	return NewHardwareAddr(fn(h.HardwareAddr), true)
+}
+
+// Filter returns this HardwareAddr if it is null or fn returns true for its value, otherwise null.
+func (h HardwareAddr) Filter(fn func(net.HardwareAddr) bool) HardwareAddr {
+	if !h.Valid || fn(h.HardwareAddr) {
+		return h
+	}
+	return HardwareAddr{}
+}
+
+// Or returns this HardwareAddr if it is valid, otherwise other.
+func (h HardwareAddr) Or(other HardwareAddr) HardwareAddr {
+	if h.Valid {
+		return h
+	}
+	return other
+}
+
+// OrElse returns this HardwareAddr's value if it is valid, otherwise fallback.
+func (h HardwareAddr) OrElse(fallback net.HardwareAddr) net.HardwareAddr {
+	if !h.Valid {
+		return fallback
+	}
+	return h.HardwareAddr
+}
+
+// Map returns the result of fn applied to this Hex's value, or null if this Hex is null.
+func (h Hex) Map(fn func([]byte) []byte) Hex {
+	if !h.Valid {
+		return h
+	}
+	return NewHex(fn(h.Bytes), true)
+}
+
+// Filter returns this Hex if it is null or fn returns true for its value, otherwise null.
+func (h Hex) Filter(fn func([]byte) bool) Hex {
+	if !h.Valid || fn(h.Bytes) {
+		return h
+	}
+	return Hex{}
+}
+
+// Or returns this Hex if it is valid, otherwise other.
+func (h Hex) Or(other Hex) Hex {
+	if h.Valid {
+		return h
+	}
+	return other
+}
+
+// OrElse returns this Hex's value if it is valid, otherwise fallback.
+func (h Hex) OrElse(fallback []byte) []byte {
+	if !h.Valid {
+		return fallback
+	}
+	return h.Bytes
+}
+
+// Map returns the result of fn applied to this Int's value, or null if this Int is null.
+func (i Int) Map(fn func(int64) int64) Int {
+	if !i.Valid {
+		return i
+	}
+	return NewInt(fn(i.Int64), true)
+}
+
+// Filter returns this Int if it is null or fn returns true for its value, otherwise null.
+func (i Int) Filter(fn func(int64) bool) Int {
+	if !i.Valid || fn(i.Int64) {
+		return i
+	}
+	return Int{}
+}
+
+// Or returns this Int if it is valid, otherwise other.
+func (i Int) Or(other Int) Int {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// OrElse returns this Int's value if it is valid, otherwise fallback.
+func (i Int) OrElse(fallback int64) int64 {
+	if !i.Valid {
+		return fallback
+	}
+	return i.Int64
+}
+
+// Map returns the result of fn applied to this Int16's value, or null if this Int16 is null.
+func (i Int16) Map(fn func(int16) int16) Int16 {
+	if !i.Valid {
+		return i
+	}
+	return NewInt16(fn(i.Int16), true)
+}
+
+// Filter returns this Int16 if it is null or fn returns true for its value, otherwise null.
+func (i Int16) Filter(fn func(int16) bool) Int16 {
+	if !i.Valid || fn(i.Int16) {
+		return i
+	}
+	return Int16{}
+}
+
+// Or returns this Int16 if it is valid, otherwise other.
+func (i Int16) Or(other Int16) Int16 {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// OrElse returns this Int16's value if it is valid, otherwise fallback.
+func (i Int16) OrElse(fallback int16) int16 {
+	if !i.Valid {
+		return fallback
+	}
+	return i.Int16
+}
+
+// Map returns the result of fn applied to this Int32's value, or null if this Int32 is null.
+func (i Int32) Map(fn func(int32) int32) Int32 {
+	if !i.Valid {
+		return i
+	}
+	return NewInt32(fn(i.Int32), true)
+}
+
+// Filter returns this Int32 if it is null or fn returns true for its value, otherwise null.
+func (i Int32) Filter(fn func(int32) bool) Int32 {
+	if !i.Valid || fn(i.Int32) {
+		return i
+	}
+	return Int32{}
+}
+
+// Or returns this Int32 if it is valid, otherwise other.
+func (i Int32) Or(other Int32) Int32 {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// OrElse returns this Int32's value if it is valid, otherwise fallback.
+func (i Int32) OrElse(fallback int32) int32 {
+	if !i.Valid {
+		return fallback
+	}
+	return i.Int32
+}
+
+// Map returns the result of fn applied to this Int8's value, or null if this Int8 is null.
+func (i Int8) Map(fn func(int8) int8) Int8 {
+	if !i.Valid {
+		return i
+	}
+	return NewInt8(fn(i.Int8), true)
+}
+
+// Filter returns this Int8 if it is null or fn returns true for its value, otherwise null.
+func (i Int8) Filter(fn func(int8) bool) Int8 {
+	if !i.Valid || fn(i.Int8) {
+		return i
+	}
+	return Int8{}
+}
+
+// Or returns this Int8 if it is valid, otherwise other.
+func (i Int8) Or(other Int8) Int8 {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// OrElse returns this Int8's value if it is valid, otherwise fallback.
+func (i Int8) OrElse(fallback int8) int8 {
+	if !i.Valid {
+		return fallback
+	}
+	return i.Int8
+}
+
+// Map returns the result of fn applied to this JSON's value, or null if this JSON is null.
+func (j JSON) Map(fn func(json.RawMessage) json.RawMessage) JSON {
+	if !j.Valid {
+		return j
+	}
+	return NewJSON(fn(j.JSON), true)
+}
+
+// Filter returns this JSON if it is null or fn returns true for its value, otherwise null.
+func (j JSON) Filter(fn func(json.RawMessage) bool) JSON {
+	if !j.Valid || fn(j.JSON) {
+		return j
+	}
+	return JSON{}
+}
+
+// Or returns this JSON if it is valid, otherwise other.
+func (j JSON) Or(other JSON) JSON {
+	if j.Valid {
+		return j
+	}
+	return other
+}
+
+// OrElse returns this JSON's value if it is valid, otherwise fallback.
+func (j JSON) OrElse(fallback json.RawMessage) json.RawMessage {
+	if !j.Valid {
+		return fallback
+	}
+	return j.JSON
+}
+
+// Map returns the result of fn applied to this KSUID's value, or null if this KSUID is null.
+func (k KSUID) Map(fn func([20]byte) [20]byte) KSUID {
+	if !k.Valid {
+		return k
+	}
+	return NewKSUID(fn(k.KSUID), true)
+}
+
+// Filter returns this KSUID if it is null or fn returns true for its value, otherwise null.
+func (k KSUID) Filter(fn func([20]byte) bool) KSUID {
+	if !k.Valid || fn(k.KSUID) {
+		return k
+	}
+	return KSUID{}
+}
+
+// Or returns this KSUID if it is valid, otherwise other.
+func (k KSUID) Or(other KSUID) KSUID {
+	if k.Valid {
+		return k
+	}
+	return other
+}
+
+// OrElse returns this KSUID's value if it is valid, otherwise fallback.
+func (k KSUID) OrElse(fallback [20]byte) [20]byte {
+	if !k.Valid {
+		return fallback
+	}
+	return k.KSUID
+}
+
+// Map returns the result of fn applied to this LanguageTag's value, or null if this LanguageTag is null.
+func (l LanguageTag) Map(fn func(string) string) LanguageTag {
+	if !l.Valid {
+		return l
+	}
+	return NewLanguageTag(fn(l.Tag), true)
+}
+
+// Filter returns this LanguageTag if it is null or fn returns true for its value, otherwise null.
+func (l LanguageTag) Filter(fn func(string) bool) LanguageTag {
+	if !l.Valid || fn(l.Tag) {
+		return l
+	}
+	return LanguageTag{}
+}
+
+// Or returns this LanguageTag if it is valid, otherwise other.
+func (l LanguageTag) Or(other LanguageTag) LanguageTag {
+	if l.Valid {
+		return l
+	}
+	return other
+}
+
+// OrElse returns this LanguageTag's value if it is valid, otherwise fallback.
+func (l LanguageTag) OrElse(fallback string) string {
+	if !l.Valid {
+		return fallback
+	}
+	return l.Tag
+}
+
+// Map returns the result of fn applied to this Map's value, or null if this Map is null.
+func (m Map[K, V]) Map(fn func(map[K]V) map[K]V) Map[K, V] {
+	if !m.Valid {
+		return m
+	}
+	return NewMap(fn(m.V), true)
+}
+
+// Filter returns this Map if it is null or fn returns true for its value, otherwise null.
+func (m Map[K, V]) Filter(fn func(map[K]V) bool) Map[K, V] {
+	if !m.Valid || fn(m.V) {
+		return m
+	}
+	return Map[K, V]{}
+}
+
+// Or returns this Map if it is valid, otherwise other.
+func (m Map[K, V]) Or(other Map[K, V]) Map[K, V] {
+	if m.Valid {
+		return m
+	}
+	return other
+}
+
+// OrElse returns this Map's value if it is valid, otherwise fallback.
+func (m Map[K, V]) OrElse(fallback map[K]V) map[K]V {
+	if !m.Valid {
+		return fallback
+	}
+	return m.V
+}
+
+// Map returns the result of fn applied to this Optional's value, or null if this Optional is null.
+func (o Optional[T]) Map(fn func(T) T) Optional[T] {
+	if !o.Valid {
+		return o
+	}
+	return OptionalFrom(fn(o.V))
+}
+
+// Filter returns this Optional if it is null or fn returns true for its value, otherwise null.
+func (o Optional[T]) Filter(fn func(T) bool) Optional[T] {
+	if !o.Valid || fn(o.V) {
+		return o
+	}
+	return OptionalNull[T]()
+}
+
+// Or returns this Optional if it is valid, otherwise other.
+func (o Optional[T]) Or(other Optional[T]) Optional[T] {
+	if o.Valid {
+		return o
+	}
+	return other
+}
+
+// OrElse returns this Optional's value if it is valid, otherwise fallback.
+func (o Optional[T]) OrElse(fallback T) T {
+	if !o.Valid {
+		return fallback
+	}
+	return o.V
+}
+
+// Map returns the result of fn applied to this Percent's value, or null if this Percent is null.
+func (p Percent) Map(fn func(float64) float64) Percent {
+	if !p.Valid {
+		return p
+	}
+	return NewPercent(fn(p.Float64), true)
+}
+
+// Filter returns this Percent if it is null or fn returns true for its value, otherwise null.
+func (p Percent) Filter(fn func(float64) bool) Percent {
+	if !p.Valid || fn(p.Float64) {
+		return p
+	}
+	return Percent{}
+}
+
+// Or returns this Percent if it is valid, otherwise other.
+func (p Percent) Or(other Percent) Percent {
+	if p.Valid {
+		return p
+	}
+	return other
+}
+
+// OrElse returns this Percent's value if it is valid, otherwise fallback.
+func (p Percent) OrElse(fallback float64) float64 {
+	if !p.Valid {
+		return fallback
+	}
+	return p.Float64
+}
+
+// Map returns the result of fn applied to this Prefix's value, or null if this Prefix is null.
+func (p Prefix) Map(fn func(netip.Prefix) netip.Prefix) Prefix {
+	if !p.Valid {
+		return p
+	}
+	return NewPrefix(fn(p.Prefix), true)
+}
+
+// Filter returns this Prefix if it is null or fn returns true for its value, otherwise null.
+func (p Prefix) Filter(fn func(netip.Prefix) bool) Prefix {
+	if !p.Valid || fn(p.Prefix) {
+		return p
+	}
+	return Prefix{}
+}
+
+// Or returns this Prefix if it is valid, otherwise other.
+func (p Prefix) Or(other Prefix) Prefix {
+	if p.Valid {
+		return p
+	}
+	return other
+}
+
+// OrElse returns this Prefix's value if it is valid, otherwise fallback.
+func (p Prefix) OrElse(fallback netip.Prefix) netip.Prefix {
+	if !p.Valid {
+		return fallback
+	}
+	return p.Prefix
+}
+
+// Map returns the result of fn applied to this Regexp's value, or null if this Regexp is null.
+func (r Regexp) Map(fn func(*regexp.Regexp) *regexp.Regexp) Regexp {
+	if !r.Valid {
+		return r
+	}
+	return NewRegexp(fn(r.Regexp), true)
+}
+
+// Filter returns this Regexp if it is null or fn returns true for its value, otherwise null.
+func (r Regexp) Filter(fn func(*regexp.Regexp) bool) Regexp {
+	if !r.Valid || fn(r.Regexp) {
+		return r
+	}
+	return Regexp{}
+}
+
+// Or returns this Regexp if it is valid, otherwise other.
+func (r Regexp) Or(other Regexp) Regexp {
+	if r.Valid {
+		return r
+	}
+	return other
+}
+
+// OrElse returns this Regexp's value if it is valid, otherwise fallback.
+func (r Regexp) OrElse(fallback *regexp.Regexp) *regexp.Regexp {
+	if !r.Valid {
+		return fallback
+	}
+	return r.Regexp
+}
+
+// Map returns the result of fn applied to this Semver's value, or null if this Semver is null.
+func (s Semver) Map(fn func(string) string) Semver {
+	if !s.Valid {
+		return s
+	}
+	return NewSemver(fn(s.Version), true)
+}
+
+// Filter returns this Semver if it is null or fn returns true for its value, otherwise null.
+func (s Semver) Filter(fn func(string) bool) Semver {
+	if !s.Valid || fn(s.Version) {
+		return s
+	}
+	return Semver{}
+}
+
+// Or returns this Semver if it is valid, otherwise other.
+func (s Semver) Or(other Semver) Semver {
+	if s.Valid {
+		return s
+	}
+	return other
+}
+
+// OrElse returns this Semver's value if it is valid, otherwise fallback.
+func (s Semver) OrElse(fallback string) string {
+	if !s.Valid {
+		return fallback
+	}
+	return s.Version
+}
+
+// Map returns the result of fn applied to this Slice's value, or null if this Slice is null.
+func (s Slice[T]) Map(fn func([]T) []T) Slice[T] {
+	if !s.Valid {
+		return s
+	}
+	return NewSlice(fn(s.V), true)
+}
+
+// Filter returns this Slice if it is null or fn returns true for its value, otherwise null.
+func (s Slice[T]) Filter(fn func([]T) bool) Slice[T] {
+	if !s.Valid || fn(s.V) {
+		return s
+	}
+	return Slice[T]{}
+}
+
+// Or returns this Slice if it is valid, otherwise other.
+func (s Slice[T]) Or(other Slice[T]) Slice[T] {
+	if s.Valid {
+		return s
+	}
+	return other
+}
+
+// OrElse returns this Slice's value if it is valid, otherwise fallback.
+func (s Slice[T]) OrElse(fallback []T) []T {
+	if !s.Valid {
+		return fallback
+	}
+	return s.V
+}
+
+// Map returns the result of fn applied to this String's value, or null if this String is null.
+func (s String) Map(fn func(string) string) String {
+	if !s.Valid {
+		return s
+	}
+	return NewString(fn(s.String), true)
+}
+
+// Filter returns this String if it is null or fn returns true for its value, otherwise null.
+func (s String) Filter(fn func(string) bool) String {
+	if !s.Valid || fn(s.String) {
+		return s
+	}
+	return String{}
+}
+
+// Or returns this String if it is valid, otherwise other.
+func (s String) Or(other String) String {
+	if s.Valid {
+		return s
+	}
+	return other
+}
+
+// OrElse returns this String's value if it is valid, otherwise fallback.
+func (s String) OrElse(fallback string) string {
+	if !s.Valid {
+		return fallback
+	}
+	return s.String
+}
+
+// Map returns the result of fn applied to this Time's value, or null if this Time is null.
+func (t Time) Map(fn func(time.Time) time.Time) Time {
+	if !t.Valid {
+		return t
+	}
+	return NewTime(fn(t.Time), true)
+}
+
+// Filter returns this Time if it is null or fn returns true for its value, otherwise null.
+func (t Time) Filter(fn func(time.Time) bool) Time {
+	if !t.Valid || fn(t.Time) {
+		return t
+	}
+	return Time{}
+}
+
+// Or returns this Time if it is valid, otherwise other.
+func (t Time) Or(other Time) Time {
+	if t.Valid {
+		return t
+	}
+	return other
+}
+
+// OrElse returns this Time's value if it is valid, otherwise fallback.
+func (t Time) OrElse(fallback time.Time) time.Time {
+	if !t.Valid {
+		return fallback
+	}
+	return t.Time
+}
+
+// Map returns the result of fn applied to this TimeOfDay's value, or null if this TimeOfDay is null.
+func (t TimeOfDay) Map(fn func(time.Time) time.Time) TimeOfDay {
+	if !t.Valid {
+		return t
+	}
+	return TimeOfDayFrom(fn(t.time()))
+}
+
+// Filter returns this TimeOfDay if it is null or fn returns true for its value, otherwise null.
+func (t TimeOfDay) Filter(fn func(time.Time) bool) TimeOfDay {
+	if !t.Valid || fn(t.time()) {
+		return t
+	}
+	return TimeOfDay{}
+}
+
+// Or returns this TimeOfDay if it is valid, otherwise other.
+func (t TimeOfDay) Or(other TimeOfDay) TimeOfDay {
+	if t.Valid {
+		return t
+	}
+	return other
+}
+
+// OrElse returns this TimeOfDay's value if it is valid, otherwise fallback.
+func (t TimeOfDay) OrElse(fallback time.Time) time.Time {
+	if !t.Valid {
+		return fallback
+	}
+	return t.time()
+}
+
+// Map returns the result of fn applied to this Uint's value, or null if this Uint is null.
+func (i Uint) Map(fn func(uint64) uint64) Uint {
+	if !i.Valid {
+		return i
+	}
+	return NewUint(fn(i.Uint64), true)
+}
+
+// Filter returns this Uint if it is null or fn returns true for its value, otherwise null.
+func (i Uint) Filter(fn func(uint64) bool) Uint {
+	if !i.Valid || fn(i.Uint64) {
+		return i
+	}
+	return Uint{}
+}
+
+// Or returns this Uint if it is valid, otherwise other.
+func (i Uint) Or(other Uint) Uint {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// OrElse returns this Uint's value if it is valid, otherwise fallback.
+func (i Uint) OrElse(fallback uint64) uint64 {
+	if !i.Valid {
+		return fallback
+	}
+	return i.Uint64
+}
+
+// Map returns the result of fn applied to this Uint16's value, or null if this Uint16 is null.
+func (i Uint16) Map(fn func(uint16) uint16) Uint16 {
+	if !i.Valid {
+		return i
+	}
+	return NewUint16(fn(i.Uint16), true)
+}
+
+// Filter returns this Uint16 if it is null or fn returns true for its value, otherwise null.
+func (i Uint16) Filter(fn func(uint16) bool) Uint16 {
+	if !i.Valid || fn(i.Uint16) {
+		return i
+	}
+	return Uint16{}
+}
+
+// Or returns this Uint16 if it is valid, otherwise other.
+func (i Uint16) Or(other Uint16) Uint16 {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// OrElse returns this Uint16's value if it is valid, otherwise fallback.
+func (i Uint16) OrElse(fallback uint16) uint16 {
+	if !i.Valid {
+		return fallback
+	}
+	return i.Uint16
+}
+
+// Map returns the result of fn applied to this Uint32's value, or null if this Uint32 is null.
+func (i Uint32) Map(fn func(uint32) uint32) Uint32 {
+	if !i.Valid {
+		return i
+	}
+	return NewUint32(fn(i.Uint32), true)
+}
+
+// Filter returns this Uint32 if it is null or fn returns true for its value, otherwise null.
+func (i Uint32) Filter(fn func(uint32) bool) Uint32 {
+	if !i.Valid || fn(i.Uint32) {
+		return i
+	}
+	return Uint32{}
+}
+
+// Or returns this Uint32 if it is valid, otherwise other.
+func (i Uint32) Or(other Uint32) Uint32 {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// OrElse returns this Uint32's value if it is valid, otherwise fallback.
+func (i Uint32) OrElse(fallback uint32) uint32 {
+	if !i.Valid {
+		return fallback
+	}
+	return i.Uint32
+}
+
+// Map returns the result of fn applied to this Uint8's value, or null if this Uint8 is null.
+func (i Uint8) Map(fn func(uint8) uint8) Uint8 {
+	if !i.Valid {
+		return i
+	}
+	return NewUint8(fn(i.Uint8), true)
+}
+
+// Filter returns this Uint8 if it is null or fn returns true for its value, otherwise null.
+func (i Uint8) Filter(fn func(uint8) bool) Uint8 {
+	if !i.Valid || fn(i.Uint8) {
+		return i
+	}
+	return Uint8{}
+}
+
+// Or returns this Uint8 if it is valid, otherwise other.
+func (i Uint8) Or(other Uint8) Uint8 {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// OrElse returns this Uint8's value if it is valid, otherwise fallback.
+func (i Uint8) OrElse(fallback uint8) uint8 {
+	if !i.Valid {
+		return fallback
+	}
+	return i.Uint8
+}
+
+// Map returns the result of fn applied to this ULID's value, or null if this ULID is null.
+func (u ULID) Map(fn func([16]byte) [16]byte) ULID {
+	if !u.Valid {
+		return u
+	}
+	return NewULID(fn(u.ULID), true)
+}
+
+// Filter returns this ULID if it is null or fn returns true for its value, otherwise null.
+func (u ULID) Filter(fn func([16]byte) bool) ULID {
+	if !u.Valid || fn(u.ULID) {
+		return u
+	}
+	return ULID{}
+}
+
+// Or returns this ULID if it is valid, otherwise other.
+func (u ULID) Or(other ULID) ULID {
+	if u.Valid {
+		return u
+	}
+	return other
+}
+
+// OrElse returns this ULID's value if it is valid, otherwise fallback.
+func (u ULID) OrElse(fallback [16]byte) [16]byte {
+	if !u.Valid {
+		return fallback
+	}
+	return u.ULID
+}
+
+// Map returns the result of fn applied to this UnixMillis's value, or null if this UnixMillis is null.
+func (u UnixMillis) Map(fn func(time.Time) time.Time) UnixMillis {
+	if !u.Valid {
+		return u
+	}
+	return NewUnixMillis(fn(u.Time), true)
+}
+
+// Filter returns this UnixMillis if it is null or fn returns true for its value, otherwise null.
+func (u UnixMillis) Filter(fn func(time.Time) bool) UnixMillis {
+	if !u.Valid || fn(u.Time) {
+		return u
+	}
+	return UnixMillis{}
+}
+
+// Or returns this UnixMillis if it is valid, otherwise other.
+func (u UnixMillis) Or(other UnixMillis) UnixMillis {
+	if u.Valid {
+		return u
+	}
+	return other
+}
+
+// OrElse returns this UnixMillis's value if it is valid, otherwise fallback.
+func (u UnixMillis) OrElse(fallback time.Time) time.Time {
+	if !u.Valid {
+		return fallback
+	}
+	return u.Time
+}
+
+// Map returns the result of fn applied to this UnixSeconds's value, or null if this UnixSeconds is null.
+func (u UnixSeconds) Map(fn func(time.Time) time.Time) UnixSeconds {
+	if !u.Valid {
+		return u
+	}
+	return NewUnixSeconds(fn(u.Time), true)
+}
+
+// Filter returns this UnixSeconds if it is null or fn returns true for its value, otherwise null.
+func (u UnixSeconds) Filter(fn func(time.Time) bool) UnixSeconds {
+	if !u.Valid || fn(u.Time) {
+		return u
+	}
+	return UnixSeconds{}
+}
+
+// Or returns this UnixSeconds if it is valid, otherwise other.
+func (u UnixSeconds) Or(other UnixSeconds) UnixSeconds {
+	if u.Valid {
+		return u
+	}
+	return other
+}
+
+// OrElse returns this UnixSeconds's value if it is valid, otherwise fallback.
+func (u UnixSeconds) OrElse(fallback time.Time) time.Time {
+	if !u.Valid {
+		return fallback
+	}
+	return u.Time
+}
+
+// Map returns the result of fn applied to this URL's value, or null if this URL is null.
+func (u URL) Map(fn func(*url.URL) *url.URL) URL {
+	if !u.Valid {
+		return u
+	}
+	return NewURL(fn(u.URL), true)
+}
+
+// Filter returns this URL if it is null or fn returns true for its value, otherwise null.
+func (u URL) Filter(fn func(*url.URL) bool) URL {
+	if !u.Valid || fn(u.URL) {
+		return u
+	}
+	return URL{}
+}
+
+// Or returns this URL if it is valid, otherwise other.
+func (u URL) Or(other URL) URL {
+	if u.Valid {
+		return u
+	}
+	return other
+}
+
+// OrElse returns this URL's value if it is valid, otherwise fallback.
+func (u URL) OrElse(fallback *url.URL) *url.URL {
+	if !u.Valid {
+		return fallback
+	}
+	return u.URL
+}
+
+// Map returns the result of fn applied to this Value's value, or null if this Value is null.
+func (v Value[T]) Map(fn func(T) T) Value[T] {
+	if !v.Valid {
+		return v
+	}
+	return NewValue(fn(v.V), true)
+}
+
+// Filter returns this Value if it is null or fn returns true for its value, otherwise null.
+func (v Value[T]) Filter(fn func(T) bool) Value[T] {
+	if !v.Valid || fn(v.V) {
+		return v
+	}
+	return Value[T]{}
+}
+
+// Or returns this Value if it is valid, otherwise other.
+func (v Value[T]) Or(other Value[T]) Value[T] {
+	if v.Valid {
+		return v
+	}
+	return other
+}
+
+// OrElse returns this Value's value if it is valid, otherwise fallback.
+func (v Value[T]) OrElse(fallback T) T {
+	if !v.Valid {
+		return fallback
+	}
+	return v.V
+}
+
+// Or returns this Int64Range if it is valid, otherwise other.
+func (r Int64Range) Or(other Int64Range) Int64Range {
+	if r.Valid {
+		return r
+	}
+	return other
+}
+
+// Or returns this Money if it is valid, otherwise other.
+func (m Money) Or(other Money) Money {
+	if m.Valid {
+		return m
+	}
+	return other
+}
+
+// Or returns this Point if it is valid, otherwise other.
+func (p Point) Or(other Point) Point {
+	if p.Valid {
+		return p
+	}
+	return other
+}
+
+// Or returns this TimeRange if it is valid, otherwise other.
+func (r TimeRange) Or(other TimeRange) TimeRange {
+	if r.Valid {
+		return r
+	}
+	return other
+}
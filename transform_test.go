@@ -0,0 +1,131 @@
+package null
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMapFilter(t *testing.T) {
+	double := func(v uint64) uint64 { return v * 2 }
+	positive := func(v uint64) bool { return v > 0 }
+
+	if got := UintFrom(21).Map(double); !got.Equal(UintFrom(42)) {
+		t.Errorf("Map(): got %v", got)
+	}
+	if got := NewUint(21, false).Map(double); got.Valid {
+		t.Errorf("null Map(): got %v", got)
+	}
+	if got := UintFrom(21).Filter(positive); !got.Equal(UintFrom(21)) {
+		t.Errorf("Filter(): got %v", got)
+	}
+	if got := UintFrom(0).Filter(positive); got.Valid {
+		t.Errorf("Filter() of rejected value: got %v", got)
+	}
+	if got := UintFrom(0).Filter(positive).Map(double).OrElse(1); got != 1 {
+		t.Errorf("chained: got %d, want 1", got)
+	}
+	if got := StringFrom("a").Map(strings.ToUpper).OrElse("none"); got != "A" {
+		t.Errorf("String chained: got %q, want \"A\"", got)
+	}
+
+	day := DateFrom(time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC))
+	next := day.Map(func(t time.Time) time.Time { return t.AddDate(0, 0, 1) })
+	if want := DateFrom(time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)); !next.Equal(want) {
+		t.Errorf("Date Map(): got %v, want %v", next, want)
+	}
+}
+
+func TestOrOrElse(t *testing.T) {
+	null := NewUint(0, false)
+	if got := null.Or(UintFrom(1)).Or(UintFrom(2)); !got.Equal(UintFrom(1)) {
+		t.Errorf("Or(): got %v", got)
+	}
+	if got := UintFrom(0).Or(UintFrom(1)); !got.Equal(UintFrom(0)) {
+		t.Errorf("valid zero Or(): got %v", got)
+	}
+	if got := null.Or(null); got.Valid {
+		t.Errorf("null Or(null): got %v", got)
+	}
+	if got := null.OrElse(7); got != 7 {
+		t.Errorf("OrElse(): got %d, want 7", got)
+	}
+	if got := MoneyFrom(0, "USD").Or(MoneyFrom(100, "EUR")); !got.Equal(MoneyFrom(0, "USD")) {
+		t.Errorf("Money Or(): got %v", got)
+	}
+	if got := BoolFrom(false).OrElse(true); got {
+		t.Error("Bool OrElse(): valid false should not fall back")
+	}
+}
+
+func TestOptionalTransform(t *testing.T) {
+	var unset Optional[int]
+	if got := unset.Map(func(v int) int { return v + 1 }); got.IsSet() {
+		t.Errorf("unset Map(): got %v", got)
+	}
+	if got := OptionalFrom(1).Filter(func(v int) bool { return v > 1 }); !got.IsNull() {
+		t.Errorf("Filter() should leave a present null, got %v", got)
+	}
+	if got := OptionalNull[int]().Or(OptionalFrom(2)); !got.Equal(OptionalFrom(2)) {
+		t.Errorf("Or(): got %v", got)
+	}
+	if got := unset.OrElse(3); got != 3 {
+		t.Errorf("OrElse(): got %d, want 3", got)
+	}
+}
+
+func TestAllTypesTransform(t *testing.T) {
+	example := reflect.ValueOf(exampleAllTypes())
+	typ := example.Type()
+	equal := func(a, b reflect.Value) bool {
+		return a.MethodByName("Equal").Call([]reflect.Value{b})[0].Bool()
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		valid, null := example.Field(i), reflect.Zero(f.Type)
+		multi := f.Name == "Money" || f.Name == "Point" || f.Name == "Int64Range" || f.Name == "TimeRange"
+
+		if or := valid.MethodByName("Or"); f.Name != "Bool" {
+			if !or.IsValid() {
+				t.Errorf("%s: doesn't implement Or", f.Name)
+			} else if !equal(or.Call([]reflect.Value{null})[0], valid) || !equal(null.MethodByName("Or").Call([]reflect.Value{valid})[0], valid) {
+				t.Errorf("%s: Or should return the valid value", f.Name)
+			}
+		}
+		if multi {
+			continue
+		}
+
+		orElse := valid.MethodByName("OrElse")
+		mapFn, filter := valid.MethodByName("Map"), valid.MethodByName("Filter")
+		if !orElse.IsValid() || !mapFn.IsValid() || !filter.IsValid() {
+			t.Errorf("%s: doesn't implement Map, Filter, and OrElse", f.Name)
+			continue
+		}
+		inner := orElse.Call([]reflect.Value{reflect.Zero(orElse.Type().In(0))})[0]
+		if got := null.MethodByName("OrElse").Call([]reflect.Value{inner})[0]; !reflect.DeepEqual(got.Interface(), inner.Interface()) {
+			t.Errorf("%s: null OrElse should return the fallback, got %v", f.Name, got)
+		}
+
+		identity := reflect.MakeFunc(mapFn.Type().In(0), func(args []reflect.Value) []reflect.Value { return args })
+		if got := mapFn.Call([]reflect.Value{identity})[0]; !equal(got, valid) {
+			t.Errorf("%s: Map of identity should be unchanged, got %v", f.Name, got)
+		}
+		if got := null.MethodByName("Map").Call([]reflect.Value{identity})[0]; got.FieldByName("Valid").Bool() {
+			t.Errorf("%s: null Map should be null, got %v", f.Name, got)
+		}
+
+		predicate := func(keep bool) reflect.Value {
+			return reflect.MakeFunc(filter.Type().In(0), func([]reflect.Value) []reflect.Value {
+				return []reflect.Value{reflect.ValueOf(keep)}
+			})
+		}
+		if got := filter.Call([]reflect.Value{predicate(true)})[0]; !equal(got, valid) {
+			t.Errorf("%s: Filter should keep accepted values, got %v", f.Name, got)
+		}
+		if got := filter.Call([]reflect.Value{predicate(false)})[0]; got.FieldByName("Valid").Bool() {
+			t.Errorf("%s: Filter should null rejected values, got %v", f.Name, got)
+		}
+	}
+}
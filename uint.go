@@ -7,9 +7,53 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// UintBytesFormat is a way of interpreting []byte values scanned into a NullUint64.
+type UintBytesFormat int
+
+const (
+	// UintBytesDecimal scans []byte values as ASCII decimal digits, which is what MySQL and most other drivers send.
+	UintBytesDecimal UintBytesFormat = iota
+	// UintBytesLittleEndian scans []byte values as 8 little-endian bytes,
+	// which was previously the only input NullUint64 accepted.
+	UintBytesLittleEndian
 )
 
+// DefaultUintBytesFormat is the format NullUint64 and Uint use to scan []byte values.
+// Other values such as int64, uint64, and string are scanned the same way regardless.
+// It defaults to UintBytesDecimal.
+var DefaultUintBytesFormat = UintBytesDecimal
+
+// UintValueFormat is a way of representing a NullUint64 as a driver value, sent to SQL databases.
+type UintValueFormat int
+
+const (
+	// UintValueUint64 sends uint64 values.
+	// database/sql only accepts values above math.MaxInt64 for drivers that support uint64, such as go-sql-driver/mysql.
+	UintValueUint64 UintValueFormat = iota
+	// UintValueInt64 sends int64 values, returning an error for values above math.MaxInt64.
+	UintValueInt64
+	// UintValueString sends decimal strings, which databases convert to unsigned or NUMERIC columns.
+	UintValueString
+	// UintValueBytes sends 8 little-endian bytes, for BINARY(8) and similar columns.
+	// Scan them back with DefaultUintBytesFormat set to UintBytesLittleEndian.
+	UintValueBytes
+	// UintValueInt64OrString sends int64 values up to math.MaxInt64, and decimal strings above it.
+	// Unlike UintValueUint64, it works with drivers that don't accept uint64,
+	// and MySQL converts the strings when storing them in BIGINT UNSIGNED columns.
+	UintValueInt64OrString
+)
+
+// DefaultUintValueFormat is the format NullUint64 and Uint use for Value.
+// It affects anything built on Value, but not this package's binary or BSON encodings.
+// It defaults to UintValueUint64.
+var DefaultUintValueFormat = UintValueUint64
+
 // NullUint64 represents an uint64 that may be null.
 // NullUInt64 implements the Scanner interface so
 // it can be used as a scan destination, similar to NullString.
@@ -19,28 +63,59 @@ type NullUint64 struct {
 }
 
 // Scan implements the Scanner interface.
+// It supports non-negative integers, floats without a fractional part, and decimal strings.
+// []byte values are interpreted according to DefaultUintBytesFormat.
 func (n *NullUint64) Scan(value interface{}) error {
+	value = driverval.Convert(value)
 	if value == nil {
 		n.Uint64, n.Valid = 0, false
 		return nil
 	}
-	n.Valid = true
-	valueBytes, ok := value.([]byte)
-	if !ok {
-		return errors.New(fmt.Sprint("Failed to unmarshal uint64 value:", value))
+	if b, ok := value.([]byte); ok && DefaultUintBytesFormat == UintBytesLittleEndian {
+		if len(b) != 8 {
+			return fmt.Errorf("null: couldn't scan %d bytes into uint64: need 8 little-endian bytes", len(b))
+		}
+		n.Uint64, n.Valid = binary.LittleEndian.Uint64(b), true
+		return nil
+	}
+	v, err := scanUint(value, 64)
+	if err != nil {
+		return err
 	}
-	n.Uint64 = binary.LittleEndian.Uint64(valueBytes)
+	n.Uint64, n.Valid = v, true
 	return nil
 }
 
 // Value implements the driver Valuer interface.
+// The type of the value depends on DefaultUintValueFormat.
 func (n NullUint64) Value() (driver.Value, error) {
 	if !n.Valid {
 		return nil, nil
 	}
+	switch DefaultUintValueFormat {
+	case UintValueInt64:
+		if n.Uint64 > math.MaxInt64 {
+			return nil, fmt.Errorf("null: couldn't convert %d to int64: out of range", n.Uint64)
+		}
+		return int64(n.Uint64), nil
+	case UintValueString:
+		return strconv.FormatUint(n.Uint64, 10), nil
+	case UintValueInt64OrString:
+		if n.Uint64 > math.MaxInt64 {
+			return strconv.FormatUint(n.Uint64, 10), nil
+		}
+		return int64(n.Uint64), nil
+	case UintValueBytes:
+		return binary.LittleEndian.AppendUint64(nil, n.Uint64), nil
+	}
 	return n.Uint64, nil
 }
 
+// IsZero returns true for invalid NullUint64s.
+func (n NullUint64) IsZero() bool {
+	return !n.Valid
+}
+
 // Uint is an nullable uint64.
 // It does not consider zero values to be null.
 // It will decode to null, not zero, if null.
@@ -82,7 +157,14 @@ func (i Uint) ValueOrZero() uint64 {
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number, string, and null input.
 // 0 will not be considered a null Uint.
-func (i *Uint) UnmarshalJSON(data []byte) error {
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict,
+// and integral numbers such as 1.0 or 1e3 are accepted if it is JSONLenient.
+func (i *Uint) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(i, &err)
+	return i.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (i *Uint) unmarshalJSONWith(data []byte, d JSONDecoding) error {
 	if bytes.Equal(data, nullBytes) {
 		i.Valid = false
 		return nil
@@ -91,15 +173,22 @@ func (i *Uint) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &i.Uint64); err != nil {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
+			if n, ok, err := d.uintFromFloatJSON(data, 64); ok {
+				if err != nil {
+					return err
+				}
+				i.Uint64, i.Valid = n, true
+				return nil
+			}
 			// special case: accept string input
-			if typeError.Value != "string" {
-				return fmt.Errorf("null: JSON input is invalid type (need uint or string): %w", err)
+			if typeError.Value != "string" || !d.numberStrings() {
+				return fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("uint"), err)
 			}
 			var str string
 			if err := json.Unmarshal(data, &str); err != nil {
 				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
 			}
-			n, err := strconv.ParseUint(str, 10, 64)
+			n, err := DefaultNumberFormat.parseUint(str, 64)
 			if err != nil {
 				return fmt.Errorf("null: couldn't convert string to uint: %w", err)
 			}
@@ -115,16 +204,16 @@ func (i *Uint) UnmarshalJSON(data []byte) error {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Uint if the input is blank.
+// It will unmarshal to a null Uint if the input is blank or DefaultNullText.
 // It will return an error if the input is not an integer, blank, or "null".
-func (i *Uint) UnmarshalText(text []byte) error {
+func (i *Uint) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(i, &err)
 	str := string(text)
-	if str == "" || str == "null" {
+	if isNullText(text) || str == "null" {
 		i.Valid = false
 		return nil
 	}
-	var err error
-	i.Uint64, err = strconv.ParseUint(string(text), 10, 64)
+	i.Uint64, err = DefaultNumberFormat.parseUint(string(text), 64)
 	if err != nil {
 		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
 	}
@@ -142,20 +231,41 @@ func (i Uint) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string if this Uint is null.
+// It will encode DefaultNullText, blank by default, if this Uint is null.
 func (i Uint) MarshalText() ([]byte, error) {
 	if !i.Valid {
-		return []byte{}, nil
+		return nullText(), nil
 	}
 	return []byte(strconv.FormatUint(i.Uint64, 10)), nil
 }
 
+// FormatBase returns this Uint's value in the given base, which must be between 2 and 36,
+// such as "0x1f" for 31 in base 16. Bases 2, 8, and 16 have a prefix like Go literals,
+// which UnmarshalText accepts if DefaultNumberFormat.BasePrefixes is set.
+// It returns a blank string if this Uint is null.
+func (i Uint) FormatBase(base int) string {
+	if !i.Valid {
+		return ""
+	}
+	return formatUintBase(i.Uint64, base)
+}
+
 // SetValid changes this Uint's value and also sets it to be non-null.
 func (i *Uint) SetValid(n uint64) {
 	i.Uint64 = n
 	i.Valid = true
 }
 
+// SetNull sets this Uint to null, leaving its value as is. Use Reset to clear the value too.
+func (i *Uint) SetNull() {
+	i.Valid = false
+}
+
+// Reset sets this Uint to null and clears its value.
+func (i *Uint) Reset() {
+	*i = Uint{}
+}
+
 // Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
 func (i Uint) Ptr() *uint64 {
 	if !i.Valid {
@@ -164,13 +274,61 @@ func (i Uint) Ptr() *uint64 {
 	return &i.Uint64
 }
 
+// SetPtr changes this Uint's value to the value v points to, or sets it to be null if v is nil, like UintFromPtr.
+func (i *Uint) SetPtr(v *uint64) {
+	*i = UintFromPtr(v)
+}
+
 // IsZero returns true for invalid Uints, for future omitempty support (Go 1.4?)
 // A non-null Uint with a 0 value will not be considered zero.
 func (i Uint) IsZero() bool {
 	return !i.Valid
 }
 
+// IsZeroOrNull returns true for invalid Uints, or valid Uints with a 0 value.
+func (i Uint) IsZeroOrNull() bool {
+	return !i.Valid || i.Uint64 == 0
+}
+
 // Equal returns true if both uints have the same value or are both null.
 func (i Uint) Equal(other Uint) bool {
 	return i.Valid == other.Valid && (!i.Valid || i.Uint64 == other.Uint64)
 }
+
+// scanUint converts a driver value into an unsigned integer that fits in bitSize bits.
+// It accepts non-negative integers, integral floats, and decimal strings or bytes.
+func scanUint(value interface{}, bitSize int) (uint64, error) {
+	var n uint64
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return 0, fmt.Errorf("null: couldn't scan negative value %d into uint%d", v, bitSize)
+		}
+		n = uint64(v)
+	case uint64:
+		n = v
+	case float64:
+		if v != math.Trunc(v) || v < 0 || v >= math.MaxUint64 {
+			return 0, fmt.Errorf("null: couldn't scan value %v into uint%d: not an integer in range", v, bitSize)
+		}
+		n = uint64(v)
+	case []byte:
+		return scanUintString(string(v), bitSize)
+	case string:
+		return scanUintString(v, bitSize)
+	default:
+		return 0, fmt.Errorf("null: couldn't scan type %T into uint%d: %v", value, bitSize, value)
+	}
+	if bitSize < 64 && n>>uint(bitSize) != 0 {
+		return 0, fmt.Errorf("null: couldn't scan value %d into uint%d: out of range", n, bitSize)
+	}
+	return n, nil
+}
+
+func scanUintString(str string, bitSize int) (uint64, error) {
+	n, err := strconv.ParseUint(str, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("null: couldn't scan uint%d: %w", bitSize, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,228 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullUint16 represents an uint16 that may be null.
+// NullUint16 implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullUint16 struct {
+	Uint16 uint16
+	Valid  bool // Valid is true if Uint16 is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (n *NullUint16) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	if value == nil {
+		n.Uint16, n.Valid = 0, false
+		return nil
+	}
+	v, err := scanUint(value, 16)
+	if err != nil {
+		return err
+	}
+	n.Uint16, n.Valid = uint16(v), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullUint16) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.Uint16), nil
+}
+
+// IsZero returns true for invalid NullUint16s.
+func (n NullUint16) IsZero() bool {
+	return !n.Valid
+}
+
+// Uint16 is an nullable uint16.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Uint16 struct {
+	NullUint16
+}
+
+// NewUint16 creates a new Uint16
+func NewUint16(i uint16, valid bool) Uint16 {
+	return Uint16{
+		NullUint16: NullUint16{
+			Uint16: i,
+			Valid:  valid,
+		},
+	}
+}
+
+// Uint16From creates a new Uint16 that will always be valid.
+func Uint16From(i uint16) Uint16 {
+	return NewUint16(i, true)
+}
+
+// Uint16FromPtr creates a new Uint16 that be null if i is nil.
+func Uint16FromPtr(i *uint16) Uint16 {
+	if i == nil {
+		return NewUint16(0, false)
+	}
+	return NewUint16(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint16) ValueOrZero() uint16 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint16
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint16.
+// It will return an error if the input overflows uint16.
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict,
+// and integral numbers such as 1.0 or 1e3 are accepted if it is JSONLenient.
+func (i *Uint16) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(i, &err)
+	return i.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (i *Uint16) unmarshalJSONWith(data []byte, d JSONDecoding) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &i.Uint16); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			if n, ok, err := d.uintFromFloatJSON(data, 16); ok {
+				if err != nil {
+					return err
+				}
+				i.Uint16, i.Valid = uint16(n), true
+				return nil
+			}
+			// special case: accept string input
+			if typeError.Value != "string" || !d.numberStrings() {
+				return fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("uint16"), err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := DefaultNumberFormat.parseUint(str, 16)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to uint16: %w", err)
+			}
+			i.Uint16 = uint16(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint16 if the input is blank or DefaultNullText.
+// It will return an error if the input is not an integer, blank, or "null",
+// or if it overflows uint16.
+func (i *Uint16) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(i, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := DefaultNumberFormat.parseUint(str, 16)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	i.Uint16 = uint16(n)
+	i.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint16 is null.
+func (i Uint16) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(i.Uint16), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Uint16 is null.
+func (i Uint16) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatUint(uint64(i.Uint16), 10)), nil
+}
+
+// FormatBase returns this Uint16's value in the given base, like Uint.FormatBase.
+// It returns a blank string if this Uint16 is null.
+func (i Uint16) FormatBase(base int) string {
+	if !i.Valid {
+		return ""
+	}
+	return formatUintBase(uint64(i.Uint16), base)
+}
+
+// SetValid changes this Uint16's value and also sets it to be non-null.
+func (i *Uint16) SetValid(n uint16) {
+	i.Uint16 = n
+	i.Valid = true
+}
+
+// SetNull sets this Uint16 to null, leaving its value as is. Use Reset to clear the value too.
+func (i *Uint16) SetNull() {
+	i.Valid = false
+}
+
+// Reset sets this Uint16 to null and clears its value.
+func (i *Uint16) Reset() {
+	*i = Uint16{}
+}
+
+// Ptr returns a pointer to this Uint16's value, or a nil pointer if this Uint16 is null.
+func (i Uint16) Ptr() *uint16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint16
+}
+
+// SetPtr changes this Uint16's value to the value v points to, or sets it to be null if v is nil, like Uint16FromPtr.
+func (i *Uint16) SetPtr(v *uint16) {
+	*i = Uint16FromPtr(v)
+}
+
+// IsZero returns true for invalid Uint16s.
+// A non-null Uint16 with a 0 value will not be considered zero.
+func (i Uint16) IsZero() bool {
+	return !i.Valid
+}
+
+// IsZeroOrNull returns true for invalid Uint16s, or valid Uint16s with a 0 value.
+func (i Uint16) IsZeroOrNull() bool {
+	return !i.Valid || i.Uint16 == 0
+}
+
+// Equal returns true if both Uint16s have the same value or are both null.
+func (i Uint16) Equal(other Uint16) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Uint16 == other.Uint16)
+}
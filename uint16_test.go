@@ -0,0 +1,274 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	uint16JSON       = []byte(`12345`)
+	uint16StringJSON = []byte(`"12345"`)
+)
+
+func TestUint16From(t *testing.T) {
+	i := Uint16From(12345)
+	assertUint16(t, i, "Uint16From()")
+
+	zero := Uint16From(0)
+	if !zero.Valid {
+		t.Error("Uint16From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUint16FromPtr(t *testing.T) {
+	n := uint16(12345)
+	i := Uint16FromPtr(&n)
+	assertUint16(t, i, "Uint16FromPtr()")
+
+	null := Uint16FromPtr(nil)
+	assertNullUint16(t, null, "Uint16FromPtr(nil)")
+}
+
+func TestUnmarshalUint16(t *testing.T) {
+	var i Uint16
+	err := json.Unmarshal(uint16JSON, &i)
+	maybePanic(err)
+	assertUint16(t, i, "uint16 json")
+
+	var si Uint16
+	err = json.Unmarshal(uint16StringJSON, &si)
+	maybePanic(err)
+	assertUint16(t, si, "uint16 string json")
+
+	var null Uint16
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint16(t, null, "null json")
+
+	var badType Uint16
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullUint16(t, badType, "wrong type json")
+
+	var negative Uint16
+	err = json.Unmarshal([]byte(`-1`), &negative)
+	if err == nil {
+		panic("err should not be nil")
+	}
+
+	var invalid Uint16
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullUint16(t, invalid, "invalid json")
+}
+
+func TestUnmarshalUint16Overflow(t *testing.T) {
+	overflow := uint64(math.MaxUint16)
+
+	var i Uint16
+	err := json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	maybePanic(err)
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	maybePanic(err)
+
+	overflow++
+	err = json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows uint16")
+	}
+	err = json.Unmarshal([]byte(`"`+strconv.FormatUint(overflow, 10)+`"`), &i)
+	if err == nil {
+		panic("err should be present; decoded string value overflows uint16")
+	}
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	if err == nil {
+		panic("err should be present; decoded text value overflows uint16")
+	}
+}
+
+func TestTextUnmarshalUint16(t *testing.T) {
+	var i Uint16
+	err := i.UnmarshalText([]byte("12345"))
+	maybePanic(err)
+	assertUint16(t, i, "UnmarshalText() uint16")
+
+	var blank Uint16
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullUint16(t, blank, "UnmarshalText() empty uint16")
+
+	var null Uint16
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullUint16(t, null, `UnmarshalText() "null"`)
+
+	var invalid Uint16
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalUint16(t *testing.T) {
+	i := Uint16From(12345)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty json marshal")
+
+	null := NewUint16(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalUint16Text(t *testing.T) {
+	i := Uint16From(12345)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty text marshal")
+
+	null := NewUint16(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUint16Pointer(t *testing.T) {
+	i := Uint16From(12345)
+	ptr := i.Ptr()
+	if *ptr != 12345 {
+		t.Errorf("bad %s uint16: %#v ≠ %d\n", "pointer", ptr, 12345)
+	}
+
+	null := NewUint16(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint16: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUint16IsZero(t *testing.T) {
+	i := Uint16From(12345)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewUint16(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewUint16(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestUint16SetValid(t *testing.T) {
+	change := NewUint16(0, false)
+	assertNullUint16(t, change, "SetValid()")
+	change.SetValid(12345)
+	assertUint16(t, change, "SetValid()")
+}
+
+func TestUint16Scan(t *testing.T) {
+	var i Uint16
+	err := i.Scan(int64(12345))
+	maybePanic(err)
+	assertUint16(t, i, "scanned uint16")
+
+	var si Uint16
+	err = si.Scan([]byte("12345"))
+	maybePanic(err)
+	assertUint16(t, si, "scanned uint16 bytes")
+
+	var null Uint16
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint16(t, null, "scanned null")
+
+	var negative Uint16
+	err = negative.Scan(int64(-1))
+	if err == nil {
+		t.Error("expected error scanning negative value")
+	}
+
+	var overflow Uint16
+	err = overflow.Scan(int64(math.MaxUint16) + 1)
+	if err == nil {
+		t.Error("expected error scanning out of range value")
+	}
+
+	var wrong Uint16
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error scanning wrong type")
+	}
+}
+
+func TestUint16Value(t *testing.T) {
+	i := Uint16From(12345)
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(12345) {
+		t.Errorf("bad Value(): %#v ≠ %d", v, 12345)
+	}
+
+	null := NewUint16(0, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestUint16Equal(t *testing.T) {
+	i1 := NewUint16(10, false)
+	i2 := NewUint16(20, false)
+	if !i1.Equal(i2) {
+		t.Error("null Uint16s should be equal")
+	}
+
+	i1 = NewUint16(10, true)
+	i2 = NewUint16(10, true)
+	if !i1.Equal(i2) {
+		t.Error("same Uint16s should be equal")
+	}
+
+	i1 = NewUint16(10, true)
+	i2 = NewUint16(10, false)
+	if i1.Equal(i2) {
+		t.Error("valid and null Uint16s should not be equal")
+	}
+
+	i1 = NewUint16(10, true)
+	i2 = NewUint16(20, true)
+	if i1.Equal(i2) {
+		t.Error("different Uint16s should not be equal")
+	}
+}
+
+func assertUint16(t *testing.T, i Uint16, from string) {
+	t.Helper()
+	if i.Uint16 != 12345 {
+		t.Errorf("bad %s uint16: %d ≠ %d\n", from, i.Uint16, 12345)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint16(t *testing.T, i Uint16, from string) {
+	t.Helper()
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
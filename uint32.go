@@ -0,0 +1,228 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullUint32 represents an uint32 that may be null.
+// NullUint32 implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullUint32 struct {
+	Uint32 uint32
+	Valid  bool // Valid is true if Uint32 is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (n *NullUint32) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	if value == nil {
+		n.Uint32, n.Valid = 0, false
+		return nil
+	}
+	v, err := scanUint(value, 32)
+	if err != nil {
+		return err
+	}
+	n.Uint32, n.Valid = uint32(v), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullUint32) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.Uint32), nil
+}
+
+// IsZero returns true for invalid NullUint32s.
+func (n NullUint32) IsZero() bool {
+	return !n.Valid
+}
+
+// Uint32 is an nullable uint32.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Uint32 struct {
+	NullUint32
+}
+
+// NewUint32 creates a new Uint32
+func NewUint32(i uint32, valid bool) Uint32 {
+	return Uint32{
+		NullUint32: NullUint32{
+			Uint32: i,
+			Valid:  valid,
+		},
+	}
+}
+
+// Uint32From creates a new Uint32 that will always be valid.
+func Uint32From(i uint32) Uint32 {
+	return NewUint32(i, true)
+}
+
+// Uint32FromPtr creates a new Uint32 that be null if i is nil.
+func Uint32FromPtr(i *uint32) Uint32 {
+	if i == nil {
+		return NewUint32(0, false)
+	}
+	return NewUint32(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint32) ValueOrZero() uint32 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint32
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint32.
+// It will return an error if the input overflows uint32.
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict,
+// and integral numbers such as 1.0 or 1e3 are accepted if it is JSONLenient.
+func (i *Uint32) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(i, &err)
+	return i.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (i *Uint32) unmarshalJSONWith(data []byte, d JSONDecoding) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &i.Uint32); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			if n, ok, err := d.uintFromFloatJSON(data, 32); ok {
+				if err != nil {
+					return err
+				}
+				i.Uint32, i.Valid = uint32(n), true
+				return nil
+			}
+			// special case: accept string input
+			if typeError.Value != "string" || !d.numberStrings() {
+				return fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("uint32"), err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := DefaultNumberFormat.parseUint(str, 32)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to uint32: %w", err)
+			}
+			i.Uint32 = uint32(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint32 if the input is blank or DefaultNullText.
+// It will return an error if the input is not an integer, blank, or "null",
+// or if it overflows uint32.
+func (i *Uint32) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(i, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := DefaultNumberFormat.parseUint(str, 32)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	i.Uint32 = uint32(n)
+	i.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint32 is null.
+func (i Uint32) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(i.Uint32), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Uint32 is null.
+func (i Uint32) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatUint(uint64(i.Uint32), 10)), nil
+}
+
+// FormatBase returns this Uint32's value in the given base, like Uint.FormatBase.
+// It returns a blank string if this Uint32 is null.
+func (i Uint32) FormatBase(base int) string {
+	if !i.Valid {
+		return ""
+	}
+	return formatUintBase(uint64(i.Uint32), base)
+}
+
+// SetValid changes this Uint32's value and also sets it to be non-null.
+func (i *Uint32) SetValid(n uint32) {
+	i.Uint32 = n
+	i.Valid = true
+}
+
+// SetNull sets this Uint32 to null, leaving its value as is. Use Reset to clear the value too.
+func (i *Uint32) SetNull() {
+	i.Valid = false
+}
+
+// Reset sets this Uint32 to null and clears its value.
+func (i *Uint32) Reset() {
+	*i = Uint32{}
+}
+
+// Ptr returns a pointer to this Uint32's value, or a nil pointer if this Uint32 is null.
+func (i Uint32) Ptr() *uint32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint32
+}
+
+// SetPtr changes this Uint32's value to the value v points to, or sets it to be null if v is nil, like Uint32FromPtr.
+func (i *Uint32) SetPtr(v *uint32) {
+	*i = Uint32FromPtr(v)
+}
+
+// IsZero returns true for invalid Uint32s.
+// A non-null Uint32 with a 0 value will not be considered zero.
+func (i Uint32) IsZero() bool {
+	return !i.Valid
+}
+
+// IsZeroOrNull returns true for invalid Uint32s, or valid Uint32s with a 0 value.
+func (i Uint32) IsZeroOrNull() bool {
+	return !i.Valid || i.Uint32 == 0
+}
+
+// Equal returns true if both Uint32s have the same value or are both null.
+func (i Uint32) Equal(other Uint32) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Uint32 == other.Uint32)
+}
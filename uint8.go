@@ -0,0 +1,228 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullUint8 represents an uint8 that may be null.
+// NullUint8 implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullUint8 struct {
+	Uint8 uint8
+	Valid bool // Valid is true if Uint8 is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (n *NullUint8) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	if value == nil {
+		n.Uint8, n.Valid = 0, false
+		return nil
+	}
+	v, err := scanUint(value, 8)
+	if err != nil {
+		return err
+	}
+	n.Uint8, n.Valid = uint8(v), true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n NullUint8) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.Uint8), nil
+}
+
+// IsZero returns true for invalid NullUint8s.
+func (n NullUint8) IsZero() bool {
+	return !n.Valid
+}
+
+// Uint8 is an nullable uint8.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Uint8 struct {
+	NullUint8
+}
+
+// NewUint8 creates a new Uint8
+func NewUint8(i uint8, valid bool) Uint8 {
+	return Uint8{
+		NullUint8: NullUint8{
+			Uint8: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Uint8From creates a new Uint8 that will always be valid.
+func Uint8From(i uint8) Uint8 {
+	return NewUint8(i, true)
+}
+
+// Uint8FromPtr creates a new Uint8 that be null if i is nil.
+func Uint8FromPtr(i *uint8) Uint8 {
+	if i == nil {
+		return NewUint8(0, false)
+	}
+	return NewUint8(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint8) ValueOrZero() uint8 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint8
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint8.
+// It will return an error if the input overflows uint8.
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict,
+// and integral numbers such as 1.0 or 1e3 are accepted if it is JSONLenient.
+func (i *Uint8) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(i, &err)
+	return i.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (i *Uint8) unmarshalJSONWith(data []byte, d JSONDecoding) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &i.Uint8); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			if n, ok, err := d.uintFromFloatJSON(data, 8); ok {
+				if err != nil {
+					return err
+				}
+				i.Uint8, i.Valid = uint8(n), true
+				return nil
+			}
+			// special case: accept string input
+			if typeError.Value != "string" || !d.numberStrings() {
+				return fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("uint8"), err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := DefaultNumberFormat.parseUint(str, 8)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to uint8: %w", err)
+			}
+			i.Uint8 = uint8(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint8 if the input is blank or DefaultNullText.
+// It will return an error if the input is not an integer, blank, or "null",
+// or if it overflows uint8.
+func (i *Uint8) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(i, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := DefaultNumberFormat.parseUint(str, 8)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	i.Uint8 = uint8(n)
+	i.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint8 is null.
+func (i Uint8) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(i.Uint8), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Uint8 is null.
+func (i Uint8) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatUint(uint64(i.Uint8), 10)), nil
+}
+
+// FormatBase returns this Uint8's value in the given base, like Uint.FormatBase.
+// It returns a blank string if this Uint8 is null.
+func (i Uint8) FormatBase(base int) string {
+	if !i.Valid {
+		return ""
+	}
+	return formatUintBase(uint64(i.Uint8), base)
+}
+
+// SetValid changes this Uint8's value and also sets it to be non-null.
+func (i *Uint8) SetValid(n uint8) {
+	i.Uint8 = n
+	i.Valid = true
+}
+
+// SetNull sets this Uint8 to null, leaving its value as is. Use Reset to clear the value too.
+func (i *Uint8) SetNull() {
+	i.Valid = false
+}
+
+// Reset sets this Uint8 to null and clears its value.
+func (i *Uint8) Reset() {
+	*i = Uint8{}
+}
+
+// Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
+func (i Uint8) Ptr() *uint8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint8
+}
+
+// SetPtr changes this Uint8's value to the value v points to, or sets it to be null if v is nil, like Uint8FromPtr.
+func (i *Uint8) SetPtr(v *uint8) {
+	*i = Uint8FromPtr(v)
+}
+
+// IsZero returns true for invalid Uint8s.
+// A non-null Uint8 with a 0 value will not be considered zero.
+func (i Uint8) IsZero() bool {
+	return !i.Valid
+}
+
+// IsZeroOrNull returns true for invalid Uint8s, or valid Uint8s with a 0 value.
+func (i Uint8) IsZeroOrNull() bool {
+	return !i.Valid || i.Uint8 == 0
+}
+
+// Equal returns true if both Uint8s have the same value or are both null.
+func (i Uint8) Equal(other Uint8) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Uint8 == other.Uint8)
+}
@@ -0,0 +1,274 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	uint8JSON       = []byte(`123`)
+	uint8StringJSON = []byte(`"123"`)
+)
+
+func TestUint8From(t *testing.T) {
+	i := Uint8From(123)
+	assertUint8(t, i, "Uint8From()")
+
+	zero := Uint8From(0)
+	if !zero.Valid {
+		t.Error("Uint8From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUint8FromPtr(t *testing.T) {
+	n := uint8(123)
+	i := Uint8FromPtr(&n)
+	assertUint8(t, i, "Uint8FromPtr()")
+
+	null := Uint8FromPtr(nil)
+	assertNullUint8(t, null, "Uint8FromPtr(nil)")
+}
+
+func TestUnmarshalUint8(t *testing.T) {
+	var i Uint8
+	err := json.Unmarshal(uint8JSON, &i)
+	maybePanic(err)
+	assertUint8(t, i, "uint8 json")
+
+	var si Uint8
+	err = json.Unmarshal(uint8StringJSON, &si)
+	maybePanic(err)
+	assertUint8(t, si, "uint8 string json")
+
+	var null Uint8
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint8(t, null, "null json")
+
+	var badType Uint8
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullUint8(t, badType, "wrong type json")
+
+	var negative Uint8
+	err = json.Unmarshal([]byte(`-1`), &negative)
+	if err == nil {
+		panic("err should not be nil")
+	}
+
+	var invalid Uint8
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullUint8(t, invalid, "invalid json")
+}
+
+func TestUnmarshalUint8Overflow(t *testing.T) {
+	overflow := uint64(math.MaxUint8)
+
+	var i Uint8
+	err := json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	maybePanic(err)
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	maybePanic(err)
+
+	overflow++
+	err = json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows uint8")
+	}
+	err = json.Unmarshal([]byte(`"`+strconv.FormatUint(overflow, 10)+`"`), &i)
+	if err == nil {
+		panic("err should be present; decoded string value overflows uint8")
+	}
+	err = i.UnmarshalText([]byte(strconv.FormatUint(overflow, 10)))
+	if err == nil {
+		panic("err should be present; decoded text value overflows uint8")
+	}
+}
+
+func TestTextUnmarshalUint8(t *testing.T) {
+	var i Uint8
+	err := i.UnmarshalText([]byte("123"))
+	maybePanic(err)
+	assertUint8(t, i, "UnmarshalText() uint8")
+
+	var blank Uint8
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullUint8(t, blank, "UnmarshalText() empty uint8")
+
+	var null Uint8
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullUint8(t, null, `UnmarshalText() "null"`)
+
+	var invalid Uint8
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalUint8(t *testing.T) {
+	i := Uint8From(123)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty json marshal")
+
+	null := NewUint8(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalUint8Text(t *testing.T) {
+	i := Uint8From(123)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "123", "non-empty text marshal")
+
+	null := NewUint8(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUint8Pointer(t *testing.T) {
+	i := Uint8From(123)
+	ptr := i.Ptr()
+	if *ptr != 123 {
+		t.Errorf("bad %s uint8: %#v ≠ %d\n", "pointer", ptr, 123)
+	}
+
+	null := NewUint8(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint8: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUint8IsZero(t *testing.T) {
+	i := Uint8From(123)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewUint8(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewUint8(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestUint8SetValid(t *testing.T) {
+	change := NewUint8(0, false)
+	assertNullUint8(t, change, "SetValid()")
+	change.SetValid(123)
+	assertUint8(t, change, "SetValid()")
+}
+
+func TestUint8Scan(t *testing.T) {
+	var i Uint8
+	err := i.Scan(int64(123))
+	maybePanic(err)
+	assertUint8(t, i, "scanned uint8")
+
+	var si Uint8
+	err = si.Scan([]byte("123"))
+	maybePanic(err)
+	assertUint8(t, si, "scanned uint8 bytes")
+
+	var null Uint8
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint8(t, null, "scanned null")
+
+	var negative Uint8
+	err = negative.Scan(int64(-1))
+	if err == nil {
+		t.Error("expected error scanning negative value")
+	}
+
+	var overflow Uint8
+	err = overflow.Scan(int64(math.MaxUint8) + 1)
+	if err == nil {
+		t.Error("expected error scanning out of range value")
+	}
+
+	var wrong Uint8
+	err = wrong.Scan(true)
+	if err == nil {
+		t.Error("expected error scanning wrong type")
+	}
+}
+
+func TestUint8Value(t *testing.T) {
+	i := Uint8From(123)
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(123) {
+		t.Errorf("bad Value(): %#v ≠ %d", v, 123)
+	}
+
+	null := NewUint8(0, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestUint8Equal(t *testing.T) {
+	i1 := NewUint8(10, false)
+	i2 := NewUint8(20, false)
+	if !i1.Equal(i2) {
+		t.Error("null Uint8s should be equal")
+	}
+
+	i1 = NewUint8(10, true)
+	i2 = NewUint8(10, true)
+	if !i1.Equal(i2) {
+		t.Error("same Uint8s should be equal")
+	}
+
+	i1 = NewUint8(10, true)
+	i2 = NewUint8(10, false)
+	if i1.Equal(i2) {
+		t.Error("valid and null Uint8s should not be equal")
+	}
+
+	i1 = NewUint8(10, true)
+	i2 = NewUint8(20, true)
+	if i1.Equal(i2) {
+		t.Error("different Uint8s should not be equal")
+	}
+}
+
+func assertUint8(t *testing.T, i Uint8, from string) {
+	t.Helper()
+	if i.Uint8 != 123 {
+		t.Errorf("bad %s uint8: %d ≠ %d\n", from, i.Uint8, 123)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint8(t *testing.T, i Uint8, from string) {
+	t.Helper()
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
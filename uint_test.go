@@ -0,0 +1,154 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestUintScan(t *testing.T) {
+	for _, in := range []interface{}{
+		int64(12345),
+		uint64(12345),
+		"12345",
+		[]byte("12345"),
+	} {
+		var i Uint
+		err := i.Scan(in)
+		maybePanic(err)
+		if !i.Equal(UintFrom(12345)) {
+			t.Errorf("bad scan of %#v: %v", in, i)
+		}
+	}
+
+	var max Uint
+	err := max.Scan([]byte("18446744073709551615"))
+	maybePanic(err)
+	if !max.Equal(UintFrom(math.MaxUint64)) {
+		t.Errorf("bad max uint64: %v", max)
+	}
+
+	null := UintFrom(1)
+	err = null.Scan(nil)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("scanned nil should be null")
+	}
+
+	for _, bad := range []interface{}{
+		int64(-1),
+		"18446744073709551616",
+		[]byte("-1"),
+		[]byte("12a"),
+		3.5,
+		true,
+	} {
+		var i Uint
+		if err := i.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %#v", bad)
+		}
+	}
+}
+
+func TestUintScanLittleEndian(t *testing.T) {
+	defer func() { DefaultUintBytesFormat = UintBytesDecimal }()
+	DefaultUintBytesFormat = UintBytesLittleEndian
+
+	var i Uint
+	err := i.Scan(binary.LittleEndian.AppendUint64(nil, math.MaxUint64-1))
+	maybePanic(err)
+	if !i.Equal(UintFrom(math.MaxUint64 - 1)) {
+		t.Errorf("bad little-endian scan: %v", i)
+	}
+
+	// other types are unaffected
+	err = i.Scan("12345")
+	maybePanic(err)
+	if !i.Equal(UintFrom(12345)) {
+		t.Errorf("bad string scan: %v", i)
+	}
+
+	if err := i.Scan([]byte("12345")); err == nil {
+		t.Error("expected error for 5 bytes")
+	}
+}
+
+func TestUintValue(t *testing.T) {
+	defer func() {
+		DefaultUintValueFormat = UintValueUint64
+		DefaultUintBytesFormat = UintBytesDecimal
+	}()
+
+	for _, test := range []struct {
+		format UintValueFormat
+		want   interface{}
+	}{
+		{UintValueUint64, uint64(math.MaxUint64)},
+		{UintValueString, "18446744073709551615"},
+		{UintValueBytes, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	} {
+		DefaultUintValueFormat = test.format
+		v, err := UintFrom(math.MaxUint64).Value()
+		maybePanic(err)
+		if !reflect.DeepEqual(v, test.want) {
+			t.Errorf("format %d: bad Value(): %#v ≠ %#v", test.format, v, test.want)
+		}
+
+		if test.format == UintValueBytes {
+			DefaultUintBytesFormat = UintBytesLittleEndian
+		}
+		var scanned Uint
+		err = scanned.Scan(v)
+		maybePanic(err)
+		if !scanned.Equal(UintFrom(math.MaxUint64)) {
+			t.Errorf("format %d: bad round trip: %v", test.format, scanned)
+		}
+
+		null, err := Uint{}.Value()
+		maybePanic(err)
+		if null != nil {
+			t.Errorf("format %d: null Value() should be nil: %#v", test.format, null)
+		}
+	}
+
+	DefaultUintValueFormat = UintValueInt64
+	v, err := UintFrom(math.MaxInt64).Value()
+	maybePanic(err)
+	if v != int64(math.MaxInt64) {
+		t.Errorf("bad int64 Value(): %#v", v)
+	}
+	if _, err := UintFrom(math.MaxInt64 + 1).Value(); err == nil {
+		t.Error("expected error for overflowing int64")
+	}
+
+	DefaultUintValueFormat = UintValueInt64OrString
+	for _, test := range []struct {
+		in   uint64
+		want interface{}
+	}{
+		{math.MaxInt64, int64(math.MaxInt64)},
+		{math.MaxInt64 + 1, "9223372036854775808"},
+		{math.MaxUint64, "18446744073709551615"},
+	} {
+		v, err := UintFrom(test.in).Value()
+		maybePanic(err)
+		if v != test.want {
+			t.Errorf("bad int64 or string Value() of %d: %#v ≠ %#v", test.in, v, test.want)
+		}
+		if !driver.IsValue(v) {
+			t.Errorf("Value() of %d isn't a valid driver value: %#v", test.in, v)
+		}
+	}
+
+	// the binary encoding doesn't change
+	data, err := UintFrom(math.MaxUint64).MarshalBinary()
+	maybePanic(err)
+	var decoded Uint
+	err = decoded.UnmarshalBinary(data)
+	maybePanic(err)
+	if data[0] != binaryUint64 || !decoded.Equal(UintFrom(math.MaxUint64)) {
+		t.Errorf("bad binary encoding: %x", data)
+	}
+}
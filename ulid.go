@@ -0,0 +1,283 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// ulidAlphabet is Crockford's base32 alphabet, used by ULIDs.
+const ulidAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulidLen is the length of a ULID's string form.
+const ulidLen = 26
+
+// NullULID represents a ULID that may be null.
+// NullULID implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullULID struct {
+	ULID  [16]byte
+	Valid bool // Valid is true if ULID is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It supports 26 character strings, and 16 byte binary values such as BINARY(16) columns.
+func (n *NullULID) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var id [16]byte
+	var err error
+	switch v := value.(type) {
+	case nil:
+		n.ULID, n.Valid = [16]byte{}, false
+		return nil
+	case []byte:
+		if len(v) == len(id) {
+			copy(id[:], v)
+		} else {
+			id, err = parseULID(string(v))
+		}
+	case string:
+		id, err = parseULID(v)
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into ULID: %v", value, value)
+	}
+	if err != nil {
+		n.Valid = false
+		return fmt.Errorf("null: couldn't scan ULID: %w", err)
+	}
+	n.ULID, n.Valid = id, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as 26 character strings.
+func (n NullULID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return formatULID(n.ULID), nil
+}
+
+// IsZero returns true for invalid NullULIDs.
+func (n NullULID) IsZero() bool {
+	return !n.Valid
+}
+
+// ULID is a nullable ULID (Universally Unique Lexicographically Sortable Identifier),
+// as specified by https://github.com/ulid/spec.
+// It encodes as a 26 character upper case string in JSON and text. Lower case input is accepted.
+// It does not consider the all-zero ULID to be null.
+// It will decode to null, not zero, if null.
+type ULID struct {
+	NullULID
+}
+
+// NewULID creates a new ULID.
+func NewULID(id [16]byte, valid bool) ULID {
+	return ULID{
+		NullULID: NullULID{
+			ULID:  id,
+			Valid: valid,
+		},
+	}
+}
+
+// ULIDFrom creates a new ULID that will always be valid.
+func ULIDFrom(id [16]byte) ULID {
+	return NewULID(id, true)
+}
+
+// ULIDFromPtr creates a new ULID that will be null if id is nil.
+func ULIDFromPtr(id *[16]byte) ULID {
+	if id == nil {
+		return NewULID([16]byte{}, false)
+	}
+	return NewULID(*id, true)
+}
+
+// ParseULID creates a new ULID from its 26 character string form.
+func ParseULID(s string) (ULID, error) {
+	id, err := parseULID(s)
+	if err != nil {
+		return ULID{}, fmt.Errorf("null: couldn't parse ULID: %w", err)
+	}
+	return ULIDFrom(id), nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u ULID) ValueOrZero() [16]byte {
+	if !u.Valid {
+		return [16]byte{}
+	}
+	return u.ULID
+}
+
+// Time returns the timestamp encoded in this ULID, with millisecond precision,
+// or the zero time if this ULID is null.
+func (u ULID) Time() time.Time {
+	if !u.Valid {
+		return time.Time{}
+	}
+	var ms [8]byte
+	copy(ms[2:], u.ULID[:6])
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(ms[:]))).UTC()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports ULID strings and null input.
+func (u *ULID) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(u, &err)
+	if bytes.Equal(data, nullBytes) {
+		u.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	id, err := parseULID(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	u.ULID = id
+	u.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this ULID is null.
+func (u ULID) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + formatULID(u.ULID) + `"`), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null ULID if the input is blank or DefaultNullText.
+func (u *ULID) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(u, &err)
+	if isNullText(text) {
+		u.Valid = false
+		return nil
+	}
+	id, err := parseULID(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	u.ULID = id
+	u.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this ULID is null.
+func (u ULID) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(formatULID(u.ULID)), nil
+}
+
+// SetValid changes this ULID's value and also sets it to be non-null.
+func (u *ULID) SetValid(v [16]byte) {
+	u.ULID = v
+	u.Valid = true
+}
+
+// SetNull sets this ULID to null, leaving its value as is. Use Reset to clear the value too.
+func (u *ULID) SetNull() {
+	u.Valid = false
+}
+
+// Reset sets this ULID to null and clears its value.
+func (u *ULID) Reset() {
+	*u = ULID{}
+}
+
+// Ptr returns a pointer to this ULID's value, or a nil pointer if this ULID is null.
+func (u ULID) Ptr() *[16]byte {
+	if !u.Valid {
+		return nil
+	}
+	return &u.ULID
+}
+
+// SetPtr changes this ULID's value to the value v points to, or sets it to be null if v is nil, like ULIDFromPtr.
+func (u *ULID) SetPtr(v *[16]byte) {
+	*u = ULIDFromPtr(v)
+}
+
+// IsZero returns true for invalid ULIDs.
+// A non-null all-zero ULID will not be considered zero.
+func (u ULID) IsZero() bool {
+	return !u.Valid
+}
+
+// IsZeroOrNull returns true for invalid ULIDs, or valid ULIDs with all bytes zero.
+func (u ULID) IsZeroOrNull() bool {
+	return !u.Valid || u.ULID == [16]byte{}
+}
+
+// Equal returns true if both ULIDs have the same value or are both null.
+func (u ULID) Equal(other ULID) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.ULID == other.ULID)
+}
+
+func formatULID(id [16]byte) string {
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+	var buf [ulidLen]byte
+	for i := range buf {
+		shift := uint(5 * (ulidLen - 1 - i))
+		var v uint64
+		switch {
+		case shift == 0:
+			v = lo
+		case shift < 64:
+			v = lo>>shift | hi<<(64-shift)
+		default:
+			v = hi >> (shift - 64)
+		}
+		buf[i] = ulidAlphabet[v&31]
+	}
+	return string(buf[:])
+}
+
+func parseULID(str string) ([16]byte, error) {
+	var id [16]byte
+	if len(str) != ulidLen {
+		return id, fmt.Errorf("invalid ULID length: %q", str)
+	}
+	var hi, lo uint64
+	for i := 0; i < len(str); i++ {
+		d := strings.IndexByte(ulidAlphabet, upperASCII(str[i]))
+		if d < 0 {
+			return id, fmt.Errorf("invalid ULID character %q: %q", str[i], str)
+		}
+		if i == 0 && d > 7 {
+			// the first character holds only 3 bits
+			return id, fmt.Errorf("ULID out of range: %q", str)
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(d)
+	}
+	binary.BigEndian.PutUint64(id[:8], hi)
+	binary.BigEndian.PutUint64(id[8:], lo)
+	return id, nil
+}
+
+func upperASCII(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - ('a' - 'A')
+	}
+	return c
+}
@@ -0,0 +1,200 @@
+package null
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	ulidString = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	ulidValue  = mustHexArray16("01563e3ab5d3d6764c61efb99302bd5b")
+	ulidJSON   = []byte(`"01ARZ3NDEKTSV4RRFFQ69G5FAV"`)
+)
+
+func TestParseULID(t *testing.T) {
+	for _, in := range []string{ulidString, "01arz3ndektsv4rrffq69g5fav"} {
+		u, err := ParseULID(in)
+		if err != nil {
+			t.Errorf("ParseULID(%q): unexpected error: %v", in, err)
+			continue
+		}
+		assertULID(t, u, "ParseULID("+in+")")
+	}
+
+	max, err := ParseULID("7ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+	maybePanic(err)
+	if max.ULID != [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff} {
+		t.Errorf("bad max ULID: %x", max.ULID)
+	}
+
+	for _, bad := range []string{"", "01ARZ3NDEKTSV4RRFFQ69G5FA", "01ARZ3NDEKTSV4RRFFQ69G5FAVV", "01ARZ3NDEKTSV4RRFFQ69G5FAU", "01ARZ3NDEKTSV4RRFFQ69G5FA-", "80000000000000000000000000"} {
+		if _, err := ParseULID(bad); err == nil {
+			t.Errorf("ParseULID(%q): expected error", bad)
+		}
+	}
+}
+
+func TestULIDFromPtr(t *testing.T) {
+	v := ulidValue
+	assertULID(t, ULIDFromPtr(&v), "ULIDFromPtr()")
+	assertNullULID(t, ULIDFromPtr(nil), "ULIDFromPtr(nil)")
+}
+
+func TestULIDTime(t *testing.T) {
+	want := time.UnixMilli(1469922850259).UTC()
+	if got := ULIDFrom(ulidValue).Time(); !got.Equal(want) {
+		t.Errorf("bad Time(): %v ≠ %v", got, want)
+	}
+	if !NewULID(ulidValue, false).Time().IsZero() {
+		t.Error("null ULID should have zero Time()")
+	}
+}
+
+func TestUnmarshalULID(t *testing.T) {
+	var u ULID
+	err := json.Unmarshal(ulidJSON, &u)
+	maybePanic(err)
+	assertULID(t, u, "ulid json")
+
+	var null ULID
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullULID(t, null, "null json")
+
+	for _, bad := range [][]byte{blankStringJSON, []byte(`"not a ulid"`), intJSON, invalidJSON} {
+		var invalid ULID
+		if err := json.Unmarshal(bad, &invalid); err == nil {
+			t.Errorf("expected error unmarshaling %s", bad)
+		}
+		assertNullULID(t, invalid, "invalid json")
+	}
+}
+
+func TestMarshalULID(t *testing.T) {
+	data, err := json.Marshal(ULIDFrom(ulidValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(ulidJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(ULIDFrom([16]byte{}))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"00000000000000000000000000"`, "zero json marshal")
+
+	data, err = json.Marshal(NewULID(ulidValue, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestULIDText(t *testing.T) {
+	data, err := ULIDFrom(ulidValue).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, ulidString, "text marshal")
+
+	var u ULID
+	err = u.UnmarshalText([]byte(ulidString))
+	maybePanic(err)
+	assertULID(t, u, "text unmarshal")
+
+	var blank ULID
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullULID(t, blank, "blank text unmarshal")
+
+	data, err = NewULID(ulidValue, false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	if err := u.UnmarshalText([]byte("nope")); err == nil {
+		t.Error("expected error unmarshaling bad text")
+	}
+}
+
+func TestULIDScanValue(t *testing.T) {
+	for _, in := range []interface{}{ulidString, []byte(ulidString), ulidValue[:]} {
+		var u ULID
+		err := u.Scan(in)
+		maybePanic(err)
+		assertULID(t, u, "scanned")
+	}
+
+	var null ULID
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullULID(t, null, "scanned null")
+
+	for _, bad := range []interface{}{"x", []byte{1, 2, 3}, int64(1)} {
+		var invalid ULID
+		if err := invalid.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %v", bad)
+		}
+		assertNullULID(t, invalid, "invalid scan")
+	}
+
+	v, err := ULIDFrom(ulidValue).Value()
+	maybePanic(err)
+	if v != ulidString {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v", v)
+	}
+}
+
+func TestULIDPointer(t *testing.T) {
+	u := ULIDFrom(ulidValue)
+	if ptr := u.Ptr(); ptr == nil || *ptr != ulidValue {
+		t.Errorf("bad pointer: %v", ptr)
+	}
+	if NewULID(ulidValue, false).Ptr() != nil {
+		t.Error("null Ptr() should be nil")
+	}
+	if u.ValueOrZero() != ulidValue || NewULID(ulidValue, false).ValueOrZero() != [16]byte{} {
+		t.Error("bad ValueOrZero()")
+	}
+}
+
+func TestULIDSetValid(t *testing.T) {
+	var change ULID
+	assertNullULID(t, change, "SetValid()")
+	change.SetValid(ulidValue)
+	assertULID(t, change, "SetValid()")
+}
+
+func TestULIDEqual(t *testing.T) {
+	if !ULIDFrom(ulidValue).Equal(ULIDFrom(ulidValue)) || !NewULID(ulidValue, false).Equal(ULID{}) {
+		t.Error("ULIDs should be equal")
+	}
+	if ULIDFrom(ulidValue).Equal(ULIDFrom([16]byte{})) || ULIDFrom([16]byte{}).Equal(ULID{}) {
+		t.Error("ULIDs should not be equal")
+	}
+	if ULIDFrom([16]byte{}).IsZero() || !(ULID{}).IsZero() {
+		t.Error("bad IsZero()")
+	}
+}
+
+func assertULID(t *testing.T, u ULID, from string) {
+	t.Helper()
+	if u.ULID != ulidValue {
+		t.Errorf("bad %s ULID: %x ≠ %x\n", from, u.ULID, ulidValue)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullULID(t *testing.T, u ULID, from string) {
+	t.Helper()
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func mustHexArray16(s string) (a [16]byte) {
+	if n, err := hex.Decode(a[:], []byte(s)); err != nil || n != len(a) {
+		panic("bad hex: " + s)
+	}
+	return
+}
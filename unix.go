@@ -0,0 +1,415 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// UnixSeconds is a nullable time.Time that is represented in JSON
+// as an integer number of seconds since the Unix epoch.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type UnixSeconds struct {
+	sql.NullTime
+}
+
+// NewUnixSeconds creates a new UnixSeconds.
+func NewUnixSeconds(t time.Time, valid bool) UnixSeconds {
+	return UnixSeconds{
+		NullTime: sql.NullTime{
+			Time:  t,
+			Valid: valid,
+		},
+	}
+}
+
+// UnixSecondsFrom creates a new UnixSeconds that will always be valid.
+func UnixSecondsFrom(t time.Time) UnixSeconds {
+	return NewUnixSeconds(t, true)
+}
+
+// UnixSecondsFromPtr creates a new UnixSeconds that will be null if t is nil.
+func UnixSecondsFromPtr(t *time.Time) UnixSeconds {
+	if t == nil {
+		return NewUnixSeconds(time.Time{}, false)
+	}
+	return NewUnixSeconds(*t, true)
+}
+
+// UnixSecondsFromInt64 creates a new UnixSeconds from seconds since the Unix epoch.
+// It will always be valid.
+func UnixSecondsFromInt64(secs int64) UnixSeconds {
+	return UnixSecondsFrom(unixTime(secs, time.Second))
+}
+
+// Scan implements the Scanner interface.
+// It supports time.Time values, and integer seconds since the Unix epoch, including integral floats.
+func (u *UnixSeconds) Scan(value interface{}) (err error) {
+	defer validateDecoded(u, &err)
+	value = driverval.Convert(value)
+	u.Time, u.Valid, err = scanUnix(value, time.Second)
+	if err != nil {
+		return fmt.Errorf("null: couldn't scan UnixSeconds: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as time.Time.
+func (u UnixSeconds) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.Time, nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u UnixSeconds) ValueOrZero() time.Time {
+	if !u.Valid {
+		return time.Time{}
+	}
+	return u.Time
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this time is null,
+// otherwise the number of seconds since the Unix epoch.
+func (u UnixSeconds) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(u.Time.Unix(), 10)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports integer seconds, integer strings, and null input.
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict.
+func (u *UnixSeconds) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(u, &err)
+	return u.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (u *UnixSeconds) unmarshalJSONWith(data []byte, d JSONDecoding) error {
+	if bytes.Equal(data, nullBytes) {
+		u.Valid = false
+		return nil
+	}
+	n, err := unmarshalUnixJSON(data, d)
+	if err != nil {
+		return err
+	}
+	u.Time = unixTime(n, time.Second)
+	u.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns DefaultNullText if invalid, otherwise the number of seconds since the Unix epoch.
+func (u UnixSeconds) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatInt(u.Time.Unix(), 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UnixSeconds if the input is blank, "null", or DefaultNullText.
+func (u *UnixSeconds) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(u, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		u.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	u.Time = unixTime(n, time.Second)
+	u.Valid = true
+	return nil
+}
+
+// SetValid changes this UnixSeconds' value and sets it to be non-null.
+func (u *UnixSeconds) SetValid(v time.Time) {
+	u.Time = v
+	u.Valid = true
+}
+
+// SetNull sets this UnixSeconds to null, leaving its value as is. Use Reset to clear the value too.
+func (u *UnixSeconds) SetNull() {
+	u.Valid = false
+}
+
+// Reset sets this UnixSeconds to null and clears its value.
+func (u *UnixSeconds) Reset() {
+	*u = UnixSeconds{}
+}
+
+// Ptr returns a pointer to this UnixSeconds' value, or a nil pointer if this UnixSeconds is null.
+func (u UnixSeconds) Ptr() *time.Time {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Time
+}
+
+// SetPtr changes this UnixSeconds's value to the value v points to, or sets it to be null if v is nil, like UnixSecondsFromPtr.
+func (u *UnixSeconds) SetPtr(v *time.Time) {
+	*u = UnixSecondsFromPtr(v)
+}
+
+// IsZero returns true for invalid UnixSeconds, for future omitempty support.
+// A non-null UnixSeconds with a zero value will not be considered zero.
+func (u UnixSeconds) IsZero() bool {
+	return !u.Valid
+}
+
+// IsZeroOrNull returns true for invalid UnixSecondss, or valid UnixSecondss with the zero time or the Unix epoch.
+func (u UnixSeconds) IsZeroOrNull() bool {
+	return !u.Valid || u.Time.IsZero() || u.Time.Unix() == 0
+}
+
+// Equal returns true if both UnixSeconds encode the same time or are both null.
+// Times with different sub-second parts are not equal, even though they encode to the same JSON.
+func (u UnixSeconds) Equal(other UnixSeconds) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.Time.Equal(other.Time))
+}
+
+// UnixMillis is a nullable time.Time that is represented in JSON
+// as an integer number of milliseconds since the Unix epoch.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type UnixMillis struct {
+	sql.NullTime
+}
+
+// NewUnixMillis creates a new UnixMillis.
+func NewUnixMillis(t time.Time, valid bool) UnixMillis {
+	return UnixMillis{
+		NullTime: sql.NullTime{
+			Time:  t,
+			Valid: valid,
+		},
+	}
+}
+
+// UnixMillisFrom creates a new UnixMillis that will always be valid.
+func UnixMillisFrom(t time.Time) UnixMillis {
+	return NewUnixMillis(t, true)
+}
+
+// UnixMillisFromPtr creates a new UnixMillis that will be null if t is nil.
+func UnixMillisFromPtr(t *time.Time) UnixMillis {
+	if t == nil {
+		return NewUnixMillis(time.Time{}, false)
+	}
+	return NewUnixMillis(*t, true)
+}
+
+// UnixMillisFromInt64 creates a new UnixMillis from milliseconds since the Unix epoch.
+// It will always be valid.
+func UnixMillisFromInt64(ms int64) UnixMillis {
+	return UnixMillisFrom(unixTime(ms, time.Millisecond))
+}
+
+// Scan implements the Scanner interface.
+// It supports time.Time values, and integer milliseconds since the Unix epoch, including integral floats.
+func (u *UnixMillis) Scan(value interface{}) (err error) {
+	defer validateDecoded(u, &err)
+	value = driverval.Convert(value)
+	u.Time, u.Valid, err = scanUnix(value, time.Millisecond)
+	if err != nil {
+		return fmt.Errorf("null: couldn't scan UnixMillis: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as time.Time.
+func (u UnixMillis) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.Time, nil
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (u UnixMillis) ValueOrZero() time.Time {
+	if !u.Valid {
+		return time.Time{}
+	}
+	return u.Time
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this time is null,
+// otherwise the number of milliseconds since the Unix epoch.
+func (u UnixMillis) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(u.Time.UnixMilli(), 10)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports integer milliseconds, integer strings, and null input.
+// Strings are not accepted if DefaultJSONDecoding is JSONStrict.
+func (u *UnixMillis) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(u, &err)
+	return u.unmarshalJSONWith(data, DefaultJSONDecoding)
+}
+
+func (u *UnixMillis) unmarshalJSONWith(data []byte, d JSONDecoding) error {
+	if bytes.Equal(data, nullBytes) {
+		u.Valid = false
+		return nil
+	}
+	n, err := unmarshalUnixJSON(data, d)
+	if err != nil {
+		return err
+	}
+	u.Time = unixTime(n, time.Millisecond)
+	u.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns DefaultNullText if invalid, otherwise the number of milliseconds since the Unix epoch.
+func (u UnixMillis) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(strconv.FormatInt(u.Time.UnixMilli(), 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UnixMillis if the input is blank, "null", or DefaultNullText.
+func (u *UnixMillis) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(u, &err)
+	str := string(text)
+	if isNullText(text) || str == "null" {
+		u.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	u.Time = unixTime(n, time.Millisecond)
+	u.Valid = true
+	return nil
+}
+
+// SetValid changes this UnixMillis' value and sets it to be non-null.
+func (u *UnixMillis) SetValid(v time.Time) {
+	u.Time = v
+	u.Valid = true
+}
+
+// SetNull sets this UnixMillis to null, leaving its value as is. Use Reset to clear the value too.
+func (u *UnixMillis) SetNull() {
+	u.Valid = false
+}
+
+// Reset sets this UnixMillis to null and clears its value.
+func (u *UnixMillis) Reset() {
+	*u = UnixMillis{}
+}
+
+// Ptr returns a pointer to this UnixMillis' value, or a nil pointer if this UnixMillis is null.
+func (u UnixMillis) Ptr() *time.Time {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Time
+}
+
+// SetPtr changes this UnixMillis's value to the value v points to, or sets it to be null if v is nil, like UnixMillisFromPtr.
+func (u *UnixMillis) SetPtr(v *time.Time) {
+	*u = UnixMillisFromPtr(v)
+}
+
+// IsZero returns true for invalid UnixMillis, for future omitempty support.
+// A non-null UnixMillis with a zero value will not be considered zero.
+func (u UnixMillis) IsZero() bool {
+	return !u.Valid
+}
+
+// IsZeroOrNull returns true for invalid UnixMilliss, or valid UnixMilliss with the zero time or the Unix epoch.
+func (u UnixMillis) IsZeroOrNull() bool {
+	return !u.Valid || u.Time.IsZero() || u.Time.UnixMilli() == 0
+}
+
+// Equal returns true if both UnixMillis encode the same time or are both null.
+func (u UnixMillis) Equal(other UnixMillis) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.Time.Equal(other.Time))
+}
+
+// unixTime converts n units since the Unix epoch to a UTC time.
+func unixTime(n int64, unit time.Duration) time.Time {
+	if unit == time.Millisecond {
+		return time.UnixMilli(n).UTC()
+	}
+	return time.Unix(n, 0).UTC()
+}
+
+func scanUnix(value interface{}, unit time.Duration) (time.Time, bool, error) {
+	var n int64
+	var err error
+	switch v := value.(type) {
+	case nil:
+		return time.Time{}, false, nil
+	case time.Time:
+		return v, true, nil
+	case int64:
+		n = v
+	case float64:
+		var ok bool
+		if n, ok = driverval.FloatToInt(v); !ok {
+			err = fmt.Errorf("not an integer in range: %v", v)
+		}
+	case []byte:
+		n, err = strconv.ParseInt(string(v), 10, 64)
+	case string:
+		n, err = strconv.ParseInt(v, 10, 64)
+	default:
+		return time.Time{}, false, fmt.Errorf("unsupported type %T: %v", value, value)
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return unixTime(n, unit), true, nil
+}
+
+func unmarshalUnixJSON(data []byte, d JSONDecoding) (int64, error) {
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" || !d.numberStrings() {
+				return 0, fmt.Errorf("null: JSON input is invalid type (need %s): %w", d.numberInput("int"), err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return 0, fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseInt(str, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("null: couldn't convert string to int: %w", err)
+			}
+			return n, nil
+		}
+		return 0, fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return n, nil
+}
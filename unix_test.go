@@ -0,0 +1,334 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	unixSecondsJSON       = []byte(`1356124881`)
+	unixSecondsStringJSON = []byte(`"1356124881"`)
+	unixMillisJSON        = []byte(`1356124881000`)
+	unixMillisValue       = timeValue1.Add(123 * time.Millisecond)
+)
+
+func TestUnixSecondsFrom(t *testing.T) {
+	u := UnixSecondsFrom(timeValue1)
+	assertUnixSeconds(t, u, "UnixSecondsFrom()")
+
+	i := UnixSecondsFromInt64(1356124881)
+	assertUnixSeconds(t, i, "UnixSecondsFromInt64()")
+
+	zero := UnixSecondsFrom(time.Time{})
+	if !zero.Valid {
+		t.Error("UnixSecondsFrom(time.Time{})", "is invalid, but should be valid")
+	}
+}
+
+func TestUnixSecondsFromPtr(t *testing.T) {
+	ti := timeValue1
+	u := UnixSecondsFromPtr(&ti)
+	assertUnixSeconds(t, u, "UnixSecondsFromPtr()")
+
+	null := UnixSecondsFromPtr(nil)
+	assertNullUnixSeconds(t, null, "UnixSecondsFromPtr(nil)")
+}
+
+func TestUnmarshalUnixSeconds(t *testing.T) {
+	var u UnixSeconds
+	err := json.Unmarshal(unixSecondsJSON, &u)
+	maybePanic(err)
+	assertUnixSeconds(t, u, "unix seconds json")
+
+	var str UnixSeconds
+	err = json.Unmarshal(unixSecondsStringJSON, &str)
+	maybePanic(err)
+	assertUnixSeconds(t, str, "unix seconds string json")
+
+	var null UnixSeconds
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUnixSeconds(t, null, "null json")
+
+	var rfc UnixSeconds
+	err = json.Unmarshal(timeJSON, &rfc)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUnixSeconds(t, rfc, "RFC 3339 json")
+
+	var float UnixSeconds
+	err = json.Unmarshal(floatJSON, &float)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUnixSeconds(t, float, "float json")
+
+	var invalid UnixSeconds
+	err = invalid.UnmarshalJSON(invalidJSON)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUnixSeconds(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalUnixSeconds(t *testing.T) {
+	var u UnixSeconds
+	err := u.UnmarshalText([]byte("1356124881"))
+	maybePanic(err)
+	assertUnixSeconds(t, u, "UnmarshalText() unix seconds")
+
+	var blank UnixSeconds
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullUnixSeconds(t, blank, "UnmarshalText() empty unix seconds")
+
+	var null UnixSeconds
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullUnixSeconds(t, null, `UnmarshalText() "null"`)
+
+	var invalid UnixSeconds
+	err = invalid.UnmarshalText([]byte(timeString1))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalUnixSeconds(t *testing.T) {
+	u := UnixSecondsFrom(timeValue1.Add(500 * time.Millisecond))
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, "1356124881", "non-empty json marshal")
+
+	data, err = u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "1356124881", "non-empty text marshal")
+
+	null := NewUnixSeconds(timeValue1, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUnixSecondsScanValue(t *testing.T) {
+	var ti UnixSeconds
+	err := ti.Scan(timeValue1)
+	maybePanic(err)
+	assertUnixSeconds(t, ti, "scanned time")
+
+	var i UnixSeconds
+	err = i.Scan(int64(1356124881))
+	maybePanic(err)
+	assertUnixSeconds(t, i, "scanned int")
+
+	var b UnixSeconds
+	err = b.Scan([]byte("1356124881"))
+	maybePanic(err)
+	assertUnixSeconds(t, b, "scanned bytes")
+
+	var null UnixSeconds
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUnixSeconds(t, null, "scanned null")
+
+	var wrong UnixSeconds
+	err = wrong.Scan(1.5)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUnixSeconds(t, wrong, "scanned float")
+
+	if v, err := ti.Value(); v != timeValue1 || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+}
+
+func TestUnixSecondsPointer(t *testing.T) {
+	u := UnixSecondsFrom(timeValue1)
+	ptr := u.Ptr()
+	if *ptr != timeValue1 {
+		t.Errorf("bad %s time: %#v ≠ %v\n", "pointer", ptr, timeValue1)
+	}
+
+	null := NewUnixSeconds(timeValue1, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s time: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUnixSecondsIsZeroSetValid(t *testing.T) {
+	null := NewUnixSeconds(timeValue1, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+	zero := UnixSecondsFrom(time.Time{})
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null.SetValid(timeValue1)
+	assertUnixSeconds(t, null, "SetValid()")
+}
+
+func TestUnixSecondsEqual(t *testing.T) {
+	if !NewUnixSeconds(timeValue1, false).Equal(NewUnixSeconds(timeValue3, false)) {
+		t.Error("null UnixSeconds should be equal")
+	}
+	if !UnixSecondsFrom(timeValue1).Equal(UnixSecondsFrom(timeValue2)) {
+		t.Error("same instant in different locations should be equal")
+	}
+	if UnixSecondsFrom(timeValue1).Equal(NewUnixSeconds(timeValue1, false)) {
+		t.Error("valid and null UnixSeconds should not be equal")
+	}
+	if UnixSecondsFrom(timeValue1).Equal(UnixSecondsFrom(timeValue3)) {
+		t.Error("different times should not be equal")
+	}
+}
+
+func TestUnixMillisFrom(t *testing.T) {
+	u := UnixMillisFrom(unixMillisValue)
+	assertUnixMillis(t, u, "UnixMillisFrom()")
+
+	i := UnixMillisFromInt64(1356124881123)
+	assertUnixMillis(t, i, "UnixMillisFromInt64()")
+
+	v := unixMillisValue
+	p := UnixMillisFromPtr(&v)
+	assertUnixMillis(t, p, "UnixMillisFromPtr()")
+
+	null := UnixMillisFromPtr(nil)
+	assertNullUnixMillis(t, null, "UnixMillisFromPtr(nil)")
+}
+
+func TestUnmarshalUnixMillis(t *testing.T) {
+	var u UnixMillis
+	err := json.Unmarshal([]byte(`1356124881123`), &u)
+	maybePanic(err)
+	assertUnixMillis(t, u, "unix millis json")
+
+	var str UnixMillis
+	err = json.Unmarshal([]byte(`"1356124881123"`), &str)
+	maybePanic(err)
+	assertUnixMillis(t, str, "unix millis string json")
+
+	var null UnixMillis
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUnixMillis(t, null, "null json")
+
+	var bad UnixMillis
+	err = json.Unmarshal(boolJSON, &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullUnixMillis(t, bad, "bool json")
+
+	var text UnixMillis
+	err = text.UnmarshalText([]byte("1356124881123"))
+	maybePanic(err)
+	assertUnixMillis(t, text, "UnmarshalText() unix millis")
+
+	var blank UnixMillis
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullUnixMillis(t, blank, "UnmarshalText() empty unix millis")
+}
+
+func TestMarshalUnixMillis(t *testing.T) {
+	u := UnixMillisFrom(timeValue1)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(unixMillisJSON), "non-empty json marshal")
+
+	data, err = u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, string(unixMillisJSON), "non-empty text marshal")
+
+	null := NewUnixMillis(timeValue1, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestUnixMillisScanValue(t *testing.T) {
+	var i UnixMillis
+	err := i.Scan(int64(1356124881123))
+	maybePanic(err)
+	assertUnixMillis(t, i, "scanned int")
+
+	var ti UnixMillis
+	err = ti.Scan(unixMillisValue)
+	maybePanic(err)
+	assertUnixMillis(t, ti, "scanned time")
+
+	var null UnixMillis
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUnixMillis(t, null, "scanned null")
+
+	if v, err := i.Value(); v != unixMillisValue || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+}
+
+func TestUnixMillisEqual(t *testing.T) {
+	if !UnixMillisFrom(unixMillisValue).Equal(UnixMillisFromInt64(1356124881123)) {
+		t.Error("same times should be equal")
+	}
+	if UnixMillisFrom(unixMillisValue).Equal(UnixMillisFrom(timeValue1)) {
+		t.Error("different times should not be equal")
+	}
+	null := NewUnixMillis(timeValue1, false)
+	if !null.IsZero() || !null.Equal(UnixMillis{}) {
+		t.Error("null UnixMillis should be zero and equal")
+	}
+	null.SetValid(unixMillisValue)
+	assertUnixMillis(t, null, "SetValid()")
+}
+
+func assertUnixSeconds(t *testing.T, u UnixSeconds, from string) {
+	t.Helper()
+	if !u.Time.Equal(timeValue1) {
+		t.Errorf("bad %v time: %v ≠ %v\n", from, u.Time, timeValue1)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUnixSeconds(t *testing.T, u UnixSeconds, from string) {
+	t.Helper()
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertUnixMillis(t *testing.T, u UnixMillis, from string) {
+	t.Helper()
+	if !u.Time.Equal(unixMillisValue) {
+		t.Errorf("bad %v time: %v ≠ %v\n", from, u.Time, unixMillisValue)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUnixMillis(t *testing.T, u UnixMillis, from string) {
+	t.Helper()
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
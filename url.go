@@ -0,0 +1,207 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
+)
+
+// NullURL represents a *url.URL that may be null.
+// NullURL implements the Scanner interface so
+// it can be used as a scan destination, similar to NullString.
+type NullURL struct {
+	URL   *url.URL
+	Valid bool // Valid is true if URL is not NULL
+}
+
+// Scan implements the Scanner interface.
+// It will return an error if the input can't be parsed as a URL.
+func (n *NullURL) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	var str string
+	switch v := value.(type) {
+	case nil:
+		n.URL, n.Valid = nil, false
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("null: couldn't scan type %T into URL: %v", value, value)
+	}
+	u, err := url.Parse(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't scan URL: %w", err)
+	}
+	n.URL, n.Valid = u, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Valid values are sent as their string form.
+func (n NullURL) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.URL.String(), nil
+}
+
+// IsZero returns true for invalid NullURLs.
+func (n NullURL) IsZero() bool {
+	return !n.Valid
+}
+
+// URL is a nullable *url.URL.
+// It will decode to null, not an empty URL, if null.
+// Copies of a URL share the same underlying *url.URL.
+type URL struct {
+	NullURL
+}
+
+// NewURL creates a new URL.
+func NewURL(u *url.URL, valid bool) URL {
+	return URL{
+		NullURL: NullURL{
+			URL:   u,
+			Valid: valid && u != nil,
+		},
+	}
+}
+
+// URLFrom creates a new URL that will be null if u is nil.
+func URLFrom(u *url.URL) URL {
+	return NewURL(u, u != nil)
+}
+
+// ParseURL parses s into a new URL that will always be valid.
+// It will return an error if s can't be parsed as a URL.
+func ParseURL(s string) (URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return URL{}, fmt.Errorf("null: couldn't parse URL: %w", err)
+	}
+	return URLFrom(u), nil
+}
+
+// MustURL is like ParseURL but panics if s can't be parsed.
+// It is intended for use with constant input, such as in variable initialization.
+func MustURL(s string) URL {
+	u, err := ParseURL(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// ValueOrZero returns the inner value if valid, otherwise a new empty *url.URL.
+func (u URL) ValueOrZero() *url.URL {
+	if !u.Valid {
+		return &url.URL{}
+	}
+	return u.URL
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+// It will return an error if the input can't be parsed as a URL.
+func (u *URL) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(u, &err)
+	if bytes.Equal(data, nullBytes) {
+		u.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := url.Parse(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	u.URL = v
+	u.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this URL is null.
+func (u URL) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(u.URL.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null URL if the input is DefaultNullText, which is blank by default.
+// It will return an error if the input can't be parsed as a URL.
+func (u *URL) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(u, &err)
+	if isNullTextOnly(text) {
+		u.Valid = false
+		return nil
+	}
+	v, err := url.Parse(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	u.URL = v
+	u.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this URL is null.
+func (u URL) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return nullText(), nil
+	}
+	return []byte(u.URL.String()), nil
+}
+
+// SetValid changes this URL's value and also sets it to be non-null.
+// A nil v will set this URL to null.
+func (u *URL) SetValid(v *url.URL) {
+	u.URL = v
+	u.Valid = v != nil
+}
+
+// SetNull sets this URL to null, leaving its value as is. Use Reset to clear the value too.
+func (u *URL) SetNull() {
+	u.Valid = false
+}
+
+// Reset sets this URL to null and clears its value.
+func (u *URL) Reset() {
+	*u = URL{}
+}
+
+// Ptr returns this URL's value, or a nil pointer if this URL is null.
+func (u URL) Ptr() *url.URL {
+	if !u.Valid {
+		return nil
+	}
+	return u.URL
+}
+
+// IsZero returns true for invalid URLs.
+func (u URL) IsZero() bool {
+	return !u.Valid
+}
+
+// IsZeroOrNull returns true for invalid URLs, or valid URLs with an empty URL.
+func (u URL) IsZeroOrNull() bool {
+	return !u.Valid || u.URL == nil || *u.URL == (url.URL{})
+}
+
+// Equal returns true if both URLs have the same string form or are both null.
+func (u URL) Equal(other URL) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.URL.String() == other.URL.String())
+}
@@ -0,0 +1,261 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+)
+
+var (
+	urlString    = "https://example.com/path?q=1#frag"
+	urlJSON      = []byte(`"` + urlString + `"`)
+	urlValue, _  = url.Parse(urlString)
+	invalidURL   = "http://[::1"
+	otherURLJSON = []byte(`"/relative/path"`)
+)
+
+func TestURLFrom(t *testing.T) {
+	u := URLFrom(urlValue)
+	assertURL(t, u, "URLFrom()")
+
+	null := URLFrom(nil)
+	assertNullURL(t, null, "URLFrom(nil)")
+}
+
+func TestParseURL(t *testing.T) {
+	u, err := ParseURL(urlString)
+	maybePanic(err)
+	assertURL(t, u, "ParseURL()")
+
+	_, err = ParseURL(invalidURL)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMustURL(t *testing.T) {
+	u := MustURL(urlString)
+	assertURL(t, u, "MustURL()")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustURL() should panic on invalid input")
+		}
+	}()
+	MustURL(invalidURL)
+}
+
+func TestUnmarshalURL(t *testing.T) {
+	var u URL
+	err := json.Unmarshal(urlJSON, &u)
+	maybePanic(err)
+	assertURL(t, u, "url json")
+
+	var rel URL
+	err = json.Unmarshal(otherURLJSON, &rel)
+	maybePanic(err)
+	if !rel.Valid || rel.URL.Path != "/relative/path" {
+		t.Errorf("bad relative url json: %v", rel.URL)
+	}
+
+	var null URL
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullURL(t, null, "null json")
+
+	var bad URL
+	err = json.Unmarshal([]byte(`"`+invalidURL+`"`), &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullURL(t, bad, "bad url json")
+
+	var badType URL
+	err = json.Unmarshal(intJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullURL(t, badType, "wrong type json")
+
+	var invalid URL
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullURL(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalURL(t *testing.T) {
+	var u URL
+	err := u.UnmarshalText([]byte(urlString))
+	maybePanic(err)
+	assertURL(t, u, "UnmarshalText() url")
+
+	var blank URL
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullURL(t, blank, "UnmarshalText() empty url")
+
+	var invalid URL
+	err = invalid.UnmarshalText([]byte(invalidURL))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalURL(t *testing.T) {
+	u := URLFrom(urlValue)
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(urlJSON), "non-empty json marshal")
+
+	null := NewURL(nil, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalURLText(t *testing.T) {
+	u := URLFrom(urlValue)
+	data, err := u.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, urlString, "non-empty text marshal")
+
+	null := NewURL(nil, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestURLPointer(t *testing.T) {
+	u := URLFrom(urlValue)
+	if ptr := u.Ptr(); ptr != urlValue {
+		t.Errorf("bad %s url: %v ≠ %v\n", "pointer", ptr, urlValue)
+	}
+
+	null := NewURL(urlValue, false)
+	if ptr := null.Ptr(); ptr != nil {
+		t.Errorf("bad %s url: %v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestURLIsZero(t *testing.T) {
+	u := URLFrom(urlValue)
+	if u.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewURL(nil, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestURLSetValid(t *testing.T) {
+	change := NewURL(nil, false)
+	assertNullURL(t, change, "SetValid()")
+	change.SetValid(urlValue)
+	assertURL(t, change, "SetValid()")
+}
+
+func TestURLValueOrZero(t *testing.T) {
+	valid := URLFrom(urlValue)
+	if valid.ValueOrZero() != urlValue {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewURL(urlValue, false)
+	if invalid.ValueOrZero().String() != "" {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestURLScan(t *testing.T) {
+	var u URL
+	err := u.Scan(urlString)
+	maybePanic(err)
+	assertURL(t, u, "scanned string")
+
+	var b URL
+	err = b.Scan([]byte(urlString))
+	maybePanic(err)
+	assertURL(t, b, "scanned bytes")
+
+	var null URL
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullURL(t, null, "scanned null")
+
+	var invalid URL
+	err = invalid.Scan(invalidURL)
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	var wrong URL
+	err = wrong.Scan(int64(42))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestURLValue(t *testing.T) {
+	u := URLFrom(urlValue)
+	v, err := u.Value()
+	maybePanic(err)
+	if v != urlString {
+		t.Errorf("bad Value(): %#v ≠ %s", v, urlString)
+	}
+
+	null := NewURL(nil, false)
+	v, err = null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", v)
+	}
+}
+
+func TestURLEqual(t *testing.T) {
+	u1 := NewURL(urlValue, false)
+	u2 := NewURL(nil, false)
+	if !u1.Equal(u2) {
+		t.Error("null URLs should be equal")
+	}
+
+	u1 = MustURL(urlString)
+	u2 = MustURL(urlString)
+	if !u1.Equal(u2) {
+		t.Error("same URLs should be equal")
+	}
+
+	u1 = MustURL(urlString)
+	u2 = NewURL(urlValue, false)
+	if u1.Equal(u2) {
+		t.Error("valid and null URLs should not be equal")
+	}
+
+	u1 = MustURL(urlString)
+	u2 = MustURL("https://example.org")
+	if u1.Equal(u2) {
+		t.Error("different URLs should not be equal")
+	}
+}
+
+func assertURL(t *testing.T, u URL, from string) {
+	t.Helper()
+	if u.URL == nil || u.URL.String() != urlString {
+		t.Errorf("bad %s url: %v ≠ %s\n", from, u.URL, urlString)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullURL(t *testing.T, u URL, from string) {
+	t.Helper()
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
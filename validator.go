@@ -0,0 +1,254 @@
+package null
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	validatorMu sync.RWMutex
+	validators  = make(map[reflect.Type]func(interface{}) error)
+	// hasValidators is true once a validator has been registered, so that decoding doesn't take the lock otherwise.
+	hasValidators atomic.Bool
+)
+
+// getter is implemented by the types with a single value.
+type getter[T any] interface {
+	Get() (T, bool)
+}
+
+// RegisterValidator sets a function that UnmarshalJSON, UnmarshalText, and Scan of N call with the decoded value
+// if it is valid, replacing any previously registered function, so that rules such as ranges are enforced
+// at the decoding boundary:
+//
+//	null.RegisterValidator[null.Uint](func(v uint64) error {
+//		if v > 100 {
+//			return errors.New("must be at most 100")
+//		}
+//		return nil
+//	})
+//
+// If validate returns an error, the value is set to null and the error is returned, wrapped.
+// Null values aren't validated. A nil validate removes the validator for N.
+// It is typically called from an init function. Validators work with the types with a single value,
+// which have Get methods, except Map.
+func RegisterValidator[N getter[T], T any](validate func(T) error) {
+	typ := reflect.TypeOf((*N)(nil)).Elem()
+	validatorMu.Lock()
+	defer validatorMu.Unlock()
+	if validate == nil {
+		delete(validators, typ)
+		return
+	}
+	validators[typ] = func(v interface{}) error {
+		x, ok := v.(N).Get()
+		if !ok {
+			return nil
+		}
+		return validate(x)
+	}
+	hasValidators.Store(true)
+}
+
+// validateDecoded calls the validator registered for the type pointed to by v, if decoding it didn't return an error.
+// It is deferred by UnmarshalJSON, UnmarshalText, and Scan, which name their error result err.
+func validateDecoded(v interface{ SetNull() }, err *error) {
+	if *err != nil || !hasValidators.Load() {
+		return
+	}
+	rv := reflect.ValueOf(v).Elem()
+	validatorMu.RLock()
+	validate, ok := validators[rv.Type()]
+	validatorMu.RUnlock()
+	if !ok {
+		return
+	}
+	if verr := validate(rv.Interface()); verr != nil {
+		v.SetNull()
+		*err = fmt.Errorf("null: invalid %s: %w", rv.Type().Name(), verr)
+	}
+}
+
+// The types below get Scan from an embedded type, which doesn't know about validators, so they override it.
+
+// Scan implements sql.Scanner, like NullAddr.Scan, and calls the validator registered with RegisterValidator.
+func (a *Addr) Scan(value interface{}) (err error) {
+	defer validateDecoded(a, &err)
+	return a.NullAddr.Scan(value)
+}
+
+// Scan implements sql.Scanner, like Slice.Scan, and calls the validator registered with RegisterValidator.
+func (a *Array[T]) Scan(value interface{}) (err error) {
+	defer validateDecoded(a, &err)
+	return a.Slice.Scan(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, like Slice.UnmarshalJSON, and calls the validator registered with RegisterValidator.
+func (a *Array[T]) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(a, &err)
+	return a.Slice.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, like Slice.UnmarshalText, and calls the validator registered with RegisterValidator.
+func (a *Array[T]) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(a, &err)
+	return a.Slice.UnmarshalText(text)
+}
+
+// Scan implements sql.Scanner, like NullBigInt.Scan, and calls the validator registered with RegisterValidator.
+func (b *BigInt) Scan(value interface{}) (err error) {
+	defer validateDecoded(b, &err)
+	return b.NullBigInt.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullBytes.Scan, and calls the validator registered with RegisterValidator.
+func (b *Bytes) Scan(value interface{}) (err error) {
+	defer validateDecoded(b, &err)
+	return b.NullBytes.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullByteSize.Scan, and calls the validator registered with RegisterValidator.
+func (b *ByteSize) Scan(value interface{}) (err error) {
+	defer validateDecoded(b, &err)
+	return b.NullByteSize.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullComplex.Scan, and calls the validator registered with RegisterValidator.
+func (c *Complex) Scan(value interface{}) (err error) {
+	defer validateDecoded(c, &err)
+	return c.NullComplex.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullCountryCode.Scan, and calls the validator registered with RegisterValidator.
+func (c *CountryCode) Scan(value interface{}) (err error) {
+	defer validateDecoded(c, &err)
+	return c.NullCountryCode.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullCurrencyCode.Scan, and calls the validator registered with RegisterValidator.
+func (c *CurrencyCode) Scan(value interface{}) (err error) {
+	defer validateDecoded(c, &err)
+	return c.NullCurrencyCode.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullDate.Scan, and calls the validator registered with RegisterValidator.
+func (d *Date) Scan(value interface{}) (err error) {
+	defer validateDecoded(d, &err)
+	return d.NullDate.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullDuration.Scan, and calls the validator registered with RegisterValidator.
+func (d *Duration) Scan(value interface{}) (err error) {
+	defer validateDecoded(d, &err)
+	return d.NullDuration.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullEmail.Scan, and calls the validator registered with RegisterValidator.
+func (e *Email) Scan(value interface{}) (err error) {
+	defer validateDecoded(e, &err)
+	return e.NullEmail.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullFileMode.Scan, and calls the validator registered with RegisterValidator.
+func (m *FileMode) Scan(value interface{}) (err error) {
+	defer validateDecoded(m, &err)
+	return m.NullFileMode.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullHardwareAddr.Scan, and calls the validator registered with RegisterValidator.
+func (h *HardwareAddr) Scan(value interface{}) (err error) {
+	defer validateDecoded(h, &err)
+	return h.NullHardwareAddr.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullInt8.Scan, and calls the validator registered with RegisterValidator.
+func (i *Int8) Scan(value interface{}) (err error) {
+	defer validateDecoded(i, &err)
+	return i.NullInt8.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullJSON.Scan, and calls the validator registered with RegisterValidator.
+func (j *JSON) Scan(value interface{}) (err error) {
+	defer validateDecoded(j, &err)
+	return j.NullJSON.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullKSUID.Scan, and calls the validator registered with RegisterValidator.
+func (k *KSUID) Scan(value interface{}) (err error) {
+	defer validateDecoded(k, &err)
+	return k.NullKSUID.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullLanguageTag.Scan, and calls the validator registered with RegisterValidator.
+func (l *LanguageTag) Scan(value interface{}) (err error) {
+	defer validateDecoded(l, &err)
+	return l.NullLanguageTag.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullPrefix.Scan, and calls the validator registered with RegisterValidator.
+func (p *Prefix) Scan(value interface{}) (err error) {
+	defer validateDecoded(p, &err)
+	return p.NullPrefix.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullRegexp.Scan, and calls the validator registered with RegisterValidator.
+func (r *Regexp) Scan(value interface{}) (err error) {
+	defer validateDecoded(r, &err)
+	return r.NullRegexp.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullSemver.Scan, and calls the validator registered with RegisterValidator.
+func (s *Semver) Scan(value interface{}) (err error) {
+	defer validateDecoded(s, &err)
+	return s.NullSemver.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullTimeOfDay.Scan, and calls the validator registered with RegisterValidator.
+func (t *TimeOfDay) Scan(value interface{}) (err error) {
+	defer validateDecoded(t, &err)
+	return t.NullTimeOfDay.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullUint64.Scan, and calls the validator registered with RegisterValidator.
+func (i *Uint) Scan(value interface{}) (err error) {
+	defer validateDecoded(i, &err)
+	return i.NullUint64.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullUint16.Scan, and calls the validator registered with RegisterValidator.
+func (i *Uint16) Scan(value interface{}) (err error) {
+	defer validateDecoded(i, &err)
+	return i.NullUint16.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullUint32.Scan, and calls the validator registered with RegisterValidator.
+func (i *Uint32) Scan(value interface{}) (err error) {
+	defer validateDecoded(i, &err)
+	return i.NullUint32.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullUint8.Scan, and calls the validator registered with RegisterValidator.
+func (i *Uint8) Scan(value interface{}) (err error) {
+	defer validateDecoded(i, &err)
+	return i.NullUint8.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullULID.Scan, and calls the validator registered with RegisterValidator.
+func (u *ULID) Scan(value interface{}) (err error) {
+	defer validateDecoded(u, &err)
+	return u.NullULID.Scan(value)
+}
+
+// Scan implements sql.Scanner, like NullURL.Scan, and calls the validator registered with RegisterValidator.
+func (u *URL) Scan(value interface{}) (err error) {
+	defer validateDecoded(u, &err)
+	return u.NullURL.Scan(value)
+}
+
+// Scan implements sql.Scanner, like sql.Null.Scan, and calls the validator registered with RegisterValidator.
+func (v *Value[T]) Scan(value interface{}) (err error) {
+	defer validateDecoded(v, &err)
+	return v.Null.Scan(value)
+}
@@ -0,0 +1,69 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestRegisterValidator(t *testing.T) {
+	errTooBig := errors.New("must be at most 100")
+	RegisterValidator[Uint](func(v uint64) error {
+		if v > 100 {
+			return errTooBig
+		}
+		return nil
+	})
+	defer RegisterValidator[Uint](nil)
+
+	var u Uint
+	if err := json.Unmarshal([]byte(`42`), &u); err != nil || !u.Equal(UintFrom(42)) {
+		t.Errorf("bad valid value: %v, %v", u, err)
+	}
+	if err := json.Unmarshal([]byte(`null`), &u); err != nil || u.Valid {
+		t.Errorf("null shouldn't be validated: %v, %v", u, err)
+	}
+	u = UintFrom(1)
+	if err := json.Unmarshal([]byte(`101`), &u); !errors.Is(err, errTooBig) || u.Valid {
+		t.Errorf("expected validation error and null from UnmarshalJSON, got %v, %v", u, err)
+	}
+	if err := u.UnmarshalText([]byte(`101`)); !errors.Is(err, errTooBig) || u.Valid {
+		t.Errorf("expected validation error and null from UnmarshalText, got %v, %v", u, err)
+	}
+	if err := u.Scan(int64(101)); !errors.Is(err, errTooBig) || u.Valid {
+		t.Errorf("expected validation error and null from Scan, got %v, %v", u, err)
+	}
+	for _, d := range []JSONDecoding{JSONStrict, JSONLenient} {
+		u = UintFrom(1)
+		if err := d.Unmarshal([]byte(`101`), &u); !errors.Is(err, errTooBig) || u.Valid {
+			t.Errorf("expected validation error and null from JSONDecoding(%d).Unmarshal, got %v, %v", d, u, err)
+		}
+		if err := d.Unmarshal([]byte(`42`), &u); err != nil || !u.Equal(UintFrom(42)) {
+			t.Errorf("bad valid value from JSONDecoding(%d).Unmarshal: %v, %v", d, u, err)
+		}
+	}
+	if err := u.Scan("bad"); err == nil || errors.Is(err, errTooBig) {
+		t.Errorf("expected scan error, got %v", err)
+	}
+
+	// other types aren't affected
+	var i Int
+	if err := i.Scan(int64(101)); err != nil {
+		t.Error(err)
+	}
+
+	RegisterValidator[Value[string]](func(v string) error {
+		if v == "" {
+			return errors.New("must not be blank")
+		}
+		return nil
+	})
+	defer RegisterValidator[Value[string]](nil)
+	var s Value[string]
+	if err := s.Scan(""); err == nil || s.Valid {
+		t.Errorf("expected validation error from promoted Scan, got %v, %v", s, err)
+	}
+	if err := s.Scan("ok"); err != nil || !s.Valid {
+		t.Errorf("bad Scan: %v, %v", s, err)
+	}
+}
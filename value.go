@@ -0,0 +1,195 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Value is a nullable value of any type T.
+// It can be used to wrap custom types such as enums or IDs.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Value[T any] struct {
+	sql.Null[T]
+}
+
+// NewValue creates a new Value.
+func NewValue[T any](v T, valid bool) Value[T] {
+	return Value[T]{
+		Null: sql.Null[T]{
+			V:     v,
+			Valid: valid,
+		},
+	}
+}
+
+// ValueFrom creates a new Value that will always be valid.
+func ValueFrom[T any](v T) Value[T] {
+	return NewValue(v, true)
+}
+
+// ValueFromPtr creates a new Value that will be null if v is nil.
+func ValueFromPtr[T any](v *T) Value[T] {
+	if v == nil {
+		var zero T
+		return NewValue(zero, false)
+	}
+	return NewValue(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (v Value[T]) ValueOrZero() T {
+	if !v.Valid {
+		var zero T
+		return zero
+	}
+	return v.V
+}
+
+// Value implements the driver Valuer interface.
+// If T implements driver.Valuer it will be used,
+// otherwise the inner value is converted with driver.DefaultParameterConverter.
+func (v Value[T]) Value() (driver.Value, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	if valuer, ok := any(v.V).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(v.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null input, and any input T can be decoded from.
+func (v *Value[T]) UnmarshalJSON(data []byte) (err error) {
+	defer validateDecoded(v, &err)
+	if bytes.Equal(data, nullBytes) {
+		v.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &v.V); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	v.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Value is null.
+func (v Value[T]) MarshalJSON() ([]byte, error) {
+	if !v.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(v.V)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Value if the input is DefaultNullText, or blank if T is not a string type.
+// If T implements encoding.TextUnmarshaler it will be used,
+// string types take the text as-is, and other types are decoded as JSON.
+func (v *Value[T]) UnmarshalText(text []byte) (err error) {
+	defer validateDecoded(v, &err)
+	if isNullTextOnly(text) || (len(text) == 0 && reflect.ValueOf(&v.V).Elem().Kind() != reflect.String) {
+		v.Valid = false
+		return nil
+	}
+	if u, ok := any(&v.V).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+		}
+		v.Valid = true
+		return nil
+	}
+	if rv := reflect.ValueOf(&v.V).Elem(); rv.Kind() == reflect.String {
+		rv.SetString(string(text))
+		v.Valid = true
+		return nil
+	}
+	if bytes.Equal(text, nullBytes) {
+		v.Valid = false
+		return nil
+	}
+	if err := json.Unmarshal(text, &v.V); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	v.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode DefaultNullText, blank by default, if this Value is null.
+func (v Value[T]) MarshalText() ([]byte, error) {
+	if !v.Valid {
+		return nullText(), nil
+	}
+	if m, ok := any(v.V).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	if rv := reflect.ValueOf(v.V); rv.Kind() == reflect.String {
+		return []byte(rv.String()), nil
+	}
+	return json.Marshal(v.V)
+}
+
+// SetValid changes this Value's value and also sets it to be non-null.
+func (v *Value[T]) SetValid(t T) {
+	v.V = t
+	v.Valid = true
+}
+
+// SetNull sets this Value to null, leaving its value as is. Use Reset to clear the value too.
+func (v *Value[T]) SetNull() {
+	v.Valid = false
+}
+
+// Reset sets this Value to null and clears its value.
+func (v *Value[T]) Reset() {
+	*v = Value[T]{}
+}
+
+// Ptr returns a pointer to this Value's value, or a nil pointer if this Value is null.
+func (v Value[T]) Ptr() *T {
+	if !v.Valid {
+		return nil
+	}
+	return &v.V
+}
+
+// SetPtr changes this Value's value to the value p points to, or sets it to be null if p is nil, like ValueFromPtr.
+func (v *Value[T]) SetPtr(p *T) {
+	*v = ValueFromPtr(p)
+}
+
+// IsZero returns true for invalid Values.
+// A non-null Value with a zero value will not be considered zero.
+func (v Value[T]) IsZero() bool {
+	return !v.Valid
+}
+
+// IsZeroOrNull returns true for invalid Values, or valid Values with the zero value of T.
+func (v Value[T]) IsZeroOrNull() bool {
+	return !v.Valid || reflect.ValueOf(&v.V).Elem().IsZero()
+}
+
+// Equal returns true if both Values have the same value or are both null.
+// If T has an Equal(T) bool method it will be used to compare values,
+// otherwise they are compared with reflect.DeepEqual.
+func (v Value[T]) Equal(other Value[T]) bool {
+	if v.Valid != other.Valid {
+		return false
+	}
+	if !v.Valid {
+		return true
+	}
+	if eq, ok := any(v.V).(interface{ Equal(T) bool }); ok {
+		return eq.Equal(other.V)
+	}
+	return reflect.DeepEqual(v.V, other.V)
+}
@@ -0,0 +1,263 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type testID uint32
+
+type testColor string
+
+var (
+	valueJSON       = []byte(`12345`)
+	valueStringJSON = []byte(`"red"`)
+)
+
+func TestValueFrom(t *testing.T) {
+	v := ValueFrom(testID(12345))
+	assertValue(t, v, "ValueFrom()")
+
+	zero := ValueFrom(testID(0))
+	if !zero.Valid {
+		t.Error("ValueFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestValueFromPtr(t *testing.T) {
+	n := testID(12345)
+	v := ValueFromPtr(&n)
+	assertValue(t, v, "ValueFromPtr()")
+
+	null := ValueFromPtr[testID](nil)
+	assertNullValue(t, null, "ValueFromPtr(nil)")
+}
+
+func TestUnmarshalValue(t *testing.T) {
+	var v Value[testID]
+	err := json.Unmarshal(valueJSON, &v)
+	maybePanic(err)
+	assertValue(t, v, "value json")
+
+	var s Value[testColor]
+	err = json.Unmarshal(valueStringJSON, &s)
+	maybePanic(err)
+	if s.V != "red" || !s.Valid {
+		t.Errorf("bad string value json: %#v", s)
+	}
+
+	var null Value[testID]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullValue(t, null, "null json")
+
+	var badType Value[testID]
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullValue(t, badType, "wrong type json")
+
+	var invalid Value[testID]
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullValue(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalValue(t *testing.T) {
+	var v Value[testID]
+	err := v.UnmarshalText([]byte("12345"))
+	maybePanic(err)
+	assertValue(t, v, "UnmarshalText() value")
+
+	var s Value[testColor]
+	err = s.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	if s.V != "null" || !s.Valid {
+		t.Errorf("bad string UnmarshalText(): %#v", s)
+	}
+
+	var blank Value[testID]
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullValue(t, blank, "UnmarshalText() empty value")
+
+	var null Value[testID]
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullValue(t, null, `UnmarshalText() "null"`)
+
+	var ti Value[Time]
+	err = ti.UnmarshalText([]byte(timeString1))
+	maybePanic(err)
+	if !ti.V.Time.Equal(timeValue1) {
+		t.Errorf("bad TextUnmarshaler UnmarshalText(): %v", ti.V)
+	}
+
+	var invalid Value[testID]
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalValue(t *testing.T) {
+	v := ValueFrom(testID(12345))
+	data, err := json.Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty json marshal")
+
+	null := NewValue(testID(0), false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalValueText(t *testing.T) {
+	v := ValueFrom(testID(12345))
+	data, err := v.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty text marshal")
+
+	s := ValueFrom(testColor("red"))
+	data, err = s.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "red", "string text marshal")
+
+	null := NewValue(testID(0), false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestValuePointer(t *testing.T) {
+	v := ValueFrom(testID(12345))
+	ptr := v.Ptr()
+	if *ptr != 12345 {
+		t.Errorf("bad %s value: %#v ≠ %d\n", "pointer", ptr, 12345)
+	}
+
+	null := NewValue(testID(0), false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s value: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestValueIsZero(t *testing.T) {
+	v := ValueFrom(testID(12345))
+	if v.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewValue(testID(0), false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewValue(testID(0), true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestValueSetValid(t *testing.T) {
+	change := NewValue(testID(0), false)
+	assertNullValue(t, change, "SetValid()")
+	change.SetValid(12345)
+	assertValue(t, change, "SetValid()")
+}
+
+func TestValueScan(t *testing.T) {
+	var v Value[testID]
+	err := v.Scan(int64(12345))
+	maybePanic(err)
+	assertValue(t, v, "scanned value")
+
+	var null Value[testID]
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullValue(t, null, "scanned null")
+}
+
+func TestValueValue(t *testing.T) {
+	v := ValueFrom(testID(12345))
+	val, err := v.Value()
+	maybePanic(err)
+	if val != int64(12345) {
+		t.Errorf("bad Value(): %#v ≠ %d", val, 12345)
+	}
+
+	ti := ValueFrom(TimeFrom(timeValue1))
+	val, err = ti.Value()
+	maybePanic(err)
+	if val != timeValue1 {
+		t.Errorf("bad Valuer Value(): %#v ≠ %v", val, timeValue1)
+	}
+
+	null := NewValue(testID(12345), false)
+	val, err = null.Value()
+	maybePanic(err)
+	if val != nil {
+		t.Errorf("bad null Value(): %#v ≠ nil", val)
+	}
+}
+
+func TestValueEqual(t *testing.T) {
+	v1 := NewValue(testID(10), false)
+	v2 := NewValue(testID(20), false)
+	assertValueEqualIsTrue(t, v1, v2)
+
+	v1 = NewValue(testID(10), true)
+	v2 = NewValue(testID(10), true)
+	assertValueEqualIsTrue(t, v1, v2)
+
+	v1 = NewValue(testID(10), true)
+	v2 = NewValue(testID(10), false)
+	assertValueEqualIsFalse(t, v1, v2)
+
+	v1 = NewValue(testID(10), true)
+	v2 = NewValue(testID(20), true)
+	assertValueEqualIsFalse(t, v1, v2)
+
+	t1 := ValueFrom(TimeFrom(timeValue1))
+	t2 := ValueFrom(TimeFrom(timeValue2))
+	if !t1.Equal(t2) {
+		t.Errorf("Equal() should use the inner Equal method")
+	}
+}
+
+func assertValue(t *testing.T, v Value[testID], from string) {
+	t.Helper()
+	if v.V != 12345 {
+		t.Errorf("bad %s value: %d ≠ %d\n", from, v.V, 12345)
+	}
+	if !v.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullValue(t *testing.T, v Value[testID], from string) {
+	t.Helper()
+	if v.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertValueEqualIsTrue(t *testing.T, a, b Value[testID]) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Value{%v, Valid:%t} and Value{%v, Valid:%t} should return true", a.V, a.Valid, b.V, b.Valid)
+	}
+}
+
+func assertValueEqualIsFalse(t *testing.T, a, b Value[testID]) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Value{%v, Valid:%t} and Value{%v, Valid:%t} should return false", a.V, a.Valid, b.V, b.Valid)
+	}
+}
@@ -0,0 +1,657 @@
+//go:build yaml
+
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// This file implements yaml.Marshaler and yaml.Unmarshaler from gopkg.in/yaml.v3.
+// It is only built with the yaml build tag, so that the null package stays free of dependencies:
+//
+//	go build -tags yaml
+//
+// Values are converted to and from the same representation as JSON,
+// so null values are encoded as YAML null.
+// Note that yaml.v3 doesn't call UnmarshalYAML for ~ or null, leaving the destination untouched,
+// so they only decode as null into values that are null to begin with.
+
+// marshalYAML encodes v as JSON and returns the equivalent YAML node.
+func marshalYAML(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't marshal YAML: %w", err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("null: couldn't marshal YAML: %w", err)
+	}
+	node := doc.Content[0]
+	plainYAML(node)
+	return node, nil
+}
+
+// plainYAML clears the JSON quoting style from node and its children.
+// The encoder will still quote strings that would otherwise be read as another type.
+func plainYAML(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		plainYAML(child)
+	}
+}
+
+// unmarshalYAML decodes node as JSON into v.
+func unmarshalYAML(v interface{}, node *yaml.Node) error {
+	var x interface{}
+	if err := node.Decode(&x); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal YAML: %w", err)
+	}
+	data, err := json.Marshal(x)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal YAML: %w", err)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Addr is null.
+func (a Addr) MarshalYAML() (interface{}, error) {
+	return marshalYAML(a)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Addr can be unmarshaled from as JSON.
+func (a *Addr) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(a, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Any is null.
+func (a Any) MarshalYAML() (interface{}, error) {
+	return marshalYAML(a)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Any can be unmarshaled from as JSON.
+func (a *Any) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(a, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Base64 is null.
+func (b Base64) MarshalYAML() (interface{}, error) {
+	return marshalYAML(b)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Base64 can be unmarshaled from as JSON.
+func (b *Base64) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(b, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this BigInt is null.
+func (b BigInt) MarshalYAML() (interface{}, error) {
+	return marshalYAML(b)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this BigInt can be unmarshaled from as JSON.
+func (b *BigInt) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(b, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalYAML() (interface{}, error) {
+	return marshalYAML(b)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Bool can be unmarshaled from as JSON.
+func (b *Bool) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(b, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this ByteSize is null.
+func (b ByteSize) MarshalYAML() (interface{}, error) {
+	return marshalYAML(b)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this ByteSize can be unmarshaled from as JSON.
+func (b *ByteSize) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(b, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Bytes is null.
+func (b Bytes) MarshalYAML() (interface{}, error) {
+	return marshalYAML(b)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Bytes can be unmarshaled from as JSON.
+func (b *Bytes) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(b, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Complex is null.
+func (c Complex) MarshalYAML() (interface{}, error) {
+	return marshalYAML(c)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Complex can be unmarshaled from as JSON.
+func (c *Complex) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(c, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Date is null.
+func (d Date) MarshalYAML() (interface{}, error) {
+	return marshalYAML(d)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Date can be unmarshaled from as JSON.
+func (d *Date) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(d, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Duration is null.
+func (d Duration) MarshalYAML() (interface{}, error) {
+	return marshalYAML(d)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Duration can be unmarshaled from as JSON.
+func (d *Duration) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(d, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Email is null.
+func (e Email) MarshalYAML() (interface{}, error) {
+	return marshalYAML(e)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Email can be unmarshaled from as JSON.
+func (e *Email) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(e, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Enum is null.
+func (e Enum[T]) MarshalYAML() (interface{}, error) {
+	return marshalYAML(e)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Enum can be unmarshaled from as JSON.
+func (e *Enum[T]) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(e, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this FileMode is null.
+func (m FileMode) MarshalYAML() (interface{}, error) {
+	return marshalYAML(m)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this FileMode can be unmarshaled from as JSON.
+func (m *FileMode) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(m, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Flags is null.
+func (f Flags[T]) MarshalYAML() (interface{}, error) {
+	return marshalYAML(f)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Flags can be unmarshaled from as JSON.
+func (f *Flags[T]) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(f, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Float is null.
+func (f Float) MarshalYAML() (interface{}, error) {
+	return marshalYAML(f)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Float can be unmarshaled from as JSON.
+func (f *Float) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(f, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this HardwareAddr is null.
+func (h HardwareAddr) MarshalYAML() (interface{}, error) {
+	return marshalYAML(h)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this HardwareAddr can be unmarshaled from as JSON.
+func (h *HardwareAddr) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(h, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Hex is null.
+func (h Hex) MarshalYAML() (interface{}, error) {
+	return marshalYAML(h)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Hex can be unmarshaled from as JSON.
+func (h *Hex) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(h, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this FixedHex is null.
+func (h FixedHex[A]) MarshalYAML() (interface{}, error) {
+	return marshalYAML(h)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this FixedHex can be unmarshaled from as JSON.
+func (h *FixedHex[A]) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(h, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Int is null.
+func (i Int) MarshalYAML() (interface{}, error) {
+	return marshalYAML(i)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Int can be unmarshaled from as JSON.
+func (i *Int) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(i, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalYAML() (interface{}, error) {
+	return marshalYAML(i)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Int16 can be unmarshaled from as JSON.
+func (i *Int16) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(i, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalYAML() (interface{}, error) {
+	return marshalYAML(i)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Int32 can be unmarshaled from as JSON.
+func (i *Int32) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(i, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalYAML() (interface{}, error) {
+	return marshalYAML(i)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Int8 can be unmarshaled from as JSON.
+func (i *Int8) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(i, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this CountryCode is null.
+func (c CountryCode) MarshalYAML() (interface{}, error) {
+	return marshalYAML(c)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this CountryCode can be unmarshaled from as JSON.
+func (c *CountryCode) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(c, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this CurrencyCode is null.
+func (c CurrencyCode) MarshalYAML() (interface{}, error) {
+	return marshalYAML(c)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this CurrencyCode can be unmarshaled from as JSON.
+func (c *CurrencyCode) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(c, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this JSON is null.
+func (j JSON) MarshalYAML() (interface{}, error) {
+	return marshalYAML(j)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this JSON can be unmarshaled from as JSON.
+func (j *JSON) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(j, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this KSUID is null.
+func (k KSUID) MarshalYAML() (interface{}, error) {
+	return marshalYAML(k)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this KSUID can be unmarshaled from as JSON.
+func (k *KSUID) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(k, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this LanguageTag is null.
+func (l LanguageTag) MarshalYAML() (interface{}, error) {
+	return marshalYAML(l)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this LanguageTag can be unmarshaled from as JSON.
+func (l *LanguageTag) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(l, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Map is null.
+func (m Map[K, V]) MarshalYAML() (interface{}, error) {
+	return marshalYAML(m)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Map can be unmarshaled from as JSON.
+func (m *Map[K, V]) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(m, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Money is null.
+func (m Money) MarshalYAML() (interface{}, error) {
+	return marshalYAML(m)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Money can be unmarshaled from as JSON.
+func (m *Money) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(m, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Optional is null.
+func (o Optional[T]) MarshalYAML() (interface{}, error) {
+	return marshalYAML(o)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Optional can be unmarshaled from as JSON.
+func (o *Optional[T]) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(o, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Percent is null.
+func (p Percent) MarshalYAML() (interface{}, error) {
+	return marshalYAML(p)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Percent can be unmarshaled from as JSON.
+func (p *Percent) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(p, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Point is null.
+func (p Point) MarshalYAML() (interface{}, error) {
+	return marshalYAML(p)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Point can be unmarshaled from as JSON.
+func (p *Point) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(p, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Prefix is null.
+func (p Prefix) MarshalYAML() (interface{}, error) {
+	return marshalYAML(p)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Prefix can be unmarshaled from as JSON.
+func (p *Prefix) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(p, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Int64Range is null.
+func (r Int64Range) MarshalYAML() (interface{}, error) {
+	return marshalYAML(r)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Int64Range can be unmarshaled from as JSON.
+func (r *Int64Range) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(r, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this TimeRange is null.
+func (r TimeRange) MarshalYAML() (interface{}, error) {
+	return marshalYAML(r)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this TimeRange can be unmarshaled from as JSON.
+func (r *TimeRange) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(r, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Regexp is null.
+func (r Regexp) MarshalYAML() (interface{}, error) {
+	return marshalYAML(r)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Regexp can be unmarshaled from as JSON.
+func (r *Regexp) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(r, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Semver is null.
+func (s Semver) MarshalYAML() (interface{}, error) {
+	return marshalYAML(s)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Semver can be unmarshaled from as JSON.
+func (s *Semver) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(s, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Slice is null.
+func (s Slice[T]) MarshalYAML() (interface{}, error) {
+	return marshalYAML(s)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Slice can be unmarshaled from as JSON.
+func (s *Slice[T]) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(s, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this String is null.
+func (s String) MarshalYAML() (interface{}, error) {
+	return marshalYAML(s)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this String can be unmarshaled from as JSON.
+func (s *String) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(s, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Time is null.
+func (t Time) MarshalYAML() (interface{}, error) {
+	return marshalYAML(t)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Time can be unmarshaled from as JSON.
+func (t *Time) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(t, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this TimeOfDay is null.
+func (t TimeOfDay) MarshalYAML() (interface{}, error) {
+	return marshalYAML(t)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this TimeOfDay can be unmarshaled from as JSON.
+func (t *TimeOfDay) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(t, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Uint is null.
+func (i Uint) MarshalYAML() (interface{}, error) {
+	return marshalYAML(i)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Uint can be unmarshaled from as JSON.
+func (i *Uint) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(i, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Uint16 is null.
+func (i Uint16) MarshalYAML() (interface{}, error) {
+	return marshalYAML(i)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Uint16 can be unmarshaled from as JSON.
+func (i *Uint16) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(i, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Uint32 is null.
+func (i Uint32) MarshalYAML() (interface{}, error) {
+	return marshalYAML(i)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Uint32 can be unmarshaled from as JSON.
+func (i *Uint32) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(i, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Uint8 is null.
+func (i Uint8) MarshalYAML() (interface{}, error) {
+	return marshalYAML(i)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Uint8 can be unmarshaled from as JSON.
+func (i *Uint8) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(i, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this ULID is null.
+func (u ULID) MarshalYAML() (interface{}, error) {
+	return marshalYAML(u)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this ULID can be unmarshaled from as JSON.
+func (u *ULID) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(u, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this UnixSeconds is null.
+func (u UnixSeconds) MarshalYAML() (interface{}, error) {
+	return marshalYAML(u)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this UnixSeconds can be unmarshaled from as JSON.
+func (u *UnixSeconds) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(u, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this UnixMillis is null.
+func (u UnixMillis) MarshalYAML() (interface{}, error) {
+	return marshalYAML(u)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this UnixMillis can be unmarshaled from as JSON.
+func (u *UnixMillis) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(u, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this URL is null.
+func (u URL) MarshalYAML() (interface{}, error) {
+	return marshalYAML(u)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this URL can be unmarshaled from as JSON.
+func (u *URL) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(u, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Value is null.
+func (v Value[T]) MarshalYAML() (interface{}, error) {
+	return marshalYAML(v)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Value can be unmarshaled from as JSON.
+func (v *Value[T]) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(v, node)
+}
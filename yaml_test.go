@@ -0,0 +1,90 @@
+//go:build yaml
+
+package null
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAMLRoundTrip(t *testing.T) {
+	in := exampleAllTypes()
+	data, err := yaml.Marshal(in)
+	maybePanic(err)
+	var out allTypes
+	err = yaml.Unmarshal(data, &out)
+	maybePanic(err)
+	assertAllTypesEqual(t, out, in, "yaml round trip")
+	if !out.Optional.IsSet() {
+		t.Error("unmarshaled Optional should be present")
+	}
+}
+
+func TestYAMLNull(t *testing.T) {
+	data, err := yaml.Marshal(allTypes{})
+	maybePanic(err)
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if !strings.HasSuffix(line, ": null") {
+			t.Errorf("null value should encode as null: %s", line)
+		}
+	}
+
+	src := `
+int: ~
+string: null
+float:
+bool: true
+`
+	var out struct {
+		Int    Int
+		String String
+		Float  Float
+		Bool   Bool
+	}
+	err = yaml.Unmarshal([]byte(src), &out)
+	maybePanic(err)
+	if out.Int.Valid || out.String.Valid || out.Float.Valid {
+		t.Errorf("null YAML should decode as null: %#v", out)
+	}
+	assertBool(t, out.Bool, "yaml bool")
+}
+
+func TestYAMLEncoding(t *testing.T) {
+	in := struct {
+		S   String
+		N   String
+		I   Int
+		T   Slice[int]
+		M   Map[string, int]
+		Ver Semver
+	}{
+		S:   StringFrom("hello"),
+		N:   StringFrom("123"),
+		I:   IntFrom(42),
+		T:   SliceFrom([]int{1, 2}),
+		M:   MapFrom(map[string]int{"a": 1}),
+		Ver: MustSemver("1.2.3"),
+	}
+	data, err := yaml.Marshal(in)
+	maybePanic(err)
+	want := `s: hello
+"n": "123"
+i: 42
+t:
+    - 1
+    - 2
+m:
+    a: 1
+ver: 1.2.3
+`
+	if string(data) != want {
+		t.Errorf("bad YAML:\n%s\nwant:\n%s", data, want)
+	}
+
+	var bad struct{ I Int }
+	if err := yaml.Unmarshal([]byte("i: hello"), &bad); err == nil {
+		t.Error("expected error unmarshaling string into Int")
+	}
+}
@@ -0,0 +1,137 @@
+package zero
+
+import (
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// This file implements AppendJSON and AppendText, which append the same output as MarshalJSON and MarshalText
+// to a byte slice instead of allocating a new one, so that encoders can reuse a buffer.
+// AppendText implements encoding.TextAppender.
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends s to dst as a JSON string, escaped the same way as encoding/json,
+// including HTML characters.
+func appendJSONString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are escaped for JavaScript, as in encoding/json
+		if r == '\u2028' || r == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// AppendJSON appends the JSON encoding of this Bool to dst, the same as MarshalJSON.
+// It will append false if this Bool is null.
+func (b Bool) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendBool(dst, b.ValueOrZero()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (b Bool) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendBool(dst, b.ValueOrZero()), nil
+}
+
+// AppendJSON appends the JSON encoding of this Float to dst, the same as MarshalJSON.
+// It will append 0 if this Float is null.
+func (f Float) AppendJSON(dst []byte) ([]byte, error) {
+	if math.IsInf(f.Float64, 0) || math.IsNaN(f.Float64) {
+		_, err := f.MarshalJSON()
+		return dst, err
+	}
+	return strconv.AppendFloat(dst, f.ValueOrZero(), 'f', -1, 64), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (f Float) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendFloat(dst, f.ValueOrZero(), 'f', -1, 64), nil
+}
+
+// AppendJSON appends the JSON encoding of this Int to dst, the same as MarshalJSON.
+// It will append 0 if this Int is null.
+func (i Int) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, i.ValueOrZero(), 10), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (i Int) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, i.ValueOrZero(), 10), nil
+}
+
+// AppendJSON appends the JSON encoding of this String to dst.
+// It will append a blank string if this String is null.
+func (s String) AppendJSON(dst []byte) ([]byte, error) {
+	return appendJSONString(dst, s.ValueOrZero()), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (s String) AppendText(dst []byte) ([]byte, error) {
+	return append(dst, s.ValueOrZero()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this Time to dst, the same as MarshalJSON.
+// It will append the zero value of time.Time if this Time is null.
+func (t Time) AppendJSON(dst []byte) ([]byte, error) {
+	text, err := t.ValueOrZero().AppendText(append(dst, '"'))
+	if err != nil {
+		// for the same error as MarshalJSON
+		_, err = t.MarshalJSON()
+		return dst, err
+	}
+	return append(text, '"'), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the same text as MarshalText.
+func (t Time) AppendText(dst []byte) ([]byte, error) {
+	return t.ValueOrZero().AppendText(dst)
+}
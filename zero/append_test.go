@@ -0,0 +1,37 @@
+package zero
+
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAppend(t *testing.T) {
+	prefix := []byte("prefix:")
+	for _, v := range []interface {
+		encoding.TextMarshaler
+		encoding.TextAppender
+		AppendJSON(dst []byte) ([]byte, error)
+	}{
+		Bool{}, Float{}, Int{}, String{}, Time{},
+		BoolFrom(true), FloatFrom(1.2345), IntFrom(12345), StringFrom(`<"test">`), TimeFrom(time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)),
+	} {
+		want, err := json.Marshal(v)
+		maybePanic(err)
+		got, err := v.AppendJSON(prefix[:len(prefix):len(prefix)])
+		maybePanic(err)
+		assertJSONEquals(t, got, string(prefix)+string(want), "AppendJSON")
+
+		want, err = v.MarshalText()
+		maybePanic(err)
+		got, err = v.AppendText(prefix[:len(prefix):len(prefix)])
+		maybePanic(err)
+		assertJSONEquals(t, got, string(prefix)+string(want), "AppendText")
+	}
+
+	if _, err := FloatFrom(math.Inf(1)).AppendJSON(nil); err == nil {
+		t.Error("expected error for infinity")
+	}
+}
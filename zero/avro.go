@@ -0,0 +1,122 @@
+package zero
+
+import "fmt"
+
+// This file implements the UnionConverter interface from github.com/hamba/avro,
+// so that pointers to zero types can be encoded as Avro unions such as ["null", "long"].
+// The interface only uses built-in types, so no build tag is needed.
+//
+// Like JSON, null values are encoded as the zero value, and Avro null or zero values decode to null.
+// Only nil pointers are encoded as Avro null.
+
+// fromAvro stores a value decoded from an Avro union in v, or sets v to its zero value for Avro null.
+func fromAvro[T any](v *T, payload any) error {
+	switch x := payload.(type) {
+	case nil:
+		var zero T
+		*v = zero
+		return nil
+	case int:
+		payload = int64(x)
+	case int32:
+		payload = int64(x)
+	case float32:
+		payload = float64(x)
+	}
+	x, ok := payload.(T)
+	if !ok {
+		return fmt.Errorf("zero: couldn't unmarshal Avro: %T into %T", payload, *v)
+	}
+	*v = x
+	return nil
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode false if this Bool is null.
+func (b Bool) ToAny() (any, error) {
+	v := b.ValueOrZero()
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports Avro booleans and null.
+func (b *Bool) FromAny(payload any) error {
+	if err := fromAvro(&b.Bool, payload); err != nil {
+		b.Valid = false
+		return err
+	}
+	b.Valid = b.Bool
+	return nil
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode 0 if this Float is null.
+func (f Float) ToAny() (any, error) {
+	v := f.ValueOrZero()
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports Avro floats, doubles, and null.
+func (f *Float) FromAny(payload any) error {
+	if err := fromAvro(&f.Float64, payload); err != nil {
+		f.Valid = false
+		return err
+	}
+	f.Valid = f.Float64 != 0
+	return nil
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode 0 if this Int is null.
+func (i Int) ToAny() (any, error) {
+	v := i.ValueOrZero()
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports Avro ints, longs, and null.
+func (i *Int) FromAny(payload any) error {
+	if err := fromAvro(&i.Int64, payload); err != nil {
+		i.Valid = false
+		return err
+	}
+	i.Valid = i.Int64 != 0
+	return nil
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode a blank string if this String is null.
+func (s String) ToAny() (any, error) {
+	v := s.ValueOrZero()
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports Avro strings and null.
+func (s *String) FromAny(payload any) error {
+	if err := fromAvro(&s.String, payload); err != nil {
+		s.Valid = false
+		return err
+	}
+	s.Valid = s.String != ""
+	return nil
+}
+
+// ToAny implements avro.UnionConverter.
+// It will encode the zero value of time.Time if this Time is null.
+func (t Time) ToAny() (any, error) {
+	v := t.ValueOrZero()
+	return &v, nil
+}
+
+// FromAny implements avro.UnionConverter.
+// It supports Avro timestamps and null.
+func (t *Time) FromAny(payload any) error {
+	if err := fromAvro(&t.Time, payload); err != nil {
+		t.Valid = false
+		return err
+	}
+	t.Valid = !t.Time.IsZero()
+	return nil
+}
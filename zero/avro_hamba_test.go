@@ -0,0 +1,42 @@
+//go:build avro
+
+package zero
+
+import (
+	"testing"
+
+	"github.com/hamba/avro/v2"
+)
+
+func TestAvroHamba(t *testing.T) {
+	schema := avro.MustParse(`{
+		"type": "record",
+		"name": "rec",
+		"fields": [
+			{"name": "count", "type": ["null", "long"]},
+			{"name": "name", "type": ["null", "string"]},
+			{"name": "missing", "type": ["null", "string"]}
+		]
+	}`)
+	type rec struct {
+		Count   *Int    `avro:"count"`
+		Name    *String `avro:"name"`
+		Missing *String `avro:"missing"`
+	}
+	count, name := IntFrom(12345), String{}
+	data, err := avro.Marshal(schema, rec{Count: &count, Name: &name})
+	maybePanic(err)
+	// a null String is encoded as a blank string, but a nil pointer is Avro null
+	want, err := avro.Marshal(schema, map[string]any{"count": int64(12345), "name": "", "missing": nil})
+	maybePanic(err)
+	if string(data) != string(want) {
+		t.Errorf("encoded record %v ≠ %v", data, want)
+	}
+
+	var out rec
+	err = avro.Unmarshal(schema, data, &out)
+	maybePanic(err)
+	if out.Count == nil || !out.Count.Equal(count) || out.Name == nil || out.Name.Valid || out.Missing != nil {
+		t.Errorf("bad decoded record: %+v", out)
+	}
+}
@@ -0,0 +1,68 @@
+package zero
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAvroToAny(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123000000, time.UTC)
+	for _, tc := range []struct {
+		v    interface{ ToAny() (any, error) }
+		want any
+	}{
+		{Bool{}, false},
+		{Float{}, 0.0},
+		{Int{}, int64(0)},
+		{String{}, ""},
+		{Time{}, time.Time{}},
+		{BoolFrom(true), true},
+		{FloatFrom(1.5), 1.5},
+		{IntFrom(12345), int64(12345)},
+		{StringFrom("hello"), "hello"},
+		{TimeFrom(now), now},
+	} {
+		v, err := tc.v.ToAny()
+		maybePanic(err)
+		if got := reflect.ValueOf(v).Elem().Interface(); got != tc.want {
+			t.Errorf("%T: ToAny got %#v, want %#v", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestAvroFromAny(t *testing.T) {
+	var i Int
+	err := i.FromAny(int32(12345))
+	maybePanic(err)
+	if !i.Equal(IntFrom(12345)) {
+		t.Errorf("bad Int: %v", i)
+	}
+	err = i.FromAny(int64(0))
+	maybePanic(err)
+	if i.Valid {
+		t.Error("zero should decode as null Int")
+	}
+
+	var f Float
+	err = f.FromAny(float32(1.5))
+	maybePanic(err)
+	if !f.Equal(FloatFrom(1.5)) {
+		t.Errorf("bad Float: %v", f)
+	}
+
+	s := StringFrom("hello")
+	err = s.FromAny(nil)
+	maybePanic(err)
+	if s.Valid || s.String != "" {
+		t.Errorf("nil should decode as null String: %#v", s)
+	}
+
+	if err := s.FromAny(int64(1)); err == nil {
+		t.Error("expected error for long into String")
+	}
+	var tm Time
+	if err := tm.FromAny("2024-05-06"); err == nil {
+		t.Error("expected error for string into Time")
+	}
+}
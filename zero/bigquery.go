@@ -0,0 +1,62 @@
+//go:build bigquery
+
+package zero
+
+import "cloud.google.com/go/bigquery"
+
+// This file converts between this package's types and the BigQuery client's NullInt64, NullString, and so on.
+// It is only built with the bigquery build tag, so that the zero package stays free of dependencies:
+//
+//	go build -tags bigquery
+//
+// To save and load structs with fields of these types, use null.BigQueryStruct.
+
+// BoolFromBigQuery creates a new Bool from a bigquery.NullBool.
+func BoolFromBigQuery(n bigquery.NullBool) Bool {
+	return NewBool(n.Bool, n.Valid)
+}
+
+// ToBigQuery returns this Bool as a bigquery.NullBool.
+func (b Bool) ToBigQuery() bigquery.NullBool {
+	return bigquery.NullBool{Bool: b.Bool, Valid: b.Valid}
+}
+
+// FloatFromBigQuery creates a new Float from a bigquery.NullFloat64.
+func FloatFromBigQuery(n bigquery.NullFloat64) Float {
+	return NewFloat(n.Float64, n.Valid)
+}
+
+// ToBigQuery returns this Float as a bigquery.NullFloat64.
+func (f Float) ToBigQuery() bigquery.NullFloat64 {
+	return bigquery.NullFloat64{Float64: f.Float64, Valid: f.Valid}
+}
+
+// IntFromBigQuery creates a new Int from a bigquery.NullInt64.
+func IntFromBigQuery(n bigquery.NullInt64) Int {
+	return NewInt(n.Int64, n.Valid)
+}
+
+// ToBigQuery returns this Int as a bigquery.NullInt64.
+func (i Int) ToBigQuery() bigquery.NullInt64 {
+	return bigquery.NullInt64{Int64: i.Int64, Valid: i.Valid}
+}
+
+// StringFromBigQuery creates a new String from a bigquery.NullString.
+func StringFromBigQuery(n bigquery.NullString) String {
+	return NewString(n.StringVal, n.Valid)
+}
+
+// ToBigQuery returns this String as a bigquery.NullString.
+func (s String) ToBigQuery() bigquery.NullString {
+	return bigquery.NullString{StringVal: s.String, Valid: s.Valid}
+}
+
+// TimeFromBigQuery creates a new Time from a bigquery.NullTimestamp.
+func TimeFromBigQuery(n bigquery.NullTimestamp) Time {
+	return NewTime(n.Timestamp, n.Valid)
+}
+
+// ToBigQuery returns this Time as a bigquery.NullTimestamp.
+func (t Time) ToBigQuery() bigquery.NullTimestamp {
+	return bigquery.NullTimestamp{Timestamp: t.Time, Valid: t.Valid}
+}
@@ -0,0 +1,24 @@
+//go:build bigquery
+
+package zero
+
+import (
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+)
+
+func TestBigQueryConversions(t *testing.T) {
+	if got := IntFromBigQuery(bigquery.NullInt64{Int64: 5, Valid: true}); !got.Equal(IntFrom(5)) {
+		t.Errorf("bad IntFromBigQuery: %v", got)
+	}
+	if got := IntFrom(0).ToBigQuery(); got.Valid {
+		t.Errorf("zero Int should convert to null: %v", got)
+	}
+	if got := StringFrom("hi").ToBigQuery(); got != (bigquery.NullString{StringVal: "hi", Valid: true}) {
+		t.Errorf("bad String.ToBigQuery: %v", got)
+	}
+	if got := TimeFromBigQuery(bigquery.NullTimestamp{}); got.Valid {
+		t.Errorf("bad TimeFromBigQuery: %v", got)
+	}
+}
@@ -0,0 +1,223 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// MarshalBinary and GobEncode use a stable binary encoding, suitable for key-value stores.
+// It starts with a byte holding the kind of the value that would be sent to an SQL database (see Value),
+// followed by the value itself:
+//
+//	0  null, with nothing following
+//	1  int64, as a varint
+//	2  float64, as 8 big-endian IEEE 754 bytes
+//	3  bool, as 0 or 1
+//	4  []byte, as is
+//	5  string, as is
+//	6  time.Time, as encoded by its MarshalBinary
+//	7  uint64, as a uvarint
+//
+// Decoding scans the value back.
+const (
+	binaryNull byte = iota
+	binaryInt64
+	binaryFloat64
+	binaryBool
+	binaryBytes
+	binaryString
+	binaryTime
+	binaryUint64
+)
+
+// encodeBinary encodes the driver value of v.
+func encodeBinary(v driver.Valuer) ([]byte, error) {
+	val, err := v.Value()
+	if err != nil {
+		return nil, fmt.Errorf("zero: couldn't encode binary: %w", err)
+	}
+	if u, ok := val.(uint64); ok {
+		return binary.AppendUvarint([]byte{binaryUint64}, u), nil
+	}
+	val, err = driver.DefaultParameterConverter.ConvertValue(val)
+	if err != nil {
+		return nil, fmt.Errorf("zero: couldn't encode binary: %w", err)
+	}
+	switch x := val.(type) {
+	case nil:
+		return []byte{binaryNull}, nil
+	case int64:
+		return binary.AppendVarint([]byte{binaryInt64}, x), nil
+	case float64:
+		return binary.BigEndian.AppendUint64([]byte{binaryFloat64}, math.Float64bits(x)), nil
+	case bool:
+		if x {
+			return []byte{binaryBool, 1}, nil
+		}
+		return []byte{binaryBool, 0}, nil
+	case []byte:
+		return append([]byte{binaryBytes}, x...), nil
+	case string:
+		return append([]byte{binaryString}, x...), nil
+	case time.Time:
+		return x.AppendBinary([]byte{binaryTime})
+	}
+	return nil, fmt.Errorf("zero: couldn't encode binary: unsupported type %T", val)
+}
+
+// decodeBinaryValue decodes data into the driver value it was encoded from.
+func decodeBinaryValue(data []byte) (interface{}, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("zero: couldn't decode binary: no data")
+	}
+	kind, rest := data[0], data[1:]
+	switch kind {
+	case binaryNull:
+		if len(rest) == 0 {
+			return nil, nil
+		}
+	case binaryInt64:
+		if n, size := binary.Varint(rest); size > 0 && size == len(rest) {
+			return n, nil
+		}
+	case binaryFloat64:
+		if len(rest) == 8 {
+			return math.Float64frombits(binary.BigEndian.Uint64(rest)), nil
+		}
+	case binaryBool:
+		if len(rest) == 1 && rest[0] <= 1 {
+			return rest[0] == 1, nil
+		}
+	case binaryBytes:
+		return bytes.Clone(rest), nil
+	case binaryString:
+		return string(rest), nil
+	case binaryTime:
+		var t time.Time
+		if err := t.UnmarshalBinary(rest); err != nil {
+			return nil, fmt.Errorf("zero: couldn't decode binary: %w", err)
+		}
+		return t, nil
+	case binaryUint64:
+		if n, size := binary.Uvarint(rest); size > 0 && size == len(rest) {
+			return n, nil
+		}
+	default:
+		return nil, fmt.Errorf("zero: couldn't decode binary: unknown kind %d", kind)
+	}
+	return nil, fmt.Errorf("zero: couldn't decode binary: invalid data for kind %d", kind)
+}
+
+// decodeBinary decodes data and scans it into s.
+func decodeBinary(s sql.Scanner, data []byte) error {
+	v, err := decodeBinaryValue(data)
+	if err != nil {
+		return err
+	}
+	return s.Scan(v)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b Bool) MarshalBinary() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Bool) UnmarshalBinary(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b Bool) GobEncode() ([]byte, error) {
+	return encodeBinary(b)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *Bool) GobDecode(data []byte) error {
+	return decodeBinary(b, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (f Float) MarshalBinary() ([]byte, error) {
+	return encodeBinary(f)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Float) UnmarshalBinary(data []byte) error {
+	return decodeBinary(f, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (f Float) GobEncode() ([]byte, error) {
+	return encodeBinary(f)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (f *Float) GobDecode(data []byte) error {
+	return decodeBinary(f, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Int) MarshalBinary() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int) UnmarshalBinary(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Int) GobEncode() ([]byte, error) {
+	return encodeBinary(i)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Int) GobDecode(data []byte) error {
+	return decodeBinary(i, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s String) MarshalBinary() ([]byte, error) {
+	return encodeBinary(s)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *String) UnmarshalBinary(data []byte) error {
+	return decodeBinary(s, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s String) GobEncode() ([]byte, error) {
+	return encodeBinary(s)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *String) GobDecode(data []byte) error {
+	return decodeBinary(s, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return encodeBinary(t)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	return decodeBinary(t, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (t Time) GobEncode() ([]byte, error) {
+	return encodeBinary(t)
+}
+
+// GobDecode implements gob.GobDecoder.
+func (t *Time) GobDecode(data []byte) error {
+	return decodeBinary(t, data)
+}
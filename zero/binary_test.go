@@ -0,0 +1,50 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+)
+
+func TestGob(t *testing.T) {
+	type record struct {
+		Bool   Bool
+		Float  Float
+		Int    Int
+		String String
+		Time   Time
+	}
+	in := record{
+		Bool:   BoolFrom(true),
+		Float:  FloatFrom(1.5),
+		Int:    IntFrom(-12345),
+		String: StringFrom("hello"),
+		Time:   TimeFrom(time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)),
+	}
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(in)
+	maybePanic(err)
+	var out record
+	err = gob.NewDecoder(&buf).Decode(&out)
+	maybePanic(err)
+	if !out.Bool.Equal(in.Bool) || !out.Float.Equal(in.Float) || !out.Int.Equal(in.Int) ||
+		!out.String.Equal(in.String) || !out.Time.Equal(in.Time) {
+		t.Errorf("bad round trip: %#v ≠ %#v", out, in)
+	}
+
+	data, err := NewInt(0, false).MarshalBinary()
+	maybePanic(err)
+	if !bytes.Equal(data, []byte{0}) {
+		t.Errorf("bad null encoding: %v", data)
+	}
+	i := IntFrom(1)
+	err = i.UnmarshalBinary(data)
+	maybePanic(err)
+	if i.Valid {
+		t.Error("decoded null should be invalid")
+	}
+	if err := i.UnmarshalBinary([]byte{9}); err == nil {
+		t.Error("expected error decoding bad data")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // Bool is a nullable bool. False input is considered null.
@@ -43,6 +45,21 @@ func (b Bool) ValueOrZero() bool {
 	return b.Valid && b.Bool
 }
 
+// Scan implements the Scanner interface.
+// In addition to what sql.NullBool accepts, it accepts the float64 values 0 and 1.
+func (b *Bool) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	if f, ok := value.(float64); ok {
+		if f != 0 && f != 1 {
+			b.Valid = false
+			return fmt.Errorf("zero: couldn't scan value %v into Bool", f)
+		}
+		b.Bool, b.Valid = f == 1, true
+		return nil
+	}
+	return b.NullBool.Scan(value)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // "false" will be considered a null Bool.
 func (b *Bool) UnmarshalJSON(data []byte) error {
@@ -104,6 +121,16 @@ func (b *Bool) SetValid(v bool) {
 	b.Valid = true
 }
 
+// SetNull sets this Bool to null, leaving its value as is. Use Reset to clear the value too.
+func (b *Bool) SetNull() {
+	b.Valid = false
+}
+
+// Reset sets this Bool to null and clears its value.
+func (b *Bool) Reset() {
+	*b = Bool{}
+}
+
 // Ptr returns a poBooler to this Bool's value, or a nil poBooler if this Bool is null.
 func (b Bool) Ptr() *bool {
 	if !b.Valid {
@@ -112,11 +139,21 @@ func (b Bool) Ptr() *bool {
 	return &b.Bool
 }
 
+// SetPtr changes this Bool's value to the value v points to, or sets it to be null if v is nil, like BoolFromPtr.
+func (b *Bool) SetPtr(v *bool) {
+	*b = BoolFromPtr(v)
+}
+
 // IsZero returns true for null or zero Bools, for future omitempty support (Go 1.4?)
 func (b Bool) IsZero() bool {
 	return !b.Valid || !b.Bool
 }
 
+// IsZeroOrNull returns true for null or false Bools, the same as IsZero.
+func (b Bool) IsZeroOrNull() bool {
+	return b.IsZero()
+}
+
 // Equal returns true if both booleans are true and valid, or if both booleans are either false or invalid.
 func (b Bool) Equal(other Bool) bool {
 	return b.ValueOrZero() == other.ValueOrZero()
@@ -168,6 +168,11 @@ func TestBoolScan(t *testing.T) {
 	maybePanic(err)
 	assertBool(t, b, "scanned bool")
 
+	var f Bool
+	err = f.Scan(float64(1))
+	maybePanic(err)
+	assertBool(t, f, "scanned float")
+
 	var null Bool
 	err = null.Scan(nil)
 	maybePanic(err)
@@ -0,0 +1,124 @@
+//go:build bson
+
+package zero
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// This file implements bson.ValueMarshaler and bson.ValueUnmarshaler from the MongoDB driver.
+// It is only built with the bson build tag, so that the zero package stays free of dependencies:
+//
+//	go build -tags bson
+//
+// Like JSON, null values are stored as the zero value, and BSON null or zero values decode to null.
+
+func marshalBSONValue(v interface{}) (byte, []byte, error) {
+	typ, data, err := bson.MarshalValue(v)
+	if err != nil {
+		return 0, nil, fmt.Errorf("zero: couldn't marshal BSON: %w", err)
+	}
+	return byte(typ), data, nil
+}
+
+// unmarshalBSONValue decodes a BSON value into v, or sets v to its zero value for BSON null.
+func unmarshalBSONValue[T any](v *T, typ byte, data []byte) error {
+	raw := bson.RawValue{Type: bson.Type(typ), Value: data}
+	if raw.Type == bson.TypeNull || raw.Type == bson.TypeUndefined {
+		var zero T
+		*v = zero
+		return nil
+	}
+	if err := raw.Unmarshal(v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal BSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode false if this Bool is null.
+func (b Bool) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(b.ValueOrZero())
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports BSON booleans and null.
+func (b *Bool) UnmarshalBSONValue(typ byte, data []byte) error {
+	if err := unmarshalBSONValue(&b.Bool, typ, data); err != nil {
+		b.Valid = false
+		return err
+	}
+	b.Valid = b.Bool
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode 0 if this Float is null.
+func (f Float) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(f.ValueOrZero())
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports BSON numbers and null.
+func (f *Float) UnmarshalBSONValue(typ byte, data []byte) error {
+	if err := unmarshalBSONValue(&f.Float64, typ, data); err != nil {
+		f.Valid = false
+		return err
+	}
+	f.Valid = f.Float64 != 0
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode 0 if this Int is null.
+func (i Int) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(i.ValueOrZero())
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports BSON integers and null.
+func (i *Int) UnmarshalBSONValue(typ byte, data []byte) error {
+	if err := unmarshalBSONValue(&i.Int64, typ, data); err != nil {
+		i.Valid = false
+		return err
+	}
+	i.Valid = i.Int64 != 0
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode a blank string if this String is null.
+func (s String) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(s.ValueOrZero())
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports BSON strings and null.
+func (s *String) UnmarshalBSONValue(typ byte, data []byte) error {
+	if err := unmarshalBSONValue(&s.String, typ, data); err != nil {
+		s.Valid = false
+		return err
+	}
+	s.Valid = s.String != ""
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+// It will encode the zero value of time.Time if this Time is null.
+// BSON datetimes have millisecond precision.
+func (t Time) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(t.ValueOrZero())
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+// It supports BSON datetimes and null.
+func (t *Time) UnmarshalBSONValue(typ byte, data []byte) error {
+	if err := unmarshalBSONValue(&t.Time, typ, data); err != nil {
+		t.Valid = false
+		return err
+	}
+	t.Valid = !t.Time.IsZero()
+	return nil
+}
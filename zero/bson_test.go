@@ -0,0 +1,76 @@
+//go:build bson
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type bsonTypes struct {
+	Bool   Bool
+	Float  Float
+	Int    Int
+	String String
+	Time   Time
+}
+
+func TestBSONRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123000000, time.UTC)
+	in := bsonTypes{
+		Bool:   BoolFrom(true),
+		Float:  FloatFrom(1.5),
+		Int:    IntFrom(12345),
+		String: StringFrom("hello"),
+		Time:   TimeFrom(now),
+	}
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+	var out bsonTypes
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.Bool.Equal(in.Bool) || !out.Float.Equal(in.Float) || !out.Int.Equal(in.Int) ||
+		!out.String.Equal(in.String) || !out.Time.Equal(in.Time) {
+		t.Errorf("bad round trip: %#v ≠ %#v", out, in)
+	}
+}
+
+func TestBSONZero(t *testing.T) {
+	data, err := bson.Marshal(bsonTypes{})
+	maybePanic(err)
+	var doc struct {
+		Bool   bool
+		Float  float64
+		Int    int64
+		String string
+		Time   time.Time
+	}
+	err = bson.Unmarshal(data, &doc)
+	maybePanic(err)
+	if doc.Bool || doc.Float != 0 || doc.Int != 0 || doc.String != "" || !doc.Time.IsZero() {
+		t.Errorf("null values should encode as zero: %#v", doc)
+	}
+
+	out := bsonTypes{Int: IntFrom(1), String: StringFrom("x")}
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.Bool.Valid || out.Float.Valid || out.Int.Valid || out.String.Valid || out.Time.Valid {
+		t.Errorf("zero values should decode as null: %#v", out)
+	}
+
+	nulls, err := bson.Marshal(bson.D{{Key: "int", Value: nil}, {Key: "string", Value: nil}})
+	maybePanic(err)
+	err = bson.Unmarshal(nulls, &out)
+	maybePanic(err)
+	if out.Int.Valid || out.String.Valid {
+		t.Errorf("BSON null should decode as null: %#v", out)
+	}
+
+	bad, err := bson.Marshal(bson.D{{Key: "int", Value: "x"}})
+	maybePanic(err)
+	if err := bson.Unmarshal(bad, &out); err == nil {
+		t.Error("expected error unmarshaling string into Int")
+	}
+}
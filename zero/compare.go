@@ -0,0 +1,70 @@
+package zero
+
+import (
+	"cmp"
+	"strings"
+)
+
+// The Compare and Less methods treat null as the zero value, in the same way as the rest of this package.
+
+// Compare compares two Bools, treating null as false.
+// The result will be 0 if b == other, -1 if b < other, and +1 if b > other. false is less than true.
+func (b Bool) Compare(other Bool) int {
+	switch x, y := b.ValueOrZero(), other.ValueOrZero(); {
+	case x == y:
+		return 0
+	case !x:
+		return -1
+	}
+	return 1
+}
+
+// Less reports whether this Bool sorts before other.
+func (b Bool) Less(other Bool) bool {
+	return b.Compare(other) < 0
+}
+
+// Compare compares two Floats, treating null as 0.
+// The result will be 0 if f == other, -1 if f < other, and +1 if f > other.
+// NaN is less than any other number, like cmp.Compare.
+func (f Float) Compare(other Float) int {
+	return cmp.Compare(f.ValueOrZero(), other.ValueOrZero())
+}
+
+// Less reports whether this Float sorts before other.
+func (f Float) Less(other Float) bool {
+	return f.Compare(other) < 0
+}
+
+// Compare compares two Ints, treating null as 0.
+// The result will be 0 if i == other, -1 if i < other, and +1 if i > other.
+func (i Int) Compare(other Int) int {
+	return cmp.Compare(i.ValueOrZero(), other.ValueOrZero())
+}
+
+// Less reports whether this Int sorts before other.
+func (i Int) Less(other Int) bool {
+	return i.Compare(other) < 0
+}
+
+// Compare compares two Strings, treating null as blank.
+// The result will be 0 if s == other, -1 if s < other, and +1 if s > other.
+func (s String) Compare(other String) int {
+	return strings.Compare(s.ValueOrZero(), other.ValueOrZero())
+}
+
+// Less reports whether this String sorts before other.
+func (s String) Less(other String) bool {
+	return s.Compare(other) < 0
+}
+
+// Compare compares two Times, treating null as the zero time.
+// The result will be 0 if t == other, -1 if t < other, and +1 if t > other.
+func (t Time) Compare(other Time) int {
+	return t.ValueOrZero().Compare(other.ValueOrZero())
+}
+
+// Less reports whether this Time sorts before other.
+func (t Time) Less(other Time) bool {
+	return t.Compare(other) < 0
+}
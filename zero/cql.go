@@ -0,0 +1,133 @@
+//go:build gocql
+
+package zero
+
+import (
+	"fmt"
+
+	"github.com/gocql/gocql"
+)
+
+// This file implements gocql.Marshaler and gocql.Unmarshaler from the Cassandra driver.
+// It is only built with the gocql build tag, so that the zero package stays free of dependencies:
+//
+//	go build -tags gocql
+//
+// Like JSON, null values are stored as the zero value, and CQL null or zero values decode to null.
+
+func marshalCQL(info gocql.TypeInfo, v interface{}) ([]byte, error) {
+	data, err := gocql.Marshal(info, v)
+	if err != nil {
+		return nil, fmt.Errorf("zero: couldn't marshal CQL: %w", err)
+	}
+	return data, nil
+}
+
+// unmarshalCQL decodes CQL data into v, or sets v to its zero value for null.
+func unmarshalCQL[T any](v *T, info gocql.TypeInfo, data []byte) error {
+	if data == nil {
+		var zero T
+		*v = zero
+		return nil
+	}
+	if err := gocql.Unmarshal(info, data, v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal CQL: %w", err)
+	}
+	return nil
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode false if this Bool is null.
+func (b Bool) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, b.ValueOrZero())
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports booleans and null.
+func (b *Bool) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if err := unmarshalCQL(&b.Bool, info, data); err != nil {
+		b.Valid = false
+		return err
+	}
+	b.Valid = b.Bool
+	return nil
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode 0 if this Float is null.
+func (f Float) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if info.Type() == gocql.TypeFloat {
+		return marshalCQL(info, float32(f.ValueOrZero()))
+	}
+	return marshalCQL(info, f.ValueOrZero())
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports float, double, and null.
+func (f *Float) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	var err error
+	if info.Type() == gocql.TypeFloat {
+		var f32 float32
+		err = unmarshalCQL(&f32, info, data)
+		f.Float64 = float64(f32)
+	} else {
+		err = unmarshalCQL(&f.Float64, info, data)
+	}
+	if err != nil {
+		f.Valid = false
+		return err
+	}
+	f.Valid = f.Float64 != 0
+	return nil
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Int) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, i.ValueOrZero())
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports integers and null.
+func (i *Int) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if err := unmarshalCQL(&i.Int64, info, data); err != nil {
+		i.Valid = false
+		return err
+	}
+	i.Valid = i.Int64 != 0
+	return nil
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode a blank string if this String is null.
+func (s String) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, s.ValueOrZero())
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports text and null.
+func (s *String) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if err := unmarshalCQL(&s.String, info, data); err != nil {
+		s.Valid = false
+		return err
+	}
+	s.Valid = s.String != ""
+	return nil
+}
+
+// MarshalCQL implements gocql.Marshaler.
+// It will encode the zero value of time.Time if this Time is null.
+func (t Time) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	return marshalCQL(info, t.ValueOrZero())
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+// It supports timestamps, dates, and null.
+func (t *Time) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if err := unmarshalCQL(&t.Time, info, data); err != nil {
+		t.Valid = false
+		return err
+	}
+	t.Valid = !t.Time.IsZero()
+	return nil
+}
@@ -0,0 +1,75 @@
+//go:build gocql
+
+package zero
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+func cqlType(typ gocql.Type) gocql.TypeInfo {
+	return gocql.NewNativeType(4, typ, "")
+}
+
+func TestCQLRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123000000, time.UTC)
+	for _, test := range []struct {
+		typ   gocql.TypeInfo
+		value gocql.Marshaler
+	}{
+		{cqlType(gocql.TypeBoolean), BoolFrom(true)},
+		{cqlType(gocql.TypeDouble), FloatFrom(1.5)},
+		{cqlType(gocql.TypeFloat), FloatFrom(1.5)},
+		{cqlType(gocql.TypeBigInt), IntFrom(7)},
+		{cqlType(gocql.TypeInt), IntFrom(7)},
+		{cqlType(gocql.TypeText), StringFrom("hi")},
+		{cqlType(gocql.TypeTimestamp), TimeFrom(now)},
+	} {
+		data, err := test.value.MarshalCQL(test.typ)
+		if err != nil {
+			t.Errorf("%T as %s: MarshalCQL: %v", test.value, test.typ, err)
+			continue
+		}
+		out := reflect.New(reflect.TypeOf(test.value))
+		if err := out.Interface().(gocql.Unmarshaler).UnmarshalCQL(test.typ, data); err != nil {
+			t.Errorf("%T as %s: UnmarshalCQL: %v", test.value, test.typ, err)
+			continue
+		}
+		if got := out.Elem().Interface(); got != test.value {
+			t.Errorf("%T as %s: got %v, want %v", test.value, test.typ, got, test.value)
+		}
+	}
+}
+
+func TestCQLZero(t *testing.T) {
+	data, err := Int{}.MarshalCQL(cqlType(gocql.TypeBigInt))
+	maybePanic(err)
+	if data == nil {
+		t.Error("null Int should encode as 0, not null")
+	}
+	i := IntFrom(1)
+	err = i.UnmarshalCQL(cqlType(gocql.TypeBigInt), data)
+	maybePanic(err)
+	if i.Valid {
+		t.Errorf("0 should unmarshal to null: %v", i)
+	}
+
+	data, err = Time{}.MarshalCQL(cqlType(gocql.TypeTimestamp))
+	maybePanic(err)
+	tm := TimeFrom(time.Now())
+	err = tm.UnmarshalCQL(cqlType(gocql.TypeTimestamp), data)
+	maybePanic(err)
+	if tm.Valid {
+		t.Errorf("zero time should unmarshal to null: %v", tm)
+	}
+
+	s := StringFrom("x")
+	err = s.UnmarshalCQL(cqlType(gocql.TypeText), nil)
+	maybePanic(err)
+	if s.Valid {
+		t.Errorf("CQL null should unmarshal to null: %v", s)
+	}
+}
@@ -0,0 +1,124 @@
+//go:build dynamodb
+
+package zero
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// This file implements attributevalue.Marshaler and attributevalue.Unmarshaler
+// from github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue.
+// It is only built with the dynamodb build tag, so that the zero package stays free of dependencies:
+//
+//	go build -tags dynamodb
+//
+// Like JSON, null values are stored as the zero value, and NULL or zero values decode to null.
+
+func marshalDynamoDB(v interface{}) (types.AttributeValue, error) {
+	av, err := attributevalue.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("zero: couldn't marshal DynamoDB attribute: %w", err)
+	}
+	return av, nil
+}
+
+// unmarshalDynamoDB decodes a DynamoDB attribute value into v, or sets v to its zero value for NULL.
+func unmarshalDynamoDB[T any](v *T, av types.AttributeValue) error {
+	if _, ok := av.(*types.AttributeValueMemberNULL); ok || av == nil {
+		var zero T
+		*v = zero
+		return nil
+	}
+	if err := attributevalue.Unmarshal(av, v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal DynamoDB attribute: %w", err)
+	}
+	return nil
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode false if this Bool is null.
+func (b Bool) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(b.ValueOrZero())
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports booleans and NULL.
+func (b *Bool) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if err := unmarshalDynamoDB(&b.Bool, av); err != nil {
+		b.Valid = false
+		return err
+	}
+	b.Valid = b.Bool
+	return nil
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode 0 if this Float is null.
+func (f Float) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(f.ValueOrZero())
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports numbers and NULL.
+func (f *Float) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if err := unmarshalDynamoDB(&f.Float64, av); err != nil {
+		f.Valid = false
+		return err
+	}
+	f.Valid = f.Float64 != 0
+	return nil
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Int) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(i.ValueOrZero())
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports integer numbers and NULL.
+func (i *Int) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if err := unmarshalDynamoDB(&i.Int64, av); err != nil {
+		i.Valid = false
+		return err
+	}
+	i.Valid = i.Int64 != 0
+	return nil
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode a blank string if this String is null.
+func (s String) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(s.ValueOrZero())
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports strings and NULL.
+func (s *String) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if err := unmarshalDynamoDB(&s.String, av); err != nil {
+		s.Valid = false
+		return err
+	}
+	s.Valid = s.String != ""
+	return nil
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler.
+// It will encode the zero value of time.Time if this Time is null.
+func (t Time) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return marshalDynamoDB(t.ValueOrZero())
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler.
+// It supports RFC 3339 strings, Unix time numbers, and NULL.
+func (t *Time) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	if err := unmarshalDynamoDB(&t.Time, av); err != nil {
+		t.Valid = false
+		return err
+	}
+	t.Valid = !t.Time.IsZero()
+	return nil
+}
@@ -0,0 +1,57 @@
+//go:build dynamodb
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+type dynamoDBItem struct {
+	Bool   Bool
+	Float  Float
+	Int    Int
+	String String
+	Time   Time
+}
+
+func TestDynamoDBRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+	in := dynamoDBItem{BoolFrom(true), FloatFrom(1.5), IntFrom(7), StringFrom("hi"), TimeFrom(now)}
+	item, err := attributevalue.MarshalMap(in)
+	maybePanic(err)
+	var out dynamoDBItem
+	err = attributevalue.UnmarshalMap(item, &out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("bad round trip: %#v ≠ %#v", out, in)
+	}
+}
+
+func TestDynamoDBZero(t *testing.T) {
+	item, err := attributevalue.MarshalMap(dynamoDBItem{})
+	maybePanic(err)
+	if n, ok := item["Int"].(*types.AttributeValueMemberN); !ok || n.Value != "0" {
+		t.Errorf("null Int should encode as 0: %#v", item["Int"])
+	}
+	if s, ok := item["String"].(*types.AttributeValueMemberS); !ok || s.Value != "" {
+		t.Errorf("null String should encode as blank: %#v", item["String"])
+	}
+
+	out := dynamoDBItem{BoolFrom(true), FloatFrom(1), IntFrom(1), StringFrom("x"), TimeFrom(time.Now())}
+	err = attributevalue.UnmarshalMap(item, &out)
+	maybePanic(err)
+	if out != (dynamoDBItem{}) {
+		t.Errorf("zero values should unmarshal to null: %#v", out)
+	}
+
+	null := map[string]types.AttributeValue{"Int": &types.AttributeValueMemberNULL{Value: true}}
+	err = attributevalue.UnmarshalMap(null, &out)
+	maybePanic(err)
+	if out.Int.Valid {
+		t.Errorf("NULL should unmarshal to null: %v", out.Int)
+	}
+}
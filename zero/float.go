@@ -9,6 +9,8 @@ import (
 	"math"
 	"reflect"
 	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // Float is a nullable float64. Zero input will be considered null.
@@ -49,6 +51,12 @@ func (f Float) ValueOrZero() float64 {
 	return f.Float64
 }
 
+// Scan implements the Scanner interface.
+// It accepts what sql.NullFloat64 does, as well as float32 and pointers from drivers such as clickhouse-go.
+func (f *Float) Scan(value interface{}) error {
+	return f.NullFloat64.Scan(driverval.Convert(value))
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Float.
@@ -134,6 +142,16 @@ func (f *Float) SetValid(v float64) {
 	f.Valid = true
 }
 
+// SetNull sets this Float to null, leaving its value as is. Use Reset to clear the value too.
+func (f *Float) SetNull() {
+	f.Valid = false
+}
+
+// Reset sets this Float to null and clears its value.
+func (f *Float) Reset() {
+	*f = Float{}
+}
+
 // Ptr returns a poFloater to this Float's value, or a nil poFloater if this Float is null.
 func (f Float) Ptr() *float64 {
 	if !f.Valid {
@@ -142,11 +160,21 @@ func (f Float) Ptr() *float64 {
 	return &f.Float64
 }
 
+// SetPtr changes this Float's value to the value v points to, or sets it to be null if v is nil, like FloatFromPtr.
+func (f *Float) SetPtr(v *float64) {
+	*f = FloatFromPtr(v)
+}
+
 // IsZero returns true for null or zero Floats, for future omitempty support (Go 1.4?)
 func (f Float) IsZero() bool {
 	return !f.Valid || f.Float64 == 0
 }
 
+// IsZeroOrNull returns true for null or zero Floats, the same as IsZero.
+func (f Float) IsZeroOrNull() bool {
+	return f.IsZero()
+}
+
 // Equal returns true if both floats have the same value or are both either null or zero.
 // Warning: calculations using floating point numbers can result in different ways
 // the numbers are stored in memory. Therefore, this function is not suitable to
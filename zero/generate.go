@@ -0,0 +1,40 @@
+package zero
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// The Generate methods implement quick.Generator, so that testing/quick can generate structs with fields of these types.
+// One in four generated values is null. Like the rest of this package, zero values are generated as null.
+
+// generateNullChance is the probability that the Generate methods return a null value.
+const generateNullChance = 0.25
+
+// Generate implements quick.Generator, returning true, or null one in four times.
+func (Bool) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(RandomBool(r, generateNullChance))
+}
+
+// Generate implements quick.Generator, returning a random Float with a standard deviation of 1000, or null.
+func (Float) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(RandomFloat(r, generateNullChance))
+}
+
+// Generate implements quick.Generator, returning a random Int, or null.
+func (Int) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(RandomInt(r, generateNullChance))
+}
+
+// Generate implements quick.Generator, returning a random String of 1 to size printable ASCII characters, or null.
+func (String) Generate(r *rand.Rand, size int) reflect.Value {
+	if r.Float64() < generateNullChance {
+		return reflect.ValueOf(String{})
+	}
+	return reflect.ValueOf(StringFrom(randomString(r, size)))
+}
+
+// Generate implements quick.Generator, returning a random UTC Time from 1970 to 2100 with microsecond precision, or null.
+func (Time) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(RandomTime(r, generateNullChance))
+}
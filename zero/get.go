@@ -0,0 +1,91 @@
+package zero
+
+import "time"
+
+// Get and MustGet return the value of these types, for code that has already checked validity.
+// Valid zero values are returned as valid, even though the From constructors consider them null.
+
+// Get returns this Bool's value and true if it is valid, otherwise the zero value and false.
+func (b Bool) Get() (bool, bool) {
+	if !b.Valid {
+		var zero bool
+		return zero, false
+	}
+	return b.Bool, true
+}
+
+// MustGet returns this Bool's value, and panics if it is null.
+func (b Bool) MustGet() bool {
+	if !b.Valid {
+		panic("zero: MustGet called on a null Bool")
+	}
+	return b.Bool
+}
+
+// Get returns this Float's value and true if it is valid, otherwise the zero value and false.
+func (f Float) Get() (float64, bool) {
+	if !f.Valid {
+		var zero float64
+		return zero, false
+	}
+	return f.Float64, true
+}
+
+// MustGet returns this Float's value, and panics if it is null.
+func (f Float) MustGet() float64 {
+	if !f.Valid {
+		panic("zero: MustGet called on a null Float")
+	}
+	return f.Float64
+}
+
+// Get returns this Int's value and true if it is valid, otherwise the zero value and false.
+func (i Int) Get() (int64, bool) {
+	if !i.Valid {
+		var zero int64
+		return zero, false
+	}
+	return i.Int64, true
+}
+
+// MustGet returns this Int's value, and panics if it is null.
+func (i Int) MustGet() int64 {
+	if !i.Valid {
+		panic("zero: MustGet called on a null Int")
+	}
+	return i.Int64
+}
+
+// Get returns this String's value and true if it is valid, otherwise the zero value and false.
+func (s String) Get() (string, bool) {
+	if !s.Valid {
+		var zero string
+		return zero, false
+	}
+	return s.String, true
+}
+
+// MustGet returns this String's value, and panics if it is null.
+func (s String) MustGet() string {
+	if !s.Valid {
+		panic("zero: MustGet called on a null String")
+	}
+	return s.String
+}
+
+// Get returns this Time's value and true if it is valid, otherwise the zero value and false.
+func (t Time) Get() (time.Time, bool) {
+	if !t.Valid {
+		var zero time.Time
+		return zero, false
+	}
+	return t.Time, true
+}
+
+// MustGet returns this Time's value, and panics if it is null.
+func (t Time) MustGet() time.Time {
+	if !t.Valid {
+		panic("zero: MustGet called on a null Time")
+	}
+	return t.Time
+}
@@ -0,0 +1,90 @@
+package zero
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// This file implements the Marshaler and Unmarshaler interfaces from github.com/99designs/gqlgen,
+// so that every type can be bound directly as a GraphQL scalar.
+// Values are converted to and from the same representation as JSON,
+// so null values are encoded as the zero value, and zero values decode to null.
+
+// marshalGQL writes the JSON encoding of v to w, or null if v can't be encoded.
+func marshalGQL(w io.Writer, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		data = nullBytes
+	}
+	_, _ = w.Write(data)
+}
+
+// unmarshalGQL decodes a GraphQL input value into u via its JSON representation.
+func unmarshalGQL(u json.Unmarshaler, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal GraphQL: %w", err)
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode false if this Bool is null.
+func (b Bool) MarshalGQL(w io.Writer) {
+	marshalGQL(w, b)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports anything this Bool can be unmarshaled from as JSON.
+func (b *Bool) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(b, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode 0 if this Float is null.
+func (f Float) MarshalGQL(w io.Writer) {
+	marshalGQL(w, f)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports anything this Float can be unmarshaled from as JSON.
+func (f *Float) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(f, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Int) MarshalGQL(w io.Writer) {
+	marshalGQL(w, i)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports anything this Int can be unmarshaled from as JSON.
+func (i *Int) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(i, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode a blank string if this String is null.
+func (s String) MarshalGQL(w io.Writer) {
+	marshalGQL(w, s)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports anything this String can be unmarshaled from as JSON.
+func (s *String) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(s, input)
+}
+
+// MarshalGQL implements graphql.Marshaler.
+// It will encode the zero value of time.Time if this Time is null.
+func (t Time) MarshalGQL(w io.Writer) {
+	marshalGQL(w, t)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+// It supports anything this Time can be unmarshaled from as JSON.
+func (t *Time) UnmarshalGQL(input interface{}) error {
+	return unmarshalGQL(t, input)
+}
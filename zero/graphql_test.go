@@ -0,0 +1,62 @@
+package zero
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestMarshalGQL(t *testing.T) {
+	for _, tc := range []struct {
+		v    interface{ MarshalGQL(w io.Writer) }
+		want string
+	}{
+		{Bool{}, "false"},
+		{Float{}, "0"},
+		{Int{}, "0"},
+		{String{}, `""`},
+		{Time{}, `"0001-01-01T00:00:00Z"`},
+		{IntFrom(12345), "12345"},
+		{StringFrom("hello"), `"hello"`},
+	} {
+		var buf bytes.Buffer
+		tc.v.MarshalGQL(&buf)
+		if buf.String() != tc.want {
+			t.Errorf("%T: MarshalGQL got %s, want %s", tc.v, buf.String(), tc.want)
+		}
+	}
+}
+
+func TestUnmarshalGQL(t *testing.T) {
+	var i Int
+	err := i.UnmarshalGQL(int64(12345))
+	maybePanic(err)
+	if !i.Equal(IntFrom(12345)) {
+		t.Errorf("bad Int: %v", i)
+	}
+	err = i.UnmarshalGQL(nil)
+	maybePanic(err)
+	if i.Valid {
+		t.Error("nil should decode as null Int")
+	}
+
+	var s String
+	err = s.UnmarshalGQL("")
+	maybePanic(err)
+	if s.Valid {
+		t.Error("blank string should decode as null String")
+	}
+
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	var tm Time
+	err = tm.UnmarshalGQL(now.Format(time.RFC3339))
+	maybePanic(err)
+	if !tm.Equal(TimeFrom(now)) {
+		t.Errorf("bad Time: %v", tm)
+	}
+
+	if err := i.UnmarshalGQL(func() {}); err == nil {
+		t.Error("expected error for func into Int")
+	}
+}
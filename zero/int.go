@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // Int is a nullable int64.
@@ -48,6 +50,23 @@ func (i Int) ValueOrZero() int64 {
 	return i.Int64
 }
 
+// Scan implements the Scanner interface.
+// In addition to what sql.NullInt64 accepts, it accepts float64 values without a fractional part,
+// which SQLite drivers return for integers stored as REAL.
+func (i *Int) Scan(value interface{}) error {
+	value = driverval.Convert(value)
+	if f, ok := value.(float64); ok {
+		n, ok := driverval.FloatToInt(f)
+		if !ok {
+			i.Valid = false
+			return fmt.Errorf("zero: couldn't scan value %v into Int: not an integer in range", f)
+		}
+		i.Int64, i.Valid = n, true
+		return nil
+	}
+	return i.NullInt64.Scan(value)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Int.
@@ -127,6 +146,16 @@ func (i *Int) SetValid(n int64) {
 	i.Valid = true
 }
 
+// SetNull sets this Int to null, leaving its value as is. Use Reset to clear the value too.
+func (i *Int) SetNull() {
+	i.Valid = false
+}
+
+// Reset sets this Int to null and clears its value.
+func (i *Int) Reset() {
+	*i = Int{}
+}
+
 // Ptr returns a pointer to this Int's value, or a nil pointer if this Int is null.
 func (i Int) Ptr() *int64 {
 	if !i.Valid {
@@ -135,11 +164,21 @@ func (i Int) Ptr() *int64 {
 	return &i.Int64
 }
 
+// SetPtr changes this Int's value to the value v points to, or sets it to be null if v is nil, like IntFromPtr.
+func (i *Int) SetPtr(v *int64) {
+	*i = IntFromPtr(v)
+}
+
 // IsZero returns true for null or zero Ints, for future omitempty support (Go 1.4?)
 func (i Int) IsZero() bool {
 	return !i.Valid || i.Int64 == 0
 }
 
+// IsZeroOrNull returns true for null or zero Ints, the same as IsZero.
+func (i Int) IsZeroOrNull() bool {
+	return i.IsZero()
+}
+
 // Equal returns true if both ints have the same value or are both either null or zero.
 func (i Int) Equal(other Int) bool {
 	return i.ValueOrZero() == other.ValueOrZero()
@@ -1,9 +1,13 @@
 package zero
 
 import (
+	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
 	"math"
+	"math/rand"
 	"strconv"
 	"testing"
 )
@@ -191,6 +195,9 @@ func TestIntIsZero(t *testing.T) {
 	if !zero.IsZero() {
 		t.Errorf("IsZero() should be true")
 	}
+	if !zero.IsZeroOrNull() || !null.IsZeroOrNull() || i.IsZeroOrNull() {
+		t.Errorf("IsZeroOrNull() should match IsZero()")
+	}
 }
 
 func TestIntScan(t *testing.T) {
@@ -199,6 +206,14 @@ func TestIntScan(t *testing.T) {
 	maybePanic(err)
 	assertInt(t, i, "scanned int")
 
+	var f Int
+	err = f.Scan(float64(12345))
+	maybePanic(err)
+	assertInt(t, f, "scanned float")
+	if err := f.Scan(1.5); err == nil {
+		t.Error("expected error for fractional float")
+	}
+
 	var null Int
 	err = null.Scan(nil)
 	maybePanic(err)
@@ -282,3 +297,121 @@ func assertIntEqualIsFalse(t *testing.T, a, b Int) {
 		t.Errorf("Equal() of Int{%v, Valid:%t} and Int{%v, Valid:%t} should return false", a.Int64, a.Valid, b.Int64, b.Valid)
 	}
 }
+
+func TestIntSQLNull(t *testing.T) {
+	n := IntFrom(12345).ToSQLNull()
+	if n != (sql.Null[int64]{V: 12345, Valid: true}) {
+		t.Errorf("bad sql.Null: %#v", n)
+	}
+	assertInt(t, IntFromSQLNull(n), "IntFromSQLNull")
+	assertNullInt(t, IntFromSQLNull(sql.Null[int64]{}), "IntFromSQLNull invalid")
+}
+
+func TestIntTransform(t *testing.T) {
+	half := func(v int64) int64 { return v / 2 }
+	if got := IntFrom(24690).Map(half); got.Int64 != 12345 || !got.Valid {
+		t.Errorf("Map(): got %v", got)
+	}
+	if got := IntFrom(1).Map(half); got.Valid {
+		t.Errorf("Map() to zero should be null, got %v", got)
+	}
+	if got := IntFrom(12345).Filter(func(v int64) bool { return v < 0 }); got.Valid {
+		t.Errorf("Filter(): got %v", got)
+	}
+	assertInt(t, NewInt(0, false).Or(IntFrom(12345)), "Or")
+	if got := NewInt(0, false).OrElse(12345); got != 12345 {
+		t.Errorf("OrElse(): got %d", got)
+	}
+}
+
+func TestIntGet(t *testing.T) {
+	if v, ok := IntFrom(12345).Get(); v != 12345 || !ok {
+		t.Errorf("Get(): got %d, %v", v, ok)
+	}
+	if _, ok := NewInt(0, false).Get(); ok {
+		t.Error("null Get() should not be ok")
+	}
+	if v := IntFrom(12345).MustGet(); v != 12345 {
+		t.Errorf("MustGet(): got %d", v)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustGet() of null should panic")
+		}
+	}()
+	NewInt(0, false).MustGet()
+}
+
+func TestIntSetNull(t *testing.T) {
+	i := IntFrom(12345)
+	i.SetNull()
+	assertNullInt(t, i, "SetNull()")
+	if i.Int64 != 12345 {
+		t.Errorf("SetNull() should leave the value, got %d", i.Int64)
+	}
+	i.Reset()
+	if i != (Int{}) {
+		t.Errorf("Reset() should clear the value, got %v", i)
+	}
+}
+
+func TestIntSetPtr(t *testing.T) {
+	var i Int
+	n := int64(12345)
+	i.SetPtr(&n)
+	assertInt(t, i, "SetPtr()")
+	i.SetPtr(nil)
+	assertNullInt(t, i, "SetPtr(nil)")
+}
+
+func TestIntCompare(t *testing.T) {
+	if IntFrom(1).Compare(IntFrom(2)) != -1 || IntFrom(2).Compare(IntFrom(1)) != 1 {
+		t.Error("bad Compare()")
+	}
+	if NewInt(0, false).Compare(IntFrom(0)) != 0 || !NewInt(0, false).Less(IntFrom(1)) || NewInt(0, false).Less(IntFrom(-1)) {
+		t.Error("null should compare as zero")
+	}
+}
+
+func TestIntString(t *testing.T) {
+	if got := fmt.Sprint(IntFrom(12345), NewInt(0, false)); got != "12345 0" {
+		t.Errorf("bad String(): %q", got)
+	}
+}
+
+func TestIntLogValue(t *testing.T) {
+	if v := IntFrom(12345).LogValue(); v.Kind() != slog.KindInt64 || v.Int64() != 12345 {
+		t.Errorf("bad LogValue(): %v", v)
+	}
+	if v := NewInt(0, false).LogValue(); v.Kind() != slog.KindInt64 || v.Int64() != 0 {
+		t.Errorf("bad null LogValue(): %v", v)
+	}
+}
+
+func TestIntGenerate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var null int
+	for i := 0; i < 100; i++ {
+		v := Int{}.Generate(r, 10).Interface().(Int)
+		if !v.Valid {
+			null++
+		} else if v.Int64 == 0 {
+			t.Errorf("zero value should be null: %#v", v)
+		}
+	}
+	if null == 0 || null == 100 {
+		t.Errorf("expected a mix of valid and null values, got %d null", null)
+	}
+}
+
+func TestRandomInt(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		if v := RandomInt(r, 0); !v.Valid || v.Int64 == 0 {
+			t.Errorf("nullProbability 0 should always be valid: %#v", v)
+		}
+		if v := RandomInt(r, 1); v.Valid {
+			t.Errorf("nullProbability 1 should always be null: %#v", v)
+		}
+	}
+}
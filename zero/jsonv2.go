@@ -0,0 +1,90 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package zero
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+)
+
+// This file implements the MarshalerTo and UnmarshalerFrom interfaces from encoding/json/v2,
+// which are available from Go 1.27 unless disabled with GOEXPERIMENT=nojsonv2.
+// They produce the same output as the v1 encoding, encoding null values as the zero value.
+
+// marshalJSONTo writes the JSON encoding of m to enc.
+func marshalJSONTo(enc *jsontext.Encoder, m json.Marshaler) error {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(data)
+}
+
+// unmarshalJSONFrom reads the next JSON value from dec into u.
+func unmarshalJSONFrom(dec *jsontext.Decoder, u json.Unmarshaler) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode false if this Bool is null.
+func (b Bool) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.Bool(b.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (b *Bool) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, b)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Float is null.
+func (f Float) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, f)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (f *Float) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, f)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Int is null.
+func (i Int) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.Int(i.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (i *Int) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, i)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode a blank string if this String is null.
+func (s String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.String(s.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (s *String) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, s)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode the zero value of time.Time if this Time is null.
+func (t Time) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, t)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the same input as UnmarshalJSON.
+func (t *Time) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return unmarshalJSONFrom(dec, t)
+}
@@ -0,0 +1,45 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package zero
+
+import (
+	"encoding/json"
+	jsonv2 "encoding/json/v2"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJSONv2(t *testing.T) {
+	for _, v := range []interface{}{
+		Bool{}, Float{}, Int{}, String{}, Time{},
+		BoolFrom(true), FloatFrom(1.2345), IntFrom(12345), StringFrom("test"), TimeFrom(time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)),
+	} {
+		want, err := json.Marshal(v)
+		maybePanic(err)
+		data, err := jsonv2.Marshal(v)
+		maybePanic(err)
+		if string(data) != string(want) {
+			t.Errorf("%T: json/v2 Marshal got %s, want %s", v, data, want)
+		}
+
+		out := reflect.New(reflect.TypeOf(v))
+		err = jsonv2.Unmarshal(data, out.Interface())
+		maybePanic(err)
+		if got := out.Elem().Interface(); !reflect.DeepEqual(got, v) {
+			t.Errorf("%T: json/v2 Unmarshal got %v, want %v", v, got, v)
+		}
+	}
+}
+
+func TestJSONv2OmitZero(t *testing.T) {
+	type omit struct {
+		Int    Int    `json:",omitzero"`
+		String String `json:",omitzero"`
+	}
+	data, err := jsonv2.Marshal(omit{Int: IntFrom(0), String: StringFrom("")})
+	maybePanic(err)
+	if string(data) != "{}" {
+		t.Errorf("omitzero: got %s", data)
+	}
+}
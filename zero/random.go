@@ -0,0 +1,60 @@
+package zero
+
+import (
+	"math/rand"
+	"time"
+)
+
+// The Random functions return random values for test fixtures and load generators.
+// They are null with a probability of nullProbability, from 0 to 1, and are otherwise valid, non-zero values
+// like those returned by the Generate methods.
+
+// randomString returns a random string of 1 to size printable ASCII characters, or a blank string if size isn't positive.
+func randomString(r *rand.Rand, size int) string {
+	if size <= 0 {
+		return ""
+	}
+	s := make([]byte, 1+r.Intn(size))
+	for i := range s {
+		s[i] = byte(' ' + r.Intn('~'-' '+1))
+	}
+	return string(s)
+}
+
+// RandomBool returns a random valid Bool, which is always true, or null with a probability of nullProbability.
+func RandomBool(r *rand.Rand, nullProbability float64) Bool {
+	return BoolFrom(r.Float64() >= nullProbability)
+}
+
+// RandomFloat returns a random valid Float with a standard deviation of 1000, or null with a probability of nullProbability.
+func RandomFloat(r *rand.Rand, nullProbability float64) Float {
+	if r.Float64() < nullProbability {
+		return Float{}
+	}
+	return FloatFrom(r.NormFloat64() * 1000)
+}
+
+// RandomInt returns a random valid Int, or null with a probability of nullProbability.
+func RandomInt(r *rand.Rand, nullProbability float64) Int {
+	if r.Float64() < nullProbability {
+		return Int{}
+	}
+	return IntFrom(int64(r.Uint64()))
+}
+
+// RandomString returns a random valid String of up to 50 printable ASCII characters, or null with a probability of nullProbability.
+func RandomString(r *rand.Rand, nullProbability float64) String {
+	if r.Float64() < nullProbability {
+		return String{}
+	}
+	return StringFrom(randomString(r, 50))
+}
+
+// RandomTime returns a random valid UTC Time from 1970 to 2100 with microsecond precision,
+// or null with a probability of nullProbability.
+func RandomTime(r *rand.Rand, nullProbability float64) Time {
+	if r.Float64() < nullProbability {
+		return Time{}
+	}
+	return TimeFrom(time.Unix(r.Int63n(4102444800), r.Int63n(1e6)*1e3).UTC())
+}
@@ -0,0 +1,31 @@
+package zero
+
+import "log/slog"
+
+// The LogValue methods implement slog.LogValuer, so that values are logged as scalars
+// instead of groups of their fields. Like the rest of this package, null values are logged as the zero value.
+
+// LogValue implements slog.LogValuer, logging this Bool's value, or false if it is null.
+func (b Bool) LogValue() slog.Value {
+	return slog.BoolValue(b.ValueOrZero())
+}
+
+// LogValue implements slog.LogValuer, logging this Float's value, or 0 if it is null.
+func (f Float) LogValue() slog.Value {
+	return slog.Float64Value(f.ValueOrZero())
+}
+
+// LogValue implements slog.LogValuer, logging this Int's value, or 0 if it is null.
+func (i Int) LogValue() slog.Value {
+	return slog.Int64Value(i.ValueOrZero())
+}
+
+// LogValue implements slog.LogValuer, logging this String's value, or a blank string if it is null.
+func (s String) LogValue() slog.Value {
+	return slog.StringValue(s.ValueOrZero())
+}
+
+// LogValue implements slog.LogValuer, logging this Time's value, or the zero time if it is null.
+func (t Time) LogValue() slog.Value {
+	return slog.TimeValue(t.ValueOrZero())
+}
@@ -0,0 +1,176 @@
+//go:build spanner
+
+package zero
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// This file implements spanner.Encoder and spanner.Decoder from the Cloud Spanner client,
+// and converts between this package's types and Spanner's NullBool, NullInt64, and so on.
+// It is only built with the spanner build tag, so that the zero package stays free of dependencies:
+//
+//	go build -tags spanner
+//
+// Like SQL, null values are encoded as NULL, and Spanner NULL decodes to null.
+
+// spannerValue converts a value passed to spanner.Decoder into the equivalent driver value.
+// Spanner passes NULL as a typed nil pointer.
+func spannerValue(input interface{}) (interface{}, error) {
+	switch v := input.(type) {
+	case *string:
+		if v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	case *float64:
+		if v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	case *bool:
+		if v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	case *structpb.ListValue:
+		return nil, errors.New("arrays are not supported")
+	}
+	return input, nil
+}
+
+// decodeSpanner converts a Spanner value into the equivalent driver value and scans it into s.
+func decodeSpanner(s sql.Scanner, input interface{}) error {
+	v, err := spannerValue(input)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't decode Spanner value: %w", err)
+	}
+	return s.Scan(v)
+}
+
+// BoolFromSpanner creates a new Bool from a spanner.NullBool.
+func BoolFromSpanner(n spanner.NullBool) Bool {
+	return NewBool(n.Bool, n.Valid)
+}
+
+// ToSpanner returns this Bool as a spanner.NullBool.
+func (b Bool) ToSpanner() spanner.NullBool {
+	return spanner.NullBool{Bool: b.Bool, Valid: b.Valid}
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Bool is null.
+func (b Bool) EncodeSpanner() (interface{}, error) {
+	return b.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Bool can be scanned from.
+func (b *Bool) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(b, input)
+}
+
+// FloatFromSpanner creates a new Float from a spanner.NullFloat64.
+func FloatFromSpanner(n spanner.NullFloat64) Float {
+	return NewFloat(n.Float64, n.Valid)
+}
+
+// ToSpanner returns this Float as a spanner.NullFloat64.
+func (f Float) ToSpanner() spanner.NullFloat64 {
+	return spanner.NullFloat64{Float64: f.Float64, Valid: f.Valid}
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Float is null.
+func (f Float) EncodeSpanner() (interface{}, error) {
+	return f.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Float can be scanned from.
+func (f *Float) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(f, input)
+}
+
+// IntFromSpanner creates a new Int from a spanner.NullInt64.
+func IntFromSpanner(n spanner.NullInt64) Int {
+	return NewInt(n.Int64, n.Valid)
+}
+
+// ToSpanner returns this Int as a spanner.NullInt64.
+func (i Int) ToSpanner() spanner.NullInt64 {
+	return spanner.NullInt64{Int64: i.Int64, Valid: i.Valid}
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Int is null.
+func (i Int) EncodeSpanner() (interface{}, error) {
+	return i.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this Int can be scanned from.
+func (i *Int) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(i, input)
+}
+
+// StringFromSpanner creates a new String from a spanner.NullString.
+func StringFromSpanner(n spanner.NullString) String {
+	return NewString(n.StringVal, n.Valid)
+}
+
+// ToSpanner returns this String as a spanner.NullString.
+func (s String) ToSpanner() spanner.NullString {
+	return spanner.NullString{StringVal: s.String, Valid: s.Valid}
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this String is null.
+func (s String) EncodeSpanner() (interface{}, error) {
+	return s.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and any Spanner value this String can be scanned from.
+func (s *String) DecodeSpanner(input interface{}) error {
+	return decodeSpanner(s, input)
+}
+
+// TimeFromSpanner creates a new Time from a spanner.NullTime.
+func TimeFromSpanner(n spanner.NullTime) Time {
+	return NewTime(n.Time, n.Valid)
+}
+
+// ToSpanner returns this Time as a spanner.NullTime.
+func (t Time) ToSpanner() spanner.NullTime {
+	return spanner.NullTime{Time: t.Time, Valid: t.Valid}
+}
+
+// EncodeSpanner implements spanner.Encoder.
+// It will encode null if this Time is null.
+func (t Time) EncodeSpanner() (interface{}, error) {
+	return t.ToSpanner(), nil
+}
+
+// DecodeSpanner implements spanner.Decoder.
+// It supports null and TIMESTAMP values, which Spanner sends as RFC 3339 strings.
+func (t *Time) DecodeSpanner(input interface{}) error {
+	v, err := spannerValue(input)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't decode Spanner value: %w", err)
+	}
+	if str, ok := v.(string); ok {
+		parsed, err := time.Parse(time.RFC3339Nano, str)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't decode Spanner TIMESTAMP: %w", err)
+		}
+		v = parsed
+	}
+	return t.Scan(v)
+}
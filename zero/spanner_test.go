@@ -0,0 +1,52 @@
+//go:build spanner
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+func TestSpannerRoundTrip(t *testing.T) {
+	type row struct {
+		Int    Int
+		Float  Float
+		Bool   Bool
+		String String
+		Time   Time
+	}
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+	for _, in := range []row{
+		{IntFrom(1), FloatFrom(1.5), BoolFrom(true), StringFrom("hi"), TimeFrom(now)},
+		{},
+	} {
+		r, err := spanner.NewRow(
+			[]string{"Int", "Float", "Bool", "String", "Time"},
+			[]interface{}{in.Int, in.Float, in.Bool, in.String, in.Time},
+		)
+		maybePanic(err)
+		out := row{IntFrom(2), FloatFrom(2), BoolFrom(true), StringFrom("x"), TimeFrom(now)}
+		err = r.ToStruct(&out)
+		maybePanic(err)
+		if out != in {
+			t.Errorf("bad round trip: %#v ≠ %#v", out, in)
+		}
+	}
+}
+
+func TestSpannerConversions(t *testing.T) {
+	if got := IntFromSpanner(spanner.NullInt64{Int64: 5, Valid: true}); !got.Equal(IntFrom(5)) {
+		t.Errorf("bad IntFromSpanner: %v", got)
+	}
+	if got := IntFrom(0).ToSpanner(); got.Valid {
+		t.Errorf("zero Int should convert to null: %v", got)
+	}
+	if got := StringFrom("hi").ToSpanner(); got != (spanner.NullString{StringVal: "hi", Valid: true}) {
+		t.Errorf("bad String.ToSpanner: %v", got)
+	}
+	if got := TimeFromSpanner(spanner.NullTime{}); got.Valid {
+		t.Errorf("bad TimeFromSpanner: %v", got)
+	}
+}
@@ -0,0 +1,67 @@
+package zero
+
+import (
+	"database/sql"
+	"time"
+)
+
+// The FromSQLNull constructors and ToSQLNull methods convert between this package's types
+// and the standard library's generic sql.Null[T].
+
+// sqlNull returns v as a sql.Null[T], with the zero value if it is not valid.
+func sqlNull[T any](v T, valid bool) sql.Null[T] {
+	if !valid {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{V: v, Valid: true}
+}
+
+// BoolFromSQLNull creates a new Bool from a sql.Null[bool].
+func BoolFromSQLNull(n sql.Null[bool]) Bool {
+	return NewBool(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Bool as a sql.Null[bool].
+func (b Bool) ToSQLNull() sql.Null[bool] {
+	return sqlNull(b.Bool, b.Valid)
+}
+
+// FloatFromSQLNull creates a new Float from a sql.Null[float64].
+func FloatFromSQLNull(n sql.Null[float64]) Float {
+	return NewFloat(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Float as a sql.Null[float64].
+func (f Float) ToSQLNull() sql.Null[float64] {
+	return sqlNull(f.Float64, f.Valid)
+}
+
+// IntFromSQLNull creates a new Int from a sql.Null[int64].
+func IntFromSQLNull(n sql.Null[int64]) Int {
+	return NewInt(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Int as a sql.Null[int64].
+func (i Int) ToSQLNull() sql.Null[int64] {
+	return sqlNull(i.Int64, i.Valid)
+}
+
+// StringFromSQLNull creates a new String from a sql.Null[string].
+func StringFromSQLNull(n sql.Null[string]) String {
+	return NewString(n.V, n.Valid)
+}
+
+// ToSQLNull returns this String as a sql.Null[string].
+func (s String) ToSQLNull() sql.Null[string] {
+	return sqlNull(s.String, s.Valid)
+}
+
+// TimeFromSQLNull creates a new Time from a sql.Null[time.Time].
+func TimeFromSQLNull(n sql.Null[time.Time]) Time {
+	return NewTime(n.V, n.Valid)
+}
+
+// ToSQLNull returns this Time as a sql.Null[time.Time].
+func (t Time) ToSQLNull() sql.Null[time.Time] {
+	return sqlNull(t.Time, t.Valid)
+}
@@ -9,6 +9,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // nullBytes is a JSON null literal
@@ -53,6 +55,12 @@ func (s String) ValueOrZero() string {
 	return s.String
 }
 
+// Scan implements the Scanner interface.
+// Unlike sql.NullString, it also accepts pointers, such as the *string clickhouse-go returns for Nullable(String) columns.
+func (s *String) Scan(value interface{}) error {
+	return s.NullString.Scan(driverval.Convert(value))
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports string and null input. Blank string input produces a null String.
 func (s *String) UnmarshalJSON(data []byte) error {
@@ -92,6 +100,16 @@ func (s *String) SetValid(v string) {
 	s.Valid = true
 }
 
+// SetNull sets this String to null, leaving its value as is. Use Reset to clear the value too.
+func (s *String) SetNull() {
+	s.Valid = false
+}
+
+// Reset sets this String to null and clears its value.
+func (s *String) Reset() {
+	*s = String{}
+}
+
 // Ptr returns a pointer to this String's value, or a nil pointer if this String is null.
 func (s String) Ptr() *string {
 	if !s.Valid {
@@ -100,11 +118,21 @@ func (s String) Ptr() *string {
 	return &s.String
 }
 
+// SetPtr changes this String's value to the value v points to, or sets it to be null if v is nil, like StringFromPtr.
+func (s *String) SetPtr(v *string) {
+	*s = StringFromPtr(v)
+}
+
 // IsZero returns true for null or empty strings, for potential future omitempty support.
 func (s String) IsZero() bool {
 	return !s.Valid || s.String == ""
 }
 
+// IsZeroOrNull returns true for null or empty strings, the same as IsZero.
+func (s String) IsZeroOrNull() bool {
+	return s.IsZero()
+}
+
 // Equal returns true if both strings have the same value or are both either null or empty.
 func (s String) Equal(other String) bool {
 	return s.ValueOrZero() == other.ValueOrZero()
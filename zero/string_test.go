@@ -151,6 +151,12 @@ func TestStringScan(t *testing.T) {
 	maybePanic(err)
 	assertStr(t, str, "scanned string")
 
+	s := "test"
+	var ptr String
+	err = ptr.Scan(&s)
+	maybePanic(err)
+	assertStr(t, ptr, "scanned *string")
+
 	var null String
 	err = null.Scan(nil)
 	maybePanic(err)
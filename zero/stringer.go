@@ -0,0 +1,44 @@
+package zero
+
+import (
+	"encoding"
+	"fmt"
+)
+
+// The String methods return values as text, like MarshalText, so that they print as such with fmt and in logs.
+// Like the rest of this package, null values are printed as the zero value.
+// String has no String method, because that is the name of its value field; it implements fmt.Formatter instead.
+
+// textString returns the text of m for the String methods.
+func textString(m encoding.TextMarshaler) string {
+	text, err := m.MarshalText()
+	if err != nil {
+		return fmt.Sprintf("%%!v(ERROR=%v)", err)
+	}
+	return string(text)
+}
+
+// String returns this Bool's value as text, or "false" if it is null.
+func (b Bool) String() string {
+	return textString(b)
+}
+
+// String returns this Float's value as text, or "0" if it is null.
+func (f Float) String() string {
+	return textString(f)
+}
+
+// String returns this Int's value as text, or "0" if it is null.
+func (i Int) String() string {
+	return textString(i)
+}
+
+// String returns this Time's value as text, like MarshalText, or the zero time if it is null.
+func (t Time) String() string {
+	return textString(t)
+}
+
+// Format implements fmt.Formatter, printing this String's value with the given verb and flags, or a blank string if it is null.
+func (s String) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), s.ValueOrZero())
+}
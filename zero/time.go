@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"gopkg.in/guregu/null.v4/internal/driverval"
 )
 
 // Time is a nullable time.Time.
@@ -66,6 +68,12 @@ func (t Time) MarshalJSON() ([]byte, error) {
 	return t.Time.MarshalJSON()
 }
 
+// Scan implements the Scanner interface.
+// Unlike sql.NullTime, it also accepts *time.Time, which clickhouse-go returns for Nullable(DateTime) columns.
+func (t *Time) Scan(value interface{}) error {
+	return t.NullTime.Scan(driverval.Convert(value))
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports string and null input.
 func (t *Time) UnmarshalJSON(data []byte) error {
@@ -117,6 +125,16 @@ func (t *Time) SetValid(v time.Time) {
 	t.Valid = true
 }
 
+// SetNull sets this Time to null, leaving its value as is. Use Reset to clear the value too.
+func (t *Time) SetNull() {
+	t.Valid = false
+}
+
+// Reset sets this Time to null and clears its value.
+func (t *Time) Reset() {
+	*t = Time{}
+}
+
 // Ptr returns a pointer to this Time's value,
 // or a nil pointer if this Time is zero.
 func (t Time) Ptr() *time.Time {
@@ -126,11 +144,21 @@ func (t Time) Ptr() *time.Time {
 	return &t.Time
 }
 
+// SetPtr changes this Time's value to the value v points to, or sets it to be null if v is nil, like TimeFromPtr.
+func (t *Time) SetPtr(v *time.Time) {
+	*t = TimeFromPtr(v)
+}
+
 // IsZero returns true for null or zero Times, for potential future omitempty support.
 func (t Time) IsZero() bool {
 	return !t.Valid || t.Time.IsZero()
 }
 
+// IsZeroOrNull returns true for null or zero Times, the same as IsZero.
+func (t Time) IsZeroOrNull() bool {
+	return t.IsZero()
+}
+
 // Equal returns true if both Time objects encode the same time or are both are either null or zero.
 // Two times can be equal even if they are in different locations.
 // For example, 6:00 +0200 CEST and 4:00 UTC are Equal.
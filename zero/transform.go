@@ -0,0 +1,167 @@
+package zero
+
+import "time"
+
+// The Map, Filter, Or, and OrElse methods transform values and provide fallbacks
+// without checking Valid first, and can be chained: i.Filter(positive).Map(double).OrElse(1).
+// Like the From constructors, Map returns null if fn returns a zero value.
+
+// Map returns the result of fn applied to this Bool's value, or null if this Bool is null.
+func (b Bool) Map(fn func(bool) bool) Bool {
+	if !b.Valid {
+		return b
+	}
+	return BoolFrom(fn(b.Bool))
+}
+
+// Filter returns this Bool if it is null or fn returns true for its value, otherwise null.
+func (b Bool) Filter(fn func(bool) bool) Bool {
+	if !b.Valid || fn(b.Bool) {
+		return b
+	}
+	return Bool{}
+}
+
+// Or returns this Bool if it is valid, otherwise other.
+func (b Bool) Or(other Bool) Bool {
+	if b.Valid {
+		return b
+	}
+	return other
+}
+
+// OrElse returns this Bool's value if it is valid, otherwise fallback.
+func (b Bool) OrElse(fallback bool) bool {
+	if !b.Valid {
+		return fallback
+	}
+	return b.Bool
+}
+
+// Map returns the result of fn applied to this Float's value, or null if this Float is null.
+func (f Float) Map(fn func(float64) float64) Float {
+	if !f.Valid {
+		return f
+	}
+	return FloatFrom(fn(f.Float64))
+}
+
+// Filter returns this Float if it is null or fn returns true for its value, otherwise null.
+func (f Float) Filter(fn func(float64) bool) Float {
+	if !f.Valid || fn(f.Float64) {
+		return f
+	}
+	return Float{}
+}
+
+// Or returns this Float if it is valid, otherwise other.
+func (f Float) Or(other Float) Float {
+	if f.Valid {
+		return f
+	}
+	return other
+}
+
+// OrElse returns this Float's value if it is valid, otherwise fallback.
+func (f Float) OrElse(fallback float64) float64 {
+	if !f.Valid {
+		return fallback
+	}
+	return f.Float64
+}
+
+// Map returns the result of fn applied to this Int's value, or null if this Int is null.
+func (i Int) Map(fn func(int64) int64) Int {
+	if !i.Valid {
+		return i
+	}
+	return IntFrom(fn(i.Int64))
+}
+
+// Filter returns this Int if it is null or fn returns true for its value, otherwise null.
+func (i Int) Filter(fn func(int64) bool) Int {
+	if !i.Valid || fn(i.Int64) {
+		return i
+	}
+	return Int{}
+}
+
+// Or returns this Int if it is valid, otherwise other.
+func (i Int) Or(other Int) Int {
+	if i.Valid {
+		return i
+	}
+	return other
+}
+
+// OrElse returns this Int's value if it is valid, otherwise fallback.
+func (i Int) OrElse(fallback int64) int64 {
+	if !i.Valid {
+		return fallback
+	}
+	return i.Int64
+}
+
+// Map returns the result of fn applied to this String's value, or null if this String is null.
+func (s String) Map(fn func(string) string) String {
+	if !s.Valid {
+		return s
+	}
+	return StringFrom(fn(s.String))
+}
+
+// Filter returns this String if it is null or fn returns true for its value, otherwise null.
+func (s String) Filter(fn func(string) bool) String {
+	if !s.Valid || fn(s.String) {
+		return s
+	}
+	return String{}
+}
+
+// Or returns this String if it is valid, otherwise other.
+func (s String) Or(other String) String {
+	if s.Valid {
+		return s
+	}
+	return other
+}
+
+// OrElse returns this String's value if it is valid, otherwise fallback.
+func (s String) OrElse(fallback string) string {
+	if !s.Valid {
+		return fallback
+	}
+	return s.String
+}
+
+// Map returns the result of fn applied to this Time's value, or null if this Time is null.
+func (t Time) Map(fn func(time.Time) time.Time) Time {
+	if !t.Valid {
+		return t
+	}
+	return TimeFrom(fn(t.Time))
+}
+
+// Filter returns this Time if it is null or fn returns true for its value, otherwise null.
+func (t Time) Filter(fn func(time.Time) bool) Time {
+	if !t.Valid || fn(t.Time) {
+		return t
+	}
+	return Time{}
+}
+
+// Or returns this Time if it is valid, otherwise other.
+func (t Time) Or(other Time) Time {
+	if t.Valid {
+		return t
+	}
+	return other
+}
+
+// OrElse returns this Time's value if it is valid, otherwise fallback.
+func (t Time) OrElse(fallback time.Time) time.Time {
+	if !t.Valid {
+		return fallback
+	}
+	return t.Time
+}
@@ -0,0 +1,112 @@
+//go:build yaml
+
+package zero
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// This file implements yaml.Marshaler and yaml.Unmarshaler from gopkg.in/yaml.v3.
+// It is only built with the yaml build tag:
+//
+//	go build -tags yaml
+//
+// Values are converted to and from the same representation as JSON,
+// so null values are encoded as the zero value, and zero values decode to null.
+
+func marshalYAML(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("zero: couldn't marshal YAML: %w", err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("zero: couldn't marshal YAML: %w", err)
+	}
+	node := doc.Content[0]
+	plainYAML(node)
+	return node, nil
+}
+
+// plainYAML clears the JSON quoting style from node and its children.
+func plainYAML(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		plainYAML(child)
+	}
+}
+
+func unmarshalYAML(v interface{}, node *yaml.Node) error {
+	var x interface{}
+	if err := node.Decode(&x); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal YAML: %w", err)
+	}
+	data, err := json.Marshal(x)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal YAML: %w", err)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode false if this Bool is null.
+func (b Bool) MarshalYAML() (interface{}, error) {
+	return marshalYAML(b)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Bool can be unmarshaled from as JSON.
+func (b *Bool) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(b, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Float is null.
+func (f Float) MarshalYAML() (interface{}, error) {
+	return marshalYAML(f)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Float can be unmarshaled from as JSON.
+func (f *Float) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(f, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Int) MarshalYAML() (interface{}, error) {
+	return marshalYAML(i)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Int can be unmarshaled from as JSON.
+func (i *Int) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(i, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode a blank string if this String is null.
+func (s String) MarshalYAML() (interface{}, error) {
+	return marshalYAML(s)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this String can be unmarshaled from as JSON.
+func (s *String) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(s, node)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode the zero time if this Time is null.
+func (t Time) MarshalYAML() (interface{}, error) {
+	return marshalYAML(t)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports anything this Time can be unmarshaled from as JSON.
+func (t *Time) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAML(t, node)
+}
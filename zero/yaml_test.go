@@ -0,0 +1,56 @@
+//go:build yaml
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAML(t *testing.T) {
+	type config struct {
+		Bool   Bool
+		Float  Float
+		Int    Int
+		String String
+		Time   Time
+	}
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	in := config{
+		Bool:   BoolFrom(true),
+		Float:  FloatFrom(1.5),
+		Int:    IntFrom(12345),
+		String: StringFrom("hello"),
+		Time:   TimeFrom(now),
+	}
+	data, err := yaml.Marshal(in)
+	maybePanic(err)
+	var out config
+	err = yaml.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.Bool.Equal(in.Bool) || !out.Float.Equal(in.Float) || !out.Int.Equal(in.Int) ||
+		!out.String.Equal(in.String) || !out.Time.Equal(in.Time) {
+		t.Errorf("bad round trip: %#v ≠ %#v", out, in)
+	}
+
+	data, err = yaml.Marshal(config{})
+	maybePanic(err)
+	want := `bool: false
+float: 0
+int: 0
+string: ""
+time: "0001-01-01T00:00:00Z"
+`
+	if string(data) != want {
+		t.Errorf("bad null YAML:\n%s\nwant:\n%s", data, want)
+	}
+
+	var zero config
+	err = yaml.Unmarshal(data, &zero)
+	maybePanic(err)
+	if zero.Bool.Valid || zero.Float.Valid || zero.Int.Valid || zero.String.Valid || zero.Time.Valid {
+		t.Errorf("zero values should decode as null: %#v", zero)
+	}
+}